@@ -3,17 +3,26 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"flag"
 	"fmt"
 	"log"
+	"log/slog"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/cacack/my-family/internal/api"
 	"github.com/cacack/my-family/internal/command"
 	"github.com/cacack/my-family/internal/config"
 	"github.com/cacack/my-family/internal/demo"
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/filesystem"
 	"github.com/cacack/my-family/internal/repository/memory"
+	"github.com/cacack/my-family/internal/repository/postgres"
+	"github.com/cacack/my-family/internal/repository/s3"
+	"github.com/cacack/my-family/internal/repository/sqlite"
 	"github.com/cacack/my-family/internal/web"
 )
 
@@ -32,7 +41,7 @@ func main() {
 
 	switch os.Args[1] {
 	case "serve":
-		runServer()
+		runServer(os.Args[2:])
 	case "version":
 		fmt.Printf("my-family %s (commit: %s, built: %s)\n", version, commit, date)
 	case "help", "-h", "--help":
@@ -48,31 +57,211 @@ func printUsage() {
 	fmt.Println(`My Family - Self-hosted genealogy software
 
 Usage:
-  myfamily <command>
+  myfamily <command> [options]
 
 Commands:
   serve     Start the HTTP server
   version   Show version information
   help      Show this help message
 
+Serve Options:
+  --config <path>      YAML config file; keys are the variables below in lower case
+                       (e.g. sqlite_path), and environment variables override them
+  --port <n>           HTTP server port (overrides PORT)
+  --sqlite <path>      SQLite database path (overrides SQLITE_PATH; alias --db)
+  --log-level <level>  Log level: debug, info, warn, error (overrides LOG_LEVEL)
+  --log-format <fmt>   Log format: text, json (overrides LOG_FORMAT)
+
 Environment Variables:
+  CONFIG_FILE    YAML config file, used when --config is not given
   DATABASE_URL   PostgreSQL connection string (optional, uses SQLite by default)
   SQLITE_PATH    SQLite database path (default: ./myfamily.db)
   PORT           HTTP server port (default: 8080)
   LOG_LEVEL      Log level: debug, info, warn, error (default: info)
   LOG_FORMAT     Log format: text, json (default: text)
+  TLS_CERT       PEM certificate file; serve HTTPS together with TLS_KEY
+  TLS_KEY        PEM private key file for TLS_CERT
+  ACME_DOMAIN    Comma-separated domains to get Let's Encrypt certificates for
+  ACME_CACHE_DIR Directory for Let's Encrypt certificates (default: ./acme-cache)
+  SNAPSHOT_EVERY Snapshot each entity stream every N events, 0 disables (default: 50)
+  THUMBNAIL_SIZE Default media thumbnail size in pixels (default: 300)
+  MAX_MEDIA_SIZE Largest media upload in megabytes (default: 10)
+  MAX_IMPORT_SIZE  Largest GEDCOM import upload in megabytes, with media archive (default: 100)
+  DEFAULT_GENERATIONS  Generations shown in pedigree, ahnentafel and descendancy views (default: 5, 4 for descendancy)
+  MAX_GENERATIONS  Most generations a tree view may request (default: 10)
+  SEARCH_MIN_QUERY_LENGTH  Fewest characters in a search query, 1 for CJK names (default: 2)
+  SEARCH_MAX_LIMIT  Most results a search may request (default: 100)
+  MEDIA_STORAGE  Media content storage: database, filesystem, s3 (default: database)
+  MEDIA_STORAGE_PATH  Directory for filesystem media storage (default: ./media)
+  MEDIA_S3_ENDPOINT  S3-compatible endpoint URL for s3 media storage
+  MEDIA_S3_BUCKET  Bucket for s3 media storage
+  MEDIA_S3_REGION  Signing region for s3 media storage (default: us-east-1)
+  MEDIA_S3_ACCESS_KEY_ID  Access key for s3 media storage
+  MEDIA_S3_SECRET_ACCESS_KEY  Secret key for s3 media storage
+  API_TOKENS     Comma-separated bearer tokens API requests must carry (default: none, API open)
+  AUTH_PUBLIC_READS  With API_TOKENS, allow GET requests without a token (default: false)
+  ADMIN_TOKENS   Comma-separated bearer tokens for /api/v1/admin endpoints (default: none, disabled)
+  RATE_LIMIT     API requests per minute per client IP, 0 disables (default: 0)
+  RATE_LIMIT_EXPENSIVE  Search, export, GraphQL and admin requests per minute per client IP,
+                 0 disables (default: 0)
+  TRUSTED_PROXIES  Comma-separated proxy IPs or CIDR ranges whose X-Forwarded-For
+                 gives the client IP (default: none, use the connection's address)
+  CORS_ALLOW_ORIGINS  Comma-separated origins allowed to call the API cross-origin (default: *)
+  CORS_ALLOW_METHODS  Comma-separated methods allowed cross-origin (default: GET,POST,PUT,DELETE,OPTIONS)
+  CORS_ALLOW_HEADERS  Comma-separated request headers allowed besides those the API reads
+  IGNORE_SURNAME_PREFIX  File surnames under their main part, e.g. "van Gogh" under G (default: false)
+  HOME_PERSON    ID of the tree owner; person details show their relationship to them
+  TREES          Comma-separated IDs of extra family trees, each with its own database
+                 (served under /api/v1/trees/<id>/ or with an X-Tree-ID header)
   DEMO_MODE      Run with sample data, no persistence (default: false)`)
 }
 
-func runServer() {
-	// Load configuration
+// loadServeConfig builds the server configuration from, in increasing order of
+// precedence, defaults, the config file, environment variables and the serve
+// command's flags.
+func loadServeConfig(args []string) (*config.Config, error) {
+	var (
+		configFile string
+		port       int
+		sqlitePath string
+		logLevel   string
+		logFormat  string
+	)
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	fs.Usage = printUsage
+	fs.StringVar(&configFile, "config", os.Getenv("CONFIG_FILE"), "path to a YAML config file")
+	fs.IntVar(&port, "port", 0, "HTTP server port")
+	fs.StringVar(&sqlitePath, "sqlite", "", "SQLite database path")
+	fs.StringVar(&sqlitePath, "db", "", "SQLite database path (alias for --sqlite)")
+	fs.StringVar(&logLevel, "log-level", "", "log level: debug, info, warn, error")
+	fs.StringVar(&logFormat, "log-format", "", "log format: text, json")
+	_ = fs.Parse(args)
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected argument: %s", fs.Arg(0))
+	}
+
 	cfg := config.Load()
+	if configFile != "" {
+		var err error
+		cfg, err = config.LoadFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Only flags given on the command line override the loaded values
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "port":
+			cfg.Port = port
+		case "sqlite", "db":
+			cfg.SQLitePath = sqlitePath
+		case "log-level":
+			cfg.LogLevel = logLevel
+		case "log-format":
+			cfg.LogFormat = logFormat
+		}
+	})
+	return cfg, nil
+}
+
+func runServer(args []string) {
+	// Load configuration
+	cfg, err := loadServeConfig(args)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if err := cfg.ValidateTLS(); err != nil {
+		log.Fatalf("Invalid TLS configuration: %v", err)
+	}
+	if err := cfg.ValidateCORS(); err != nil {
+		log.Fatalf("Invalid CORS configuration: %v", err)
+	}
+	if err := cfg.ValidateTrustedProxies(); err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES configuration: %v", err)
+	}
+	if err := cfg.ValidateHomePerson(); err != nil {
+		log.Fatalf("Invalid HOME_PERSON configuration: %v", err)
+	}
+	if err := cfg.ValidateTrees(); err != nil {
+		log.Fatalf("Invalid TREES configuration: %v", err)
+	}
+
+	// Background warnings (e.g. from projections) share the request log's
+	// level and format
+	slog.SetDefault(api.NewLogger(cfg, os.Stdout))
+
+	// Create repositories. Demo mode is ephemeral and always uses the
+	// in-memory stores so it can be reset; otherwise data is persisted.
+	var (
+		st         *stores
+		serverOpts []api.ServerOption
+	)
+	if cfg.DemoMode {
+		memEventStore := memory.NewEventStore()
+		memReadStore := memory.NewReadModelStore()
+		memSnapshotStore := memory.NewSnapshotStore(memEventStore)
+		st = &stores{eventStore: memEventStore, readStore: memReadStore, snapshotStore: memSnapshotStore}
+		serverOpts = append(serverOpts, api.WithDemoReset(memEventStore, memReadStore, memSnapshotStore))
+	} else if cfg.UsePostgreSQL() {
+		db, err := postgres.OpenDB(cfg.DatabaseURL)
+		if err != nil {
+			log.Fatalf("Failed to open PostgreSQL database: %v", err)
+		}
+		defer db.Close()
 
-	// Create repositories
-	// For MVP, use in-memory stores. SQLite will be added later.
-	eventStore := memory.NewEventStore()
-	readStore := memory.NewReadModelStore()
-	snapshotStore := memory.NewSnapshotStore(eventStore)
+		st, err = newPostgresStores(db)
+		if err != nil {
+			log.Fatalf("Failed to initialize PostgreSQL stores: %v", err)
+		}
+	} else {
+		db, err := sqlite.OpenDB(cfg.SQLitePath)
+		if err != nil {
+			log.Fatalf("Failed to open SQLite database: %v", err)
+		}
+		defer db.Close()
+
+		st, err = newSQLiteStores(db)
+		if err != nil {
+			log.Fatalf("Failed to initialize SQLite stores: %v", err)
+		}
+	}
+	if st.streamSnapshots != nil {
+		serverOpts = append(serverOpts, api.WithStreamSnapshots(st.streamSnapshots))
+	}
+	mediaBlobs, err := newMediaBlobStore(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize media storage: %v", err)
+	}
+	if mediaBlobs != nil {
+		serverOpts = append(serverOpts, api.WithMediaBlobStore(mediaBlobs))
+	}
+	serverOpts = append(serverOpts, api.WithProjectionCheck(newScratchReadStore))
+	eventStore, readStore, snapshotStore := st.eventStore, st.readStore, st.snapshotStore
+
+	// Additional family trees, each served by its own server over its own
+	// stores. Blob keys are content hashes that only the owning tree tracks,
+	// so each tree gets its own namespace in the shared blob store.
+	trees := make(map[string]*api.Server, len(cfg.Trees))
+	for _, id := range cfg.Trees {
+		treeStores, closeTree, err := openTreeStores(cfg, id)
+		if err != nil {
+			log.Fatalf("Failed to initialize tree %q: %v", id, err)
+		}
+		defer closeTree()
+
+		treeOpts := []api.ServerOption{api.WithProjectionCheck(newScratchReadStore)}
+		if treeStores.streamSnapshots != nil {
+			treeOpts = append(treeOpts, api.WithStreamSnapshots(treeStores.streamSnapshots))
+		}
+		if mediaBlobs != nil {
+			treeOpts = append(treeOpts, api.WithMediaBlobStore(repository.NewTreeMediaBlobStore(mediaBlobs, id)))
+		}
+		trees[id] = api.NewServer(cfg, treeStores.eventStore, treeStores.readStore, treeStores.snapshotStore, nil, treeOpts...)
+	}
+	if len(trees) > 0 {
+		serverOpts = append(serverOpts, api.WithTrees(trees))
+	}
 
 	// Get frontend filesystem (embedded in production, local in dev)
 	frontendFS, err := web.GetFileSystem()
@@ -82,14 +271,44 @@ func runServer() {
 	}
 
 	log.Printf("Starting My Family server on port %d", cfg.Port)
-	if cfg.UsePostgreSQL() {
+	switch {
+	case cfg.DemoMode:
+		log.Printf("Database: In-memory")
+	case cfg.UsePostgreSQL():
 		log.Printf("Database: PostgreSQL")
-	} else {
-		log.Printf("Database: In-memory (SQLite path configured: %s)", cfg.SQLitePath)
+	default:
+		log.Printf("Database: SQLite (%s)", cfg.SQLitePath)
+	}
+	if len(cfg.Trees) > 0 {
+		log.Printf("Trees: %s, %s", config.DefaultTreeID, strings.Join(cfg.Trees, ", "))
+	}
+	switch cfg.MediaStorage {
+	case "filesystem":
+		log.Printf("Media storage: Filesystem (%s)", cfg.MediaStoragePath)
+	case "s3":
+		log.Printf("Media storage: S3 (%s/%s)", cfg.MediaS3Endpoint, cfg.MediaS3Bucket)
+	default:
+		log.Printf("Media storage: Database")
 	}
 	if cfg.DemoMode {
 		log.Printf("Mode: DEMO (sample data, no persistence)")
 	}
+	switch {
+	case len(cfg.ACMEDomains) > 0:
+		log.Printf("TLS: Let's Encrypt (%s)", strings.Join(cfg.ACMEDomains, ", "))
+	case cfg.TLSEnabled():
+		log.Printf("TLS: Certificate %s", cfg.TLSCert)
+	default:
+		log.Printf("TLS: Disabled (plain HTTP)")
+	}
+	switch {
+	case !cfg.AuthEnabled():
+		log.Printf("Auth: Disabled (API is open)")
+	case cfg.AuthPublicReads:
+		log.Printf("Auth: Bearer token required for changes (reads are public)")
+	default:
+		log.Printf("Auth: Bearer token required")
+	}
 	if frontendFS != nil {
 		log.Printf("Frontend: Embedded")
 	} else {
@@ -105,12 +324,6 @@ func runServer() {
 		log.Printf("Demo data loaded: sample family tree ready")
 	}
 
-	// Build server options for demo mode
-	var serverOpts []api.ServerOption
-	if cfg.DemoMode {
-		serverOpts = append(serverOpts, api.WithDemoReset(eventStore, readStore, snapshotStore))
-	}
-
 	// Create and start server
 	server := api.NewServer(cfg, eventStore, readStore, snapshotStore, frontendFS, serverOpts...)
 
@@ -131,3 +344,118 @@ func runServer() {
 		log.Printf("Server stopped: %v", err)
 	}
 }
+
+// stores groups the repositories backing the server.
+type stores struct {
+	eventStore      repository.EventStore
+	readStore       repository.ReadModelStore
+	snapshotStore   repository.SnapshotStore
+	streamSnapshots repository.StreamSnapshotStore // nil for ephemeral demo stores
+}
+
+// openTreeStores opens the stores for an additional family tree: fresh
+// in-memory stores in demo mode, otherwise a schema named after the tree in
+// the PostgreSQL database or a SQLite file beside the default one. The
+// returned function closes the database.
+func openTreeStores(cfg *config.Config, treeID string) (*stores, func(), error) {
+	switch {
+	case cfg.DemoMode:
+		eventStore := memory.NewEventStore()
+		return &stores{
+			eventStore:    eventStore,
+			readStore:     memory.NewReadModelStore(),
+			snapshotStore: memory.NewSnapshotStore(eventStore),
+		}, func() {}, nil
+	case cfg.UsePostgreSQL():
+		db, err := postgres.OpenSchemaDB(cfg.DatabaseURL, "tree_"+treeID)
+		if err != nil {
+			return nil, nil, err
+		}
+		st, err := newPostgresStores(db)
+		if err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+		return st, func() { db.Close() }, nil
+	default:
+		db, err := sqlite.OpenDB(cfg.TreeSQLitePath(treeID))
+		if err != nil {
+			return nil, nil, err
+		}
+		st, err := newSQLiteStores(db)
+		if err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+		return st, func() { db.Close() }, nil
+	}
+}
+
+// newScratchReadStore returns an empty in-memory read model for projection
+// checks to rebuild state into, whatever database the server itself uses.
+func newScratchReadStore() repository.ReadModelStore {
+	return memory.NewReadModelStore()
+}
+
+// newMediaBlobStore creates the external store for media content selected by
+// cfg.MediaStorage. Returns nil when content is kept in the database.
+func newMediaBlobStore(cfg *config.Config) (repository.MediaBlobStore, error) {
+	switch cfg.MediaStorage {
+	case "", "database":
+		return nil, nil
+	case "filesystem":
+		return filesystem.NewMediaBlobStore(cfg.MediaStoragePath)
+	case "s3":
+		return s3.NewMediaBlobStore(s3.Config{
+			Endpoint:        cfg.MediaS3Endpoint,
+			Bucket:          cfg.MediaS3Bucket,
+			Region:          cfg.MediaS3Region,
+			AccessKeyID:     cfg.MediaS3AccessKeyID,
+			SecretAccessKey: cfg.MediaS3SecretKey,
+		})
+	default:
+		return nil, fmt.Errorf("unknown MEDIA_STORAGE %q (want database, filesystem, or s3)", cfg.MediaStorage)
+	}
+}
+
+// newSQLiteStores creates the SQLite-backed repositories sharing a single connection.
+func newSQLiteStores(db *sql.DB) (*stores, error) {
+	eventStore, err := sqlite.NewEventStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("event store: %w", err)
+	}
+	readStore, err := sqlite.NewReadModelStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("read model store: %w", err)
+	}
+	snapshotStore, err := sqlite.NewSnapshotStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot store: %w", err)
+	}
+	streamSnapshots, err := sqlite.NewStreamSnapshotStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("stream snapshot store: %w", err)
+	}
+	return &stores{eventStore, readStore, snapshotStore, streamSnapshots}, nil
+}
+
+// newPostgresStores creates the PostgreSQL-backed repositories sharing a single connection pool.
+func newPostgresStores(db *sql.DB) (*stores, error) {
+	eventStore, err := postgres.NewEventStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("event store: %w", err)
+	}
+	readStore, err := postgres.NewReadModelStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("read model store: %w", err)
+	}
+	snapshotStore, err := postgres.NewSnapshotStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot store: %w", err)
+	}
+	streamSnapshots, err := postgres.NewStreamSnapshotStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("stream snapshot store: %w", err)
+	}
+	return &stores{eventStore, readStore, snapshotStore, streamSnapshots}, nil
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+
+	"github.com/cacack/my-family/internal/query"
+)
+
+// ============================================================================
+// Admin endpoints
+// ============================================================================
+
+// VerifyProjections implements StrictServerInterface.
+func (ss *StrictServer) VerifyProjections(ctx context.Context, request VerifyProjectionsRequestObject) (VerifyProjectionsResponseObject, error) {
+	if ss.server.projectionCheck == nil {
+		return VerifyProjections403JSONResponse{ForbiddenJSONResponse{
+			Code:    CodeForbidden,
+			Message: "Projection checks are not available on this server",
+		}}, nil
+	}
+
+	sample := 0
+	if request.Params.Sample != nil {
+		sample = *request.Params.Sample
+	}
+
+	result, err := ss.server.projectionCheck.VerifyProjections(ctx, sample)
+	if err != nil {
+		return nil, err
+	}
+
+	discrepancies := make([]ProjectionDiscrepancy, len(result.Discrepancies))
+	for i, d := range result.Discrepancies {
+		discrepancies[i] = convertProjectionDiscrepancy(d)
+	}
+
+	return VerifyProjections200JSONResponse{
+		Checked:        result.Checked,
+		EventsReplayed: result.EventsReplayed,
+		Discrepancies:  discrepancies,
+	}, nil
+}
+
+// convertProjectionDiscrepancy converts a query projection discrepancy to the API type.
+func convertProjectionDiscrepancy(d query.ProjectionDiscrepancy) ProjectionDiscrepancy {
+	out := ProjectionDiscrepancy{
+		EntityType: d.EntityType,
+		EntityId:   d.EntityID,
+		Issue:      ProjectionDiscrepancyIssue(d.Issue),
+		Error:      strPtr(d.Error),
+	}
+	if len(d.Fields) > 0 {
+		fields := make([]ProjectionFieldMismatch, len(d.Fields))
+		for i, f := range d.Fields {
+			fields[i] = ProjectionFieldMismatch{
+				Field:    f.Field,
+				Expected: f.Expected,
+				Actual:   f.Actual,
+			}
+		}
+		out.Fields = &fields
+	}
+	return out
+}
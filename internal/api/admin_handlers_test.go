@@ -0,0 +1,99 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cacack/my-family/internal/api"
+	"github.com/cacack/my-family/internal/config"
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/memory"
+)
+
+func newScratchReadStore() repository.ReadModelStore {
+	return memory.NewReadModelStore()
+}
+
+func setupAdminTestServer(cfg *config.Config, opts ...api.ServerOption) *api.Server {
+	eventStore := memory.NewEventStore()
+	return api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil, opts...)
+}
+
+func doAdminRequest(server *api.Server, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/verify-projections", http.NoBody)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestVerifyProjections(t *testing.T) {
+	server := setupAdminTestServer(&config.Config{AdminTokens: []string{"root"}}, api.WithProjectionCheck(newScratchReadStore))
+	importAhnentafelTestData(t, server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/verify-projections", http.NoBody)
+	req.Header.Set("Authorization", "Bearer root")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp struct {
+		Checked        int               `json:"checked"`
+		EventsReplayed int               `json:"events_replayed"`
+		Discrepancies  []json.RawMessage `json:"discrepancies"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	// Five persons and two families from the import
+	if resp.Checked != 7 {
+		t.Errorf("Checked = %d, want 7", resp.Checked)
+	}
+	if resp.Discrepancies == nil || len(resp.Discrepancies) != 0 {
+		t.Errorf("Discrepancies = %s, want empty list", rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/admin/verify-projections?sample=3", http.NoBody)
+	req.Header.Set("Authorization", "Bearer root")
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Checked != 3 {
+		t.Errorf("sample=3: Checked = %d, want 3", resp.Checked)
+	}
+}
+
+func TestVerifyProjections_AdminAuth(t *testing.T) {
+	check := api.WithProjectionCheck(newScratchReadStore)
+	tests := []struct {
+		name   string
+		cfg    *config.Config
+		opts   []api.ServerOption
+		token  string
+		status int
+	}{
+		{"disabled without admin tokens", &config.Config{}, []api.ServerOption{check}, "", http.StatusForbidden},
+		{"missing token", &config.Config{AdminTokens: []string{"root"}}, []api.ServerOption{check}, "", http.StatusUnauthorized},
+		{"API token is not an admin token", &config.Config{APITokens: []string{"user"}, AdminTokens: []string{"root"}}, []api.ServerOption{check}, "user", http.StatusUnauthorized},
+		{"public reads do not open admin", &config.Config{APITokens: []string{"user"}, AuthPublicReads: true, AdminTokens: []string{"root"}}, []api.ServerOption{check}, "", http.StatusUnauthorized},
+		{"admin token passes API auth", &config.Config{APITokens: []string{"user"}, AdminTokens: []string{"root"}}, []api.ServerOption{check}, "root", http.StatusOK},
+		{"no projection check configured", &config.Config{AdminTokens: []string{"root"}}, nil, "root", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupAdminTestServer(tt.cfg, tt.opts...)
+			if rec := doAdminRequest(server, tt.token); rec.Code != tt.status {
+				t.Errorf("Status = %d, want %d. Body: %s", rec.Code, tt.status, rec.Body.String())
+			}
+		})
+	}
+}
@@ -287,6 +287,39 @@ func TestGetAhnentafel_TextFormat_Success(t *testing.T) {
 	}
 }
 
+func TestGetAhnentafel_IncludeSources(t *testing.T) {
+	server := setupAhnentafelTestServer(t)
+	juniorID := importAhnentafelTestData(t, server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/ahnentafel/"+juniorID+"?include_sources=true", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp api.AhnentafelResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	for _, entry := range resp.Entries {
+		if entry.Id != nil && entry.Sources == nil {
+			t.Errorf("entry %d has no sources", entry.Number)
+		}
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/ahnentafel/"+juniorID+"?format=text&include_sources=true", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	body := rec.Body.String()
+	if !strings.Contains(body, "b. 1 JAN 1970, Boston [unsourced]") {
+		t.Errorf("Text output should flag unsourced facts, got:\n%s", body)
+	}
+	if strings.Contains(body, "d. - [unsourced]") {
+		t.Errorf("Unknown facts should not be flagged, got:\n%s", body)
+	}
+}
+
 func TestGetAhnentafel_NotFound(t *testing.T) {
 	server := setupAhnentafelTestServer(t)
 
@@ -560,3 +593,59 @@ func TestGetAhnentafel_ExplicitJSONFormat(t *testing.T) {
 		t.Fatalf("Failed to parse response as JSON: %v", err)
 	}
 }
+
+func TestGetEndOfLines(t *testing.T) {
+	server := setupAhnentafelTestServer(t)
+	juniorID := importAhnentafelTestData(t, server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+juniorID+"/end-of-lines", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp struct {
+		Subject struct {
+			GivenName string `json:"given_name"`
+		} `json:"subject"`
+		Entries []struct {
+			Number    int    `json:"number"`
+			GivenName string `json:"given_name"`
+		} `json:"entries"`
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if resp.Subject.GivenName != "Junior" {
+		t.Errorf("Subject given name = %q, want Junior", resp.Subject.GivenName)
+	}
+	// Jane Doe (3) has no parents; George (4) and Mary (5) end the paternal line.
+	want := []struct {
+		number int
+		name   string
+	}{{3, "Jane"}, {4, "George"}, {5, "Mary"}}
+	if resp.Total != len(want) || len(resp.Entries) != len(want) {
+		t.Fatalf("Total = %d, entries = %d, want %d", resp.Total, len(resp.Entries), len(want))
+	}
+	for i, w := range want {
+		if resp.Entries[i].Number != w.number || resp.Entries[i].GivenName != w.name {
+			t.Errorf("Entries[%d] = %d %s, want %d %s", i, resp.Entries[i].Number, resp.Entries[i].GivenName, w.number, w.name)
+		}
+	}
+}
+
+func TestGetEndOfLines_NotFound(t *testing.T) {
+	server := setupAhnentafelTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/00000000-0000-0000-0000-000000000001/end-of-lines", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("Expected status 404, got %d", rec.Code)
+	}
+}
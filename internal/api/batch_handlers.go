@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cacack/my-family/internal/command"
+)
+
+// ============================================================================
+// Batch endpoint
+// ============================================================================
+
+// ExecuteBatch implements StrictServerInterface.
+func (ss *StrictServer) ExecuteBatch(ctx context.Context, request ExecuteBatchRequestObject) (ExecuteBatchResponseObject, error) {
+	ops := make([]command.BatchOperation, len(request.Body.Operations))
+	for i, op := range request.Body.Operations {
+		converted, err := convertBatchOperationToCommand(op)
+		if err != nil {
+			return ExecuteBatch400JSONResponse{BadRequestJSONResponse{
+				Code:    "invalid_input",
+				Message: fmt.Sprintf("operation %d: %s", i, err),
+			}}, nil
+		}
+		ops[i] = converted
+	}
+
+	stopOnError := true
+	if request.Body.StopOnError != nil {
+		stopOnError = *request.Body.StopOnError
+	}
+
+	result, err := ss.server.commandHandler.ExecuteBatch(ctx, ops, stopOnError)
+	if err != nil {
+		if errors.Is(err, command.ErrInvalidInput) {
+			return ExecuteBatch400JSONResponse{BadRequestJSONResponse{
+				Code:    "invalid_input",
+				Message: err.Error(),
+				Fields:  fieldErrors(err),
+			}}, nil
+		}
+		return nil, err
+	}
+
+	results := make([]BatchOperationResult, len(result.Results))
+	for i, r := range result.Results {
+		results[i] = BatchOperationResult{
+			Index:  r.Index,
+			Op:     r.Op,
+			Status: BatchOperationResultStatus(r.Status),
+			Id:     r.ID,
+		}
+		if r.Alias != "" {
+			alias := r.Alias
+			results[i].Alias = &alias
+		}
+		if r.Error != "" {
+			msg := r.Error
+			results[i].Error = &msg
+		}
+	}
+
+	return ExecuteBatch200JSONResponse{
+		Results:    results,
+		Succeeded:  result.Succeeded,
+		Failed:     result.Failed,
+		Skipped:    result.Skipped,
+		RolledBack: result.RolledBack,
+	}, nil
+}
+
+// convertBatchOperationToCommand maps a batch operation to command input,
+// requiring the payload its operation kind needs.
+func convertBatchOperationToCommand(op BatchOperation) (command.BatchOperation, error) {
+	result := command.BatchOperation{Op: string(op.Op)}
+	if op.Alias != nil {
+		result.Alias = *op.Alias
+	}
+	if op.PersonId != nil {
+		result.PersonRef = *op.PersonId
+	}
+	if op.Partner1Id != nil {
+		result.Partner1Ref = *op.Partner1Id
+	}
+	if op.Partner2Id != nil {
+		result.Partner2Ref = *op.Partner2Id
+	}
+	if op.FamilyId != nil {
+		result.FamilyRef = *op.FamilyId
+	}
+	if op.ChildId != nil {
+		result.ChildRef = *op.ChildId
+	}
+	if op.RelationshipType != nil {
+		result.RelationType = string(*op.RelationshipType)
+	}
+
+	switch result.Op {
+	case command.BatchCreatePerson:
+		if op.Person == nil {
+			return result, errors.New("person is required for create_person")
+		}
+		result.Person = convertPersonCreateToInput(*op.Person)
+	case command.BatchAddName:
+		if op.Name == nil {
+			return result, errors.New("name is required for add_name")
+		}
+		result.Name = convertPersonNameCreateToInput(*op.Name)
+	case command.BatchCreateFamily:
+		if op.Family != nil {
+			if op.Family.RelationshipType != nil {
+				result.Family.RelationshipType = string(*op.Family.RelationshipType)
+			}
+			if op.Family.MarriageDate != nil {
+				result.Family.MarriageDate = *op.Family.MarriageDate
+			}
+			if op.Family.MarriagePlace != nil {
+				result.Family.MarriagePlace = *op.Family.MarriagePlace
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,130 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func postBatch(t *testing.T, handler http.Handler, body map[string]interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+	jsonBody, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/batch", bytes.NewReader(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestExecuteBatch(t *testing.T) {
+	server := setupFamilyTestServer(t)
+
+	rec := postBatch(t, server.Echo(), map[string]interface{}{
+		"operations": []map[string]interface{}{
+			{"op": "create_person", "alias": "father", "person": map[string]interface{}{"given_name": "John", "surname": "Doe", "gender": "male"}},
+			{"op": "create_person", "alias": "mother", "person": map[string]interface{}{"given_name": "Jane", "surname": "Smith", "gender": "female"}},
+			{"op": "create_person", "alias": "child", "person": map[string]interface{}{"given_name": "Jimmy", "surname": "Doe"}},
+			{"op": "add_name", "person_id": "$mother", "name": map[string]interface{}{"given_name": "Jane", "surname": "Doe", "name_type": "married"}},
+			{"op": "create_family", "alias": "family", "partner1_id": "$father", "partner2_id": "$mother", "family": map[string]interface{}{"relationship_type": "marriage"}},
+			{"op": "link_child", "family_id": "$family", "child_id": "$child", "relationship_type": "biological"},
+		},
+	})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var result struct {
+		Results []struct {
+			Index  int    `json:"index"`
+			Alias  string `json:"alias"`
+			Status string `json:"status"`
+			ID     string `json:"id"`
+		} `json:"results"`
+		Succeeded int `json:"succeeded"`
+		Failed    int `json:"failed"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &result)
+
+	if result.Succeeded != 6 || result.Failed != 0 {
+		t.Fatalf("Expected 6 succeeded, got %s", rec.Body.String())
+	}
+	if result.Results[4].Alias != "family" || result.Results[4].ID == "" {
+		t.Errorf("Expected family result with id, got %+v", result.Results[4])
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/families/"+result.Results[4].ID, http.NoBody)
+	getRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(getRec, req)
+	if getRec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", getRec.Code, getRec.Body.String())
+	}
+	var family map[string]interface{}
+	json.Unmarshal(getRec.Body.Bytes(), &family)
+	if family["partner1_id"] != result.Results[0].ID || family["partner2_id"] != result.Results[1].ID {
+		t.Errorf("Family partners = %v/%v, want created persons", family["partner1_id"], family["partner2_id"])
+	}
+	if children, _ := family["children"].([]interface{}); len(children) != 1 {
+		t.Errorf("Expected 1 child, got %v", family["children"])
+	}
+}
+
+func TestExecuteBatch_StopOnError(t *testing.T) {
+	server := setupFamilyTestServer(t)
+
+	rec := postBatch(t, server.Echo(), map[string]interface{}{
+		"operations": []map[string]interface{}{
+			{"op": "create_person", "person": map[string]interface{}{"given_name": "Jane"}},
+			{"op": "create_family", "partner1_id": "00000000-0000-0000-0000-000000000001"},
+			{"op": "create_person", "person": map[string]interface{}{"given_name": "John"}},
+		},
+	})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var result map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &result)
+	if result["succeeded"] != float64(0) || result["rolled_back"] != float64(1) ||
+		result["failed"] != float64(1) || result["skipped"] != float64(1) {
+		t.Errorf("Expected 0/1/1/1, got %s", rec.Body.String())
+	}
+	results := result["results"].([]interface{})
+	if rolledBack := results[0].(map[string]interface{}); rolledBack["status"] != "rolled_back" {
+		t.Errorf("Expected the applied operation to be rolled back, got %v", rolledBack)
+	}
+	if failed := results[1].(map[string]interface{}); failed["status"] != "error" || failed["error"] == nil {
+		t.Errorf("Expected error result with message, got %v", failed)
+	}
+}
+
+func TestExecuteBatch_InvalidBatch(t *testing.T) {
+	server := setupFamilyTestServer(t)
+
+	tests := []struct {
+		name    string
+		ops     []map[string]interface{}
+		message string
+	}{
+		{"undefined alias", []map[string]interface{}{
+			{"op": "link_child", "family_id": "$family", "child_id": "$child"},
+		}, "undefined alias"},
+		{"missing payload", []map[string]interface{}{
+			{"op": "create_person"},
+		}, "person is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := postBatch(t, server.Echo(), map[string]interface{}{"operations": tt.ops})
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("Expected status 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.message) {
+				t.Errorf("Expected message containing %q, got %s", tt.message, rec.Body.String())
+			}
+		})
+	}
+}
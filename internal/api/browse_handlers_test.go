@@ -615,3 +615,213 @@ func TestGetPersonsByCemetery_Pagination(t *testing.T) {
 		t.Errorf("Limit = %d, want 2", resp.Limit)
 	}
 }
+
+func TestBrowseBirthDecades(t *testing.T) {
+	server := setupBrowseTestServer()
+
+	for _, body := range []string{
+		`{"given_name":"John","surname":"Smith","birth_date":"1852"}`,
+		`{"given_name":"Jane","surname":"Smith","birth_date":"ABT 1858"}`,
+		`{"given_name":"Bob","surname":"Smith"}`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("CreatePerson failed: %d - %s", rec.Code, rec.Body.String())
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/browse/birth-decades", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp struct {
+		Items []struct {
+			Decade string `json:"decade"`
+			Count  int    `json:"count"`
+		} `json:"items"`
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if resp.Total != 2 {
+		t.Fatalf("Total = %d, want 2", resp.Total)
+	}
+	if resp.Items[0].Decade != "1850s" || resp.Items[0].Count != 2 {
+		t.Errorf("Items[0] = %+v, want 1850s with 2", resp.Items[0])
+	}
+	if resp.Items[1].Decade != "unknown" || resp.Items[1].Count != 1 {
+		t.Errorf("Items[1] = %+v, want unknown with 1", resp.Items[1])
+	}
+
+	// List persons in the decade
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/browse/birth-decades/1850s", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var listResp struct {
+		Total int `json:"total"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &listResp)
+	if listResp.Total != 2 {
+		t.Errorf("Total = %d, want 2", listResp.Total)
+	}
+}
+
+func TestGetPersonsByBirthDecade_InvalidDecade(t *testing.T) {
+	server := setupBrowseTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/browse/birth-decades/1855", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestBrowseSourceRepositories(t *testing.T) {
+	server := setupBrowseTestServer()
+
+	for _, body := range []string{
+		`{"source_type":"census","title":"1850 Census","repository_name":"National Archives"}`,
+		`{"source_type":"other","title":"Passenger Lists","repository_name":"National Archives"}`,
+		`{"source_type":"book","title":"County History"}`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/sources", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("CreateSource failed: %d - %s", rec.Code, rec.Body.String())
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/browse/repositories", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp struct {
+		Items []struct {
+			Name  string `json:"name"`
+			Count int    `json:"count"`
+		} `json:"items"`
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if resp.Total != 1 {
+		t.Fatalf("Total = %d, want 1", resp.Total)
+	}
+	if resp.Items[0].Name != "National Archives" || resp.Items[0].Count != 2 {
+		t.Errorf("Items[0] = %+v, want National Archives with 2", resp.Items[0])
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/browse/repositories/"+url.PathEscape("National Archives"), http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var listResp struct {
+		Sources []struct {
+			Title string `json:"title"`
+		} `json:"sources"`
+		Total int `json:"total"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &listResp)
+	if listResp.Total != 2 {
+		t.Errorf("Total = %d, want 2", listResp.Total)
+	}
+}
+
+func TestListFactTypes(t *testing.T) {
+	server, readStore := setupBrowseTestServerWithStore()
+	createCemeteryTestData(t, readStore)
+	ctx := context.Background()
+
+	personID := uuid.New()
+	if err := readStore.SaveAttribute(ctx, &repository.AttributeReadModel{
+		ID:        uuid.New(),
+		PersonID:  personID,
+		FactType:  domain.FactPersonOccupation,
+		Value:     "Farmer",
+		Version:   1,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		t.Fatalf("SaveAttribute() failed: %v", err)
+	}
+	for _, ft := range []domain.FactType{domain.FactPersonBurial, "person_custom"} {
+		if err := readStore.SaveCitation(ctx, &repository.CitationReadModel{
+			ID:          uuid.New(),
+			SourceID:    uuid.New(),
+			FactType:    ft,
+			FactOwnerID: personID,
+			Version:     1,
+			CreatedAt:   time.Now(),
+		}); err != nil {
+			t.Fatalf("SaveCitation() failed: %v", err)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/fact-types", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp struct {
+		Items []struct {
+			FactType       string `json:"fact_type"`
+			Known          bool   `json:"known"`
+			Count          int    `json:"count"`
+			EventCount     int    `json:"event_count"`
+			AttributeCount int    `json:"attribute_count"`
+			CitationCount  int    `json:"citation_count"`
+		} `json:"items"`
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Total != 4 {
+		t.Fatalf("Total = %d, want 4: %+v", resp.Total, resp.Items)
+	}
+
+	byType := make(map[string]int)
+	for i, item := range resp.Items {
+		byType[item.FactType] = i
+	}
+	burial := resp.Items[byType["person_burial"]]
+	if burial.EventCount != 2 || burial.CitationCount != 1 || burial.Count != 3 || !burial.Known {
+		t.Errorf("person_burial = %+v, want 2 events, 1 citation, known", burial)
+	}
+	occupation := resp.Items[byType["person_occupation"]]
+	if occupation.AttributeCount != 1 || occupation.Count != 1 {
+		t.Errorf("person_occupation = %+v, want 1 attribute", occupation)
+	}
+	if custom := resp.Items[byType["person_custom"]]; custom.Known {
+		t.Errorf("person_custom known = true, want false")
+	}
+}
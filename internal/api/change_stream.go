@@ -0,0 +1,71 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// changeStreamBuffer is how many notifications a slow client may lag behind
+	// before further notifications are dropped for it.
+	changeStreamBuffer = 64
+
+	// changeStreamHeartbeat is how often an idle stream sends a comment line so
+	// proxies and load balancers do not close the connection.
+	changeStreamHeartbeat = 30 * time.Second
+)
+
+// registerChangeStreamRoutes wires the change notification stream. Like the
+// streaming import, it lives outside the generated handler because Server-Sent
+// Events do not map onto the OpenAPI strict server.
+func (s *Server) registerChangeStreamRoutes(api *echo.Group) {
+	api.GET("/events/stream", s.streamChanges)
+}
+
+// streamChanges sends a Server-Sent "change" event, carrying a
+// repository.ChangeNotification, for every event appended to the event store
+// until the client disconnects. Notifications are not replayed: clients should
+// re-read anything they display after (re)connecting.
+func (s *Server) streamChanges(c echo.Context) error {
+	changes, unsubscribe := s.changes.Subscribe(changeStreamBuffer)
+	defer unsubscribe()
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set("Cache-Control", "no-cache")
+	resp.Header().Set("Connection", "keep-alive")
+	resp.Header().Set("X-Accel-Buffering", "no") // disable proxy buffering (e.g. nginx)
+	resp.WriteHeader(http.StatusOK)
+
+	flusher, canFlush := resp.Writer.(http.Flusher)
+	flush := func() {
+		if canFlush {
+			flusher.Flush()
+		}
+	}
+	flush()
+
+	heartbeat := time.NewTicker(changeStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	ctx := c.Request().Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n := <-changes:
+			if err := s.writeSSE(c, "change", n); err != nil {
+				return nil
+			}
+			flush()
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(resp.Writer, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			flush()
+		}
+	}
+}
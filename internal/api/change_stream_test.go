@@ -0,0 +1,69 @@
+package api_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStreamChanges(t *testing.T) {
+	server := setupTestServer()
+	ts := httptest.NewServer(server.Echo())
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/api/v1/events/stream", http.NoBody)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to open stream: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/event-stream") {
+		t.Fatalf("Content-Type = %q, want text/event-stream", ct)
+	}
+
+	// The subscription is registered before headers are sent, so this change is seen
+	createResp, err := http.Post(ts.URL+"/api/v1/persons", "application/json",
+		strings.NewReader(`{"given_name":"Jane","surname":"Smith"}`))
+	if err != nil {
+		t.Fatalf("Failed to create person: %v", err)
+	}
+	var created map[string]any
+	_ = json.NewDecoder(createResp.Body).Decode(&created)
+	_ = createResp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	var event string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if name, ok := strings.CutPrefix(line, "event: "); ok {
+			event = name
+			continue
+		}
+		data, ok := strings.CutPrefix(line, "data: ")
+		if !ok || event != "change" {
+			continue
+		}
+
+		var n map[string]any
+		if err := json.Unmarshal([]byte(data), &n); err != nil {
+			t.Fatalf("Failed to parse notification: %v", err)
+		}
+		if n["entity_type"] != "person" || n["entity_id"] != created["id"] {
+			t.Errorf("notification = %v, want person %v", n, created["id"])
+		}
+		if n["version"] != float64(1) || n["action"] != "created" {
+			t.Errorf("notification = %v, want version 1 created", n)
+		}
+		return
+	}
+	t.Fatalf("stream ended without a change event: %v", scanner.Err())
+}
@@ -12,7 +12,10 @@ import (
 // getAppConfig returns application configuration visible to the frontend.
 func (s *Server) getAppConfig(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]any{
-		"demo_mode": s.config.DemoMode,
+		"demo_mode":       s.config.DemoMode,
+		"max_import_size": maxImportSize(s.config),
+		"max_generations": maxGenerations(s.config),
+		"trees":           s.treeIDs(),
 	})
 }
 
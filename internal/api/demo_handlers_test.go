@@ -73,6 +73,10 @@ func TestGetAppConfig_NormalMode(t *testing.T) {
 	if resp["demo_mode"] != false {
 		t.Errorf("demo_mode = %v, want false", resp["demo_mode"])
 	}
+	// JSON numbers decode as float64; the default limit is 100MB
+	if resp["max_import_size"] != float64(100*1024*1024) {
+		t.Errorf("max_import_size = %v, want %d", resp["max_import_size"], 100*1024*1024)
+	}
 }
 
 func TestResetDemo(t *testing.T) {
@@ -196,6 +196,11 @@ func TestGetDescendancy_Success(t *testing.T) {
 	if maxGeneration != 2 {
 		t.Errorf("max_generation = %d, want 2", maxGeneration)
 	}
+
+	generationCounts := result["generation_counts"].([]interface{})
+	if len(generationCounts) != 2 || generationCounts[0].(float64) != 1 || generationCounts[1].(float64) != 2 {
+		t.Errorf("generation_counts = %v, want [1 2]", generationCounts)
+	}
 }
 
 func TestGetDescendancy_NotFound(t *testing.T) {
@@ -259,6 +264,42 @@ func TestGetDescendancy_MaxGenerations(t *testing.T) {
 	}
 }
 
+func TestGetDescendancy_Filters(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		wantTotal int
+	}{
+		// Junior (2000) is left out; John (1970) connects George to Jenny (2002)
+		{"birth year cutoff", "?birth_year_from=2001", 2},
+		// Nobody has a recorded death or was born over 100 years ago
+		{"living only", "?include_deceased=false", 3},
+		{"no descendants born since", "?birth_year_from=2050", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupDescendancyTestServer(t)
+			georgeID := importDescendancyTestData(t, server)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/descendancy/"+georgeID+tt.query, http.NoBody)
+			rec := httptest.NewRecorder()
+			server.Echo().ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+			}
+
+			var result map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &result)
+
+			if total := int(result["total_descendants"].(float64)); total != tt.wantTotal {
+				t.Errorf("total_descendants = %d, want %d", total, tt.wantTotal)
+			}
+		})
+	}
+}
+
 func TestGetDescendancy_NoDescendants(t *testing.T) {
 	server := setupDescendancyTestServer(t)
 
@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"archive/zip"
 	"bytes"
 	"encoding/json"
 	"io"
@@ -765,3 +766,340 @@ func TestExportGedcom_InvalidVersion(t *testing.T) {
 		t.Errorf("Code = %q, want invalid_version", errResp.Code)
 	}
 }
+
+const exportIncludeTestGedcom = `0 HEAD
+1 GEDC
+2 VERS 5.5
+1 CHAR UTF-8
+0 @S1@ SOUR
+1 TITL Parish register
+0 @N1@ NOTE Research notes on John
+0 @I1@ INDI
+1 NAME John /Doe/
+1 BIRT
+2 DATE 15 JAN 1850
+2 SOUR @S1@
+3 PAGE folio 12
+1 NOTE @N1@
+0 TRLR
+`
+
+func TestExportGedcom_Include(t *testing.T) {
+	server := setupExportTestServer(t)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "test.ged")
+	io.WriteString(part, exportIncludeTestGedcom)
+	writer.Close()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/gedcom/import", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Import failed: %d: %s", rec.Code, rec.Body.String())
+	}
+
+	tests := []struct {
+		query       string
+		wantSources bool
+		wantNotes   bool
+	}{
+		{"", true, true},
+		{"?include=sources,notes", true, true},
+		{"?include=sources", true, false},
+		{"?include=notes", false, true},
+		{"?include=", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/gedcom/export"+tt.query, http.NoBody)
+			rec := httptest.NewRecorder()
+			server.Echo().ServeHTTP(rec, req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("Export failed: %d: %s", rec.Code, rec.Body.String())
+			}
+			output := rec.Body.String()
+
+			if !strings.Contains(output, "1 NAME John /Doe/\n") {
+				t.Error("Export should always contain persons")
+			}
+			if got := strings.Contains(output, "1 TITL Parish register\n"); got != tt.wantSources {
+				t.Errorf("source record exported = %v, want %v", got, tt.wantSources)
+			}
+			if got := strings.Contains(output, "3 PAGE folio 12\n"); got != tt.wantSources {
+				t.Errorf("citation exported = %v, want %v", got, tt.wantSources)
+			}
+			if got := strings.Contains(output, "Research notes on John"); got != tt.wantNotes {
+				t.Errorf("note exported = %v, want %v", got, tt.wantNotes)
+			}
+		})
+	}
+}
+
+func TestExportGedcom_InvalidInclude(t *testing.T) {
+	server := setupExportTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/gedcom/export?include=sources,media", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+// findPersonID returns the ID of the first person matching a search query.
+func findPersonID(t *testing.T, server *api.Server, q string) string {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q="+q, http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	var result struct {
+		Items []struct {
+			ID string `json:"id"`
+		} `json:"items"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &result)
+	if len(result.Items) == 0 {
+		t.Fatalf("Could not find %s in search results", q)
+	}
+	return result.Items[0].ID
+}
+
+func TestExportPersonGedcom_Descendants(t *testing.T) {
+	server := setupDescendancyTestServer(t)
+	importDescendancyTestData(t, server)
+	johnID := findPersonID(t, server, "John")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+johnID+"/export-gedcom?mode=descendants", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if cd := rec.Header().Get("Content-Disposition"); !strings.Contains(cd, "descendants.ged") {
+		t.Errorf("Content-Disposition = %q, want descendants.ged filename", cd)
+	}
+
+	body := rec.Body.String()
+	for _, name := range []string{"John /Smith/", "Jane /Doe/", "Junior /Smith/", "Jenny /Smith/"} {
+		if !strings.Contains(body, name) {
+			t.Errorf("descendant export should contain %s", name)
+		}
+	}
+	for _, name := range []string{"George /Smith/", "Mary /Jones/"} {
+		if strings.Contains(body, name) {
+			t.Errorf("descendant export should not contain %s", name)
+		}
+	}
+	if n := strings.Count(body, " FAM\n"); n != 1 {
+		t.Errorf("family records = %d, want 1", n)
+	}
+}
+
+func TestExportPersonGedcom_Ancestors(t *testing.T) {
+	server := setupDescendancyTestServer(t)
+	importDescendancyTestData(t, server)
+	juniorID := findPersonID(t, server, "Junior")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+juniorID+"/export-gedcom?mode=ancestors", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	body := rec.Body.String()
+	for _, name := range []string{"Junior /Smith/", "John /Smith/", "Jane /Doe/", "George /Smith/", "Mary /Jones/"} {
+		if !strings.Contains(body, name) {
+			t.Errorf("ancestor export should contain %s", name)
+		}
+	}
+	if strings.Contains(body, "Jenny /Smith/") {
+		t.Error("ancestor export should not contain siblings")
+	}
+}
+
+func TestExportPersonGedcom_InvalidMode(t *testing.T) {
+	server := setupDescendancyTestServer(t)
+	georgeID := importDescendancyTestData(t, server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+georgeID+"/export-gedcom?mode=cousins", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestExportPersonGedcom_NotFound(t *testing.T) {
+	server := setupExportTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/00000000-0000-0000-0000-000000000001/export-gedcom?mode=descendants", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("Expected status 404, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestExportSelection_Gedcom(t *testing.T) {
+	server := setupDescendancyTestServer(t)
+	georgeID := importDescendancyTestData(t, server)
+	juniorID := findPersonID(t, server, "Junior")
+	jennyID := findPersonID(t, server, "Jenny")
+
+	reqBody := `{"person_ids":["` + georgeID + `","` + juniorID + `","` + jennyID + `"]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/export/selection", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/x-gedcom" {
+		t.Errorf("Content-Type = %s, want application/x-gedcom", ct)
+	}
+
+	body := rec.Body.String()
+	for _, name := range []string{"George /Smith/", "Junior /Smith/", "Jenny /Smith/"} {
+		if !strings.Contains(body, name) {
+			t.Errorf("selection export should contain %s", name)
+		}
+	}
+	for _, name := range []string{"John /Smith/", "Jane /Doe/", "Mary /Jones/"} {
+		if strings.Contains(body, name) {
+			t.Errorf("selection export should not contain %s", name)
+		}
+	}
+	// Only the siblings' family connects two selected persons.
+	if n := strings.Count(body, " FAM\n"); n != 1 {
+		t.Errorf("family records = %d, want 1", n)
+	}
+}
+
+func TestExportSelection_JSON(t *testing.T) {
+	server := setupDescendancyTestServer(t)
+	georgeID := importDescendancyTestData(t, server)
+	johnID := findPersonID(t, server, "John")
+
+	reqBody := `{"person_ids":["` + georgeID + `","` + johnID + `"],"format":"json"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/export/selection", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var result struct {
+		Persons  []map[string]interface{} `json:"persons"`
+		Families []map[string]interface{} `json:"families"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if len(result.Persons) != 2 {
+		t.Errorf("persons = %d, want 2", len(result.Persons))
+	}
+	if len(result.Families) != 1 {
+		t.Errorf("families = %d, want 1", len(result.Families))
+	}
+}
+
+func TestExportSelection_Validation(t *testing.T) {
+	server := setupExportTestServer(t)
+
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"empty selection", `{"person_ids":[]}`, http.StatusBadRequest},
+		{"invalid format", `{"person_ids":["00000000-0000-0000-0000-000000000001"],"format":"csv"}`, http.StatusBadRequest},
+		{"unknown person", `{"person_ids":["00000000-0000-0000-0000-000000000001"]}`, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/export/selection", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			server.Echo().ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestExportBySurname(t *testing.T) {
+	server := setupDescendancyTestServer(t)
+	importDescendancyTestData(t, server)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/export/by-surname", strings.NewReader(`{"version":"5.5.1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/zip" {
+		t.Errorf("Content-Type = %s, want application/zip", ct)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(rec.Body.Bytes()), int64(rec.Body.Len()))
+	if err != nil {
+		t.Fatalf("invalid zip: %v", err)
+	}
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(data)
+	}
+
+	for _, name := range []string{"Doe.ged", "Jones.ged", "Smith.ged"} {
+		if !strings.HasPrefix(files[name], "0 HEAD\n") {
+			t.Errorf("archive should contain a GEDCOM file %s; entries: %v", name, zr.File)
+		}
+	}
+
+	// A married-in spouse is exported with the line, but not their parents
+	doe := files["Doe.ged"]
+	if !strings.Contains(doe, "Jane /Doe/") || !strings.Contains(doe, "John /Smith/") {
+		t.Errorf("Doe.ged should hold Jane and her husband:\n%s", doe)
+	}
+	if strings.Contains(doe, "George /Smith/") {
+		t.Errorf("Doe.ged should not hold the husband's father:\n%s", doe)
+	}
+	if !strings.Contains(files["Smith.ged"], "George /Smith/") {
+		t.Error("Smith.ged should hold George Smith")
+	}
+}
+
+func TestExportBySurname_InvalidVersion(t *testing.T) {
+	server := setupExportTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/export/by-surname", strings.NewReader(`{"version":"6.0"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d: %s", rec.Code, http.StatusBadRequest, rec.Body.String())
+	}
+}
@@ -40,7 +40,7 @@ func TestGetPerson_ExternalLinks(t *testing.T) {
 		t.Fatalf("parse person id: %v", err)
 	}
 
-	// Seed external IDs directly in the read model (they are import-only; no create API).
+	// Seed external IDs directly in the read model, as GEDCOM import does.
 	if err := readStore.ReplacePersonExternalIDs(context.Background(), personID, []repository.PersonExternalIDReadModel{
 		{PersonID: personID, Sequence: 0, Value: "KWCJ-QN7", Type: "http://www.familysearch.org/ark"},
 		{PersonID: personID, Sequence: 1, Value: "X99", Type: "http://example.com/unknown-system"},
@@ -194,3 +194,60 @@ func TestGetEntity_ExternalLinks(t *testing.T) {
 		})
 	}
 }
+
+// TestAddPersonExternalID verifies that an identifier added through the API
+// is returned as a resolved link and then appears on the person detail.
+func TestAddPersonExternalID(t *testing.T) {
+	cfg := &config.Config{Port: 8080, LogFormat: "text"}
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	snapshotStore := memory.NewSnapshotStore(eventStore)
+	server := api.NewServer(cfg, eventStore, readStore, snapshotStore, nil)
+
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(`{"given_name":"Ada","surname":"Lovelace"}`))
+	createReq.Header.Set("Content-Type", "application/json")
+	createRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(createRec, createReq)
+	var created map[string]any
+	if err := json.Unmarshal(createRec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("create person: %d: %s", createRec.Code, createRec.Body.String())
+	}
+	personID := created["id"].(string)
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := post("/api/v1/persons/"+personID+"/external-ids", `{"value":"Lovelace-1","type":"https://www.wikitree.com/"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("add external id: %d: %s", rec.Code, rec.Body.String())
+	}
+	var link struct {
+		Label string `json:"label"`
+		URL   string `json:"url"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &link); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if link.Label != "WikiTree" || link.URL != "https://www.wikitree.com/wiki/Lovelace-1" {
+		t.Errorf("link = %+v, want the WikiTree profile", link)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+personID, http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if !strings.Contains(rec.Body.String(), `"value":"Lovelace-1"`) {
+		t.Errorf("person detail should list the new external id: %s", rec.Body.String())
+	}
+
+	if rec := post("/api/v1/persons/"+personID+"/external-ids", `{"value":""}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("empty value: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if rec := post("/api/v1/persons/"+uuid.New().String()+"/external-ids", `{"value":"X1"}`); rec.Code != http.StatusNotFound {
+		t.Errorf("unknown person: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
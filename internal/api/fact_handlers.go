@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// ============================================================================
+// Life event and attribute endpoints
+// ============================================================================
+
+// SetEventResearchStatus implements StrictServerInterface.
+func (ss *StrictServer) SetEventResearchStatus(ctx context.Context, request SetEventResearchStatusRequestObject) (SetEventResearchStatusResponseObject, error) {
+	result, err := ss.server.commandHandler.SetLifeEventResearchStatus(ctx, command.SetResearchStatusInput{
+		ID:             request.Id,
+		ResearchStatus: string(request.Body.ResearchStatus),
+		Version:        request.Body.Version,
+	})
+	if err != nil {
+		if errors.Is(err, command.ErrLifeEventNotFound) {
+			return SetEventResearchStatus404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: "Event not found",
+			}}, nil
+		}
+		if errors.Is(err, repository.ErrConcurrencyConflict) {
+			return SetEventResearchStatus409JSONResponse{ConflictJSONResponse{
+				Code:    "conflict",
+				Message: "Version conflict",
+			}}, nil
+		}
+		if errors.Is(err, command.ErrInvalidInput) {
+			return SetEventResearchStatus400JSONResponse{BadRequestJSONResponse{
+				Code:    "invalid_input",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+
+	return SetEventResearchStatus200JSONResponse{
+		Id:             request.Id,
+		ResearchStatus: request.Body.ResearchStatus,
+		Version:        result.Version,
+	}, nil
+}
+
+// SetAttributeResearchStatus implements StrictServerInterface.
+func (ss *StrictServer) SetAttributeResearchStatus(ctx context.Context, request SetAttributeResearchStatusRequestObject) (SetAttributeResearchStatusResponseObject, error) {
+	result, err := ss.server.commandHandler.SetAttributeResearchStatus(ctx, command.SetResearchStatusInput{
+		ID:             request.Id,
+		ResearchStatus: string(request.Body.ResearchStatus),
+		Version:        request.Body.Version,
+	})
+	if err != nil {
+		if errors.Is(err, command.ErrAttributeNotFound) {
+			return SetAttributeResearchStatus404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: "Attribute not found",
+			}}, nil
+		}
+		if errors.Is(err, repository.ErrConcurrencyConflict) {
+			return SetAttributeResearchStatus409JSONResponse{ConflictJSONResponse{
+				Code:    "conflict",
+				Message: "Version conflict",
+			}}, nil
+		}
+		if errors.Is(err, command.ErrInvalidInput) {
+			return SetAttributeResearchStatus400JSONResponse{BadRequestJSONResponse{
+				Code:    "invalid_input",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+
+	return SetAttributeResearchStatus200JSONResponse{
+		Id:             request.Id,
+		ResearchStatus: request.Body.ResearchStatus,
+		Version:        result.Version,
+	}, nil
+}
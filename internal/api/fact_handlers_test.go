@@ -0,0 +1,146 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/api"
+)
+
+const factTestGedcom = `0 HEAD
+1 GEDC
+2 VERS 5.5
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME John /Doe/
+1 SEX M
+1 RESI
+2 DATE 1880
+2 PLAC Boston, MA
+1 OCCU Blacksmith
+0 TRLR
+`
+
+func importFactTestGedcom(t *testing.T, server *api.Server) {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "test.ged")
+	io.WriteString(part, factTestGedcom)
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/gedcom/import", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Import failed: %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+// firstExportedFact returns the id and version of the first item listed by an
+// export endpoint such as /export/events.
+func firstExportedFact(t *testing.T, server *api.Server, path, key string) (string, int64) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET %s: %d: %s", path, rec.Code, rec.Body.String())
+	}
+
+	var resp map[string][]struct {
+		ID      string `json:"id"`
+		Version int64  `json:"version"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+	if len(resp[key]) == 0 {
+		t.Fatalf("GET %s returned no %s", path, key)
+	}
+	return resp[key][0].ID, resp[key][0].Version
+}
+
+func putResearchStatus(server *api.Server, path, status string, version int64) *httptest.ResponseRecorder {
+	body := fmt.Sprintf(`{"research_status":%q,"version":%d}`, status, version)
+	req := httptest.NewRequest(http.MethodPut, path, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestSetEventResearchStatus(t *testing.T) {
+	server := setupFamilyTestServer(t)
+	importFactTestGedcom(t, server)
+
+	id, version := firstExportedFact(t, server, "/api/v1/export/events", "events")
+	path := "/api/v1/events/" + id + "/research-status"
+
+	rec := putResearchStatus(server, path, "probable", version)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var result map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &result)
+	if result["research_status"] != "probable" {
+		t.Errorf("research_status = %v, want probable", result["research_status"])
+	}
+	if result["version"] != float64(version+1) {
+		t.Errorf("version = %v, want %d", result["version"], version+1)
+	}
+
+	// Stale version
+	if rec := putResearchStatus(server, path, "certain", version); rec.Code != http.StatusConflict {
+		t.Errorf("Stale version status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+
+	// Invalid status
+	if rec := putResearchStatus(server, path, "definite", version+1); rec.Code != http.StatusBadRequest {
+		t.Errorf("Invalid status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestSetEventResearchStatus_NotFound(t *testing.T) {
+	server := setupFamilyTestServer(t)
+
+	rec := putResearchStatus(server, "/api/v1/events/"+uuid.New().String()+"/research-status", "certain", 1)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestSetAttributeResearchStatus(t *testing.T) {
+	server := setupFamilyTestServer(t)
+	importFactTestGedcom(t, server)
+
+	id, version := firstExportedFact(t, server, "/api/v1/export/attributes", "attributes")
+	path := "/api/v1/attributes/" + id + "/research-status"
+
+	rec := putResearchStatus(server, path, "certain", version)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/export/attributes", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	var resp struct {
+		Attributes []map[string]interface{} `json:"attributes"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+	if len(resp.Attributes) == 0 || resp.Attributes[0]["research_status"] != "certain" {
+		t.Errorf("exported attributes = %v, want research_status certain", resp.Attributes)
+	}
+
+	if rec := putResearchStatus(server, "/api/v1/attributes/"+uuid.New().String()+"/research-status", "certain", 1); rec.Code != http.StatusNotFound {
+		t.Errorf("Unknown attribute status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/cacack/my-family/internal/api"
@@ -102,6 +103,34 @@ func TestCreateFamily_NoPartners(t *testing.T) {
 	}
 }
 
+func TestCreateFamily_InvalidRelationshipType(t *testing.T) {
+	server := setupFamilyTestServer(t)
+	person1 := createTestPerson(t, server, "John", "Doe")
+
+	body := map[string]interface{}{
+		"partner1_id":       person1["id"],
+		"relationship_type": "situationship",
+	}
+	jsonBody, _ := json.Marshal(body)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/families", bytes.NewReader(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp api.Error
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Fields == nil || len(*resp.Fields) != 1 || (*resp.Fields)[0].Field != "relationship_type" {
+		t.Errorf("Expected a relationship_type field error, got %s", rec.Body.String())
+	}
+}
+
 func TestListFamilies(t *testing.T) {
 	server := setupFamilyTestServer(t)
 
@@ -166,6 +195,95 @@ func TestListFamilies(t *testing.T) {
 	}
 }
 
+func TestSearchFamilies(t *testing.T) {
+	server := setupFamilyTestServer(t)
+
+	person1 := createTestPerson(t, server, "John", "Doe")
+	person2 := createTestPerson(t, server, "Jane", "Smith")
+
+	jsonBody, _ := json.Marshal(map[string]interface{}{
+		"partner1_id": person1["id"],
+		"partner2_id": person2["id"],
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/families", bytes.NewReader(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create family: status %d: %s", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/families/search?q=doe+smith", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var result struct {
+		Families []struct {
+			Partner1Name string `json:"partner1_name"`
+			Partner2Name string `json:"partner2_name"`
+		} `json:"families"`
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("unmarshal search response: %v", err)
+	}
+	if result.Total != 1 {
+		t.Fatalf("Expected 1 family, got %d", result.Total)
+	}
+	if result.Families[0].Partner1Name != "John Doe" || result.Families[0].Partner2Name != "Jane Smith" {
+		t.Errorf("unexpected partner names: %+v", result.Families[0])
+	}
+
+	// Query too short
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/families/search?q=d", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for short query, got %d", rec.Code)
+	}
+}
+
+func TestSearchFamilies_ConfiguredSearchLimits(t *testing.T) {
+	cfg := &config.Config{SearchMinQueryLength: 1, SearchMaxLimit: 2}
+	eventStore := memory.NewEventStore()
+	server := api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil)
+
+	for _, given := range []string{"伟", "芳", "静"} {
+		person := createTestPerson(t, server, given, "王")
+		jsonBody, _ := json.Marshal(map[string]interface{}{"partner1_id": person["id"]})
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/families", bytes.NewReader(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("create family: status %d: %s", rec.Code, rec.Body.String())
+		}
+	}
+
+	// A single CJK character is a valid query, and the requested limit is
+	// capped at SearchMaxLimit.
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/families/search?q="+url.QueryEscape("王")+"&limit=1000", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var result struct {
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("unmarshal search response: %v", err)
+	}
+	if result.Total != 2 {
+		t.Errorf("Expected 2 families, got %d", result.Total)
+	}
+}
+
 func TestListFamilies_SinglePartner(t *testing.T) {
 	server := setupFamilyTestServer(t)
 
@@ -261,6 +379,129 @@ func TestGetFamily(t *testing.T) {
 	if result["marriage_place"] != "Chicago, IL" {
 		t.Errorf("Expected marriage_place 'Chicago, IL', got %v", result["marriage_place"])
 	}
+	if result["created_at"] == nil || result["updated_at"] == nil {
+		t.Errorf("Expected created_at and updated_at, got %v / %v", result["created_at"], result["updated_at"])
+	}
+}
+
+func TestGetFamily_ETag(t *testing.T) {
+	server := setupFamilyTestServer(t)
+
+	person1 := createTestPerson(t, server, "John", "Doe")
+	body := map[string]interface{}{"partner1_id": person1["id"]}
+	jsonBody, _ := json.Marshal(body)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/families", bytes.NewReader(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	var created map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &created)
+	familyID := created["id"].(string)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/families/"+familyID, http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	etag := rec.Header().Get("ETag")
+	if etag != `"1"` {
+		t.Fatalf("ETag = %q, want %q", etag, `"1"`)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/families/"+familyID, http.NoBody)
+	req.Header.Set("If-None-Match", `"0"`)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("stale ETag: Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/families/"+familyID, http.NoBody)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotModified)
+	}
+}
+
+func TestUpdateFamily_IfMatch(t *testing.T) {
+	server := setupFamilyTestServer(t)
+
+	person1 := createTestPerson(t, server, "John", "Doe")
+	body := map[string]interface{}{"partner1_id": person1["id"]}
+	jsonBody, _ := json.Marshal(body)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/families", bytes.NewReader(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	var created map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &created)
+	familyID := created["id"].(string)
+
+	for _, tc := range []struct {
+		ifMatch string
+		want    int
+	}{
+		{`"1"`, http.StatusOK},
+		{`"1"`, http.StatusPreconditionFailed},
+	} {
+		req = httptest.NewRequest(http.MethodPut, "/api/v1/families/"+familyID, bytes.NewReader([]byte(`{"marriage_place":"Boston"}`)))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", tc.ifMatch)
+		rec = httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != tc.want {
+			t.Errorf("If-Match %s: Status = %d, want %d. Body: %s", tc.ifMatch, rec.Code, tc.want, rec.Body.String())
+		}
+	}
+}
+
+func TestFamily_ResearchStatus(t *testing.T) {
+	server := setupFamilyTestServer(t)
+
+	person1 := createTestPerson(t, server, "John", "Doe")
+	body := map[string]interface{}{"partner1_id": person1["id"], "research_status": "possible"}
+	jsonBody, _ := json.Marshal(body)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/families", bytes.NewReader(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Create status = %d, want %d. Body: %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+
+	var created map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &created)
+	if created["research_status"] != "possible" {
+		t.Errorf("research_status = %v, want possible", created["research_status"])
+	}
+	familyID := created["id"].(string)
+
+	req = httptest.NewRequest(http.MethodPut, "/api/v1/families/"+familyID, bytes.NewReader([]byte(`{"research_status":"certain","version":1}`)))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Update status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var updated map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &updated)
+	if updated["research_status"] != "certain" {
+		t.Errorf("research_status = %v, want certain", updated["research_status"])
+	}
+
+	req = httptest.NewRequest(http.MethodPut, "/api/v1/families/"+familyID, bytes.NewReader([]byte(`{"research_status":"definite","version":2}`)))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Invalid update status = %d, want %d. Body: %s", rec.Code, http.StatusBadRequest, rec.Body.String())
+	}
 }
 
 func TestGetFamily_ChildrenHaveSplitNames(t *testing.T) {
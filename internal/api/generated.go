@@ -40,11 +40,75 @@ func (e AddChildRelationshipType) Valid() bool {
 	}
 }
 
+// Defines values for AdvancedSearchRequestGender.
+const (
+	AdvancedSearchRequestGenderFemale    AdvancedSearchRequestGender = "female"
+	AdvancedSearchRequestGenderMale      AdvancedSearchRequestGender = "male"
+	AdvancedSearchRequestGenderNonbinary AdvancedSearchRequestGender = "nonbinary"
+	AdvancedSearchRequestGenderUnknown   AdvancedSearchRequestGender = "unknown"
+)
+
+// Valid indicates whether the value is a known member of the AdvancedSearchRequestGender enum.
+func (e AdvancedSearchRequestGender) Valid() bool {
+	switch e {
+	case AdvancedSearchRequestGenderFemale:
+		return true
+	case AdvancedSearchRequestGenderMale:
+		return true
+	case AdvancedSearchRequestGenderNonbinary:
+		return true
+	case AdvancedSearchRequestGenderUnknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AdvancedSearchRequestOrder.
+const (
+	AdvancedSearchRequestOrderAsc  AdvancedSearchRequestOrder = "asc"
+	AdvancedSearchRequestOrderDesc AdvancedSearchRequestOrder = "desc"
+)
+
+// Valid indicates whether the value is a known member of the AdvancedSearchRequestOrder enum.
+func (e AdvancedSearchRequestOrder) Valid() bool {
+	switch e {
+	case AdvancedSearchRequestOrderAsc:
+		return true
+	case AdvancedSearchRequestOrderDesc:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AdvancedSearchRequestSort.
+const (
+	AdvancedSearchRequestSortBirthDate AdvancedSearchRequestSort = "birth_date"
+	AdvancedSearchRequestSortDeathDate AdvancedSearchRequestSort = "death_date"
+	AdvancedSearchRequestSortName      AdvancedSearchRequestSort = "name"
+)
+
+// Valid indicates whether the value is a known member of the AdvancedSearchRequestSort enum.
+func (e AdvancedSearchRequestSort) Valid() bool {
+	switch e {
+	case AdvancedSearchRequestSortBirthDate:
+		return true
+	case AdvancedSearchRequestSortDeathDate:
+		return true
+	case AdvancedSearchRequestSortName:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for AhnentafelEntryGender.
 const (
-	AhnentafelEntryGenderFemale  AhnentafelEntryGender = "female"
-	AhnentafelEntryGenderMale    AhnentafelEntryGender = "male"
-	AhnentafelEntryGenderUnknown AhnentafelEntryGender = "unknown"
+	AhnentafelEntryGenderFemale    AhnentafelEntryGender = "female"
+	AhnentafelEntryGenderMale      AhnentafelEntryGender = "male"
+	AhnentafelEntryGenderNonbinary AhnentafelEntryGender = "nonbinary"
+	AhnentafelEntryGenderUnknown   AhnentafelEntryGender = "unknown"
 )
 
 // Valid indicates whether the value is a known member of the AhnentafelEntryGender enum.
@@ -54,6 +118,8 @@ func (e AhnentafelEntryGender) Valid() bool {
 		return true
 	case AhnentafelEntryGenderMale:
 		return true
+	case AhnentafelEntryGenderNonbinary:
+		return true
 	case AhnentafelEntryGenderUnknown:
 		return true
 	default:
@@ -61,6 +127,123 @@ func (e AhnentafelEntryGender) Valid() bool {
 	}
 }
 
+// Defines values for BatchFamilyRelationshipType.
+const (
+	BatchFamilyRelationshipTypeMarriage    BatchFamilyRelationshipType = "marriage"
+	BatchFamilyRelationshipTypePartnership BatchFamilyRelationshipType = "partnership"
+	BatchFamilyRelationshipTypeUnknown     BatchFamilyRelationshipType = "unknown"
+)
+
+// Valid indicates whether the value is a known member of the BatchFamilyRelationshipType enum.
+func (e BatchFamilyRelationshipType) Valid() bool {
+	switch e {
+	case BatchFamilyRelationshipTypeMarriage:
+		return true
+	case BatchFamilyRelationshipTypePartnership:
+		return true
+	case BatchFamilyRelationshipTypeUnknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for BatchOperationOp.
+const (
+	AddName      BatchOperationOp = "add_name"
+	CreateFamily BatchOperationOp = "create_family"
+	CreatePerson BatchOperationOp = "create_person"
+	LinkChild    BatchOperationOp = "link_child"
+)
+
+// Valid indicates whether the value is a known member of the BatchOperationOp enum.
+func (e BatchOperationOp) Valid() bool {
+	switch e {
+	case AddName:
+		return true
+	case CreateFamily:
+		return true
+	case CreatePerson:
+		return true
+	case LinkChild:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for BatchOperationRelationshipType.
+const (
+	BatchOperationRelationshipTypeAdopted    BatchOperationRelationshipType = "adopted"
+	BatchOperationRelationshipTypeBiological BatchOperationRelationshipType = "biological"
+	BatchOperationRelationshipTypeFoster     BatchOperationRelationshipType = "foster"
+)
+
+// Valid indicates whether the value is a known member of the BatchOperationRelationshipType enum.
+func (e BatchOperationRelationshipType) Valid() bool {
+	switch e {
+	case BatchOperationRelationshipTypeAdopted:
+		return true
+	case BatchOperationRelationshipTypeBiological:
+		return true
+	case BatchOperationRelationshipTypeFoster:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for BatchOperationResultStatus.
+const (
+	BatchOperationResultStatusError      BatchOperationResultStatus = "error"
+	BatchOperationResultStatusOk         BatchOperationResultStatus = "ok"
+	BatchOperationResultStatusRolledBack BatchOperationResultStatus = "rolled_back"
+	BatchOperationResultStatusSkipped    BatchOperationResultStatus = "skipped"
+)
+
+// Valid indicates whether the value is a known member of the BatchOperationResultStatus enum.
+func (e BatchOperationResultStatus) Valid() bool {
+	switch e {
+	case BatchOperationResultStatusError:
+		return true
+	case BatchOperationResultStatusOk:
+		return true
+	case BatchOperationResultStatusRolledBack:
+		return true
+	case BatchOperationResultStatusSkipped:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for BulkDeleteResultStatus.
+const (
+	BulkDeleteResultStatusConflict    BulkDeleteResultStatus = "conflict"
+	BulkDeleteResultStatusDeleted     BulkDeleteResultStatus = "deleted"
+	BulkDeleteResultStatusError       BulkDeleteResultStatus = "error"
+	BulkDeleteResultStatusHasFamilies BulkDeleteResultStatus = "has_families"
+	BulkDeleteResultStatusNotFound    BulkDeleteResultStatus = "not_found"
+)
+
+// Valid indicates whether the value is a known member of the BulkDeleteResultStatus enum.
+func (e BulkDeleteResultStatus) Valid() bool {
+	switch e {
+	case BulkDeleteResultStatusConflict:
+		return true
+	case BulkDeleteResultStatusDeleted:
+		return true
+	case BulkDeleteResultStatusError:
+		return true
+	case BulkDeleteResultStatusHasFamilies:
+		return true
+	case BulkDeleteResultStatusNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ChangeEntryAction.
 const (
 	ChangeEntryActionCreated ChangeEntryAction = "created"
@@ -106,6 +289,24 @@ func (e ChangeEntryEntityType) Valid() bool {
 	}
 }
 
+// Defines values for CitationOwnerOwnerType.
+const (
+	CitationOwnerOwnerTypeFamily CitationOwnerOwnerType = "family"
+	CitationOwnerOwnerTypePerson CitationOwnerOwnerType = "person"
+)
+
+// Valid indicates whether the value is a known member of the CitationOwnerOwnerType enum.
+func (e CitationOwnerOwnerType) Valid() bool {
+	switch e {
+	case CitationOwnerOwnerTypeFamily:
+		return true
+	case CitationOwnerOwnerTypePerson:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for CitationValidationIssueLevel.
 const (
 	CitationValidationIssueLevelError   CitationValidationIssueLevel = "error"
@@ -124,6 +325,27 @@ func (e CitationValidationIssueLevel) Valid() bool {
 	}
 }
 
+// Defines values for CitedFactBestQuality.
+const (
+	CitedFactBestQualityAuthored   CitedFactBestQuality = "authored"
+	CitedFactBestQualityDerivative CitedFactBestQuality = "derivative"
+	CitedFactBestQualityOriginal   CitedFactBestQuality = "original"
+)
+
+// Valid indicates whether the value is a known member of the CitedFactBestQuality enum.
+func (e CitedFactBestQuality) Valid() bool {
+	switch e {
+	case CitedFactBestQualityAuthored:
+		return true
+	case CitedFactBestQualityDerivative:
+		return true
+	case CitedFactBestQualityOriginal:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for DiscoverySuggestionType.
 const (
 	BrickWallResolved DiscoverySuggestionType = "brick_wall_resolved"
@@ -217,6 +439,87 @@ func (e EvidenceConflictStatus) Valid() bool {
 	}
 }
 
+// Defines values for ExportBySurnameRequestVersion.
+const (
+	ExportBySurnameRequestVersionN55  ExportBySurnameRequestVersion = "5.5"
+	ExportBySurnameRequestVersionN551 ExportBySurnameRequestVersion = "5.5.1"
+	ExportBySurnameRequestVersionN70  ExportBySurnameRequestVersion = "7.0"
+)
+
+// Valid indicates whether the value is a known member of the ExportBySurnameRequestVersion enum.
+func (e ExportBySurnameRequestVersion) Valid() bool {
+	switch e {
+	case ExportBySurnameRequestVersionN55:
+		return true
+	case ExportBySurnameRequestVersionN551:
+		return true
+	case ExportBySurnameRequestVersionN70:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExportSelectionRequestFormat.
+const (
+	ExportSelectionRequestFormatGedcom ExportSelectionRequestFormat = "gedcom"
+	ExportSelectionRequestFormatJson   ExportSelectionRequestFormat = "json"
+)
+
+// Valid indicates whether the value is a known member of the ExportSelectionRequestFormat enum.
+func (e ExportSelectionRequestFormat) Valid() bool {
+	switch e {
+	case ExportSelectionRequestFormatGedcom:
+		return true
+	case ExportSelectionRequestFormatJson:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExportSelectionRequestVersion.
+const (
+	ExportSelectionRequestVersionN55  ExportSelectionRequestVersion = "5.5"
+	ExportSelectionRequestVersionN551 ExportSelectionRequestVersion = "5.5.1"
+	ExportSelectionRequestVersionN70  ExportSelectionRequestVersion = "7.0"
+)
+
+// Valid indicates whether the value is a known member of the ExportSelectionRequestVersion enum.
+func (e ExportSelectionRequestVersion) Valid() bool {
+	switch e {
+	case ExportSelectionRequestVersionN55:
+		return true
+	case ExportSelectionRequestVersionN551:
+		return true
+	case ExportSelectionRequestVersionN70:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for FactCoverageBestQuality.
+const (
+	FactCoverageBestQualityAuthored   FactCoverageBestQuality = "authored"
+	FactCoverageBestQualityDerivative FactCoverageBestQuality = "derivative"
+	FactCoverageBestQualityOriginal   FactCoverageBestQuality = "original"
+)
+
+// Valid indicates whether the value is a known member of the FactCoverageBestQuality enum.
+func (e FactCoverageBestQuality) Valid() bool {
+	switch e {
+	case FactCoverageBestQualityAuthored:
+		return true
+	case FactCoverageBestQualityDerivative:
+		return true
+	case FactCoverageBestQualityOriginal:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for FamilyRelationshipType.
 const (
 	FamilyRelationshipTypeMarriage    FamilyRelationshipType = "marriage"
@@ -324,37 +627,40 @@ func (e FamilyUpdateRelationshipType) Valid() bool {
 
 // Defines values for GenDateQualifier.
 const (
-	Abt   GenDateQualifier = "abt"
-	Aft   GenDateQualifier = "aft"
-	Bef   GenDateQualifier = "bef"
-	Bet   GenDateQualifier = "bet"
-	Cal   GenDateQualifier = "cal"
-	Est   GenDateQualifier = "est"
-	Exact GenDateQualifier = "exact"
-	From  GenDateQualifier = "from"
-	Int   GenDateQualifier = "int"
+	GenDateQualifierAbt   GenDateQualifier = "abt"
+	GenDateQualifierAft   GenDateQualifier = "aft"
+	GenDateQualifierBef   GenDateQualifier = "bef"
+	GenDateQualifierBet   GenDateQualifier = "bet"
+	GenDateQualifierCal   GenDateQualifier = "cal"
+	GenDateQualifierEst   GenDateQualifier = "est"
+	GenDateQualifierExact GenDateQualifier = "exact"
+	GenDateQualifierFrom  GenDateQualifier = "from"
+	GenDateQualifierInt   GenDateQualifier = "int"
+	GenDateQualifierTo    GenDateQualifier = "to"
 )
 
 // Valid indicates whether the value is a known member of the GenDateQualifier enum.
 func (e GenDateQualifier) Valid() bool {
 	switch e {
-	case Abt:
+	case GenDateQualifierAbt:
 		return true
-	case Aft:
+	case GenDateQualifierAft:
 		return true
-	case Bef:
+	case GenDateQualifierBef:
 		return true
-	case Bet:
+	case GenDateQualifierBet:
 		return true
-	case Cal:
+	case GenDateQualifierCal:
 		return true
-	case Est:
+	case GenDateQualifierEst:
 		return true
-	case Exact:
+	case GenDateQualifierExact:
 		return true
-	case From:
+	case GenDateQualifierFrom:
 		return true
-	case Int:
+	case GenDateQualifierInt:
+		return true
+	case GenDateQualifierTo:
 		return true
 	default:
 		return false
@@ -363,9 +669,10 @@ func (e GenDateQualifier) Valid() bool {
 
 // Defines values for GroupSheetChildGender.
 const (
-	GroupSheetChildGenderFemale  GroupSheetChildGender = "female"
-	GroupSheetChildGenderMale    GroupSheetChildGender = "male"
-	GroupSheetChildGenderUnknown GroupSheetChildGender = "unknown"
+	GroupSheetChildGenderFemale    GroupSheetChildGender = "female"
+	GroupSheetChildGenderMale      GroupSheetChildGender = "male"
+	GroupSheetChildGenderNonbinary GroupSheetChildGender = "nonbinary"
+	GroupSheetChildGenderUnknown   GroupSheetChildGender = "unknown"
 )
 
 // Valid indicates whether the value is a known member of the GroupSheetChildGender enum.
@@ -375,6 +682,8 @@ func (e GroupSheetChildGender) Valid() bool {
 		return true
 	case GroupSheetChildGenderMale:
 		return true
+	case GroupSheetChildGenderNonbinary:
+		return true
 	case GroupSheetChildGenderUnknown:
 		return true
 	default:
@@ -384,19 +693,19 @@ func (e GroupSheetChildGender) Valid() bool {
 
 // Defines values for GroupSheetChildRelationshipType.
 const (
-	Adopted    GroupSheetChildRelationshipType = "adopted"
-	Biological GroupSheetChildRelationshipType = "biological"
-	Foster     GroupSheetChildRelationshipType = "foster"
+	GroupSheetChildRelationshipTypeAdopted    GroupSheetChildRelationshipType = "adopted"
+	GroupSheetChildRelationshipTypeBiological GroupSheetChildRelationshipType = "biological"
+	GroupSheetChildRelationshipTypeFoster     GroupSheetChildRelationshipType = "foster"
 )
 
 // Valid indicates whether the value is a known member of the GroupSheetChildRelationshipType enum.
 func (e GroupSheetChildRelationshipType) Valid() bool {
 	switch e {
-	case Adopted:
+	case GroupSheetChildRelationshipTypeAdopted:
 		return true
-	case Biological:
+	case GroupSheetChildRelationshipTypeBiological:
 		return true
-	case Foster:
+	case GroupSheetChildRelationshipTypeFoster:
 		return true
 	default:
 		return false
@@ -405,9 +714,10 @@ func (e GroupSheetChildRelationshipType) Valid() bool {
 
 // Defines values for GroupSheetPersonGender.
 const (
-	GroupSheetPersonGenderFemale  GroupSheetPersonGender = "female"
-	GroupSheetPersonGenderMale    GroupSheetPersonGender = "male"
-	GroupSheetPersonGenderUnknown GroupSheetPersonGender = "unknown"
+	GroupSheetPersonGenderFemale    GroupSheetPersonGender = "female"
+	GroupSheetPersonGenderMale      GroupSheetPersonGender = "male"
+	GroupSheetPersonGenderNonbinary GroupSheetPersonGender = "nonbinary"
+	GroupSheetPersonGenderUnknown   GroupSheetPersonGender = "unknown"
 )
 
 // Valid indicates whether the value is a known member of the GroupSheetPersonGender enum.
@@ -417,6 +727,8 @@ func (e GroupSheetPersonGender) Valid() bool {
 		return true
 	case GroupSheetPersonGenderMale:
 		return true
+	case GroupSheetPersonGenderNonbinary:
+		return true
 	case GroupSheetPersonGenderUnknown:
 		return true
 	default:
@@ -424,6 +736,42 @@ func (e GroupSheetPersonGender) Valid() bool {
 	}
 }
 
+// Defines values for ImportIssueSeverity.
+const (
+	ImportIssueSeverityError   ImportIssueSeverity = "error"
+	ImportIssueSeverityWarning ImportIssueSeverity = "warning"
+)
+
+// Valid indicates whether the value is a known member of the ImportIssueSeverity enum.
+func (e ImportIssueSeverity) Valid() bool {
+	switch e {
+	case ImportIssueSeverityError:
+		return true
+	case ImportIssueSeverityWarning:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ImportIssueGroupSeverity.
+const (
+	ImportIssueGroupSeverityError   ImportIssueGroupSeverity = "error"
+	ImportIssueGroupSeverityWarning ImportIssueGroupSeverity = "warning"
+)
+
+// Valid indicates whether the value is a known member of the ImportIssueGroupSeverity enum.
+func (e ImportIssueGroupSeverity) Valid() bool {
+	switch e {
+	case ImportIssueGroupSeverityError:
+		return true
+	case ImportIssueGroupSeverityWarning:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for LDSOrdinanceType.
 const (
 	BAPL LDSOrdinanceType = "BAPL"
@@ -562,11 +910,57 @@ func (e MergePersonsRequestFieldResolution) Valid() bool {
 	}
 }
 
+// Defines values for MissingDataItemMissing.
+const (
+	MissingDataItemMissingBirthDate MissingDataItemMissing = "birth_date"
+	MissingDataItemMissingDeathDate MissingDataItemMissing = "death_date"
+	MissingDataItemMissingParents   MissingDataItemMissing = "parents"
+	MissingDataItemMissingSources   MissingDataItemMissing = "sources"
+)
+
+// Valid indicates whether the value is a known member of the MissingDataItemMissing enum.
+func (e MissingDataItemMissing) Valid() bool {
+	switch e {
+	case MissingDataItemMissingBirthDate:
+		return true
+	case MissingDataItemMissingDeathDate:
+		return true
+	case MissingDataItemMissingParents:
+		return true
+	case MissingDataItemMissingSources:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for NoteLinkEntityType.
+const (
+	NoteLinkEntityTypeFamily NoteLinkEntityType = "family"
+	NoteLinkEntityTypePerson NoteLinkEntityType = "person"
+	NoteLinkEntityTypeSource NoteLinkEntityType = "source"
+)
+
+// Valid indicates whether the value is a known member of the NoteLinkEntityType enum.
+func (e NoteLinkEntityType) Valid() bool {
+	switch e {
+	case NoteLinkEntityTypeFamily:
+		return true
+	case NoteLinkEntityTypePerson:
+		return true
+	case NoteLinkEntityTypeSource:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for PersonGender.
 const (
-	PersonGenderFemale  PersonGender = "female"
-	PersonGenderMale    PersonGender = "male"
-	PersonGenderUnknown PersonGender = "unknown"
+	PersonGenderFemale    PersonGender = "female"
+	PersonGenderMale      PersonGender = "male"
+	PersonGenderNonbinary PersonGender = "nonbinary"
+	PersonGenderUnknown   PersonGender = "unknown"
 )
 
 // Valid indicates whether the value is a known member of the PersonGender enum.
@@ -576,6 +970,8 @@ func (e PersonGender) Valid() bool {
 		return true
 	case PersonGenderMale:
 		return true
+	case PersonGenderNonbinary:
+		return true
 	case PersonGenderUnknown:
 		return true
 	default:
@@ -585,9 +981,10 @@ func (e PersonGender) Valid() bool {
 
 // Defines values for PersonCreateGender.
 const (
-	PersonCreateGenderFemale  PersonCreateGender = "female"
-	PersonCreateGenderMale    PersonCreateGender = "male"
-	PersonCreateGenderUnknown PersonCreateGender = "unknown"
+	PersonCreateGenderFemale    PersonCreateGender = "female"
+	PersonCreateGenderMale      PersonCreateGender = "male"
+	PersonCreateGenderNonbinary PersonCreateGender = "nonbinary"
+	PersonCreateGenderUnknown   PersonCreateGender = "unknown"
 )
 
 // Valid indicates whether the value is a known member of the PersonCreateGender enum.
@@ -597,6 +994,8 @@ func (e PersonCreateGender) Valid() bool {
 		return true
 	case PersonCreateGenderMale:
 		return true
+	case PersonCreateGenderNonbinary:
+		return true
 	case PersonCreateGenderUnknown:
 		return true
 	default:
@@ -606,9 +1005,10 @@ func (e PersonCreateGender) Valid() bool {
 
 // Defines values for PersonDetailGender.
 const (
-	PersonDetailGenderFemale  PersonDetailGender = "female"
-	PersonDetailGenderMale    PersonDetailGender = "male"
-	PersonDetailGenderUnknown PersonDetailGender = "unknown"
+	PersonDetailGenderFemale    PersonDetailGender = "female"
+	PersonDetailGenderMale      PersonDetailGender = "male"
+	PersonDetailGenderNonbinary PersonDetailGender = "nonbinary"
+	PersonDetailGenderUnknown   PersonDetailGender = "unknown"
 )
 
 // Valid indicates whether the value is a known member of the PersonDetailGender enum.
@@ -618,6 +1018,8 @@ func (e PersonDetailGender) Valid() bool {
 		return true
 	case PersonDetailGenderMale:
 		return true
+	case PersonDetailGenderNonbinary:
+		return true
 	case PersonDetailGenderUnknown:
 		return true
 	default:
@@ -631,6 +1033,7 @@ const (
 	PersonNameNameTypeBirth        PersonNameNameType = "birth"
 	PersonNameNameTypeImmigrant    PersonNameNameType = "immigrant"
 	PersonNameNameTypeMarried      PersonNameNameType = "married"
+	PersonNameNameTypePatronymic   PersonNameNameType = "patronymic"
 	PersonNameNameTypeProfessional PersonNameNameType = "professional"
 	PersonNameNameTypeReligious    PersonNameNameType = "religious"
 )
@@ -646,6 +1049,8 @@ func (e PersonNameNameType) Valid() bool {
 		return true
 	case PersonNameNameTypeMarried:
 		return true
+	case PersonNameNameTypePatronymic:
+		return true
 	case PersonNameNameTypeProfessional:
 		return true
 	case PersonNameNameTypeReligious:
@@ -661,6 +1066,7 @@ const (
 	PersonNameCreateNameTypeBirth        PersonNameCreateNameType = "birth"
 	PersonNameCreateNameTypeImmigrant    PersonNameCreateNameType = "immigrant"
 	PersonNameCreateNameTypeMarried      PersonNameCreateNameType = "married"
+	PersonNameCreateNameTypePatronymic   PersonNameCreateNameType = "patronymic"
 	PersonNameCreateNameTypeProfessional PersonNameCreateNameType = "professional"
 	PersonNameCreateNameTypeReligious    PersonNameCreateNameType = "religious"
 )
@@ -676,6 +1082,8 @@ func (e PersonNameCreateNameType) Valid() bool {
 		return true
 	case PersonNameCreateNameTypeMarried:
 		return true
+	case PersonNameCreateNameTypePatronymic:
+		return true
 	case PersonNameCreateNameTypeProfessional:
 		return true
 	case PersonNameCreateNameTypeReligious:
@@ -691,6 +1099,7 @@ const (
 	PersonNameUpdateNameTypeBirth        PersonNameUpdateNameType = "birth"
 	PersonNameUpdateNameTypeImmigrant    PersonNameUpdateNameType = "immigrant"
 	PersonNameUpdateNameTypeMarried      PersonNameUpdateNameType = "married"
+	PersonNameUpdateNameTypePatronymic   PersonNameUpdateNameType = "patronymic"
 	PersonNameUpdateNameTypeProfessional PersonNameUpdateNameType = "professional"
 	PersonNameUpdateNameTypeReligious    PersonNameUpdateNameType = "religious"
 )
@@ -706,6 +1115,8 @@ func (e PersonNameUpdateNameType) Valid() bool {
 		return true
 	case PersonNameUpdateNameTypeMarried:
 		return true
+	case PersonNameUpdateNameTypePatronymic:
+		return true
 	case PersonNameUpdateNameTypeProfessional:
 		return true
 	case PersonNameUpdateNameTypeReligious:
@@ -717,9 +1128,10 @@ func (e PersonNameUpdateNameType) Valid() bool {
 
 // Defines values for PersonUpdateGender.
 const (
-	PersonUpdateGenderFemale  PersonUpdateGender = "female"
-	PersonUpdateGenderMale    PersonUpdateGender = "male"
-	PersonUpdateGenderUnknown PersonUpdateGender = "unknown"
+	PersonUpdateGenderFemale    PersonUpdateGender = "female"
+	PersonUpdateGenderMale      PersonUpdateGender = "male"
+	PersonUpdateGenderNonbinary PersonUpdateGender = "nonbinary"
+	PersonUpdateGenderUnknown   PersonUpdateGender = "unknown"
 )
 
 // Valid indicates whether the value is a known member of the PersonUpdateGender enum.
@@ -729,6 +1141,8 @@ func (e PersonUpdateGender) Valid() bool {
 		return true
 	case PersonUpdateGenderMale:
 		return true
+	case PersonUpdateGenderNonbinary:
+		return true
 	case PersonUpdateGenderUnknown:
 		return true
 	default:
@@ -736,6 +1150,30 @@ func (e PersonUpdateGender) Valid() bool {
 	}
 }
 
+// Defines values for ProjectionDiscrepancyIssue.
+const (
+	Failed     ProjectionDiscrepancyIssue = "failed"
+	Mismatch   ProjectionDiscrepancyIssue = "mismatch"
+	Missing    ProjectionDiscrepancyIssue = "missing"
+	Unexpected ProjectionDiscrepancyIssue = "unexpected"
+)
+
+// Valid indicates whether the value is a known member of the ProjectionDiscrepancyIssue enum.
+func (e ProjectionDiscrepancyIssue) Valid() bool {
+	switch e {
+	case Failed:
+		return true
+	case Mismatch:
+		return true
+	case Missing:
+		return true
+	case Unexpected:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ProofSummaryResearchStatus.
 const (
 	ProofSummaryResearchStatusCertain  ProofSummaryResearchStatus = "certain"
@@ -946,39 +1384,162 @@ func (e RollbackResponseEntityType) Valid() bool {
 	}
 }
 
-// Defines values for ValidationIssueSeverity.
+// Defines values for SearchMatchField.
 const (
-	ValidationIssueSeverityError   ValidationIssueSeverity = "error"
-	ValidationIssueSeverityInfo    ValidationIssueSeverity = "info"
-	ValidationIssueSeverityWarning ValidationIssueSeverity = "warning"
+	SearchMatchFieldAlternateName SearchMatchField = "alternate_name"
+	SearchMatchFieldBirthDate     SearchMatchField = "birth_date"
+	SearchMatchFieldBirthPlace    SearchMatchField = "birth_place"
+	SearchMatchFieldDeathDate     SearchMatchField = "death_date"
+	SearchMatchFieldDeathPlace    SearchMatchField = "death_place"
+	SearchMatchFieldGivenName     SearchMatchField = "given_name"
+	SearchMatchFieldSurname       SearchMatchField = "surname"
 )
 
-// Valid indicates whether the value is a known member of the ValidationIssueSeverity enum.
-func (e ValidationIssueSeverity) Valid() bool {
+// Valid indicates whether the value is a known member of the SearchMatchField enum.
+func (e SearchMatchField) Valid() bool {
 	switch e {
-	case ValidationIssueSeverityError:
+	case SearchMatchFieldAlternateName:
 		return true
-	case ValidationIssueSeverityInfo:
+	case SearchMatchFieldBirthDate:
 		return true
-	case ValidationIssueSeverityWarning:
+	case SearchMatchFieldBirthPlace:
+		return true
+	case SearchMatchFieldDeathDate:
+		return true
+	case SearchMatchFieldDeathPlace:
+		return true
+	case SearchMatchFieldGivenName:
+		return true
+	case SearchMatchFieldSurname:
 		return true
 	default:
 		return false
 	}
 }
 
-// Defines values for GetAhnentafelParamsFormat.
+// Defines values for SearchResultMatchedBy.
 const (
-	Json GetAhnentafelParamsFormat = "json"
-	Text GetAhnentafelParamsFormat = "text"
+	SearchResultMatchedByExact     SearchResultMatchedBy = "exact"
+	SearchResultMatchedByFuzzy     SearchResultMatchedBy = "fuzzy"
+	SearchResultMatchedByMetaphone SearchResultMatchedBy = "metaphone"
+	SearchResultMatchedBySoundex   SearchResultMatchedBy = "soundex"
+)
+
+// Valid indicates whether the value is a known member of the SearchResultMatchedBy enum.
+func (e SearchResultMatchedBy) Valid() bool {
+	switch e {
+	case SearchResultMatchedByExact:
+		return true
+	case SearchResultMatchedByFuzzy:
+		return true
+	case SearchResultMatchedByMetaphone:
+		return true
+	case SearchResultMatchedBySoundex:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for TrashItemEntityType.
+const (
+	TrashItemEntityTypeCitation TrashItemEntityType = "citation"
+	TrashItemEntityTypeFamily   TrashItemEntityType = "family"
+	TrashItemEntityTypePerson   TrashItemEntityType = "person"
+	TrashItemEntityTypeSource   TrashItemEntityType = "source"
+)
+
+// Valid indicates whether the value is a known member of the TrashItemEntityType enum.
+func (e TrashItemEntityType) Valid() bool {
+	switch e {
+	case TrashItemEntityTypeCitation:
+		return true
+	case TrashItemEntityTypeFamily:
+		return true
+	case TrashItemEntityTypePerson:
+		return true
+	case TrashItemEntityTypeSource:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ValidationIssueSeverity.
+const (
+	ValidationIssueSeverityError   ValidationIssueSeverity = "error"
+	ValidationIssueSeverityInfo    ValidationIssueSeverity = "info"
+	ValidationIssueSeverityWarning ValidationIssueSeverity = "warning"
+)
+
+// Valid indicates whether the value is a known member of the ValidationIssueSeverity enum.
+func (e ValidationIssueSeverity) Valid() bool {
+	switch e {
+	case ValidationIssueSeverityError:
+		return true
+	case ValidationIssueSeverityInfo:
+		return true
+	case ValidationIssueSeverityWarning:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for EvidenceTypeParam.
+const (
+	EvidenceTypeParamDirect   EvidenceTypeParam = "direct"
+	EvidenceTypeParamIndirect EvidenceTypeParam = "indirect"
+	EvidenceTypeParamNegative EvidenceTypeParam = "negative"
+)
+
+// Valid indicates whether the value is a known member of the EvidenceTypeParam enum.
+func (e EvidenceTypeParam) Valid() bool {
+	switch e {
+	case EvidenceTypeParamDirect:
+		return true
+	case EvidenceTypeParamIndirect:
+		return true
+	case EvidenceTypeParamNegative:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SourceQualityParam.
+const (
+	SourceQualityParamAuthored   SourceQualityParam = "authored"
+	SourceQualityParamDerivative SourceQualityParam = "derivative"
+	SourceQualityParamOriginal   SourceQualityParam = "original"
+)
+
+// Valid indicates whether the value is a known member of the SourceQualityParam enum.
+func (e SourceQualityParam) Valid() bool {
+	switch e {
+	case SourceQualityParamAuthored:
+		return true
+	case SourceQualityParamDerivative:
+		return true
+	case SourceQualityParamOriginal:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetAhnentafelParamsFormat.
+const (
+	GetAhnentafelParamsFormatJson GetAhnentafelParamsFormat = "json"
+	GetAhnentafelParamsFormatText GetAhnentafelParamsFormat = "text"
 )
 
 // Valid indicates whether the value is a known member of the GetAhnentafelParamsFormat enum.
 func (e GetAhnentafelParamsFormat) Valid() bool {
 	switch e {
-	case Json:
+	case GetAhnentafelParamsFormatJson:
 		return true
-	case Text:
+	case GetAhnentafelParamsFormatText:
 		return true
 	default:
 		return false
@@ -1021,6 +1582,21 @@ func (e ListAssociationsParamsOrder) Valid() bool {
 	}
 }
 
+// Defines values for GetFormattedCitationParamsStyle.
+const (
+	EvidenceExplained GetFormattedCitationParamsStyle = "evidence_explained"
+)
+
+// Valid indicates whether the value is a known member of the GetFormattedCitationParamsStyle enum.
+func (e GetFormattedCitationParamsStyle) Valid() bool {
+	switch e {
+	case EvidenceExplained:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ListEvidenceAnalysesParamsSort.
 const (
 	ListEvidenceAnalysesParamsSortCreatedAt ListEvidenceAnalysesParamsSort = "created_at"
@@ -1144,6 +1720,33 @@ func (e ListHistoryParamsEntityType) Valid() bool {
 	}
 }
 
+// Defines values for ListHistoryParamsAction.
+const (
+	Created  ListHistoryParamsAction = "created"
+	Deleted  ListHistoryParamsAction = "deleted"
+	Linked   ListHistoryParamsAction = "linked"
+	Unlinked ListHistoryParamsAction = "unlinked"
+	Updated  ListHistoryParamsAction = "updated"
+)
+
+// Valid indicates whether the value is a known member of the ListHistoryParamsAction enum.
+func (e ListHistoryParamsAction) Valid() bool {
+	switch e {
+	case Created:
+		return true
+	case Deleted:
+		return true
+	case Linked:
+		return true
+	case Unlinked:
+		return true
+	case Updated:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ListLDSOrdinancesParamsSort.
 const (
 	ListLDSOrdinancesParamsSortDate      ListLDSOrdinancesParamsSort = "date"
@@ -1201,6 +1804,24 @@ func (e ListNotesParamsOrder) Valid() bool {
 	}
 }
 
+// Defines values for GetPedigreeParamsFormat.
+const (
+	GetPedigreeParamsFormatJson GetPedigreeParamsFormat = "json"
+	GetPedigreeParamsFormatText GetPedigreeParamsFormat = "text"
+)
+
+// Valid indicates whether the value is a known member of the GetPedigreeParamsFormat enum.
+func (e GetPedigreeParamsFormat) Valid() bool {
+	switch e {
+	case GetPedigreeParamsFormatJson:
+		return true
+	case GetPedigreeParamsFormatText:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ListPersonsParamsSort.
 const (
 	ListPersonsParamsSortBirthDate ListPersonsParamsSort = "birth_date"
@@ -1270,6 +1891,111 @@ func (e ListPersonsParamsResearchStatus) Valid() bool {
 	}
 }
 
+// Defines values for ListPersonsParamsGender.
+const (
+	Female    ListPersonsParamsGender = "female"
+	Male      ListPersonsParamsGender = "male"
+	Nonbinary ListPersonsParamsGender = "nonbinary"
+	Unknown   ListPersonsParamsGender = "unknown"
+)
+
+// Valid indicates whether the value is a known member of the ListPersonsParamsGender enum.
+func (e ListPersonsParamsGender) Valid() bool {
+	switch e {
+	case Female:
+		return true
+	case Male:
+		return true
+	case Nonbinary:
+		return true
+	case Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetCitationsForPersonParamsSourceQuality.
+const (
+	GetCitationsForPersonParamsSourceQualityAuthored   GetCitationsForPersonParamsSourceQuality = "authored"
+	GetCitationsForPersonParamsSourceQualityDerivative GetCitationsForPersonParamsSourceQuality = "derivative"
+	GetCitationsForPersonParamsSourceQualityOriginal   GetCitationsForPersonParamsSourceQuality = "original"
+)
+
+// Valid indicates whether the value is a known member of the GetCitationsForPersonParamsSourceQuality enum.
+func (e GetCitationsForPersonParamsSourceQuality) Valid() bool {
+	switch e {
+	case GetCitationsForPersonParamsSourceQualityAuthored:
+		return true
+	case GetCitationsForPersonParamsSourceQualityDerivative:
+		return true
+	case GetCitationsForPersonParamsSourceQualityOriginal:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetCitationsForPersonParamsEvidenceType.
+const (
+	GetCitationsForPersonParamsEvidenceTypeDirect   GetCitationsForPersonParamsEvidenceType = "direct"
+	GetCitationsForPersonParamsEvidenceTypeIndirect GetCitationsForPersonParamsEvidenceType = "indirect"
+	GetCitationsForPersonParamsEvidenceTypeNegative GetCitationsForPersonParamsEvidenceType = "negative"
+)
+
+// Valid indicates whether the value is a known member of the GetCitationsForPersonParamsEvidenceType enum.
+func (e GetCitationsForPersonParamsEvidenceType) Valid() bool {
+	switch e {
+	case GetCitationsForPersonParamsEvidenceTypeDirect:
+		return true
+	case GetCitationsForPersonParamsEvidenceTypeIndirect:
+		return true
+	case GetCitationsForPersonParamsEvidenceTypeNegative:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExportPersonGedcomParamsMode.
+const (
+	Ancestors   ExportPersonGedcomParamsMode = "ancestors"
+	Descendants ExportPersonGedcomParamsMode = "descendants"
+)
+
+// Valid indicates whether the value is a known member of the ExportPersonGedcomParamsMode enum.
+func (e ExportPersonGedcomParamsMode) Valid() bool {
+	switch e {
+	case Ancestors:
+		return true
+	case Descendants:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExportPersonGedcomParamsVersion.
+const (
+	N55  ExportPersonGedcomParamsVersion = "5.5"
+	N551 ExportPersonGedcomParamsVersion = "5.5.1"
+	N70  ExportPersonGedcomParamsVersion = "7.0"
+)
+
+// Valid indicates whether the value is a known member of the ExportPersonGedcomParamsVersion enum.
+func (e ExportPersonGedcomParamsVersion) Valid() bool {
+	switch e {
+	case N55:
+		return true
+	case N551:
+		return true
+	case N70:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for UploadPersonMediaMultipartBodyMediaType.
 const (
 	Audio       UploadPersonMediaMultipartBodyMediaType = "audio"
@@ -1336,6 +2062,27 @@ func (e ListProofSummariesParamsOrder) Valid() bool {
 	}
 }
 
+// Defines values for GetQualityReportParamsFormat.
+const (
+	GetQualityReportParamsFormatCsv  GetQualityReportParamsFormat = "csv"
+	GetQualityReportParamsFormatJson GetQualityReportParamsFormat = "json"
+	GetQualityReportParamsFormatPdf  GetQualityReportParamsFormat = "pdf"
+)
+
+// Valid indicates whether the value is a known member of the GetQualityReportParamsFormat enum.
+func (e GetQualityReportParamsFormat) Valid() bool {
+	switch e {
+	case GetQualityReportParamsFormatCsv:
+		return true
+	case GetQualityReportParamsFormatJson:
+		return true
+	case GetQualityReportParamsFormatPdf:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for GetValidationIssuesParamsSeverity.
 const (
 	GetValidationIssuesParamsSeverityError   GetValidationIssuesParamsSeverity = "error"
@@ -1432,6 +2179,30 @@ func (e ListResearchLogsParamsOrder) Valid() bool {
 	}
 }
 
+// Defines values for SearchPersonsParamsAlgorithm.
+const (
+	Exact     SearchPersonsParamsAlgorithm = "exact"
+	Fuzzy     SearchPersonsParamsAlgorithm = "fuzzy"
+	Metaphone SearchPersonsParamsAlgorithm = "metaphone"
+	Soundex   SearchPersonsParamsAlgorithm = "soundex"
+)
+
+// Valid indicates whether the value is a known member of the SearchPersonsParamsAlgorithm enum.
+func (e SearchPersonsParamsAlgorithm) Valid() bool {
+	switch e {
+	case Exact:
+		return true
+	case Fuzzy:
+		return true
+	case Metaphone:
+		return true
+	case Soundex:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for SearchPersonsParamsSort.
 const (
 	SearchPersonsParamsSortBirthDate SearchPersonsParamsSort = "birth_date"
@@ -1476,22 +2247,25 @@ func (e SearchPersonsParamsOrder) Valid() bool {
 
 // Defines values for ListSourcesParamsSort.
 const (
-	ListSourcesParamsSortCreatedAt  ListSourcesParamsSort = "created_at"
-	ListSourcesParamsSortSourceType ListSourcesParamsSort = "source_type"
-	ListSourcesParamsSortTitle      ListSourcesParamsSort = "title"
-	ListSourcesParamsSortUpdatedAt  ListSourcesParamsSort = "updated_at"
+	CitationCount ListSourcesParamsSort = "citation_count"
+	CreatedAt     ListSourcesParamsSort = "created_at"
+	SourceType    ListSourcesParamsSort = "source_type"
+	Title         ListSourcesParamsSort = "title"
+	UpdatedAt     ListSourcesParamsSort = "updated_at"
 )
 
 // Valid indicates whether the value is a known member of the ListSourcesParamsSort enum.
 func (e ListSourcesParamsSort) Valid() bool {
 	switch e {
-	case ListSourcesParamsSortCreatedAt:
+	case CitationCount:
+		return true
+	case CreatedAt:
 		return true
-	case ListSourcesParamsSortSourceType:
+	case SourceType:
 		return true
-	case ListSourcesParamsSortTitle:
+	case Title:
 		return true
-	case ListSourcesParamsSortUpdatedAt:
+	case UpdatedAt:
 		return true
 	default:
 		return false
@@ -1516,6 +2290,48 @@ func (e ListSourcesParamsOrder) Valid() bool {
 	}
 }
 
+// Defines values for GetCitationsForSourceParamsSourceQuality.
+const (
+	Authored   GetCitationsForSourceParamsSourceQuality = "authored"
+	Derivative GetCitationsForSourceParamsSourceQuality = "derivative"
+	Original   GetCitationsForSourceParamsSourceQuality = "original"
+)
+
+// Valid indicates whether the value is a known member of the GetCitationsForSourceParamsSourceQuality enum.
+func (e GetCitationsForSourceParamsSourceQuality) Valid() bool {
+	switch e {
+	case Authored:
+		return true
+	case Derivative:
+		return true
+	case Original:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetCitationsForSourceParamsEvidenceType.
+const (
+	Direct   GetCitationsForSourceParamsEvidenceType = "direct"
+	Indirect GetCitationsForSourceParamsEvidenceType = "indirect"
+	Negative GetCitationsForSourceParamsEvidenceType = "negative"
+)
+
+// Valid indicates whether the value is a known member of the GetCitationsForSourceParamsEvidenceType enum.
+func (e GetCitationsForSourceParamsEvidenceType) Valid() bool {
+	switch e {
+	case Direct:
+		return true
+	case Indirect:
+		return true
+	case Negative:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ListSubmittersParamsSort.
 const (
 	ListSubmittersParamsSortName      ListSubmittersParamsSort = "name"
@@ -1536,16 +2352,16 @@ func (e ListSubmittersParamsSort) Valid() bool {
 
 // Defines values for ListSubmittersParamsOrder.
 const (
-	Asc  ListSubmittersParamsOrder = "asc"
-	Desc ListSubmittersParamsOrder = "desc"
+	ListSubmittersParamsOrderAsc  ListSubmittersParamsOrder = "asc"
+	ListSubmittersParamsOrderDesc ListSubmittersParamsOrder = "desc"
 )
 
 // Valid indicates whether the value is a known member of the ListSubmittersParamsOrder enum.
 func (e ListSubmittersParamsOrder) Valid() bool {
 	switch e {
-	case Asc:
+	case ListSubmittersParamsOrderAsc:
 		return true
-	case Desc:
+	case ListSubmittersParamsOrderDesc:
 		return true
 	default:
 		return false
@@ -1598,6 +2414,56 @@ type Address struct {
 	Website *string `json:"website,omitempty"`
 }
 
+// AdvancedSearchRequest defines model for AdvancedSearchRequest.
+type AdvancedSearchRequest struct {
+	BirthPlace *string `json:"birth_place,omitempty"`
+
+	// BirthYearFrom Born in or after this year
+	BirthYearFrom *int `json:"birth_year_from,omitempty"`
+
+	// BirthYearTo Born in or before this year
+	BirthYearTo *int `json:"birth_year_to,omitempty"`
+
+	// DeathYearFrom Died in or after this year
+	DeathYearFrom *int `json:"death_year_from,omitempty"`
+
+	// DeathYearTo Died in or before this year
+	DeathYearTo *int                         `json:"death_year_to,omitempty"`
+	Gender      *AdvancedSearchRequestGender `json:"gender,omitempty"`
+
+	// GivenName Must have at least SEARCH_MIN_QUERY_LENGTH characters (default 2) when given
+	GivenName *string `json:"given_name,omitempty"`
+
+	// Limit Maximum results to return (default 20), capped at SEARCH_MAX_LIMIT (default 100)
+	Limit *int                        `json:"limit,omitempty"`
+	Order *AdvancedSearchRequestOrder `json:"order,omitempty"`
+	Sort  *AdvancedSearchRequestSort  `json:"sort,omitempty"`
+
+	// Surname Must have at least SEARCH_MIN_QUERY_LENGTH characters (default 2) when given
+	Surname *string `json:"surname,omitempty"`
+}
+
+// AdvancedSearchRequestGender defines model for AdvancedSearchRequest.Gender.
+type AdvancedSearchRequestGender string
+
+// AdvancedSearchRequestOrder defines model for AdvancedSearchRequest.Order.
+type AdvancedSearchRequestOrder string
+
+// AdvancedSearchRequestSort defines model for AdvancedSearchRequest.Sort.
+type AdvancedSearchRequestSort string
+
+// AgeSummary defines model for AgeSummary.
+type AgeSummary struct {
+	// AverageAge Average age in years (omitted when person_count is 0)
+	AverageAge *float32 `json:"average_age,omitempty"`
+
+	// MedianAge Median age in years (omitted when person_count is 0)
+	MedianAge *float32 `json:"median_age,omitempty"`
+
+	// PersonCount Number of persons with a plausible age
+	PersonCount int `json:"person_count"`
+}
+
 // AhnentafelEntry A single entry in the Ahnentafel report
 type AhnentafelEntry struct {
 	// BirthDate Genealogical date with flexible precision
@@ -1626,6 +2492,9 @@ type AhnentafelEntry struct {
 	// Relationship Human-readable relationship to subject
 	Relationship string `json:"relationship"`
 
+	// Sources Citation counts for a person's vital facts; zero marks an unsourced fact
+	Sources *FactSourceCounts `json:"sources,omitempty"`
+
 	// Surname Surname (omitted if ancestor is unknown)
 	Surname *string `json:"surname,omitempty"`
 }
@@ -1755,8 +2624,11 @@ type AttributeExport struct {
 	Id       openapi_types.UUID `json:"id"`
 	PersonId openapi_types.UUID `json:"person_id"`
 	Place    *string            `json:"place,omitempty"`
-	Value    string             `json:"value"`
-	Version  *int64             `json:"version,omitempty"`
+
+	// ResearchStatus Confidence level of genealogical data per GPS standards
+	ResearchStatus *ResearchStatus `json:"research_status,omitempty"`
+	Value          string          `json:"value"`
+	Version        *int64          `json:"version,omitempty"`
 }
 
 // AttributesExportResponse defines model for AttributesExportResponse.
@@ -1801,6 +2673,16 @@ type BatchDismissResult struct {
 	Success bool `json:"success"`
 }
 
+// BatchFamily Family fields for create_family; partners are given on the operation
+type BatchFamily struct {
+	MarriageDate     *string                      `json:"marriage_date,omitempty"`
+	MarriagePlace    *string                      `json:"marriage_place,omitempty"`
+	RelationshipType *BatchFamilyRelationshipType `json:"relationship_type,omitempty"`
+}
+
+// BatchFamilyRelationshipType defines model for BatchFamily.RelationshipType.
+type BatchFamilyRelationshipType string
+
 // BatchMergeRequest Request to merge multiple duplicate pairs
 type BatchMergeRequest struct {
 	// Merges List of merge operations to perform
@@ -1840,22 +2722,153 @@ type BatchMergeResult struct {
 	SurvivorId openapi_types.UUID `json:"survivor_id"`
 }
 
-// BrickWallEntry defines model for BrickWallEntry.
-type BrickWallEntry struct {
-	Note       string             `json:"note"`
-	PersonId   openapi_types.UUID `json:"person_id"`
-	PersonName string             `json:"person_name"`
-	ResolvedAt *time.Time         `json:"resolved_at,omitempty"`
-	Since      time.Time          `json:"since"`
-}
+// BatchOperation defines model for BatchOperation.
+type BatchOperation struct {
+	// Alias Name later operations use to reference the created entity (as "$alias")
+	Alias *string `json:"alias,omitempty"`
 
-// BrickWallsResponse defines model for BrickWallsResponse.
-type BrickWallsResponse struct {
+	// ChildId link_child: child UUID or $alias
+	ChildId *string `json:"child_id,omitempty"`
+
+	// Family Family fields for create_family; partners are given on the operation
+	Family *BatchFamily `json:"family,omitempty"`
+
+	// FamilyId link_child: family UUID or $alias
+	FamilyId *string           `json:"family_id,omitempty"`
+	Name     *PersonNameCreate `json:"name,omitempty"`
+	Op       BatchOperationOp  `json:"op"`
+
+	// Partner1Id create_family: partner UUID or $alias
+	Partner1Id *string `json:"partner1_id,omitempty"`
+
+	// Partner2Id create_family: partner UUID or $alias
+	Partner2Id *string `json:"partner2_id,omitempty"`
+
+	// Person Either given_name or full_name is required
+	Person *PersonCreate `json:"person,omitempty"`
+
+	// PersonId add_name: person UUID or $alias
+	PersonId *string `json:"person_id,omitempty"`
+
+	// RelationshipType link_child: the child's relationship to the family
+	RelationshipType *BatchOperationRelationshipType `json:"relationship_type,omitempty"`
+}
+
+// BatchOperationOp defines model for BatchOperation.Op.
+type BatchOperationOp string
+
+// BatchOperationRelationshipType link_child: the child's relationship to the family
+type BatchOperationRelationshipType string
+
+// BatchOperationResult defines model for BatchOperationResult.
+type BatchOperationResult struct {
+	Alias *string `json:"alias,omitempty"`
+
+	// Error Why the operation failed, or why its rollback failed
+	Error *string `json:"error,omitempty"`
+
+	// Id Created person, name, or family; the family for link_child
+	Id     *openapi_types.UUID        `json:"id,omitempty"`
+	Index  int                        `json:"index"`
+	Op     string                     `json:"op"`
+	Status BatchOperationResultStatus `json:"status"`
+}
+
+// BatchOperationResultStatus defines model for BatchOperationResult.Status.
+type BatchOperationResultStatus string
+
+// BatchRequest defines model for BatchRequest.
+type BatchRequest struct {
+	Operations []BatchOperation `json:"operations"`
+
+	// StopOnError Roll back the applied operations and skip the rest after the first failure
+	StopOnError *bool `json:"stop_on_error,omitempty"`
+}
+
+// BatchResult defines model for BatchResult.
+type BatchResult struct {
+	Failed     int                    `json:"failed"`
+	Results    []BatchOperationResult `json:"results"`
+	RolledBack int                    `json:"rolled_back"`
+	Skipped    int                    `json:"skipped"`
+	Succeeded  int                    `json:"succeeded"`
+}
+
+// BirthDecadeEntry defines model for BirthDecadeEntry.
+type BirthDecadeEntry struct {
+	// Count Number of persons born in the decade
+	Count int `json:"count"`
+
+	// Decade Decade label (e.g. "1850s"), or "unknown" for undated persons
+	Decade string `json:"decade"`
+}
+
+// BirthDecadeIndexResponse defines model for BirthDecadeIndexResponse.
+type BirthDecadeIndexResponse struct {
+	Items []BirthDecadeEntry `json:"items"`
+
+	// Total Total number of decades, including the unknown bucket
+	Total int `json:"total"`
+}
+
+// BrickWallEntry defines model for BrickWallEntry.
+type BrickWallEntry struct {
+	Note       string             `json:"note"`
+	PersonId   openapi_types.UUID `json:"person_id"`
+	PersonName string             `json:"person_name"`
+	ResolvedAt *time.Time         `json:"resolved_at,omitempty"`
+	Since      time.Time          `json:"since"`
+}
+
+// BrickWallsResponse defines model for BrickWallsResponse.
+type BrickWallsResponse struct {
 	ActiveCount   int              `json:"active_count"`
 	Items         []BrickWallEntry `json:"items"`
 	ResolvedCount int              `json:"resolved_count"`
 }
 
+// BulkDeleteRequest Request to delete multiple persons
+type BulkDeleteRequest struct {
+	// Deletions Persons to delete, each with the version last read
+	Deletions []VersionedRef `json:"deletions"`
+
+	// Reason Reason recorded on each deletion
+	Reason *string `json:"reason,omitempty"`
+}
+
+// BulkDeleteResponse Results of bulk delete operation
+type BulkDeleteResponse struct {
+	// Failed Number of deletions that failed
+	Failed int `json:"failed"`
+
+	// Results Individual results in request order
+	Results []BulkDeleteResult `json:"results"`
+
+	// Successful Number of persons deleted
+	Successful int `json:"successful"`
+
+	// Total Total number of deletions attempted
+	Total int `json:"total"`
+}
+
+// BulkDeleteResult Result of a single deletion in a bulk delete
+type BulkDeleteResult struct {
+	// Error Error message if the deletion failed
+	Error *string            `json:"error,omitempty"`
+	Id    openapi_types.UUID `json:"id"`
+
+	// Status Outcome: deleted; conflict when the version is stale; not_found;
+	// has_families when the person is still linked to a family; error otherwise
+	Status BulkDeleteResultStatus `json:"status"`
+
+	// Success Whether the person was deleted
+	Success bool `json:"success"`
+}
+
+// BulkDeleteResultStatus Outcome: deleted; conflict when the version is stale; not_found;
+// has_families when the person is still linked to a family; error otherwise
+type BulkDeleteResultStatus string
+
 // CemeteryEntry defines model for CemeteryEntry.
 type CemeteryEntry struct {
 	// Count Number of persons buried/cremated here
@@ -1873,6 +2886,16 @@ type CemeteryIndexResponse struct {
 	Total int `json:"total"`
 }
 
+// CenturyLifespan defines model for CenturyLifespan.
+type CenturyLifespan struct {
+	AverageAge float32 `json:"average_age"`
+
+	// Century Birth century (e.g. "1800s")
+	Century     string  `json:"century"`
+	MedianAge   float32 `json:"median_age"`
+	PersonCount int     `json:"person_count"`
+}
+
 // ChangeEntry defines model for ChangeEntry.
 type ChangeEntry struct {
 	Action ChangeEntryAction `json:"action"`
@@ -1885,9 +2908,12 @@ type ChangeEntry struct {
 	EntityName *string               `json:"entity_name,omitempty"`
 	EntityType ChangeEntryEntityType `json:"entity_type"`
 	Id         openapi_types.UUID    `json:"id"`
-	Timestamp  time.Time             `json:"timestamp"`
 
-	// UserId ID of user who made the change (null if single-user)
+	// Summary Human-readable description of the change (e.g., "updated birth_place, notes")
+	Summary   *string   `json:"summary,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// UserId Who made the change, from the X-Actor header (omitted if unattributed)
 	UserId *string `json:"user_id,omitempty"`
 }
 
@@ -1977,9 +3003,42 @@ type CitationCreate struct {
 // CitationList defines model for CitationList.
 type CitationList struct {
 	Citations []Citation `json:"citations"`
+	Limit     *int       `json:"limit,omitempty"`
+	Offset    *int       `json:"offset,omitempty"`
 	Total     int        `json:"total"`
 }
 
+// CitationOwner defines model for CitationOwner.
+type CitationOwner struct {
+	Facts   []CitedFact        `json:"facts"`
+	OwnerId openapi_types.UUID `json:"owner_id"`
+
+	// OwnerName Person's name or the family's partner names; absent if the owner no longer exists
+	OwnerName *string                `json:"owner_name,omitempty"`
+	OwnerType CitationOwnerOwnerType `json:"owner_type"`
+}
+
+// CitationOwnerOwnerType defines model for CitationOwner.OwnerType.
+type CitationOwnerOwnerType string
+
+// CitationReference defines model for CitationReference.
+type CitationReference struct {
+	// Bibliography Source list entry
+	Bibliography string `json:"bibliography"`
+
+	// Footnote First reference note
+	Footnote string `json:"footnote"`
+
+	// ShortNote Subsequent reference note
+	ShortNote string `json:"short_note"`
+
+	// Style Citation style used
+	Style string `json:"style"`
+
+	// ValidationIssues Template field warnings or errors, after source fields are applied
+	ValidationIssues *[]CitationValidationIssue `json:"validation_issues,omitempty"`
+}
+
 // CitationTemplate defines model for CitationTemplate.
 type CitationTemplate struct {
 	// Category Template category (e.g., Census Records)
@@ -2049,6 +3108,18 @@ type CitationValidationIssue struct {
 // CitationValidationIssueLevel defines model for CitationValidationIssue.Level.
 type CitationValidationIssueLevel string
 
+// CitedFact defines model for CitedFact.
+type CitedFact struct {
+	// BestQuality Highest source quality among the fact's citations
+	BestQuality   *CitedFactBestQuality `json:"best_quality,omitempty"`
+	CitationCount int                   `json:"citation_count"`
+	CitationIds   []openapi_types.UUID  `json:"citation_ids"`
+	FactType      string                `json:"fact_type"`
+}
+
+// CitedFactBestQuality Highest source quality among the fact's citations
+type CitedFactBestQuality string
+
 // DataLossItem defines model for DataLossItem.
 type DataLossItem struct {
 	// AffectedRecords Ephemeral GEDCOM XREFs (e.g. "@I1@") of the records affected by this loss, assigned during export from record order. They are for display and debugging only and do NOT resolve back to entity IDs in this API.
@@ -2070,8 +3141,19 @@ type DateRange struct {
 	LatestBirth *string `json:"latest_birth,omitempty"`
 }
 
+// DecadeCount defines model for DecadeCount.
+type DecadeCount struct {
+	Count int `json:"count"`
+
+	// Decade First year of the decade (e.g. 1850)
+	Decade int `json:"decade"`
+}
+
 // Descendancy Descendancy tree showing descendants of a person
 type Descendancy struct {
+	// GenerationCounts Number of descendants at each generation; the first element counts children (generation 1)
+	GenerationCounts *[]int `json:"generation_counts,omitempty"`
+
 	// Generations Number of generations included
 	Generations *int `json:"generations,omitempty"`
 
@@ -2093,7 +3175,12 @@ type DescendancyNode struct {
 
 	// DeathDate Genealogical date with flexible precision
 	DeathDate *GenDate `json:"death_date,omitempty"`
-	Gender    *string  `json:"gender,omitempty"`
+
+	// FamilyId Family of the parent node this person descends through. Matches the
+	// family_id of one of the parent's spouses, so children can be grouped
+	// per spouse. Omitted for the root.
+	FamilyId *openapi_types.UUID `json:"family_id,omitempty"`
+	Gender   *string             `json:"gender,omitempty"`
 
 	// Generation Generation level (0 = root, 1 = children, 2 = grandchildren, etc.)
 	Generation *int               `json:"generation,omitempty"`
@@ -2173,14 +3260,32 @@ type DuplicatesResponse struct {
 	Total int `json:"total"`
 }
 
+// EndOfLinesResponse Ancestors in a person's pedigree with no recorded parents
+type EndOfLinesResponse struct {
+	// Entries End-of-line ancestors in Ahnentafel number order
+	Entries []AhnentafelEntry `json:"entries"`
+
+	// Subject The subject (root person) of the Ahnentafel report
+	Subject AhnentafelSubject `json:"subject"`
+
+	// Total Number of end-of-line ancestors
+	Total int `json:"total"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code Error code
 	Code    string                  `json:"code"`
 	Details *map[string]interface{} `json:"details,omitempty"`
 
+	// Fields Per-field validation failures, present when the request failed validation
+	Fields *[]FieldError `json:"fields,omitempty"`
+
 	// Message Human-readable error message
 	Message string `json:"message"`
+
+	// RequestId Identifier of the request, also sent in the X-Request-ID response header. Quote it when reporting a problem so the matching server log lines can be found.
+	RequestId *string `json:"request_id,omitempty"`
 }
 
 // EventExport Life event data for export
@@ -2314,6 +3419,15 @@ type EvidenceConflictResolve struct {
 	Version    int64  `json:"version"`
 }
 
+// ExportBySurnameRequest Options for a per-surname export
+type ExportBySurnameRequest struct {
+	// Version GEDCOM version to emit. When omitted, each file defaults to 5.5 and is automatically upgraded to 7.0 if its data uses 7.0-only features.
+	Version *ExportBySurnameRequestVersion `json:"version,omitempty"`
+}
+
+// ExportBySurnameRequestVersion GEDCOM version to emit. When omitted, each file defaults to 5.5 and is automatically upgraded to 7.0 if its data uses 7.0-only features.
+type ExportBySurnameRequestVersion string
+
 // ExportEstimate defines model for ExportEstimate.
 type ExportEstimate struct {
 	// CitationCount Estimated number of citations (embedded in facts)
@@ -2359,6 +3473,33 @@ type ExportPreview struct {
 	TargetVersion string `json:"targetVersion"`
 }
 
+// ExportSelectionRequest Persons to include in a selection export
+type ExportSelectionRequest struct {
+	// Format Output format
+	Format *ExportSelectionRequestFormat `json:"format,omitempty"`
+
+	// PersonIds Persons to export
+	PersonIds []openapi_types.UUID `json:"person_ids"`
+
+	// Version GEDCOM version to emit. Ignored for JSON. When omitted, defaults to 5.5 and is automatically upgraded to 7.0 if the data uses 7.0-only features.
+	Version *ExportSelectionRequestVersion `json:"version,omitempty"`
+}
+
+// ExportSelectionRequestFormat Output format
+type ExportSelectionRequestFormat string
+
+// ExportSelectionRequestVersion GEDCOM version to emit. Ignored for JSON. When omitted, defaults to 5.5 and is automatically upgraded to 7.0 if the data uses 7.0-only features.
+type ExportSelectionRequestVersion string
+
+// ExternalIdCreate defines model for ExternalIdCreate.
+type ExternalIdCreate struct {
+	// Type URI naming the external system, e.g. http://www.familysearch.org/ark, https://www.wikitree.com/, or https://www.ancestry.com/.
+	Type *string `json:"type,omitempty"`
+
+	// Value The identifier within the external system.
+	Value string `json:"value"`
+}
+
 // ExternalLink A GEDCOM 7.0 external identifier (EXID) with a resolved display label and, for recognized systems, a browsable URL.
 type ExternalLink struct {
 	// Label Human-readable system name, the raw type URI when unrecognized, or a generic "External ID" when the source record omitted the type. Never empty.
@@ -2374,9 +3515,61 @@ type ExternalLink struct {
 	Value string `json:"value"`
 }
 
+// FactCoverage defines model for FactCoverage.
+type FactCoverage struct {
+	// BestQuality Highest source quality among the fact's citations
+	BestQuality   *FactCoverageBestQuality `json:"best_quality,omitempty"`
+	CitationCount int                      `json:"citation_count"`
+	Date          *string                  `json:"date,omitempty"`
+
+	// EventId Life event ID, for event facts
+	EventId *openapi_types.UUID `json:"event_id,omitempty"`
+
+	// FactType Fact type (person_name, person_birth, person_death, or an event type)
+	FactType string  `json:"fact_type"`
+	Place    *string `json:"place,omitempty"`
+
+	// Value Fact value, e.g. the name or an event description
+	Value *string `json:"value,omitempty"`
+}
+
+// FactCoverageBestQuality Highest source quality among the fact's citations
+type FactCoverageBestQuality string
+
+// FactSourceCounts Citation counts for a person's vital facts; zero marks an unsourced fact
+type FactSourceCounts struct {
+	// Birth Citations supporting the birth
+	Birth int `json:"birth"`
+
+	// Death Citations supporting the death
+	Death int `json:"death"`
+}
+
+// FactTypeEntry defines model for FactTypeEntry.
+type FactTypeEntry struct {
+	AttributeCount int `json:"attribute_count"`
+	CitationCount  int `json:"citation_count"`
+
+	// Count Total uses across events, attributes, and citations
+	Count      int    `json:"count"`
+	EventCount int    `json:"event_count"`
+	FactType   string `json:"fact_type"`
+
+	// Known Whether the application defines this fact type
+	Known bool `json:"known"`
+}
+
+// FactTypeIndexResponse defines model for FactTypeIndexResponse.
+type FactTypeIndexResponse struct {
+	Items []FactTypeEntry `json:"items"`
+	Total int             `json:"total"`
+}
+
 // Family defines model for Family.
 type Family struct {
-	Id openapi_types.UUID `json:"id"`
+	// CreatedAt When the family was first recorded. Omitted for records projected before creation times were tracked.
+	CreatedAt *time.Time         `json:"created_at,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
 
 	// MarriageDate Genealogical date with flexible precision
 	MarriageDate  *GenDate `json:"marriage_date,omitempty"`
@@ -2390,7 +3583,13 @@ type Family struct {
 	Partner1Id             *openapi_types.UUID     `json:"partner1_id,omitempty"`
 	Partner2Id             *openapi_types.UUID     `json:"partner2_id,omitempty"`
 	RelationshipType       *FamilyRelationshipType `json:"relationship_type,omitempty"`
-	Version                int64                   `json:"version"`
+
+	// ResearchStatus Confidence level of genealogical data per GPS standards
+	ResearchStatus *ResearchStatus `json:"research_status,omitempty"`
+
+	// UpdatedAt When the family was last changed
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Version   int64      `json:"version"`
 }
 
 // FamilyRelationshipType defines model for Family.RelationshipType.
@@ -2414,6 +3613,9 @@ type FamilyCreate struct {
 	Partner1Id       *openapi_types.UUID           `json:"partner1_id,omitempty"`
 	Partner2Id       *openapi_types.UUID           `json:"partner2_id,omitempty"`
 	RelationshipType *FamilyCreateRelationshipType `json:"relationship_type,omitempty"`
+
+	// ResearchStatus Confidence level of genealogical data per GPS standards
+	ResearchStatus *ResearchStatus `json:"research_status,omitempty"`
 }
 
 // FamilyCreateRelationshipType defines model for FamilyCreate.RelationshipType.
@@ -2423,6 +3625,9 @@ type FamilyCreateRelationshipType string
 type FamilyDetail struct {
 	Children *[]FamilyChild `json:"children,omitempty"`
 
+	// CreatedAt When the family was first recorded. Omitted for records projected before creation times were tracked.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
 	// ExternalIds GEDCOM 7.0 external identifiers (EXID) with resolved display label and link. Read-only: populated from GEDCOM import; there is no direct-write endpoint.
 	ExternalIds *[]ExternalLink    `json:"external_ids,omitempty"`
 	Id          openapi_types.UUID `json:"id"`
@@ -2445,7 +3650,13 @@ type FamilyDetail struct {
 	Partner2         *PersonSummary                `json:"partner2,omitempty"`
 	Partner2Id       *openapi_types.UUID           `json:"partner2_id,omitempty"`
 	RelationshipType *FamilyDetailRelationshipType `json:"relationship_type,omitempty"`
-	Version          int64                         `json:"version"`
+
+	// ResearchStatus Confidence level of genealogical data per GPS standards
+	ResearchStatus *ResearchStatus `json:"research_status,omitempty"`
+
+	// UpdatedAt When the family was last changed
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Version   int64      `json:"version"`
 }
 
 // FamilyDetailRelationshipType defines model for FamilyDetail.RelationshipType.
@@ -2476,6 +3687,35 @@ type FamilyList struct {
 	Total  int            `json:"total"`
 }
 
+// FamilySearchResults defines model for FamilySearchResults.
+type FamilySearchResults struct {
+	Families []FamilySummary `json:"families"`
+	Query    string          `json:"query"`
+	Total    int             `json:"total"`
+}
+
+// FamilySizeCount defines model for FamilySizeCount.
+type FamilySizeCount struct {
+	Children int `json:"children"`
+
+	// Families Number of families with exactly this many children
+	Families int `json:"families"`
+}
+
+// FamilySizeStatistics Number of children per family
+type FamilySizeStatistics struct {
+	// AverageChildren Average children per family (omitted when family_count is 0)
+	AverageChildren *float32 `json:"average_children,omitempty"`
+
+	// Distribution Families by number of children, from childless up to the largest family, including
+	// sizes no family has.
+	Distribution []FamilySizeCount `json:"distribution"`
+	FamilyCount  int               `json:"family_count"`
+
+	// MedianChildren Median children per family (omitted when family_count is 0)
+	MedianChildren *float32 `json:"median_children,omitempty"`
+}
+
 // FamilySummary defines model for FamilySummary.
 type FamilySummary struct {
 	Id               openapi_types.UUID `json:"id"`
@@ -2491,7 +3731,12 @@ type FamilyUpdate struct {
 	Partner1Id       *openapi_types.UUID           `json:"partner1_id,omitempty"`
 	Partner2Id       *openapi_types.UUID           `json:"partner2_id,omitempty"`
 	RelationshipType *FamilyUpdateRelationshipType `json:"relationship_type,omitempty"`
-	Version          int64                         `json:"version"`
+
+	// ResearchStatus Confidence level of genealogical data per GPS standards
+	ResearchStatus *ResearchStatus `json:"research_status,omitempty"`
+
+	// Version Current version for optimistic locking. Required unless the If-Match header carries the version instead.
+	Version *int64 `json:"version,omitempty"`
 }
 
 // FamilyUpdateRelationshipType defines model for FamilyUpdate.RelationshipType.
@@ -2506,6 +3751,15 @@ type FieldChange struct {
 	OldValue interface{} `json:"old_value,omitempty"`
 }
 
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Name of the offending request field
+	Field string `json:"field"`
+
+	// Message Why the value is invalid
+	Message string `json:"message"`
+}
+
 // FormattedCitation defines model for FormattedCitation.
 type FormattedCitation struct {
 	// Full Full formatted citation text
@@ -2525,6 +3779,12 @@ type GenDate struct {
 	Day      *int    `json:"day,omitempty"`
 	Day2     *int    `json:"day2,omitempty"`
 
+	// DualYear New Style year of a dual-dated year such as "1719/20" (1720); year holds the Old Style year
+	DualYear *int `json:"dual_year,omitempty"`
+
+	// DualYear2 New Style year of a dual-dated range end
+	DualYear2 *int `json:"dual_year2,omitempty"`
+
 	// InterpretedFrom Original ambiguous phrase for interpreted (INT) dates, preserved for research transparency (e.g. "about eighteen fifty")
 	InterpretedFrom *string           `json:"interpreted_from,omitempty"`
 	Month           *int              `json:"month,omitempty"`
@@ -2532,8 +3792,14 @@ type GenDate struct {
 	Qualifier       *GenDateQualifier `json:"qualifier,omitempty"`
 
 	// Raw Original date string
-	Raw  *string `json:"raw,omitempty"`
-	Year *int    `json:"year,omitempty"`
+	Raw *string `json:"raw,omitempty"`
+
+	// SortDate Gregorian date used to order this date against others. Ranges (BET ... AND ...) resolve to the midpoint of their bounds; other dates resolve to the date they name, or the start of a FROM period.
+	SortDate *openapi_types.Date `json:"sort_date,omitempty"`
+
+	// Uncertain True when the qualifier makes the date imprecise (abt, cal, est, bef, aft, bet, int)
+	Uncertain *bool `json:"uncertain,omitempty"`
+	Year      *int  `json:"year,omitempty"`
 
 	// Year2 End year for ranges
 	Year2 *int `json:"year2,omitempty"`
@@ -2544,8 +3810,11 @@ type GenDateQualifier string
 
 // GenderDistribution defines model for GenderDistribution.
 type GenderDistribution struct {
-	Female  int `json:"female"`
-	Male    int `json:"male"`
+	Female    int `json:"female"`
+	Male      int `json:"male"`
+	Nonbinary int `json:"nonbinary"`
+
+	// Unknown Persons whose gender is unknown or was never recorded
 	Unknown int `json:"unknown"`
 }
 
@@ -2629,13 +3898,55 @@ type ImportError struct {
 	Record  *string `json:"record,omitempty"`
 }
 
+// ImportIssue A problem found while importing. Warnings are recoverable: the data was
+// imported as-is or with a substitute (e.g. an unknown tag). Errors are data
+// problems to fix, such as an unparseable date or a reference to a missing record.
+type ImportIssue struct {
+	Code string `json:"code"`
+
+	// Context Record @XREF@ or raw GEDCOM content the issue concerns
+	Context *string `json:"context,omitempty"`
+
+	// Line GEDCOM line number, 0 when unknown
+	Line     int                 `json:"line"`
+	Message  string              `json:"message"`
+	Severity ImportIssueSeverity `json:"severity"`
+}
+
+// ImportIssueSeverity defines model for ImportIssue.Severity.
+type ImportIssueSeverity string
+
+// ImportIssueGroup defines model for ImportIssueGroup.
+type ImportIssueGroup struct {
+	// Code Issue code shared by the group, e.g. BROKEN_XREF, INVALID_DATE, UNKNOWN_TAG
+	Code   string        `json:"code"`
+	Count  int           `json:"count"`
+	Issues []ImportIssue `json:"issues"`
+
+	// Severity Most severe of the group's issues
+	Severity ImportIssueGroupSeverity `json:"severity"`
+}
+
+// ImportIssueGroupSeverity Most severe of the group's issues
+type ImportIssueGroupSeverity string
+
 // ImportResult defines model for ImportResult.
 type ImportResult struct {
-	Errors           *[]ImportError   `json:"errors,omitempty"`
-	FamiliesImported int              `json:"families_imported"`
-	PersonsImported  int              `json:"persons_imported"`
-	Success          bool             `json:"success"`
-	Warnings         *[]ImportWarning `json:"warnings,omitempty"`
+	Errors           *[]ImportError `json:"errors,omitempty"`
+	FamiliesImported int            `json:"families_imported"`
+
+	// FileSize Size of the imported GEDCOM file in bytes
+	FileSize *int64 `json:"file_size,omitempty"`
+
+	// IssueGroups Warnings and parse errors as machine-readable issues, grouped by code.
+	// Groups containing errors come first, then larger groups.
+	IssueGroups *[]ImportIssueGroup `json:"issue_groups,omitempty"`
+
+	// MediaImported Media records created from files in the uploaded media archive
+	MediaImported   *int             `json:"media_imported,omitempty"`
+	PersonsImported int              `json:"persons_imported"`
+	Success         bool             `json:"success"`
+	Warnings        *[]ImportWarning `json:"warnings,omitempty"`
 }
 
 // ImportWarning defines model for ImportWarning.
@@ -2647,6 +3958,32 @@ type ImportWarning struct {
 	Record *string `json:"record,omitempty"`
 }
 
+// KinMember defines model for KinMember.
+type KinMember struct {
+	// Degree Parent-child links between the subject person and the relative
+	Degree int `json:"degree"`
+
+	// GenerationsDown Generations from the common ancestor to the relative
+	GenerationsDown int `json:"generations_down"`
+
+	// GenerationsUp Generations from the subject person to the common ancestor
+	GenerationsUp int    `json:"generations_up"`
+	Person        Person `json:"person"`
+
+	// Relationship What the relative is to the subject person, e.g. "1st cousin"
+	Relationship string `json:"relationship"`
+}
+
+// KinReport defines model for KinReport.
+type KinReport struct {
+	Kin []KinMember `json:"kin"`
+
+	// MaxDegree Degree limit applied to the walk
+	MaxDegree int    `json:"max_degree"`
+	Person    Person `json:"person"`
+	Total     int    `json:"total"`
+}
+
 // LDSOrdinance defines model for LDSOrdinance.
 type LDSOrdinance struct {
 	// Date Genealogical date with flexible precision
@@ -2757,6 +4094,42 @@ type LetterCount struct {
 	Letter string `json:"letter"`
 }
 
+// LifespanStatistics Age at death for persons with both a birth and a death date. Ages outside 0-120 years
+// are treated as data errors and excluded.
+type LifespanStatistics struct {
+	// AverageAge Average age at death in years (omitted when person_count is 0)
+	AverageAge *float32 `json:"average_age,omitempty"`
+
+	// ByCentury Age at death grouped by birth century, oldest first
+	ByCentury []CenturyLifespan `json:"by_century"`
+
+	// MedianAge Median age at death in years (omitted when person_count is 0)
+	MedianAge *float32 `json:"median_age,omitempty"`
+
+	// PersonCount Number of persons with a plausible age at death
+	PersonCount int `json:"person_count"`
+}
+
+// LivingStatistics Living and deceased persons. Living status is estimated: a person with a death date
+// or place is deceased; one without who was born more than presumed_lifespan years
+// before as_of_year is presumed deceased, and one born since is presumed living.
+// Persons with neither a death nor a birth year are counted as unknown.
+type LivingStatistics struct {
+	// AsOfYear Year the estimate was made for
+	AsOfYear int `json:"as_of_year"`
+
+	// Deceased Persons with a recorded death, plus those presumed deceased
+	Deceased int `json:"deceased"`
+	Living   int `json:"living"`
+
+	// PresumedDeceased Deceased persons with no recorded death, judged by birth year alone
+	PresumedDeceased int `json:"presumed_deceased"`
+
+	// PresumedLifespan Age in years beyond which a person with no recorded death is presumed deceased
+	PresumedLifespan int `json:"presumed_lifespan"`
+	Unknown          int `json:"unknown"`
+}
+
 // MapLocation defines model for MapLocation.
 type MapLocation struct {
 	// Count Number of persons at this location
@@ -2789,8 +4162,22 @@ type MapLocationsResponse struct {
 	Total int `json:"total"`
 }
 
+// MarriageAgeStatistics Age at first marriage for partners with both a birth date and a dated marriage. Ages
+// outside 12-100 years are treated as data errors and excluded.
+type MarriageAgeStatistics struct {
+	Female  AgeSummary `json:"female"`
+	Male    AgeSummary `json:"male"`
+	Overall AgeSummary `json:"overall"`
+	Unknown AgeSummary `json:"unknown"`
+}
+
 // Media defines model for Media.
 type Media struct {
+	// CapturedAt When the photo was taken, from EXIF DateTimeOriginal
+	CapturedAt *time.Time `json:"captured_at,omitempty"`
+
+	// ContentHash Hex-encoded SHA-256 hash of the file content
+	ContentHash *string            `json:"content_hash,omitempty"`
 	CreatedAt   *time.Time         `json:"created_at,omitempty"`
 	CropHeight  *int               `json:"crop_height,omitempty"`
 	CropLeft    *int               `json:"crop_left,omitempty"`
@@ -2810,12 +4197,21 @@ type Media struct {
 	// Format Primary format/MIME type (FORM tag)
 	Format *string `json:"format,omitempty"`
 
+	// GpsLatitude EXIF GPS latitude in decimal degrees (negative is south)
+	GpsLatitude *float64 `json:"gps_latitude,omitempty"`
+
+	// GpsLongitude EXIF GPS longitude in decimal degrees (negative is west)
+	GpsLongitude *float64 `json:"gps_longitude,omitempty"`
+
 	// HasThumbnail Whether a thumbnail is available
 	HasThumbnail *bool              `json:"has_thumbnail,omitempty"`
 	Id           openapi_types.UUID `json:"id"`
 	MediaType    *MediaMediaType    `json:"media_type,omitempty"`
 	MimeType     string             `json:"mime_type"`
-	Title        string             `json:"title"`
+
+	// SuggestedEventDate Capture date as a GEDCOM date, suitable for prefilling an event date
+	SuggestedEventDate *string `json:"suggested_event_date,omitempty"`
+	Title              string  `json:"title"`
 
 	// Translations Translated titles (GEDCOM 7.0 support)
 	Translations *[]string  `json:"translations,omitempty"`
@@ -2829,6 +4225,26 @@ type MediaEntityType string
 // MediaMediaType defines model for Media.MediaType.
 type MediaMediaType string
 
+// MediaDuplicateGroup defines model for MediaDuplicateGroup.
+type MediaDuplicateGroup struct {
+	// ContentHash Hex-encoded SHA-256 hash shared by the group
+	ContentHash string `json:"content_hash"`
+
+	// FileSize Size in bytes of the shared file
+	FileSize int64 `json:"file_size"`
+
+	// Media Media sharing the file, oldest first
+	Media []Media `json:"media"`
+}
+
+// MediaDuplicatesReport defines model for MediaDuplicatesReport.
+type MediaDuplicatesReport struct {
+	Groups []MediaDuplicateGroup `json:"groups"`
+
+	// Total Number of duplicate groups
+	Total int `json:"total"`
+}
+
 // MediaFile A single file reference within a media object (GEDCOM 7.0 FILE structure)
 type MediaFile struct {
 	// Format MIME type (FORM tag)
@@ -2936,6 +4352,39 @@ type MergeSummary struct {
 	NamesTransferred int `json:"names_transferred"`
 }
 
+// MissingDataItem defines model for MissingDataItem.
+type MissingDataItem struct {
+	// CompletenessScore Completeness score (0-100) as reported by the person quality endpoint
+	CompletenessScore float32 `json:"completeness_score"`
+
+	// Missing Key facts the person is missing
+	Missing    []MissingDataItemMissing `json:"missing"`
+	PersonId   openapi_types.UUID       `json:"person_id"`
+	PersonName string                   `json:"person_name"`
+
+	// PriorityScore Sum of the gap weights; higher means research first
+	PriorityScore int `json:"priority_score"`
+}
+
+// MissingDataItemMissing defines model for MissingDataItem.Missing.
+type MissingDataItemMissing string
+
+// MissingDataReport defines model for MissingDataReport.
+type MissingDataReport struct {
+	Items  []MissingDataItem `json:"items"`
+	Limit  int               `json:"limit"`
+	Offset int               `json:"offset"`
+
+	// Total Number of persons with at least one gap
+	Total int `json:"total"`
+}
+
+// NameCount defines model for NameCount.
+type NameCount struct {
+	Count int    `json:"count"`
+	Name  string `json:"name"`
+}
+
 // Note A shared GEDCOM NOTE record that can be referenced by multiple entities
 type Note struct {
 	// GedcomXref GEDCOM cross-reference ID (e.g., "@N1@") for round-trip support
@@ -2945,6 +4394,9 @@ type Note struct {
 	// Language GEDCOM 7.0 shared note (SNOTE) BCP 47 language tag for the text, e.g. "en" or "zh-Hans".
 	Language *string `json:"language,omitempty"`
 
+	// Links Persons, families, and sources this note is attached to
+	Links *[]NoteLink `json:"links,omitempty"`
+
 	// Mime GEDCOM 7.0 shared note (SNOTE) media type of the text, e.g. "text/plain" or "text/html". Empty for plain NOTE records.
 	Mime *string `json:"mime,omitempty"`
 
@@ -2968,6 +4420,15 @@ type NoteCreate struct {
 	Text string `json:"text"`
 }
 
+// NoteLink Attachment of a note to a person, family, or source
+type NoteLink struct {
+	EntityId   openapi_types.UUID `json:"entity_id"`
+	EntityType NoteLinkEntityType `json:"entity_type"`
+}
+
+// NoteLinkEntityType defines model for NoteLink.EntityType.
+type NoteLinkEntityType string
+
 // NoteList defines model for NoteList.
 type NoteList struct {
 	Limit  *int   `json:"limit,omitempty"`
@@ -3027,7 +4488,10 @@ type PedigreeNode struct {
 	GivenName  *string            `json:"given_name,omitempty"`
 	Id         openapi_types.UUID `json:"id"`
 	Mother     *PedigreeNode      `json:"mother,omitempty"`
-	Surname    *string            `json:"surname,omitempty"`
+
+	// Sources Citation counts for a person's vital facts; zero marks an unsourced fact
+	Sources *FactSourceCounts `json:"sources,omitempty"`
+	Surname *string           `json:"surname,omitempty"`
 }
 
 // Person defines model for Person.
@@ -3045,6 +4509,9 @@ type Person struct {
 	BrickWallResolvedAt *time.Time `json:"brick_wall_resolved_at,omitempty"`
 	BrickWallSince      *time.Time `json:"brick_wall_since,omitempty"`
 
+	// CreatedAt When the person was first recorded. Omitted for records projected before creation times were tracked.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
 	// DeathDate Genealogical date with flexible precision
 	DeathDate  *GenDate `json:"death_date,omitempty"`
 	DeathPlace *string  `json:"death_place,omitempty"`
@@ -3063,6 +4530,9 @@ type Person struct {
 	ResearchStatus *ResearchStatus `json:"research_status,omitempty"`
 	Surname        string          `json:"surname"`
 
+	// UpdatedAt When the person was last changed
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
 	// Version Optimistic locking version
 	Version int64 `json:"version"`
 }
@@ -3070,18 +4540,22 @@ type Person struct {
 // PersonGender defines model for Person.Gender.
 type PersonGender string
 
-// PersonCreate defines model for PersonCreate.
+// PersonCreate Either given_name or full_name is required
 type PersonCreate struct {
 	// BirthDate GEDCOM-format date string
 	BirthDate  *string `json:"birth_date,omitempty"`
 	BirthPlace *string `json:"birth_place,omitempty"`
 
 	// DeathDate GEDCOM-format date string
-	DeathDate  *string             `json:"death_date,omitempty"`
-	DeathPlace *string             `json:"death_place,omitempty"`
-	Gender     *PersonCreateGender `json:"gender,omitempty"`
-	GivenName  string              `json:"given_name"`
-	Notes      *string             `json:"notes,omitempty"`
+	DeathDate  *string `json:"death_date,omitempty"`
+	DeathPlace *string `json:"death_place,omitempty"`
+
+	// FullName Full name to split into parts, e.g. "Dr. John A. Smith Jr.", "Jan van der Berg"
+	// or "Smith, John". Explicit given_name and surname take precedence over the parsed parts.
+	FullName  *string             `json:"full_name,omitempty"`
+	Gender    *PersonCreateGender `json:"gender,omitempty"`
+	GivenName *string             `json:"given_name,omitempty"`
+	Notes     *string             `json:"notes,omitempty"`
 
 	// ResearchStatus Confidence level of genealogical data per GPS standards
 	ResearchStatus *ResearchStatus `json:"research_status,omitempty"`
@@ -3106,6 +4580,9 @@ type PersonDetail struct {
 	BrickWallResolvedAt *time.Time `json:"brick_wall_resolved_at,omitempty"`
 	BrickWallSince      *time.Time `json:"brick_wall_since,omitempty"`
 
+	// CreatedAt When the person was first recorded. Omitted for records projected before creation times were tracked.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
 	// DeathDate Genealogical date with flexible precision
 	DeathDate  *GenDate `json:"death_date,omitempty"`
 	DeathPlace *string  `json:"death_place,omitempty"`
@@ -3128,10 +4605,16 @@ type PersonDetail struct {
 	Names *[]PersonName `json:"names,omitempty"`
 	Notes *string       `json:"notes,omitempty"`
 
+	// Relationship What this person is to the configured home person (HOME_PERSON), as named by the relationship calculator. Omitted when no home person is configured or the two are not related.
+	Relationship *string `json:"relationship,omitempty"`
+
 	// ResearchStatus Confidence level of genealogical data per GPS standards
 	ResearchStatus *ResearchStatus `json:"research_status,omitempty"`
 	Surname        string          `json:"surname"`
 
+	// UpdatedAt When the person was last changed
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
 	// Version Optimistic locking version
 	Version int64 `json:"version"`
 }
@@ -3149,17 +4632,20 @@ type PersonList struct {
 
 // PersonName defines model for PersonName.
 type PersonName struct {
-	FullName      *string            `json:"full_name,omitempty"`
-	GivenName     string             `json:"given_name"`
-	Id            openapi_types.UUID `json:"id"`
-	IsPrimary     bool               `json:"is_primary"`
-	NamePrefix    *string            `json:"name_prefix,omitempty"`
-	NameSuffix    *string            `json:"name_suffix,omitempty"`
-	NameType      PersonNameNameType `json:"name_type"`
-	Nickname      *string            `json:"nickname,omitempty"`
-	PersonId      openapi_types.UUID `json:"person_id"`
-	Surname       string             `json:"surname"`
-	SurnamePrefix *string            `json:"surname_prefix,omitempty"`
+	FullName   *string            `json:"full_name,omitempty"`
+	GivenName  string             `json:"given_name"`
+	Id         openapi_types.UUID `json:"id"`
+	IsPrimary  bool               `json:"is_primary"`
+	NamePrefix *string            `json:"name_prefix,omitempty"`
+	NameSuffix *string            `json:"name_suffix,omitempty"`
+	NameType   PersonNameNameType `json:"name_type"`
+	Nickname   *string            `json:"nickname,omitempty"`
+	PersonId   openapi_types.UUID `json:"person_id"`
+
+	// Romanized Latin-script form of a name written in another script, searched alongside it
+	Romanized     *string `json:"romanized,omitempty"`
+	Surname       string  `json:"surname"`
+	SurnamePrefix *string `json:"surname_prefix,omitempty"`
 }
 
 // PersonNameNameType defines model for PersonName.NameType.
@@ -3167,14 +4653,17 @@ type PersonNameNameType string
 
 // PersonNameCreate defines model for PersonNameCreate.
 type PersonNameCreate struct {
-	GivenName     string                   `json:"given_name"`
-	IsPrimary     *bool                    `json:"is_primary,omitempty"`
-	NamePrefix    *string                  `json:"name_prefix,omitempty"`
-	NameSuffix    *string                  `json:"name_suffix,omitempty"`
-	NameType      PersonNameCreateNameType `json:"name_type"`
-	Nickname      *string                  `json:"nickname,omitempty"`
-	Surname       string                   `json:"surname"`
-	SurnamePrefix *string                  `json:"surname_prefix,omitempty"`
+	GivenName  string                   `json:"given_name"`
+	IsPrimary  *bool                    `json:"is_primary,omitempty"`
+	NamePrefix *string                  `json:"name_prefix,omitempty"`
+	NameSuffix *string                  `json:"name_suffix,omitempty"`
+	NameType   PersonNameCreateNameType `json:"name_type"`
+	Nickname   *string                  `json:"nickname,omitempty"`
+
+	// Romanized Latin-script form of a name written in another script, searched alongside it
+	Romanized     *string `json:"romanized,omitempty"`
+	Surname       string  `json:"surname"`
+	SurnamePrefix *string `json:"surname_prefix,omitempty"`
 }
 
 // PersonNameCreateNameType defines model for PersonNameCreate.NameType.
@@ -3188,14 +4677,17 @@ type PersonNameList struct {
 
 // PersonNameUpdate defines model for PersonNameUpdate.
 type PersonNameUpdate struct {
-	GivenName     *string                   `json:"given_name,omitempty"`
-	IsPrimary     *bool                     `json:"is_primary,omitempty"`
-	NamePrefix    *string                   `json:"name_prefix,omitempty"`
-	NameSuffix    *string                   `json:"name_suffix,omitempty"`
-	NameType      *PersonNameUpdateNameType `json:"name_type,omitempty"`
-	Nickname      *string                   `json:"nickname,omitempty"`
-	Surname       *string                   `json:"surname,omitempty"`
-	SurnamePrefix *string                   `json:"surname_prefix,omitempty"`
+	GivenName  *string                   `json:"given_name,omitempty"`
+	IsPrimary  *bool                     `json:"is_primary,omitempty"`
+	NamePrefix *string                   `json:"name_prefix,omitempty"`
+	NameSuffix *string                   `json:"name_suffix,omitempty"`
+	NameType   *PersonNameUpdateNameType `json:"name_type,omitempty"`
+	Nickname   *string                   `json:"nickname,omitempty"`
+
+	// Romanized Latin-script form of a name written in another script, searched alongside it
+	Romanized     *string `json:"romanized,omitempty"`
+	Surname       *string `json:"surname,omitempty"`
+	SurnamePrefix *string `json:"surname_prefix,omitempty"`
 }
 
 // PersonNameUpdateNameType defines model for PersonNameUpdate.NameType.
@@ -3240,8 +4732,8 @@ type PersonUpdate struct {
 	ResearchStatus *ResearchStatus `json:"research_status,omitempty"`
 	Surname        *string         `json:"surname,omitempty"`
 
-	// Version Current version for optimistic locking
-	Version int64 `json:"version"`
+	// Version Current version for optimistic locking. Required unless the If-Match header carries the version instead.
+	Version *int64 `json:"version,omitempty"`
 }
 
 // PersonUpdateGender defines model for PersonUpdate.Gender.
@@ -3272,13 +4764,100 @@ type PlaceIndexResponse struct {
 	Total int `json:"total"`
 }
 
-// ProofSummary defines model for ProofSummary.
-type ProofSummary struct {
-	// AnalysisIds IDs of evidence analyses used in this proof
-	AnalysisIds *[]openapi_types.UUID `json:"analysis_ids,omitempty"`
+// PlaceMergeRequest defines model for PlaceMergeRequest.
+type PlaceMergeRequest struct {
+	// Canonical Place name to keep
+	Canonical string `json:"canonical"`
 
-	// Argument The proof argument supporting the conclusion
-	Argument string `json:"argument"`
+	// Variants Place names to rewrite to the canonical name
+	Variants []string `json:"variants"`
+}
+
+// PlaceMergeResult defines model for PlaceMergeResult.
+type PlaceMergeResult struct {
+	EventsUpdated   int `json:"events_updated"`
+	FamiliesUpdated int `json:"families_updated"`
+	PersonsUpdated  int `json:"persons_updated"`
+}
+
+// PlaceVariant defines model for PlaceVariant.
+type PlaceVariant struct {
+	// Count Number of person, family, and event fields using this place
+	Count int    `json:"count"`
+	Place string `json:"place"`
+}
+
+// PlaceVariantCluster defines model for PlaceVariantCluster.
+type PlaceVariantCluster struct {
+	// Count Total references across all variants
+	Count int `json:"count"`
+
+	// Suggested Suggested canonical name (the most used variant)
+	Suggested string         `json:"suggested"`
+	Variants  []PlaceVariant `json:"variants"`
+}
+
+// PlaceVariantsResponse defines model for PlaceVariantsResponse.
+type PlaceVariantsResponse struct {
+	Clusters []PlaceVariantCluster `json:"clusters"`
+
+	// Total Number of clusters
+	Total int `json:"total"`
+}
+
+// ProjectionCheckResponse defines model for ProjectionCheckResponse.
+type ProjectionCheckResponse struct {
+	// Checked Number of entities compared
+	Checked       int                     `json:"checked"`
+	Discrepancies []ProjectionDiscrepancy `json:"discrepancies"`
+
+	// EventsReplayed Number of events applied to rebuild state
+	EventsReplayed int `json:"events_replayed"`
+}
+
+// ProjectionDiscrepancy defines model for ProjectionDiscrepancy.
+type ProjectionDiscrepancy struct {
+	EntityId openapi_types.UUID `json:"entity_id"`
+
+	// EntityType person, family, source, or citation; any stream type for failed
+	EntityType string `json:"entity_type"`
+
+	// Error Why the first failing event could not be replayed (failed only)
+	Error *string `json:"error,omitempty"`
+
+	// Fields Differing fields (mismatch only)
+	Fields *[]ProjectionFieldMismatch `json:"fields,omitempty"`
+
+	// Issue missing: the event log has the entity but the read model does not.
+	// unexpected: the read model has an entity the event log deleted.
+	// mismatch: both have the entity but fields differ.
+	// failed: an event for the entity could not be decoded or projected.
+	Issue ProjectionDiscrepancyIssue `json:"issue"`
+}
+
+// ProjectionDiscrepancyIssue missing: the event log has the entity but the read model does not.
+// unexpected: the read model has an entity the event log deleted.
+// mismatch: both have the entity but fields differ.
+// failed: an event for the entity could not be decoded or projected.
+type ProjectionDiscrepancyIssue string
+
+// ProjectionFieldMismatch defines model for ProjectionFieldMismatch.
+type ProjectionFieldMismatch struct {
+	// Actual Value stored in the read model
+	Actual string `json:"actual"`
+
+	// Expected Value rebuilt from the event log
+	Expected string `json:"expected"`
+	Field    string `json:"field"`
+}
+
+// ProofSummary defines model for ProofSummary.
+type ProofSummary struct {
+	// AnalysisIds IDs of evidence analyses used in this proof
+	AnalysisIds *[]openapi_types.UUID `json:"analysis_ids,omitempty"`
+
+	// Argument The proof argument supporting the conclusion
+	Argument string `json:"argument"`
 
 	// Conclusion The proven conclusion
 	Conclusion     string                      `json:"conclusion"`
@@ -3367,6 +4946,9 @@ type QualityReport struct {
 	// InfoCount Total number of info-level issues
 	InfoCount int `json:"info_count"`
 
+	// ResearchStatus Research status breakdown for each kind of record
+	ResearchStatus ResearchStatusSummary `json:"research_status"`
+
 	// SourceCoverage Fraction of individuals with at least one source citation (0.0-1.0)
 	SourceCoverage float32 `json:"source_coverage"`
 
@@ -3395,6 +4977,11 @@ type QualityReportIssue struct {
 	Count int `json:"count"`
 }
 
+// RecentChangesResponse defines model for RecentChangesResponse.
+type RecentChangesResponse struct {
+	Items []ChangeEntry `json:"items"`
+}
+
 // RelationshipPath defines model for RelationshipPath.
 type RelationshipPath struct {
 	CommonAncestorId    *openapi_types.UUID `json:"commonAncestorId,omitempty"`
@@ -3576,6 +5163,47 @@ type ResearchLogUpdateOutcome string
 // ResearchStatus Confidence level of genealogical data per GPS standards
 type ResearchStatus string
 
+// ResearchStatusCounts Records by research status. Records never assessed count as unknown.
+type ResearchStatusCounts struct {
+	Certain  int `json:"certain"`
+	Possible int `json:"possible"`
+	Probable int `json:"probable"`
+	Unknown  int `json:"unknown"`
+}
+
+// ResearchStatusSummary Research status breakdown for each kind of record
+type ResearchStatusSummary struct {
+	// Attributes Records by research status. Records never assessed count as unknown.
+	Attributes ResearchStatusCounts `json:"attributes"`
+
+	// Events Records by research status. Records never assessed count as unknown.
+	Events ResearchStatusCounts `json:"events"`
+
+	// Families Records by research status. Records never assessed count as unknown.
+	Families ResearchStatusCounts `json:"families"`
+
+	// Persons Records by research status. Records never assessed count as unknown.
+	Persons ResearchStatusCounts `json:"persons"`
+}
+
+// ResearchStatusUpdate defines model for ResearchStatusUpdate.
+type ResearchStatusUpdate struct {
+	// ResearchStatus Confidence level of genealogical data per GPS standards
+	ResearchStatus ResearchStatus `json:"research_status"`
+
+	// Version Current version for optimistic locking
+	Version int64 `json:"version"`
+}
+
+// ResearchStatusUpdateResult defines model for ResearchStatusUpdateResult.
+type ResearchStatusUpdateResult struct {
+	Id openapi_types.UUID `json:"id"`
+
+	// ResearchStatus Confidence level of genealogical data per GPS standards
+	ResearchStatus ResearchStatus `json:"research_status"`
+	Version        int64          `json:"version"`
+}
+
 // RestorePoint defines model for RestorePoint.
 type RestorePoint struct {
 	// Action The action that created this version
@@ -3587,6 +5215,9 @@ type RestorePoint struct {
 	// Timestamp When this version was created
 	Timestamp time.Time `json:"timestamp"`
 
+	// UserId Who made the change, from the X-Actor header (omitted if unattributed)
+	UserId *string `json:"user_id,omitempty"`
+
 	// Version The version number of this restore point
 	Version int64 `json:"version"`
 }
@@ -3631,21 +5262,53 @@ type RollbackResponse struct {
 // RollbackResponseEntityType Type of entity that was rolled back
 type RollbackResponseEntityType string
 
+// SearchMatch A matched span within a field of a search result. `start` and `end`
+// are character (Unicode code point) offsets into `value`, with `end`
+// exclusive. Date range matches span the whole value.
+type SearchMatch struct {
+	End   int              `json:"end"`
+	Field SearchMatchField `json:"field"`
+	Start int              `json:"start"`
+
+	// Value The field's text; the full name for alternate names
+	Value string `json:"value"`
+}
+
+// SearchMatchField defines model for SearchMatch.Field.
+type SearchMatchField string
+
 // SearchResult defines model for SearchResult.
 type SearchResult struct {
 	// BirthDate Genealogical date with flexible precision
-	BirthDate *GenDate `json:"birth_date,omitempty"`
+	BirthDate  *GenDate `json:"birth_date,omitempty"`
+	BirthPlace *string  `json:"birth_place,omitempty"`
 
 	// DeathDate Genealogical date with flexible precision
-	DeathDate *GenDate           `json:"death_date,omitempty"`
-	GivenName string             `json:"given_name"`
-	Id        openapi_types.UUID `json:"id"`
+	DeathDate  *GenDate           `json:"death_date,omitempty"`
+	DeathPlace *string            `json:"death_place,omitempty"`
+	GivenName  string             `json:"given_name"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// MatchedBy Algorithm that matched the result. `exact` when the primary name
+	// contains the query, otherwise the requested algorithm.
+	MatchedBy *SearchResultMatchedBy `json:"matched_by,omitempty"`
+
+	// Matches Where the search criteria matched, for highlighting
+	Matches *[]SearchMatch `json:"matches,omitempty"`
+
+	// Patronymic True when the primary name is a patronymic, so the surname is
+	// derived from a parent rather than a family name.
+	Patronymic *bool `json:"patronymic,omitempty"`
 
 	// Score Relevance score (0-1)
 	Score   *float32 `json:"score,omitempty"`
 	Surname string   `json:"surname"`
 }
 
+// SearchResultMatchedBy Algorithm that matched the result. `exact` when the primary name
+// contains the query, otherwise the requested algorithm.
+type SearchResultMatchedBy string
+
 // SearchResults defines model for SearchResults.
 type SearchResults struct {
 	Items []SearchResult `json:"items"`
@@ -3777,6 +5440,35 @@ type SourceList struct {
 	Total   int      `json:"total"`
 }
 
+// SourceReport defines model for SourceReport.
+type SourceReport struct {
+	// CitedCount Number of facts with at least one citation
+	CitedCount int                `json:"cited_count"`
+	Facts      []FactCoverage     `json:"facts"`
+	PersonId   openapi_types.UUID `json:"person_id"`
+	PersonName string             `json:"person_name"`
+
+	// UncitedCount Number of facts without citations
+	UncitedCount int `json:"uncited_count"`
+}
+
+// SourceRepositoryEntry defines model for SourceRepositoryEntry.
+type SourceRepositoryEntry struct {
+	// Count Number of sources held by the repository
+	Count int `json:"count"`
+
+	// Name Repository name as recorded on sources
+	Name string `json:"name"`
+}
+
+// SourceRepositoryIndexResponse defines model for SourceRepositoryIndexResponse.
+type SourceRepositoryIndexResponse struct {
+	Items []SourceRepositoryEntry `json:"items"`
+
+	// Total Total number of unique repository names
+	Total int `json:"total"`
+}
+
 // SourceSearchResults defines model for SourceSearchResults.
 type SourceSearchResults struct {
 	Query   string   `json:"query"`
@@ -3797,13 +5489,24 @@ type SourceUpdate struct {
 	Title          *string `json:"title,omitempty"`
 	Url            *string `json:"url,omitempty"`
 
-	// Version Current version for optimistic locking
-	Version int64 `json:"version"`
+	// Version Current version for optimistic locking. Required unless the If-Match header carries the version instead.
+	Version *int64 `json:"version,omitempty"`
+}
+
+// SourceUsage defines model for SourceUsage.
+type SourceUsage struct {
+	// CitationCount Total number of citations of the source
+	CitationCount int                `json:"citation_count"`
+	Owners        []CitationOwner    `json:"owners"`
+	SourceId      openapi_types.UUID `json:"source_id"`
+	SourceTitle   string             `json:"source_title"`
 }
 
 // SpouseInfo Spouse information in the descendancy tree
 type SpouseInfo struct {
-	Id openapi_types.UUID `json:"id"`
+	// FamilyId Family shared with this spouse
+	FamilyId openapi_types.UUID `json:"family_id"`
+	Id       openapi_types.UUID `json:"id"`
 
 	// MarriageDate Genealogical date with flexible precision
 	MarriageDate *GenDate `json:"marriage_date,omitempty"`
@@ -3812,8 +5515,27 @@ type SpouseInfo struct {
 
 // Statistics defines model for Statistics.
 type Statistics struct {
-	DateRange          DateRange          `json:"date_range"`
-	GenderDistribution GenderDistribution `json:"gender_distribution"`
+	// BirthsByDecade Birth counts per decade from the earliest to the latest birth decade, including
+	// decades with no births. Persons without a parseable birth year are not counted.
+	BirthsByDecade []DecadeCount `json:"births_by_decade"`
+	DateRange      DateRange     `json:"date_range"`
+
+	// FamilySize Number of children per family
+	FamilySize         FamilySizeStatistics `json:"family_size"`
+	GenderDistribution GenderDistribution   `json:"gender_distribution"`
+
+	// Lifespan Age at death for persons with both a birth and a death date. Ages outside 0-120 years
+	// are treated as data errors and excluded.
+	Lifespan LifespanStatistics `json:"lifespan"`
+
+	// Living Living and deceased persons. Living status is estimated: a person with a death date
+	// or place is deceased; one without who was born more than presumed_lifespan years
+	// before as_of_year is presumed deceased, and one born since is presumed living.
+	// Persons with neither a death nor a birth year are counted as unknown.
+	Living LivingStatistics `json:"living"`
+
+	// TopGivenNames Most common given names (top 10), counted by first token of compound names
+	TopGivenNames []NameCount `json:"top_given_names"`
 
 	// TopSurnames Most common surnames (top 10)
 	TopSurnames []SurnameCount `json:"top_surnames"`
@@ -3936,6 +5658,62 @@ type SurnameIndexResponse struct {
 	Total int `json:"total"`
 }
 
+// SurnameQuality defines model for SurnameQuality.
+type SurnameQuality struct {
+	// AverageCompleteness Average completeness score of those persons (0-100)
+	AverageCompleteness float32 `json:"average_completeness"`
+
+	// MissingBirthDates Number of those persons without a usable birth date
+	MissingBirthDates int `json:"missing_birth_dates"`
+
+	// PersonCount Number of persons with this surname
+	PersonCount int `json:"person_count"`
+
+	// RecordsWithIssues Number of those persons with at least one data quality issue
+	RecordsWithIssues int    `json:"records_with_issues"`
+	Surname           string `json:"surname"`
+
+	// UnsourcedBirths Number of those persons with a birth date or place but no birth citation
+	UnsourcedBirths int `json:"unsourced_births"`
+}
+
+// SurnameQualityReport defines model for SurnameQualityReport.
+type SurnameQualityReport struct {
+	Items  []SurnameQuality `json:"items"`
+	Limit  int              `json:"limit"`
+	Offset int              `json:"offset"`
+
+	// Total Number of surnames
+	Total int `json:"total"`
+}
+
+// TrashItem defines model for TrashItem.
+type TrashItem struct {
+	DeletedAt time.Time          `json:"deleted_at"`
+	EntityId  openapi_types.UUID `json:"entity_id"`
+
+	// EntityName Human-readable name of the entity
+	EntityName string              `json:"entity_name"`
+	EntityType TrashItemEntityType `json:"entity_type"`
+
+	// Reason Reason given for the deletion
+	Reason *string `json:"reason,omitempty"`
+
+	// UserId Who deleted the entity (omitted if unattributed)
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// TrashItemEntityType defines model for TrashItem.EntityType.
+type TrashItemEntityType string
+
+// TrashResponse defines model for TrashResponse.
+type TrashResponse struct {
+	Items  []TrashItem `json:"items"`
+	Limit  int         `json:"limit"`
+	Offset int         `json:"offset"`
+	Total  int         `json:"total"`
+}
+
 // ValidationIssue A single validation issue detected in the data
 type ValidationIssue struct {
 	// Code Issue code identifier
@@ -3978,6 +5756,12 @@ type ValidationIssuesResponse struct {
 	WarningCount int `json:"warning_count"`
 }
 
+// VersionedRef Entity ID with the version expected for optimistic locking
+type VersionedRef struct {
+	Id      openapi_types.UUID `json:"id"`
+	Version int64              `json:"version"`
+}
+
 // AssociationId defines model for associationId.
 type AssociationId = openapi_types.UUID
 
@@ -3987,9 +5771,18 @@ type EvidenceAnalysisId = openapi_types.UUID
 // EvidenceConflictId defines model for evidenceConflictId.
 type EvidenceConflictId = openapi_types.UUID
 
+// EvidenceTypeParam defines model for evidenceTypeParam.
+type EvidenceTypeParam string
+
 // FamilyId defines model for familyId.
 type FamilyId = openapi_types.UUID
 
+// IfMatch defines model for ifMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for ifNoneMatch.
+type IfNoneMatch = string
+
 // LdsOrdinanceId defines model for ldsOrdinanceId.
 type LdsOrdinanceId = openapi_types.UUID
 
@@ -4014,9 +5807,18 @@ type RepositoryId = openapi_types.UUID
 // ResearchLogId defines model for researchLogId.
 type ResearchLogId = openapi_types.UUID
 
+// RetryParam defines model for retryParam.
+type RetryParam = bool
+
+// SearchLimitParam defines model for searchLimitParam.
+type SearchLimitParam = int
+
 // SnapshotId defines model for snapshotId.
 type SnapshotId = openapi_types.UUID
 
+// SourceQualityParam defines model for sourceQualityParam.
+type SourceQualityParam string
+
 // SubmitterId defines model for submitterId.
 type SubmitterId = openapi_types.UUID
 
@@ -4029,16 +5831,35 @@ type BadRequest = Error
 // Conflict defines model for Conflict.
 type Conflict = Error
 
+// Forbidden defines model for Forbidden.
+type Forbidden = Error
+
 // NotFound defines model for NotFound.
 type NotFound = Error
 
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = Error
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = Error
+
+// VerifyProjectionsParams defines parameters for VerifyProjections.
+type VerifyProjectionsParams struct {
+	// Sample Compare only this many randomly chosen entities (default all)
+	Sample *int `form:"sample,omitempty" json:"sample,omitempty"`
+}
+
 // GetAhnentafelParams defines parameters for GetAhnentafel.
 type GetAhnentafelParams struct {
-	// Generations Number of ancestor generations to include (1-10)
+	// Generations Number of ancestor generations to include. Defaults to the server's
+	// DEFAULT_GENERATIONS (5 when unset) and is capped at MAX_GENERATIONS (default 10).
 	Generations *int `form:"generations,omitempty" json:"generations,omitempty"`
 
 	// Format Output format
 	Format *GetAhnentafelParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// IncludeSources Attach birth and death citation counts to each ancestor, so unsourced facts can be flagged
+	IncludeSources *bool `form:"include_sources,omitempty" json:"include_sources,omitempty"`
 }
 
 // GetAhnentafelParamsFormat defines parameters for GetAhnentafel.
@@ -4069,6 +5890,20 @@ type DeleteAssociationParams struct {
 	Version VersionParam `form:"version" json:"version"`
 }
 
+// UpdateAssociationParams defines parameters for UpdateAssociation.
+type UpdateAssociationParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+}
+
+// GetPersonsByBirthDecadeParams defines parameters for GetPersonsByBirthDecade.
+type GetPersonsByBirthDecadeParams struct {
+	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // GetBrickWallsParams defines parameters for GetBrickWalls.
 type GetBrickWallsParams struct {
 	IncludeResolved *bool `form:"include_resolved,omitempty" json:"include_resolved,omitempty"`
@@ -4092,6 +5927,12 @@ type GetPersonsByPlaceParams struct {
 	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// GetSourcesByRepositoryParams defines parameters for GetSourcesByRepository.
+type GetSourcesByRepositoryParams struct {
+	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // BrowseSurnamesParams defines parameters for BrowseSurnames.
 type BrowseSurnamesParams struct {
 	// Letter Filter surnames by starting letter (A-Z)
@@ -4122,6 +5963,23 @@ type DeleteCitationParams struct {
 	Version VersionParam `form:"version" json:"version"`
 }
 
+// UpdateCitationParams defines parameters for UpdateCitation.
+type UpdateCitationParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+}
+
+// GetFormattedCitationParams defines parameters for GetFormattedCitation.
+type GetFormattedCitationParams struct {
+	// Style Citation style (default evidence_explained)
+	Style *GetFormattedCitationParamsStyle `form:"style,omitempty" json:"style,omitempty"`
+}
+
+// GetFormattedCitationParamsStyle defines parameters for GetFormattedCitation.
+type GetFormattedCitationParamsStyle string
+
 // GetCitationRestorePointsParams defines parameters for GetCitationRestorePoints.
 type GetCitationRestorePointsParams struct {
 	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
@@ -4130,8 +5988,18 @@ type GetCitationRestorePointsParams struct {
 
 // GetDescendancyParams defines parameters for GetDescendancy.
 type GetDescendancyParams struct {
-	// Generations Number of descendant generations to include
+	// Generations Number of descendant generations to include. Defaults to the server's
+	// DEFAULT_GENERATIONS (4 when unset) and is capped at MAX_GENERATIONS (default 10).
 	Generations *int `form:"generations,omitempty" json:"generations,omitempty"`
+
+	// IncludeDeceased Set to false to leave out descendants estimated to be deceased: those with a
+	// death date or place, or born more than 100 years ago. Descendants with no
+	// birth year are kept.
+	IncludeDeceased *bool `form:"include_deceased,omitempty" json:"include_deceased,omitempty"`
+
+	// BirthYearFrom Leave out descendants born before this year. Descendants with no birth year
+	// are kept.
+	BirthYearFrom *int `form:"birth_year_from,omitempty" json:"birth_year_from,omitempty"`
 }
 
 // ListEvidenceAnalysesParams defines parameters for ListEvidenceAnalyses.
@@ -4160,6 +6028,14 @@ type DeleteEvidenceAnalysisParams struct {
 	Version VersionParam `form:"version" json:"version"`
 }
 
+// UpdateEvidenceAnalysisParams defines parameters for UpdateEvidenceAnalysis.
+type UpdateEvidenceAnalysisParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+}
+
 // ListEvidenceConflictsParams defines parameters for ListEvidenceConflicts.
 type ListEvidenceConflictsParams struct {
 	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
@@ -4178,6 +6054,32 @@ type ListFamiliesParams struct {
 	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// SearchFamiliesParams defines parameters for SearchFamilies.
+type SearchFamiliesParams struct {
+	// Q Search query (partner names); must have at least SEARCH_MIN_QUERY_LENGTH characters (default 2).
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum results to return (default 20), capped at SEARCH_MAX_LIMIT (default 100)
+	Limit *SearchLimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetFamilyParams defines parameters for GetFamily.
+type GetFamilyParams struct {
+	// IfNoneMatch ETag from a previous response. When it matches the current version, the server responds 304 Not Modified without a body.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// UpdateFamilyParams defines parameters for UpdateFamily.
+type UpdateFamilyParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+
+	// IfMatch ETag of the version being updated, as returned by GET (e.g. "3" or "3-9f86d081884c7d65"); only the version before any dash is used. Used in place of the body version; when the entity has since changed the server responds 412 Precondition Failed. Retries are not attempted when this header is sent.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // GetFamilyHistoryParams defines parameters for GetFamilyHistory.
 type GetFamilyHistoryParams struct {
 	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
@@ -4194,6 +6096,9 @@ type GetFamilyRestorePointsParams struct {
 type ExportGedcomParams struct {
 	// Version GEDCOM version to emit. When omitted, defaults to 5.5 and is automatically upgraded to 7.0 if the data uses 7.0-only features.
 	Version *ExportGedcomParamsVersion `form:"version,omitempty" json:"version,omitempty"`
+
+	// Include Comma-separated optional record types to export, from "sources" (with their repositories and citations) and "notes". Persons and families are always exported. When omitted, everything is exported; an empty value gives a lean person and family file.
+	Include *string `form:"include,omitempty" json:"include,omitempty"`
 }
 
 // ExportGedcomParamsVersion defines parameters for ExportGedcom.
@@ -4212,6 +6117,9 @@ type PreviewGedcomExportParamsVersion string
 type ImportGedcomMultipartBody struct {
 	// File GEDCOM file to import
 	File openapi_types.File `json:"file"`
+
+	// Media Optional ZIP archive of the media files referenced by the GEDCOM OBJE/FILE structures. Files are matched by path, or by file name when the path does not match.
+	Media *openapi_types.File `json:"media,omitempty"`
 }
 
 // ListHistoryParams defines parameters for ListHistory.
@@ -4219,6 +6127,9 @@ type ListHistoryParams struct {
 	// EntityType Filter by entity type
 	EntityType *ListHistoryParamsEntityType `form:"entity_type,omitempty" json:"entity_type,omitempty"`
 
+	// Action Filter by action. Linked and unlinked match child links and unlinks on families.
+	Action *ListHistoryParamsAction `form:"action,omitempty" json:"action,omitempty"`
+
 	// From Start date/time for history (ISO 8601)
 	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
 
@@ -4231,6 +6142,9 @@ type ListHistoryParams struct {
 // ListHistoryParamsEntityType defines parameters for ListHistory.
 type ListHistoryParamsEntityType string
 
+// ListHistoryParamsAction defines parameters for ListHistory.
+type ListHistoryParamsAction string
+
 // ListLDSOrdinancesParams defines parameters for ListLDSOrdinances.
 type ListLDSOrdinancesParams struct {
 	Limit  *LimitParam                   `form:"limit,omitempty" json:"limit,omitempty"`
@@ -4251,17 +6165,42 @@ type DeleteLDSOrdinanceParams struct {
 	Version VersionParam `form:"version" json:"version"`
 }
 
+// UpdateLDSOrdinanceParams defines parameters for UpdateLDSOrdinance.
+type UpdateLDSOrdinanceParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+}
+
 // DeleteMediaParams defines parameters for DeleteMedia.
 type DeleteMediaParams struct {
 	// Version Entity version for optimistic locking
 	Version VersionParam `form:"version" json:"version"`
 }
 
+// UpdateMediaParams defines parameters for UpdateMedia.
+type UpdateMediaParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+}
+
+// GetMediaThumbnailParams defines parameters for GetMediaThumbnail.
+type GetMediaThumbnailParams struct {
+	// Size Maximum thumbnail width and height in pixels (default set by THUMBNAIL_SIZE)
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+}
+
 // ListNotesParams defines parameters for ListNotes.
 type ListNotesParams struct {
 	Limit  *LimitParam           `form:"limit,omitempty" json:"limit,omitempty"`
 	Offset *OffsetParam          `form:"offset,omitempty" json:"offset,omitempty"`
 	Order  *ListNotesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// EntityId Only return notes attached to this person, family, or source
+	EntityId *openapi_types.UUID `form:"entityId,omitempty" json:"entityId,omitempty"`
 }
 
 // ListNotesParamsOrder defines parameters for ListNotes.
@@ -4273,12 +6212,30 @@ type DeleteNoteParams struct {
 	Version VersionParam `form:"version" json:"version"`
 }
 
+// UpdateNoteParams defines parameters for UpdateNote.
+type UpdateNoteParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+}
+
 // GetPedigreeParams defines parameters for GetPedigree.
 type GetPedigreeParams struct {
-	// Generations Number of ancestor generations to include
+	// Generations Number of ancestor generations to include. Defaults to the server's
+	// DEFAULT_GENERATIONS (5 when unset) and is capped at MAX_GENERATIONS (default 10).
 	Generations *int `form:"generations,omitempty" json:"generations,omitempty"`
+
+	// Format Output format; text is an indented outline of the tree for pasting into emails
+	Format *GetPedigreeParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// IncludeSources Attach birth and death citation counts to each ancestor, so unsourced facts can be flagged
+	IncludeSources *bool `form:"include_sources,omitempty" json:"include_sources,omitempty"`
 }
 
+// GetPedigreeParamsFormat defines parameters for GetPedigree.
+type GetPedigreeParamsFormat string
+
 // ListPersonsParams defines parameters for ListPersons.
 type ListPersonsParams struct {
 	Limit  *LimitParam             `form:"limit,omitempty" json:"limit,omitempty"`
@@ -4288,6 +6245,12 @@ type ListPersonsParams struct {
 
 	// ResearchStatus Filter by research status
 	ResearchStatus *ListPersonsParamsResearchStatus `form:"research_status,omitempty" json:"research_status,omitempty"`
+
+	// Surname Filter by exact surname (case-insensitive)
+	Surname *string `form:"surname,omitempty" json:"surname,omitempty"`
+
+	// Gender Filter by gender
+	Gender *ListPersonsParamsGender `form:"gender,omitempty" json:"gender,omitempty"`
 }
 
 // ListPersonsParamsSort defines parameters for ListPersons.
@@ -4299,6 +6262,9 @@ type ListPersonsParamsOrder string
 // ListPersonsParamsResearchStatus defines parameters for ListPersons.
 type ListPersonsParamsResearchStatus string
 
+// ListPersonsParamsGender defines parameters for ListPersons.
+type ListPersonsParamsGender string
+
 // GetPersonsDuplicatesParams defines parameters for GetPersonsDuplicates.
 type GetPersonsDuplicatesParams struct {
 	// Limit Maximum number of duplicate pairs to return
@@ -4308,18 +6274,84 @@ type GetPersonsDuplicatesParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// ListOrphanedPersonsParams defines parameters for ListOrphanedPersons.
+type ListOrphanedPersonsParams struct {
+	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetPersonParams defines parameters for GetPerson.
+type GetPersonParams struct {
+	// IfNoneMatch ETag from a previous response. When it matches the current version, the server responds 304 Not Modified without a body.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// UpdatePersonParams defines parameters for UpdatePerson.
+type UpdatePersonParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+
+	// IfMatch ETag of the version being updated, as returned by GET (e.g. "3" or "3-9f86d081884c7d65"); only the version before any dash is used. Used in place of the body version; when the entity has since changed the server responds 412 Precondition Failed. Retries are not attempted when this header is sent.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // SetPersonBrickWallJSONBody defines parameters for SetPersonBrickWall.
 type SetPersonBrickWallJSONBody struct {
 	// Note Description of the research block
 	Note string `json:"note"`
 }
 
+// GetCitationsForPersonParams defines parameters for GetCitationsForPerson.
+type GetCitationsForPersonParams struct {
+	// SourceQuality Only return citations whose source has this quality
+	SourceQuality *GetCitationsForPersonParamsSourceQuality `form:"source_quality,omitempty" json:"source_quality,omitempty"`
+
+	// EvidenceType Only return citations providing this type of evidence
+	EvidenceType *GetCitationsForPersonParamsEvidenceType `form:"evidence_type,omitempty" json:"evidence_type,omitempty"`
+}
+
+// GetCitationsForPersonParamsSourceQuality defines parameters for GetCitationsForPerson.
+type GetCitationsForPersonParamsSourceQuality string
+
+// GetCitationsForPersonParamsEvidenceType defines parameters for GetCitationsForPerson.
+type GetCitationsForPersonParamsEvidenceType string
+
+// GetEndOfLinesParams defines parameters for GetEndOfLines.
+type GetEndOfLinesParams struct {
+	// Generations Number of ancestor generations to search. Defaults to the server's
+	// DEFAULT_GENERATIONS (5 when unset) and is capped at MAX_GENERATIONS (default 10).
+	Generations *int `form:"generations,omitempty" json:"generations,omitempty"`
+}
+
+// ExportPersonGedcomParams defines parameters for ExportPersonGedcom.
+type ExportPersonGedcomParams struct {
+	// Mode Direction to walk from the person
+	Mode ExportPersonGedcomParamsMode `form:"mode" json:"mode"`
+
+	// Version GEDCOM version to emit. When omitted, defaults to 5.5 and is automatically upgraded to 7.0 if the data uses 7.0-only features.
+	Version *ExportPersonGedcomParamsVersion `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// ExportPersonGedcomParamsMode defines parameters for ExportPersonGedcom.
+type ExportPersonGedcomParamsMode string
+
+// ExportPersonGedcomParamsVersion defines parameters for ExportPersonGedcom.
+type ExportPersonGedcomParamsVersion string
+
 // GetPersonHistoryParams defines parameters for GetPersonHistory.
 type GetPersonHistoryParams struct {
 	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
 	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// GetPersonKinParams defines parameters for GetPersonKin.
+type GetPersonKinParams struct {
+	// MaxDegree Maximum number of parent-child links between the person and a relative
+	MaxDegree *int `form:"maxDegree,omitempty" json:"maxDegree,omitempty"`
+}
+
 // ListPersonMediaParams defines parameters for ListPersonMedia.
 type ListPersonMediaParams struct {
 	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
@@ -4331,7 +6363,7 @@ type UploadPersonMediaMultipartBody struct {
 	// Description Optional description
 	Description *string `json:"description,omitempty"`
 
-	// File The file to upload (max 10MB)
+	// File The file to upload (max 10MB unless MAX_MEDIA_SIZE is set)
 	File openapi_types.File `json:"file"`
 
 	// MediaType Category of media
@@ -4344,6 +6376,12 @@ type UploadPersonMediaMultipartBody struct {
 // UploadPersonMediaMultipartBodyMediaType defines parameters for UploadPersonMedia.
 type UploadPersonMediaMultipartBodyMediaType string
 
+// GetPersonNamesParams defines parameters for GetPersonNames.
+type GetPersonNamesParams struct {
+	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // GetPersonRestorePointsParams defines parameters for GetPersonRestorePoints.
 type GetPersonRestorePointsParams struct {
 	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
@@ -4376,6 +6414,29 @@ type DeleteProofSummaryParams struct {
 	Version VersionParam `form:"version" json:"version"`
 }
 
+// UpdateProofSummaryParams defines parameters for UpdateProofSummary.
+type UpdateProofSummaryParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+}
+
+// GetQualityBySurnameParams defines parameters for GetQualityBySurname.
+type GetQualityBySurnameParams struct {
+	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetQualityReportParams defines parameters for GetQualityReport.
+type GetQualityReportParams struct {
+	// Format Output format
+	Format *GetQualityReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetQualityReportParamsFormat defines parameters for GetQualityReport.
+type GetQualityReportParamsFormat string
+
 // GetValidationIssuesParams defines parameters for GetValidationIssues.
 type GetValidationIssuesParams struct {
 	// Severity Filter by severity level
@@ -4391,6 +6452,17 @@ type GetValidationIssuesParams struct {
 // GetValidationIssuesParamsSeverity defines parameters for GetValidationIssues.
 type GetValidationIssuesParamsSeverity string
 
+// ListRecentChangesParams defines parameters for ListRecentChanges.
+type ListRecentChangesParams struct {
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetMissingDataReportParams defines parameters for GetMissingDataReport.
+type GetMissingDataReportParams struct {
+	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // ListRepositoriesParams defines parameters for ListRepositories.
 type ListRepositoriesParams struct {
 	Limit  *LimitParam                  `form:"limit,omitempty" json:"limit,omitempty"`
@@ -4411,6 +6483,14 @@ type DeleteRepositoryParams struct {
 	Version VersionParam `form:"version" json:"version"`
 }
 
+// UpdateRepositoryParams defines parameters for UpdateRepository.
+type UpdateRepositoryParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+}
+
 // ListResearchLogsParams defines parameters for ListResearchLogs.
 type ListResearchLogsParams struct {
 	Limit  *LimitParam                  `form:"limit,omitempty" json:"limit,omitempty"`
@@ -4431,9 +6511,20 @@ type DeleteResearchLogParams struct {
 	Version VersionParam `form:"version" json:"version"`
 }
 
+// UpdateResearchLogParams defines parameters for UpdateResearchLog.
+type UpdateResearchLogParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+}
+
 // SearchPersonsParams defines parameters for SearchPersons.
 type SearchPersonsParams struct {
-	// Q Search query (name)
+	// Q Search query (name). `*` matches any run of characters at a word boundary,
+	// so `Smi*` finds names beginning with "Smi" and `*son` names ending in "son";
+	// wildcard queries ignore `algorithm`, `fuzzy`, and `soundex`.
+	// Must have at least SEARCH_MIN_QUERY_LENGTH characters (default 2).
 	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
 	// Fuzzy Enable fuzzy matching for spelling variations
@@ -4442,6 +6533,10 @@ type SearchPersonsParams struct {
 	// Soundex Enable Soundex phonetic matching for name variants
 	Soundex *bool `form:"soundex,omitempty" json:"soundex,omitempty"`
 
+	// Algorithm Name matching algorithm. Overrides `fuzzy` and `soundex` when set.
+	// `metaphone` matches query words against the Metaphone key of surnames.
+	Algorithm *SearchPersonsParamsAlgorithm `form:"algorithm,omitempty" json:"algorithm,omitempty"`
+
 	// BirthDateFrom Filter by birth date on or after this date
 	BirthDateFrom *openapi_types.Date `form:"birth_date_from,omitempty" json:"birth_date_from,omitempty"`
 
@@ -4465,9 +6560,14 @@ type SearchPersonsParams struct {
 
 	// Order Sort order
 	Order *SearchPersonsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
-	Limit *LimitParam               `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Limit Maximum results to return (default 20), capped at SEARCH_MAX_LIMIT (default 100)
+	Limit *SearchLimitParam `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// SearchPersonsParamsAlgorithm defines parameters for SearchPersons.
+type SearchPersonsParamsAlgorithm string
+
 // SearchPersonsParamsSort defines parameters for SearchPersons.
 type SearchPersonsParamsSort string
 
@@ -4476,10 +6576,12 @@ type SearchPersonsParamsOrder string
 
 // ListSourcesParams defines parameters for ListSources.
 type ListSourcesParams struct {
-	Limit  *LimitParam             `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset *OffsetParam            `form:"offset,omitempty" json:"offset,omitempty"`
-	Sort   *ListSourcesParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
-	Order  *ListSourcesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort Field to sort by; citation_count orders sources by how often they are cited
+	Sort  *ListSourcesParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
+	Order *ListSourcesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
 
 	// Q Search query to filter sources
 	Q *string `form:"q,omitempty" json:"q,omitempty"`
@@ -4493,9 +6595,11 @@ type ListSourcesParamsOrder string
 
 // SearchSourcesParams defines parameters for SearchSources.
 type SearchSourcesParams struct {
-	// Q Search query
-	Q     string      `form:"q" json:"q"`
-	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+	// Q Search query; must have at least SEARCH_MIN_QUERY_LENGTH characters (default 2).
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum results to return (default 20), capped at SEARCH_MAX_LIMIT (default 100)
+	Limit *SearchLimitParam `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // DeleteSourceParams defines parameters for DeleteSource.
@@ -4504,19 +6608,57 @@ type DeleteSourceParams struct {
 	Version VersionParam `form:"version" json:"version"`
 }
 
-// GetSourceHistoryParams defines parameters for GetSourceHistory.
-type GetSourceHistoryParams struct {
-	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+// GetSourceParams defines parameters for GetSource.
+type GetSourceParams struct {
+	// IfNoneMatch ETag from a previous response. When it matches the current version, the server responds 304 Not Modified without a body.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
 }
 
-// GetSourceRestorePointsParams defines parameters for GetSourceRestorePoints.
-type GetSourceRestorePointsParams struct {
+// UpdateSourceParams defines parameters for UpdateSource.
+type UpdateSourceParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+
+	// IfMatch ETag of the version being updated, as returned by GET (e.g. "3" or "3-9f86d081884c7d65"); only the version before any dash is used. Used in place of the body version; when the entity has since changed the server responds 412 Precondition Failed. Retries are not attempted when this header is sent.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetCitationsForSourceParams defines parameters for GetCitationsForSource.
+type GetCitationsForSourceParams struct {
 	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
 	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
-}
 
-// ListSubmittersParams defines parameters for ListSubmitters.
+	// FactType Only return citations of this fact type (e.g. person_birth)
+	FactType *string `form:"fact_type,omitempty" json:"fact_type,omitempty"`
+
+	// SourceQuality Only return citations whose source has this quality
+	SourceQuality *GetCitationsForSourceParamsSourceQuality `form:"source_quality,omitempty" json:"source_quality,omitempty"`
+
+	// EvidenceType Only return citations providing this type of evidence
+	EvidenceType *GetCitationsForSourceParamsEvidenceType `form:"evidence_type,omitempty" json:"evidence_type,omitempty"`
+}
+
+// GetCitationsForSourceParamsSourceQuality defines parameters for GetCitationsForSource.
+type GetCitationsForSourceParamsSourceQuality string
+
+// GetCitationsForSourceParamsEvidenceType defines parameters for GetCitationsForSource.
+type GetCitationsForSourceParamsEvidenceType string
+
+// GetSourceHistoryParams defines parameters for GetSourceHistory.
+type GetSourceHistoryParams struct {
+	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetSourceRestorePointsParams defines parameters for GetSourceRestorePoints.
+type GetSourceRestorePointsParams struct {
+	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListSubmittersParams defines parameters for ListSubmitters.
 type ListSubmittersParams struct {
 	Limit  *LimitParam                `form:"limit,omitempty" json:"limit,omitempty"`
 	Offset *OffsetParam               `form:"offset,omitempty" json:"offset,omitempty"`
@@ -4536,12 +6678,32 @@ type DeleteSubmitterParams struct {
 	Version VersionParam `form:"version" json:"version"`
 }
 
+// UpdateSubmitterParams defines parameters for UpdateSubmitter.
+type UpdateSubmitterParams struct {
+	// Retry Automatically retry on a version conflict by re-reading the current
+	// version and re-applying the submitted changes. Changes to the same
+	// field made concurrently by someone else are overwritten.
+	Retry *RetryParam `form:"retry,omitempty" json:"retry,omitempty"`
+}
+
+// ListTrashParams defines parameters for ListTrash.
+type ListTrashParams struct {
+	Limit  *LimitParam  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // CreateAssociationJSONRequestBody defines body for CreateAssociation for application/json ContentType.
 type CreateAssociationJSONRequestBody = AssociationCreate
 
 // UpdateAssociationJSONRequestBody defines body for UpdateAssociation for application/json ContentType.
 type UpdateAssociationJSONRequestBody = AssociationUpdate
 
+// SetAttributeResearchStatusJSONRequestBody defines body for SetAttributeResearchStatus for application/json ContentType.
+type SetAttributeResearchStatusJSONRequestBody = ResearchStatusUpdate
+
+// ExecuteBatchJSONRequestBody defines body for ExecuteBatch for application/json ContentType.
+type ExecuteBatchJSONRequestBody = BatchRequest
+
 // PreviewCitationTemplateJSONRequestBody defines body for PreviewCitationTemplate for application/json ContentType.
 type PreviewCitationTemplateJSONRequestBody PreviewCitationTemplateJSONBody
 
@@ -4554,6 +6716,9 @@ type UpdateCitationJSONRequestBody = CitationUpdate
 // RollbackCitationJSONRequestBody defines body for RollbackCitation for application/json ContentType.
 type RollbackCitationJSONRequestBody = RollbackRequest
 
+// SetEventResearchStatusJSONRequestBody defines body for SetEventResearchStatus for application/json ContentType.
+type SetEventResearchStatusJSONRequestBody = ResearchStatusUpdate
+
 // CreateEvidenceAnalysisJSONRequestBody defines body for CreateEvidenceAnalysis for application/json ContentType.
 type CreateEvidenceAnalysisJSONRequestBody = EvidenceAnalysisCreate
 
@@ -4563,6 +6728,12 @@ type UpdateEvidenceAnalysisJSONRequestBody = EvidenceAnalysisUpdate
 // ResolveEvidenceConflictJSONRequestBody defines body for ResolveEvidenceConflict for application/json ContentType.
 type ResolveEvidenceConflictJSONRequestBody = EvidenceConflictResolve
 
+// ExportBySurnameJSONRequestBody defines body for ExportBySurname for application/json ContentType.
+type ExportBySurnameJSONRequestBody = ExportBySurnameRequest
+
+// ExportSelectionJSONRequestBody defines body for ExportSelection for application/json ContentType.
+type ExportSelectionJSONRequestBody = ExportSelectionRequest
+
 // CreateFamilyJSONRequestBody defines body for CreateFamily for application/json ContentType.
 type CreateFamilyJSONRequestBody = FamilyCreate
 
@@ -4596,6 +6767,9 @@ type UpdateNoteJSONRequestBody = NoteUpdate
 // CreatePersonJSONRequestBody defines body for CreatePerson for application/json ContentType.
 type CreatePersonJSONRequestBody = PersonCreate
 
+// BulkDeletePersonsJSONRequestBody defines body for BulkDeletePersons for application/json ContentType.
+type BulkDeletePersonsJSONRequestBody = BulkDeleteRequest
+
 // BatchDismissDuplicatesJSONRequestBody defines body for BatchDismissDuplicates for application/json ContentType.
 type BatchDismissDuplicatesJSONRequestBody = BatchDismissRequest
 
@@ -4614,6 +6788,9 @@ type UpdatePersonJSONRequestBody = PersonUpdate
 // SetPersonBrickWallJSONRequestBody defines body for SetPersonBrickWall for application/json ContentType.
 type SetPersonBrickWallJSONRequestBody SetPersonBrickWallJSONBody
 
+// AddPersonExternalIdJSONRequestBody defines body for AddPersonExternalId for application/json ContentType.
+type AddPersonExternalIdJSONRequestBody = ExternalIdCreate
+
 // UploadPersonMediaMultipartRequestBody defines body for UploadPersonMedia for multipart/form-data ContentType.
 type UploadPersonMediaMultipartRequestBody UploadPersonMediaMultipartBody
 
@@ -4626,6 +6803,9 @@ type UpdatePersonNameJSONRequestBody = PersonNameUpdate
 // RollbackPersonJSONRequestBody defines body for RollbackPerson for application/json ContentType.
 type RollbackPersonJSONRequestBody = RollbackRequest
 
+// MergePlacesJSONRequestBody defines body for MergePlaces for application/json ContentType.
+type MergePlacesJSONRequestBody = PlaceMergeRequest
+
 // CreateProofSummaryJSONRequestBody defines body for CreateProofSummary for application/json ContentType.
 type CreateProofSummaryJSONRequestBody = ProofSummaryCreate
 
@@ -4644,6 +6824,9 @@ type CreateResearchLogJSONRequestBody = ResearchLogCreate
 // UpdateResearchLogJSONRequestBody defines body for UpdateResearchLog for application/json ContentType.
 type UpdateResearchLogJSONRequestBody = ResearchLogUpdate
 
+// AdvancedSearchPersonsJSONRequestBody defines body for AdvancedSearchPersons for application/json ContentType.
+type AdvancedSearchPersonsJSONRequestBody = AdvancedSearchRequest
+
 // CreateSnapshotJSONRequestBody defines body for CreateSnapshot for application/json ContentType.
 type CreateSnapshotJSONRequestBody = SnapshotCreate
 
@@ -4664,6 +6847,9 @@ type UpdateSubmitterJSONRequestBody = SubmitterUpdate
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Compare read models against the event log
+	// (GET /admin/verify-projections)
+	VerifyProjections(ctx echo.Context, params VerifyProjectionsParams) error
 	// Get Ahnentafel (ancestor table) report for a person
 	// (GET /ahnentafel/{id})
 	GetAhnentafel(ctx echo.Context, id PersonId, params GetAhnentafelParams) error
@@ -4684,7 +6870,19 @@ type ServerInterface interface {
 	GetAssociation(ctx echo.Context, id AssociationId) error
 	// Update an association
 	// (PUT /associations/{id})
-	UpdateAssociation(ctx echo.Context, id AssociationId) error
+	UpdateAssociation(ctx echo.Context, id AssociationId, params UpdateAssociationParams) error
+	// Set the research status of a person attribute
+	// (PUT /attributes/{id}/research-status)
+	SetAttributeResearchStatus(ctx echo.Context, id openapi_types.UUID) error
+	// Run several operations in one request
+	// (POST /batch)
+	ExecuteBatch(ctx echo.Context) error
+	// Get birth decade index with counts
+	// (GET /browse/birth-decades)
+	BrowseBirthDecades(ctx echo.Context) error
+	// Get persons born in a decade
+	// (GET /browse/birth-decades/{decade})
+	GetPersonsByBirthDecade(ctx echo.Context, decade string, params GetPersonsByBirthDecadeParams) error
 	// List brick wall research blocks
 	// (GET /browse/brick-walls)
 	GetBrickWalls(ctx echo.Context, params GetBrickWallsParams) error
@@ -4700,6 +6898,12 @@ type ServerInterface interface {
 	// Get persons associated with a place
 	// (GET /browse/places/{place}/persons)
 	GetPersonsByPlace(ctx echo.Context, place string, params GetPersonsByPlaceParams) error
+	// Get source repository index with counts
+	// (GET /browse/repositories)
+	BrowseSourceRepositories(ctx echo.Context) error
+	// Get sources held by a repository
+	// (GET /browse/repositories/{name})
+	GetSourcesByRepository(ctx echo.Context, name string, params GetSourcesByRepositoryParams) error
 	// Get surname index with counts
 	// (GET /browse/surnames)
 	BrowseSurnames(ctx echo.Context, params BrowseSurnamesParams) error
@@ -4726,10 +6930,13 @@ type ServerInterface interface {
 	GetCitation(ctx echo.Context, id openapi_types.UUID) error
 	// Update a citation
 	// (PUT /citations/{id})
-	UpdateCitation(ctx echo.Context, id openapi_types.UUID) error
+	UpdateCitation(ctx echo.Context, id openapi_types.UUID, params UpdateCitationParams) error
 	// Format a citation using its template
 	// (GET /citations/{id}/format)
 	FormatCitation(ctx echo.Context, id openapi_types.UUID) error
+	// Render a citation as footnote and bibliography text
+	// (GET /citations/{id}/formatted)
+	GetFormattedCitation(ctx echo.Context, id openapi_types.UUID, params GetFormattedCitationParams) error
 	// Get restore points for a citation
 	// (GET /citations/{id}/restore-points)
 	GetCitationRestorePoints(ctx echo.Context, id openapi_types.UUID, params GetCitationRestorePointsParams) error
@@ -4739,6 +6946,9 @@ type ServerInterface interface {
 	// Get descendancy tree for a person
 	// (GET /descendancy/{id})
 	GetDescendancy(ctx echo.Context, id PersonId, params GetDescendancyParams) error
+	// Set the research status of a life event
+	// (PUT /events/{id}/research-status)
+	SetEventResearchStatus(ctx echo.Context, id openapi_types.UUID) error
 	// List all evidence analyses
 	// (GET /evidence-analyses)
 	ListEvidenceAnalyses(ctx echo.Context, params ListEvidenceAnalysesParams) error
@@ -4756,7 +6966,7 @@ type ServerInterface interface {
 	GetEvidenceAnalysis(ctx echo.Context, id EvidenceAnalysisId) error
 	// Update an evidence analysis
 	// (PUT /evidence-analyses/{id})
-	UpdateEvidenceAnalysis(ctx echo.Context, id EvidenceAnalysisId) error
+	UpdateEvidenceAnalysis(ctx echo.Context, id EvidenceAnalysisId, params UpdateEvidenceAnalysisParams) error
 	// List all evidence conflicts
 	// (GET /evidence-conflicts)
 	ListEvidenceConflicts(ctx echo.Context, params ListEvidenceConflictsParams) error
@@ -4772,6 +6982,9 @@ type ServerInterface interface {
 	// Export attributes data
 	// (GET /export/attributes)
 	ExportAttributes(ctx echo.Context) error
+	// Export one GEDCOM per surname as a ZIP
+	// (POST /export/by-surname)
+	ExportBySurname(ctx echo.Context) error
 	// Export citations data
 	// (GET /export/citations)
 	ExportCitations(ctx echo.Context) error
@@ -4787,27 +7000,36 @@ type ServerInterface interface {
 	// Export persons data
 	// (GET /export/persons)
 	ExportPersons(ctx echo.Context) error
+	// Export a selected set of persons
+	// (POST /export/selection)
+	ExportSelection(ctx echo.Context) error
 	// Export sources data
 	// (GET /export/sources)
 	ExportSources(ctx echo.Context) error
 	// Export full family tree
 	// (GET /export/tree)
 	ExportTree(ctx echo.Context) error
+	// List the fact types in use with counts
+	// (GET /fact-types)
+	ListFactTypes(ctx echo.Context) error
 	// List all families
 	// (GET /families)
 	ListFamilies(ctx echo.Context, params ListFamiliesParams) error
 	// Create a new family
 	// (POST /families)
 	CreateFamily(ctx echo.Context) error
+	// Search families by partner name
+	// (GET /families/search)
+	SearchFamilies(ctx echo.Context, params SearchFamiliesParams) error
 	// Delete a family
 	// (DELETE /families/{id})
 	DeleteFamily(ctx echo.Context, id FamilyId) error
 	// Get a family by ID
 	// (GET /families/{id})
-	GetFamily(ctx echo.Context, id FamilyId) error
+	GetFamily(ctx echo.Context, id FamilyId, params GetFamilyParams) error
 	// Update a family
 	// (PUT /families/{id})
-	UpdateFamily(ctx echo.Context, id FamilyId) error
+	UpdateFamily(ctx echo.Context, id FamilyId, params UpdateFamilyParams) error
 	// Add a child to a family
 	// (POST /families/{id}/children)
 	AddChildToFamily(ctx echo.Context, id FamilyId) error
@@ -4855,10 +7077,13 @@ type ServerInterface interface {
 	GetLDSOrdinance(ctx echo.Context, id LdsOrdinanceId) error
 	// Update an LDS ordinance
 	// (PUT /lds-ordinances/{id})
-	UpdateLDSOrdinance(ctx echo.Context, id LdsOrdinanceId) error
+	UpdateLDSOrdinance(ctx echo.Context, id LdsOrdinanceId, params UpdateLDSOrdinanceParams) error
 	// Get geographic locations for map visualization
 	// (GET /map/locations)
 	GetMapLocations(ctx echo.Context) error
+	// Report media with identical file content
+	// (GET /media/duplicates)
+	ListDuplicateMedia(ctx echo.Context) error
 	// Delete media
 	// (DELETE /media/{id})
 	DeleteMedia(ctx echo.Context, id openapi_types.UUID, params DeleteMediaParams) error
@@ -4867,13 +7092,13 @@ type ServerInterface interface {
 	GetMedia(ctx echo.Context, id openapi_types.UUID) error
 	// Update media metadata
 	// (PUT /media/{id})
-	UpdateMedia(ctx echo.Context, id openapi_types.UUID) error
+	UpdateMedia(ctx echo.Context, id openapi_types.UUID, params UpdateMediaParams) error
 	// Download media file
 	// (GET /media/{id}/content)
 	DownloadMedia(ctx echo.Context, id openapi_types.UUID) error
 	// Get media thumbnail
 	// (GET /media/{id}/thumbnail)
-	GetMediaThumbnail(ctx echo.Context, id openapi_types.UUID) error
+	GetMediaThumbnail(ctx echo.Context, id openapi_types.UUID, params GetMediaThumbnailParams) error
 	// List all notes
 	// (GET /notes)
 	ListNotes(ctx echo.Context, params ListNotesParams) error
@@ -4888,7 +7113,7 @@ type ServerInterface interface {
 	GetNote(ctx echo.Context, id NoteId) error
 	// Update a note
 	// (PUT /notes/{id})
-	UpdateNote(ctx echo.Context, id NoteId) error
+	UpdateNote(ctx echo.Context, id NoteId, params UpdateNoteParams) error
 	// Get ancestor pedigree for a person
 	// (GET /pedigree/{id})
 	GetPedigree(ctx echo.Context, id PersonId, params GetPedigreeParams) error
@@ -4898,6 +7123,9 @@ type ServerInterface interface {
 	// Create a new person
 	// (POST /persons)
 	CreatePerson(ctx echo.Context) error
+	// Delete multiple persons
+	// (POST /persons/bulk-delete)
+	BulkDeletePersons(ctx echo.Context) error
 	// Find potential duplicate persons
 	// (GET /persons/duplicates)
 	GetPersonsDuplicates(ctx echo.Context, params GetPersonsDuplicatesParams) error
@@ -4913,15 +7141,18 @@ type ServerInterface interface {
 	// Batch merge multiple duplicate pairs
 	// (POST /persons/merge/batch)
 	BatchMergePersons(ctx echo.Context) error
+	// List persons with no family links
+	// (GET /persons/orphans)
+	ListOrphanedPersons(ctx echo.Context, params ListOrphanedPersonsParams) error
 	// Delete a person
 	// (DELETE /persons/{id})
 	DeletePerson(ctx echo.Context, id PersonId) error
 	// Get a person by ID
 	// (GET /persons/{id})
-	GetPerson(ctx echo.Context, id PersonId) error
+	GetPerson(ctx echo.Context, id PersonId, params GetPersonParams) error
 	// Update a person
 	// (PUT /persons/{id})
-	UpdatePerson(ctx echo.Context, id PersonId) error
+	UpdatePerson(ctx echo.Context, id PersonId, params UpdatePersonParams) error
 	// List associations for a person
 	// (GET /persons/{id}/associations)
 	ListAssociationsForPerson(ctx echo.Context, id PersonId) error
@@ -4933,10 +7164,22 @@ type ServerInterface interface {
 	SetPersonBrickWall(ctx echo.Context, id PersonId) error
 	// Get citations for a person
 	// (GET /persons/{id}/citations)
-	GetCitationsForPerson(ctx echo.Context, id PersonId) error
+	GetCitationsForPerson(ctx echo.Context, id PersonId, params GetCitationsForPersonParams) error
+	// List end-of-line ancestors for a person
+	// (GET /persons/{id}/end-of-lines)
+	GetEndOfLines(ctx echo.Context, id PersonId, params GetEndOfLinesParams) error
+	// Export one branch of the tree as GEDCOM
+	// (GET /persons/{id}/export-gedcom)
+	ExportPersonGedcom(ctx echo.Context, id PersonId, params ExportPersonGedcomParams) error
+	// Link a person to an external tree
+	// (POST /persons/{id}/external-ids)
+	AddPersonExternalId(ctx echo.Context, id PersonId) error
 	// Get change history for a person
 	// (GET /persons/{id}/history)
 	GetPersonHistory(ctx echo.Context, id PersonId, params GetPersonHistoryParams) error
+	// List a person's blood relatives with their relationship
+	// (GET /persons/{id}/kin)
+	GetPersonKin(ctx echo.Context, id PersonId, params GetPersonKinParams) error
 	// List LDS ordinances for a person
 	// (GET /persons/{id}/lds-ordinances)
 	ListLDSOrdinancesForPerson(ctx echo.Context, id PersonId) error
@@ -4946,9 +7189,12 @@ type ServerInterface interface {
 	// Upload media to a person
 	// (POST /persons/{id}/media)
 	UploadPersonMedia(ctx echo.Context, id PersonId) error
+	// Download all media attached to a person as a ZIP
+	// (GET /persons/{id}/media/archive)
+	DownloadPersonMediaArchive(ctx echo.Context, id PersonId) error
 	// Get all names for a person
 	// (GET /persons/{id}/names)
-	GetPersonNames(ctx echo.Context, id PersonId) error
+	GetPersonNames(ctx echo.Context, id PersonId, params GetPersonNamesParams) error
 	// Add a name to a person
 	// (POST /persons/{id}/names)
 	AddPersonName(ctx echo.Context, id PersonId) error
@@ -4964,6 +7210,15 @@ type ServerInterface interface {
 	// Rollback a person to a previous version
 	// (POST /persons/{id}/rollback)
 	RollbackPerson(ctx echo.Context, id PersonId) error
+	// Get citation coverage for each of a person's facts
+	// (GET /persons/{id}/source-report)
+	GetPersonSourceReport(ctx echo.Context, id PersonId) error
+	// Merge place name variants into a canonical name
+	// (POST /places/merge)
+	MergePlaces(ctx echo.Context) error
+	// Find clusters of similar place names
+	// (GET /places/variants)
+	GetPlaceVariants(ctx echo.Context) error
 	// List all proof summaries
 	// (GET /proof-summaries)
 	ListProofSummaries(ctx echo.Context, params ListProofSummariesParams) error
@@ -4981,7 +7236,10 @@ type ServerInterface interface {
 	GetProofSummary(ctx echo.Context, id ProofSummaryId) error
 	// Update a proof summary
 	// (PUT /proof-summaries/{id})
-	UpdateProofSummary(ctx echo.Context, id ProofSummaryId) error
+	UpdateProofSummary(ctx echo.Context, id ProofSummaryId, params UpdateProofSummaryParams) error
+	// Get quality by surname
+	// (GET /quality/by-surname)
+	GetQualityBySurname(ctx echo.Context, params GetQualityBySurnameParams) error
 	// Get aggregate quality metrics
 	// (GET /quality/overview)
 	GetQualityOverview(ctx echo.Context) error
@@ -4990,13 +7248,19 @@ type ServerInterface interface {
 	GetPersonQuality(ctx echo.Context, id PersonId) error
 	// Get full quality report
 	// (GET /quality/report)
-	GetQualityReport(ctx echo.Context) error
+	GetQualityReport(ctx echo.Context, params GetQualityReportParams) error
 	// Get validation issues
 	// (GET /quality/validation)
 	GetValidationIssues(ctx echo.Context, params GetValidationIssuesParams) error
+	// List recently changed entities
+	// (GET /recent)
+	ListRecentChanges(ctx echo.Context, params ListRecentChangesParams) error
 	// Calculate relationship between two people
 	// (GET /relationship/{personId1}/{personId2})
 	GetRelationship(ctx echo.Context, personId1 openapi_types.UUID, personId2 openapi_types.UUID) error
+	// List persons with research gaps
+	// (GET /reports/missing-data)
+	GetMissingDataReport(ctx echo.Context, params GetMissingDataReportParams) error
 	// List all repositories
 	// (GET /repositories)
 	ListRepositories(ctx echo.Context, params ListRepositoriesParams) error
@@ -5011,7 +7275,7 @@ type ServerInterface interface {
 	GetRepository(ctx echo.Context, id RepositoryId) error
 	// Update a repository
 	// (PUT /repositories/{id})
-	UpdateRepository(ctx echo.Context, id RepositoryId) error
+	UpdateRepository(ctx echo.Context, id RepositoryId, params UpdateRepositoryParams) error
 	// List all research log entries
 	// (GET /research-logs)
 	ListResearchLogs(ctx echo.Context, params ListResearchLogsParams) error
@@ -5029,10 +7293,13 @@ type ServerInterface interface {
 	GetResearchLog(ctx echo.Context, id ResearchLogId) error
 	// Update a research log entry
 	// (PUT /research-logs/{id})
-	UpdateResearchLog(ctx echo.Context, id ResearchLogId) error
+	UpdateResearchLog(ctx echo.Context, id ResearchLogId, params UpdateResearchLogParams) error
 	// Search for persons
 	// (GET /search)
 	SearchPersons(ctx echo.Context, params SearchPersonsParams) error
+	// Search for persons by structured criteria
+	// (POST /search/advanced)
+	AdvancedSearchPersons(ctx echo.Context) error
 	// List all snapshots
 	// (GET /snapshots)
 	ListSnapshots(ctx echo.Context) error
@@ -5062,13 +7329,13 @@ type ServerInterface interface {
 	DeleteSource(ctx echo.Context, id openapi_types.UUID, params DeleteSourceParams) error
 	// Get a source by ID
 	// (GET /sources/{id})
-	GetSource(ctx echo.Context, id openapi_types.UUID) error
+	GetSource(ctx echo.Context, id openapi_types.UUID, params GetSourceParams) error
 	// Update a source
 	// (PUT /sources/{id})
-	UpdateSource(ctx echo.Context, id openapi_types.UUID) error
+	UpdateSource(ctx echo.Context, id openapi_types.UUID, params UpdateSourceParams) error
 	// Get citations for a source
 	// (GET /sources/{id}/citations)
-	GetCitationsForSource(ctx echo.Context, id openapi_types.UUID) error
+	GetCitationsForSource(ctx echo.Context, id openapi_types.UUID, params GetCitationsForSourceParams) error
 	// Get change history for a source
 	// (GET /sources/{id}/history)
 	GetSourceHistory(ctx echo.Context, id openapi_types.UUID, params GetSourceHistoryParams) error
@@ -5078,9 +7345,15 @@ type ServerInterface interface {
 	// Rollback a source to a previous version
 	// (POST /sources/{id}/rollback)
 	RollbackSource(ctx echo.Context, id openapi_types.UUID) error
+	// Get the persons and families a source is cited for
+	// (GET /sources/{id}/usage)
+	GetSourceUsage(ctx echo.Context, id openapi_types.UUID) error
 	// Get tree-wide statistics
 	// (GET /statistics)
 	GetStatistics(ctx echo.Context) error
+	// Get age at first marriage statistics
+	// (GET /statistics/marriage-age)
+	GetMarriageAgeStatistics(ctx echo.Context) error
 	// List all submitters
 	// (GET /submitters)
 	ListSubmitters(ctx echo.Context, params ListSubmittersParams) error
@@ -5095,7 +7368,13 @@ type ServerInterface interface {
 	GetSubmitter(ctx echo.Context, id SubmitterId) error
 	// Update a submitter
 	// (PUT /submitters/{id})
-	UpdateSubmitter(ctx echo.Context, id SubmitterId) error
+	UpdateSubmitter(ctx echo.Context, id SubmitterId, params UpdateSubmitterParams) error
+	// List deleted entities
+	// (GET /trash)
+	ListTrash(ctx echo.Context, params ListTrashParams) error
+	// Restore a deleted entity
+	// (POST /trash/{id}/restore)
+	RestoreFromTrash(ctx echo.Context, id openapi_types.UUID) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -5103,6 +7382,24 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// VerifyProjections converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyProjections(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VerifyProjectionsParams
+	// ------------- Optional query parameter "sample" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "sample", ctx.QueryParams(), &params.Sample, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sample: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyProjections(ctx, params)
+	return err
+}
+
 // GetAhnentafel converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAhnentafel(ctx echo.Context) error {
 	var err error
@@ -5130,6 +7427,13 @@ func (w *ServerInterfaceWrapper) GetAhnentafel(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "include_sources" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_sources", ctx.QueryParams(), &params.IncludeSources, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_sources: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetAhnentafel(ctx, id, params)
 	return err
@@ -5253,8 +7557,83 @@ func (w *ServerInterfaceWrapper) UpdateAssociation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateAssociationParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateAssociation(ctx, id, params)
+	return err
+}
+
+// SetAttributeResearchStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) SetAttributeResearchStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetAttributeResearchStatus(ctx, id)
+	return err
+}
+
+// ExecuteBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecuteBatch(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecuteBatch(ctx)
+	return err
+}
+
+// BrowseBirthDecades converts echo context to params.
+func (w *ServerInterfaceWrapper) BrowseBirthDecades(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BrowseBirthDecades(ctx)
+	return err
+}
+
+// GetPersonsByBirthDecade converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPersonsByBirthDecade(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "decade" -------------
+	var decade string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "decade", ctx.Param("decade"), &decade, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter decade: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPersonsByBirthDecadeParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateAssociation(ctx, id)
+	err = w.Handler.GetPersonsByBirthDecade(ctx, decade, params)
 	return err
 }
 
@@ -5367,6 +7746,47 @@ func (w *ServerInterfaceWrapper) GetPersonsByPlace(ctx echo.Context) error {
 	return err
 }
 
+// BrowseSourceRepositories converts echo context to params.
+func (w *ServerInterfaceWrapper) BrowseSourceRepositories(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BrowseSourceRepositories(ctx)
+	return err
+}
+
+// GetSourcesByRepository converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSourcesByRepository(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSourcesByRepositoryParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSourcesByRepository(ctx, name, params)
+	return err
+}
+
 // BrowseSurnames converts echo context to params.
 func (w *ServerInterfaceWrapper) BrowseSurnames(ctx echo.Context) error {
 	var err error
@@ -5528,8 +7948,17 @@ func (w *ServerInterfaceWrapper) UpdateCitation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateCitationParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateCitation(ctx, id)
+	err = w.Handler.UpdateCitation(ctx, id, params)
 	return err
 }
 
@@ -5549,6 +7978,31 @@ func (w *ServerInterfaceWrapper) FormatCitation(ctx echo.Context) error {
 	return err
 }
 
+// GetFormattedCitation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFormattedCitation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFormattedCitationParams
+	// ------------- Optional query parameter "style" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "style", ctx.QueryParams(), &params.Style, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter style: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFormattedCitation(ctx, id, params)
+	return err
+}
+
 // GetCitationRestorePoints converts echo context to params.
 func (w *ServerInterfaceWrapper) GetCitationRestorePoints(ctx echo.Context) error {
 	var err error
@@ -5617,11 +8071,41 @@ func (w *ServerInterfaceWrapper) GetDescendancy(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter generations: %s", err))
 	}
 
+	// ------------- Optional query parameter "include_deceased" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_deceased", ctx.QueryParams(), &params.IncludeDeceased, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deceased: %s", err))
+	}
+
+	// ------------- Optional query parameter "birth_year_from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "birth_year_from", ctx.QueryParams(), &params.BirthYearFrom, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter birth_year_from: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetDescendancy(ctx, id, params)
 	return err
 }
 
+// SetEventResearchStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) SetEventResearchStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetEventResearchStatus(ctx, id)
+	return err
+}
+
 // ListEvidenceAnalyses converts echo context to params.
 func (w *ServerInterfaceWrapper) ListEvidenceAnalyses(ctx echo.Context) error {
 	var err error
@@ -5747,8 +8231,17 @@ func (w *ServerInterfaceWrapper) UpdateEvidenceAnalysis(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateEvidenceAnalysisParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateEvidenceAnalysis(ctx, id)
+	err = w.Handler.UpdateEvidenceAnalysis(ctx, id, params)
 	return err
 }
 
@@ -5841,6 +8334,15 @@ func (w *ServerInterfaceWrapper) ExportAttributes(ctx echo.Context) error {
 	return err
 }
 
+// ExportBySurname converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportBySurname(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportBySurname(ctx)
+	return err
+}
+
 // ExportCitations converts echo context to params.
 func (w *ServerInterfaceWrapper) ExportCitations(ctx echo.Context) error {
 	var err error
@@ -5886,6 +8388,15 @@ func (w *ServerInterfaceWrapper) ExportPersons(ctx echo.Context) error {
 	return err
 }
 
+// ExportSelection converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportSelection(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportSelection(ctx)
+	return err
+}
+
 // ExportSources converts echo context to params.
 func (w *ServerInterfaceWrapper) ExportSources(ctx echo.Context) error {
 	var err error
@@ -5904,6 +8415,15 @@ func (w *ServerInterfaceWrapper) ExportTree(ctx echo.Context) error {
 	return err
 }
 
+// ListFactTypes converts echo context to params.
+func (w *ServerInterfaceWrapper) ListFactTypes(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListFactTypes(ctx)
+	return err
+}
+
 // ListFamilies converts echo context to params.
 func (w *ServerInterfaceWrapper) ListFamilies(ctx echo.Context) error {
 	var err error
@@ -5938,6 +8458,31 @@ func (w *ServerInterfaceWrapper) CreateFamily(ctx echo.Context) error {
 	return err
 }
 
+// SearchFamilies converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchFamilies(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchFamiliesParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "q", ctx.QueryParams(), &params.Q, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SearchFamilies(ctx, params)
+	return err
+}
+
 // DeleteFamily converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteFamily(ctx echo.Context) error {
 	var err error
@@ -5965,8 +8510,28 @@ func (w *ServerInterfaceWrapper) GetFamily(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFamilyParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetFamily(ctx, id)
+	err = w.Handler.GetFamily(ctx, id, params)
 	return err
 }
 
@@ -5981,8 +8546,34 @@ func (w *ServerInterfaceWrapper) UpdateFamily(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateFamilyParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateFamily(ctx, id)
+	err = w.Handler.UpdateFamily(ctx, id, params)
 	return err
 }
 
@@ -6151,6 +8742,13 @@ func (w *ServerInterfaceWrapper) ExportGedcom(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
 
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include", ctx.QueryParams(), &params.Include, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ExportGedcom(ctx, params)
 	return err
@@ -6196,6 +8794,13 @@ func (w *ServerInterfaceWrapper) ListHistory(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entity_type: %s", err))
 	}
 
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "action", ctx.QueryParams(), &params.Action, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
 	// ------------- Optional query parameter "from" -------------
 
 	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", ctx.QueryParams(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
@@ -6329,8 +8934,17 @@ func (w *ServerInterfaceWrapper) UpdateLDSOrdinance(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateLDSOrdinanceParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateLDSOrdinance(ctx, id)
+	err = w.Handler.UpdateLDSOrdinance(ctx, id, params)
 	return err
 }
 
@@ -6343,11 +8957,20 @@ func (w *ServerInterfaceWrapper) GetMapLocations(ctx echo.Context) error {
 	return err
 }
 
-// DeleteMedia converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteMedia(ctx echo.Context) error {
+// ListDuplicateMedia converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDuplicateMedia(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDuplicateMedia(ctx)
+	return err
+}
+
+// DeleteMedia converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMedia(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
@@ -6395,8 +9018,17 @@ func (w *ServerInterfaceWrapper) UpdateMedia(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateMediaParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateMedia(ctx, id)
+	err = w.Handler.UpdateMedia(ctx, id, params)
 	return err
 }
 
@@ -6427,8 +9059,17 @@ func (w *ServerInterfaceWrapper) GetMediaThumbnail(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMediaThumbnailParams
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "size", ctx.QueryParams(), &params.Size, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetMediaThumbnail(ctx, id)
+	err = w.Handler.GetMediaThumbnail(ctx, id, params)
 	return err
 }
 
@@ -6459,6 +9100,13 @@ func (w *ServerInterfaceWrapper) ListNotes(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
 	}
 
+	// ------------- Optional query parameter "entityId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "entityId", ctx.QueryParams(), &params.EntityId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityId: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ListNotes(ctx, params)
 	return err
@@ -6525,8 +9173,17 @@ func (w *ServerInterfaceWrapper) UpdateNote(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateNoteParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateNote(ctx, id)
+	err = w.Handler.UpdateNote(ctx, id, params)
 	return err
 }
 
@@ -6550,6 +9207,20 @@ func (w *ServerInterfaceWrapper) GetPedigree(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter generations: %s", err))
 	}
 
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", ctx.QueryParams(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_sources" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_sources", ctx.QueryParams(), &params.IncludeSources, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_sources: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetPedigree(ctx, id, params)
 	return err
@@ -6596,6 +9267,20 @@ func (w *ServerInterfaceWrapper) ListPersons(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter research_status: %s", err))
 	}
 
+	// ------------- Optional query parameter "surname" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "surname", ctx.QueryParams(), &params.Surname, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter surname: %s", err))
+	}
+
+	// ------------- Optional query parameter "gender" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "gender", ctx.QueryParams(), &params.Gender, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gender: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ListPersons(ctx, params)
 	return err
@@ -6610,6 +9295,15 @@ func (w *ServerInterfaceWrapper) CreatePerson(ctx echo.Context) error {
 	return err
 }
 
+// BulkDeletePersons converts echo context to params.
+func (w *ServerInterfaceWrapper) BulkDeletePersons(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BulkDeletePersons(ctx)
+	return err
+}
+
 // GetPersonsDuplicates converts echo context to params.
 func (w *ServerInterfaceWrapper) GetPersonsDuplicates(ctx echo.Context) error {
 	var err error
@@ -6686,6 +9380,31 @@ func (w *ServerInterfaceWrapper) BatchMergePersons(ctx echo.Context) error {
 	return err
 }
 
+// ListOrphanedPersons converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOrphanedPersons(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrphanedPersonsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOrphanedPersons(ctx, params)
+	return err
+}
+
 // DeletePerson converts echo context to params.
 func (w *ServerInterfaceWrapper) DeletePerson(ctx echo.Context) error {
 	var err error
@@ -6713,8 +9432,28 @@ func (w *ServerInterfaceWrapper) GetPerson(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPersonParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPerson(ctx, id)
+	err = w.Handler.GetPerson(ctx, id, params)
 	return err
 }
 
@@ -6729,8 +9468,34 @@ func (w *ServerInterfaceWrapper) UpdatePerson(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePersonParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdatePerson(ctx, id)
+	err = w.Handler.UpdatePerson(ctx, id, params)
 	return err
 }
 
@@ -6793,8 +9558,97 @@ func (w *ServerInterfaceWrapper) GetCitationsForPerson(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCitationsForPersonParams
+	// ------------- Optional query parameter "source_quality" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "source_quality", ctx.QueryParams(), &params.SourceQuality, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter source_quality: %s", err))
+	}
+
+	// ------------- Optional query parameter "evidence_type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "evidence_type", ctx.QueryParams(), &params.EvidenceType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter evidence_type: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCitationsForPerson(ctx, id, params)
+	return err
+}
+
+// GetEndOfLines converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEndOfLines(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEndOfLinesParams
+	// ------------- Optional query parameter "generations" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "generations", ctx.QueryParams(), &params.Generations, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter generations: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEndOfLines(ctx, id, params)
+	return err
+}
+
+// ExportPersonGedcom converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportPersonGedcom(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportPersonGedcomParams
+	// ------------- Required query parameter "mode" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "mode", ctx.QueryParams(), &params.Mode, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mode: %s", err))
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "version", ctx.QueryParams(), &params.Version, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportPersonGedcom(ctx, id, params)
+	return err
+}
+
+// AddPersonExternalId converts echo context to params.
+func (w *ServerInterfaceWrapper) AddPersonExternalId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCitationsForPerson(ctx, id)
+	err = w.Handler.AddPersonExternalId(ctx, id)
 	return err
 }
 
@@ -6830,6 +9684,31 @@ func (w *ServerInterfaceWrapper) GetPersonHistory(ctx echo.Context) error {
 	return err
 }
 
+// GetPersonKin converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPersonKin(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPersonKinParams
+	// ------------- Optional query parameter "maxDegree" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "maxDegree", ctx.QueryParams(), &params.MaxDegree, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxDegree: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPersonKin(ctx, id, params)
+	return err
+}
+
 // ListLDSOrdinancesForPerson converts echo context to params.
 func (w *ServerInterfaceWrapper) ListLDSOrdinancesForPerson(ctx echo.Context) error {
 	var err error
@@ -6894,6 +9773,22 @@ func (w *ServerInterfaceWrapper) UploadPersonMedia(ctx echo.Context) error {
 	return err
 }
 
+// DownloadPersonMediaArchive converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadPersonMediaArchive(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DownloadPersonMediaArchive(ctx, id)
+	return err
+}
+
 // GetPersonNames converts echo context to params.
 func (w *ServerInterfaceWrapper) GetPersonNames(ctx echo.Context) error {
 	var err error
@@ -6905,8 +9800,24 @@ func (w *ServerInterfaceWrapper) GetPersonNames(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPersonNamesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPersonNames(ctx, id)
+	err = w.Handler.GetPersonNames(ctx, id, params)
 	return err
 }
 
@@ -7022,6 +9933,40 @@ func (w *ServerInterfaceWrapper) RollbackPerson(ctx echo.Context) error {
 	return err
 }
 
+// GetPersonSourceReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPersonSourceReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPersonSourceReport(ctx, id)
+	return err
+}
+
+// MergePlaces converts echo context to params.
+func (w *ServerInterfaceWrapper) MergePlaces(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MergePlaces(ctx)
+	return err
+}
+
+// GetPlaceVariants converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPlaceVariants(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPlaceVariants(ctx)
+	return err
+}
+
 // ListProofSummaries converts echo context to params.
 func (w *ServerInterfaceWrapper) ListProofSummaries(ctx echo.Context) error {
 	var err error
@@ -7147,33 +10092,67 @@ func (w *ServerInterfaceWrapper) UpdateProofSummary(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateProofSummary(ctx, id)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateProofSummaryParams
+	// ------------- Optional query parameter "retry" -------------
 
-// GetQualityOverview converts echo context to params.
-func (w *ServerInterfaceWrapper) GetQualityOverview(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetQualityOverview(ctx)
+	err = w.Handler.UpdateProofSummary(ctx, id, params)
 	return err
 }
 
-// GetPersonQuality converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPersonQuality(ctx echo.Context) error {
+// GetQualityBySurname converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQualityBySurname(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "id" -------------
-	var id PersonId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQualityBySurnameParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPersonQuality(ctx, id)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetQualityBySurname(ctx, params)
+	return err
+}
+
+// GetQualityOverview converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQualityOverview(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetQualityOverview(ctx)
+	return err
+}
+
+// GetPersonQuality converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPersonQuality(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id PersonId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPersonQuality(ctx, id)
 	return err
 }
 
@@ -7181,8 +10160,17 @@ func (w *ServerInterfaceWrapper) GetPersonQuality(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetQualityReport(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQualityReportParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", ctx.QueryParams(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetQualityReport(ctx)
+	err = w.Handler.GetQualityReport(ctx, params)
 	return err
 }
 
@@ -7218,6 +10206,24 @@ func (w *ServerInterfaceWrapper) GetValidationIssues(ctx echo.Context) error {
 	return err
 }
 
+// ListRecentChanges converts echo context to params.
+func (w *ServerInterfaceWrapper) ListRecentChanges(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRecentChangesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListRecentChanges(ctx, params)
+	return err
+}
+
 // GetRelationship converts echo context to params.
 func (w *ServerInterfaceWrapper) GetRelationship(ctx echo.Context) error {
 	var err error
@@ -7242,6 +10248,31 @@ func (w *ServerInterfaceWrapper) GetRelationship(ctx echo.Context) error {
 	return err
 }
 
+// GetMissingDataReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMissingDataReport(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMissingDataReportParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMissingDataReport(ctx, params)
+	return err
+}
+
 // ListRepositories converts echo context to params.
 func (w *ServerInterfaceWrapper) ListRepositories(ctx echo.Context) error {
 	var err error
@@ -7342,8 +10373,17 @@ func (w *ServerInterfaceWrapper) UpdateRepository(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateRepositoryParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateRepository(ctx, id)
+	err = w.Handler.UpdateRepository(ctx, id, params)
 	return err
 }
 
@@ -7463,8 +10503,17 @@ func (w *ServerInterfaceWrapper) UpdateResearchLog(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateResearchLogParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateResearchLog(ctx, id)
+	err = w.Handler.UpdateResearchLog(ctx, id, params)
 	return err
 }
 
@@ -7495,6 +10544,13 @@ func (w *ServerInterfaceWrapper) SearchPersons(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter soundex: %s", err))
 	}
 
+	// ------------- Optional query parameter "algorithm" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "algorithm", ctx.QueryParams(), &params.Algorithm, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter algorithm: %s", err))
+	}
+
 	// ------------- Optional query parameter "birth_date_from" -------------
 
 	err = runtime.BindQueryParameterWithOptions("form", true, false, "birth_date_from", ctx.QueryParams(), &params.BirthDateFrom, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
@@ -7563,6 +10619,15 @@ func (w *ServerInterfaceWrapper) SearchPersons(ctx echo.Context) error {
 	return err
 }
 
+// AdvancedSearchPersons converts echo context to params.
+func (w *ServerInterfaceWrapper) AdvancedSearchPersons(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdvancedSearchPersons(ctx)
+	return err
+}
+
 // ListSnapshots converts echo context to params.
 func (w *ServerInterfaceWrapper) ListSnapshots(ctx echo.Context) error {
 	var err error
@@ -7753,8 +10818,28 @@ func (w *ServerInterfaceWrapper) GetSource(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSourceParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetSource(ctx, id)
+	err = w.Handler.GetSource(ctx, id, params)
 	return err
 }
 
@@ -7769,8 +10854,34 @@ func (w *ServerInterfaceWrapper) UpdateSource(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateSourceParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateSource(ctx, id)
+	err = w.Handler.UpdateSource(ctx, id, params)
 	return err
 }
 
@@ -7785,8 +10896,45 @@ func (w *ServerInterfaceWrapper) GetCitationsForSource(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCitationsForSourceParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "fact_type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "fact_type", ctx.QueryParams(), &params.FactType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fact_type: %s", err))
+	}
+
+	// ------------- Optional query parameter "source_quality" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "source_quality", ctx.QueryParams(), &params.SourceQuality, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter source_quality: %s", err))
+	}
+
+	// ------------- Optional query parameter "evidence_type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "evidence_type", ctx.QueryParams(), &params.EvidenceType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter evidence_type: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCitationsForSource(ctx, id)
+	err = w.Handler.GetCitationsForSource(ctx, id, params)
 	return err
 }
 
@@ -7870,6 +11018,22 @@ func (w *ServerInterfaceWrapper) RollbackSource(ctx echo.Context) error {
 	return err
 }
 
+// GetSourceUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSourceUsage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSourceUsage(ctx, id)
+	return err
+}
+
 // GetStatistics converts echo context to params.
 func (w *ServerInterfaceWrapper) GetStatistics(ctx echo.Context) error {
 	var err error
@@ -7879,6 +11043,15 @@ func (w *ServerInterfaceWrapper) GetStatistics(ctx echo.Context) error {
 	return err
 }
 
+// GetMarriageAgeStatistics converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMarriageAgeStatistics(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMarriageAgeStatistics(ctx)
+	return err
+}
+
 // ListSubmitters converts echo context to params.
 func (w *ServerInterfaceWrapper) ListSubmitters(ctx echo.Context) error {
 	var err error
@@ -7979,8 +11152,58 @@ func (w *ServerInterfaceWrapper) UpdateSubmitter(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateSubmitterParams
+	// ------------- Optional query parameter "retry" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "retry", ctx.QueryParams(), &params.Retry, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter retry: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateSubmitter(ctx, id, params)
+	return err
+}
+
+// ListTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTrash(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTrashParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTrash(ctx, params)
+	return err
+}
+
+// RestoreFromTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreFromTrash(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateSubmitter(ctx, id)
+	err = w.Handler.RestoreFromTrash(ctx, id)
 	return err
 }
 
@@ -8031,6 +11254,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 		Handler: si,
 	}
 
+	router.GET(options.BaseURL+"/admin/verify-projections", wrapper.VerifyProjections, options.OperationMiddlewares["verifyProjections"]...)
 	router.GET(options.BaseURL+"/ahnentafel/:id", wrapper.GetAhnentafel, options.OperationMiddlewares["getAhnentafel"]...)
 	router.GET(options.BaseURL+"/analytics/discovery", wrapper.GetDiscoveryFeed, options.OperationMiddlewares["getDiscoveryFeed"]...)
 	router.GET(options.BaseURL+"/associations", wrapper.ListAssociations, options.OperationMiddlewares["listAssociations"]...)
@@ -8038,11 +11262,17 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.DELETE(options.BaseURL+"/associations/:id", wrapper.DeleteAssociation, options.OperationMiddlewares["deleteAssociation"]...)
 	router.GET(options.BaseURL+"/associations/:id", wrapper.GetAssociation, options.OperationMiddlewares["getAssociation"]...)
 	router.PUT(options.BaseURL+"/associations/:id", wrapper.UpdateAssociation, options.OperationMiddlewares["updateAssociation"]...)
+	router.PUT(options.BaseURL+"/attributes/:id/research-status", wrapper.SetAttributeResearchStatus, options.OperationMiddlewares["setAttributeResearchStatus"]...)
+	router.POST(options.BaseURL+"/batch", wrapper.ExecuteBatch, options.OperationMiddlewares["executeBatch"]...)
+	router.GET(options.BaseURL+"/browse/birth-decades", wrapper.BrowseBirthDecades, options.OperationMiddlewares["browseBirthDecades"]...)
+	router.GET(options.BaseURL+"/browse/birth-decades/:decade", wrapper.GetPersonsByBirthDecade, options.OperationMiddlewares["getPersonsByBirthDecade"]...)
 	router.GET(options.BaseURL+"/browse/brick-walls", wrapper.GetBrickWalls, options.OperationMiddlewares["getBrickWalls"]...)
 	router.GET(options.BaseURL+"/browse/cemeteries", wrapper.BrowseCemeteries, options.OperationMiddlewares["browseCemeteries"]...)
 	router.GET(options.BaseURL+"/browse/cemeteries/:place/persons", wrapper.GetPersonsByCemetery, options.OperationMiddlewares["getPersonsByCemetery"]...)
 	router.GET(options.BaseURL+"/browse/places", wrapper.BrowsePlaces, options.OperationMiddlewares["browsePlaces"]...)
 	router.GET(options.BaseURL+"/browse/places/:place/persons", wrapper.GetPersonsByPlace, options.OperationMiddlewares["getPersonsByPlace"]...)
+	router.GET(options.BaseURL+"/browse/repositories", wrapper.BrowseSourceRepositories, options.OperationMiddlewares["browseSourceRepositories"]...)
+	router.GET(options.BaseURL+"/browse/repositories/:name", wrapper.GetSourcesByRepository, options.OperationMiddlewares["getSourcesByRepository"]...)
 	router.GET(options.BaseURL+"/browse/surnames", wrapper.BrowseSurnames, options.OperationMiddlewares["browseSurnames"]...)
 	router.GET(options.BaseURL+"/browse/surnames/:surname/persons", wrapper.GetPersonsBySurname, options.OperationMiddlewares["getPersonsBySurname"]...)
 	router.GET(options.BaseURL+"/citation-templates", wrapper.ListCitationTemplates, options.OperationMiddlewares["listCitationTemplates"]...)
@@ -8053,9 +11283,11 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/citations/:id", wrapper.GetCitation, options.OperationMiddlewares["getCitation"]...)
 	router.PUT(options.BaseURL+"/citations/:id", wrapper.UpdateCitation, options.OperationMiddlewares["updateCitation"]...)
 	router.GET(options.BaseURL+"/citations/:id/format", wrapper.FormatCitation, options.OperationMiddlewares["formatCitation"]...)
+	router.GET(options.BaseURL+"/citations/:id/formatted", wrapper.GetFormattedCitation, options.OperationMiddlewares["getFormattedCitation"]...)
 	router.GET(options.BaseURL+"/citations/:id/restore-points", wrapper.GetCitationRestorePoints, options.OperationMiddlewares["getCitationRestorePoints"]...)
 	router.POST(options.BaseURL+"/citations/:id/rollback", wrapper.RollbackCitation, options.OperationMiddlewares["rollbackCitation"]...)
 	router.GET(options.BaseURL+"/descendancy/:id", wrapper.GetDescendancy, options.OperationMiddlewares["getDescendancy"]...)
+	router.PUT(options.BaseURL+"/events/:id/research-status", wrapper.SetEventResearchStatus, options.OperationMiddlewares["setEventResearchStatus"]...)
 	router.GET(options.BaseURL+"/evidence-analyses", wrapper.ListEvidenceAnalyses, options.OperationMiddlewares["listEvidenceAnalyses"]...)
 	router.POST(options.BaseURL+"/evidence-analyses", wrapper.CreateEvidenceAnalysis, options.OperationMiddlewares["createEvidenceAnalysis"]...)
 	router.GET(options.BaseURL+"/evidence-analyses/by-fact", wrapper.GetAnalysesByFact, options.OperationMiddlewares["getAnalysesByFact"]...)
@@ -8067,15 +11299,19 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/evidence-conflicts/:id", wrapper.GetEvidenceConflict, options.OperationMiddlewares["getEvidenceConflict"]...)
 	router.POST(options.BaseURL+"/evidence-conflicts/:id/resolve", wrapper.ResolveEvidenceConflict, options.OperationMiddlewares["resolveEvidenceConflict"]...)
 	router.GET(options.BaseURL+"/export/attributes", wrapper.ExportAttributes, options.OperationMiddlewares["exportAttributes"]...)
+	router.POST(options.BaseURL+"/export/by-surname", wrapper.ExportBySurname, options.OperationMiddlewares["exportBySurname"]...)
 	router.GET(options.BaseURL+"/export/citations", wrapper.ExportCitations, options.OperationMiddlewares["exportCitations"]...)
 	router.GET(options.BaseURL+"/export/estimate", wrapper.GetExportEstimate, options.OperationMiddlewares["getExportEstimate"]...)
 	router.GET(options.BaseURL+"/export/events", wrapper.ExportEvents, options.OperationMiddlewares["exportEvents"]...)
 	router.GET(options.BaseURL+"/export/families", wrapper.ExportFamilies, options.OperationMiddlewares["exportFamilies"]...)
 	router.GET(options.BaseURL+"/export/persons", wrapper.ExportPersons, options.OperationMiddlewares["exportPersons"]...)
+	router.POST(options.BaseURL+"/export/selection", wrapper.ExportSelection, options.OperationMiddlewares["exportSelection"]...)
 	router.GET(options.BaseURL+"/export/sources", wrapper.ExportSources, options.OperationMiddlewares["exportSources"]...)
 	router.GET(options.BaseURL+"/export/tree", wrapper.ExportTree, options.OperationMiddlewares["exportTree"]...)
+	router.GET(options.BaseURL+"/fact-types", wrapper.ListFactTypes, options.OperationMiddlewares["listFactTypes"]...)
 	router.GET(options.BaseURL+"/families", wrapper.ListFamilies, options.OperationMiddlewares["listFamilies"]...)
 	router.POST(options.BaseURL+"/families", wrapper.CreateFamily, options.OperationMiddlewares["createFamily"]...)
+	router.GET(options.BaseURL+"/families/search", wrapper.SearchFamilies, options.OperationMiddlewares["searchFamilies"]...)
 	router.DELETE(options.BaseURL+"/families/:id", wrapper.DeleteFamily, options.OperationMiddlewares["deleteFamily"]...)
 	router.GET(options.BaseURL+"/families/:id", wrapper.GetFamily, options.OperationMiddlewares["getFamily"]...)
 	router.PUT(options.BaseURL+"/families/:id", wrapper.UpdateFamily, options.OperationMiddlewares["updateFamily"]...)
@@ -8096,6 +11332,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/lds-ordinances/:id", wrapper.GetLDSOrdinance, options.OperationMiddlewares["getLDSOrdinance"]...)
 	router.PUT(options.BaseURL+"/lds-ordinances/:id", wrapper.UpdateLDSOrdinance, options.OperationMiddlewares["updateLDSOrdinance"]...)
 	router.GET(options.BaseURL+"/map/locations", wrapper.GetMapLocations, options.OperationMiddlewares["getMapLocations"]...)
+	router.GET(options.BaseURL+"/media/duplicates", wrapper.ListDuplicateMedia, options.OperationMiddlewares["listDuplicateMedia"]...)
 	router.DELETE(options.BaseURL+"/media/:id", wrapper.DeleteMedia, options.OperationMiddlewares["deleteMedia"]...)
 	router.GET(options.BaseURL+"/media/:id", wrapper.GetMedia, options.OperationMiddlewares["getMedia"]...)
 	router.PUT(options.BaseURL+"/media/:id", wrapper.UpdateMedia, options.OperationMiddlewares["updateMedia"]...)
@@ -8109,11 +11346,13 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/pedigree/:id", wrapper.GetPedigree, options.OperationMiddlewares["getPedigree"]...)
 	router.GET(options.BaseURL+"/persons", wrapper.ListPersons, options.OperationMiddlewares["listPersons"]...)
 	router.POST(options.BaseURL+"/persons", wrapper.CreatePerson, options.OperationMiddlewares["createPerson"]...)
+	router.POST(options.BaseURL+"/persons/bulk-delete", wrapper.BulkDeletePersons, options.OperationMiddlewares["bulkDeletePersons"]...)
 	router.GET(options.BaseURL+"/persons/duplicates", wrapper.GetPersonsDuplicates, options.OperationMiddlewares["getPersonsDuplicates"]...)
 	router.POST(options.BaseURL+"/persons/duplicates/dismiss/batch", wrapper.BatchDismissDuplicates, options.OperationMiddlewares["batchDismissDuplicates"]...)
 	router.POST(options.BaseURL+"/persons/duplicates/:person1Id/:person2Id/dismiss", wrapper.DismissDuplicate, options.OperationMiddlewares["dismissDuplicate"]...)
 	router.POST(options.BaseURL+"/persons/merge", wrapper.MergePersons, options.OperationMiddlewares["mergePersons"]...)
 	router.POST(options.BaseURL+"/persons/merge/batch", wrapper.BatchMergePersons, options.OperationMiddlewares["batchMergePersons"]...)
+	router.GET(options.BaseURL+"/persons/orphans", wrapper.ListOrphanedPersons, options.OperationMiddlewares["listOrphanedPersons"]...)
 	router.DELETE(options.BaseURL+"/persons/:id", wrapper.DeletePerson, options.OperationMiddlewares["deletePerson"]...)
 	router.GET(options.BaseURL+"/persons/:id", wrapper.GetPerson, options.OperationMiddlewares["getPerson"]...)
 	router.PUT(options.BaseURL+"/persons/:id", wrapper.UpdatePerson, options.OperationMiddlewares["updatePerson"]...)
@@ -8121,27 +11360,38 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.DELETE(options.BaseURL+"/persons/:id/brick-wall", wrapper.ResolvePersonBrickWall, options.OperationMiddlewares["resolvePersonBrickWall"]...)
 	router.PUT(options.BaseURL+"/persons/:id/brick-wall", wrapper.SetPersonBrickWall, options.OperationMiddlewares["setPersonBrickWall"]...)
 	router.GET(options.BaseURL+"/persons/:id/citations", wrapper.GetCitationsForPerson, options.OperationMiddlewares["getCitationsForPerson"]...)
+	router.GET(options.BaseURL+"/persons/:id/end-of-lines", wrapper.GetEndOfLines, options.OperationMiddlewares["getEndOfLines"]...)
+	router.GET(options.BaseURL+"/persons/:id/export-gedcom", wrapper.ExportPersonGedcom, options.OperationMiddlewares["exportPersonGedcom"]...)
+	router.POST(options.BaseURL+"/persons/:id/external-ids", wrapper.AddPersonExternalId, options.OperationMiddlewares["addPersonExternalId"]...)
 	router.GET(options.BaseURL+"/persons/:id/history", wrapper.GetPersonHistory, options.OperationMiddlewares["getPersonHistory"]...)
+	router.GET(options.BaseURL+"/persons/:id/kin", wrapper.GetPersonKin, options.OperationMiddlewares["getPersonKin"]...)
 	router.GET(options.BaseURL+"/persons/:id/lds-ordinances", wrapper.ListLDSOrdinancesForPerson, options.OperationMiddlewares["listLDSOrdinancesForPerson"]...)
 	router.GET(options.BaseURL+"/persons/:id/media", wrapper.ListPersonMedia, options.OperationMiddlewares["listPersonMedia"]...)
 	router.POST(options.BaseURL+"/persons/:id/media", wrapper.UploadPersonMedia, options.OperationMiddlewares["uploadPersonMedia"]...)
+	router.GET(options.BaseURL+"/persons/:id/media/archive", wrapper.DownloadPersonMediaArchive, options.OperationMiddlewares["downloadPersonMediaArchive"]...)
 	router.GET(options.BaseURL+"/persons/:id/names", wrapper.GetPersonNames, options.OperationMiddlewares["getPersonNames"]...)
 	router.POST(options.BaseURL+"/persons/:id/names", wrapper.AddPersonName, options.OperationMiddlewares["addPersonName"]...)
 	router.DELETE(options.BaseURL+"/persons/:id/names/:nameId", wrapper.DeletePersonName, options.OperationMiddlewares["deletePersonName"]...)
 	router.PUT(options.BaseURL+"/persons/:id/names/:nameId", wrapper.UpdatePersonName, options.OperationMiddlewares["updatePersonName"]...)
 	router.GET(options.BaseURL+"/persons/:id/restore-points", wrapper.GetPersonRestorePoints, options.OperationMiddlewares["getPersonRestorePoints"]...)
 	router.POST(options.BaseURL+"/persons/:id/rollback", wrapper.RollbackPerson, options.OperationMiddlewares["rollbackPerson"]...)
+	router.GET(options.BaseURL+"/persons/:id/source-report", wrapper.GetPersonSourceReport, options.OperationMiddlewares["getPersonSourceReport"]...)
+	router.POST(options.BaseURL+"/places/merge", wrapper.MergePlaces, options.OperationMiddlewares["mergePlaces"]...)
+	router.GET(options.BaseURL+"/places/variants", wrapper.GetPlaceVariants, options.OperationMiddlewares["getPlaceVariants"]...)
 	router.GET(options.BaseURL+"/proof-summaries", wrapper.ListProofSummaries, options.OperationMiddlewares["listProofSummaries"]...)
 	router.POST(options.BaseURL+"/proof-summaries", wrapper.CreateProofSummary, options.OperationMiddlewares["createProofSummary"]...)
 	router.GET(options.BaseURL+"/proof-summaries/by-fact", wrapper.GetProofSummaryByFact, options.OperationMiddlewares["getProofSummaryByFact"]...)
 	router.DELETE(options.BaseURL+"/proof-summaries/:id", wrapper.DeleteProofSummary, options.OperationMiddlewares["deleteProofSummary"]...)
 	router.GET(options.BaseURL+"/proof-summaries/:id", wrapper.GetProofSummary, options.OperationMiddlewares["getProofSummary"]...)
 	router.PUT(options.BaseURL+"/proof-summaries/:id", wrapper.UpdateProofSummary, options.OperationMiddlewares["updateProofSummary"]...)
+	router.GET(options.BaseURL+"/quality/by-surname", wrapper.GetQualityBySurname, options.OperationMiddlewares["getQualityBySurname"]...)
 	router.GET(options.BaseURL+"/quality/overview", wrapper.GetQualityOverview, options.OperationMiddlewares["getQualityOverview"]...)
 	router.GET(options.BaseURL+"/quality/persons/:id", wrapper.GetPersonQuality, options.OperationMiddlewares["getPersonQuality"]...)
 	router.GET(options.BaseURL+"/quality/report", wrapper.GetQualityReport, options.OperationMiddlewares["getQualityReport"]...)
 	router.GET(options.BaseURL+"/quality/validation", wrapper.GetValidationIssues, options.OperationMiddlewares["getValidationIssues"]...)
+	router.GET(options.BaseURL+"/recent", wrapper.ListRecentChanges, options.OperationMiddlewares["listRecentChanges"]...)
 	router.GET(options.BaseURL+"/relationship/:personId1/:personId2", wrapper.GetRelationship, options.OperationMiddlewares["getRelationship"]...)
+	router.GET(options.BaseURL+"/reports/missing-data", wrapper.GetMissingDataReport, options.OperationMiddlewares["getMissingDataReport"]...)
 	router.GET(options.BaseURL+"/repositories", wrapper.ListRepositories, options.OperationMiddlewares["listRepositories"]...)
 	router.POST(options.BaseURL+"/repositories", wrapper.CreateRepository, options.OperationMiddlewares["createRepository"]...)
 	router.DELETE(options.BaseURL+"/repositories/:id", wrapper.DeleteRepository, options.OperationMiddlewares["deleteRepository"]...)
@@ -8154,6 +11404,7 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/research-logs/:id", wrapper.GetResearchLog, options.OperationMiddlewares["getResearchLog"]...)
 	router.PUT(options.BaseURL+"/research-logs/:id", wrapper.UpdateResearchLog, options.OperationMiddlewares["updateResearchLog"]...)
 	router.GET(options.BaseURL+"/search", wrapper.SearchPersons, options.OperationMiddlewares["searchPersons"]...)
+	router.POST(options.BaseURL+"/search/advanced", wrapper.AdvancedSearchPersons, options.OperationMiddlewares["advancedSearchPersons"]...)
 	router.GET(options.BaseURL+"/snapshots", wrapper.ListSnapshots, options.OperationMiddlewares["listSnapshots"]...)
 	router.POST(options.BaseURL+"/snapshots", wrapper.CreateSnapshot, options.OperationMiddlewares["createSnapshot"]...)
 	router.GET(options.BaseURL+"/snapshots/:id1/compare/:id2", wrapper.CompareSnapshots, options.OperationMiddlewares["compareSnapshots"]...)
@@ -8169,12 +11420,16 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/sources/:id/history", wrapper.GetSourceHistory, options.OperationMiddlewares["getSourceHistory"]...)
 	router.GET(options.BaseURL+"/sources/:id/restore-points", wrapper.GetSourceRestorePoints, options.OperationMiddlewares["getSourceRestorePoints"]...)
 	router.POST(options.BaseURL+"/sources/:id/rollback", wrapper.RollbackSource, options.OperationMiddlewares["rollbackSource"]...)
+	router.GET(options.BaseURL+"/sources/:id/usage", wrapper.GetSourceUsage, options.OperationMiddlewares["getSourceUsage"]...)
 	router.GET(options.BaseURL+"/statistics", wrapper.GetStatistics, options.OperationMiddlewares["getStatistics"]...)
+	router.GET(options.BaseURL+"/statistics/marriage-age", wrapper.GetMarriageAgeStatistics, options.OperationMiddlewares["getMarriageAgeStatistics"]...)
 	router.GET(options.BaseURL+"/submitters", wrapper.ListSubmitters, options.OperationMiddlewares["listSubmitters"]...)
 	router.POST(options.BaseURL+"/submitters", wrapper.CreateSubmitter, options.OperationMiddlewares["createSubmitter"]...)
 	router.DELETE(options.BaseURL+"/submitters/:id", wrapper.DeleteSubmitter, options.OperationMiddlewares["deleteSubmitter"]...)
 	router.GET(options.BaseURL+"/submitters/:id", wrapper.GetSubmitter, options.OperationMiddlewares["getSubmitter"]...)
 	router.PUT(options.BaseURL+"/submitters/:id", wrapper.UpdateSubmitter, options.OperationMiddlewares["updateSubmitter"]...)
+	router.GET(options.BaseURL+"/trash", wrapper.ListTrash, options.OperationMiddlewares["listTrash"]...)
+	router.POST(options.BaseURL+"/trash/:id/restore", wrapper.RestoreFromTrash, options.OperationMiddlewares["restoreFromTrash"]...)
 
 }
 
@@ -8182,8 +11437,71 @@ type BadRequestJSONResponse Error
 
 type ConflictJSONResponse Error
 
+type ForbiddenJSONResponse Error
+
 type NotFoundJSONResponse Error
 
+type NotModifiedResponseHeaders struct {
+	ETag *string
+}
+type NotModifiedResponse struct {
+	Headers NotModifiedResponseHeaders
+}
+
+type PreconditionFailedJSONResponse Error
+
+type UnauthorizedJSONResponse Error
+
+type VerifyProjectionsRequestObject struct {
+	Params VerifyProjectionsParams
+}
+
+type VerifyProjectionsResponseObject interface {
+	VisitVerifyProjectionsResponse(w http.ResponseWriter) error
+}
+
+type VerifyProjections200JSONResponse ProjectionCheckResponse
+
+func (response VerifyProjections200JSONResponse) VisitVerifyProjectionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type VerifyProjections401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response VerifyProjections401JSONResponse) VisitVerifyProjectionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type VerifyProjections403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response VerifyProjections403JSONResponse) VisitVerifyProjectionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetAhnentafelRequestObject struct {
 	Id     PersonId `json:"id"`
 	Params GetAhnentafelParams
@@ -8436,8 +11754,9 @@ func (response GetAssociation404JSONResponse) VisitGetAssociationResponse(w http
 }
 
 type UpdateAssociationRequestObject struct {
-	Id   AssociationId `json:"id"`
-	Body *UpdateAssociationJSONRequestBody
+	Id     AssociationId `json:"id"`
+	Params UpdateAssociationParams
+	Body   *UpdateAssociationJSONRequestBody
 }
 
 type UpdateAssociationResponseObject interface {
@@ -8500,17 +11819,18 @@ func (response UpdateAssociation409JSONResponse) VisitUpdateAssociationResponse(
 	return err
 }
 
-type GetBrickWallsRequestObject struct {
-	Params GetBrickWallsParams
+type SetAttributeResearchStatusRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *SetAttributeResearchStatusJSONRequestBody
 }
 
-type GetBrickWallsResponseObject interface {
-	VisitGetBrickWallsResponse(w http.ResponseWriter) error
+type SetAttributeResearchStatusResponseObject interface {
+	VisitSetAttributeResearchStatusResponse(w http.ResponseWriter) error
 }
 
-type GetBrickWalls200JSONResponse BrickWallsResponse
+type SetAttributeResearchStatus200JSONResponse ResearchStatusUpdateResult
 
-func (response GetBrickWalls200JSONResponse) VisitGetBrickWallsResponse(w http.ResponseWriter) error {
+func (response SetAttributeResearchStatus200JSONResponse) VisitSetAttributeResearchStatusResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -8522,12 +11842,170 @@ func (response GetBrickWalls200JSONResponse) VisitGetBrickWallsResponse(w http.R
 	return err
 }
 
-type BrowseCemeteriesRequestObject struct {
-}
+type SetAttributeResearchStatus400JSONResponse struct{ BadRequestJSONResponse }
 
-type BrowseCemeteriesResponseObject interface {
-	VisitBrowseCemeteriesResponse(w http.ResponseWriter) error
-}
+func (response SetAttributeResearchStatus400JSONResponse) VisitSetAttributeResearchStatusResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SetAttributeResearchStatus404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetAttributeResearchStatus404JSONResponse) VisitSetAttributeResearchStatusResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SetAttributeResearchStatus409JSONResponse struct{ ConflictJSONResponse }
+
+func (response SetAttributeResearchStatus409JSONResponse) VisitSetAttributeResearchStatusResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ExecuteBatchRequestObject struct {
+	Body *ExecuteBatchJSONRequestBody
+}
+
+type ExecuteBatchResponseObject interface {
+	VisitExecuteBatchResponse(w http.ResponseWriter) error
+}
+
+type ExecuteBatch200JSONResponse BatchResult
+
+func (response ExecuteBatch200JSONResponse) VisitExecuteBatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ExecuteBatch400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExecuteBatch400JSONResponse) VisitExecuteBatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type BrowseBirthDecadesRequestObject struct {
+}
+
+type BrowseBirthDecadesResponseObject interface {
+	VisitBrowseBirthDecadesResponse(w http.ResponseWriter) error
+}
+
+type BrowseBirthDecades200JSONResponse BirthDecadeIndexResponse
+
+func (response BrowseBirthDecades200JSONResponse) VisitBrowseBirthDecadesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPersonsByBirthDecadeRequestObject struct {
+	Decade string `json:"decade"`
+	Params GetPersonsByBirthDecadeParams
+}
+
+type GetPersonsByBirthDecadeResponseObject interface {
+	VisitGetPersonsByBirthDecadeResponse(w http.ResponseWriter) error
+}
+
+type GetPersonsByBirthDecade200JSONResponse PersonList
+
+func (response GetPersonsByBirthDecade200JSONResponse) VisitGetPersonsByBirthDecadeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPersonsByBirthDecade400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPersonsByBirthDecade400JSONResponse) VisitGetPersonsByBirthDecadeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetBrickWallsRequestObject struct {
+	Params GetBrickWallsParams
+}
+
+type GetBrickWallsResponseObject interface {
+	VisitGetBrickWallsResponse(w http.ResponseWriter) error
+}
+
+type GetBrickWalls200JSONResponse BrickWallsResponse
+
+func (response GetBrickWalls200JSONResponse) VisitGetBrickWallsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type BrowseCemeteriesRequestObject struct {
+}
+
+type BrowseCemeteriesResponseObject interface {
+	VisitBrowseCemeteriesResponse(w http.ResponseWriter) error
+}
 
 type BrowseCemeteries200JSONResponse CemeteryIndexResponse
 
@@ -8611,6 +12089,50 @@ func (response GetPersonsByPlace200JSONResponse) VisitGetPersonsByPlaceResponse(
 	return err
 }
 
+type BrowseSourceRepositoriesRequestObject struct {
+}
+
+type BrowseSourceRepositoriesResponseObject interface {
+	VisitBrowseSourceRepositoriesResponse(w http.ResponseWriter) error
+}
+
+type BrowseSourceRepositories200JSONResponse SourceRepositoryIndexResponse
+
+func (response BrowseSourceRepositories200JSONResponse) VisitBrowseSourceRepositoriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetSourcesByRepositoryRequestObject struct {
+	Name   string `json:"name"`
+	Params GetSourcesByRepositoryParams
+}
+
+type GetSourcesByRepositoryResponseObject interface {
+	VisitGetSourcesByRepositoryResponse(w http.ResponseWriter) error
+}
+
+type GetSourcesByRepository200JSONResponse SourceList
+
+func (response GetSourcesByRepository200JSONResponse) VisitGetSourcesByRepositoryResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type BrowseSurnamesRequestObject struct {
 	Params BrowseSurnamesParams
 }
@@ -8911,8 +12433,9 @@ func (response GetCitation404JSONResponse) VisitGetCitationResponse(w http.Respo
 }
 
 type UpdateCitationRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateCitationJSONRequestBody
+	Id     openapi_types.UUID `json:"id"`
+	Params UpdateCitationParams
+	Body   *UpdateCitationJSONRequestBody
 }
 
 type UpdateCitationResponseObject interface {
@@ -9011,6 +12534,57 @@ func (response FormatCitation404JSONResponse) VisitFormatCitationResponse(w http
 	return err
 }
 
+type GetFormattedCitationRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetFormattedCitationParams
+}
+
+type GetFormattedCitationResponseObject interface {
+	VisitGetFormattedCitationResponse(w http.ResponseWriter) error
+}
+
+type GetFormattedCitation200JSONResponse CitationReference
+
+func (response GetFormattedCitation200JSONResponse) VisitGetFormattedCitationResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetFormattedCitation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetFormattedCitation400JSONResponse) VisitGetFormattedCitationResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetFormattedCitation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetFormattedCitation404JSONResponse) VisitGetFormattedCitationResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetCitationRestorePointsRequestObject struct {
 	Id     openapi_types.UUID `json:"id"`
 	Params GetCitationRestorePointsParams
@@ -9150,17 +12724,18 @@ func (response GetDescendancy404JSONResponse) VisitGetDescendancyResponse(w http
 	return err
 }
 
-type ListEvidenceAnalysesRequestObject struct {
-	Params ListEvidenceAnalysesParams
+type SetEventResearchStatusRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *SetEventResearchStatusJSONRequestBody
 }
 
-type ListEvidenceAnalysesResponseObject interface {
-	VisitListEvidenceAnalysesResponse(w http.ResponseWriter) error
+type SetEventResearchStatusResponseObject interface {
+	VisitSetEventResearchStatusResponse(w http.ResponseWriter) error
 }
 
-type ListEvidenceAnalyses200JSONResponse EvidenceAnalysisList
+type SetEventResearchStatus200JSONResponse ResearchStatusUpdateResult
 
-func (response ListEvidenceAnalyses200JSONResponse) VisitListEvidenceAnalysesResponse(w http.ResponseWriter) error {
+func (response SetEventResearchStatus200JSONResponse) VisitSetEventResearchStatusResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -9172,9 +12747,9 @@ func (response ListEvidenceAnalyses200JSONResponse) VisitListEvidenceAnalysesRes
 	return err
 }
 
-type ListEvidenceAnalyses400JSONResponse struct{ BadRequestJSONResponse }
+type SetEventResearchStatus400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListEvidenceAnalyses400JSONResponse) VisitListEvidenceAnalysesResponse(w http.ResponseWriter) error {
+func (response SetEventResearchStatus400JSONResponse) VisitSetEventResearchStatusResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -9186,53 +12761,45 @@ func (response ListEvidenceAnalyses400JSONResponse) VisitListEvidenceAnalysesRes
 	return err
 }
 
-type CreateEvidenceAnalysisRequestObject struct {
-	Body *CreateEvidenceAnalysisJSONRequestBody
-}
-
-type CreateEvidenceAnalysisResponseObject interface {
-	VisitCreateEvidenceAnalysisResponse(w http.ResponseWriter) error
-}
-
-type CreateEvidenceAnalysis201JSONResponse EvidenceAnalysis
+type SetEventResearchStatus404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response CreateEvidenceAnalysis201JSONResponse) VisitCreateEvidenceAnalysisResponse(w http.ResponseWriter) error {
+func (response SetEventResearchStatus404JSONResponse) VisitSetEventResearchStatusResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type CreateEvidenceAnalysis400JSONResponse struct{ BadRequestJSONResponse }
+type SetEventResearchStatus409JSONResponse struct{ ConflictJSONResponse }
 
-func (response CreateEvidenceAnalysis400JSONResponse) VisitCreateEvidenceAnalysisResponse(w http.ResponseWriter) error {
+func (response SetEventResearchStatus409JSONResponse) VisitSetEventResearchStatusResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetAnalysesByFactRequestObject struct {
-	Params GetAnalysesByFactParams
+type ListEvidenceAnalysesRequestObject struct {
+	Params ListEvidenceAnalysesParams
 }
 
-type GetAnalysesByFactResponseObject interface {
-	VisitGetAnalysesByFactResponse(w http.ResponseWriter) error
+type ListEvidenceAnalysesResponseObject interface {
+	VisitListEvidenceAnalysesResponse(w http.ResponseWriter) error
 }
 
-type GetAnalysesByFact200JSONResponse []EvidenceAnalysis
+type ListEvidenceAnalyses200JSONResponse EvidenceAnalysisList
 
-func (response GetAnalysesByFact200JSONResponse) VisitGetAnalysesByFactResponse(w http.ResponseWriter) error {
+func (response ListEvidenceAnalyses200JSONResponse) VisitListEvidenceAnalysesResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -9244,9 +12811,9 @@ func (response GetAnalysesByFact200JSONResponse) VisitGetAnalysesByFactResponse(
 	return err
 }
 
-type GetAnalysesByFact400JSONResponse struct{ BadRequestJSONResponse }
+type ListEvidenceAnalyses400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetAnalysesByFact400JSONResponse) VisitGetAnalysesByFactResponse(w http.ResponseWriter) error {
+func (response ListEvidenceAnalyses400JSONResponse) VisitListEvidenceAnalysesResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -9258,9 +12825,81 @@ func (response GetAnalysesByFact400JSONResponse) VisitGetAnalysesByFactResponse(
 	return err
 }
 
-type DeleteEvidenceAnalysisRequestObject struct {
-	Id     EvidenceAnalysisId `json:"id"`
-	Params DeleteEvidenceAnalysisParams
+type CreateEvidenceAnalysisRequestObject struct {
+	Body *CreateEvidenceAnalysisJSONRequestBody
+}
+
+type CreateEvidenceAnalysisResponseObject interface {
+	VisitCreateEvidenceAnalysisResponse(w http.ResponseWriter) error
+}
+
+type CreateEvidenceAnalysis201JSONResponse EvidenceAnalysis
+
+func (response CreateEvidenceAnalysis201JSONResponse) VisitCreateEvidenceAnalysisResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEvidenceAnalysis400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateEvidenceAnalysis400JSONResponse) VisitCreateEvidenceAnalysisResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetAnalysesByFactRequestObject struct {
+	Params GetAnalysesByFactParams
+}
+
+type GetAnalysesByFactResponseObject interface {
+	VisitGetAnalysesByFactResponse(w http.ResponseWriter) error
+}
+
+type GetAnalysesByFact200JSONResponse []EvidenceAnalysis
+
+func (response GetAnalysesByFact200JSONResponse) VisitGetAnalysesByFactResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetAnalysesByFact400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetAnalysesByFact400JSONResponse) VisitGetAnalysesByFactResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteEvidenceAnalysisRequestObject struct {
+	Id     EvidenceAnalysisId `json:"id"`
+	Params DeleteEvidenceAnalysisParams
 }
 
 type DeleteEvidenceAnalysisResponseObject interface {
@@ -9354,8 +12993,9 @@ func (response GetEvidenceAnalysis404JSONResponse) VisitGetEvidenceAnalysisRespo
 }
 
 type UpdateEvidenceAnalysisRequestObject struct {
-	Id   EvidenceAnalysisId `json:"id"`
-	Body *UpdateEvidenceAnalysisJSONRequestBody
+	Id     EvidenceAnalysisId `json:"id"`
+	Params UpdateEvidenceAnalysisParams
+	Body   *UpdateEvidenceAnalysisJSONRequestBody
 }
 
 type UpdateEvidenceAnalysisResponseObject interface {
@@ -9598,6 +13238,56 @@ func (response ExportAttributes200JSONResponse) VisitExportAttributesResponse(w
 	return err
 }
 
+type ExportBySurnameRequestObject struct {
+	Body *ExportBySurnameJSONRequestBody
+}
+
+type ExportBySurnameResponseObject interface {
+	VisitExportBySurnameResponse(w http.ResponseWriter) error
+}
+
+type ExportBySurname200ResponseHeaders struct {
+	ContentDisposition *string
+}
+
+type ExportBySurname200ApplicationzipResponse struct {
+	Body          io.Reader
+	Headers       ExportBySurname200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportBySurname200ApplicationzipResponse) VisitExportBySurnameResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportBySurname400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportBySurname400JSONResponse) VisitExportBySurnameResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type ExportCitationsRequestObject struct {
 }
 
@@ -9712,6 +13402,93 @@ func (response ExportPersons200JSONResponse) VisitExportPersonsResponse(w http.R
 	return err
 }
 
+type ExportSelectionRequestObject struct {
+	Body *ExportSelectionJSONRequestBody
+}
+
+type ExportSelectionResponseObject interface {
+	VisitExportSelectionResponse(w http.ResponseWriter) error
+}
+
+type ExportSelection200ResponseHeaders struct {
+	ContentDisposition *string
+}
+
+type ExportSelection200JSONResponse struct {
+	Body struct {
+		Families *[]Family `json:"families,omitempty"`
+		Persons  *[]Person `json:"persons,omitempty"`
+	}
+	Headers ExportSelection200ResponseHeaders
+}
+
+func (response ExportSelection200JSONResponse) VisitExportSelectionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ExportSelection200ApplicationxGedcomResponse struct {
+	Body          io.Reader
+	Headers       ExportSelection200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportSelection200ApplicationxGedcomResponse) VisitExportSelectionResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "application/x-gedcom")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportSelection400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportSelection400JSONResponse) VisitExportSelectionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ExportSelection404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ExportSelection404JSONResponse) VisitExportSelectionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type ExportSourcesRequestObject struct {
 }
 
@@ -9760,6 +13537,27 @@ func (response ExportTree200JSONResponse) VisitExportTreeResponse(w http.Respons
 	return err
 }
 
+type ListFactTypesRequestObject struct {
+}
+
+type ListFactTypesResponseObject interface {
+	VisitListFactTypesResponse(w http.ResponseWriter) error
+}
+
+type ListFactTypes200JSONResponse FactTypeIndexResponse
+
+func (response ListFactTypes200JSONResponse) VisitListFactTypesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type ListFamiliesRequestObject struct {
 	Params ListFamiliesParams
 }
@@ -9818,6 +13616,42 @@ func (response CreateFamily400JSONResponse) VisitCreateFamilyResponse(w http.Res
 	return err
 }
 
+type SearchFamiliesRequestObject struct {
+	Params SearchFamiliesParams
+}
+
+type SearchFamiliesResponseObject interface {
+	VisitSearchFamiliesResponse(w http.ResponseWriter) error
+}
+
+type SearchFamilies200JSONResponse FamilySearchResults
+
+func (response SearchFamilies200JSONResponse) VisitSearchFamiliesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SearchFamilies400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SearchFamilies400JSONResponse) VisitSearchFamiliesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type DeleteFamilyRequestObject struct {
 	Id FamilyId `json:"id"`
 }
@@ -9849,27 +13683,48 @@ func (response DeleteFamily404JSONResponse) VisitDeleteFamilyResponse(w http.Res
 }
 
 type GetFamilyRequestObject struct {
-	Id FamilyId `json:"id"`
+	Id     FamilyId `json:"id"`
+	Params GetFamilyParams
 }
 
 type GetFamilyResponseObject interface {
 	VisitGetFamilyResponse(w http.ResponseWriter) error
 }
 
-type GetFamily200JSONResponse FamilyDetail
+type GetFamily200ResponseHeaders struct {
+	ETag *string
+}
+
+type GetFamily200JSONResponse struct {
+	Body    FamilyDetail
+	Headers GetFamily200ResponseHeaders
+}
 
 func (response GetFamily200JSONResponse) VisitGetFamilyResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
 	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
+type GetFamily304Response = NotModifiedResponse
+
+func (response GetFamily304Response) VisitGetFamilyResponse(w http.ResponseWriter) error {
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	w.WriteHeader(304)
+	return nil
+}
+
 type GetFamily404JSONResponse struct{ NotFoundJSONResponse }
 
 func (response GetFamily404JSONResponse) VisitGetFamilyResponse(w http.ResponseWriter) error {
@@ -9885,8 +13740,9 @@ func (response GetFamily404JSONResponse) VisitGetFamilyResponse(w http.ResponseW
 }
 
 type UpdateFamilyRequestObject struct {
-	Id   FamilyId `json:"id"`
-	Body *UpdateFamilyJSONRequestBody
+	Id     FamilyId `json:"id"`
+	Params UpdateFamilyParams
+	Body   *UpdateFamilyJSONRequestBody
 }
 
 type UpdateFamilyResponseObject interface {
@@ -9935,6 +13791,20 @@ func (response UpdateFamily404JSONResponse) VisitUpdateFamilyResponse(w http.Res
 	return err
 }
 
+type UpdateFamily412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response UpdateFamily412JSONResponse) VisitUpdateFamilyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type AddChildToFamilyRequestObject struct {
 	Id   FamilyId `json:"id"`
 	Body *AddChildToFamilyJSONRequestBody
@@ -10364,13 +14234,27 @@ func (response ImportGedcom400JSONResponse) VisitImportGedcomResponse(w http.Res
 	return err
 }
 
-type ListHistoryRequestObject struct {
-	Params ListHistoryParams
-}
+type ImportGedcom413JSONResponse Error
 
-type ListHistoryResponseObject interface {
-	VisitListHistoryResponse(w http.ResponseWriter) error
-}
+func (response ImportGedcom413JSONResponse) VisitImportGedcomResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListHistoryRequestObject struct {
+	Params ListHistoryParams
+}
+
+type ListHistoryResponseObject interface {
+	VisitListHistoryResponse(w http.ResponseWriter) error
+}
 
 type ListHistory200JSONResponse ChangeHistoryResponse
 
@@ -10568,8 +14452,9 @@ func (response GetLDSOrdinance404JSONResponse) VisitGetLDSOrdinanceResponse(w ht
 }
 
 type UpdateLDSOrdinanceRequestObject struct {
-	Id   LdsOrdinanceId `json:"id"`
-	Body *UpdateLDSOrdinanceJSONRequestBody
+	Id     LdsOrdinanceId `json:"id"`
+	Params UpdateLDSOrdinanceParams
+	Body   *UpdateLDSOrdinanceJSONRequestBody
 }
 
 type UpdateLDSOrdinanceResponseObject interface {
@@ -10653,6 +14538,27 @@ func (response GetMapLocations200JSONResponse) VisitGetMapLocationsResponse(w ht
 	return err
 }
 
+type ListDuplicateMediaRequestObject struct {
+}
+
+type ListDuplicateMediaResponseObject interface {
+	VisitListDuplicateMediaResponse(w http.ResponseWriter) error
+}
+
+type ListDuplicateMedia200JSONResponse MediaDuplicatesReport
+
+func (response ListDuplicateMedia200JSONResponse) VisitListDuplicateMediaResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type DeleteMediaRequestObject struct {
 	Id     openapi_types.UUID `json:"id"`
 	Params DeleteMediaParams
@@ -10749,8 +14655,9 @@ func (response GetMedia404JSONResponse) VisitGetMediaResponse(w http.ResponseWri
 }
 
 type UpdateMediaRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateMediaJSONRequestBody
+	Id     openapi_types.UUID `json:"id"`
+	Params UpdateMediaParams
+	Body   *UpdateMediaJSONRequestBody
 }
 
 type UpdateMediaResponseObject interface {
@@ -10861,6 +14768,46 @@ func (response DownloadMedia200ApplicationpdfResponse) VisitDownloadMediaRespons
 	return err
 }
 
+type DownloadMedia200AudiompegResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response DownloadMedia200AudiompegResponse) VisitDownloadMediaResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "audio/mpeg")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadMedia200AudiowaveResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response DownloadMedia200AudiowaveResponse) VisitDownloadMediaResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "audio/wave")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
 type DownloadMedia200ImagegifResponse struct {
 	Body          io.Reader
 	ContentLength int64
@@ -10921,6 +14868,46 @@ func (response DownloadMedia200ImagepngResponse) VisitDownloadMediaResponse(w ht
 	return err
 }
 
+type DownloadMedia200Videomp4Response struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response DownloadMedia200Videomp4Response) VisitDownloadMediaResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "video/mp4")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadMedia200VideowebmResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response DownloadMedia200VideowebmResponse) VisitDownloadMediaResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "video/webm")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
 type DownloadMedia404JSONResponse struct{ NotFoundJSONResponse }
 
 func (response DownloadMedia404JSONResponse) VisitDownloadMediaResponse(w http.ResponseWriter) error {
@@ -10936,7 +14923,8 @@ func (response DownloadMedia404JSONResponse) VisitDownloadMediaResponse(w http.R
 }
 
 type GetMediaThumbnailRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	Id     openapi_types.UUID `json:"id"`
+	Params GetMediaThumbnailParams
 }
 
 type GetMediaThumbnailResponseObject interface {
@@ -10963,6 +14951,20 @@ func (response GetMediaThumbnail200ImagejpegResponse) VisitGetMediaThumbnailResp
 	return err
 }
 
+type GetMediaThumbnail400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetMediaThumbnail400JSONResponse) VisitGetMediaThumbnailResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetMediaThumbnail404JSONResponse struct{ NotFoundJSONResponse }
 
 func (response GetMediaThumbnail404JSONResponse) VisitGetMediaThumbnailResponse(w http.ResponseWriter) error {
@@ -11145,8 +15147,9 @@ func (response GetNote404JSONResponse) VisitGetNoteResponse(w http.ResponseWrite
 }
 
 type UpdateNoteRequestObject struct {
-	Id   NoteId `json:"id"`
-	Body *UpdateNoteJSONRequestBody
+	Id     NoteId `json:"id"`
+	Params UpdateNoteParams
+	Body   *UpdateNoteJSONRequestBody
 }
 
 type UpdateNoteResponseObject interface {
@@ -11232,6 +15235,31 @@ func (response GetPedigree200JSONResponse) VisitGetPedigreeResponse(w http.Respo
 	return err
 }
 
+type GetPedigree200TextResponse string
+
+func (response GetPedigree200TextResponse) VisitGetPedigreeResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetPedigree400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPedigree400JSONResponse) VisitGetPedigreeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetPedigree404JSONResponse struct{ NotFoundJSONResponse }
 
 func (response GetPedigree404JSONResponse) VisitGetPedigreeResponse(w http.ResponseWriter) error {
@@ -11318,6 +15346,42 @@ func (response CreatePerson400JSONResponse) VisitCreatePersonResponse(w http.Res
 	return err
 }
 
+type BulkDeletePersonsRequestObject struct {
+	Body *BulkDeletePersonsJSONRequestBody
+}
+
+type BulkDeletePersonsResponseObject interface {
+	VisitBulkDeletePersonsResponse(w http.ResponseWriter) error
+}
+
+type BulkDeletePersons200JSONResponse BulkDeleteResponse
+
+func (response BulkDeletePersons200JSONResponse) VisitBulkDeletePersonsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type BulkDeletePersons400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response BulkDeletePersons400JSONResponse) VisitBulkDeletePersonsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetPersonsDuplicatesRequestObject struct {
 	Params GetPersonsDuplicatesParams
 }
@@ -11522,6 +15586,28 @@ func (response BatchMergePersons400JSONResponse) VisitBatchMergePersonsResponse(
 	return err
 }
 
+type ListOrphanedPersonsRequestObject struct {
+	Params ListOrphanedPersonsParams
+}
+
+type ListOrphanedPersonsResponseObject interface {
+	VisitListOrphanedPersonsResponse(w http.ResponseWriter) error
+}
+
+type ListOrphanedPersons200JSONResponse PersonList
+
+func (response ListOrphanedPersons200JSONResponse) VisitListOrphanedPersonsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type DeletePersonRequestObject struct {
 	Id PersonId `json:"id"`
 }
@@ -11567,27 +15653,48 @@ func (response DeletePerson409JSONResponse) VisitDeletePersonResponse(w http.Res
 }
 
 type GetPersonRequestObject struct {
-	Id PersonId `json:"id"`
+	Id     PersonId `json:"id"`
+	Params GetPersonParams
 }
 
 type GetPersonResponseObject interface {
 	VisitGetPersonResponse(w http.ResponseWriter) error
 }
 
-type GetPerson200JSONResponse PersonDetail
+type GetPerson200ResponseHeaders struct {
+	ETag *string
+}
+
+type GetPerson200JSONResponse struct {
+	Body    PersonDetail
+	Headers GetPerson200ResponseHeaders
+}
 
 func (response GetPerson200JSONResponse) VisitGetPersonResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
 	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
+type GetPerson304Response = NotModifiedResponse
+
+func (response GetPerson304Response) VisitGetPersonResponse(w http.ResponseWriter) error {
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	w.WriteHeader(304)
+	return nil
+}
+
 type GetPerson404JSONResponse struct{ NotFoundJSONResponse }
 
 func (response GetPerson404JSONResponse) VisitGetPersonResponse(w http.ResponseWriter) error {
@@ -11603,8 +15710,9 @@ func (response GetPerson404JSONResponse) VisitGetPersonResponse(w http.ResponseW
 }
 
 type UpdatePersonRequestObject struct {
-	Id   PersonId `json:"id"`
-	Body *UpdatePersonJSONRequestBody
+	Id     PersonId `json:"id"`
+	Params UpdatePersonParams
+	Body   *UpdatePersonJSONRequestBody
 }
 
 type UpdatePersonResponseObject interface {
@@ -11667,6 +15775,20 @@ func (response UpdatePerson409JSONResponse) VisitUpdatePersonResponse(w http.Res
 	return err
 }
 
+type UpdatePerson412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response UpdatePerson412JSONResponse) VisitUpdatePersonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type ListAssociationsForPersonRequestObject struct {
 	Id PersonId `json:"id"`
 }
@@ -11765,7 +15887,8 @@ func (response SetPersonBrickWall404JSONResponse) VisitSetPersonBrickWallRespons
 }
 
 type GetCitationsForPersonRequestObject struct {
-	Id PersonId `json:"id"`
+	Id     PersonId `json:"id"`
+	Params GetCitationsForPersonParams
 }
 
 type GetCitationsForPersonResponseObject interface {
@@ -11786,6 +15909,20 @@ func (response GetCitationsForPerson200JSONResponse) VisitGetCitationsForPersonR
 	return err
 }
 
+type GetCitationsForPerson400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCitationsForPerson400JSONResponse) VisitGetCitationsForPersonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetCitationsForPerson404JSONResponse struct{ NotFoundJSONResponse }
 
 func (response GetCitationsForPerson404JSONResponse) VisitGetCitationsForPersonResponse(w http.ResponseWriter) error {
@@ -11800,6 +15937,159 @@ func (response GetCitationsForPerson404JSONResponse) VisitGetCitationsForPersonR
 	return err
 }
 
+type GetEndOfLinesRequestObject struct {
+	Id     PersonId `json:"id"`
+	Params GetEndOfLinesParams
+}
+
+type GetEndOfLinesResponseObject interface {
+	VisitGetEndOfLinesResponse(w http.ResponseWriter) error
+}
+
+type GetEndOfLines200JSONResponse EndOfLinesResponse
+
+func (response GetEndOfLines200JSONResponse) VisitGetEndOfLinesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEndOfLines404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetEndOfLines404JSONResponse) VisitGetEndOfLinesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ExportPersonGedcomRequestObject struct {
+	Id     PersonId `json:"id"`
+	Params ExportPersonGedcomParams
+}
+
+type ExportPersonGedcomResponseObject interface {
+	VisitExportPersonGedcomResponse(w http.ResponseWriter) error
+}
+
+type ExportPersonGedcom200ResponseHeaders struct {
+	ContentDisposition *string
+}
+
+type ExportPersonGedcom200ApplicationxGedcomResponse struct {
+	Body          io.Reader
+	Headers       ExportPersonGedcom200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportPersonGedcom200ApplicationxGedcomResponse) VisitExportPersonGedcomResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "application/x-gedcom")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportPersonGedcom400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportPersonGedcom400JSONResponse) VisitExportPersonGedcomResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ExportPersonGedcom404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ExportPersonGedcom404JSONResponse) VisitExportPersonGedcomResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AddPersonExternalIdRequestObject struct {
+	Id   PersonId `json:"id"`
+	Body *AddPersonExternalIdJSONRequestBody
+}
+
+type AddPersonExternalIdResponseObject interface {
+	VisitAddPersonExternalIdResponse(w http.ResponseWriter) error
+}
+
+type AddPersonExternalId201JSONResponse ExternalLink
+
+func (response AddPersonExternalId201JSONResponse) VisitAddPersonExternalIdResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AddPersonExternalId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddPersonExternalId400JSONResponse) VisitAddPersonExternalIdResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AddPersonExternalId404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddPersonExternalId404JSONResponse) VisitAddPersonExternalIdResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetPersonHistoryRequestObject struct {
 	Id     PersonId `json:"id"`
 	Params GetPersonHistoryParams
@@ -11837,6 +16127,43 @@ func (response GetPersonHistory404JSONResponse) VisitGetPersonHistoryResponse(w
 	return err
 }
 
+type GetPersonKinRequestObject struct {
+	Id     PersonId `json:"id"`
+	Params GetPersonKinParams
+}
+
+type GetPersonKinResponseObject interface {
+	VisitGetPersonKinResponse(w http.ResponseWriter) error
+}
+
+type GetPersonKin200JSONResponse KinReport
+
+func (response GetPersonKin200JSONResponse) VisitGetPersonKinResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPersonKin404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetPersonKin404JSONResponse) VisitGetPersonKinResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type ListLDSOrdinancesForPersonRequestObject struct {
 	Id PersonId `json:"id"`
 }
@@ -11975,10 +16302,61 @@ func (response UploadPersonMedia413JSONResponse) VisitUploadPersonMediaResponse(
 	return err
 }
 
-type GetPersonNamesRequestObject struct {
+type DownloadPersonMediaArchiveRequestObject struct {
 	Id PersonId `json:"id"`
 }
 
+type DownloadPersonMediaArchiveResponseObject interface {
+	VisitDownloadPersonMediaArchiveResponse(w http.ResponseWriter) error
+}
+
+type DownloadPersonMediaArchive200ResponseHeaders struct {
+	ContentDisposition *string
+}
+
+type DownloadPersonMediaArchive200ApplicationzipResponse struct {
+	Body          io.Reader
+	Headers       DownloadPersonMediaArchive200ResponseHeaders
+	ContentLength int64
+}
+
+func (response DownloadPersonMediaArchive200ApplicationzipResponse) VisitDownloadPersonMediaArchiveResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadPersonMediaArchive404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DownloadPersonMediaArchive404JSONResponse) VisitDownloadPersonMediaArchiveResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPersonNamesRequestObject struct {
+	Id     PersonId `json:"id"`
+	Params GetPersonNamesParams
+}
+
 type GetPersonNamesResponseObject interface {
 	VisitGetPersonNamesResponse(w http.ResponseWriter) error
 }
@@ -12081,21 +16459,110 @@ func (response DeletePersonName204Response) VisitDeletePersonNameResponse(w http
 
 type DeletePersonName400JSONResponse Error
 
-func (response DeletePersonName400JSONResponse) VisitDeletePersonNameResponse(w http.ResponseWriter) error {
+func (response DeletePersonName400JSONResponse) VisitDeletePersonNameResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePersonName404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeletePersonName404JSONResponse) VisitDeletePersonNameResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdatePersonNameRequestObject struct {
+	Id     PersonId           `json:"id"`
+	NameId openapi_types.UUID `json:"nameId"`
+	Body   *UpdatePersonNameJSONRequestBody
+}
+
+type UpdatePersonNameResponseObject interface {
+	VisitUpdatePersonNameResponse(w http.ResponseWriter) error
+}
+
+type UpdatePersonName200JSONResponse PersonName
+
+func (response UpdatePersonName200JSONResponse) VisitUpdatePersonNameResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdatePersonName400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdatePersonName400JSONResponse) VisitUpdatePersonNameResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdatePersonName404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdatePersonName404JSONResponse) VisitUpdatePersonNameResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPersonRestorePointsRequestObject struct {
+	Id     PersonId `json:"id"`
+	Params GetPersonRestorePointsParams
+}
+
+type GetPersonRestorePointsResponseObject interface {
+	VisitGetPersonRestorePointsResponse(w http.ResponseWriter) error
+}
+
+type GetPersonRestorePoints200JSONResponse RestorePointsResponse
+
+func (response GetPersonRestorePoints200JSONResponse) VisitGetPersonRestorePointsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type DeletePersonName404JSONResponse struct{ NotFoundJSONResponse }
+type GetPersonRestorePoints404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeletePersonName404JSONResponse) VisitDeletePersonNameResponse(w http.ResponseWriter) error {
+func (response GetPersonRestorePoints404JSONResponse) VisitGetPersonRestorePointsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -12107,19 +16574,18 @@ func (response DeletePersonName404JSONResponse) VisitDeletePersonNameResponse(w
 	return err
 }
 
-type UpdatePersonNameRequestObject struct {
-	Id     PersonId           `json:"id"`
-	NameId openapi_types.UUID `json:"nameId"`
-	Body   *UpdatePersonNameJSONRequestBody
+type RollbackPersonRequestObject struct {
+	Id   PersonId `json:"id"`
+	Body *RollbackPersonJSONRequestBody
 }
 
-type UpdatePersonNameResponseObject interface {
-	VisitUpdatePersonNameResponse(w http.ResponseWriter) error
+type RollbackPersonResponseObject interface {
+	VisitRollbackPersonResponse(w http.ResponseWriter) error
 }
 
-type UpdatePersonName200JSONResponse PersonName
+type RollbackPerson200JSONResponse RollbackResponse
 
-func (response UpdatePersonName200JSONResponse) VisitUpdatePersonNameResponse(w http.ResponseWriter) error {
+func (response RollbackPerson200JSONResponse) VisitRollbackPersonResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -12131,9 +16597,9 @@ func (response UpdatePersonName200JSONResponse) VisitUpdatePersonNameResponse(w
 	return err
 }
 
-type UpdatePersonName400JSONResponse struct{ BadRequestJSONResponse }
+type RollbackPerson400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpdatePersonName400JSONResponse) VisitUpdatePersonNameResponse(w http.ResponseWriter) error {
+func (response RollbackPerson400JSONResponse) VisitRollbackPersonResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -12145,9 +16611,9 @@ func (response UpdatePersonName400JSONResponse) VisitUpdatePersonNameResponse(w
 	return err
 }
 
-type UpdatePersonName404JSONResponse struct{ NotFoundJSONResponse }
+type RollbackPerson404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response UpdatePersonName404JSONResponse) VisitUpdatePersonNameResponse(w http.ResponseWriter) error {
+func (response RollbackPerson404JSONResponse) VisitRollbackPersonResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -12159,18 +16625,31 @@ func (response UpdatePersonName404JSONResponse) VisitUpdatePersonNameResponse(w
 	return err
 }
 
-type GetPersonRestorePointsRequestObject struct {
-	Id     PersonId `json:"id"`
-	Params GetPersonRestorePointsParams
+type RollbackPerson409JSONResponse Error
+
+func (response RollbackPerson409JSONResponse) VisitRollbackPersonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type GetPersonRestorePointsResponseObject interface {
-	VisitGetPersonRestorePointsResponse(w http.ResponseWriter) error
+type GetPersonSourceReportRequestObject struct {
+	Id PersonId `json:"id"`
 }
 
-type GetPersonRestorePoints200JSONResponse RestorePointsResponse
+type GetPersonSourceReportResponseObject interface {
+	VisitGetPersonSourceReportResponse(w http.ResponseWriter) error
+}
 
-func (response GetPersonRestorePoints200JSONResponse) VisitGetPersonRestorePointsResponse(w http.ResponseWriter) error {
+type GetPersonSourceReport200JSONResponse SourceReport
+
+func (response GetPersonSourceReport200JSONResponse) VisitGetPersonSourceReportResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -12182,9 +16661,9 @@ func (response GetPersonRestorePoints200JSONResponse) VisitGetPersonRestorePoint
 	return err
 }
 
-type GetPersonRestorePoints404JSONResponse struct{ NotFoundJSONResponse }
+type GetPersonSourceReport404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetPersonRestorePoints404JSONResponse) VisitGetPersonRestorePointsResponse(w http.ResponseWriter) error {
+func (response GetPersonSourceReport404JSONResponse) VisitGetPersonSourceReportResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -12196,18 +16675,17 @@ func (response GetPersonRestorePoints404JSONResponse) VisitGetPersonRestorePoint
 	return err
 }
 
-type RollbackPersonRequestObject struct {
-	Id   PersonId `json:"id"`
-	Body *RollbackPersonJSONRequestBody
+type MergePlacesRequestObject struct {
+	Body *MergePlacesJSONRequestBody
 }
 
-type RollbackPersonResponseObject interface {
-	VisitRollbackPersonResponse(w http.ResponseWriter) error
+type MergePlacesResponseObject interface {
+	VisitMergePlacesResponse(w http.ResponseWriter) error
 }
 
-type RollbackPerson200JSONResponse RollbackResponse
+type MergePlaces200JSONResponse PlaceMergeResult
 
-func (response RollbackPerson200JSONResponse) VisitRollbackPersonResponse(w http.ResponseWriter) error {
+func (response MergePlaces200JSONResponse) VisitMergePlacesResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -12219,9 +16697,9 @@ func (response RollbackPerson200JSONResponse) VisitRollbackPersonResponse(w http
 	return err
 }
 
-type RollbackPerson400JSONResponse struct{ BadRequestJSONResponse }
+type MergePlaces400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response RollbackPerson400JSONResponse) VisitRollbackPersonResponse(w http.ResponseWriter) error {
+func (response MergePlaces400JSONResponse) VisitMergePlacesResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -12233,30 +16711,37 @@ func (response RollbackPerson400JSONResponse) VisitRollbackPersonResponse(w http
 	return err
 }
 
-type RollbackPerson404JSONResponse struct{ NotFoundJSONResponse }
+type MergePlaces409JSONResponse struct{ ConflictJSONResponse }
 
-func (response RollbackPerson404JSONResponse) VisitRollbackPersonResponse(w http.ResponseWriter) error {
+func (response MergePlaces409JSONResponse) VisitMergePlacesResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type RollbackPerson409JSONResponse Error
+type GetPlaceVariantsRequestObject struct {
+}
 
-func (response RollbackPerson409JSONResponse) VisitRollbackPersonResponse(w http.ResponseWriter) error {
+type GetPlaceVariantsResponseObject interface {
+	VisitGetPlaceVariantsResponse(w http.ResponseWriter) error
+}
+
+type GetPlaceVariants200JSONResponse PlaceVariantsResponse
+
+func (response GetPlaceVariants200JSONResponse) VisitGetPlaceVariantsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
@@ -12465,8 +16950,9 @@ func (response GetProofSummary404JSONResponse) VisitGetProofSummaryResponse(w ht
 }
 
 type UpdateProofSummaryRequestObject struct {
-	Id   ProofSummaryId `json:"id"`
-	Body *UpdateProofSummaryJSONRequestBody
+	Id     ProofSummaryId `json:"id"`
+	Params UpdateProofSummaryParams
+	Body   *UpdateProofSummaryJSONRequestBody
 }
 
 type UpdateProofSummaryResponseObject interface {
@@ -12529,6 +17015,28 @@ func (response UpdateProofSummary409JSONResponse) VisitUpdateProofSummaryRespons
 	return err
 }
 
+type GetQualityBySurnameRequestObject struct {
+	Params GetQualityBySurnameParams
+}
+
+type GetQualityBySurnameResponseObject interface {
+	VisitGetQualityBySurnameResponse(w http.ResponseWriter) error
+}
+
+type GetQualityBySurname200JSONResponse SurnameQualityReport
+
+func (response GetQualityBySurname200JSONResponse) VisitGetQualityBySurnameResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetQualityOverviewRequestObject struct {
 }
 
@@ -12587,22 +17095,95 @@ func (response GetPersonQuality404JSONResponse) VisitGetPersonQualityResponse(w
 }
 
 type GetQualityReportRequestObject struct {
+	Params GetQualityReportParams
 }
 
 type GetQualityReportResponseObject interface {
 	VisitGetQualityReportResponse(w http.ResponseWriter) error
 }
 
-type GetQualityReport200JSONResponse QualityReport
+type GetQualityReport200ResponseHeaders struct {
+	ContentDisposition *string
+}
+
+type GetQualityReport200JSONResponse struct {
+	Body    QualityReport
+	Headers GetQualityReport200ResponseHeaders
+}
 
 func (response GetQualityReport200JSONResponse) VisitGetQualityReportResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetQualityReport200ApplicationpdfResponse struct {
+	Body          io.Reader
+	Headers       GetQualityReport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetQualityReport200ApplicationpdfResponse) VisitGetQualityReportResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetQualityReport200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetQualityReport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetQualityReport200TextcsvResponse) VisitGetQualityReportResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
 	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetQualityReport400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetQualityReport400JSONResponse) VisitGetQualityReportResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
@@ -12629,6 +17210,28 @@ func (response GetValidationIssues200JSONResponse) VisitGetValidationIssuesRespo
 	return err
 }
 
+type ListRecentChangesRequestObject struct {
+	Params ListRecentChangesParams
+}
+
+type ListRecentChangesResponseObject interface {
+	VisitListRecentChangesResponse(w http.ResponseWriter) error
+}
+
+type ListRecentChanges200JSONResponse RecentChangesResponse
+
+func (response ListRecentChanges200JSONResponse) VisitListRecentChangesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetRelationshipRequestObject struct {
 	PersonId1 openapi_types.UUID `json:"personId1"`
 	PersonId2 openapi_types.UUID `json:"personId2"`
@@ -12666,6 +17269,28 @@ func (response GetRelationship404JSONResponse) VisitGetRelationshipResponse(w ht
 	return err
 }
 
+type GetMissingDataReportRequestObject struct {
+	Params GetMissingDataReportParams
+}
+
+type GetMissingDataReportResponseObject interface {
+	VisitGetMissingDataReportResponse(w http.ResponseWriter) error
+}
+
+type GetMissingDataReport200JSONResponse MissingDataReport
+
+func (response GetMissingDataReport200JSONResponse) VisitGetMissingDataReportResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type ListRepositoriesRequestObject struct {
 	Params ListRepositoriesParams
 }
@@ -12834,8 +17459,9 @@ func (response GetRepository404JSONResponse) VisitGetRepositoryResponse(w http.R
 }
 
 type UpdateRepositoryRequestObject struct {
-	Id   RepositoryId `json:"id"`
-	Body *UpdateRepositoryJSONRequestBody
+	Id     RepositoryId `json:"id"`
+	Params UpdateRepositoryParams
+	Body   *UpdateRepositoryJSONRequestBody
 }
 
 type UpdateRepositoryResponseObject interface {
@@ -13088,8 +17714,9 @@ func (response GetResearchLog404JSONResponse) VisitGetResearchLogResponse(w http
 }
 
 type UpdateResearchLogRequestObject struct {
-	Id   ResearchLogId `json:"id"`
-	Body *UpdateResearchLogJSONRequestBody
+	Id     ResearchLogId `json:"id"`
+	Params UpdateResearchLogParams
+	Body   *UpdateResearchLogJSONRequestBody
 }
 
 type UpdateResearchLogResponseObject interface {
@@ -13188,6 +17815,42 @@ func (response SearchPersons400JSONResponse) VisitSearchPersonsResponse(w http.R
 	return err
 }
 
+type AdvancedSearchPersonsRequestObject struct {
+	Body *AdvancedSearchPersonsJSONRequestBody
+}
+
+type AdvancedSearchPersonsResponseObject interface {
+	VisitAdvancedSearchPersonsResponse(w http.ResponseWriter) error
+}
+
+type AdvancedSearchPersons200JSONResponse SearchResults
+
+func (response AdvancedSearchPersons200JSONResponse) VisitAdvancedSearchPersonsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AdvancedSearchPersons400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AdvancedSearchPersons400JSONResponse) VisitAdvancedSearchPersonsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type ListSnapshotsRequestObject struct {
 }
 
@@ -13516,27 +18179,48 @@ func (response DeleteSource409JSONResponse) VisitDeleteSourceResponse(w http.Res
 }
 
 type GetSourceRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	Id     openapi_types.UUID `json:"id"`
+	Params GetSourceParams
 }
 
 type GetSourceResponseObject interface {
 	VisitGetSourceResponse(w http.ResponseWriter) error
 }
 
-type GetSource200JSONResponse SourceDetail
+type GetSource200ResponseHeaders struct {
+	ETag *string
+}
+
+type GetSource200JSONResponse struct {
+	Body    SourceDetail
+	Headers GetSource200ResponseHeaders
+}
 
 func (response GetSource200JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
 	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
+type GetSource304Response = NotModifiedResponse
+
+func (response GetSource304Response) VisitGetSourceResponse(w http.ResponseWriter) error {
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	w.WriteHeader(304)
+	return nil
+}
+
 type GetSource404JSONResponse struct{ NotFoundJSONResponse }
 
 func (response GetSource404JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
@@ -13552,8 +18236,9 @@ func (response GetSource404JSONResponse) VisitGetSourceResponse(w http.ResponseW
 }
 
 type UpdateSourceRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateSourceJSONRequestBody
+	Id     openapi_types.UUID `json:"id"`
+	Params UpdateSourceParams
+	Body   *UpdateSourceJSONRequestBody
 }
 
 type UpdateSourceResponseObject interface {
@@ -13616,8 +18301,23 @@ func (response UpdateSource409JSONResponse) VisitUpdateSourceResponse(w http.Res
 	return err
 }
 
+type UpdateSource412JSONResponse struct{ PreconditionFailedJSONResponse }
+
+func (response UpdateSource412JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetCitationsForSourceRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	Id     openapi_types.UUID `json:"id"`
+	Params GetCitationsForSourceParams
 }
 
 type GetCitationsForSourceResponseObject interface {
@@ -13626,14 +18326,28 @@ type GetCitationsForSourceResponseObject interface {
 
 type GetCitationsForSource200JSONResponse CitationList
 
-func (response GetCitationsForSource200JSONResponse) VisitGetCitationsForSourceResponse(w http.ResponseWriter) error {
+func (response GetCitationsForSource200JSONResponse) VisitGetCitationsForSourceResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetCitationsForSource400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCitationsForSource400JSONResponse) VisitGetCitationsForSourceResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
@@ -13791,6 +18505,42 @@ func (response RollbackSource409JSONResponse) VisitRollbackSourceResponse(w http
 	return err
 }
 
+type GetSourceUsageRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetSourceUsageResponseObject interface {
+	VisitGetSourceUsageResponse(w http.ResponseWriter) error
+}
+
+type GetSourceUsage200JSONResponse SourceUsage
+
+func (response GetSourceUsage200JSONResponse) VisitGetSourceUsageResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetSourceUsage404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSourceUsage404JSONResponse) VisitGetSourceUsageResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetStatisticsRequestObject struct {
 }
 
@@ -13812,6 +18562,27 @@ func (response GetStatistics200JSONResponse) VisitGetStatisticsResponse(w http.R
 	return err
 }
 
+type GetMarriageAgeStatisticsRequestObject struct {
+}
+
+type GetMarriageAgeStatisticsResponseObject interface {
+	VisitGetMarriageAgeStatisticsResponse(w http.ResponseWriter) error
+}
+
+type GetMarriageAgeStatistics200JSONResponse MarriageAgeStatistics
+
+func (response GetMarriageAgeStatistics200JSONResponse) VisitGetMarriageAgeStatisticsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type ListSubmittersRequestObject struct {
 	Params ListSubmittersParams
 }
@@ -13980,8 +18751,9 @@ func (response GetSubmitter404JSONResponse) VisitGetSubmitterResponse(w http.Res
 }
 
 type UpdateSubmitterRequestObject struct {
-	Id   SubmitterId `json:"id"`
-	Body *UpdateSubmitterJSONRequestBody
+	Id     SubmitterId `json:"id"`
+	Params UpdateSubmitterParams
+	Body   *UpdateSubmitterJSONRequestBody
 }
 
 type UpdateSubmitterResponseObject interface {
@@ -14044,8 +18816,83 @@ func (response UpdateSubmitter409JSONResponse) VisitUpdateSubmitterResponse(w ht
 	return err
 }
 
+type ListTrashRequestObject struct {
+	Params ListTrashParams
+}
+
+type ListTrashResponseObject interface {
+	VisitListTrashResponse(w http.ResponseWriter) error
+}
+
+type ListTrash200JSONResponse TrashResponse
+
+func (response ListTrash200JSONResponse) VisitListTrashResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RestoreFromTrashRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RestoreFromTrashResponseObject interface {
+	VisitRestoreFromTrashResponse(w http.ResponseWriter) error
+}
+
+type RestoreFromTrash200JSONResponse RollbackResponse
+
+func (response RestoreFromTrash200JSONResponse) VisitRestoreFromTrashResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RestoreFromTrash404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RestoreFromTrash404JSONResponse) VisitRestoreFromTrashResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RestoreFromTrash409JSONResponse Error
+
+func (response RestoreFromTrash409JSONResponse) VisitRestoreFromTrashResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Compare read models against the event log
+	// (GET /admin/verify-projections)
+	VerifyProjections(ctx context.Context, request VerifyProjectionsRequestObject) (VerifyProjectionsResponseObject, error)
 	// Get Ahnentafel (ancestor table) report for a person
 	// (GET /ahnentafel/{id})
 	GetAhnentafel(ctx context.Context, request GetAhnentafelRequestObject) (GetAhnentafelResponseObject, error)
@@ -14067,6 +18914,18 @@ type StrictServerInterface interface {
 	// Update an association
 	// (PUT /associations/{id})
 	UpdateAssociation(ctx context.Context, request UpdateAssociationRequestObject) (UpdateAssociationResponseObject, error)
+	// Set the research status of a person attribute
+	// (PUT /attributes/{id}/research-status)
+	SetAttributeResearchStatus(ctx context.Context, request SetAttributeResearchStatusRequestObject) (SetAttributeResearchStatusResponseObject, error)
+	// Run several operations in one request
+	// (POST /batch)
+	ExecuteBatch(ctx context.Context, request ExecuteBatchRequestObject) (ExecuteBatchResponseObject, error)
+	// Get birth decade index with counts
+	// (GET /browse/birth-decades)
+	BrowseBirthDecades(ctx context.Context, request BrowseBirthDecadesRequestObject) (BrowseBirthDecadesResponseObject, error)
+	// Get persons born in a decade
+	// (GET /browse/birth-decades/{decade})
+	GetPersonsByBirthDecade(ctx context.Context, request GetPersonsByBirthDecadeRequestObject) (GetPersonsByBirthDecadeResponseObject, error)
 	// List brick wall research blocks
 	// (GET /browse/brick-walls)
 	GetBrickWalls(ctx context.Context, request GetBrickWallsRequestObject) (GetBrickWallsResponseObject, error)
@@ -14082,6 +18941,12 @@ type StrictServerInterface interface {
 	// Get persons associated with a place
 	// (GET /browse/places/{place}/persons)
 	GetPersonsByPlace(ctx context.Context, request GetPersonsByPlaceRequestObject) (GetPersonsByPlaceResponseObject, error)
+	// Get source repository index with counts
+	// (GET /browse/repositories)
+	BrowseSourceRepositories(ctx context.Context, request BrowseSourceRepositoriesRequestObject) (BrowseSourceRepositoriesResponseObject, error)
+	// Get sources held by a repository
+	// (GET /browse/repositories/{name})
+	GetSourcesByRepository(ctx context.Context, request GetSourcesByRepositoryRequestObject) (GetSourcesByRepositoryResponseObject, error)
 	// Get surname index with counts
 	// (GET /browse/surnames)
 	BrowseSurnames(ctx context.Context, request BrowseSurnamesRequestObject) (BrowseSurnamesResponseObject, error)
@@ -14112,6 +18977,9 @@ type StrictServerInterface interface {
 	// Format a citation using its template
 	// (GET /citations/{id}/format)
 	FormatCitation(ctx context.Context, request FormatCitationRequestObject) (FormatCitationResponseObject, error)
+	// Render a citation as footnote and bibliography text
+	// (GET /citations/{id}/formatted)
+	GetFormattedCitation(ctx context.Context, request GetFormattedCitationRequestObject) (GetFormattedCitationResponseObject, error)
 	// Get restore points for a citation
 	// (GET /citations/{id}/restore-points)
 	GetCitationRestorePoints(ctx context.Context, request GetCitationRestorePointsRequestObject) (GetCitationRestorePointsResponseObject, error)
@@ -14121,6 +18989,9 @@ type StrictServerInterface interface {
 	// Get descendancy tree for a person
 	// (GET /descendancy/{id})
 	GetDescendancy(ctx context.Context, request GetDescendancyRequestObject) (GetDescendancyResponseObject, error)
+	// Set the research status of a life event
+	// (PUT /events/{id}/research-status)
+	SetEventResearchStatus(ctx context.Context, request SetEventResearchStatusRequestObject) (SetEventResearchStatusResponseObject, error)
 	// List all evidence analyses
 	// (GET /evidence-analyses)
 	ListEvidenceAnalyses(ctx context.Context, request ListEvidenceAnalysesRequestObject) (ListEvidenceAnalysesResponseObject, error)
@@ -14154,6 +19025,9 @@ type StrictServerInterface interface {
 	// Export attributes data
 	// (GET /export/attributes)
 	ExportAttributes(ctx context.Context, request ExportAttributesRequestObject) (ExportAttributesResponseObject, error)
+	// Export one GEDCOM per surname as a ZIP
+	// (POST /export/by-surname)
+	ExportBySurname(ctx context.Context, request ExportBySurnameRequestObject) (ExportBySurnameResponseObject, error)
 	// Export citations data
 	// (GET /export/citations)
 	ExportCitations(ctx context.Context, request ExportCitationsRequestObject) (ExportCitationsResponseObject, error)
@@ -14169,18 +19043,27 @@ type StrictServerInterface interface {
 	// Export persons data
 	// (GET /export/persons)
 	ExportPersons(ctx context.Context, request ExportPersonsRequestObject) (ExportPersonsResponseObject, error)
+	// Export a selected set of persons
+	// (POST /export/selection)
+	ExportSelection(ctx context.Context, request ExportSelectionRequestObject) (ExportSelectionResponseObject, error)
 	// Export sources data
 	// (GET /export/sources)
 	ExportSources(ctx context.Context, request ExportSourcesRequestObject) (ExportSourcesResponseObject, error)
 	// Export full family tree
 	// (GET /export/tree)
 	ExportTree(ctx context.Context, request ExportTreeRequestObject) (ExportTreeResponseObject, error)
+	// List the fact types in use with counts
+	// (GET /fact-types)
+	ListFactTypes(ctx context.Context, request ListFactTypesRequestObject) (ListFactTypesResponseObject, error)
 	// List all families
 	// (GET /families)
 	ListFamilies(ctx context.Context, request ListFamiliesRequestObject) (ListFamiliesResponseObject, error)
 	// Create a new family
 	// (POST /families)
 	CreateFamily(ctx context.Context, request CreateFamilyRequestObject) (CreateFamilyResponseObject, error)
+	// Search families by partner name
+	// (GET /families/search)
+	SearchFamilies(ctx context.Context, request SearchFamiliesRequestObject) (SearchFamiliesResponseObject, error)
 	// Delete a family
 	// (DELETE /families/{id})
 	DeleteFamily(ctx context.Context, request DeleteFamilyRequestObject) (DeleteFamilyResponseObject, error)
@@ -14241,6 +19124,9 @@ type StrictServerInterface interface {
 	// Get geographic locations for map visualization
 	// (GET /map/locations)
 	GetMapLocations(ctx context.Context, request GetMapLocationsRequestObject) (GetMapLocationsResponseObject, error)
+	// Report media with identical file content
+	// (GET /media/duplicates)
+	ListDuplicateMedia(ctx context.Context, request ListDuplicateMediaRequestObject) (ListDuplicateMediaResponseObject, error)
 	// Delete media
 	// (DELETE /media/{id})
 	DeleteMedia(ctx context.Context, request DeleteMediaRequestObject) (DeleteMediaResponseObject, error)
@@ -14280,6 +19166,9 @@ type StrictServerInterface interface {
 	// Create a new person
 	// (POST /persons)
 	CreatePerson(ctx context.Context, request CreatePersonRequestObject) (CreatePersonResponseObject, error)
+	// Delete multiple persons
+	// (POST /persons/bulk-delete)
+	BulkDeletePersons(ctx context.Context, request BulkDeletePersonsRequestObject) (BulkDeletePersonsResponseObject, error)
 	// Find potential duplicate persons
 	// (GET /persons/duplicates)
 	GetPersonsDuplicates(ctx context.Context, request GetPersonsDuplicatesRequestObject) (GetPersonsDuplicatesResponseObject, error)
@@ -14295,6 +19184,9 @@ type StrictServerInterface interface {
 	// Batch merge multiple duplicate pairs
 	// (POST /persons/merge/batch)
 	BatchMergePersons(ctx context.Context, request BatchMergePersonsRequestObject) (BatchMergePersonsResponseObject, error)
+	// List persons with no family links
+	// (GET /persons/orphans)
+	ListOrphanedPersons(ctx context.Context, request ListOrphanedPersonsRequestObject) (ListOrphanedPersonsResponseObject, error)
 	// Delete a person
 	// (DELETE /persons/{id})
 	DeletePerson(ctx context.Context, request DeletePersonRequestObject) (DeletePersonResponseObject, error)
@@ -14316,9 +19208,21 @@ type StrictServerInterface interface {
 	// Get citations for a person
 	// (GET /persons/{id}/citations)
 	GetCitationsForPerson(ctx context.Context, request GetCitationsForPersonRequestObject) (GetCitationsForPersonResponseObject, error)
+	// List end-of-line ancestors for a person
+	// (GET /persons/{id}/end-of-lines)
+	GetEndOfLines(ctx context.Context, request GetEndOfLinesRequestObject) (GetEndOfLinesResponseObject, error)
+	// Export one branch of the tree as GEDCOM
+	// (GET /persons/{id}/export-gedcom)
+	ExportPersonGedcom(ctx context.Context, request ExportPersonGedcomRequestObject) (ExportPersonGedcomResponseObject, error)
+	// Link a person to an external tree
+	// (POST /persons/{id}/external-ids)
+	AddPersonExternalId(ctx context.Context, request AddPersonExternalIdRequestObject) (AddPersonExternalIdResponseObject, error)
 	// Get change history for a person
 	// (GET /persons/{id}/history)
 	GetPersonHistory(ctx context.Context, request GetPersonHistoryRequestObject) (GetPersonHistoryResponseObject, error)
+	// List a person's blood relatives with their relationship
+	// (GET /persons/{id}/kin)
+	GetPersonKin(ctx context.Context, request GetPersonKinRequestObject) (GetPersonKinResponseObject, error)
 	// List LDS ordinances for a person
 	// (GET /persons/{id}/lds-ordinances)
 	ListLDSOrdinancesForPerson(ctx context.Context, request ListLDSOrdinancesForPersonRequestObject) (ListLDSOrdinancesForPersonResponseObject, error)
@@ -14328,6 +19232,9 @@ type StrictServerInterface interface {
 	// Upload media to a person
 	// (POST /persons/{id}/media)
 	UploadPersonMedia(ctx context.Context, request UploadPersonMediaRequestObject) (UploadPersonMediaResponseObject, error)
+	// Download all media attached to a person as a ZIP
+	// (GET /persons/{id}/media/archive)
+	DownloadPersonMediaArchive(ctx context.Context, request DownloadPersonMediaArchiveRequestObject) (DownloadPersonMediaArchiveResponseObject, error)
 	// Get all names for a person
 	// (GET /persons/{id}/names)
 	GetPersonNames(ctx context.Context, request GetPersonNamesRequestObject) (GetPersonNamesResponseObject, error)
@@ -14346,6 +19253,15 @@ type StrictServerInterface interface {
 	// Rollback a person to a previous version
 	// (POST /persons/{id}/rollback)
 	RollbackPerson(ctx context.Context, request RollbackPersonRequestObject) (RollbackPersonResponseObject, error)
+	// Get citation coverage for each of a person's facts
+	// (GET /persons/{id}/source-report)
+	GetPersonSourceReport(ctx context.Context, request GetPersonSourceReportRequestObject) (GetPersonSourceReportResponseObject, error)
+	// Merge place name variants into a canonical name
+	// (POST /places/merge)
+	MergePlaces(ctx context.Context, request MergePlacesRequestObject) (MergePlacesResponseObject, error)
+	// Find clusters of similar place names
+	// (GET /places/variants)
+	GetPlaceVariants(ctx context.Context, request GetPlaceVariantsRequestObject) (GetPlaceVariantsResponseObject, error)
 	// List all proof summaries
 	// (GET /proof-summaries)
 	ListProofSummaries(ctx context.Context, request ListProofSummariesRequestObject) (ListProofSummariesResponseObject, error)
@@ -14364,6 +19280,9 @@ type StrictServerInterface interface {
 	// Update a proof summary
 	// (PUT /proof-summaries/{id})
 	UpdateProofSummary(ctx context.Context, request UpdateProofSummaryRequestObject) (UpdateProofSummaryResponseObject, error)
+	// Get quality by surname
+	// (GET /quality/by-surname)
+	GetQualityBySurname(ctx context.Context, request GetQualityBySurnameRequestObject) (GetQualityBySurnameResponseObject, error)
 	// Get aggregate quality metrics
 	// (GET /quality/overview)
 	GetQualityOverview(ctx context.Context, request GetQualityOverviewRequestObject) (GetQualityOverviewResponseObject, error)
@@ -14376,9 +19295,15 @@ type StrictServerInterface interface {
 	// Get validation issues
 	// (GET /quality/validation)
 	GetValidationIssues(ctx context.Context, request GetValidationIssuesRequestObject) (GetValidationIssuesResponseObject, error)
+	// List recently changed entities
+	// (GET /recent)
+	ListRecentChanges(ctx context.Context, request ListRecentChangesRequestObject) (ListRecentChangesResponseObject, error)
 	// Calculate relationship between two people
 	// (GET /relationship/{personId1}/{personId2})
 	GetRelationship(ctx context.Context, request GetRelationshipRequestObject) (GetRelationshipResponseObject, error)
+	// List persons with research gaps
+	// (GET /reports/missing-data)
+	GetMissingDataReport(ctx context.Context, request GetMissingDataReportRequestObject) (GetMissingDataReportResponseObject, error)
 	// List all repositories
 	// (GET /repositories)
 	ListRepositories(ctx context.Context, request ListRepositoriesRequestObject) (ListRepositoriesResponseObject, error)
@@ -14415,6 +19340,9 @@ type StrictServerInterface interface {
 	// Search for persons
 	// (GET /search)
 	SearchPersons(ctx context.Context, request SearchPersonsRequestObject) (SearchPersonsResponseObject, error)
+	// Search for persons by structured criteria
+	// (POST /search/advanced)
+	AdvancedSearchPersons(ctx context.Context, request AdvancedSearchPersonsRequestObject) (AdvancedSearchPersonsResponseObject, error)
 	// List all snapshots
 	// (GET /snapshots)
 	ListSnapshots(ctx context.Context, request ListSnapshotsRequestObject) (ListSnapshotsResponseObject, error)
@@ -14460,9 +19388,15 @@ type StrictServerInterface interface {
 	// Rollback a source to a previous version
 	// (POST /sources/{id}/rollback)
 	RollbackSource(ctx context.Context, request RollbackSourceRequestObject) (RollbackSourceResponseObject, error)
+	// Get the persons and families a source is cited for
+	// (GET /sources/{id}/usage)
+	GetSourceUsage(ctx context.Context, request GetSourceUsageRequestObject) (GetSourceUsageResponseObject, error)
 	// Get tree-wide statistics
 	// (GET /statistics)
 	GetStatistics(ctx context.Context, request GetStatisticsRequestObject) (GetStatisticsResponseObject, error)
+	// Get age at first marriage statistics
+	// (GET /statistics/marriage-age)
+	GetMarriageAgeStatistics(ctx context.Context, request GetMarriageAgeStatisticsRequestObject) (GetMarriageAgeStatisticsResponseObject, error)
 	// List all submitters
 	// (GET /submitters)
 	ListSubmitters(ctx context.Context, request ListSubmittersRequestObject) (ListSubmittersResponseObject, error)
@@ -14478,6 +19412,12 @@ type StrictServerInterface interface {
 	// Update a submitter
 	// (PUT /submitters/{id})
 	UpdateSubmitter(ctx context.Context, request UpdateSubmitterRequestObject) (UpdateSubmitterResponseObject, error)
+	// List deleted entities
+	// (GET /trash)
+	ListTrash(ctx context.Context, request ListTrashRequestObject) (ListTrashResponseObject, error)
+	// Restore a deleted entity
+	// (POST /trash/{id}/restore)
+	RestoreFromTrash(ctx context.Context, request RestoreFromTrashRequestObject) (RestoreFromTrashResponseObject, error)
 }
 
 type StrictHandlerFunc func(ctx echo.Context, request any) (any, error)
@@ -14492,6 +19432,31 @@ type strictHandler struct {
 	middlewares []StrictMiddlewareFunc
 }
 
+// VerifyProjections operation middleware
+func (sh *strictHandler) VerifyProjections(ctx echo.Context, params VerifyProjectionsParams) error {
+	var request VerifyProjectionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyProjections(ctx.Request().Context(), request.(VerifyProjectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyProjections")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(VerifyProjectionsResponseObject); ok {
+		return validResponse.VisitVerifyProjectionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetAhnentafel operation middleware
 func (sh *strictHandler) GetAhnentafel(ctx echo.Context, id PersonId, params GetAhnentafelParams) error {
 	var request GetAhnentafelRequestObject
@@ -14649,10 +19614,11 @@ func (sh *strictHandler) GetAssociation(ctx echo.Context, id AssociationId) erro
 }
 
 // UpdateAssociation operation middleware
-func (sh *strictHandler) UpdateAssociation(ctx echo.Context, id AssociationId) error {
+func (sh *strictHandler) UpdateAssociation(ctx echo.Context, id AssociationId, params UpdateAssociationParams) error {
 	var request UpdateAssociationRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateAssociationJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -14679,6 +19645,115 @@ func (sh *strictHandler) UpdateAssociation(ctx echo.Context, id AssociationId) e
 	return nil
 }
 
+// SetAttributeResearchStatus operation middleware
+func (sh *strictHandler) SetAttributeResearchStatus(ctx echo.Context, id openapi_types.UUID) error {
+	var request SetAttributeResearchStatusRequestObject
+
+	request.Id = id
+
+	var body SetAttributeResearchStatusJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetAttributeResearchStatus(ctx.Request().Context(), request.(SetAttributeResearchStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetAttributeResearchStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetAttributeResearchStatusResponseObject); ok {
+		return validResponse.VisitSetAttributeResearchStatusResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ExecuteBatch operation middleware
+func (sh *strictHandler) ExecuteBatch(ctx echo.Context) error {
+	var request ExecuteBatchRequestObject
+
+	var body ExecuteBatchJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ExecuteBatch(ctx.Request().Context(), request.(ExecuteBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExecuteBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ExecuteBatchResponseObject); ok {
+		return validResponse.VisitExecuteBatchResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// BrowseBirthDecades operation middleware
+func (sh *strictHandler) BrowseBirthDecades(ctx echo.Context) error {
+	var request BrowseBirthDecadesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BrowseBirthDecades(ctx.Request().Context(), request.(BrowseBirthDecadesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BrowseBirthDecades")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BrowseBirthDecadesResponseObject); ok {
+		return validResponse.VisitBrowseBirthDecadesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPersonsByBirthDecade operation middleware
+func (sh *strictHandler) GetPersonsByBirthDecade(ctx echo.Context, decade string, params GetPersonsByBirthDecadeParams) error {
+	var request GetPersonsByBirthDecadeRequestObject
+
+	request.Decade = decade
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPersonsByBirthDecade(ctx.Request().Context(), request.(GetPersonsByBirthDecadeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPersonsByBirthDecade")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPersonsByBirthDecadeResponseObject); ok {
+		return validResponse.VisitGetPersonsByBirthDecadeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetBrickWalls operation middleware
 func (sh *strictHandler) GetBrickWalls(ctx echo.Context, params GetBrickWallsParams) error {
 	var request GetBrickWallsRequestObject
@@ -14804,6 +19879,55 @@ func (sh *strictHandler) GetPersonsByPlace(ctx echo.Context, place string, param
 	return nil
 }
 
+// BrowseSourceRepositories operation middleware
+func (sh *strictHandler) BrowseSourceRepositories(ctx echo.Context) error {
+	var request BrowseSourceRepositoriesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BrowseSourceRepositories(ctx.Request().Context(), request.(BrowseSourceRepositoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BrowseSourceRepositories")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BrowseSourceRepositoriesResponseObject); ok {
+		return validResponse.VisitBrowseSourceRepositoriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSourcesByRepository operation middleware
+func (sh *strictHandler) GetSourcesByRepository(ctx echo.Context, name string, params GetSourcesByRepositoryParams) error {
+	var request GetSourcesByRepositoryRequestObject
+
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSourcesByRepository(ctx.Request().Context(), request.(GetSourcesByRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSourcesByRepository")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSourcesByRepositoryResponseObject); ok {
+		return validResponse.VisitGetSourcesByRepositoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // BrowseSurnames operation middleware
 func (sh *strictHandler) BrowseSurnames(ctx echo.Context, params BrowseSurnamesParams) error {
 	var request BrowseSurnamesRequestObject
@@ -15017,10 +20141,11 @@ func (sh *strictHandler) GetCitation(ctx echo.Context, id openapi_types.UUID) er
 }
 
 // UpdateCitation operation middleware
-func (sh *strictHandler) UpdateCitation(ctx echo.Context, id openapi_types.UUID) error {
+func (sh *strictHandler) UpdateCitation(ctx echo.Context, id openapi_types.UUID, params UpdateCitationParams) error {
 	var request UpdateCitationRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateCitationJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -15072,6 +20197,32 @@ func (sh *strictHandler) FormatCitation(ctx echo.Context, id openapi_types.UUID)
 	return nil
 }
 
+// GetFormattedCitation operation middleware
+func (sh *strictHandler) GetFormattedCitation(ctx echo.Context, id openapi_types.UUID, params GetFormattedCitationParams) error {
+	var request GetFormattedCitationRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFormattedCitation(ctx.Request().Context(), request.(GetFormattedCitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFormattedCitation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetFormattedCitationResponseObject); ok {
+		return validResponse.VisitGetFormattedCitationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetCitationRestorePoints operation middleware
 func (sh *strictHandler) GetCitationRestorePoints(ctx echo.Context, id openapi_types.UUID, params GetCitationRestorePointsParams) error {
 	var request GetCitationRestorePointsRequestObject
@@ -15155,6 +20306,37 @@ func (sh *strictHandler) GetDescendancy(ctx echo.Context, id PersonId, params Ge
 	return nil
 }
 
+// SetEventResearchStatus operation middleware
+func (sh *strictHandler) SetEventResearchStatus(ctx echo.Context, id openapi_types.UUID) error {
+	var request SetEventResearchStatusRequestObject
+
+	request.Id = id
+
+	var body SetEventResearchStatusJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetEventResearchStatus(ctx.Request().Context(), request.(SetEventResearchStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetEventResearchStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetEventResearchStatusResponseObject); ok {
+		return validResponse.VisitSetEventResearchStatusResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ListEvidenceAnalyses operation middleware
 func (sh *strictHandler) ListEvidenceAnalyses(ctx echo.Context, params ListEvidenceAnalysesParams) error {
 	var request ListEvidenceAnalysesRequestObject
@@ -15286,10 +20468,11 @@ func (sh *strictHandler) GetEvidenceAnalysis(ctx echo.Context, id EvidenceAnalys
 }
 
 // UpdateEvidenceAnalysis operation middleware
-func (sh *strictHandler) UpdateEvidenceAnalysis(ctx echo.Context, id EvidenceAnalysisId) error {
+func (sh *strictHandler) UpdateEvidenceAnalysis(ctx echo.Context, id EvidenceAnalysisId, params UpdateEvidenceAnalysisParams) error {
 	var request UpdateEvidenceAnalysisRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateEvidenceAnalysisJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -15445,6 +20628,38 @@ func (sh *strictHandler) ExportAttributes(ctx echo.Context) error {
 	return nil
 }
 
+// ExportBySurname operation middleware
+func (sh *strictHandler) ExportBySurname(ctx echo.Context) error {
+	var request ExportBySurnameRequestObject
+
+	var body ExportBySurnameJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		if !errors.Is(err, io.EOF) {
+			return err
+		}
+	} else {
+		request.Body = &body
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportBySurname(ctx.Request().Context(), request.(ExportBySurnameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportBySurname")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ExportBySurnameResponseObject); ok {
+		return validResponse.VisitExportBySurnameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ExportCitations operation middleware
 func (sh *strictHandler) ExportCitations(ctx echo.Context) error {
 	var request ExportCitationsRequestObject
@@ -15560,6 +20775,35 @@ func (sh *strictHandler) ExportPersons(ctx echo.Context) error {
 	return nil
 }
 
+// ExportSelection operation middleware
+func (sh *strictHandler) ExportSelection(ctx echo.Context) error {
+	var request ExportSelectionRequestObject
+
+	var body ExportSelectionJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportSelection(ctx.Request().Context(), request.(ExportSelectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportSelection")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ExportSelectionResponseObject); ok {
+		return validResponse.VisitExportSelectionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ExportSources operation middleware
 func (sh *strictHandler) ExportSources(ctx echo.Context) error {
 	var request ExportSourcesRequestObject
@@ -15606,6 +20850,29 @@ func (sh *strictHandler) ExportTree(ctx echo.Context) error {
 	return nil
 }
 
+// ListFactTypes operation middleware
+func (sh *strictHandler) ListFactTypes(ctx echo.Context) error {
+	var request ListFactTypesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFactTypes(ctx.Request().Context(), request.(ListFactTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFactTypes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListFactTypesResponseObject); ok {
+		return validResponse.VisitListFactTypesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ListFamilies operation middleware
 func (sh *strictHandler) ListFamilies(ctx echo.Context, params ListFamiliesParams) error {
 	var request ListFamiliesRequestObject
@@ -15660,6 +20927,31 @@ func (sh *strictHandler) CreateFamily(ctx echo.Context) error {
 	return nil
 }
 
+// SearchFamilies operation middleware
+func (sh *strictHandler) SearchFamilies(ctx echo.Context, params SearchFamiliesParams) error {
+	var request SearchFamiliesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchFamilies(ctx.Request().Context(), request.(SearchFamiliesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchFamilies")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SearchFamiliesResponseObject); ok {
+		return validResponse.VisitSearchFamiliesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteFamily operation middleware
 func (sh *strictHandler) DeleteFamily(ctx echo.Context, id FamilyId) error {
 	var request DeleteFamilyRequestObject
@@ -15686,10 +20978,11 @@ func (sh *strictHandler) DeleteFamily(ctx echo.Context, id FamilyId) error {
 }
 
 // GetFamily operation middleware
-func (sh *strictHandler) GetFamily(ctx echo.Context, id FamilyId) error {
+func (sh *strictHandler) GetFamily(ctx echo.Context, id FamilyId, params GetFamilyParams) error {
 	var request GetFamilyRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetFamily(ctx.Request().Context(), request.(GetFamilyRequestObject))
@@ -15711,10 +21004,11 @@ func (sh *strictHandler) GetFamily(ctx echo.Context, id FamilyId) error {
 }
 
 // UpdateFamily operation middleware
-func (sh *strictHandler) UpdateFamily(ctx echo.Context, id FamilyId) error {
+func (sh *strictHandler) UpdateFamily(ctx echo.Context, id FamilyId, params UpdateFamilyParams) error {
 	var request UpdateFamilyRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateFamilyJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -16141,10 +21435,11 @@ func (sh *strictHandler) GetLDSOrdinance(ctx echo.Context, id LdsOrdinanceId) er
 }
 
 // UpdateLDSOrdinance operation middleware
-func (sh *strictHandler) UpdateLDSOrdinance(ctx echo.Context, id LdsOrdinanceId) error {
+func (sh *strictHandler) UpdateLDSOrdinance(ctx echo.Context, id LdsOrdinanceId, params UpdateLDSOrdinanceParams) error {
 	var request UpdateLDSOrdinanceRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateLDSOrdinanceJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -16194,6 +21489,29 @@ func (sh *strictHandler) GetMapLocations(ctx echo.Context) error {
 	return nil
 }
 
+// ListDuplicateMedia operation middleware
+func (sh *strictHandler) ListDuplicateMedia(ctx echo.Context) error {
+	var request ListDuplicateMediaRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDuplicateMedia(ctx.Request().Context(), request.(ListDuplicateMediaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDuplicateMedia")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListDuplicateMediaResponseObject); ok {
+		return validResponse.VisitListDuplicateMediaResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteMedia operation middleware
 func (sh *strictHandler) DeleteMedia(ctx echo.Context, id openapi_types.UUID, params DeleteMediaParams) error {
 	var request DeleteMediaRequestObject
@@ -16246,10 +21564,11 @@ func (sh *strictHandler) GetMedia(ctx echo.Context, id openapi_types.UUID) error
 }
 
 // UpdateMedia operation middleware
-func (sh *strictHandler) UpdateMedia(ctx echo.Context, id openapi_types.UUID) error {
+func (sh *strictHandler) UpdateMedia(ctx echo.Context, id openapi_types.UUID, params UpdateMediaParams) error {
 	var request UpdateMediaRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateMediaJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -16302,10 +21621,11 @@ func (sh *strictHandler) DownloadMedia(ctx echo.Context, id openapi_types.UUID)
 }
 
 // GetMediaThumbnail operation middleware
-func (sh *strictHandler) GetMediaThumbnail(ctx echo.Context, id openapi_types.UUID) error {
+func (sh *strictHandler) GetMediaThumbnail(ctx echo.Context, id openapi_types.UUID, params GetMediaThumbnailParams) error {
 	var request GetMediaThumbnailRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetMediaThumbnail(ctx.Request().Context(), request.(GetMediaThumbnailRequestObject))
@@ -16432,10 +21752,11 @@ func (sh *strictHandler) GetNote(ctx echo.Context, id NoteId) error {
 }
 
 // UpdateNote operation middleware
-func (sh *strictHandler) UpdateNote(ctx echo.Context, id NoteId) error {
+func (sh *strictHandler) UpdateNote(ctx echo.Context, id NoteId, params UpdateNoteParams) error {
 	var request UpdateNoteRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateNoteJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -16542,6 +21863,35 @@ func (sh *strictHandler) CreatePerson(ctx echo.Context) error {
 	return nil
 }
 
+// BulkDeletePersons operation middleware
+func (sh *strictHandler) BulkDeletePersons(ctx echo.Context) error {
+	var request BulkDeletePersonsRequestObject
+
+	var body BulkDeletePersonsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BulkDeletePersons(ctx.Request().Context(), request.(BulkDeletePersonsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BulkDeletePersons")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BulkDeletePersonsResponseObject); ok {
+		return validResponse.VisitBulkDeletePersonsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetPersonsDuplicates operation middleware
 func (sh *strictHandler) GetPersonsDuplicates(ctx echo.Context, params GetPersonsDuplicatesParams) error {
 	var request GetPersonsDuplicatesRequestObject
@@ -16689,6 +22039,31 @@ func (sh *strictHandler) BatchMergePersons(ctx echo.Context) error {
 	return nil
 }
 
+// ListOrphanedPersons operation middleware
+func (sh *strictHandler) ListOrphanedPersons(ctx echo.Context, params ListOrphanedPersonsParams) error {
+	var request ListOrphanedPersonsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrphanedPersons(ctx.Request().Context(), request.(ListOrphanedPersonsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrphanedPersons")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListOrphanedPersonsResponseObject); ok {
+		return validResponse.VisitListOrphanedPersonsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeletePerson operation middleware
 func (sh *strictHandler) DeletePerson(ctx echo.Context, id PersonId) error {
 	var request DeletePersonRequestObject
@@ -16715,10 +22090,11 @@ func (sh *strictHandler) DeletePerson(ctx echo.Context, id PersonId) error {
 }
 
 // GetPerson operation middleware
-func (sh *strictHandler) GetPerson(ctx echo.Context, id PersonId) error {
+func (sh *strictHandler) GetPerson(ctx echo.Context, id PersonId, params GetPersonParams) error {
 	var request GetPersonRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetPerson(ctx.Request().Context(), request.(GetPersonRequestObject))
@@ -16740,10 +22116,11 @@ func (sh *strictHandler) GetPerson(ctx echo.Context, id PersonId) error {
 }
 
 // UpdatePerson operation middleware
-func (sh *strictHandler) UpdatePerson(ctx echo.Context, id PersonId) error {
+func (sh *strictHandler) UpdatePerson(ctx echo.Context, id PersonId, params UpdatePersonParams) error {
 	var request UpdatePersonRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdatePersonJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -16826,76 +22203,186 @@ func (sh *strictHandler) SetPersonBrickWall(ctx echo.Context, id PersonId) error
 
 	request.Id = id
 
-	var body SetPersonBrickWallJSONRequestBody
+	var body SetPersonBrickWallJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetPersonBrickWall(ctx.Request().Context(), request.(SetPersonBrickWallRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetPersonBrickWall")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetPersonBrickWallResponseObject); ok {
+		return validResponse.VisitSetPersonBrickWallResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCitationsForPerson operation middleware
+func (sh *strictHandler) GetCitationsForPerson(ctx echo.Context, id PersonId, params GetCitationsForPersonParams) error {
+	var request GetCitationsForPersonRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCitationsForPerson(ctx.Request().Context(), request.(GetCitationsForPersonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCitationsForPerson")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCitationsForPersonResponseObject); ok {
+		return validResponse.VisitGetCitationsForPersonResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetEndOfLines operation middleware
+func (sh *strictHandler) GetEndOfLines(ctx echo.Context, id PersonId, params GetEndOfLinesParams) error {
+	var request GetEndOfLinesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEndOfLines(ctx.Request().Context(), request.(GetEndOfLinesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEndOfLines")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetEndOfLinesResponseObject); ok {
+		return validResponse.VisitGetEndOfLinesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ExportPersonGedcom operation middleware
+func (sh *strictHandler) ExportPersonGedcom(ctx echo.Context, id PersonId, params ExportPersonGedcomParams) error {
+	var request ExportPersonGedcomRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportPersonGedcom(ctx.Request().Context(), request.(ExportPersonGedcomRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportPersonGedcom")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ExportPersonGedcomResponseObject); ok {
+		return validResponse.VisitExportPersonGedcomResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddPersonExternalId operation middleware
+func (sh *strictHandler) AddPersonExternalId(ctx echo.Context, id PersonId) error {
+	var request AddPersonExternalIdRequestObject
+
+	request.Id = id
+
+	var body AddPersonExternalIdJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SetPersonBrickWall(ctx.Request().Context(), request.(SetPersonBrickWallRequestObject))
+		return sh.ssi.AddPersonExternalId(ctx.Request().Context(), request.(AddPersonExternalIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SetPersonBrickWall")
+		handler = middleware(handler, "AddPersonExternalId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SetPersonBrickWallResponseObject); ok {
-		return validResponse.VisitSetPersonBrickWallResponse(ctx.Response())
+	} else if validResponse, ok := response.(AddPersonExternalIdResponseObject); ok {
+		return validResponse.VisitAddPersonExternalIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCitationsForPerson operation middleware
-func (sh *strictHandler) GetCitationsForPerson(ctx echo.Context, id PersonId) error {
-	var request GetCitationsForPersonRequestObject
+// GetPersonHistory operation middleware
+func (sh *strictHandler) GetPersonHistory(ctx echo.Context, id PersonId, params GetPersonHistoryParams) error {
+	var request GetPersonHistoryRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCitationsForPerson(ctx.Request().Context(), request.(GetCitationsForPersonRequestObject))
+		return sh.ssi.GetPersonHistory(ctx.Request().Context(), request.(GetPersonHistoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCitationsForPerson")
+		handler = middleware(handler, "GetPersonHistory")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCitationsForPersonResponseObject); ok {
-		return validResponse.VisitGetCitationsForPersonResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetPersonHistoryResponseObject); ok {
+		return validResponse.VisitGetPersonHistoryResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetPersonHistory operation middleware
-func (sh *strictHandler) GetPersonHistory(ctx echo.Context, id PersonId, params GetPersonHistoryParams) error {
-	var request GetPersonHistoryRequestObject
+// GetPersonKin operation middleware
+func (sh *strictHandler) GetPersonKin(ctx echo.Context, id PersonId, params GetPersonKinParams) error {
+	var request GetPersonKinRequestObject
 
 	request.Id = id
 	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPersonHistory(ctx.Request().Context(), request.(GetPersonHistoryRequestObject))
+		return sh.ssi.GetPersonKin(ctx.Request().Context(), request.(GetPersonKinRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPersonHistory")
+		handler = middleware(handler, "GetPersonKin")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetPersonHistoryResponseObject); ok {
-		return validResponse.VisitGetPersonHistoryResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetPersonKinResponseObject); ok {
+		return validResponse.VisitGetPersonKinResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -16984,11 +22471,37 @@ func (sh *strictHandler) UploadPersonMedia(ctx echo.Context, id PersonId) error
 	return nil
 }
 
+// DownloadPersonMediaArchive operation middleware
+func (sh *strictHandler) DownloadPersonMediaArchive(ctx echo.Context, id PersonId) error {
+	var request DownloadPersonMediaArchiveRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadPersonMediaArchive(ctx.Request().Context(), request.(DownloadPersonMediaArchiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadPersonMediaArchive")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DownloadPersonMediaArchiveResponseObject); ok {
+		return validResponse.VisitDownloadPersonMediaArchiveResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetPersonNames operation middleware
-func (sh *strictHandler) GetPersonNames(ctx echo.Context, id PersonId) error {
+func (sh *strictHandler) GetPersonNames(ctx echo.Context, id PersonId, params GetPersonNamesParams) error {
 	var request GetPersonNamesRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetPersonNames(ctx.Request().Context(), request.(GetPersonNamesRequestObject))
@@ -17155,6 +22668,83 @@ func (sh *strictHandler) RollbackPerson(ctx echo.Context, id PersonId) error {
 	return nil
 }
 
+// GetPersonSourceReport operation middleware
+func (sh *strictHandler) GetPersonSourceReport(ctx echo.Context, id PersonId) error {
+	var request GetPersonSourceReportRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPersonSourceReport(ctx.Request().Context(), request.(GetPersonSourceReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPersonSourceReport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPersonSourceReportResponseObject); ok {
+		return validResponse.VisitGetPersonSourceReportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MergePlaces operation middleware
+func (sh *strictHandler) MergePlaces(ctx echo.Context) error {
+	var request MergePlacesRequestObject
+
+	var body MergePlacesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MergePlaces(ctx.Request().Context(), request.(MergePlacesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergePlaces")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MergePlacesResponseObject); ok {
+		return validResponse.VisitMergePlacesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPlaceVariants operation middleware
+func (sh *strictHandler) GetPlaceVariants(ctx echo.Context) error {
+	var request GetPlaceVariantsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlaceVariants(ctx.Request().Context(), request.(GetPlaceVariantsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlaceVariants")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPlaceVariantsResponseObject); ok {
+		return validResponse.VisitGetPlaceVariantsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ListProofSummaries operation middleware
 func (sh *strictHandler) ListProofSummaries(ctx echo.Context, params ListProofSummariesParams) error {
 	var request ListProofSummariesRequestObject
@@ -17286,10 +22876,11 @@ func (sh *strictHandler) GetProofSummary(ctx echo.Context, id ProofSummaryId) er
 }
 
 // UpdateProofSummary operation middleware
-func (sh *strictHandler) UpdateProofSummary(ctx echo.Context, id ProofSummaryId) error {
+func (sh *strictHandler) UpdateProofSummary(ctx echo.Context, id ProofSummaryId, params UpdateProofSummaryParams) error {
 	var request UpdateProofSummaryRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateProofSummaryJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -17316,6 +22907,31 @@ func (sh *strictHandler) UpdateProofSummary(ctx echo.Context, id ProofSummaryId)
 	return nil
 }
 
+// GetQualityBySurname operation middleware
+func (sh *strictHandler) GetQualityBySurname(ctx echo.Context, params GetQualityBySurnameParams) error {
+	var request GetQualityBySurnameRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetQualityBySurname(ctx.Request().Context(), request.(GetQualityBySurnameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetQualityBySurname")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetQualityBySurnameResponseObject); ok {
+		return validResponse.VisitGetQualityBySurnameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetQualityOverview operation middleware
 func (sh *strictHandler) GetQualityOverview(ctx echo.Context) error {
 	var request GetQualityOverviewRequestObject
@@ -17365,9 +22981,11 @@ func (sh *strictHandler) GetPersonQuality(ctx echo.Context, id PersonId) error {
 }
 
 // GetQualityReport operation middleware
-func (sh *strictHandler) GetQualityReport(ctx echo.Context) error {
+func (sh *strictHandler) GetQualityReport(ctx echo.Context, params GetQualityReportParams) error {
 	var request GetQualityReportRequestObject
 
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetQualityReport(ctx.Request().Context(), request.(GetQualityReportRequestObject))
 	}
@@ -17412,6 +23030,31 @@ func (sh *strictHandler) GetValidationIssues(ctx echo.Context, params GetValidat
 	return nil
 }
 
+// ListRecentChanges operation middleware
+func (sh *strictHandler) ListRecentChanges(ctx echo.Context, params ListRecentChangesParams) error {
+	var request ListRecentChangesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRecentChanges(ctx.Request().Context(), request.(ListRecentChangesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRecentChanges")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListRecentChangesResponseObject); ok {
+		return validResponse.VisitListRecentChangesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetRelationship operation middleware
 func (sh *strictHandler) GetRelationship(ctx echo.Context, personId1 openapi_types.UUID, personId2 openapi_types.UUID) error {
 	var request GetRelationshipRequestObject
@@ -17438,6 +23081,31 @@ func (sh *strictHandler) GetRelationship(ctx echo.Context, personId1 openapi_typ
 	return nil
 }
 
+// GetMissingDataReport operation middleware
+func (sh *strictHandler) GetMissingDataReport(ctx echo.Context, params GetMissingDataReportParams) error {
+	var request GetMissingDataReportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMissingDataReport(ctx.Request().Context(), request.(GetMissingDataReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMissingDataReport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetMissingDataReportResponseObject); ok {
+		return validResponse.VisitGetMissingDataReportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ListRepositories operation middleware
 func (sh *strictHandler) ListRepositories(ctx echo.Context, params ListRepositoriesParams) error {
 	var request ListRepositoriesRequestObject
@@ -17544,10 +23212,11 @@ func (sh *strictHandler) GetRepository(ctx echo.Context, id RepositoryId) error
 }
 
 // UpdateRepository operation middleware
-func (sh *strictHandler) UpdateRepository(ctx echo.Context, id RepositoryId) error {
+func (sh *strictHandler) UpdateRepository(ctx echo.Context, id RepositoryId, params UpdateRepositoryParams) error {
 	var request UpdateRepositoryRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateRepositoryJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -17705,10 +23374,11 @@ func (sh *strictHandler) GetResearchLog(ctx echo.Context, id ResearchLogId) erro
 }
 
 // UpdateResearchLog operation middleware
-func (sh *strictHandler) UpdateResearchLog(ctx echo.Context, id ResearchLogId) error {
+func (sh *strictHandler) UpdateResearchLog(ctx echo.Context, id ResearchLogId, params UpdateResearchLogParams) error {
 	var request UpdateResearchLogRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateResearchLogJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -17760,6 +23430,35 @@ func (sh *strictHandler) SearchPersons(ctx echo.Context, params SearchPersonsPar
 	return nil
 }
 
+// AdvancedSearchPersons operation middleware
+func (sh *strictHandler) AdvancedSearchPersons(ctx echo.Context) error {
+	var request AdvancedSearchPersonsRequestObject
+
+	var body AdvancedSearchPersonsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdvancedSearchPersons(ctx.Request().Context(), request.(AdvancedSearchPersonsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdvancedSearchPersons")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdvancedSearchPersonsResponseObject); ok {
+		return validResponse.VisitAdvancedSearchPersonsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ListSnapshots operation middleware
 func (sh *strictHandler) ListSnapshots(ctx echo.Context) error {
 	var request ListSnapshotsRequestObject
@@ -17994,10 +23693,11 @@ func (sh *strictHandler) DeleteSource(ctx echo.Context, id openapi_types.UUID, p
 }
 
 // GetSource operation middleware
-func (sh *strictHandler) GetSource(ctx echo.Context, id openapi_types.UUID) error {
+func (sh *strictHandler) GetSource(ctx echo.Context, id openapi_types.UUID, params GetSourceParams) error {
 	var request GetSourceRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetSource(ctx.Request().Context(), request.(GetSourceRequestObject))
@@ -18019,10 +23719,11 @@ func (sh *strictHandler) GetSource(ctx echo.Context, id openapi_types.UUID) erro
 }
 
 // UpdateSource operation middleware
-func (sh *strictHandler) UpdateSource(ctx echo.Context, id openapi_types.UUID) error {
+func (sh *strictHandler) UpdateSource(ctx echo.Context, id openapi_types.UUID, params UpdateSourceParams) error {
 	var request UpdateSourceRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateSourceJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -18050,10 +23751,11 @@ func (sh *strictHandler) UpdateSource(ctx echo.Context, id openapi_types.UUID) e
 }
 
 // GetCitationsForSource operation middleware
-func (sh *strictHandler) GetCitationsForSource(ctx echo.Context, id openapi_types.UUID) error {
+func (sh *strictHandler) GetCitationsForSource(ctx echo.Context, id openapi_types.UUID, params GetCitationsForSourceParams) error {
 	var request GetCitationsForSourceRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetCitationsForSource(ctx.Request().Context(), request.(GetCitationsForSourceRequestObject))
@@ -18157,6 +23859,31 @@ func (sh *strictHandler) RollbackSource(ctx echo.Context, id openapi_types.UUID)
 	return nil
 }
 
+// GetSourceUsage operation middleware
+func (sh *strictHandler) GetSourceUsage(ctx echo.Context, id openapi_types.UUID) error {
+	var request GetSourceUsageRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSourceUsage(ctx.Request().Context(), request.(GetSourceUsageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSourceUsage")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSourceUsageResponseObject); ok {
+		return validResponse.VisitGetSourceUsageResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetStatistics operation middleware
 func (sh *strictHandler) GetStatistics(ctx echo.Context) error {
 	var request GetStatisticsRequestObject
@@ -18180,6 +23907,29 @@ func (sh *strictHandler) GetStatistics(ctx echo.Context) error {
 	return nil
 }
 
+// GetMarriageAgeStatistics operation middleware
+func (sh *strictHandler) GetMarriageAgeStatistics(ctx echo.Context) error {
+	var request GetMarriageAgeStatisticsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMarriageAgeStatistics(ctx.Request().Context(), request.(GetMarriageAgeStatisticsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMarriageAgeStatistics")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetMarriageAgeStatisticsResponseObject); ok {
+		return validResponse.VisitGetMarriageAgeStatisticsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ListSubmitters operation middleware
 func (sh *strictHandler) ListSubmitters(ctx echo.Context, params ListSubmittersParams) error {
 	var request ListSubmittersRequestObject
@@ -18286,10 +24036,11 @@ func (sh *strictHandler) GetSubmitter(ctx echo.Context, id SubmitterId) error {
 }
 
 // UpdateSubmitter operation middleware
-func (sh *strictHandler) UpdateSubmitter(ctx echo.Context, id SubmitterId) error {
+func (sh *strictHandler) UpdateSubmitter(ctx echo.Context, id SubmitterId, params UpdateSubmitterParams) error {
 	var request UpdateSubmitterRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body UpdateSubmitterJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -18315,3 +24066,53 @@ func (sh *strictHandler) UpdateSubmitter(ctx echo.Context, id SubmitterId) error
 	}
 	return nil
 }
+
+// ListTrash operation middleware
+func (sh *strictHandler) ListTrash(ctx echo.Context, params ListTrashParams) error {
+	var request ListTrashRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTrash(ctx.Request().Context(), request.(ListTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTrash")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListTrashResponseObject); ok {
+		return validResponse.VisitListTrashResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RestoreFromTrash operation middleware
+func (sh *strictHandler) RestoreFromTrash(ctx echo.Context, id openapi_types.UUID) error {
+	var request RestoreFromTrashRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreFromTrash(ctx.Request().Context(), request.(RestoreFromTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreFromTrash")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RestoreFromTrashResponseObject); ok {
+		return validResponse.VisitRestoreFromTrashResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
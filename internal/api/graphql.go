@@ -0,0 +1,370 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/cacack/my-family/internal/graphql"
+	"github.com/cacack/my-family/internal/query"
+)
+
+// graphQLMaxDepth limits how deeply GraphQL queries may nest, so a single
+// request cannot walk the whole tree through families and children.
+const graphQLMaxDepth = 10
+
+// graphQLMaxFields limits how many fields a GraphQL query may select, so
+// aliases cannot multiply list fields within the depth limit.
+const graphQLMaxFields = 200
+
+// registerGraphQLRoutes wires the GraphQL query endpoint and its schema. It
+// lives outside the generated handler because a GraphQL request's shape is
+// defined by the query, not by the OpenAPI spec.
+func (s *Server) registerGraphQLRoutes(api *echo.Group) {
+	schema, err := s.newGraphQLSchema()
+	if err != nil {
+		// The schema is static, so this only happens if it is defined
+		// inconsistently; leave the routes unregistered rather than fail
+		// the whole server.
+		s.logger.Error("invalid graphql schema", "error", err)
+		return
+	}
+	s.graphQLSchema = schema
+	var mw []echo.MiddlewareFunc
+	if limit := s.expensiveRateLimit(); limit != nil {
+		mw = append(mw, limit)
+	}
+	api.POST("/graphql", s.executeGraphQL, mw...)
+	api.GET("/graphql/schema", s.getGraphQLSchema)
+}
+
+// executeGraphQL runs a GraphQL query. Query and field errors are reported in
+// the response's errors array with status 200, as GraphQL clients expect;
+// only a body that is not a GraphQL request at all is rejected with 400.
+func (s *Server) executeGraphQL(c echo.Context) error {
+	var req graphql.Request
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil || strings.TrimSpace(req.Query) == "" {
+		return c.JSON(http.StatusBadRequest, APIError{
+			Code:      CodeBadRequest,
+			Message:   `Request body must be a JSON object with a "query" string`,
+			RequestID: requestID(c),
+		})
+	}
+	return c.JSON(http.StatusOK, s.graphQLSchema.Execute(c.Request().Context(), req))
+}
+
+// getGraphQLSchema returns the GraphQL schema in SDL form.
+func (s *Server) getGraphQLSchema(c echo.Context) error {
+	return c.String(http.StatusOK, s.graphQLSchema.String())
+}
+
+// newGraphQLSchema builds the GraphQL schema over the person, family, and
+// source query services.
+func (s *Server) newGraphQLSchema() (*graphql.Schema, error) {
+	person := &graphql.Object{Name: "Person", Description: "An individual in the family tree."}
+	personName := &graphql.Object{Name: "PersonName", Description: "A name recorded for a person."}
+	family := &graphql.Object{Name: "Family", Description: "A partnership and its children."}
+	source := &graphql.Object{Name: "Source", Description: "A source of genealogical evidence."}
+	citation := &graphql.Object{Name: "Citation", Description: "A citation of a source for a fact."}
+
+	id := graphql.NonNullOf(graphql.ID)
+	str := graphql.NonNullOf(graphql.String)
+	num := graphql.NonNullOf(graphql.Int)
+	listOf := func(t graphql.Type) graphql.Type { return graphql.NonNullOf(graphql.ListOf(graphql.NonNullOf(t))) }
+	idArg := []*graphql.Argument{{Name: "id", Type: id}}
+	pageArgs := func(extra ...*graphql.Argument) []*graphql.Argument {
+		return append([]*graphql.Argument{
+			{Name: "limit", Type: graphql.Int, Default: 20, Description: "Maximum results, at most 100."},
+			{Name: "offset", Type: graphql.Int, Default: 0},
+		}, extra...)
+	}
+
+	person.Fields = []*graphql.Field{
+		{Name: "id", Type: id, Resolve: gqlValue(func(p query.Person) any { return p.ID })},
+		{Name: "given_name", Type: str, Resolve: gqlValue(func(p query.Person) any { return p.GivenName })},
+		{Name: "surname", Type: str, Resolve: gqlValue(func(p query.Person) any { return p.Surname })},
+		{Name: "gender", Type: graphql.String, Resolve: gqlValue(func(p query.Person) any { return p.Gender })},
+		{Name: "birth_date", Type: graphql.String, Resolve: gqlValue(func(p query.Person) any { return p.BirthDate })},
+		{Name: "birth_place", Type: graphql.String, Resolve: gqlValue(func(p query.Person) any { return p.BirthPlace })},
+		{Name: "death_date", Type: graphql.String, Resolve: gqlValue(func(p query.Person) any { return p.DeathDate })},
+		{Name: "death_place", Type: graphql.String, Resolve: gqlValue(func(p query.Person) any { return p.DeathPlace })},
+		{Name: "notes", Type: graphql.String, Resolve: gqlValue(func(p query.Person) any { return p.Notes })},
+		{Name: "research_status", Type: graphql.String, Resolve: gqlValue(func(p query.Person) any { return p.ResearchStatus })},
+		{Name: "version", Type: num, Resolve: gqlValue(func(p query.Person) any { return p.Version })},
+		{
+			Name: "names", Type: listOf(personName), Description: "All recorded names, primary first.",
+			Resolve: func(ctx context.Context, src any, _ map[string]any) (any, error) {
+				return s.personService.GetPersonNames(ctx, src.(query.Person).ID)
+			},
+		},
+		{
+			Name: "families", Type: listOf(family), Description: "Families in which the person is a partner.",
+			Resolve: func(ctx context.Context, src any, _ map[string]any) (any, error) {
+				return s.familyService.GetFamiliesForPerson(ctx, src.(query.Person).ID)
+			},
+		},
+		{
+			Name: "parent_family", Type: family, Description: "The family in which the person is a child.",
+			Resolve: func(ctx context.Context, src any, _ map[string]any) (any, error) {
+				detail, err := s.personService.GetPerson(ctx, src.(query.Person).ID)
+				if err != nil || detail.FamilyAsChild == nil {
+					return nil, notFoundAsNull(err)
+				}
+				return s.gqlFamily(ctx, detail.FamilyAsChild.ID)
+			},
+		},
+		{
+			Name: "citations", Type: listOf(citation), Description: "Citations supporting the person's facts.",
+			Resolve: func(ctx context.Context, src any, _ map[string]any) (any, error) {
+				return s.sourceService.GetCitationsForPerson(ctx, src.(query.Person).ID)
+			},
+		},
+	}
+
+	personName.Fields = []*graphql.Field{
+		{Name: "given_name", Type: str, Resolve: gqlValue(func(n query.PersonName) any { return n.GivenName })},
+		{Name: "surname", Type: str, Resolve: gqlValue(func(n query.PersonName) any { return n.Surname })},
+		{Name: "full_name", Type: str, Resolve: gqlValue(func(n query.PersonName) any { return n.FullName })},
+		{Name: "nickname", Type: graphql.String, Resolve: gqlValue(func(n query.PersonName) any { return optionalString(n.Nickname) })},
+		{Name: "name_type", Type: str, Resolve: gqlValue(func(n query.PersonName) any { return n.NameType })},
+		{Name: "is_primary", Type: graphql.NonNullOf(graphql.Boolean), Resolve: gqlValue(func(n query.PersonName) any { return n.IsPrimary })},
+	}
+
+	family.Fields = []*graphql.Field{
+		{Name: "id", Type: id, Resolve: gqlValue(func(f query.Family) any { return f.ID })},
+		{
+			Name: "partner1", Type: person,
+			Resolve: func(ctx context.Context, src any, _ map[string]any) (any, error) {
+				return s.gqlPartner(ctx, src.(query.Family).Partner1ID)
+			},
+		},
+		{
+			Name: "partner2", Type: person,
+			Resolve: func(ctx context.Context, src any, _ map[string]any) (any, error) {
+				return s.gqlPartner(ctx, src.(query.Family).Partner2ID)
+			},
+		},
+		{Name: "relationship_type", Type: graphql.String, Resolve: gqlValue(func(f query.Family) any { return f.RelationshipType })},
+		{Name: "marriage_date", Type: graphql.String, Resolve: gqlValue(func(f query.Family) any { return f.MarriageDate })},
+		{Name: "marriage_place", Type: graphql.String, Resolve: gqlValue(func(f query.Family) any { return f.MarriagePlace })},
+		{Name: "child_count", Type: num, Resolve: gqlValue(func(f query.Family) any { return f.ChildCount })},
+		{
+			Name: "children", Type: listOf(person),
+			Resolve: func(ctx context.Context, src any, _ map[string]any) (any, error) {
+				detail, err := s.familyService.GetFamily(ctx, src.(query.Family).ID)
+				if err != nil {
+					return nil, err
+				}
+				children := make([]query.Person, 0, len(detail.Children))
+				for _, child := range detail.Children {
+					p, err := s.personService.GetPerson(ctx, child.ID)
+					if errors.Is(err, query.ErrNotFound) {
+						continue
+					}
+					if err != nil {
+						return nil, err
+					}
+					children = append(children, p.Person)
+				}
+				return children, nil
+			},
+		},
+		{Name: "version", Type: num, Resolve: gqlValue(func(f query.Family) any { return f.Version })},
+	}
+
+	source.Fields = []*graphql.Field{
+		{Name: "id", Type: id, Resolve: gqlValue(func(src query.Source) any { return src.ID })},
+		{Name: "source_type", Type: str, Resolve: gqlValue(func(src query.Source) any { return src.SourceType })},
+		{Name: "title", Type: str, Resolve: gqlValue(func(src query.Source) any { return src.Title })},
+		{Name: "author", Type: graphql.String, Resolve: gqlValue(func(src query.Source) any { return src.Author })},
+		{Name: "publisher", Type: graphql.String, Resolve: gqlValue(func(src query.Source) any { return src.Publisher })},
+		{Name: "publish_date", Type: graphql.String, Resolve: gqlValue(func(src query.Source) any { return src.PublishDate })},
+		{Name: "url", Type: graphql.String, Resolve: gqlValue(func(src query.Source) any { return src.URL })},
+		{Name: "repository_name", Type: graphql.String, Resolve: gqlValue(func(src query.Source) any { return src.RepositoryName })},
+		{Name: "citation_count", Type: num, Resolve: gqlValue(func(src query.Source) any { return src.CitationCount })},
+		{
+			Name: "citations", Type: listOf(citation),
+			Resolve: func(ctx context.Context, src any, _ map[string]any) (any, error) {
+				detail, err := s.sourceService.GetSource(ctx, src.(query.Source).ID)
+				if err != nil {
+					return nil, err
+				}
+				return detail.Citations, nil
+			},
+		},
+		{Name: "version", Type: num, Resolve: gqlValue(func(src query.Source) any { return src.Version })},
+	}
+
+	citation.Fields = []*graphql.Field{
+		{Name: "id", Type: id, Resolve: gqlValue(func(c query.Citation) any { return c.ID })},
+		{
+			Name: "source", Type: source,
+			Resolve: func(ctx context.Context, src any, _ map[string]any) (any, error) {
+				return s.gqlSource(ctx, src.(query.Citation).SourceID)
+			},
+		},
+		{Name: "fact_type", Type: str, Resolve: gqlValue(func(c query.Citation) any { return c.FactType })},
+		{Name: "fact_owner_id", Type: id, Resolve: gqlValue(func(c query.Citation) any { return c.FactOwnerID })},
+		{Name: "page", Type: graphql.String, Resolve: gqlValue(func(c query.Citation) any { return c.Page })},
+		{Name: "volume", Type: graphql.String, Resolve: gqlValue(func(c query.Citation) any { return c.Volume })},
+		{Name: "source_quality", Type: graphql.String, Resolve: gqlValue(func(c query.Citation) any { return c.SourceQuality })},
+		{Name: "informant_type", Type: graphql.String, Resolve: gqlValue(func(c query.Citation) any { return c.InformantType })},
+		{Name: "evidence_type", Type: graphql.String, Resolve: gqlValue(func(c query.Citation) any { return c.EvidenceType })},
+		{Name: "quoted_text", Type: graphql.String, Resolve: gqlValue(func(c query.Citation) any { return c.QuotedText })},
+		{Name: "analysis", Type: graphql.String, Resolve: gqlValue(func(c query.Citation) any { return c.Analysis })},
+	}
+
+	root := &graphql.Object{Name: "Query", Fields: []*graphql.Field{
+		{
+			Name: "person", Type: person, Args: idArg,
+			Resolve: func(ctx context.Context, _ any, args map[string]any) (any, error) {
+				personID, err := gqlID(args)
+				if err != nil {
+					return nil, err
+				}
+				detail, err := s.personService.GetPerson(ctx, personID)
+				if err != nil {
+					return nil, notFoundAsNull(err)
+				}
+				return detail.Person, nil
+			},
+		},
+		{
+			Name: "persons", Type: listOf(person), Description: "Persons sorted by surname.",
+			Args: pageArgs(&graphql.Argument{Name: "surname", Type: graphql.String, Description: "Only persons with this surname."}),
+			Resolve: func(ctx context.Context, _ any, args map[string]any) (any, error) {
+				surname, _ := args["surname"].(string)
+				result, err := s.personService.ListPersons(ctx, query.ListPersonsInput{
+					Limit:   args["limit"].(int),
+					Offset:  args["offset"].(int),
+					Surname: surname,
+				})
+				if err != nil {
+					return nil, err
+				}
+				return result.Items, nil
+			},
+		},
+		{
+			Name: "family", Type: family, Args: idArg,
+			Resolve: func(ctx context.Context, _ any, args map[string]any) (any, error) {
+				familyID, err := gqlID(args)
+				if err != nil {
+					return nil, err
+				}
+				return s.gqlFamily(ctx, familyID)
+			},
+		},
+		{
+			Name: "families", Type: listOf(family), Args: pageArgs(),
+			Resolve: func(ctx context.Context, _ any, args map[string]any) (any, error) {
+				result, err := s.familyService.ListFamilies(ctx, query.ListFamiliesInput{
+					Limit:  args["limit"].(int),
+					Offset: args["offset"].(int),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return result.Items, nil
+			},
+		},
+		{
+			Name: "source", Type: source, Args: idArg,
+			Resolve: func(ctx context.Context, _ any, args map[string]any) (any, error) {
+				sourceID, err := gqlID(args)
+				if err != nil {
+					return nil, err
+				}
+				return s.gqlSource(ctx, sourceID)
+			},
+		},
+		{
+			Name: "sources", Type: listOf(source), Description: "Sources sorted by title.", Args: pageArgs(),
+			Resolve: func(ctx context.Context, _ any, args map[string]any) (any, error) {
+				result, err := s.sourceService.ListSources(ctx, query.ListSourcesInput{
+					Limit:  args["limit"].(int),
+					Offset: args["offset"].(int),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return result.Sources, nil
+			},
+		},
+	}}
+
+	schema, err := graphql.NewSchema(root)
+	if err != nil {
+		return nil, err
+	}
+	schema.MaxDepth = graphQLMaxDepth
+	schema.MaxFields = graphQLMaxFields
+	return schema, nil
+}
+
+// gqlValue adapts a plain field accessor on a query result type into a
+// resolver.
+func gqlValue[T any](get func(T) any) graphql.ResolveFunc {
+	return func(_ context.Context, src any, _ map[string]any) (any, error) {
+		return get(src.(T)), nil
+	}
+}
+
+// gqlID parses the "id" argument of a lookup field.
+func gqlID(args map[string]any) (uuid.UUID, error) {
+	id, err := uuid.Parse(args["id"].(string))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid id %q", args["id"])
+	}
+	return id, nil
+}
+
+// notFoundAsNull lets lookups of missing entities resolve to null, which is
+// how GraphQL reports an absent object, while passing other errors through.
+func notFoundAsNull(err error) error {
+	if errors.Is(err, query.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (s *Server) gqlFamily(ctx context.Context, id uuid.UUID) (any, error) {
+	detail, err := s.familyService.GetFamily(ctx, id)
+	if err != nil {
+		return nil, notFoundAsNull(err)
+	}
+	return detail.Family, nil
+}
+
+func (s *Server) gqlSource(ctx context.Context, id uuid.UUID) (any, error) {
+	detail, err := s.sourceService.GetSource(ctx, id)
+	if err != nil {
+		return nil, notFoundAsNull(err)
+	}
+	return detail.Source, nil
+}
+
+func (s *Server) gqlPartner(ctx context.Context, id *uuid.UUID) (any, error) {
+	if id == nil {
+		return nil, nil
+	}
+	detail, err := s.personService.GetPerson(ctx, *id)
+	if err != nil {
+		return nil, notFoundAsNull(err)
+	}
+	return detail.Person, nil
+}
+
+// optionalString maps an empty string to null.
+func optionalString(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,187 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cacack/my-family/internal/api"
+)
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+		Path    []any  `json:"path"`
+	} `json:"errors"`
+}
+
+func postGraphQL(t *testing.T, server *api.Server, body any) (*httptest.ResponseRecorder, graphQLResponse) {
+	t.Helper()
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/graphql", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	var resp graphQLResponse
+	if rec.Code == http.StatusOK {
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+	}
+	return rec, resp
+}
+
+func TestGraphQL_PersonWithRelationships(t *testing.T) {
+	server := setupPedigreeTestServer(t)
+	juniorID := importPedigreeTestData(t, server)
+
+	rec, resp := postGraphQL(t, server, map[string]any{
+		"query": `query Tree($id: ID!) {
+			person(id: $id) {
+				given_name
+				names { full_name is_primary }
+				parent_family {
+					partner1 { given_name parent_family { partner1 { given_name } } }
+					partner2 { given_name }
+					children { given_name }
+				}
+				citations { id }
+			}
+		}`,
+		"variables": map[string]any{"id": juniorID},
+	})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if len(resp.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %+v", resp.Errors)
+	}
+
+	want := `{"person":{"given_name":"Junior",` +
+		`"names":[{"full_name":"Junior Smith","is_primary":true}],` +
+		`"parent_family":{"partner1":{"given_name":"John","parent_family":{"partner1":{"given_name":"George"}}},` +
+		`"partner2":{"given_name":"Jane"},"children":[{"given_name":"Junior"}]},` +
+		`"citations":[]}}`
+	if string(resp.Data) != want {
+		t.Errorf("data =\n%s\nwant\n%s", resp.Data, want)
+	}
+}
+
+func TestGraphQL_Lists(t *testing.T) {
+	server := setupPedigreeTestServer(t)
+	importPedigreeTestData(t, server)
+
+	_, resp := postGraphQL(t, server, map[string]any{
+		"query": `{ smiths: persons(surname: "Smith", limit: 2) { given_name } families { child_count } sources { title } }`,
+	})
+	if len(resp.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %+v", resp.Errors)
+	}
+
+	var data struct {
+		Smiths []struct {
+			GivenName string `json:"given_name"`
+		} `json:"smiths"`
+		Families []struct {
+			ChildCount int `json:"child_count"`
+		} `json:"families"`
+		Sources []any `json:"sources"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		t.Fatalf("Failed to parse data: %v", err)
+	}
+	if len(data.Smiths) != 2 {
+		t.Errorf("smiths = %+v, want 2 persons", data.Smiths)
+	}
+	if len(data.Families) != 2 || data.Families[0].ChildCount != 1 {
+		t.Errorf("families = %+v, want 2 families with one child each", data.Families)
+	}
+	if data.Sources == nil || len(data.Sources) != 0 {
+		t.Errorf("sources = %v, want empty list", data.Sources)
+	}
+}
+
+func TestGraphQL_ListPagingIsClamped(t *testing.T) {
+	server := setupPedigreeTestServer(t)
+	importPedigreeTestData(t, server)
+
+	_, resp := postGraphQL(t, server, map[string]any{
+		"query": `{ persons(offset: -5, limit: 1000) { id } families(offset: -1) { id } sources(offset: -1) { id } }`,
+	})
+	if len(resp.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %+v", resp.Errors)
+	}
+
+	var data struct {
+		Persons  []any `json:"persons"`
+		Families []any `json:"families"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		t.Fatalf("Failed to parse data: %v", err)
+	}
+	if len(data.Persons) == 0 || len(data.Families) != 2 {
+		t.Errorf("data = %s, want persons and families from the start of the list", resp.Data)
+	}
+}
+
+func TestGraphQL_MissingPersonIsNull(t *testing.T) {
+	server := setupTestServer()
+
+	_, resp := postGraphQL(t, server, map[string]any{
+		"query": `{ person(id: "00000000-0000-0000-0000-000000000001") { given_name } }`,
+	})
+	if len(resp.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %+v", resp.Errors)
+	}
+	if string(resp.Data) != `{"person":null}` {
+		t.Errorf("data = %s, want person null", resp.Data)
+	}
+}
+
+func TestGraphQL_Errors(t *testing.T) {
+	server := setupTestServer()
+
+	_, resp := postGraphQL(t, server, map[string]any{"query": `{ person(id: "not-a-uuid") { given_name } }`})
+	if len(resp.Errors) != 1 || resp.Errors[0].Message != `invalid id "not-a-uuid"` {
+		t.Errorf("errors = %+v, want invalid id error", resp.Errors)
+	}
+
+	_, resp = postGraphQL(t, server, map[string]any{"query": `{ persons { age } }`})
+	if len(resp.Errors) != 1 || resp.Data != nil {
+		t.Errorf("response = %+v, want one validation error and no data", resp)
+	}
+
+	var aliases strings.Builder
+	for i := range 150 {
+		fmt.Fprintf(&aliases, "p%d: persons { id } ", i)
+	}
+	_, resp = postGraphQL(t, server, map[string]any{"query": "{ " + aliases.String() + "}"})
+	if len(resp.Errors) != 1 || resp.Data != nil || !strings.Contains(resp.Errors[0].Message, "maximum of") {
+		t.Errorf("response = %+v, want a field limit error and no data", resp)
+	}
+
+	rec, _ := postGraphQL(t, server, map[string]any{"variables": map[string]any{}})
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Status = %d, want %d for a request without a query", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGraphQL_Schema(t *testing.T) {
+	server := setupTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/graphql/schema", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if !strings.Contains(rec.Body.String(), "person(id: ID!): Person\n") {
+		t.Errorf("schema missing person query:\n%s", rec.Body.String())
+	}
+}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
 	"github.com/cacack/my-family/internal/domain"
 	"github.com/cacack/my-family/internal/query"
 )
@@ -34,6 +36,7 @@ func convertQueryAhnentafelEntryToGenerated(entry query.AhnentafelEntry) Ahnenta
 		if entry.DeathPlace != nil {
 			resp.DeathPlace = entry.DeathPlace
 		}
+		resp.Sources = convertQueryFactSourceCountsToGenerated(entry.Sources)
 	}
 
 	return resp
@@ -54,9 +57,16 @@ func convertDomainGenDateToGenerated(qd *domain.GenDate) *GenDate {
 	if qd.Day != nil {
 		gd.Day = qd.Day
 	}
+	gd.Year2, gd.Month2, gd.Day2 = qd.Year2, qd.Month2, qd.Day2
+	gd.DualYear, gd.DualYear2 = qd.DualYear, qd.DualYear2
+	if t := qd.SortDate(); !t.IsZero() {
+		gd.SortDate = &openapi_types.Date{Time: t}
+	}
 	if qd.Qualifier != "" {
 		q := GenDateQualifier(string(qd.Qualifier))
 		gd.Qualifier = &q
+		uncertain := qd.IsUncertain()
+		gd.Uncertain = &uncertain
 	}
 	// Expose non-Gregorian calendars so clients can display and convert dates in
 	// their original calendar system. Gregorian (the default) is left unset.
@@ -109,3 +119,14 @@ func getRelationLabel(num int) string {
 	}
 	return result
 }
+
+// convertQueryFactSourceCountsToGenerated converts query.FactSourceCounts to the generated type.
+func convertQueryFactSourceCountsToGenerated(counts *query.FactSourceCounts) *FactSourceCounts {
+	if counts == nil {
+		return nil
+	}
+	return &FactSourceCounts{
+		Birth: counts.Birth,
+		Death: counts.Death,
+	}
+}
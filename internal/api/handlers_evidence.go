@@ -87,6 +87,7 @@ func (ss *StrictServer) CreateEvidenceAnalysis(ctx context.Context, request Crea
 			return CreateEvidenceAnalysis400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -149,7 +150,12 @@ func (ss *StrictServer) UpdateEvidenceAnalysis(ctx context.Context, request Upda
 		input.Notes = request.Body.Notes
 	}
 
-	result, err := ss.server.commandHandler.UpdateEvidenceAnalysis(ctx, input)
+	var result *command.UpdateEvidenceAnalysisResult
+	err := ss.runUpdate(ctx, request.Params.Retry, request.Id, &input.Version, func(ctx context.Context) error {
+		var err error
+		result, err = ss.server.commandHandler.UpdateEvidenceAnalysis(ctx, input)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, command.ErrEvidenceAnalysisNotFound) {
 			return UpdateEvidenceAnalysis404JSONResponse{NotFoundJSONResponse{
@@ -167,6 +173,7 @@ func (ss *StrictServer) UpdateEvidenceAnalysis(ctx context.Context, request Upda
 			return UpdateEvidenceAnalysis400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -303,6 +310,7 @@ func (ss *StrictServer) ResolveEvidenceConflict(ctx context.Context, request Res
 			return ResolveEvidenceConflict400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -407,6 +415,7 @@ func (ss *StrictServer) CreateResearchLog(ctx context.Context, request CreateRes
 			return CreateResearchLog400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -467,7 +476,10 @@ func (ss *StrictServer) UpdateResearchLog(ctx context.Context, request UpdateRes
 		input.SearchDate = request.Body.SearchDate
 	}
 
-	_, err := ss.server.commandHandler.UpdateResearchLog(ctx, input)
+	err := ss.runUpdate(ctx, request.Params.Retry, request.Id, &input.Version, func(ctx context.Context) error {
+		_, err := ss.server.commandHandler.UpdateResearchLog(ctx, input)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, command.ErrResearchLogNotFound) {
 			return UpdateResearchLog404JSONResponse{NotFoundJSONResponse{
@@ -485,6 +497,7 @@ func (ss *StrictServer) UpdateResearchLog(ctx context.Context, request UpdateRes
 			return UpdateResearchLog400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -612,6 +625,7 @@ func (ss *StrictServer) CreateProofSummary(ctx context.Context, request CreatePr
 			return CreateProofSummary400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -669,7 +683,10 @@ func (ss *StrictServer) UpdateProofSummary(ctx context.Context, request UpdatePr
 		input.ResearchStatus = &rs
 	}
 
-	_, err := ss.server.commandHandler.UpdateProofSummary(ctx, input)
+	err := ss.runUpdate(ctx, request.Params.Retry, request.Id, &input.Version, func(ctx context.Context) error {
+		_, err := ss.server.commandHandler.UpdateProofSummary(ctx, input)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, command.ErrProofSummaryNotFound) {
 			return UpdateProofSummary404JSONResponse{NotFoundJSONResponse{
@@ -687,6 +704,7 @@ func (ss *StrictServer) UpdateProofSummary(ctx context.Context, request UpdatePr
 			return UpdateProofSummary400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
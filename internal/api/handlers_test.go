@@ -1,14 +1,23 @@
 package api_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"slices"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/cacack/my-family/internal/api"
 	"github.com/cacack/my-family/internal/config"
+	"github.com/cacack/my-family/internal/domain"
+	"github.com/cacack/my-family/internal/repository"
 	"github.com/cacack/my-family/internal/repository/memory"
 )
 
@@ -43,6 +52,84 @@ func TestHealthCheck(t *testing.T) {
 	}
 }
 
+func TestLiveness(t *testing.T) {
+	server := setupTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/healthz", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	server := setupTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/readyz", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp struct {
+		Status string            `json:"status"`
+		Checks map[string]string `json:"checks"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Status != "ok" || resp.Checks["event_store"] != "ok" || resp.Checks["read_store"] != "ok" {
+		t.Errorf("response = %+v, want all ok", resp)
+	}
+}
+
+// unavailableEventStore is an event store whose reads always fail.
+type unavailableEventStore struct {
+	*memory.EventStore
+}
+
+func (unavailableEventStore) ReadAll(context.Context, int64, int) ([]repository.StoredEvent, error) {
+	return nil, errors.New("database is closed")
+}
+
+func TestReadiness_StoreUnavailable(t *testing.T) {
+	cfg := &config.Config{Port: 8080, LogFormat: "text"}
+	eventStore := unavailableEventStore{memory.NewEventStore()}
+	server := api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore.EventStore), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/readyz", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+
+	var resp struct {
+		Status string            `json:"status"`
+		Checks map[string]string `json:"checks"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Status != "unavailable" {
+		t.Errorf("status = %q, want unavailable", resp.Status)
+	}
+	if resp.Checks["event_store"] != "unavailable" {
+		t.Errorf("event_store check = %q, want unavailable", resp.Checks["event_store"])
+	}
+	if strings.Contains(rec.Body.String(), "database is closed") {
+		t.Errorf("response leaks the store error: %s", rec.Body.String())
+	}
+	if resp.Checks["read_store"] != "ok" {
+		t.Errorf("read_store check = %q, want ok", resp.Checks["read_store"])
+	}
+}
+
 func TestCreatePerson(t *testing.T) {
 	server := setupTestServer()
 	body := `{"given_name":"John","surname":"Doe","gender":"male","birth_date":"1 JAN 1990"}`
@@ -86,6 +173,64 @@ func TestCreatePerson_ValidationError(t *testing.T) {
 	}
 }
 
+func TestCreatePerson_FieldErrors(t *testing.T) {
+	server := setupTestServer()
+	// Every invalid field is reported, not just the first
+	body := `{"given_name":"","surname":"Doe","gender":"robot"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusBadRequest, rec.Body.String())
+	}
+
+	var resp api.Error
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Fields == nil {
+		t.Fatalf("Expected fields in response: %s", rec.Body.String())
+	}
+	got := map[string]string{}
+	for _, f := range *resp.Fields {
+		got[f.Field] = f.Message
+	}
+	if got["given_name"] != "cannot be empty" {
+		t.Errorf("given_name error = %q, want %q", got["given_name"], "cannot be empty")
+	}
+	if got["gender"] != "invalid value: robot" {
+		t.Errorf("gender error = %q, want %q", got["gender"], "invalid value: robot")
+	}
+}
+
+func TestCreatePerson_Gender(t *testing.T) {
+	server := setupTestServer()
+
+	// Nonbinary is accepted, and an omitted gender stays unrecorded
+	for body, want := range map[string]any{
+		`{"given_name":"Sam","surname":"Lee","gender":"nonbinary"}`: "nonbinary",
+		`{"given_name":"Kim","surname":"Lee"}`:                      nil,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("body %s: Status = %d, want %d: %s", body, rec.Code, http.StatusCreated, rec.Body.String())
+		}
+		var resp map[string]any
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		if resp["gender"] != want {
+			t.Errorf("body %s: gender = %v, want %v", body, resp["gender"], want)
+		}
+	}
+}
+
 func TestCreatePerson_WithoutSurname(t *testing.T) {
 	server := setupTestServer()
 	body := `{"given_name":"Madonna"}`
@@ -98,6 +243,67 @@ func TestCreatePerson_WithoutSurname(t *testing.T) {
 	}
 }
 
+func TestCreatePerson_FullName(t *testing.T) {
+	server := setupTestServer()
+	body := `{"full_name":"Dr. Jan van der Berg Jr.","gender":"male"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+
+	var person api.Person
+	if err := json.Unmarshal(rec.Body.Bytes(), &person); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if person.GivenName != "Jan" || person.Surname != "Berg" {
+		t.Errorf("name = %q %q, want Jan Berg", person.GivenName, person.Surname)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+person.Id.String()+"/names", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	var names api.PersonNameList
+	if err := json.Unmarshal(rec.Body.Bytes(), &names); err != nil {
+		t.Fatalf("Failed to parse names: %v", err)
+	}
+	if len(names.Items) != 1 {
+		t.Fatalf("Expected 1 name, got %d", len(names.Items))
+	}
+	name := names.Items[0]
+	if name.NamePrefix == nil || *name.NamePrefix != "Dr." {
+		t.Errorf("name_prefix = %v, want Dr.", name.NamePrefix)
+	}
+	if name.SurnamePrefix == nil || *name.SurnamePrefix != "van der" {
+		t.Errorf("surname_prefix = %v, want van der", name.SurnamePrefix)
+	}
+	if name.NameSuffix == nil || *name.NameSuffix != "Jr." {
+		t.Errorf("name_suffix = %v, want Jr.", name.NameSuffix)
+	}
+}
+
+func TestCreatePerson_FullNameExplicitFieldsWin(t *testing.T) {
+	server := setupTestServer()
+	body := `{"full_name":"Smith, John","given_name":"Johnny"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+
+	var resp map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp["given_name"] != "Johnny" || resp["surname"] != "Smith" {
+		t.Errorf("name = %v %v, want Johnny Smith", resp["given_name"], resp["surname"])
+	}
+}
+
 func TestListPersons(t *testing.T) {
 	server := setupTestServer()
 
@@ -117,192 +323,849 @@ func TestListPersons(t *testing.T) {
 		t.Errorf("Status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp map[string]any
+	var resp map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp["total"].(float64) != 1 {
+		t.Errorf("total = %v, want 1", resp["total"])
+	}
+	items := resp["items"].([]any)
+	if len(items) != 1 {
+		t.Fatalf("items count = %d, want 1", len(items))
+	}
+	item := items[0].(map[string]any)
+	for _, field := range []string{"created_at", "updated_at"} {
+		value, _ := item[field].(string)
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			t.Errorf("%s = %v, want an RFC 3339 timestamp", field, item[field])
+		}
+	}
+}
+
+func TestListPersons_SurnameAndGenderFilter(t *testing.T) {
+	server := setupTestServer()
+
+	for _, body := range []string{
+		`{"given_name":"Anna","surname":"Müller","gender":"female"}`,
+		`{"given_name":"Carl","surname":"Müller","gender":"male"}`,
+		`{"given_name":"Emma","surname":"Schmidt","gender":"female"}`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("create person: status = %d, body = %s", rec.Code, rec.Body.String())
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons?surname=M%C3%BCller&gender=female&sort=birth_date", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var resp api.PersonList
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Total != 1 || len(resp.Items) != 1 || resp.Items[0].GivenName != "Anna" {
+		t.Errorf("got %d items (total %d), want only Anna", len(resp.Items), resp.Total)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/persons?gender=other", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid gender: Status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGetPerson(t *testing.T) {
+	server := setupTestServer()
+
+	// Create a person first
+	body := `{"given_name":"Jane","surname":"Smith"}`
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	createReq.Header.Set("Content-Type", "application/json")
+	createRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(createRec, createReq)
+
+	var createResp map[string]any
+	json.Unmarshal(createRec.Body.Bytes(), &createResp)
+	personID := createResp["id"].(string)
+
+	// Get the person
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+personID, http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var resp map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp["given_name"] != "Jane" {
+		t.Errorf("given_name = %v, want Jane", resp["given_name"])
+	}
+}
+
+func TestCreatePerson_DateRange(t *testing.T) {
+	server := setupTestServer()
+
+	body := `{"given_name":"Jane","surname":"Smith","birth_date":"BET 1 JAN 1850 AND 1 JAN 1852"}`
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	createReq.Header.Set("Content-Type", "application/json")
+	createRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(createRec, createReq)
+
+	var resp struct {
+		BirthDate map[string]any `json:"birth_date"`
+	}
+	if err := json.Unmarshal(createRec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	want := map[string]any{
+		"raw":       "BET 1 JAN 1850 AND 1 JAN 1852",
+		"qualifier": "bet",
+		"year":      float64(1850),
+		"year2":     float64(1852),
+		"month2":    float64(1),
+		"day2":      float64(1),
+		"sort_date": "1851-01-01",
+		"uncertain": true,
+	}
+	for key, w := range want {
+		if resp.BirthDate[key] != w {
+			t.Errorf("birth_date.%s = %v, want %v", key, resp.BirthDate[key], w)
+		}
+	}
+}
+
+func TestCreatePerson_DualDate(t *testing.T) {
+	server := setupTestServer()
+
+	body := `{"given_name":"Jane","surname":"Smith","birth_date":"24 MAR 1719/20"}`
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	createReq.Header.Set("Content-Type", "application/json")
+	createRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(createRec, createReq)
+
+	if createRec.Code != http.StatusCreated {
+		t.Fatalf("Status = %d, want %d: %s", createRec.Code, http.StatusCreated, createRec.Body.String())
+	}
+
+	var resp struct {
+		BirthDate map[string]any `json:"birth_date"`
+	}
+	if err := json.Unmarshal(createRec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	want := map[string]any{
+		"raw":       "24 MAR 1719/20",
+		"year":      float64(1719),
+		"dual_year": float64(1720),
+		"sort_date": "1720-03-24",
+	}
+	for key, w := range want {
+		if resp.BirthDate[key] != w {
+			t.Errorf("birth_date.%s = %v, want %v", key, resp.BirthDate[key], w)
+		}
+	}
+}
+
+func TestGetPerson_NotFound(t *testing.T) {
+	server := setupTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/00000000-0000-0000-0000-000000000001", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestGetPerson_ETag(t *testing.T) {
+	server := setupTestServer()
+
+	body := `{"given_name":"Jane","surname":"Smith"}`
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	createReq.Header.Set("Content-Type", "application/json")
+	createRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(createRec, createReq)
+
+	var createResp map[string]any
+	json.Unmarshal(createRec.Body.Bytes(), &createResp)
+	personID := createResp["id"].(string)
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+personID, http.NoBody)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Creating a person also creates a primary name, so the version is 2
+	rec := get("")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	etag := rec.Header().Get("ETag")
+	if !strings.HasPrefix(etag, `"2-`) {
+		t.Fatalf("ETag = %q, want version 2", etag)
+	}
+
+	for _, header := range []string{etag, "W/" + etag, `"1", ` + etag, "*"} {
+		rec = get(header)
+		if rec.Code != http.StatusNotModified {
+			t.Errorf("If-None-Match %s: Status = %d, want %d", header, rec.Code, http.StatusNotModified)
+		}
+		if rec.Body.Len() != 0 {
+			t.Errorf("If-None-Match %s: body = %q, want empty", header, rec.Body.String())
+		}
+		if rec.Header().Get("ETag") != etag {
+			t.Errorf("If-None-Match %s: ETag = %q, want %q", header, rec.Header().Get("ETag"), etag)
+		}
+	}
+
+	// A stale ETag returns the full record
+	updateBody := `{"given_name":"Janet","version":2}`
+	updateReq := httptest.NewRequest(http.MethodPut, "/api/v1/persons/"+personID, strings.NewReader(updateBody))
+	updateReq.Header.Set("Content-Type", "application/json")
+	server.Echo().ServeHTTP(httptest.NewRecorder(), updateReq)
+
+	rec = get(etag)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if !strings.HasPrefix(rec.Header().Get("ETag"), `"3-`) {
+		t.Errorf("ETag = %q, want version 3", rec.Header().Get("ETag"))
+	}
+}
+
+func TestGetPerson_ETagCoversFamilies(t *testing.T) {
+	server := setupTestServer()
+
+	create := func(path, body string) string {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("POST %s: Status = %d. Body: %s", path, rec.Code, rec.Body.String())
+		}
+		var resp map[string]any
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		id, _ := resp["id"].(string)
+		return id
+	}
+	etagOf := func(personID string) string {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+personID, http.NoBody)
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		return rec.Header().Get("ETag")
+	}
+
+	janeID := create("/api/v1/persons", `{"given_name":"Jane","surname":"Smith"}`)
+	johnID := create("/api/v1/persons", `{"given_name":"John","surname":"Doe"}`)
+	fatherID := create("/api/v1/persons", `{"given_name":"George","surname":"Smith"}`)
+	alone := etagOf(janeID)
+
+	// Family changes leave Jane's version alone but change her ETag, since
+	// her record embeds summaries of her families
+	create("/api/v1/families", `{"partner1_id":"`+janeID+`","partner2_id":"`+johnID+`"}`)
+	married := etagOf(janeID)
+	if married == alone {
+		t.Errorf("ETag = %q after joining a family as a partner, want it to change", married)
+	}
+
+	parentsID := create("/api/v1/families", `{"partner1_id":"`+fatherID+`"}`)
+	create("/api/v1/families/"+parentsID+"/children", `{"person_id":"`+janeID+`"}`)
+	if child := etagOf(janeID); child == married || !strings.HasPrefix(child, `"2-`) {
+		t.Errorf("ETag = %q after joining a family as a child, want a new ETag for version 2", child)
+	}
+
+	// The ETag still works as If-Match for an update
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/persons/"+janeID, strings.NewReader(`{"given_name":"Janet"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", etagOf(janeID))
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("If-Match: Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+}
+
+func TestUpdatePerson(t *testing.T) {
+	server := setupTestServer()
+
+	// Create a person first
+	body := `{"given_name":"John","surname":"Doe"}`
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	createReq.Header.Set("Content-Type", "application/json")
+	createRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(createRec, createReq)
+
+	var createResp map[string]any
+	json.Unmarshal(createRec.Body.Bytes(), &createResp)
+	personID := createResp["id"].(string)
+
+	// Update the person (version is 2 because creating a person also creates a primary name)
+	updateBody := `{"given_name":"Jane","version":2}`
+	updateReq := httptest.NewRequest(http.MethodPut, "/api/v1/persons/"+personID, strings.NewReader(updateBody))
+	updateReq.Header.Set("Content-Type", "application/json")
+	updateRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(updateRec, updateReq)
+
+	if updateRec.Code != http.StatusOK {
+		t.Errorf("Status = %d, want %d. Body: %s", updateRec.Code, http.StatusOK, updateRec.Body.String())
+	}
+
+	var updateResp map[string]any
+	if err := json.Unmarshal(updateRec.Body.Bytes(), &updateResp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if updateResp["given_name"] != "Jane" {
+		t.Errorf("given_name = %v, want Jane", updateResp["given_name"])
+	}
+}
+
+func TestUpdatePerson_VersionConflict(t *testing.T) {
+	server := setupTestServer()
+
+	// Create a person
+	body := `{"given_name":"John","surname":"Doe"}`
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	createReq.Header.Set("Content-Type", "application/json")
+	createRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(createRec, createReq)
+
+	var createResp map[string]any
+	json.Unmarshal(createRec.Body.Bytes(), &createResp)
+	personID := createResp["id"].(string)
+
+	// Update with wrong version
+	updateBody := `{"given_name":"Jane","version":999}`
+	updateReq := httptest.NewRequest(http.MethodPut, "/api/v1/persons/"+personID, strings.NewReader(updateBody))
+	updateReq.Header.Set("Content-Type", "application/json")
+	updateRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(updateRec, updateReq)
+
+	if updateRec.Code != http.StatusConflict {
+		t.Errorf("Status = %d, want %d", updateRec.Code, http.StatusConflict)
+	}
+}
+
+func TestUpdatePerson_IfMatch(t *testing.T) {
+	server := setupTestServer()
+
+	body := `{"given_name":"John","surname":"Doe"}`
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	createReq.Header.Set("Content-Type", "application/json")
+	createRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(createRec, createReq)
+
+	var createResp map[string]any
+	json.Unmarshal(createRec.Body.Bytes(), &createResp)
+	personID := createResp["id"].(string)
+
+	update := func(path, body, ifMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		return rec
+	}
+	path := "/api/v1/persons/" + personID
+
+	// The header stands in for the body version
+	rec := update(path, `{"given_name":"Jane"}`, `"2"`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	// A stale ETag fails the precondition, even when retry is requested
+	for _, p := range []string{path, path + "?retry=true"} {
+		rec = update(p, `{"given_name":"Janet"}`, `"2"`)
+		if rec.Code != http.StatusPreconditionFailed {
+			t.Errorf("%s: Status = %d, want %d", p, rec.Code, http.StatusPreconditionFailed)
+		}
+	}
+
+	for _, tc := range []struct{ name, body, ifMatch string }{
+		{"no version", `{"given_name":"Janet"}`, ""},
+		{"weak tag", `{"given_name":"Janet"}`, `W/"3"`},
+		{"wildcard", `{"given_name":"Janet"}`, "*"},
+		{"disagreeing body", `{"given_name":"Janet","version":2}`, `"3"`},
+	} {
+		rec = update(path, tc.body, tc.ifMatch)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: Status = %d, want %d", tc.name, rec.Code, http.StatusBadRequest)
+		}
+	}
+
+	// Body version still works, and may agree with the header
+	rec = update(path, `{"given_name":"Janet","version":3}`, `"3"`)
+	if rec.Code != http.StatusOK {
+		t.Errorf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+}
+
+func TestUpdatePerson_VersionConflictWithRetry(t *testing.T) {
+	server := setupTestServer()
+
+	// Create a person
+	body := `{"given_name":"John","surname":"Doe"}`
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	createReq.Header.Set("Content-Type", "application/json")
+	createRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(createRec, createReq)
+
+	var createResp map[string]any
+	json.Unmarshal(createRec.Body.Bytes(), &createResp)
+	personID := createResp["id"].(string)
+
+	// Someone else updates a different field first (version 2 -> 3)
+	otherBody := `{"birth_place":"Boston","version":2}`
+	otherReq := httptest.NewRequest(http.MethodPut, "/api/v1/persons/"+personID, strings.NewReader(otherBody))
+	otherReq.Header.Set("Content-Type", "application/json")
+	otherRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(otherRec, otherReq)
+	if otherRec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", otherRec.Code, http.StatusOK, otherRec.Body.String())
+	}
+
+	// Stale update succeeds when retry is requested
+	updateBody := `{"given_name":"Jane","version":2}`
+	updateReq := httptest.NewRequest(http.MethodPut, "/api/v1/persons/"+personID+"?retry=true", strings.NewReader(updateBody))
+	updateReq.Header.Set("Content-Type", "application/json")
+	updateRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(updateRec, updateReq)
+
+	if updateRec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", updateRec.Code, http.StatusOK, updateRec.Body.String())
+	}
+
+	var updateResp map[string]any
+	if err := json.Unmarshal(updateRec.Body.Bytes(), &updateResp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if updateResp["given_name"] != "Jane" {
+		t.Errorf("given_name = %v, want Jane", updateResp["given_name"])
+	}
+	if updateResp["birth_place"] != "Boston" {
+		t.Errorf("birth_place = %v, want Boston", updateResp["birth_place"])
+	}
+	if updateResp["version"] != float64(4) {
+		t.Errorf("version = %v, want 4", updateResp["version"])
+	}
+}
+
+func TestDeletePerson(t *testing.T) {
+	server := setupTestServer()
+
+	// Create a person
+	body := `{"given_name":"John","surname":"Doe"}`
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	createReq.Header.Set("Content-Type", "application/json")
+	createRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(createRec, createReq)
+
+	var createResp map[string]any
+	json.Unmarshal(createRec.Body.Bytes(), &createResp)
+	personID := createResp["id"].(string)
+
+	// Delete the person (version is 2 because creating a person also creates a primary name)
+	deleteReq := httptest.NewRequest(http.MethodDelete, "/api/v1/persons/"+personID+"?version=2", http.NoBody)
+	deleteRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(deleteRec, deleteReq)
+
+	if deleteRec.Code != http.StatusNoContent {
+		t.Errorf("Status = %d, want %d", deleteRec.Code, http.StatusNoContent)
+	}
+
+	// Verify person is gone
+	getReq := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+personID, http.NoBody)
+	getRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(getRec, getReq)
+
+	if getRec.Code != http.StatusNotFound {
+		t.Errorf("Status = %d after delete, want %d", getRec.Code, http.StatusNotFound)
+	}
+}
+
+func TestSearchPersons(t *testing.T) {
+	server := setupTestServer()
+
+	// Create some persons
+	for _, name := range []string{"John Smith", "Jane Smith", "Bob Johnson"} {
+		parts := strings.Split(name, " ")
+		body := `{"given_name":"` + parts[0] + `","surname":"` + parts[1] + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+	}
+
+	// Search for Smith
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q=Smith", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var resp map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp["total"].(float64) != 2 {
+		t.Errorf("total = %v, want 2", resp["total"])
+	}
+}
+
+func TestSearchPersons_MetaphoneAlgorithm(t *testing.T) {
+	server := setupTestServer()
+
+	for _, surname := range []string{"Reilly", "Riley", "Smith"} {
+		body := `{"given_name":"Pat","surname":"` + surname + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q=Riley&algorithm=metaphone", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var resp struct {
+		Total int `json:"total"`
+		Items []struct {
+			Surname   string `json:"surname"`
+			MatchedBy string `json:"matched_by"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Total != 2 {
+		t.Fatalf("total = %d, want 2", resp.Total)
+	}
+	want := map[string]string{"Riley": "exact", "Reilly": "metaphone"}
+	for _, item := range resp.Items {
+		if item.MatchedBy != want[item.Surname] {
+			t.Errorf("%s matched_by = %q, want %q", item.Surname, item.MatchedBy, want[item.Surname])
+		}
+	}
+}
+
+func TestSearchPersons_Wildcard(t *testing.T) {
+	server := setupTestServer()
+
+	for _, surname := range []string{"Smith", "Smithson", "Goldsmith"} {
+		body := `{"given_name":"Pat","surname":"` + surname + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"Smi*", []string{"Smith", "Smithson"}},
+		{"*son", []string{"Smithson"}},
+		{"*smith", []string{"Goldsmith", "Smith"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/search?sort=name&q="+url.QueryEscape(tt.query), http.NoBody)
+			rec := httptest.NewRecorder()
+			server.Echo().ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+			}
+
+			var resp struct {
+				Items []struct {
+					Surname string `json:"surname"`
+				} `json:"items"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to parse response: %v", err)
+			}
+			var got []string
+			for _, item := range resp.Items {
+				got = append(got, item.Surname)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("surnames = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchPersons_IncludesPlaces(t *testing.T) {
+	server := setupTestServer()
+
+	body := `{"given_name":"John","surname":"Smith","birth_place":"Cork, Ireland","death_place":"Boston, Massachusetts"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	server.Echo().ServeHTTP(httptest.NewRecorder(), req)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/search?q=Smith", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var resp struct {
+		Items []struct {
+			BirthPlace string `json:"birth_place"`
+			DeathPlace string `json:"death_place"`
+		} `json:"items"`
+	}
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to parse response: %v", err)
 	}
-	if resp["total"].(float64) != 1 {
-		t.Errorf("total = %v, want 1", resp["total"])
+	if len(resp.Items) != 1 {
+		t.Fatalf("items = %d, want 1", len(resp.Items))
 	}
-	items := resp["items"].([]any)
-	if len(items) != 1 {
-		t.Errorf("items count = %d, want 1", len(items))
+	if got := resp.Items[0]; got.BirthPlace != "Cork, Ireland" || got.DeathPlace != "Boston, Massachusetts" {
+		t.Errorf("places = %+v, want Cork and Boston", got)
 	}
 }
 
-func TestGetPerson(t *testing.T) {
+func TestSearchPersons_Matches(t *testing.T) {
 	server := setupTestServer()
 
-	// Create a person first
-	body := `{"given_name":"Jane","surname":"Smith"}`
-	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
-	createReq.Header.Set("Content-Type", "application/json")
-	createRec := httptest.NewRecorder()
-	server.Echo().ServeHTTP(createRec, createReq)
-
-	var createResp map[string]any
-	json.Unmarshal(createRec.Body.Bytes(), &createResp)
-	personID := createResp["id"].(string)
+	body := `{"given_name":"Anna","surname":"Goldsmith","birth_place":"Boston, Massachusetts"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	server.Echo().ServeHTTP(httptest.NewRecorder(), req)
 
-	// Get the person
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+personID, http.NoBody)
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/search?q=smith&birth_place=boston", http.NoBody)
 	rec := httptest.NewRecorder()
 	server.Echo().ServeHTTP(rec, req)
 
 	if rec.Code != http.StatusOK {
-		t.Errorf("Status = %d, want %d", rec.Code, http.StatusOK)
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
 	}
 
-	var resp map[string]any
+	var resp struct {
+		Items []struct {
+			Matches []struct {
+				Field string `json:"field"`
+				Value string `json:"value"`
+				Start int    `json:"start"`
+				End   int    `json:"end"`
+			} `json:"matches"`
+		} `json:"items"`
+	}
 	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to parse response: %v", err)
 	}
-	if resp["given_name"] != "Jane" {
-		t.Errorf("given_name = %v, want Jane", resp["given_name"])
+	if len(resp.Items) != 1 {
+		t.Fatalf("items = %d, want 1", len(resp.Items))
+	}
+	matches := resp.Items[0].Matches
+	if len(matches) != 2 {
+		t.Fatalf("matches = %+v, want surname and birth place", matches)
+	}
+	if m := matches[0]; m.Field != "surname" || m.Value[m.Start:m.End] != "smith" {
+		t.Errorf("first match = %+v, want surname span \"smith\"", m)
+	}
+	if m := matches[1]; m.Field != "birth_place" || m.Value[m.Start:m.End] != "Boston" {
+		t.Errorf("second match = %+v, want birth_place span \"Boston\"", m)
 	}
 }
 
-func TestGetPerson_NotFound(t *testing.T) {
+func TestSearchPersons_InvalidAlgorithm(t *testing.T) {
 	server := setupTestServer()
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/00000000-0000-0000-0000-000000000001", http.NoBody)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q=Smith&algorithm=nysiis", http.NoBody)
 	rec := httptest.NewRecorder()
 
 	server.Echo().ServeHTTP(rec, req)
 
-	if rec.Code != http.StatusNotFound {
-		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusBadRequest)
 	}
 }
 
-func TestUpdatePerson(t *testing.T) {
+func TestAdvancedSearchPersons(t *testing.T) {
 	server := setupTestServer()
 
-	// Create a person first
-	body := `{"given_name":"John","surname":"Doe"}`
-	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
-	createReq.Header.Set("Content-Type", "application/json")
-	createRec := httptest.NewRecorder()
-	server.Echo().ServeHTTP(createRec, createReq)
-
-	var createResp map[string]any
-	json.Unmarshal(createRec.Body.Bytes(), &createResp)
-	personID := createResp["id"].(string)
+	for _, body := range []string{
+		`{"given_name":"John","surname":"Miller","gender":"male","birth_date":"1845","birth_place":"Columbus, Ohio"}`,
+		`{"given_name":"John","surname":"Baker","gender":"male","birth_date":"1860","birth_place":"Dayton, Ohio"}`,
+		`{"given_name":"Mary","surname":"Miller","gender":"female","birth_date":"1846","birth_place":"Akron, Ohio"}`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("create person: status = %d, body = %s", rec.Code, rec.Body.String())
+		}
+	}
 
-	// Update the person (version is 2 because creating a person also creates a primary name)
-	updateBody := `{"given_name":"Jane","version":2}`
-	updateReq := httptest.NewRequest(http.MethodPut, "/api/v1/persons/"+personID, strings.NewReader(updateBody))
-	updateReq.Header.Set("Content-Type", "application/json")
-	updateRec := httptest.NewRecorder()
-	server.Echo().ServeHTTP(updateRec, updateReq)
+	body := `{"given_name":"John","gender":"male","birth_year_from":1840,"birth_year_to":1850,"birth_place":"ohio"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/search/advanced", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
 
-	if updateRec.Code != http.StatusOK {
-		t.Errorf("Status = %d, want %d. Body: %s", updateRec.Code, http.StatusOK, updateRec.Body.String())
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d; body = %s", rec.Code, http.StatusOK, rec.Body.String())
 	}
 
-	var updateResp map[string]any
-	if err := json.Unmarshal(updateRec.Body.Bytes(), &updateResp); err != nil {
+	var resp struct {
+		Total int `json:"total"`
+		Items []struct {
+			Surname string `json:"surname"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
 		t.Fatalf("Failed to parse response: %v", err)
 	}
-	if updateResp["given_name"] != "Jane" {
-		t.Errorf("given_name = %v, want Jane", updateResp["given_name"])
+	if resp.Total != 1 || resp.Items[0].Surname != "Miller" {
+		t.Errorf("got %+v, want only John Miller", resp)
 	}
 }
 
-func TestUpdatePerson_VersionConflict(t *testing.T) {
+func TestAdvancedSearchPersons_BadRequest(t *testing.T) {
 	server := setupTestServer()
 
-	// Create a person
-	body := `{"given_name":"John","surname":"Doe"}`
-	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
-	createReq.Header.Set("Content-Type", "application/json")
-	createRec := httptest.NewRecorder()
-	server.Echo().ServeHTTP(createRec, createReq)
-
-	var createResp map[string]any
-	json.Unmarshal(createRec.Body.Bytes(), &createResp)
-	personID := createResp["id"].(string)
-
-	// Update with wrong version
-	updateBody := `{"given_name":"Jane","version":999}`
-	updateReq := httptest.NewRequest(http.MethodPut, "/api/v1/persons/"+personID, strings.NewReader(updateBody))
-	updateReq.Header.Set("Content-Type", "application/json")
-	updateRec := httptest.NewRecorder()
-	server.Echo().ServeHTTP(updateRec, updateReq)
+	for _, body := range []string{
+		`{}`,
+		`{"birth_year_from":1900,"birth_year_to":1800}`,
+		`{"surname":"Smith","gender":"other"}`,
+		`{"surname":"S"}`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/search/advanced", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
 
-	if updateRec.Code != http.StatusConflict {
-		t.Errorf("Status = %d, want %d", updateRec.Code, http.StatusConflict)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("body %s: Status = %d, want %d", body, rec.Code, http.StatusBadRequest)
+		}
 	}
 }
 
-func TestDeletePerson(t *testing.T) {
+func TestSearchPersons_QueryTooShort(t *testing.T) {
 	server := setupTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q=a", http.NoBody)
+	rec := httptest.NewRecorder()
 
-	// Create a person
-	body := `{"given_name":"John","surname":"Doe"}`
-	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
-	createReq.Header.Set("Content-Type", "application/json")
-	createRec := httptest.NewRecorder()
-	server.Echo().ServeHTTP(createRec, createReq)
+	server.Echo().ServeHTTP(rec, req)
 
-	var createResp map[string]any
-	json.Unmarshal(createRec.Body.Bytes(), &createResp)
-	personID := createResp["id"].(string)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
 
-	// Delete the person (version is 2 because creating a person also creates a primary name)
-	deleteReq := httptest.NewRequest(http.MethodDelete, "/api/v1/persons/"+personID+"?version=2", http.NoBody)
-	deleteRec := httptest.NewRecorder()
-	server.Echo().ServeHTTP(deleteRec, deleteReq)
+func TestSearchPersons_ConfiguredSearchLimits(t *testing.T) {
+	cfg := &config.Config{SearchMinQueryLength: 1, SearchMaxLimit: 2}
+	eventStore := memory.NewEventStore()
+	server := api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil)
 
-	if deleteRec.Code != http.StatusNoContent {
-		t.Errorf("Status = %d, want %d", deleteRec.Code, http.StatusNoContent)
+	for _, given := range []string{"伟", "芳", "静"} {
+		body := `{"given_name":"` + given + `","surname":"王"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("Create person: status = %d: %s", rec.Code, rec.Body.String())
+		}
 	}
 
-	// Verify person is gone
-	getReq := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+personID, http.NoBody)
-	getRec := httptest.NewRecorder()
-	server.Echo().ServeHTTP(getRec, getReq)
+	// A single CJK character is a valid query, and the requested limit is
+	// capped at SearchMaxLimit.
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q="+url.QueryEscape("王")+"&limit=1000", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
 
-	if getRec.Code != http.StatusNotFound {
-		t.Errorf("Status = %d after delete, want %d", getRec.Code, http.StatusNotFound)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var resp struct {
+		Items []map[string]any `json:"items"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+	if len(resp.Items) != 2 {
+		t.Errorf("len(items) = %d, want 2", len(resp.Items))
 	}
 }
 
-func TestSearchPersons(t *testing.T) {
-	server := setupTestServer()
+func TestAdvancedSearchPersons_ConfiguredSearchLimits(t *testing.T) {
+	cfg := &config.Config{SearchMinQueryLength: 1, SearchMaxLimit: 2}
+	eventStore := memory.NewEventStore()
+	server := api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil)
 
-	// Create some persons
-	for _, name := range []string{"John Smith", "Jane Smith", "Bob Johnson"} {
-		parts := strings.Split(name, " ")
-		body := `{"given_name":"` + parts[0] + `","surname":"` + parts[1] + `"}`
+	for _, given := range []string{"伟", "芳", "静"} {
+		body := `{"given_name":"` + given + `","surname":"王"}`
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
 		req.Header.Set("Content-Type", "application/json")
 		rec := httptest.NewRecorder()
 		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("Create person: status = %d: %s", rec.Code, rec.Body.String())
+		}
 	}
 
-	// Search for Smith
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q=Smith", http.NoBody)
+	// A single-character surname is allowed, and the requested limit is
+	// capped at SearchMaxLimit.
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/search/advanced", strings.NewReader(`{"surname":"王","limit":1000}`))
+	req.Header.Set("Content-Type", "application/json")
 	rec := httptest.NewRecorder()
 	server.Echo().ServeHTTP(rec, req)
 
 	if rec.Code != http.StatusOK {
-		t.Errorf("Status = %d, want %d", rec.Code, http.StatusOK)
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
 	}
-
-	var resp map[string]any
-	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
-		t.Fatalf("Failed to parse response: %v", err)
+	var resp struct {
+		Items []map[string]any `json:"items"`
 	}
-	if resp["total"].(float64) != 2 {
-		t.Errorf("total = %v, want 2", resp["total"])
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+	if len(resp.Items) != 2 {
+		t.Errorf("len(items) = %d, want 2", len(resp.Items))
 	}
 }
 
-func TestSearchPersons_QueryTooShort(t *testing.T) {
+func TestSearchPersons_MinQueryLengthCountsCharacters(t *testing.T) {
 	server := setupTestServer()
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q=a", http.NoBody)
-	rec := httptest.NewRecorder()
 
+	// "王" is three bytes but one character, so the default minimum of two
+	// characters rejects it.
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q="+url.QueryEscape("王"), http.NoBody)
+	rec := httptest.NewRecorder()
 	server.Echo().ServeHTTP(rec, req)
 
 	if rec.Code != http.StatusBadRequest {
@@ -335,6 +1198,41 @@ func TestOpenAPISpec(t *testing.T) {
 	}
 }
 
+func TestOpenAPISpecJSON(t *testing.T) {
+	server := setupTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if contentType := rec.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "application/json") {
+		t.Errorf("Content-Type = %s, want application/json", contentType)
+	}
+
+	var spec struct {
+		OpenAPI string `json:"openapi"`
+		Info    struct {
+			Title string `json:"title"`
+		} `json:"info"`
+		Paths map[string]any `json:"paths"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &spec); err != nil {
+		t.Fatalf("Failed to parse spec: %v", err)
+	}
+	if spec.OpenAPI != "3.0.3" {
+		t.Errorf("openapi = %q, want 3.0.3", spec.OpenAPI)
+	}
+	if spec.Info.Title != "My Family Genealogy API" {
+		t.Errorf("info.title = %q, want My Family Genealogy API", spec.Info.Title)
+	}
+	if _, ok := spec.Paths["/persons/{id}"]; !ok {
+		t.Error("Expected /persons/{id} in spec paths")
+	}
+}
+
 func TestSwaggerUI(t *testing.T) {
 	server := setupTestServer()
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/docs", http.NoBody)
@@ -465,6 +1363,144 @@ func TestDeletePerson_NotFound(t *testing.T) {
 	}
 }
 
+func TestBulkDeletePersons(t *testing.T) {
+	server := setupTestServer()
+
+	createPerson := func(given string) string {
+		body := `{"given_name":"` + given + `","surname":"Doe"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		var resp map[string]any
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		return resp["id"].(string)
+	}
+	current := createPerson("Current")
+	stale := createPerson("Stale")
+	missing := "00000000-0000-0000-0000-000000000001"
+
+	// Version is 2 after create (the primary name is added as a second event)
+	body := `{"deletions":[` +
+		`{"id":"` + current + `","version":2},` +
+		`{"id":"` + stale + `","version":1},` +
+		`{"id":"` + missing + `","version":1}]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/persons/bulk-delete", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp struct {
+		Total      int `json:"total"`
+		Successful int `json:"successful"`
+		Failed     int `json:"failed"`
+		Results    []struct {
+			ID      string `json:"id"`
+			Success bool   `json:"success"`
+			Status  string `json:"status"`
+		} `json:"results"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+
+	if resp.Total != 3 || resp.Successful != 1 || resp.Failed != 2 {
+		t.Errorf("total/successful/failed = %d/%d/%d, want 3/1/2", resp.Total, resp.Successful, resp.Failed)
+	}
+	wantStatuses := []string{"deleted", "conflict", "not_found"}
+	for i, want := range wantStatuses {
+		if i >= len(resp.Results) {
+			t.Fatalf("Got %d results, want %d", len(resp.Results), len(wantStatuses))
+		}
+		if resp.Results[i].Status != want {
+			t.Errorf("Results[%d].Status = %q, want %q", i, resp.Results[i].Status, want)
+		}
+	}
+
+	// The stale-version person must survive
+	getReq := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+stale, http.NoBody)
+	getRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(getRec, getReq)
+	if getRec.Code != http.StatusOK {
+		t.Errorf("Stale person: status = %d, want %d", getRec.Code, http.StatusOK)
+	}
+	getReq = httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+current, http.NoBody)
+	getRec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(getRec, getReq)
+	if getRec.Code != http.StatusNotFound {
+		t.Errorf("Deleted person: status = %d, want %d", getRec.Code, http.StatusNotFound)
+	}
+}
+
+// failingAppendStore fails every Append once failing is set.
+type failingAppendStore struct {
+	*memory.EventStore
+	failing bool
+}
+
+func (s *failingAppendStore) Append(ctx context.Context, streamID uuid.UUID, streamType string, events []domain.Event, expectedVersion int64) error {
+	if s.failing {
+		return errors.New("write /var/lib/myfamily/events.db: disk I/O error")
+	}
+	return s.EventStore.Append(ctx, streamID, streamType, events, expectedVersion)
+}
+
+func TestBulkDeletePersons_InternalErrorHidden(t *testing.T) {
+	memEvents := memory.NewEventStore()
+	eventStore := &failingAppendStore{EventStore: memEvents}
+	server := api.NewServer(&config.Config{Port: 8080, LogFormat: "text"}, eventStore,
+		memory.NewReadModelStore(), memory.NewSnapshotStore(memEvents), nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(`{"given_name":"John","surname":"Doe"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	var created map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &created)
+	id, ok := created["id"].(string)
+	if !ok {
+		t.Fatalf("Create person failed: %s", rec.Body.String())
+	}
+
+	eventStore.failing = true
+	body := `{"deletions":[{"id":"` + id + `","version":2}]}`
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/persons/bulk-delete", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if strings.Contains(rec.Body.String(), "disk I/O") || strings.Contains(rec.Body.String(), "/var/lib") {
+		t.Errorf("Response leaks the internal error: %s", rec.Body.String())
+	}
+	var resp struct {
+		Results []struct {
+			Status string `json:"status"`
+			Error  string `json:"error"`
+		} `json:"results"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+	if len(resp.Results) != 1 || resp.Results[0].Status != "error" || resp.Results[0].Error != "An unexpected error occurred" {
+		t.Errorf("Results = %+v, want one generic error", resp.Results)
+	}
+}
+
+func TestBulkDeletePersons_Empty(t *testing.T) {
+	server := setupTestServer()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/persons/bulk-delete", strings.NewReader(`{"deletions":[]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
 func TestSearchPersons_EmptyQuery(t *testing.T) {
 	server := setupTestServer()
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q=", http.NoBody)
@@ -565,6 +1601,68 @@ func TestGetPerson_WithFamilies(t *testing.T) {
 	}
 }
 
+func TestGetPerson_HomeRelationship(t *testing.T) {
+	cfg := &config.Config{Port: 8080, LogFormat: "text"}
+	eventStore := memory.NewEventStore()
+	server := api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil)
+
+	post := func(path, body string) string {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated && rec.Code != http.StatusOK {
+			t.Fatalf("POST %s: status = %d. Body: %s", path, rec.Code, rec.Body.String())
+		}
+		var resp map[string]any
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		id, _ := resp["id"].(string)
+		return id
+	}
+	// linkChild records child as the only child of a single-parent family.
+	linkChild := func(parentID, childID string) {
+		familyID := post("/api/v1/families", `{"partner1_id":"`+parentID+`"}`)
+		post("/api/v1/families/"+familyID+"/children", `{"person_id":"`+childID+`"}`)
+	}
+	relationship := func(personID string) any {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+personID, http.NoBody)
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET person: status = %d, want %d", rec.Code, http.StatusOK)
+		}
+		var resp map[string]any
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		return resp["relationship"]
+	}
+
+	grandparent := post("/api/v1/persons", `{"given_name":"Walter","surname":"Smith"}`)
+	parent := post("/api/v1/persons", `{"given_name":"Robert","surname":"Smith"}`)
+	home := post("/api/v1/persons", `{"given_name":"Anna","surname":"Smith"}`)
+	stranger := post("/api/v1/persons", `{"given_name":"Carl","surname":"Jones"}`)
+	linkChild(grandparent, parent)
+	linkChild(parent, home)
+
+	if got := relationship(grandparent); got != nil {
+		t.Errorf("relationship without a home person = %v, want none", got)
+	}
+
+	cfg.HomePerson = home
+	if got := relationship(grandparent); got != "grandparent" {
+		t.Errorf("grandparent relationship = %v, want grandparent", got)
+	}
+	if got := relationship(parent); got != "parent" {
+		t.Errorf("parent relationship = %v, want parent", got)
+	}
+	if got := relationship(stranger); got != nil {
+		t.Errorf("unrelated person relationship = %v, want none", got)
+	}
+}
+
 func TestGetFamilyGroupSheet(t *testing.T) {
 	server := setupTestServer()
 
@@ -10,6 +10,7 @@ import (
 // convertQueryChangeEntryToGenerated converts a query.ChangeEntry to generated ChangeEntry format.
 func convertQueryChangeEntryToGenerated(entry query.ChangeEntry) ChangeEntry {
 	entityName := entry.EntityName
+	summary := entry.Summary
 	resp := ChangeEntry{
 		Id:         entry.ID,
 		Timestamp:  entry.Timestamp,
@@ -17,6 +18,7 @@ func convertQueryChangeEntryToGenerated(entry query.ChangeEntry) ChangeEntry {
 		EntityId:   entry.EntityID,
 		EntityName: &entityName,
 		Action:     ChangeEntryAction(entry.Action),
+		Summary:    &summary,
 		UserId:     entry.UserID,
 	}
 
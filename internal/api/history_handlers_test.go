@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -82,6 +83,9 @@ func TestGetGlobalHistory(t *testing.T) {
 	if firstEntry["action"] != "created" {
 		t.Errorf("action = %v, want created", firstEntry["action"])
 	}
+	if firstEntry["summary"] != "created John Doe" {
+		t.Errorf("summary = %v, want created John Doe", firstEntry["summary"])
+	}
 }
 
 func TestGetGlobalHistory_WithPagination(t *testing.T) {
@@ -170,6 +174,66 @@ func TestGetGlobalHistory_WithEntityTypeFilter(t *testing.T) {
 	}
 }
 
+func TestGetGlobalHistory_WithActionFilter(t *testing.T) {
+	server := setupTestServer()
+
+	var personIDs []string
+	for _, body := range []string{`{"given_name":"John","surname":"Doe"}`, `{"given_name":"Jane","surname":"Doe"}`} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		var created map[string]any
+		_ = json.Unmarshal(rec.Body.Bytes(), &created)
+		personIDs = append(personIDs, created["id"].(string))
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/persons/"+personIDs[0]+"?version=1", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("delete person: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/history?action=deleted", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var resp map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	items := resp["items"].([]any)
+	if len(items) != 1 {
+		t.Fatalf("items count = %d, want 1", len(items))
+	}
+	entry := items[0].(map[string]any)
+	if entry["action"] != "deleted" || entry["entity_id"] != personIDs[0] {
+		t.Errorf("entry = %v, want deletion of %s", entry, personIDs[0])
+	}
+
+	// No person event is a child link, so the combination matches nothing
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/history?entity_type=person&action=linked", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if items := resp["items"].([]any); len(items) != 0 {
+		t.Errorf("items count = %d, want 0", len(items))
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/history?action=renamed", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid action: Status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
 func TestGetGlobalHistory_InvalidEntityType(t *testing.T) {
 	server := setupTestServer()
 
@@ -248,6 +312,171 @@ func TestGetGlobalHistory_InvalidTimeFormat(t *testing.T) {
 	}
 }
 
+func TestListRecentChanges(t *testing.T) {
+	server := setupTestServer()
+
+	createPerson := func(body string) map[string]any {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("CreatePerson failed: %d - %s", rec.Code, rec.Body.String())
+		}
+		var person map[string]any
+		_ = json.Unmarshal(rec.Body.Bytes(), &person)
+		return person
+	}
+	john := createPerson(`{"given_name":"John","surname":"Doe"}`)
+	createPerson(`{"given_name":"Jane","surname":"Doe"}`)
+
+	// Touch John again so he is the most recent change
+	johnID := john["id"].(string)
+	body := `{"birth_place":"Boston","version":` + strconv.Itoa(int(john["version"].(float64))) + `}`
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/persons/"+johnID, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("UpdatePerson failed: %d - %s", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/recent?limit=5", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp struct {
+		Items []struct {
+			EntityID   string         `json:"entity_id"`
+			EntityName string         `json:"entity_name"`
+			Action     string         `json:"action"`
+			Changes    map[string]any `json:"changes"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	// One entry per entity, newest first
+	if len(resp.Items) != 2 {
+		t.Fatalf("len(items) = %d, want 2", len(resp.Items))
+	}
+	first := resp.Items[0]
+	if first.EntityID != johnID || first.Action != "updated" {
+		t.Errorf("items[0] = %+v, want John's update", first)
+	}
+	if first.Changes != nil {
+		t.Errorf("items[0].changes = %v, want omitted", first.Changes)
+	}
+	if resp.Items[1].EntityName != "Jane Doe" || resp.Items[1].Action != "created" {
+		t.Errorf("items[1] = %+v, want Jane's creation", resp.Items[1])
+	}
+
+	// Limit applies to entities
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/recent?limit=1", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if len(resp.Items) != 1 || resp.Items[0].EntityID != johnID {
+		t.Errorf("limit=1 items = %+v, want only John", resp.Items)
+	}
+}
+
+func TestTrashRestore(t *testing.T) {
+	server := setupTestServer()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(`{"given_name":"John","surname":"Doe","birth_place":"Boston"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	var person map[string]any
+	_ = json.Unmarshal(rec.Body.Bytes(), &person)
+	personID := person["id"].(string)
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/persons/"+personID+"?version=1", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("DeletePerson failed: %d - %s", rec.Code, rec.Body.String())
+	}
+
+	// The deleted person is in the trash
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/trash", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var trash struct {
+		Items []struct {
+			EntityType string `json:"entity_type"`
+			EntityID   string `json:"entity_id"`
+			EntityName string `json:"entity_name"`
+		} `json:"items"`
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &trash); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if trash.Total != 1 || len(trash.Items) != 1 {
+		t.Fatalf("trash total/items = %d/%d, want 1/1", trash.Total, len(trash.Items))
+	}
+	if item := trash.Items[0]; item.EntityID != personID || item.EntityType != "person" || item.EntityName != "John Doe" {
+		t.Errorf("trash item = %+v, want John Doe", item)
+	}
+
+	// Restore it
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/trash/"+personID+"/restore", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Restore status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+personID, http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GetPerson after restore = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if !strings.Contains(rec.Body.String(), `"birth_place":"Boston"`) {
+		t.Errorf("Restored person = %s, want birth_place Boston", rec.Body.String())
+	}
+
+	// The trash is empty again, and a second restore conflicts
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/trash", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	_ = json.Unmarshal(rec.Body.Bytes(), &trash)
+	if trash.Total != 0 {
+		t.Errorf("trash total after restore = %d, want 0", trash.Total)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/trash/"+personID+"/restore", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusConflict {
+		t.Errorf("Second restore status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+}
+
+func TestTrashRestore_NotFound(t *testing.T) {
+	server := setupTestServer()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/trash/00000000-0000-0000-0000-000000000001/restore", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
 func TestGetPersonHistory(t *testing.T) {
 	server := setupTestServer()
 
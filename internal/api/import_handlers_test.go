@@ -1,12 +1,16 @@
 package api_test
 
 import (
+	"archive/zip"
 	"bytes"
 	"encoding/json"
+	"image"
+	"image/png"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/cacack/my-family/internal/api"
@@ -417,3 +421,224 @@ THIS IS NOT A VALID GEDCOM LINE
 		t.Error("Expected warnings or errors about malformed GEDCOM lines")
 	}
 }
+
+func TestImportGedcom_IssueGroups(t *testing.T) {
+	server := setupImportTestServer(t)
+
+	gedcomData := `0 HEAD
+1 GEDC
+2 VERS 5.5
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME John /Doe/
+1 BIRT
+2 DATE 31 FEB 1900
+0 @I2@ INDI
+1 SEX F
+0 @I3@ INDI
+1 SEX M
+0 @F1@ FAM
+1 HUSB @I1@
+1 CHIL @I99@
+0 TRLR
+`
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "issues.ged")
+	io.WriteString(part, gedcomData)
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/gedcom/import", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var result struct {
+		IssueGroups []struct {
+			Code     string `json:"code"`
+			Severity string `json:"severity"`
+			Count    int    `json:"count"`
+			Issues   []struct {
+				Line    int    `json:"line"`
+				Context string `json:"context"`
+				Message string `json:"message"`
+			} `json:"issues"`
+		} `json:"issue_groups"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	groups := make(map[string]int)
+	for i, g := range result.IssueGroups {
+		groups[g.Code] = i
+		if g.Count != len(g.Issues) {
+			t.Errorf("%s count = %d, want %d", g.Code, g.Count, len(g.Issues))
+		}
+	}
+
+	// Two unnamed persons share one group of recoverable warnings
+	if i, ok := groups["MISSING_NAME"]; !ok {
+		t.Errorf("missing MISSING_NAME group in %+v", result.IssueGroups)
+	} else if g := result.IssueGroups[i]; g.Severity != "warning" || g.Count != 2 {
+		t.Errorf("MISSING_NAME = %s x%d, want warning x2", g.Severity, g.Count)
+	}
+
+	// Data problems are errors and come before warnings
+	for _, code := range []string{"INVALID_DATE", "BROKEN_XREF"} {
+		i, ok := groups[code]
+		if !ok {
+			t.Errorf("missing %s group in %+v", code, result.IssueGroups)
+			continue
+		}
+		if result.IssueGroups[i].Severity != "error" {
+			t.Errorf("%s severity = %s, want error", code, result.IssueGroups[i].Severity)
+		}
+		if i > groups["MISSING_NAME"] {
+			t.Errorf("%s group should come before MISSING_NAME", code)
+		}
+	}
+	if i, ok := groups["INVALID_DATE"]; ok {
+		if issue := result.IssueGroups[i].Issues[0]; issue.Context != "@I1@" || issue.Line != 5 {
+			t.Errorf("INVALID_DATE issue = %+v, want @I1@ at line 5", issue)
+		}
+	}
+}
+
+func TestImportGedcom_WithMediaArchive(t *testing.T) {
+	server := setupImportTestServer(t)
+
+	var img bytes.Buffer
+	if err := png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 8, 8))); err != nil {
+		t.Fatal(err)
+	}
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	f, err := zw.Create("media/portrait.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write(img.Bytes())
+	zw.Close()
+
+	gedcomData := `0 HEAD
+1 GEDC
+2 VERS 5.5.1
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME John /Doe/
+1 OBJE
+2 FILE portrait.png
+3 FORM png
+0 TRLR
+`
+
+	// The archive is sent first; parts may arrive in any order
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("media", "media.zip")
+	part.Write(archive.Bytes())
+	part, _ = writer.CreateFormFile("file", "test.ged")
+	io.WriteString(part, gedcomData)
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/gedcom/import", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if result["persons_imported"] != float64(1) {
+		t.Errorf("persons_imported = %v, want 1", result["persons_imported"])
+	}
+	if result["media_imported"] != float64(1) {
+		t.Errorf("media_imported = %v, want 1", result["media_imported"])
+	}
+}
+
+func TestImportGedcom_InvalidMediaArchive(t *testing.T) {
+	server := setupImportTestServer(t)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "test.ged")
+	io.WriteString(part, testGedcom)
+	part, _ = writer.CreateFormFile("media", "media.zip")
+	io.WriteString(part, "not a zip archive")
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/gedcom/import", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestImportGedcom_TooLarge(t *testing.T) {
+	cfg := &config.Config{Port: 8080, LogFormat: "text", MaxImportSize: 1}
+	eventStore := memory.NewEventStore()
+	server := api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil)
+
+	// A GEDCOM file and media archive each under 1MB but together over it
+	half := strings.Repeat("x", 600*1024)
+	for _, path := range []string{"/api/v1/gedcom/import", "/api/v1/gedcom/import/stream"} {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, _ := writer.CreateFormFile("file", "test.ged")
+		io.WriteString(part, testGedcom+half)
+		part, _ = writer.CreateFormFile("media", "media.zip")
+		io.WriteString(part, half)
+		writer.Close()
+
+		req := httptest.NewRequest(http.MethodPost, path, body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("%s: expected status 413, got %d: %s", path, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+func TestImportGedcom_ReportsFileSize(t *testing.T) {
+	server := setupImportTestServer(t)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "test.ged")
+	io.WriteString(part, testGedcom)
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/gedcom/import", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var result struct {
+		FileSize int64 `json:"file_size"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if result.FileSize != int64(len(testGedcom)) {
+		t.Errorf("file_size = %d, want %d", result.FileSize, len(testGedcom))
+	}
+}
@@ -3,13 +3,17 @@ package api
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"mime/multipart"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/domain"
 	"github.com/cacack/my-family/internal/gedcom"
 )
 
@@ -23,14 +27,27 @@ type importProgressEvent struct {
 	Percent int `json:"percent"`
 }
 
+// importRecordsEvent is the payload for a Server-Sent Events "records" event
+// emitted while the parsed records are being created.
+type importRecordsEvent struct {
+	ImportID string `json:"import_id"`
+	Phase    string `json:"phase"` // kind of record being created, e.g. "persons"
+	Done     int    `json:"done"`
+	Total    int    `json:"total"`
+}
+
 // importStreamResult is the payload for the terminal SSE "result" event,
 // mirroring the JSON shape returned by the standard /gedcom/import endpoint.
 type importStreamResult struct {
-	Success          bool            `json:"success"`
-	PersonsImported  int             `json:"persons_imported"`
-	FamiliesImported int             `json:"families_imported"`
-	Warnings         []importMessage `json:"warnings,omitempty"`
-	Errors           []importMessage `json:"errors,omitempty"`
+	Success          bool               `json:"success"`
+	ImportID         string             `json:"import_id"`
+	PersonsImported  int                `json:"persons_imported"`
+	FamiliesImported int                `json:"families_imported"`
+	FileSize         int64              `json:"file_size"`
+	MediaImported    *int               `json:"media_imported,omitempty"`
+	Warnings         []importMessage    `json:"warnings,omitempty"`
+	Errors           []importMessage    `json:"errors,omitempty"`
+	IssueGroups      []ImportIssueGroup `json:"issue_groups,omitempty"`
 }
 
 type importMessage struct {
@@ -38,6 +55,10 @@ type importMessage struct {
 	Message string `json:"message"`
 }
 
+// multipartOverhead is the allowance for multipart boundaries and part
+// headers on top of the import size limit.
+const multipartOverhead = 64 * 1024
+
 // registerImportProgressRoutes wires the streaming GEDCOM import endpoint. It is
 // registered outside the generated handler because Server-Sent Events do not map
 // cleanly onto the OpenAPI-generated strict server.
@@ -45,8 +66,9 @@ func (s *Server) registerImportProgressRoutes(api *echo.Group) {
 	api.POST("/gedcom/import/stream", s.importGedcomStream)
 }
 
-// importGedcomStream imports a GEDCOM file while streaming parse progress to the
+// importGedcomStream imports a GEDCOM file while streaming progress to the
 // client via Server-Sent Events (SSE). It emits zero or more "progress" events
+// while parsing, then zero or more "records" events while creating records,
 // followed by exactly one terminal event: "result" on success or "error" on
 // failure.
 //
@@ -54,10 +76,32 @@ func (s *Server) registerImportProgressRoutes(api *echo.Group) {
 // progress events to report a meaningful percentage. This matches the existing
 // import behaviour, which already materialises the full document in memory.
 func (s *Server) importGedcomStream(c echo.Context) error {
+	// Reject uploads declared too large outright, and cap the request body so
+	// an undeclared oversized upload is cut off while the form is parsed, with
+	// some slack for the multipart framing
+	limit := maxImportSize(s.config)
+	tooLarge := fmt.Sprintf("Import too large (max %s)", domain.FormatMediaFileSize(limit))
+	req := c.Request()
+	if req.ContentLength > limit+multipartOverhead {
+		return s.sseError(c, http.StatusRequestEntityTooLarge, tooLarge)
+	}
+	req.Body = http.MaxBytesReader(c.Response(), req.Body, limit+multipartOverhead)
+
 	fileHeader, err := c.FormFile("file")
 	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return s.sseError(c, http.StatusRequestEntityTooLarge, tooLarge)
+		}
 		return s.sseError(c, http.StatusBadRequest, "No file uploaded")
 	}
+	uploadSize := fileHeader.Size
+	if mediaHeader, err := c.FormFile("media"); err == nil {
+		uploadSize += mediaHeader.Size
+	}
+	if uploadSize > limit {
+		return s.sseError(c, http.StatusRequestEntityTooLarge, tooLarge)
+	}
 
 	src, err := fileHeader.Open()
 	if err != nil {
@@ -71,6 +115,15 @@ func (s *Server) importGedcomStream(c echo.Context) error {
 	}
 	totalSize := int64(buf.Len())
 
+	// An optional ZIP archive holds the media files the GEDCOM references
+	var mediaFiles fs.FS
+	if mediaHeader, err := c.FormFile("media"); err == nil {
+		mediaFiles, err = readMediaArchive(mediaHeader)
+		if err != nil {
+			return s.sseError(c, http.StatusBadRequest, "Media archive is not a valid ZIP file")
+		}
+	}
+
 	// Set up the SSE response stream.
 	resp := c.Response()
 	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
@@ -103,11 +156,26 @@ func (s *Server) importGedcomStream(c echo.Context) error {
 		}
 	}
 
+	// Record creation is already throttled by the command handler.
+	emitRecords := func(p command.ImportRecordProgress) {
+		_ = s.writeSSE(c, "records", importRecordsEvent{
+			ImportID: p.ImportID.String(),
+			Phase:    p.Phase,
+			Done:     p.Done,
+			Total:    p.Total,
+		})
+		if canFlush {
+			flusher.Flush()
+		}
+	}
+
 	result, err := s.commandHandler.ImportGedcom(c.Request().Context(), command.ImportGedcomInput{
-		Filename:   fileHeader.Filename,
-		FileSize:   totalSize,
-		Reader:     &buf,
-		OnProgress: gedcom.ImportProgressCallback(emitProgress),
+		Filename:         fileHeader.Filename,
+		FileSize:         totalSize,
+		Reader:           &buf,
+		MediaFiles:       mediaFiles,
+		OnProgress:       gedcom.ImportProgressCallback(emitProgress),
+		OnRecordProgress: emitRecords,
 	})
 	if err != nil {
 		_ = s.writeSSE(c, "error", map[string]string{"message": err.Error()})
@@ -119,8 +187,13 @@ func (s *Server) importGedcomStream(c echo.Context) error {
 
 	payload := importStreamResult{
 		Success:          true,
+		ImportID:         result.ImportID.String(),
 		PersonsImported:  result.PersonsImported,
 		FamiliesImported: result.FamiliesImported,
+		FileSize:         totalSize,
+	}
+	if mediaFiles != nil {
+		payload.MediaImported = &result.MediaImported
 	}
 	for _, w := range result.Warnings {
 		payload.Warnings = append(payload.Warnings, importMessage{Message: w})
@@ -128,6 +201,7 @@ func (s *Server) importGedcomStream(c echo.Context) error {
 	for _, e := range result.Errors {
 		payload.Errors = append(payload.Errors, importMessage{Message: e})
 	}
+	payload.IssueGroups = convertImportIssueGroups(result.Issues)
 
 	_ = s.writeSSE(c, "result", payload)
 	if canFlush {
@@ -136,6 +210,21 @@ func (s *Server) importGedcomStream(c echo.Context) error {
 	return nil
 }
 
+// readMediaArchive reads an uploaded media archive into memory and opens it.
+func readMediaArchive(fh *multipart.FileHeader) (fs.FS, error) {
+	src, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = src.Close() }()
+
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return nil, err
+	}
+	return openMediaArchive(data)
+}
+
 // writeSSE writes a single named Server-Sent Event with a JSON-encoded payload.
 func (s *Server) writeSSE(c echo.Context, event string, data any) error {
 	encoded, err := json.Marshal(data)
@@ -149,5 +238,9 @@ func (s *Server) writeSSE(c echo.Context, event string, data any) error {
 // sseError writes a JSON error response for failures that occur before the SSE
 // stream has started (e.g. a missing upload).
 func (s *Server) sseError(c echo.Context, status int, message string) error {
-	return c.JSON(status, map[string]string{"code": "bad_request", "message": message})
+	code := "bad_request"
+	if status == http.StatusRequestEntityTooLarge {
+		code = "file_too_large"
+	}
+	return c.JSON(status, map[string]string{"code": code, "message": message})
 }
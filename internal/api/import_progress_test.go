@@ -175,3 +175,65 @@ func TestImportGedcomStream_ImportError(t *testing.T) {
 		t.Errorf("Expected terminal 'error' event for failed import, got %q (body: %s)", last.name, rec.Body.String())
 	}
 }
+
+func TestImportGedcomStream_RecordProgress(t *testing.T) {
+	server := setupStreamTestServer(t)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "test.ged")
+	io.WriteString(part, testGedcom)
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/gedcom/import/stream", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	type recordsEvent struct {
+		ImportID string `json:"import_id"`
+		Phase    string `json:"phase"`
+		Done     int    `json:"done"`
+		Total    int    `json:"total"`
+	}
+	var records []recordsEvent
+	var resultImportID string
+	for _, ev := range parseSSE(rec.Body.String()) {
+		switch ev.name {
+		case "records":
+			var r recordsEvent
+			if err := json.Unmarshal([]byte(ev.data), &r); err != nil {
+				t.Fatalf("Failed to parse records event: %v", err)
+			}
+			records = append(records, r)
+		case "result":
+			var r struct {
+				ImportID string `json:"import_id"`
+			}
+			if err := json.Unmarshal([]byte(ev.data), &r); err != nil {
+				t.Fatalf("Failed to parse result event: %v", err)
+			}
+			resultImportID = r.ImportID
+		}
+	}
+
+	if resultImportID == "" {
+		t.Fatal("result event should carry an import_id")
+	}
+	var personsDone bool
+	for _, r := range records {
+		if r.ImportID != resultImportID {
+			t.Errorf("records import_id = %q, want %q", r.ImportID, resultImportID)
+		}
+		if r.Phase == "persons" && r.Done == 3 && r.Total == 3 {
+			personsDone = true
+		}
+	}
+	if !personsDone {
+		t.Errorf("Expected a records event reporting 3/3 persons, got %+v", records)
+	}
+}
@@ -0,0 +1,69 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPersonKin(t *testing.T) {
+	server := setupDescendancyTestServer(t)
+	georgeID := importDescendancyTestData(t, server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+georgeID+"/kin?maxDegree=2", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var result struct {
+		MaxDegree int `json:"max_degree"`
+		Total     int `json:"total"`
+		Kin       []struct {
+			Person struct {
+				GivenName string `json:"given_name"`
+			} `json:"person"`
+			Relationship string `json:"relationship"`
+			Degree       int    `json:"degree"`
+		} `json:"kin"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if result.MaxDegree != 2 {
+		t.Errorf("max_degree = %d, want 2", result.MaxDegree)
+	}
+	// George's spouse Mary and daughter-in-law Jane are not blood relatives
+	want := map[string]string{
+		"John":   "child",
+		"Junior": "grandchild",
+		"Jenny":  "grandchild",
+	}
+	if result.Total != len(want) {
+		t.Errorf("total = %d, want %d", result.Total, len(want))
+	}
+	for _, k := range result.Kin {
+		if want[k.Person.GivenName] != k.Relationship {
+			t.Errorf("%s: relationship = %q, want %q", k.Person.GivenName, k.Relationship, want[k.Person.GivenName])
+		}
+	}
+	if len(result.Kin) > 0 && result.Kin[0].Person.GivenName != "John" {
+		t.Errorf("First kin = %s, want John (closest degree first)", result.Kin[0].Person.GivenName)
+	}
+}
+
+func TestGetPersonKin_NotFound(t *testing.T) {
+	server := setupDescendancyTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/00000000-0000-0000-0000-000000000001/kin", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", rec.Code)
+	}
+}
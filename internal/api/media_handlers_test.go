@@ -1,7 +1,9 @@
 package api_test
 
 import (
+	"archive/zip"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"image"
@@ -11,7 +13,19 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/api"
+	"github.com/cacack/my-family/internal/config"
+	"github.com/cacack/my-family/internal/domain"
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/filesystem"
+	"github.com/cacack/my-family/internal/repository/memory"
 )
 
 // createTestJPEGImage creates a small test JPEG image.
@@ -311,6 +325,127 @@ func TestGetMedia(t *testing.T) {
 	}
 }
 
+func TestGetMedia_ExifMetadata(t *testing.T) {
+	server := setupTestServer()
+
+	personBody := `{"given_name":"Test","surname":"User","gender":"male"}`
+	personReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", bytes.NewReader([]byte(personBody)))
+	personReq.Header.Set("Content-Type", "application/json")
+	personRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(personRec, personReq)
+
+	var personResp map[string]any
+	_ = json.Unmarshal(personRec.Body.Bytes(), &personResp)
+	personID := personResp["id"].(string)
+
+	// JPEG carrying a big-endian EXIF block with only an IFD0 DateTime entry
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08" +
+		"\x00\x01" + "\x01\x32\x00\x02\x00\x00\x00\x14\x00\x00\x00\x1a" + "\x00\x00\x00\x00" +
+		"1962:03:12 14:30:05\x00")
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	jpegData := createTestJPEGImage()
+	data := append([]byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00, byte(len(segment) + 2)}, segment...)
+	data = append(data, jpegData[2:]...)
+
+	uploadReq, _ := createMultipartRequest(
+		fmt.Sprintf("/api/v1/persons/%s/media", personID),
+		"file",
+		"wedding.jpg",
+		data,
+		nil,
+	)
+	uploadRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(uploadRec, uploadReq)
+
+	var uploadResp map[string]any
+	_ = json.Unmarshal(uploadRec.Body.Bytes(), &uploadResp)
+	mediaID := uploadResp["id"].(string)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/media/%s", mediaID), http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var resp map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp["captured_at"] != "1962-03-12T14:30:05Z" {
+		t.Errorf("captured_at = %v, want 1962-03-12T14:30:05Z", resp["captured_at"])
+	}
+	if resp["suggested_event_date"] != "12 MAR 1962" {
+		t.Errorf("suggested_event_date = %v, want 12 MAR 1962", resp["suggested_event_date"])
+	}
+	if _, ok := resp["gps_latitude"]; ok {
+		t.Errorf("gps_latitude = %v, want absent", resp["gps_latitude"])
+	}
+}
+
+func TestListDuplicateMedia(t *testing.T) {
+	server := setupTestServer()
+
+	personBody := `{"given_name":"Test","surname":"User","gender":"male"}`
+	jpegData := createTestJPEGImage()
+	for i := 0; i < 2; i++ {
+		personReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", bytes.NewReader([]byte(personBody)))
+		personReq.Header.Set("Content-Type", "application/json")
+		personRec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(personRec, personReq)
+
+		var personResp map[string]any
+		_ = json.Unmarshal(personRec.Body.Bytes(), &personResp)
+		personID := personResp["id"].(string)
+
+		uploadReq, _ := createMultipartRequest(
+			fmt.Sprintf("/api/v1/persons/%s/media", personID),
+			"file",
+			"family.jpg",
+			jpegData,
+			nil,
+		)
+		uploadRec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(uploadRec, uploadReq)
+		if uploadRec.Code != http.StatusCreated {
+			t.Fatalf("upload status = %d, body = %s", uploadRec.Code, uploadRec.Body.String())
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/media/duplicates", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d; body = %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp struct {
+		Groups []struct {
+			ContentHash string           `json:"content_hash"`
+			FileSize    int64            `json:"file_size"`
+			Media       []map[string]any `json:"media"`
+		} `json:"groups"`
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Total != 1 || len(resp.Groups) != 1 {
+		t.Fatalf("total = %d, groups = %d, want 1", resp.Total, len(resp.Groups))
+	}
+	if len(resp.Groups[0].Media) != 2 {
+		t.Errorf("group has %d media, want 2", len(resp.Groups[0].Media))
+	}
+	if resp.Groups[0].FileSize != int64(len(jpegData)) {
+		t.Errorf("file_size = %d, want %d", resp.Groups[0].FileSize, len(jpegData))
+	}
+	if resp.Groups[0].Media[0]["content_hash"] != resp.Groups[0].ContentHash {
+		t.Errorf("media content_hash = %v, want %s", resp.Groups[0].Media[0]["content_hash"], resp.Groups[0].ContentHash)
+	}
+}
+
 func TestGetMedia_NotFound(t *testing.T) {
 	server := setupTestServer()
 
@@ -384,6 +519,265 @@ func TestDownloadMedia(t *testing.T) {
 	}
 }
 
+func TestDownloadMedia_Audio(t *testing.T) {
+	server := setupTestServer()
+
+	personBody := `{"given_name":"Oral","surname":"History","gender":"female"}`
+	personReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", bytes.NewReader([]byte(personBody)))
+	personReq.Header.Set("Content-Type", "application/json")
+	personRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(personRec, personReq)
+
+	var personResp map[string]any
+	_ = json.Unmarshal(personRec.Body.Bytes(), &personResp)
+	personID := personResp["id"].(string)
+
+	// MP3 frame header without an ID3 tag; typed by its extension
+	mp3Data := append([]byte{0xFF, 0xFB, 0x90, 0x64}, make([]byte, 64)...)
+	uploadReq, _ := createMultipartRequest(
+		fmt.Sprintf("/api/v1/persons/%s/media", personID),
+		"file",
+		"interview.mp3",
+		mp3Data,
+		nil,
+	)
+	uploadRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(uploadRec, uploadReq)
+	if uploadRec.Code != http.StatusCreated {
+		t.Fatalf("Upload status = %d, want %d: %s", uploadRec.Code, http.StatusCreated, uploadRec.Body.String())
+	}
+
+	var uploadResp map[string]any
+	_ = json.Unmarshal(uploadRec.Body.Bytes(), &uploadResp)
+	if uploadResp["media_type"] != "audio" {
+		t.Errorf("media_type = %v, want audio", uploadResp["media_type"])
+	}
+	mediaID := uploadResp["id"].(string)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/media/%s/content", mediaID), http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if contentType := rec.Header().Get("Content-Type"); contentType != "audio/mpeg" {
+		t.Errorf("Content-Type = %s, want audio/mpeg", contentType)
+	}
+	if rec.Body.Len() != len(mp3Data) {
+		t.Errorf("body length = %d, want %d", rec.Body.Len(), len(mp3Data))
+	}
+}
+
+func TestDownloadMedia_BlobStore(t *testing.T) {
+	blobDir := t.TempDir()
+	blobs, err := filesystem.NewMediaBlobStore(blobDir)
+	if err != nil {
+		t.Fatalf("NewMediaBlobStore() error = %v", err)
+	}
+	eventStore := memory.NewEventStore()
+	server := api.NewServer(&config.Config{Port: 8080, LogFormat: "text"}, eventStore, memory.NewReadModelStore(),
+		memory.NewSnapshotStore(eventStore), nil, api.WithMediaBlobStore(blobs))
+
+	personReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", bytes.NewReader([]byte(`{"given_name":"Blob","surname":"Test"}`)))
+	personReq.Header.Set("Content-Type", "application/json")
+	personRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(personRec, personReq)
+
+	var personResp map[string]any
+	_ = json.Unmarshal(personRec.Body.Bytes(), &personResp)
+	personID := personResp["id"].(string)
+
+	jpegData := createTestJPEGImage()
+	uploadReq, _ := createMultipartRequest(
+		fmt.Sprintf("/api/v1/persons/%s/media", personID),
+		"file",
+		"scan.jpg",
+		jpegData,
+		map[string]string{"title": "Scanned Record"},
+	)
+	uploadRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(uploadRec, uploadReq)
+	if uploadRec.Code != http.StatusCreated {
+		t.Fatalf("Upload status = %d, want %d: %s", uploadRec.Code, http.StatusCreated, uploadRec.Body.String())
+	}
+
+	var uploadResp map[string]any
+	_ = json.Unmarshal(uploadRec.Body.Bytes(), &uploadResp)
+	mediaID := uploadResp["id"].(string)
+
+	// The content is written to the filesystem store
+	hash := domain.MediaContentHash(jpegData)
+	if _, err := os.Stat(filepath.Join(blobDir, hash[:2], hash)); err != nil {
+		t.Errorf("expected blob file for uploaded media: %v", err)
+	}
+
+	// Downloads and resized thumbnails read it back
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/media/%s/content", mediaID), http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if !bytes.Equal(rec.Body.Bytes(), jpegData) {
+		t.Errorf("downloaded %d bytes, want the uploaded %d", rec.Body.Len(), len(jpegData))
+	}
+
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/media/%s/thumbnail?size=64", mediaID), http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("thumbnail Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestDownloadPersonMediaArchive(t *testing.T) {
+	server, readStore := setupBrowseTestServerWithStore()
+	ctx := context.Background()
+
+	personReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", bytes.NewReader([]byte(`{"given_name":"Mary","surname":"O'Brien"}`)))
+	personReq.Header.Set("Content-Type", "application/json")
+	personRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(personRec, personReq)
+
+	var personResp map[string]any
+	_ = json.Unmarshal(personRec.Body.Bytes(), &personResp)
+	personID := uuid.MustParse(personResp["id"].(string))
+
+	jpegData := createTestJPEGImage()
+	pdfData := []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\ntrailer\n<<>>\n%%EOF")
+	for _, m := range []repository.MediaReadModel{
+		{Title: "Wedding Day", Filename: "IMG_0001.jpg", MimeType: "image/jpeg", FileData: jpegData},
+		{Title: "Wedding Day", Filename: "scan.jpg", MimeType: "image/jpeg", FileData: jpegData},
+		{Title: "Census 1900: Page 3/4", Filename: "record.pdf", MimeType: "application/pdf", FileData: pdfData},
+		{Title: "obituary.PDF", Filename: "obituary.pdf", MimeType: "application/pdf", FileData: pdfData},
+	} {
+		m.ID = uuid.New()
+		m.EntityType = "person"
+		m.EntityID = personID
+		m.FileSize = int64(len(m.FileData))
+		m.CreatedAt = time.Now()
+		if err := readStore.SaveMedia(ctx, &m); err != nil {
+			t.Fatalf("SaveMedia() error = %v", err)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/persons/%s/media/archive", personID), http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/zip" {
+		t.Errorf("Content-Type = %s, want application/zip", ct)
+	}
+	if cd := rec.Header().Get("Content-Disposition"); cd != `attachment; filename="mary-o-brien-media.zip"` {
+		t.Errorf("Content-Disposition = %s", cd)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(rec.Body.Bytes()), int64(rec.Body.Len()))
+	if err != nil {
+		t.Fatalf("response is not a ZIP: %v", err)
+	}
+	contents := make(map[string][]byte)
+	for _, f := range zr.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		contents[f.Name], err = io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("read %s: %v", f.Name, err)
+		}
+		_ = r.Close()
+	}
+	want := map[string][]byte{
+		"Wedding Day.jpg":           jpegData,
+		"Wedding Day (2).jpg":       jpegData,
+		"Census 1900_ Page 3_4.pdf": pdfData,
+		"obituary.PDF":              pdfData,
+	}
+	if len(contents) != len(want) {
+		t.Errorf("archive entries = %v, want %d", mapsKeys(contents), len(want))
+	}
+	for name, data := range want {
+		if !bytes.Equal(contents[name], data) {
+			t.Errorf("entry %q: got %d bytes, want %d", name, len(contents[name]), len(data))
+		}
+	}
+}
+
+func TestDownloadPersonMediaArchive_PersonNotFound(t *testing.T) {
+	server := setupTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/00000000-0000-0000-0000-000000000001/media/archive", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func mapsKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestUploadPersonMedia_MaxMediaSize(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxMediaSize int
+		size         int
+		wantStatus   int
+	}{
+		{"default limit rejects 11MB", 0, 11 * 1024 * 1024, http.StatusRequestEntityTooLarge},
+		{"configured limit allows 18MB", 25, 18 * 1024 * 1024, http.StatusCreated},
+		{"configured limit rejects 2MB", 1, 2 * 1024 * 1024, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Port: 8080, LogFormat: "text", MaxMediaSize: tt.maxMediaSize}
+			eventStore := memory.NewEventStore()
+			server := api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil)
+
+			personReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", bytes.NewReader([]byte(`{"given_name":"Deed","surname":"Scan"}`)))
+			personReq.Header.Set("Content-Type", "application/json")
+			personRec := httptest.NewRecorder()
+			server.Echo().ServeHTTP(personRec, personReq)
+
+			var personResp map[string]any
+			_ = json.Unmarshal(personRec.Body.Bytes(), &personResp)
+
+			// A valid JPEG padded out to the target size
+			data := createTestJPEGImage()
+			data = append(data, make([]byte, tt.size-len(data))...)
+			req, _ := createMultipartRequest(fmt.Sprintf("/api/v1/persons/%s/media", personResp["id"]), "file", "deed.jpg", data, nil)
+			rec := httptest.NewRecorder()
+			server.Echo().ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("Status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus == http.StatusRequestEntityTooLarge {
+				limit := tt.maxMediaSize
+				if limit == 0 {
+					limit = 10
+				}
+				var errResp map[string]any
+				_ = json.Unmarshal(rec.Body.Bytes(), &errResp)
+				if want := fmt.Sprintf("File too large (max %dMB)", limit); errResp["message"] != want {
+					t.Errorf("message = %v, want %q", errResp["message"], want)
+				}
+			}
+		})
+	}
+}
+
 func TestGetMediaThumbnail(t *testing.T) {
 	server := setupTestServer()
 
@@ -429,6 +823,68 @@ func TestGetMediaThumbnail(t *testing.T) {
 	}
 }
 
+func TestGetMediaThumbnail_Size(t *testing.T) {
+	server := setupTestServer()
+
+	personBody := `{"given_name":"Sized","surname":"Thumb","gender":"male"}`
+	personReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", bytes.NewReader([]byte(personBody)))
+	personReq.Header.Set("Content-Type", "application/json")
+	personRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(personRec, personReq)
+
+	var personResp map[string]any
+	_ = json.Unmarshal(personRec.Body.Bytes(), &personResp)
+	personID := personResp["id"].(string)
+
+	uploadReq, _ := createMultipartRequest(
+		fmt.Sprintf("/api/v1/persons/%s/media", personID),
+		"file",
+		"sized.jpg",
+		createTestJPEGImage(),
+		nil,
+	)
+	uploadRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(uploadRec, uploadReq)
+
+	var uploadResp map[string]any
+	_ = json.Unmarshal(uploadRec.Body.Bytes(), &uploadResp)
+	mediaID := uploadResp["id"].(string)
+
+	// Requested twice: generated, then served from the cache
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/media/%s/thumbnail?size=50", mediaID), http.NoBody)
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+		}
+		img, err := jpeg.Decode(rec.Body)
+		if err != nil {
+			t.Fatalf("decode thumbnail: %v", err)
+		}
+		if b := img.Bounds(); b.Dx() != 50 || b.Dy() != 50 {
+			t.Errorf("thumbnail = %dx%d, want 50x50", b.Dx(), b.Dy())
+		}
+	}
+
+	// Out-of-range sizes are rejected
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/media/%s/thumbnail?size=5", mediaID), http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	// Unknown media has no thumbnail at any size
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/media/00000000-0000-0000-0000-000000000001/thumbnail?size=50", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
 func TestUpdateMedia(t *testing.T) {
 	server := setupTestServer()
 
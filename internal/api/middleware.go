@@ -1,12 +1,24 @@
 package api
 
 import (
+	"crypto/subtle"
 	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
 
 	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/config"
+	"github.com/cacack/my-family/internal/domain"
 	"github.com/cacack/my-family/internal/query"
 	"github.com/cacack/my-family/internal/repository"
 )
@@ -16,6 +28,11 @@ type APIError struct {
 	Code    string         `json:"code"`
 	Message string         `json:"message"`
 	Details map[string]any `json:"details,omitempty"`
+	Fields  []FieldError   `json:"fields,omitempty"` // Per-field validation failures
+
+	// RequestID correlates the error with the server's log lines for the
+	// request; see HeaderRequestID.
+	RequestID string `json:"request_id,omitempty"`
 }
 
 // Error codes.
@@ -25,6 +42,9 @@ const (
 	CodeConflict      = "CONFLICT"
 	CodeInternalError = "INTERNAL_ERROR"
 	CodeValidation    = "VALIDATION_ERROR"
+	CodeUnauthorized  = "UNAUTHORIZED"
+	CodeForbidden     = "FORBIDDEN"
+	CodeRateLimited   = "RATE_LIMITED"
 )
 
 // customErrorHandler handles errors and returns consistent JSON responses.
@@ -115,6 +135,9 @@ func customErrorHandler(err error, c echo.Context) {
 			Code:    CodeValidation,
 			Message: err.Error(),
 		}
+		if fields := fieldErrors(err); fields != nil {
+			apiErr.Fields = *fields
+		}
 	default:
 		// Check if it's an Echo HTTP error
 		var he *echo.HTTPError
@@ -138,18 +161,37 @@ func customErrorHandler(err error, c echo.Context) {
 				Message: "An unexpected error occurred",
 			}
 			// Log the actual error for debugging
-			c.Logger().Error(err)
+			requestLogger(c.Request().Context()).Error("unhandled error", "error", err)
 		}
 	}
 
+	apiErr.RequestID = requestID(c)
 	_ = c.JSON(code, apiErr)
 }
 
+// fieldErrors converts the domain validation errors in err to API field
+// errors, or returns nil when err carries none.
+func fieldErrors(err error) *[]FieldError {
+	domainFields := domain.FieldErrors(err)
+	if len(domainFields) == 0 {
+		return nil
+	}
+	fields := make([]FieldError, len(domainFields))
+	for i, fe := range domainFields {
+		fields[i].Field, fields[i].Message = fe.InvalidField()
+	}
+	return &fields
+}
+
 // httpStatusToCode converts HTTP status to error code.
 func httpStatusToCode(status int) string {
 	switch status {
 	case http.StatusBadRequest:
 		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
 	case http.StatusNotFound:
 		return CodeNotFound
 	case http.StatusConflict:
@@ -172,3 +214,227 @@ func (e *APIError) WithDetails(details map[string]any) *APIError {
 	e.Details = details
 	return e
 }
+
+// tokenAuth returns middleware that requires an "Authorization: Bearer <token>"
+// header matching one of tokens on every API request. Health probes, CORS
+// preflights, and the static frontend are always exempt. With publicReads,
+// safe methods (GET, HEAD) are exempt too, so only mutations need a token.
+func tokenAuth(tokens []string, publicReads bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if authExempt(req, publicReads) || validBearerToken(req.Header.Get(echo.HeaderAuthorization), tokens) {
+				return next(c)
+			}
+			c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+			return c.JSON(http.StatusUnauthorized, APIError{
+				Code:      CodeUnauthorized,
+				Message:   "A valid bearer token is required",
+				RequestID: requestID(c),
+			})
+		}
+	}
+}
+
+// authExempt reports whether a request may skip token authentication.
+func authExempt(req *http.Request, publicReads bool) bool {
+	path := req.URL.Path
+	switch {
+	case req.Method == http.MethodOptions:
+		return true
+	case path == "/healthz" || path == "/readyz" || path == "/api/v1/health":
+		return true
+	case !strings.HasPrefix(path, "/api/"):
+		return true
+	case publicReads && (req.Method == http.MethodGet || req.Method == http.MethodHead):
+		return true
+	default:
+		return false
+	}
+}
+
+// adminAuth returns middleware for admin endpoints, which requires an
+// "Authorization: Bearer <token>" header matching one of tokens regardless of
+// API_TOKENS and AUTH_PUBLIC_READS. With no tokens, admin endpoints are
+// disabled.
+func adminAuth(tokens []string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if len(tokens) == 0 {
+				return c.JSON(http.StatusForbidden, APIError{
+					Code:      CodeForbidden,
+					Message:   "Admin endpoints are disabled; set ADMIN_TOKENS to enable them",
+					RequestID: requestID(c),
+				})
+			}
+			if validBearerToken(c.Request().Header.Get(echo.HeaderAuthorization), tokens) {
+				return next(c)
+			}
+			c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+			return c.JSON(http.StatusUnauthorized, APIError{
+				Code:      CodeUnauthorized,
+				Message:   "An admin bearer token is required",
+				RequestID: requestID(c),
+			})
+		}
+	}
+}
+
+// validBearerToken reports whether an Authorization header carries one of
+// tokens. Tokens are compared in constant time.
+func validBearerToken(header string, tokens []string) bool {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return false
+	}
+	token = strings.TrimSpace(token)
+	valid := false
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
+
+// HeaderActor names who is making a request. Changes made by the request are
+// attributed to this actor in history and restore points.
+const HeaderActor = "X-Actor"
+
+// maxActorLength is the longest X-Actor value accepted, in runes.
+const maxActorLength = 100
+
+// actorAttribution returns middleware that attributes commands to the actor
+// named in the X-Actor header. Requests without the header are unattributed.
+func actorAttribution() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			actor := strings.TrimSpace(c.Request().Header.Get(HeaderActor))
+			if actor == "" {
+				return next(c)
+			}
+			if utf8.RuneCountInString(actor) > maxActorLength || strings.ContainsFunc(actor, unicode.IsControl) {
+				return c.JSON(http.StatusBadRequest, APIError{
+					Code:      CodeBadRequest,
+					Message:   fmt.Sprintf("%s must be at most %d printable characters", HeaderActor, maxActorLength),
+					RequestID: requestID(c),
+				})
+			}
+			req := c.Request()
+			c.SetRequest(req.WithContext(command.WithActor(req.Context(), actor)))
+			return next(c)
+		}
+	}
+}
+
+// rateLimiterStore holds the per-client token buckets behind rateLimit. The
+// servers of additional trees are pointed at the default tree's stores, so a
+// client has one budget however many trees it spreads requests over.
+type rateLimiterStore struct {
+	middleware.RateLimiterStore
+}
+
+// newRateLimiterStore returns buckets refilling at perMinute requests per
+// minute, allowing bursts of up to perMinute requests.
+func newRateLimiterStore(perMinute int) *rateLimiterStore {
+	return &rateLimiterStore{middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+		Rate:      rate.Limit(float64(perMinute) / 60),
+		Burst:     perMinute,
+		ExpiresIn: 3 * time.Minute,
+	})}
+}
+
+// rateLimit returns middleware that limits each client IP to perMinute
+// requests per minute using the buckets in store. Requests for which skip
+// returns true are not counted. Rejected requests get a 429 with a
+// Retry-After header giving the seconds until the next request is allowed.
+func rateLimit(store *rateLimiterStore, perMinute int, skip middleware.Skipper) echo.MiddlewareFunc {
+	retryAfter := strconv.Itoa(int(math.Ceil(60 / float64(perMinute))))
+
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Skipper: skip,
+		Store:   store,
+		IdentifierExtractor: func(c echo.Context) (string, error) {
+			return c.RealIP(), nil
+		},
+		DenyHandler: func(c echo.Context, _ string, _ error) error {
+			c.Response().Header().Set("Retry-After", retryAfter)
+			return c.JSON(http.StatusTooManyRequests, APIError{
+				Code:      CodeRateLimited,
+				Message:   "Too many requests; retry later",
+				RequestID: requestID(c),
+			})
+		},
+	})
+}
+
+// ipExtractor returns how the client IP is found: the connection's peer
+// address, or with trusted proxies configured, the X-Forwarded-For entry
+// added by the last of them. Untrusted forwarding headers are ignored, so
+// clients cannot pick their own IP to dodge rate limits.
+func ipExtractor(cfg *config.Config) echo.IPExtractor {
+	ranges, err := cfg.TrustedProxyRanges()
+	if err != nil || len(ranges) == 0 {
+		return echo.ExtractIPDirect()
+	}
+	opts := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, r := range ranges {
+		opts = append(opts, echo.TrustIPRange(r))
+	}
+	return echo.ExtractIPFromXFFHeader(opts...)
+}
+
+// rateLimitExempt reports whether a request is outside rate limiting: health
+// probes and the static frontend.
+func rateLimitExempt(c echo.Context) bool {
+	path := c.Request().URL.Path
+	return path == "/healthz" || path == "/readyz" || path == "/api/v1/health" ||
+		!strings.HasPrefix(path, "/api/")
+}
+
+// expensiveOperations are the operations that scan or stream a whole tree,
+// by OpenAPI operation ID. They draw on the separate RATE_LIMIT_EXPENSIVE
+// budget, as does the GraphQL endpoint, whose queries can fan out just as
+// far.
+var expensiveOperations = []string{
+	// Search
+	"searchPersons", "advancedSearchPersons", "searchFamilies", "searchSources",
+
+	// Export, including single-branch and selection exports and media archives
+	"exportGedcom", "previewGedcomExport", "exportPersonGedcom", "exportTree", "exportSelection",
+	"exportBySurname", "exportPersons", "exportFamilies", "exportSources", "exportCitations",
+	"exportEvents", "exportAttributes", "getExportEstimate", "downloadPersonMediaArchive",
+
+	// Administration
+	"verifyProjections",
+}
+
+// adminOperations are the operations that require an ADMIN_TOKENS token.
+var adminOperations = []string{"verifyProjections"}
+
+// operationMiddlewares returns the per-operation middleware for the generated
+// routes: the expensive rate limit on expensiveOperations when configured,
+// then admin authentication on adminOperations, so admin token guessing is
+// throttled too.
+func (s *Server) operationMiddlewares() map[string][]echo.MiddlewareFunc {
+	mw := make(map[string][]echo.MiddlewareFunc, len(expensiveOperations))
+	if limit := s.expensiveRateLimit(); limit != nil {
+		for _, op := range expensiveOperations {
+			mw[op] = append(mw[op], limit)
+		}
+	}
+	admin := adminAuth(s.config.AdminTokens)
+	for _, op := range adminOperations {
+		mw[op] = append(mw[op], admin)
+	}
+	return mw
+}
+
+// expensiveRateLimit returns the middleware enforcing RATE_LIMIT_EXPENSIVE,
+// or nil when it is disabled.
+func (s *Server) expensiveRateLimit() echo.MiddlewareFunc {
+	if s.expensiveRateLimits == nil {
+		return nil
+	}
+	return rateLimit(s.expensiveRateLimits, s.config.RateLimitExpensive, nil)
+}
@@ -2,8 +2,10 @@ package api_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/cacack/my-family/internal/api"
@@ -118,3 +120,445 @@ func TestCORSHeaders(t *testing.T) {
 		t.Error("Expected Access-Control-Allow-Origin header")
 	}
 }
+
+func TestCORSHeaders_Configured(t *testing.T) {
+	cfg := &config.Config{
+		Port:             8080,
+		LogFormat:        "text",
+		CORSAllowOrigins: []string{"https://app.example.com"},
+		CORSAllowMethods: []string{"get", "post"},
+		CORSAllowHeaders: []string{"X-Custom"},
+	}
+	eventStore := memory.NewEventStore()
+	snapshotStore := memory.NewSnapshotStore(eventStore)
+	readStore := memory.NewReadModelStore()
+	server := api.NewServer(cfg, eventStore, readStore, snapshotStore, nil)
+
+	preflight := func(origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodOptions, "/api/v1/persons", http.NoBody)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", "POST")
+		req.Header.Set("Access-Control-Request-Headers", "X-Custom")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := preflight("https://app.example.com")
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://app.example.com" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want https://app.example.com", got)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Methods"); got != "GET,POST" {
+		t.Errorf("Access-Control-Allow-Methods = %q, want GET,POST", got)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Headers"); !strings.Contains(got, "X-Custom") || !strings.Contains(got, "Authorization") {
+		t.Errorf("Access-Control-Allow-Headers = %q, want API headers plus X-Custom", got)
+	}
+
+	rec = preflight("https://other.example.com")
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("Access-Control-Allow-Origin = %q for a disallowed origin, want none", got)
+	}
+}
+
+func setupAuthTestServer(publicReads bool) *api.Server {
+	cfg := &config.Config{
+		Port:            8080,
+		LogFormat:       "text",
+		APITokens:       []string{"secret-1", "secret-2"},
+		AuthPublicReads: publicReads,
+	}
+	eventStore := memory.NewEventStore()
+	snapshotStore := memory.NewSnapshotStore(eventStore)
+	readStore := memory.NewReadModelStore()
+	return api.NewServer(cfg, eventStore, readStore, snapshotStore, nil)
+}
+
+func TestTokenAuth(t *testing.T) {
+	tests := []struct {
+		name        string
+		publicReads bool
+		method      string
+		path        string
+		auth        string
+		wantStatus  int
+	}{
+		{"read without token", false, http.MethodGet, "/api/v1/persons", "", http.StatusUnauthorized},
+		{"read with token", false, http.MethodGet, "/api/v1/persons", "Bearer secret-1", http.StatusOK},
+		{"second token", false, http.MethodGet, "/api/v1/persons", "bearer secret-2", http.StatusOK},
+		{"wrong token", false, http.MethodGet, "/api/v1/persons", "Bearer nope", http.StatusUnauthorized},
+		{"wrong scheme", false, http.MethodGet, "/api/v1/persons", "Basic secret-1", http.StatusUnauthorized},
+		{"health exempt", false, http.MethodGet, "/api/v1/health", "", http.StatusOK},
+		{"liveness exempt", false, http.MethodGet, "/healthz", "", http.StatusOK},
+		{"readiness exempt", false, http.MethodGet, "/readyz", "", http.StatusOK},
+		{"public read", true, http.MethodGet, "/api/v1/persons", "", http.StatusOK},
+		{"public reads still lock writes", true, http.MethodPost, "/api/v1/persons", "", http.StatusUnauthorized},
+		{"write with token", true, http.MethodPost, "/api/v1/persons", "Bearer secret-1", http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupAuthTestServer(tt.publicReads)
+
+			var body *strings.Reader
+			if tt.method == http.MethodPost {
+				body = strings.NewReader(`{"given_name":"Jane","surname":"Smith"}`)
+			} else {
+				body = strings.NewReader("")
+			}
+			req := httptest.NewRequest(tt.method, tt.path, body)
+			req.Header.Set("Content-Type", "application/json")
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+			rec := httptest.NewRecorder()
+			server.Echo().ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("Status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if rec.Code == http.StatusUnauthorized {
+				if rec.Header().Get("WWW-Authenticate") != "Bearer" {
+					t.Errorf("WWW-Authenticate = %q, want Bearer", rec.Header().Get("WWW-Authenticate"))
+				}
+				var resp api.APIError
+				if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+					t.Fatalf("Failed to parse response: %v", err)
+				}
+				if resp.Code != api.CodeUnauthorized {
+					t.Errorf("Code = %q, want %q", resp.Code, api.CodeUnauthorized)
+				}
+			}
+		})
+	}
+}
+
+func TestTokenAuth_PreflightExempt(t *testing.T) {
+	server := setupAuthTestServer(false)
+
+	req := httptest.NewRequest(http.MethodOptions, "/api/v1/persons", http.NoBody)
+	req.Header.Set("Origin", "http://example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code == http.StatusUnauthorized {
+		t.Errorf("CORS preflight was rejected: %d", rec.Code)
+	}
+}
+
+func setupRateLimitTestServer(perMinute, expensivePerMinute int) *api.Server {
+	cfg := &config.Config{
+		Port:               8080,
+		LogFormat:          "text",
+		RateLimit:          perMinute,
+		RateLimitExpensive: expensivePerMinute,
+	}
+	eventStore := memory.NewEventStore()
+	snapshotStore := memory.NewSnapshotStore(eventStore)
+	readStore := memory.NewReadModelStore()
+	return api.NewServer(cfg, eventStore, readStore, snapshotStore, nil)
+}
+
+func doRateLimitRequest(server *api.Server, path, ip string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+	req.RemoteAddr = ip + ":1234"
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestActorAttribution(t *testing.T) {
+	server := setupTestServer()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(`{"given_name":"Jane","surname":"Smith"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(api.HeaderActor, " Cousin Ann ")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+	var created map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	personID := created["id"].(string)
+
+	for _, path := range []string{"/api/v1/persons/" + personID + "/history", "/api/v1/persons/" + personID + "/restore-points"} {
+		req = httptest.NewRequest(http.MethodGet, path, http.NoBody)
+		rec = httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+
+		var resp struct {
+			Items []struct {
+				UserID string `json:"user_id"`
+			} `json:"items"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("%s: failed to parse response: %v", path, err)
+		}
+		if len(resp.Items) == 0 {
+			t.Fatalf("%s: no items", path)
+		}
+		for _, item := range resp.Items {
+			if item.UserID != "Cousin Ann" {
+				t.Errorf("%s: user_id = %q, want Cousin Ann", path, item.UserID)
+			}
+		}
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(`{"given_name":"Jane","surname":"Smith"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(api.HeaderActor, strings.Repeat("x", 101))
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("overlong actor: Status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	server := setupRateLimitTestServer(3, 0)
+
+	for i := 0; i < 3; i++ {
+		if rec := doRateLimitRequest(server, "/api/v1/persons", "192.0.2.1"); rec.Code != http.StatusOK {
+			t.Fatalf("request %d: Status = %d, want %d", i, rec.Code, http.StatusOK)
+		}
+	}
+
+	rec := doRateLimitRequest(server, "/api/v1/persons", "192.0.2.1")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+	if rec.Header().Get("Retry-After") != "20" {
+		t.Errorf("Retry-After = %q, want 20", rec.Header().Get("Retry-After"))
+	}
+	var resp api.APIError
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Code != api.CodeRateLimited {
+		t.Errorf("Code = %q, want %q", resp.Code, api.CodeRateLimited)
+	}
+
+	// Other clients and health probes are unaffected
+	if rec := doRateLimitRequest(server, "/api/v1/persons", "192.0.2.2"); rec.Code != http.StatusOK {
+		t.Errorf("other IP: Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec := doRateLimitRequest(server, "/healthz", "192.0.2.1"); rec.Code != http.StatusOK {
+		t.Errorf("healthz: Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestRateLimit_IgnoresSpoofedForwardingHeaders(t *testing.T) {
+	server := setupRateLimitTestServer(2, 0)
+
+	for i := 0; i < 6; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/persons", http.NoBody)
+		req.RemoteAddr = "192.0.2.1:1234"
+		req.Header.Set("X-Forwarded-For", fmt.Sprintf("198.51.100.%d", i))
+		req.Header.Set("X-Real-IP", fmt.Sprintf("198.51.100.%d", i))
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+
+		want := http.StatusOK
+		if i >= 2 {
+			want = http.StatusTooManyRequests
+		}
+		if rec.Code != want {
+			t.Errorf("request %d: Status = %d, want %d", i, rec.Code, want)
+		}
+	}
+}
+
+func TestRateLimit_TrustedProxy(t *testing.T) {
+	cfg := &config.Config{Port: 8080, LogFormat: "text", RateLimit: 1, TrustedProxies: []string{"10.0.0.0/8"}}
+	eventStore := memory.NewEventStore()
+	server := api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil)
+
+	do := func(remote, forwardedFor string) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/persons", http.NoBody)
+		req.RemoteAddr = remote + ":1234"
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// Behind the trusted proxy, each forwarded client has its own budget
+	if code := do("10.0.0.1", "198.51.100.1"); code != http.StatusOK {
+		t.Errorf("first client: Status = %d, want %d", code, http.StatusOK)
+	}
+	if code := do("10.0.0.1", "198.51.100.2"); code != http.StatusOK {
+		t.Errorf("second client: Status = %d, want %d", code, http.StatusOK)
+	}
+	if code := do("10.0.0.1", "198.51.100.1"); code != http.StatusTooManyRequests {
+		t.Errorf("first client again: Status = %d, want %d", code, http.StatusTooManyRequests)
+	}
+
+	// Other peers cannot claim a forwarded address
+	if code := do("192.0.2.1", "198.51.100.3"); code != http.StatusOK {
+		t.Errorf("direct client: Status = %d, want %d", code, http.StatusOK)
+	}
+	if code := do("192.0.2.1", "198.51.100.4"); code != http.StatusTooManyRequests {
+		t.Errorf("direct client with new header: Status = %d, want %d", code, http.StatusTooManyRequests)
+	}
+}
+
+func TestRateLimit_SharedAcrossTrees(t *testing.T) {
+	cfg := &config.Config{Port: 8080, LogFormat: "text", RateLimit: 2, Trees: []string{"maternal", "paternal"}}
+	newServer := func(opts ...api.ServerOption) *api.Server {
+		eventStore := memory.NewEventStore()
+		return api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil, opts...)
+	}
+	server := newServer(api.WithTrees(map[string]*api.Server{"maternal": newServer(), "paternal": newServer()}))
+
+	paths := []string{"/api/v1/persons", "/api/v1/trees/maternal/persons", "/api/v1/trees/paternal/persons"}
+	for i, path := range paths {
+		want := http.StatusOK
+		if i >= 2 {
+			want = http.StatusTooManyRequests
+		}
+		if rec := doRateLimitRequest(server, path, "192.0.2.1"); rec.Code != want {
+			t.Errorf("%s: Status = %d, want %d", path, rec.Code, want)
+		}
+	}
+}
+
+func TestRateLimit_Expensive(t *testing.T) {
+	personPath := "/api/v1/persons/00000000-0000-0000-0000-000000000001"
+	tests := []struct {
+		class    string
+		requests [][3]string // method, path, body
+	}{
+		{"search", [][3]string{
+			{http.MethodGet, "/api/v1/search?q=Smith", ""},
+			{http.MethodPost, "/api/v1/search/advanced", `{"surname":"Smith"}`},
+			{http.MethodGet, "/api/v1/families/search?q=Smith", ""},
+		}},
+		{"export", [][3]string{
+			{http.MethodGet, "/api/v1/export/tree", ""},
+			{http.MethodGet, personPath + "/export-gedcom", ""},
+			{http.MethodPost, "/api/v1/export/selection", `{"person_ids":[]}`},
+			{http.MethodGet, personPath + "/media/archive", ""},
+		}},
+		{"graphql", [][3]string{
+			{http.MethodPost, "/api/v1/graphql", `{"query":"{ persons { total } }"}`},
+			{http.MethodPost, "/api/v1/graphql", `{"query":"{ persons { total } }"}`},
+		}},
+		{"admin", [][3]string{
+			{http.MethodGet, "/api/v1/admin/verify-projections", ""},
+			{http.MethodGet, "/api/v1/admin/verify-projections", ""},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.class, func(t *testing.T) {
+			server := setupRateLimitTestServer(0, 1)
+			for i, r := range tt.requests {
+				req := httptest.NewRequest(r[0], r[1], strings.NewReader(r[2]))
+				req.Header.Set("Content-Type", "application/json")
+				req.RemoteAddr = "192.0.2.1:1234"
+				rec := httptest.NewRecorder()
+				server.Echo().ServeHTTP(rec, req)
+
+				if limited := rec.Code == http.StatusTooManyRequests; limited != (i > 0) {
+					t.Errorf("%s %s: Status = %d, want limited = %v", r[0], r[1], rec.Code, i > 0)
+				}
+			}
+
+			// Cheap endpoints are not limited
+			for i := 0; i < 3; i++ {
+				if rec := doRateLimitRequest(server, "/api/v1/persons", "192.0.2.1"); rec.Code != http.StatusOK {
+					t.Fatalf("list %d: Status = %d, want %d", i, rec.Code, http.StatusOK)
+				}
+			}
+		})
+	}
+}
+
+func TestRequestID_Generated(t *testing.T) {
+	server := setupMiddlewareTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/nonexistent", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	id := rec.Header().Get(api.HeaderRequestID)
+	if id == "" {
+		t.Fatal("Response should have an X-Request-ID header")
+	}
+	var resp map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse error response: %v", err)
+	}
+	if resp["request_id"] != id {
+		t.Errorf("request_id = %v, want %q", resp["request_id"], id)
+	}
+}
+
+func TestRequestID_Propagated(t *testing.T) {
+	server := setupMiddlewareTestServer()
+
+	tests := []struct {
+		name   string
+		header string
+		keep   bool
+	}{
+		{"client ID", "support-1234", true},
+		{"spaces", "bad id", false},
+		{"too long", strings.Repeat("x", 129), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/health", http.NoBody)
+			req.Header.Set(api.HeaderRequestID, tt.header)
+			rec := httptest.NewRecorder()
+			server.Echo().ServeHTTP(rec, req)
+
+			got := rec.Header().Get(api.HeaderRequestID)
+			if tt.keep && got != tt.header {
+				t.Errorf("X-Request-ID = %q, want %q", got, tt.header)
+			}
+			if !tt.keep && (got == tt.header || got == "") {
+				t.Errorf("X-Request-ID = %q, want a generated ID", got)
+			}
+		})
+	}
+}
+
+func TestRequestID_InGeneratedErrorResponses(t *testing.T) {
+	server := setupMiddlewareTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/00000000-0000-0000-0000-000000000001", http.NoBody)
+	req.Header.Set(api.HeaderRequestID, "trace-42")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	var resp map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse error response: %v", err)
+	}
+	if resp["request_id"] != "trace-42" {
+		t.Errorf("request_id = %v, want trace-42", resp["request_id"])
+	}
+}
+
+func TestNewLogger(t *testing.T) {
+	var buf strings.Builder
+	logger := api.NewLogger(&config.Config{LogLevel: "warn", LogFormat: "json"}, &buf)
+
+	logger.Info("hidden")
+	logger.Warn("shown", "request_id", "abc")
+
+	var line map[string]any
+	if err := json.Unmarshal([]byte(buf.String()), &line); err != nil {
+		t.Fatalf("Expected one JSON log line, got %q: %v", buf.String(), err)
+	}
+	if line["msg"] != "shown" || line["request_id"] != "abc" {
+		t.Errorf("log line = %v, want warn line with request_id", line)
+	}
+}
@@ -78,6 +78,73 @@ func TestGetPersonNames(t *testing.T) {
 	}
 }
 
+func TestGetPersonNames_Pagination(t *testing.T) {
+	server := setupNameTestServer()
+	personID := createPersonForNameTest(t, server)
+
+	for _, given := range []string{"Johnny", "Jack", "Jon"} {
+		body := `{"given_name":"` + given + `","surname":"Doe","name_type":"aka"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/persons/"+personID+"/names", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("Failed to add name: %s", rec.Body.String())
+		}
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		wantItems int
+	}{
+		{"first page", "?limit=3", 3},
+		{"last page", "?limit=3&offset=3", 1},
+		{"offset past end", "?offset=10", 0},
+		{"default limit", "", 4},
+		{"negative limit", "?limit=-1", 4},
+		{"negative offset", "?limit=3&offset=-1", 3},
+		{"oversized limit", "?limit=100000", 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+personID+"/names"+tt.query, http.NoBody)
+			rec := httptest.NewRecorder()
+			server.Echo().ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+			}
+
+			var resp struct {
+				Items []map[string]any `json:"items"`
+				Total int              `json:"total"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to parse response: %v", err)
+			}
+			if resp.Total != 4 {
+				t.Errorf("total = %d, want 4", resp.Total)
+			}
+			if len(resp.Items) != tt.wantItems {
+				t.Errorf("items count = %d, want %d", len(resp.Items), tt.wantItems)
+			}
+		})
+	}
+}
+
+func TestGetPersonNames_PersonNotFound(t *testing.T) {
+	server := setupNameTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/00000000-0000-0000-0000-000000000001/names", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
 func TestGetPersonNames_InvalidID(t *testing.T) {
 	server := setupNameTestServer()
 
@@ -3,16 +3,28 @@ package api
 
 import (
 	_ "embed"
+	"encoding/json"
 	"net/http"
 	"strings"
+	"sync"
 	"text/template"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 )
 
 //go:embed openapi.yaml
 var openapiSpec []byte
 
+// openapiSpecJSON converts the embedded spec to JSON on first use.
+var openapiSpecJSON = sync.OnceValues(func() ([]byte, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(openapiSpec)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+})
+
 // swaggerUITemplate is a minimal Swagger UI HTML page
 const swaggerUIHTML = `<!DOCTYPE html>
 <html lang="en">
@@ -58,6 +70,7 @@ var swaggerUITemplate = template.Must(template.New("swagger-ui").Parse(swaggerUI
 func (s *Server) registerDocsRoutes(api *echo.Group) {
 	// Serve raw OpenAPI spec
 	api.GET("/openapi.yaml", s.serveOpenAPISpec)
+	api.GET("/openapi.json", s.serveOpenAPISpecJSON)
 
 	// Serve Swagger UI
 	api.GET("/docs", s.serveSwaggerUI)
@@ -69,6 +82,16 @@ func (s *Server) serveOpenAPISpec(c echo.Context) error {
 	return c.Blob(http.StatusOK, "application/x-yaml", openapiSpec)
 }
 
+// serveOpenAPISpecJSON returns the OpenAPI specification as JSON, for clients
+// and code generators that do not read YAML.
+func (s *Server) serveOpenAPISpecJSON(c echo.Context) error {
+	spec, err := openapiSpecJSON()
+	if err != nil {
+		return err
+	}
+	return c.JSONBlob(http.StatusOK, spec)
+}
+
 // serveSwaggerUI returns the Swagger UI HTML page.
 func (s *Server) serveSwaggerUI(c echo.Context) error {
 	data := struct {
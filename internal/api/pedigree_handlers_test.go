@@ -7,6 +7,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/cacack/my-family/internal/api"
@@ -235,6 +236,91 @@ func TestGetPedigree_MaxGenerations(t *testing.T) {
 	}
 }
 
+func TestGetPedigree_ConfiguredGenerations(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   config.Config
+		query string
+	}{
+		{"default from config", config.Config{DefaultGenerations: 1}, ""},
+		{"request capped at config maximum", config.Config{MaxGenerations: 1}, "?generations=12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.cfg
+			eventStore := memory.NewEventStore()
+			server := api.NewServer(&cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil)
+			juniorID := importPedigreeTestData(t, server)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/pedigree/"+juniorID+tt.query, http.NoBody)
+			rec := httptest.NewRecorder()
+			server.Echo().ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+			}
+
+			var result map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &result)
+
+			if maxGeneration := int(result["max_generation"].(float64)); maxGeneration != 1 {
+				t.Errorf("max_generation = %d, want 1", maxGeneration)
+			}
+		})
+	}
+}
+
+func TestGetPedigree_TextFormat(t *testing.T) {
+	server := setupPedigreeTestServer(t)
+	juniorID := importPedigreeTestData(t, server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pedigree/"+juniorID+"?format=text", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if contentType := rec.Header().Get("Content-Type"); !strings.Contains(contentType, "text/plain") {
+		t.Errorf("Content-Type = %v, want text/plain", contentType)
+	}
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		"PEDIGREE CHART",
+		"Subject: Junior Smith",
+		"Junior Smith\n   b. 1 JAN 2000\n",
+		"  Father: John Smith\n     b. 1 JAN 1970\n",
+		"    Father: George Smith\n",
+		"    Mother: Mary Jones\n",
+		"  Mother: Jane Doe\n",
+		"Total ancestors: 4",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Text output should contain %q, got:\n%s", want, body)
+		}
+	}
+
+	// Fathers are listed before mothers, each followed by their own ancestors
+	if strings.Index(body, "George Smith") > strings.Index(body, "Jane Doe") {
+		t.Errorf("Paternal grandparents should precede the mother, got:\n%s", body)
+	}
+}
+
+func TestGetPedigree_InvalidFormat(t *testing.T) {
+	server := setupPedigreeTestServer(t)
+	juniorID := importPedigreeTestData(t, server)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/pedigree/"+juniorID+"?format=xml", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
 func TestGetPedigree_NoAncestors(t *testing.T) {
 	server := setupPedigreeTestServer(t)
 
@@ -0,0 +1,101 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestPlaceVariantsAndMerge(t *testing.T) {
+	server := setupBrowseTestServer()
+
+	createBrowseTestPerson(t, server, "John", "Smith", "Springfield, IL", "Springfield, Illinois, USA")
+	createBrowseTestPerson(t, server, "Jane", "Smith", "Springfield, IL", "")
+	createBrowseTestPerson(t, server, "Bob", "Jones", "Boston, MA", "")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/places/variants", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var variants struct {
+		Clusters []struct {
+			Suggested string `json:"suggested"`
+			Variants  []struct {
+				Place string `json:"place"`
+				Count int    `json:"count"`
+			} `json:"variants"`
+			Count int `json:"count"`
+		} `json:"clusters"`
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &variants); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if variants.Total != 1 {
+		t.Fatalf("Total = %d, want 1", variants.Total)
+	}
+	if variants.Clusters[0].Suggested != "Springfield, IL" || len(variants.Clusters[0].Variants) != 2 {
+		t.Errorf("unexpected cluster: %+v", variants.Clusters[0])
+	}
+
+	body := `{"canonical":"Springfield, Illinois, USA","variants":["Springfield, IL"]}`
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/places/merge", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var merged struct {
+		PersonsUpdated int `json:"persons_updated"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &merged)
+	if merged.PersonsUpdated != 2 {
+		t.Errorf("PersonsUpdated = %d, want 2", merged.PersonsUpdated)
+	}
+
+	// Browsing the canonical place now finds both persons
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/browse/places/"+url.PathEscape("Springfield, Illinois, USA")+"/persons", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	var persons struct {
+		Total int `json:"total"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &persons)
+	if persons.Total != 2 {
+		t.Errorf("persons at canonical place = %d, want 2", persons.Total)
+	}
+
+	// No clusters remain
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/places/variants", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	json.Unmarshal(rec.Body.Bytes(), &variants)
+	if variants.Total != 0 {
+		t.Errorf("Total after merge = %d, want 0", variants.Total)
+	}
+}
+
+func TestMergePlaces_InvalidInput(t *testing.T) {
+	server := setupBrowseTestServer()
+
+	body := `{"canonical":"Boston, MA","variants":["Boston, MA"]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/places/merge", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -419,6 +420,14 @@ func TestGetStatistics_WithData(t *testing.T) {
 		t.Errorf("Smith count = %d, want 2", resp.TopSurnames[0].Count)
 	}
 
+	// Check births by decade: 1950s (2), 1960s, 1970s, 1980s (1)
+	if len(resp.BirthsByDecade) != 4 {
+		t.Fatalf("BirthsByDecade = %+v, want 4 decades", resp.BirthsByDecade)
+	}
+	if resp.BirthsByDecade[0].Decade != 1950 || resp.BirthsByDecade[0].Count != 2 {
+		t.Errorf("BirthsByDecade[0] = %+v, want 1950 with 2", resp.BirthsByDecade[0])
+	}
+
 	// Check date range
 	if resp.DateRange.EarliestBirth == nil || *resp.DateRange.EarliestBirth != "1950" {
 		t.Errorf("EarliestBirth = %v, want 1950", resp.DateRange.EarliestBirth)
@@ -428,6 +437,47 @@ func TestGetStatistics_WithData(t *testing.T) {
 	}
 }
 
+// TestGetStatistics_Lifespan tests that age-at-death statistics are returned
+func TestGetStatistics_Lifespan(t *testing.T) {
+	server, readStore := setupQualityTestServer()
+	ctx := httptest.NewRequest(http.MethodGet, "/", http.NoBody).Context()
+
+	for _, dates := range [][2]string{{"1820", "1880"}, {"1850", "1915"}} {
+		person := repository.PersonReadModel{
+			ID:           uuid.New(),
+			GivenName:    "John",
+			Surname:      "Smith",
+			BirthDateRaw: dates[0],
+			DeathDateRaw: dates[1],
+			UpdatedAt:    time.Now(),
+		}
+		_ = readStore.SavePerson(ctx, &person)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/statistics", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp api.Statistics
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if resp.Lifespan.PersonCount != 2 {
+		t.Errorf("PersonCount = %d, want 2", resp.Lifespan.PersonCount)
+	}
+	if resp.Lifespan.AverageAge == nil || *resp.Lifespan.AverageAge != 62.5 {
+		t.Errorf("AverageAge = %v, want 62.5", resp.Lifespan.AverageAge)
+	}
+	if len(resp.Lifespan.ByCentury) != 1 || resp.Lifespan.ByCentury[0].Century != "1800s" {
+		t.Errorf("ByCentury = %+v, want a single 1800s entry", resp.Lifespan.ByCentury)
+	}
+}
+
 // TestGetStatistics_ResponseSchema tests that response matches StatisticsResponse schema
 func TestGetStatistics_ResponseSchema(t *testing.T) {
 	server, _ := setupQualityTestServer()
@@ -446,7 +496,7 @@ func TestGetStatistics_ResponseSchema(t *testing.T) {
 	}
 
 	// Check required fields
-	requiredFields := []string{"total_persons", "total_families", "top_surnames", "gender_distribution"}
+	requiredFields := []string{"total_persons", "total_families", "top_surnames", "top_given_names", "gender_distribution", "living", "lifespan", "births_by_decade", "family_size"}
 	for _, field := range requiredFields {
 		if _, ok := raw[field]; !ok {
 			t.Errorf("Missing required field: %s", field)
@@ -458,7 +508,7 @@ func TestGetStatistics_ResponseSchema(t *testing.T) {
 	if !ok {
 		t.Error("gender_distribution should be an object")
 	} else {
-		for _, field := range []string{"male", "female", "unknown"} {
+		for _, field := range []string{"male", "female", "nonbinary", "unknown"} {
 			if _, ok := genderDist[field]; !ok {
 				t.Errorf("gender_distribution missing field: %s", field)
 			}
@@ -608,3 +658,170 @@ func TestQualityEndpoints_ContentType(t *testing.T) {
 		})
 	}
 }
+
+// TestGetMarriageAgeStatistics tests GET /statistics/marriage-age
+func TestGetMarriageAgeStatistics(t *testing.T) {
+	server, readStore := setupQualityTestServer()
+	ctx := httptest.NewRequest(http.MethodGet, "/", http.NoBody).Context()
+
+	husband := repository.PersonReadModel{
+		ID: uuid.New(), GivenName: "John", Surname: "Smith", FullName: "John Smith",
+		Gender: domain.GenderMale, BirthDateRaw: "1850", UpdatedAt: time.Now(),
+	}
+	wife := repository.PersonReadModel{
+		ID: uuid.New(), GivenName: "Mary", Surname: "Jones", FullName: "Mary Jones",
+		Gender: domain.GenderFemale, BirthDateRaw: "1854", UpdatedAt: time.Now(),
+	}
+	_ = readStore.SavePerson(ctx, &husband)
+	_ = readStore.SavePerson(ctx, &wife)
+	_ = readStore.SaveFamily(ctx, &repository.FamilyReadModel{
+		ID: uuid.New(), Partner1ID: &husband.ID, Partner2ID: &wife.ID,
+		MarriageDateRaw: "1876", UpdatedAt: time.Now(),
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/statistics/marriage-age", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp api.MarriageAgeStatistics
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if resp.Overall.PersonCount != 2 {
+		t.Errorf("Overall.PersonCount = %d, want 2", resp.Overall.PersonCount)
+	}
+	if resp.Overall.AverageAge == nil || *resp.Overall.AverageAge != 24 {
+		t.Errorf("Overall.AverageAge = %v, want 24", resp.Overall.AverageAge)
+	}
+	if resp.Male.MedianAge == nil || *resp.Male.MedianAge != 26 {
+		t.Errorf("Male.MedianAge = %v, want 26", resp.Male.MedianAge)
+	}
+	if resp.Female.MedianAge == nil || *resp.Female.MedianAge != 22 {
+		t.Errorf("Female.MedianAge = %v, want 22", resp.Female.MedianAge)
+	}
+	if resp.Unknown.PersonCount != 0 || resp.Unknown.AverageAge != nil {
+		t.Errorf("Unknown = %+v, want empty", resp.Unknown)
+	}
+}
+
+func TestGetMissingDataReport(t *testing.T) {
+	server, _ := setupQualityTestServer()
+
+	createQualityTestPerson(t, server, "Nobody", "Known")
+	createQualityTestPerson(t, server, "Dated", "Person", "birth_date", strconv.Itoa(time.Now().Year()-30))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/missing-data?limit=10", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp struct {
+		Items []struct {
+			PersonName    string   `json:"person_name"`
+			Missing       []string `json:"missing"`
+			PriorityScore int      `json:"priority_score"`
+		} `json:"items"`
+		Total int `json:"total"`
+		Limit int `json:"limit"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if resp.Total != 2 || len(resp.Items) != 2 || resp.Limit != 10 {
+		t.Fatalf("total/items/limit = %d/%d/%d, want 2/2/10", resp.Total, len(resp.Items), resp.Limit)
+	}
+	// No birth date, parents or sources outranks a dated person
+	if resp.Items[0].PersonName != "Nobody Known" || resp.Items[0].PriorityScore != 8 {
+		t.Errorf("Items[0] = %+v, want Nobody Known with priority 8", resp.Items[0])
+	}
+	if resp.Items[1].PriorityScore != 5 {
+		t.Errorf("Items[1] priority = %d, want 5 (parents + sources)", resp.Items[1].PriorityScore)
+	}
+}
+
+func TestGetQualityBySurname(t *testing.T) {
+	server, _ := setupQualityTestServer()
+
+	born := strconv.Itoa(time.Now().Year() - 30)
+	createQualityTestPerson(t, server, "Ann", "Henderson")
+	createQualityTestPerson(t, server, "Bob", "Henderson", "birth_date", born, "birth_place", "Leeds")
+	createQualityTestPerson(t, server, "Cat", "Smith", "birth_date", born, "birth_place", "York")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/quality/by-surname", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp api.SurnameQualityReport
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	if resp.Total != 2 || len(resp.Items) != 2 {
+		t.Fatalf("total/items = %d/%d, want 2/2", resp.Total, len(resp.Items))
+	}
+	// Least complete surname first
+	first := resp.Items[0]
+	if first.Surname != "Henderson" || first.PersonCount != 2 {
+		t.Errorf("Items[0] = %+v, want Henderson with 2 persons", first)
+	}
+	if first.AverageCompleteness != 75 {
+		t.Errorf("Henderson completeness = %.1f, want 75", first.AverageCompleteness)
+	}
+	if first.MissingBirthDates != 1 || first.UnsourcedBirths != 1 || first.RecordsWithIssues != 1 {
+		t.Errorf("Henderson counts = %+v, want 1 missing birth, 1 unsourced birth, 1 with issues", first)
+	}
+	if resp.Items[1].Surname != "Smith" || resp.Items[1].AverageCompleteness != 100 {
+		t.Errorf("Items[1] = %+v, want Smith at 100", resp.Items[1])
+	}
+}
+
+func TestListOrphanedPersons(t *testing.T) {
+	server, readStore := setupQualityTestServer()
+	ctx := context.Background()
+
+	parent := createQualityTestPerson(t, server, "Ann", "Baker")
+	child := createQualityTestPerson(t, server, "Ben", "Baker")
+	createQualityTestPerson(t, server, "Cal", "Stray")
+	createQualityTestPerson(t, server, "Dee", "Adams")
+
+	parentID := uuid.MustParse(parent)
+	familyID := uuid.New()
+	if err := readStore.SaveFamily(ctx, &repository.FamilyReadModel{ID: familyID, Partner1ID: &parentID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := readStore.SaveFamilyChild(ctx, &repository.FamilyChildReadModel{FamilyID: familyID, PersonID: uuid.MustParse(child)}); err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/orphans?limit=1", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp api.PersonList
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Total != 2 || len(resp.Items) != 1 {
+		t.Fatalf("total/items = %d/%d, want 2/1", resp.Total, len(resp.Items))
+	}
+	if resp.Items[0].Surname != "Adams" {
+		t.Errorf("Items[0] = %s %s, want Dee Adams first by surname", resp.Items[0].GivenName, resp.Items[0].Surname)
+	}
+}
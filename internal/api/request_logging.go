@@ -0,0 +1,191 @@
+package api
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/cacack/my-family/internal/config"
+)
+
+// HeaderRequestID carries the correlation ID of a request. Clients may send
+// one to tie server logs to their own; otherwise the server generates it.
+// Either way it is returned in the response header, in error bodies, and on
+// every log line written for the request.
+const HeaderRequestID = echo.HeaderXRequestID
+
+// maxRequestIDLength is the longest client-supplied request ID accepted.
+// Longer or malformed IDs are replaced rather than logged.
+const maxRequestIDLength = 128
+
+// loggerKey is the request context key for the request-scoped logger.
+type loggerKey struct{}
+
+// NewLogger returns a structured logger writing to w at the configured
+// LOG_LEVEL, as JSON when LOG_FORMAT is "json" and as key=value text
+// otherwise.
+func NewLogger(cfg *config.Config, w io.Writer) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: logLevel(cfg.LogLevel)}
+	if cfg.LogFormat == "json" {
+		return slog.New(slog.NewJSONHandler(w, opts))
+	}
+	return slog.New(slog.NewTextHandler(w, opts))
+}
+
+// logLevel parses a LOG_LEVEL value, defaulting to info.
+func logLevel(name string) slog.Level {
+	switch strings.ToLower(name) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// requestCorrelation returns middleware that gives every request an ID, sets
+// it on the response, and attaches a logger carrying it (and the tree, for
+// requests handed on from the default tree's server) to the request context
+// for handlers and later middleware.
+func requestCorrelation(logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			id := req.Header.Get(HeaderRequestID)
+			if !validRequestID(id) {
+				id = uuid.NewString()
+			}
+			c.Response().Header().Set(HeaderRequestID, id)
+			// Keep the ID if the request is handed on to another tree's server
+			req.Header.Set(HeaderRequestID, id)
+
+			reqLogger := logger.With("request_id", id)
+			if tree := req.Header.Get(HeaderTree); tree != "" {
+				reqLogger = reqLogger.With("tree", tree)
+			}
+			ctx := context.WithValue(req.Context(), loggerKey{}, reqLogger)
+			c.SetRequest(req.WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+// validRequestID reports whether a client-supplied request ID is safe to
+// echo and log: non-empty, bounded, and free of spaces and control
+// characters.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// requestID returns the correlation ID assigned to the request.
+func requestID(c echo.Context) string {
+	return c.Response().Header().Get(HeaderRequestID)
+}
+
+// requestLogger returns the request-scoped logger from ctx, or the default
+// logger outside a request.
+func requestLogger(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// requestLogging returns middleware that writes one log line per request
+// once it completes: at error level for server errors, warn for client
+// errors, and info otherwise.
+func requestLogging() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			if err := next(c); err != nil {
+				// Render the error now so the logged status is the one sent
+				c.Error(err)
+			}
+
+			req, res := c.Request(), c.Response()
+			level := slog.LevelInfo
+			switch {
+			case res.Status >= 500:
+				level = slog.LevelError
+			case res.Status >= 400:
+				level = slog.LevelWarn
+			}
+			requestLogger(req.Context()).LogAttrs(req.Context(), level, "request",
+				slog.String("method", req.Method),
+				slog.String("uri", req.RequestURI),
+				slog.Int("status", res.Status),
+				slog.Duration("latency", time.Since(start)),
+				slog.Int64("bytes_out", res.Size),
+				slog.String("remote_ip", c.RealIP()),
+			)
+			return nil
+		}
+	}
+}
+
+// errorRequestID is a strict handler middleware that adds the request ID to
+// the Error body of error responses returned by the generated handlers.
+func errorRequestID(f StrictHandlerFunc, _ string) StrictHandlerFunc {
+	return func(c echo.Context, request any) (any, error) {
+		response, err := f(c, request)
+		if err != nil || response == nil {
+			return response, err
+		}
+		return withRequestID(response, requestID(c)), nil
+	}
+}
+
+var errorBodyType = reflect.TypeOf(Error{})
+
+// withRequestID returns a copy of response with the request ID set, if
+// response is, embeds, or has as its Body an Error. Other responses are
+// returned unchanged. The generated response types are distinct named types
+// per operation and status, hence the reflection.
+func withRequestID(response any, id string) any {
+	v := reflect.ValueOf(response)
+	if v.Kind() != reflect.Struct {
+		return response
+	}
+	out := reflect.New(v.Type()).Elem()
+	out.Set(v)
+	field := requestIDField(out)
+	if !field.IsValid() {
+		return response
+	}
+	field.Set(reflect.ValueOf(&id))
+	return out.Interface()
+}
+
+// requestIDField finds the RequestId field of the Error body within v.
+func requestIDField(v reflect.Value) reflect.Value {
+	if v.Type().ConvertibleTo(errorBodyType) {
+		return v.FieldByName("RequestId")
+	}
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		if (sf.Anonymous || sf.Name == "Body") && sf.Type.Kind() == reflect.Struct {
+			if field := requestIDField(v.Field(i)); field.IsValid() {
+				return field
+			}
+		}
+	}
+	return reflect.Value{}
+}
@@ -2,16 +2,26 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"io/fs"
+	"log/slog"
 	"net/http"
+	"os"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/crypto/acme/autocert"
 
 	"github.com/cacack/my-family/internal/command"
 	"github.com/cacack/my-family/internal/config"
+	"github.com/cacack/my-family/internal/domain"
+	"github.com/cacack/my-family/internal/graphql"
+	"github.com/cacack/my-family/internal/media"
 	"github.com/cacack/my-family/internal/query"
 	"github.com/cacack/my-family/internal/repository"
 )
@@ -42,10 +52,35 @@ func WithDemoReset(eventStore, readStore, snapshotStore Resetter) ServerOption {
 	}
 }
 
+// WithStreamSnapshots enables automatic stream snapshots (taken every
+// cfg.SnapshotEvery events) and snapshot-based state reconstruction.
+func WithStreamSnapshots(store repository.StreamSnapshotStore) ServerOption {
+	return func(s *Server) {
+		s.streamSnapshots = store
+	}
+}
+
+// WithMediaBlobStore keeps uploaded media content in an external blob store
+// rather than inline in events and the read model.
+func WithMediaBlobStore(store repository.MediaBlobStore) ServerOption {
+	return func(s *Server) {
+		s.mediaBlobs = store
+	}
+}
+
+// WithProjectionCheck enables the projection check endpoint. newScratchStore
+// returns an empty read model store to rebuild state into for each check.
+func WithProjectionCheck(newScratchStore func() repository.ReadModelStore) ServerOption {
+	return func(s *Server) {
+		s.projectionCheck = query.NewProjectionCheckService(s.eventStore, s.readStore, newScratchStore)
+	}
+}
+
 // Server wraps the Echo server with application dependencies.
 type Server struct {
 	echo                *echo.Echo
 	config              *config.Config
+	eventStore          repository.EventStore
 	readStore           repository.ReadModelStore
 	commandHandler      *command.Handler
 	personService       *query.PersonService
@@ -68,8 +103,19 @@ type Server struct {
 	ldsOrdinanceService *query.LDSOrdinanceService
 	exportService       *query.ExportService
 	evidenceService     *query.EvidenceQueryService
+	projectionCheck     *query.ProjectionCheckService // nil unless WithProjectionCheck is given
+	changes             *repository.ChangeBroker
+	thumbnails          *media.ThumbnailCache // non-default thumbnail sizes, generated on request
 	frontendFS          fs.FS
-	demo                *demoResetter // nil when not in demo mode
+	demo                *demoResetter                  // nil when not in demo mode
+	streamSnapshots     repository.StreamSnapshotStore // nil when stream snapshots are disabled
+	mediaBlobs          repository.MediaBlobStore      // nil when media content is stored inline
+	graphQLSchema       *graphql.Schema
+	logger              *slog.Logger
+	rateLimits          *rateLimiterStore  // nil when rate limiting is disabled
+	expensiveRateLimits *rateLimiterStore  // nil when search and export are not separately limited
+	trees               map[string]*Server // additional family trees by ID
+	treeID              string             // set on the servers of additional trees
 }
 
 // NewServer creates a new API server with all dependencies.
@@ -83,31 +129,51 @@ func NewServer(
 ) *Server {
 	e := echo.New()
 	e.HideBanner = true
+	e.IPExtractor = ipExtractor(cfg)
 
 	// Setup middleware stack (order matters)
+	// Correlate each request with an X-Request-ID and log it when it
+	// completes, including requests that panic and are recovered below
+	logger := NewLogger(cfg, os.Stdout)
+	e.Pre(requestCorrelation(logger))
+	e.Use(requestLogging())
 	e.Use(middleware.Recover())
-	e.Use(middleware.RequestID())
 
-	// Configure logger based on config
-	if cfg.LogFormat == "json" {
-		e.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
-			Format: `{"time":"${time_rfc3339}","id":"${id}","method":"${method}","uri":"${uri}","status":${status},"latency":"${latency_human}"}` + "\n",
-		}))
-	} else {
-		e.Use(middleware.Logger())
+	e.Use(middleware.CORSWithConfig(corsConfig(cfg)))
+
+	// Per-IP rate limiting. The separate budget for search, export and other
+	// expensive operations is attached to their routes in registerRoutes.
+	var rateLimits, expensiveRateLimits *rateLimiterStore
+	if cfg.RateLimit > 0 {
+		rateLimits = newRateLimiterStore(cfg.RateLimit)
+		e.Use(rateLimit(rateLimits, cfg.RateLimit, rateLimitExempt))
+	}
+	if cfg.RateLimitExpensive > 0 {
+		expensiveRateLimits = newRateLimiterStore(cfg.RateLimitExpensive)
 	}
 
-	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
-	}))
+	// Bearer token authentication (after CORS so preflights get CORS headers,
+	// and after rate limiting so token guessing is throttled)
+	if cfg.AuthEnabled() {
+		e.Use(tokenAuth(slices.Concat(cfg.APITokens, cfg.AdminTokens), cfg.AuthPublicReads))
+	}
+
+	// Attribute changes to the caller named in X-Actor
+	e.Use(actorAttribution())
 
 	// Custom error handler
 	e.HTTPErrorHandler = customErrorHandler
 
+	// Publish every appended event to change stream subscribers
+	changes := repository.NewChangeBroker()
+	eventStore = repository.NewNotifyingEventStore(eventStore, changes)
+
 	// Create services
-	cmdHandler := command.NewHandler(eventStore, readStore)
+	handlerOpts := []command.HandlerOption{
+		command.WithThumbnailSize(cfg.ThumbnailSize),
+		command.WithMaxMediaSize(maxMediaSize(cfg)),
+	}
+	cmdHandler := command.NewHandler(eventStore, readStore, handlerOpts...)
 	personSvc := query.NewPersonService(readStore)
 	familySvc := query.NewFamilyService(readStore)
 	pedigreeSvc := query.NewPedigreeService(readStore)
@@ -132,6 +198,7 @@ func NewServer(
 	server := &Server{
 		echo:                e,
 		config:              cfg,
+		eventStore:          eventStore,
 		readStore:           readStore,
 		commandHandler:      cmdHandler,
 		personService:       personSvc,
@@ -154,7 +221,12 @@ func NewServer(
 		ldsOrdinanceService: ldsOrdinanceSvc,
 		exportService:       exportSvc,
 		evidenceService:     evidenceSvc,
+		changes:             changes,
+		thumbnails:          media.NewThumbnailCache(media.DefaultThumbnailCacheEntries),
 		frontendFS:          frontendFS,
+		logger:              logger,
+		rateLimits:          rateLimits,
+		expensiveRateLimits: expensiveRateLimits,
 	}
 
 	// Apply options
@@ -162,14 +234,140 @@ func NewServer(
 		opt(server)
 	}
 
+	// Hand requests for other family trees to their servers
+	e.Pre(server.routeToTree)
+
+	// Rebuild the command handler and snapshot-aware services once optional
+	// stores are known
+	if server.mediaBlobs != nil {
+		handlerOpts = append(handlerOpts, command.WithMediaBlobStore(server.mediaBlobs))
+	}
+	if server.streamSnapshots != nil {
+		handlerOpts = append(handlerOpts, command.WithStreamSnapshots(server.streamSnapshots, cfg.SnapshotEvery))
+		server.rollbackService = query.NewRollbackServiceWithSnapshots(eventStore, readStore, server.streamSnapshots)
+	}
+	if server.mediaBlobs != nil || server.streamSnapshots != nil {
+		server.commandHandler = command.NewHandler(eventStore, readStore, handlerOpts...)
+	}
+
 	// Register routes
 	server.registerRoutes()
 
 	return server
 }
 
+// maxMediaSize returns the media upload limit in bytes, falling back to
+// domain.DefaultMaxMediaFileSize when none is configured.
+func maxMediaSize(cfg *config.Config) int64 {
+	if cfg.MaxMediaSize > 0 {
+		return int64(cfg.MaxMediaSize) * 1024 * 1024
+	}
+	return domain.DefaultMaxMediaFileSize
+}
+
+// defaultMaxImportSize is the GEDCOM import upload limit used when none is
+// configured.
+const defaultMaxImportSize = 100 * 1024 * 1024
+
+// maxImportSize returns the GEDCOM import upload limit in bytes, covering the
+// GEDCOM file and any media archive together.
+func maxImportSize(cfg *config.Config) int64 {
+	if cfg.MaxImportSize > 0 {
+		return int64(cfg.MaxImportSize) * 1024 * 1024
+	}
+	return defaultMaxImportSize
+}
+
+// corsAPIHeaders are the request headers the API reads, always allowed in
+// cross-origin requests.
+var corsAPIHeaders = []string{
+	echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization,
+	"If-Match", "If-None-Match", HeaderActor, HeaderRequestID, HeaderTree,
+}
+
+// corsConfig builds the CORS policy from the configured origins, methods, and
+// extra headers, allowing any origin with the API's own methods when unset.
+func corsConfig(cfg *config.Config) middleware.CORSConfig {
+	origins := cfg.CORSAllowOrigins
+	if len(origins) == 0 {
+		origins = []string{"*"}
+	}
+	methods := []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions}
+	if len(cfg.CORSAllowMethods) > 0 {
+		methods = make([]string, 0, len(cfg.CORSAllowMethods))
+		for _, m := range cfg.CORSAllowMethods {
+			methods = append(methods, strings.ToUpper(m))
+		}
+	}
+	return middleware.CORSConfig{
+		AllowOrigins:  origins,
+		AllowMethods:  methods,
+		AllowHeaders:  append(slices.Clone(corsAPIHeaders), cfg.CORSAllowHeaders...),
+		ExposeHeaders: []string{"ETag", "Retry-After", HeaderRequestID},
+	}
+}
+
+// defaultMaxGenerations is the tree view generation limit used when none is
+// configured.
+const defaultMaxGenerations = 10
+
+// maxGenerations returns the most generations a tree view may traverse.
+func maxGenerations(cfg *config.Config) int {
+	if cfg.MaxGenerations > 0 {
+		return cfg.MaxGenerations
+	}
+	return defaultMaxGenerations
+}
+
+// treeGenerations returns how many generations a tree view should traverse:
+// the requested count, else the configured default, else viewDefault, capped
+// at maxGenerations.
+func treeGenerations(cfg *config.Config, requested *int, viewDefault int) int {
+	gens := viewDefault
+	if cfg.DefaultGenerations > 0 {
+		gens = cfg.DefaultGenerations
+	}
+	if requested != nil && *requested > 0 {
+		gens = *requested
+	}
+	return min(gens, maxGenerations(cfg))
+}
+
+// Search limits used when none are configured.
+const (
+	defaultSearchMinQueryLength = 2
+	defaultSearchMaxLimit       = 100
+	defaultSearchLimit          = 20
+)
+
+// searchMinQueryLength returns the fewest characters a search query may have.
+func searchMinQueryLength(cfg *config.Config) int {
+	if cfg.SearchMinQueryLength > 0 {
+		return cfg.SearchMinQueryLength
+	}
+	return defaultSearchMinQueryLength
+}
+
+// searchLimit returns how many results a search should return: the requested
+// count, else defaultSearchLimit, capped at the configured maximum.
+func searchLimit(cfg *config.Config, requested *int) int {
+	maxLimit := defaultSearchMaxLimit
+	if cfg.SearchMaxLimit > 0 {
+		maxLimit = cfg.SearchMaxLimit
+	}
+	limit := defaultSearchLimit
+	if requested != nil && *requested > 0 {
+		limit = *requested
+	}
+	return min(limit, maxLimit)
+}
+
 // registerRoutes sets up all API routes.
 func (s *Server) registerRoutes() {
+	// Orchestration probes (unversioned, outside the API group)
+	s.echo.GET("/healthz", s.liveness)
+	s.echo.GET("/readyz", s.readiness)
+
 	api := s.echo.Group("/api/v1")
 
 	// Health check (outside generated routes)
@@ -190,11 +388,20 @@ func (s *Server) registerRoutes() {
 	// routes; SSE does not map onto the OpenAPI strict server).
 	s.registerImportProgressRoutes(api)
 
+	// Entity change notifications as Server-Sent Events (outside generated routes)
+	s.registerChangeStreamRoutes(api)
+
+	// GraphQL queries over persons, families, and sources (outside generated routes)
+	s.registerGraphQLRoutes(api)
+
 	// Use generated strict handler registration for all API routes
 	// This provides compile-time type safety for all endpoints
 	strictServer := NewStrictServer(s)
-	strictHandler := NewStrictHandler(strictServer, nil)
-	RegisterHandlersWithBaseURL(s.echo, strictHandler, "/api/v1")
+	strictHandler := NewStrictHandler(strictServer, []StrictMiddlewareFunc{errorRequestID})
+	RegisterHandlersWithOptions(s.echo, strictHandler, RegisterHandlersOptions{
+		BaseURL:              "/api/v1",
+		OperationMiddlewares: s.operationMiddlewares(),
+	})
 
 	// Serve frontend if available
 	if s.frontendFS != nil {
@@ -227,8 +434,23 @@ func (s *Server) registerRoutes() {
 }
 
 // Start starts the HTTP server.
+//
+// With TLS_CERT/TLS_KEY set it serves HTTPS using that certificate; with
+// ACME_DOMAIN set it obtains certificates from Let's Encrypt, which requires
+// the server to be reachable on port 443 for the listed domains.
 func (s *Server) Start() error {
 	addr := fmt.Sprintf(":%d", s.config.Port)
+	if err := s.config.ValidateTLS(); err != nil {
+		return err
+	}
+	switch {
+	case len(s.config.ACMEDomains) > 0:
+		s.echo.AutoTLSManager.HostPolicy = autocert.HostWhitelist(s.config.ACMEDomains...)
+		s.echo.AutoTLSManager.Cache = autocert.DirCache(s.config.ACMECacheDir)
+		return s.echo.StartAutoTLS(addr)
+	case s.config.TLSCert != "":
+		return s.echo.StartTLS(addr, s.config.TLSCert, s.config.TLSKey)
+	}
 	return s.echo.Start(addr)
 }
 
@@ -251,3 +473,54 @@ func (s *Server) CommandHandler() *command.Handler {
 func (s *Server) healthCheck(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
 }
+
+// readinessTimeout bounds how long the readiness probe waits on each store.
+const readinessTimeout = 2 * time.Second
+
+// readinessResponse is the body of GET /readyz.
+type readinessResponse struct {
+	Status string            `json:"status"` // "ok" or "unavailable"
+	Checks map[string]string `json:"checks"` // check name -> "ok" or "unavailable"
+}
+
+// liveness reports that the process is up and serving HTTP. It deliberately
+// does not touch the stores, so a slow database does not get the process restarted.
+func (s *Server) liveness(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// readiness reports whether the server can handle requests by issuing a cheap
+// read against the event store and the read model store. It responds 503 when
+// either store fails or does not answer within readinessTimeout. Store errors
+// are logged rather than returned, since the endpoint is unauthenticated.
+func (s *Server) readiness(c echo.Context) error {
+	checks := map[string]func(ctx context.Context) error{
+		"event_store": func(ctx context.Context) error {
+			_, err := s.eventStore.ReadAll(ctx, 0, 1)
+			return err
+		},
+		"read_store": func(ctx context.Context) error {
+			_, err := s.readStore.GetPerson(ctx, uuid.Nil)
+			return err
+		},
+	}
+
+	resp := readinessResponse{Status: "ok", Checks: make(map[string]string, len(checks))}
+	for name, check := range checks {
+		ctx, cancel := context.WithTimeout(c.Request().Context(), readinessTimeout)
+		err := check(ctx)
+		cancel()
+		if err != nil {
+			resp.Status = "unavailable"
+			resp.Checks[name] = "unavailable"
+			requestLogger(c.Request().Context()).Error("readiness check failed", "check", name, "error", err)
+			continue
+		}
+		resp.Checks[name] = "ok"
+	}
+
+	if resp.Status != "ok" {
+		return c.JSON(http.StatusServiceUnavailable, resp)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
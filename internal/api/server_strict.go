@@ -2,13 +2,23 @@
 package api
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/url"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	gcgedcom "github.com/cacack/gedcom-go/v2/gedcom"
 	"github.com/google/uuid"
@@ -17,7 +27,9 @@ import (
 	"github.com/cacack/my-family/internal/citation"
 	"github.com/cacack/my-family/internal/command"
 	"github.com/cacack/my-family/internal/domain"
+	"github.com/cacack/my-family/internal/exporter"
 	"github.com/cacack/my-family/internal/gedcom"
+	"github.com/cacack/my-family/internal/media"
 	"github.com/cacack/my-family/internal/query"
 	"github.com/cacack/my-family/internal/repository"
 )
@@ -35,6 +47,50 @@ func NewStrictServer(server *Server) *StrictServer {
 	return &StrictServer{server: server}
 }
 
+// runUpdate executes an update command. When the client opts in with
+// ?retry=true, version conflicts are retried against the latest version.
+func (ss *StrictServer) runUpdate(ctx context.Context, retry *RetryParam, entityID uuid.UUID, version *int64, update func(ctx context.Context) error) error {
+	if retry == nil || !*retry {
+		return update(ctx)
+	}
+	return ss.server.commandHandler.RetryUpdate(ctx, entityID, version, update, command.DefaultRetryAttempts)
+}
+
+// updateRetry disables retries for updates conditioned on If-Match, which
+// must fail rather than be re-applied to a newer version.
+func updateRetry(retry *RetryParam, ifMatch *string) *RetryParam {
+	if ifMatch != nil {
+		return nil
+	}
+	return retry
+}
+
+// updateVersion returns the expected entity version for an update, taken
+// from the If-Match header when present and otherwise from the body. The
+// header may carry a person ETag, whose version precedes a dash. A non-empty
+// message explains why neither names a usable version.
+func updateVersion(ifMatch *string, bodyVersion *int64) (int64, string) {
+	if ifMatch == nil {
+		if bodyVersion == nil {
+			return 0, "version is required in the body or the If-Match header"
+		}
+		return *bodyVersion, ""
+	}
+	tag := strings.TrimSpace(*ifMatch)
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, `If-Match must be a single version ETag, e.g. "3"`
+	}
+	versionPart, _, _ := strings.Cut(tag[1:len(tag)-1], "-")
+	version, err := strconv.ParseInt(versionPart, 10, 64)
+	if err != nil {
+		return 0, `If-Match must be a single version ETag, e.g. "3"`
+	}
+	if bodyVersion != nil && *bodyVersion != version {
+		return 0, "If-Match and body version disagree"
+	}
+	return version, ""
+}
+
 // validEnumParam reports whether an optional enum query parameter holds a
 // value the spec allows. A nil pointer (parameter omitted) is valid.
 func validEnumParam[T interface{ Valid() bool }](p *T) bool {
@@ -50,7 +106,7 @@ func (ss *StrictServer) GetAhnentafel(ctx context.Context, request GetAhnentafel
 	// Validate format enum if provided
 	if request.Params.Format != nil {
 		switch *request.Params.Format {
-		case Json, Text:
+		case GetAhnentafelParamsFormatJson, GetAhnentafelParamsFormatText:
 			// Valid formats
 		default:
 			return GetAhnentafel400JSONResponse{BadRequestJSONResponse{
@@ -60,17 +116,12 @@ func (ss *StrictServer) GetAhnentafel(ctx context.Context, request GetAhnentafel
 		}
 	}
 
-	maxGen := 5
-	if request.Params.Generations != nil {
-		maxGen = *request.Params.Generations
-		if maxGen > 10 {
-			maxGen = 10
-		}
-	}
+	maxGen := treeGenerations(ss.server.config, request.Params.Generations, 5)
 
 	result, err := ss.server.ahnentafelService.GetAhnentafel(ctx, query.GetAhnentafelInput{
 		PersonID:       request.Id,
 		MaxGenerations: maxGen,
+		IncludeSources: request.Params.IncludeSources != nil && *request.Params.IncludeSources,
 	})
 	if err != nil {
 		if errors.Is(err, query.ErrNotFound) {
@@ -96,7 +147,7 @@ func (ss *StrictServer) GetAhnentafel(ctx context.Context, request GetAhnentafel
 	}
 
 	// Check format - if text, return text response
-	if request.Params.Format != nil && *request.Params.Format == Text {
+	if request.Params.Format != nil && *request.Params.Format == GetAhnentafelParamsFormatText {
 		var sb strings.Builder
 		sb.WriteString("AHNENTAFEL REPORT\n")
 		sb.WriteString("=================\n")
@@ -117,8 +168,14 @@ func (ss *StrictServer) GetAhnentafel(ctx context.Context, request GetAhnentafel
 			if entry.DeathDate != nil {
 				deathDateStr = entry.DeathDate.String()
 			}
-			sb.WriteString(fmt.Sprintf("   b. %s\n", formatEventLineStr(birthDateStr, entry.BirthPlace)))
-			sb.WriteString(fmt.Sprintf("   d. %s\n\n", formatEventLineStr(deathDateStr, entry.DeathPlace)))
+			birthLine := formatEventLineStr(birthDateStr, entry.BirthPlace)
+			deathLine := formatEventLineStr(deathDateStr, entry.DeathPlace)
+			if entry.Sources != nil {
+				birthLine += formatSourceCount(birthLine, entry.Sources.Birth)
+				deathLine += formatSourceCount(deathLine, entry.Sources.Death)
+			}
+			sb.WriteString(fmt.Sprintf("   b. %s\n", birthLine))
+			sb.WriteString(fmt.Sprintf("   d. %s\n\n", deathLine))
 		}
 
 		sb.WriteString(fmt.Sprintf("Generated: %s\n", time.Now().Format("2006-01-02")))
@@ -147,6 +204,49 @@ func (ss *StrictServer) GetAhnentafel(ctx context.Context, request GetAhnentafel
 	}, nil
 }
 
+// GetEndOfLines implements StrictServerInterface.
+func (ss *StrictServer) GetEndOfLines(ctx context.Context, request GetEndOfLinesRequestObject) (GetEndOfLinesResponseObject, error) {
+	person, err := ss.server.readStore.GetPerson(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if person == nil {
+		return GetEndOfLines404JSONResponse{NotFoundJSONResponse{
+			Code:    "not_found",
+			Message: "Person not found",
+		}}, nil
+	}
+
+	result, err := ss.server.ahnentafelService.GetEndOfLines(ctx, query.GetAhnentafelInput{
+		PersonID:       request.Id,
+		MaxGenerations: treeGenerations(ss.server.config, request.Params.Generations, 5),
+	})
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return GetEndOfLines404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: "Person not found",
+			}}, nil
+		}
+		return nil, err
+	}
+
+	entries := make([]AhnentafelEntry, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i] = convertQueryAhnentafelEntryToGenerated(entry)
+	}
+
+	return GetEndOfLines200JSONResponse{
+		Subject: AhnentafelSubject{
+			Id:        person.ID,
+			GivenName: person.GivenName,
+			Surname:   person.Surname,
+		},
+		Entries: entries,
+		Total:   result.TotalEntries,
+	}, nil
+}
+
 // formatEventLineStr formats a date and place for text output.
 func formatEventLineStr(date string, place *string) string {
 	dateStr := "-"
@@ -159,6 +259,22 @@ func formatEventLineStr(date string, place *string) string {
 	return dateStr
 }
 
+// formatSourceCount formats a fact's citation count for text output.
+// Unknown facts with no citations are left unannotated.
+func formatSourceCount(line string, n int) string {
+	if line == "-" && n == 0 {
+		return ""
+	}
+	switch n {
+	case 0:
+		return " [unsourced]"
+	case 1:
+		return " [1 source]"
+	default:
+		return fmt.Sprintf(" [%d sources]", n)
+	}
+}
+
 // ============================================================================
 // Browse endpoints
 // ============================================================================
@@ -249,7 +365,8 @@ func (ss *StrictServer) BrowseSurnames(ctx context.Context, request BrowseSurnam
 	}
 
 	result, err := ss.server.browseService.GetSurnameIndex(ctx, query.GetSurnameIndexInput{
-		Letter: letter,
+		Letter:              letter,
+		IgnoreSurnamePrefix: ss.server.config.IgnoreSurnamePrefix,
 	})
 	if err != nil {
 		return nil, err
@@ -384,6 +501,217 @@ func (ss *StrictServer) GetPersonsByCemetery(ctx context.Context, request GetPer
 	}, nil
 }
 
+// BrowseSourceRepositories implements StrictServerInterface.
+func (ss *StrictServer) BrowseSourceRepositories(ctx context.Context, request BrowseSourceRepositoriesRequestObject) (BrowseSourceRepositoriesResponseObject, error) {
+	result, err := ss.server.browseService.GetSourceRepositoryIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := SourceRepositoryIndexResponse{
+		Items: make([]SourceRepositoryEntry, len(result.Items)),
+		Total: result.Total,
+	}
+
+	for i, item := range result.Items {
+		response.Items[i] = SourceRepositoryEntry{
+			Name:  item.Name,
+			Count: item.Count,
+		}
+	}
+
+	return BrowseSourceRepositories200JSONResponse(response), nil
+}
+
+// ListFactTypes implements StrictServerInterface.
+func (ss *StrictServer) ListFactTypes(ctx context.Context, request ListFactTypesRequestObject) (ListFactTypesResponseObject, error) {
+	result, err := ss.server.browseService.GetFactTypeIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := FactTypeIndexResponse{
+		Items: make([]FactTypeEntry, len(result.Items)),
+		Total: result.Total,
+	}
+
+	for i, item := range result.Items {
+		response.Items[i] = FactTypeEntry{
+			FactType:       item.FactType,
+			Known:          item.Known,
+			Count:          item.Count,
+			EventCount:     item.EventCount,
+			AttributeCount: item.AttributeCount,
+			CitationCount:  item.CitationCount,
+		}
+	}
+
+	return ListFactTypes200JSONResponse(response), nil
+}
+
+// GetSourcesByRepository implements StrictServerInterface.
+func (ss *StrictServer) GetSourcesByRepository(ctx context.Context, request GetSourcesByRepositoryRequestObject) (GetSourcesByRepositoryResponseObject, error) {
+	name, err := url.PathUnescape(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := 20
+	offset := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		offset = *request.Params.Offset
+	}
+
+	result, err := ss.server.browseService.GetSourcesByRepository(ctx, query.GetSourcesByRepositoryInput{
+		Name:   name,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Source, len(result.Sources))
+	for i, s := range result.Sources {
+		items[i] = convertQuerySourceToGenerated(s)
+	}
+
+	limitVal := result.Limit
+	offsetVal := result.Offset
+	return GetSourcesByRepository200JSONResponse{
+		Sources: items,
+		Total:   result.Total,
+		Limit:   &limitVal,
+		Offset:  &offsetVal,
+	}, nil
+}
+
+// BrowseBirthDecades implements StrictServerInterface.
+func (ss *StrictServer) BrowseBirthDecades(ctx context.Context, request BrowseBirthDecadesRequestObject) (BrowseBirthDecadesResponseObject, error) {
+	result, err := ss.server.browseService.GetBirthDecadeIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := BirthDecadeIndexResponse{
+		Items: make([]BirthDecadeEntry, len(result.Items)),
+		Total: result.Total,
+	}
+
+	for i, item := range result.Items {
+		response.Items[i] = BirthDecadeEntry{
+			Decade: item.Decade,
+			Count:  item.Count,
+		}
+	}
+
+	return BrowseBirthDecades200JSONResponse(response), nil
+}
+
+// GetPersonsByBirthDecade implements StrictServerInterface.
+func (ss *StrictServer) GetPersonsByBirthDecade(ctx context.Context, request GetPersonsByBirthDecadeRequestObject) (GetPersonsByBirthDecadeResponseObject, error) {
+	limit := 20
+	offset := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		offset = *request.Params.Offset
+	}
+
+	result, err := ss.server.browseService.GetPersonsByBirthDecade(ctx, query.GetPersonsByBirthDecadeInput{
+		Decade: request.Decade,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		if errors.Is(err, query.ErrInvalidDecade) {
+			return GetPersonsByBirthDecade400JSONResponse{BadRequestJSONResponse{
+				Code:    "bad_request",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+
+	items := make([]Person, len(result.Items))
+	for i, p := range result.Items {
+		items[i] = convertQueryPersonToGenerated(p)
+	}
+
+	limitVal := result.Limit
+	offsetVal := result.Offset
+	return GetPersonsByBirthDecade200JSONResponse{
+		Items:  items,
+		Total:  result.Total,
+		Limit:  &limitVal,
+		Offset: &offsetVal,
+	}, nil
+}
+
+// GetPlaceVariants implements StrictServerInterface.
+func (ss *StrictServer) GetPlaceVariants(ctx context.Context, request GetPlaceVariantsRequestObject) (GetPlaceVariantsResponseObject, error) {
+	result, err := ss.server.browseService.GetPlaceVariants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := PlaceVariantsResponse{
+		Clusters: make([]PlaceVariantCluster, len(result.Clusters)),
+		Total:    result.Total,
+	}
+
+	for i, cluster := range result.Clusters {
+		variants := make([]PlaceVariant, len(cluster.Variants))
+		for j, v := range cluster.Variants {
+			variants[j] = PlaceVariant{
+				Place: v.Place,
+				Count: v.Count,
+			}
+		}
+		response.Clusters[i] = PlaceVariantCluster{
+			Suggested: cluster.Suggested,
+			Variants:  variants,
+			Count:     cluster.Count,
+		}
+	}
+
+	return GetPlaceVariants200JSONResponse(response), nil
+}
+
+// MergePlaces implements StrictServerInterface.
+func (ss *StrictServer) MergePlaces(ctx context.Context, request MergePlacesRequestObject) (MergePlacesResponseObject, error) {
+	result, err := ss.server.commandHandler.MergePlaces(ctx, command.MergePlacesInput{
+		Canonical: request.Body.Canonical,
+		Variants:  request.Body.Variants,
+	})
+	if err != nil {
+		if errors.Is(err, command.ErrInvalidInput) {
+			return MergePlaces400JSONResponse{BadRequestJSONResponse{
+				Code:    "invalid_input",
+				Message: err.Error(),
+				Fields:  fieldErrors(err),
+			}}, nil
+		}
+		if errors.Is(err, repository.ErrConcurrencyConflict) {
+			return MergePlaces409JSONResponse{ConflictJSONResponse{
+				Code:    "conflict",
+				Message: "A record was modified during the merge; re-run the merge to finish",
+			}}, nil
+		}
+		return nil, err
+	}
+
+	return MergePlaces200JSONResponse{
+		PersonsUpdated:  result.PersonsUpdated,
+		FamiliesUpdated: result.FamiliesUpdated,
+		EventsUpdated:   result.EventsUpdated,
+	}, nil
+}
+
 // GetMapLocations implements StrictServerInterface.
 func (ss *StrictServer) GetMapLocations(ctx context.Context, request GetMapLocationsRequestObject) (GetMapLocationsResponseObject, error) {
 	result, err := ss.server.browseService.GetMapLocations(ctx)
@@ -591,7 +919,10 @@ func (ss *StrictServer) UpdateCitation(ctx context.Context, request UpdateCitati
 		input.Fields = *request.Body.Fields
 	}
 
-	_, err := ss.server.commandHandler.UpdateCitation(ctx, input)
+	err := ss.runUpdate(ctx, request.Params.Retry, request.Id, &input.Version, func(ctx context.Context) error {
+		_, err := ss.server.commandHandler.UpdateCitation(ctx, input)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, repository.ErrConcurrencyConflict) {
 			return UpdateCitation409JSONResponse{ConflictJSONResponse{
@@ -691,19 +1022,7 @@ func (ss *StrictServer) PreviewCitationTemplate(ctx context.Context, request Pre
 		}}, nil
 	}
 
-	issues := citation.ValidateFields(tmpl, fields)
-	var apiIssues *[]CitationValidationIssue
-	if len(issues) > 0 {
-		converted := make([]CitationValidationIssue, len(issues))
-		for i, issue := range issues {
-			converted[i] = CitationValidationIssue{
-				Field:   issue.Field,
-				Message: issue.Message,
-				Level:   CitationValidationIssueLevel(issue.Level),
-			}
-		}
-		apiIssues = &converted
-	}
+	apiIssues := convertCitationValidationIssues(citation.ValidateFields(tmpl, fields))
 
 	return PreviewCitationTemplate200JSONResponse(FormattedCitation{
 		Full:             full,
@@ -750,19 +1069,7 @@ func (ss *StrictServer) FormatCitation(ctx context.Context, request FormatCitati
 	full, _ := citation.FormatFull(tmpl, fields)
 	short, _ := citation.FormatShort(tmpl, fields)
 
-	issues := citation.ValidateFields(tmpl, fields)
-	var apiIssues *[]CitationValidationIssue
-	if len(issues) > 0 {
-		converted := make([]CitationValidationIssue, len(issues))
-		for i, issue := range issues {
-			converted[i] = CitationValidationIssue{
-				Field:   issue.Field,
-				Message: issue.Message,
-				Level:   CitationValidationIssueLevel(issue.Level),
-			}
-		}
-		apiIssues = &converted
-	}
+	apiIssues := convertCitationValidationIssues(citation.ValidateFields(tmpl, fields))
 
 	return FormatCitation200JSONResponse(FormattedCitation{
 		Full:             full,
@@ -771,6 +1078,83 @@ func (ss *StrictServer) FormatCitation(ctx context.Context, request FormatCitati
 	}), nil
 }
 
+// GetFormattedCitation implements StrictServerInterface.
+func (ss *StrictServer) GetFormattedCitation(ctx context.Context, request GetFormattedCitationRequestObject) (GetFormattedCitationResponseObject, error) {
+	style := citation.StyleEvidenceExplained
+	if request.Params.Style != nil {
+		style = citation.Style(*request.Params.Style)
+	}
+	if !style.IsValid() {
+		return GetFormattedCitation400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_style",
+			Message: fmt.Sprintf("Unknown citation style: %s", style),
+		}}, nil
+	}
+
+	cit, err := ss.server.sourceService.GetCitation(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return GetFormattedCitation404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: "Citation not found",
+			}}, nil
+		}
+		return nil, err
+	}
+
+	ref := citation.Reference{
+		Title:      cit.SourceTitle,
+		Page:       stringValue(cit.Page),
+		Volume:     stringValue(cit.Volume),
+		TemplateID: stringValue(cit.TemplateID),
+		Fields:     cit.Fields,
+	}
+	src, err := ss.server.readStore.GetSource(ctx, cit.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	if src != nil {
+		ref.Author = src.Author
+		ref.Title = src.Title
+		ref.Publisher = src.Publisher
+		ref.PublishDate = src.PublishDateRaw
+		ref.URL = src.URL
+		ref.RepositoryName = src.RepositoryName
+		ref.CollectionName = src.CollectionName
+		ref.CallNumber = src.CallNumber
+	}
+
+	formatted, err := citation.Format(style, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetFormattedCitation200JSONResponse(CitationReference{
+		Style:            string(formatted.Style),
+		Footnote:         formatted.Footnote,
+		ShortNote:        formatted.ShortNote,
+		Bibliography:     formatted.Bibliography,
+		ValidationIssues: convertCitationValidationIssues(formatted.Issues),
+	}), nil
+}
+
+// convertCitationValidationIssues converts template validation issues to the
+// generated API type, returning nil when there are none.
+func convertCitationValidationIssues(issues []citation.ValidationIssue) *[]CitationValidationIssue {
+	if len(issues) == 0 {
+		return nil
+	}
+	converted := make([]CitationValidationIssue, len(issues))
+	for i, issue := range issues {
+		converted[i] = CitationValidationIssue{
+			Field:   issue.Field,
+			Message: issue.Message,
+			Level:   CitationValidationIssueLevel(issue.Level),
+		}
+	}
+	return &converted
+}
+
 // convertCitationTemplate converts a citation.Template to the generated API type.
 func convertCitationTemplate(t citation.Template) CitationTemplate {
 	fields := make([]CitationTemplateField, len(t.Fields))
@@ -940,27 +1324,193 @@ func (ss *StrictServer) ExportTree(ctx context.Context, _ ExportTreeRequestObjec
 	}, nil
 }
 
-// GetExportEstimate implements StrictServerInterface.
-func (ss *StrictServer) GetExportEstimate(ctx context.Context, _ GetExportEstimateRequestObject) (GetExportEstimateResponseObject, error) {
-	estimate, err := ss.server.exportService.GetEstimate(ctx)
+// ExportBySurname implements StrictServerInterface. It streams a ZIP with one
+// GEDCOM file per surname line.
+func (ss *StrictServer) ExportBySurname(ctx context.Context, request ExportBySurnameRequestObject) (ExportBySurnameResponseObject, error) {
+	var targetVersion gcgedcom.Version
+	if request.Body != nil && request.Body.Version != nil {
+		targetVersion = gcgedcom.Version(*request.Body.Version)
+		if !targetVersion.IsValid() {
+			return ExportBySurname400JSONResponse{BadRequestJSONResponse{
+				Code:    "invalid_version",
+				Message: "Invalid version: must be one of '5.5', '5.5.1', or '7.0'",
+			}}, nil
+		}
+	}
+
+	subtrees, err := ss.server.exportService.GetSurnameSubtrees(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return GetExportEstimate200JSONResponse{
-		PersonCount:    estimate.PersonCount,
-		FamilyCount:    estimate.FamilyCount,
-		SourceCount:    estimate.SourceCount,
-		CitationCount:  estimate.CitationCount,
-		EventCount:     estimate.EventCount,
-		NoteCount:      estimate.NoteCount,
-		TotalRecords:   estimate.TotalRecords,
-		EstimatedBytes: estimate.EstimatedBytes,
-		IsLargeExport:  estimate.IsLargeExport,
+	// The pipe reader is closed once the response is written, which stops the
+	// writer early if the client goes away
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(ss.writeSurnameArchive(ctx, pw, subtrees, targetVersion))
+	}()
+
+	return ExportBySurname200ApplicationzipResponse{
+		Body: pr,
+		Headers: ExportBySurname200ResponseHeaders{
+			ContentDisposition: strPtr(`attachment; filename="surnames.zip"`),
+		},
 	}, nil
 }
 
-// ExportSources implements StrictServerInterface.
+// writeSurnameArchive writes a ZIP holding a GEDCOM export of each subtree to w,
+// named after its surname.
+func (ss *StrictServer) writeSurnameArchive(ctx context.Context, w io.Writer, subtrees []query.SurnameSubtree, version gcgedcom.Version) error {
+	zw := zip.NewWriter(w)
+	exporter := gedcom.NewExporter(ss.server.readStore)
+	used := make(map[string]bool, len(subtrees))
+	for _, subtree := range subtrees {
+		entry, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     uniqueArchiveName(archiveEntryName(subtree.Surname, "surname")+".ged", used),
+			Method:   zip.Deflate,
+			Modified: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := exporter.ExportWithOptions(ctx, entry, gedcom.ExportOptions{
+			TargetVersion: version,
+			PersonIDs:     subtree.PersonIDs,
+			FamilyIDs:     subtree.FamilyIDs,
+		}); err != nil {
+			return fmt.Errorf("exporting surname %s: %w", subtree.Surname, err)
+		}
+	}
+	return zw.Close()
+}
+
+// ExportSelection implements StrictServerInterface. It exports exactly the
+// requested persons plus the families connecting them, as GEDCOM or JSON.
+func (ss *StrictServer) ExportSelection(ctx context.Context, request ExportSelectionRequestObject) (ExportSelectionResponseObject, error) {
+	if len(request.Body.PersonIds) == 0 {
+		return ExportSelection400JSONResponse{BadRequestJSONResponse{
+			Code:    "bad_request",
+			Message: "At least one person ID is required",
+		}}, nil
+	}
+
+	if len(request.Body.PersonIds) > 1000 {
+		return ExportSelection400JSONResponse{BadRequestJSONResponse{
+			Code:    "bad_request",
+			Message: "Maximum 1000 persons per request",
+		}}, nil
+	}
+
+	format := ExportSelectionRequestFormatGedcom
+	if request.Body.Format != nil {
+		format = *request.Body.Format
+		if !format.Valid() {
+			return ExportSelection400JSONResponse{BadRequestJSONResponse{
+				Code:    "invalid_format",
+				Message: "Invalid format: must be 'gedcom' or 'json'",
+			}}, nil
+		}
+	}
+
+	var targetVersion gcgedcom.Version
+	if request.Body.Version != nil {
+		targetVersion = gcgedcom.Version(*request.Body.Version)
+		if !targetVersion.IsValid() {
+			return ExportSelection400JSONResponse{BadRequestJSONResponse{
+				Code:    "invalid_version",
+				Message: "Invalid version: must be one of '5.5', '5.5.1', or '7.0'",
+			}}, nil
+		}
+	}
+
+	selection, err := ss.server.exportService.GetSelection(ctx, request.Body.PersonIds)
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return ExportSelection404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+
+	if format == ExportSelectionRequestFormatJson {
+		persons := make([]Person, 0, len(selection.PersonIDs))
+		seen := make(map[uuid.UUID]bool, len(selection.PersonIDs))
+		for _, id := range request.Body.PersonIds {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			rm, err := ss.server.readStore.GetPerson(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			persons = append(persons, convertReadModelPersonToGenerated(*rm))
+		}
+
+		families := make([]Family, 0, len(selection.FamilyIDs))
+		for id := range selection.FamilyIDs {
+			rm, err := ss.server.readStore.GetFamily(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			families = append(families, convertReadModelFamilyToGenerated(*rm))
+		}
+		sort.Slice(families, func(i, j int) bool {
+			return families[i].Id.String() < families[j].Id.String()
+		})
+
+		resp := ExportSelection200JSONResponse{
+			Headers: ExportSelection200ResponseHeaders{
+				ContentDisposition: strPtr("attachment; filename=selection.json"),
+			},
+		}
+		resp.Body.Persons = &persons
+		resp.Body.Families = &families
+		return resp, nil
+	}
+
+	var sb strings.Builder
+	_, err = gedcom.NewExporter(ss.server.readStore).ExportWithOptions(ctx, &sb, gedcom.ExportOptions{
+		TargetVersion: targetVersion,
+		PersonIDs:     selection.PersonIDs,
+		FamilyIDs:     selection.FamilyIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ExportSelection200ApplicationxGedcomResponse{
+		Body:          strings.NewReader(sb.String()),
+		ContentLength: int64(sb.Len()),
+		Headers: ExportSelection200ResponseHeaders{
+			ContentDisposition: strPtr("attachment; filename=selection.ged"),
+		},
+	}, nil
+}
+
+// GetExportEstimate implements StrictServerInterface.
+func (ss *StrictServer) GetExportEstimate(ctx context.Context, _ GetExportEstimateRequestObject) (GetExportEstimateResponseObject, error) {
+	estimate, err := ss.server.exportService.GetEstimate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetExportEstimate200JSONResponse{
+		PersonCount:    estimate.PersonCount,
+		FamilyCount:    estimate.FamilyCount,
+		SourceCount:    estimate.SourceCount,
+		CitationCount:  estimate.CitationCount,
+		EventCount:     estimate.EventCount,
+		NoteCount:      estimate.NoteCount,
+		TotalRecords:   estimate.TotalRecords,
+		EstimatedBytes: estimate.EstimatedBytes,
+		IsLargeExport:  estimate.IsLargeExport,
+	}, nil
+}
+
+// ExportSources implements StrictServerInterface.
 func (ss *StrictServer) ExportSources(ctx context.Context, _ ExportSourcesRequestObject) (ExportSourcesResponseObject, error) {
 	readModels, err := repository.ListAll(ctx, 1000, ss.server.readStore.ListSources)
 	if err != nil {
@@ -1085,6 +1635,10 @@ func convertAttributeToExport(a repository.AttributeReadModel) AttributeExport {
 	if a.Place != "" {
 		attr.Place = &a.Place
 	}
+	if a.ResearchStatus != "" {
+		rs := ResearchStatus(a.ResearchStatus)
+		attr.ResearchStatus = &rs
+	}
 	attr.Version = &a.Version
 	attr.CreatedAt = &a.CreatedAt
 
@@ -1150,6 +1704,9 @@ func (ss *StrictServer) CreateFamily(ctx context.Context, request CreateFamilyRe
 	if request.Body.MarriagePlace != nil {
 		input.MarriagePlace = *request.Body.MarriagePlace
 	}
+	if request.Body.ResearchStatus != nil {
+		input.ResearchStatus = string(*request.Body.ResearchStatus)
+	}
 
 	result, err := ss.server.commandHandler.CreateFamily(ctx, input)
 	if err != nil {
@@ -1164,6 +1721,38 @@ func (ss *StrictServer) CreateFamily(ctx context.Context, request CreateFamilyRe
 	return CreateFamily201JSONResponse(convertQueryFamilyToGenerated(family.Family)), nil
 }
 
+// SearchFamilies implements StrictServerInterface.
+func (ss *StrictServer) SearchFamilies(ctx context.Context, request SearchFamiliesRequestObject) (SearchFamiliesResponseObject, error) {
+	if minLen := searchMinQueryLength(ss.server.config); utf8.RuneCountInString(strings.TrimSpace(request.Params.Q)) < minLen {
+		return SearchFamilies400JSONResponse{BadRequestJSONResponse{
+			Code:    "bad_request",
+			Message: fmt.Sprintf("Search query must be at least %d characters", minLen),
+		}}, nil
+	}
+
+	limit := searchLimit(ss.server.config, request.Params.Limit)
+	families, err := ss.server.familyService.SearchFamilies(ctx, request.Params.Q, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]FamilySummary, len(families))
+	for i, f := range families {
+		items[i] = FamilySummary{
+			Id:               f.ID,
+			Partner1Name:     f.Partner1Name,
+			Partner2Name:     f.Partner2Name,
+			RelationshipType: f.RelationshipType,
+		}
+	}
+
+	return SearchFamilies200JSONResponse{
+		Families: items,
+		Total:    len(items),
+		Query:    request.Params.Q,
+	}, nil
+}
+
 // GetFamily implements StrictServerInterface.
 func (ss *StrictServer) GetFamily(ctx context.Context, request GetFamilyRequestObject) (GetFamilyResponseObject, error) {
 	family, err := ss.server.familyService.GetFamily(ctx, request.Id)
@@ -1177,14 +1766,30 @@ func (ss *StrictServer) GetFamily(ctx context.Context, request GetFamilyRequestO
 		return nil, err
 	}
 
-	return GetFamily200JSONResponse(convertQueryFamilyDetailToGenerated(*family)), nil
+	etag := versionETag(family.Version)
+	if etagMatches(request.Params.IfNoneMatch, etag) {
+		return GetFamily304Response{Headers: NotModifiedResponseHeaders{ETag: &etag}}, nil
+	}
+
+	return GetFamily200JSONResponse{
+		Body:    convertQueryFamilyDetailToGenerated(*family),
+		Headers: GetFamily200ResponseHeaders{ETag: &etag},
+	}, nil
 }
 
 // UpdateFamily implements StrictServerInterface.
 func (ss *StrictServer) UpdateFamily(ctx context.Context, request UpdateFamilyRequestObject) (UpdateFamilyResponseObject, error) {
+	version, msg := updateVersion(request.Params.IfMatch, request.Body.Version)
+	if msg != "" {
+		return UpdateFamily400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_version",
+			Message: msg,
+		}}, nil
+	}
+
 	input := command.UpdateFamilyInput{
 		ID:      request.Id,
-		Version: request.Body.Version,
+		Version: version,
 	}
 
 	if request.Body.MarriageDate != nil {
@@ -1197,9 +1802,22 @@ func (ss *StrictServer) UpdateFamily(ctx context.Context, request UpdateFamilyRe
 		relType := string(*request.Body.RelationshipType)
 		input.RelationshipType = &relType
 	}
+	if request.Body.ResearchStatus != nil {
+		rs := string(*request.Body.ResearchStatus)
+		input.ResearchStatus = &rs
+	}
 
-	_, err := ss.server.commandHandler.UpdateFamily(ctx, input)
+	err := ss.runUpdate(ctx, updateRetry(request.Params.Retry, request.Params.IfMatch), request.Id, &input.Version, func(ctx context.Context) error {
+		_, err := ss.server.commandHandler.UpdateFamily(ctx, input)
+		return err
+	})
 	if err != nil {
+		if errors.Is(err, repository.ErrConcurrencyConflict) && request.Params.IfMatch != nil {
+			return UpdateFamily412JSONResponse{PreconditionFailedJSONResponse{
+				Code:    "precondition_failed",
+				Message: "Family has changed since the If-Match version",
+			}}, nil
+		}
 		if errors.Is(err, repository.ErrConcurrencyConflict) {
 			return UpdateFamily400JSONResponse{BadRequestJSONResponse{
 				Code:    "conflict",
@@ -1443,10 +2061,23 @@ func (ss *StrictServer) ExportGedcom(ctx context.Context, request ExportGedcomRe
 		}
 	}
 
+	opts := gedcom.ExportOptions{TargetVersion: targetVersion}
+	if request.Params.Include != nil {
+		include, ok := parseExportInclude(*request.Params.Include)
+		if !ok {
+			return ExportGedcom400JSONResponse{BadRequestJSONResponse{
+				Code:    "invalid_include",
+				Message: "Invalid include: must be a comma-separated list of 'sources' and 'notes'",
+			}}, nil
+		}
+		opts.ExcludeSources = !include["sources"]
+		opts.ExcludeNotes = !include["notes"]
+	}
+
 	gedcomExporter := gedcom.NewExporter(ss.server.readStore)
 
 	var sb strings.Builder
-	_, err := gedcomExporter.ExportWithOptions(ctx, &sb, gedcom.ExportOptions{TargetVersion: targetVersion})
+	_, err := gedcomExporter.ExportWithOptions(ctx, &sb, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -1460,6 +2091,76 @@ func (ss *StrictServer) ExportGedcom(ctx context.Context, request ExportGedcomRe
 	}, nil
 }
 
+// parseExportInclude parses the comma-separated record types of a GEDCOM
+// export's include parameter. It reports false for an unknown type.
+func parseExportInclude(value string) (map[string]bool, bool) {
+	include := make(map[string]bool)
+	for _, t := range strings.Split(value, ",") {
+		t = strings.ToLower(strings.TrimSpace(t))
+		switch t {
+		case "":
+		case "sources", "notes":
+			include[t] = true
+		default:
+			return nil, false
+		}
+	}
+	return include, true
+}
+
+// ExportPersonGedcom implements StrictServerInterface. It exports only the
+// descendant or ancestor subtree rooted at a person, so one branch can be
+// shared without handing over the whole database.
+func (ss *StrictServer) ExportPersonGedcom(ctx context.Context, request ExportPersonGedcomRequestObject) (ExportPersonGedcomResponseObject, error) {
+	mode := query.SubtreeMode(request.Params.Mode)
+	if !mode.IsValid() {
+		return ExportPersonGedcom400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_mode",
+			Message: "Invalid mode: must be 'descendants' or 'ancestors'",
+		}}, nil
+	}
+
+	var targetVersion gcgedcom.Version
+	if request.Params.Version != nil {
+		targetVersion = gcgedcom.Version(*request.Params.Version)
+		if !targetVersion.IsValid() {
+			return ExportPersonGedcom400JSONResponse{BadRequestJSONResponse{
+				Code:    "invalid_version",
+				Message: "Invalid version: must be one of '5.5', '5.5.1', or '7.0'",
+			}}, nil
+		}
+	}
+
+	subtree, err := ss.server.exportService.GetSubtree(ctx, request.Id, mode)
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return ExportPersonGedcom404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: "Person not found",
+			}}, nil
+		}
+		return nil, err
+	}
+
+	var sb strings.Builder
+	_, err = gedcom.NewExporter(ss.server.readStore).ExportWithOptions(ctx, &sb, gedcom.ExportOptions{
+		TargetVersion: targetVersion,
+		PersonIDs:     subtree.PersonIDs,
+		FamilyIDs:     subtree.FamilyIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ExportPersonGedcom200ApplicationxGedcomResponse{
+		Body:          strings.NewReader(sb.String()),
+		ContentLength: int64(sb.Len()),
+		Headers: ExportPersonGedcom200ResponseHeaders{
+			ContentDisposition: strPtr(fmt.Sprintf("attachment; filename=%s.ged", mode)),
+		},
+	}, nil
+}
+
 // PreviewGedcomExport implements StrictServerInterface. It reports whether
 // exporting at the requested version would lose data, without producing a file,
 // so the UI can warn before a downgraded download (issue #189).
@@ -1509,21 +2210,68 @@ func (ss *StrictServer) ImportGedcom(ctx context.Context, request ImportGedcomRe
 		}, nil
 	}
 
-	// Read the first part from multipart reader
-	part, err := request.Body.NextPart()
-	if err != nil {
-		return ImportGedcom400JSONResponse{
-			Code:    "bad_request",
-			Message: "Failed to read uploaded file",
-		}, nil
+	// The GEDCOM file and an optional media archive may arrive in either
+	// order, so both are buffered before importing. Any part other than
+	// "media" is taken as the GEDCOM file. Together they may not exceed the
+	// import limit.
+	limit := maxImportSize(ss.server.config)
+	remaining := limit
+	var gedcomData, archiveData []byte
+	var filename string
+	for {
+		part, err := request.Body.NextPart()
+		if errors.Is(err, io.EOF) && gedcomData != nil {
+			break
+		}
+		if err != nil {
+			return ImportGedcom400JSONResponse{
+				Code:    "bad_request",
+				Message: "Failed to read uploaded file",
+			}, nil
+		}
+		// Read at most one byte past the limit so oversized uploads are
+		// rejected without buffering them whole
+		data, err := io.ReadAll(io.LimitReader(part, remaining+1))
+		_ = part.Close()
+		if err != nil {
+			return ImportGedcom400JSONResponse{
+				Code:    "bad_request",
+				Message: "Failed to read uploaded file",
+			}, nil
+		}
+		if int64(len(data)) > remaining {
+			return ImportGedcom413JSONResponse{
+				Code:    "file_too_large",
+				Message: fmt.Sprintf("Import too large (max %s)", domain.FormatMediaFileSize(limit)),
+			}, nil
+		}
+		remaining -= int64(len(data))
+		switch {
+		case part.FormName() == "media":
+			archiveData = data
+		case gedcomData == nil:
+			gedcomData = data
+			filename = part.FileName()
+		}
 	}
-	defer part.Close()
 
-	result, err := ss.server.commandHandler.ImportGedcom(ctx, command.ImportGedcomInput{
-		Filename: part.FileName(),
-		FileSize: 0, // Size not available from multipart part
-		Reader:   part,
-	})
+	input := command.ImportGedcomInput{
+		Filename: filename,
+		FileSize: int64(len(gedcomData)),
+		Reader:   bytes.NewReader(gedcomData),
+	}
+	if archiveData != nil {
+		mediaFiles, err := openMediaArchive(archiveData)
+		if err != nil {
+			return ImportGedcom400JSONResponse{
+				Code:    "bad_request",
+				Message: "Media archive is not a valid ZIP file",
+			}, nil
+		}
+		input.MediaFiles = mediaFiles
+	}
+
+	result, err := ss.server.commandHandler.ImportGedcom(ctx, input)
 	if err != nil {
 		return ImportGedcom400JSONResponse{
 			Code:    "bad_request",
@@ -1549,23 +2297,68 @@ func (ss *StrictServer) ImportGedcom(ctx context.Context, request ImportGedcomRe
 
 	response := ImportGedcom200JSONResponse{
 		FamiliesImported: result.FamiliesImported,
+		FileSize:         &input.FileSize,
 		PersonsImported:  result.PersonsImported,
 		Success:          true,
 		Warnings:         &warnings,
 	}
+	if input.MediaFiles != nil {
+		response.MediaImported = &result.MediaImported
+	}
 	if len(importErrors) > 0 {
 		response.Errors = &importErrors
 	}
+	if groups := convertImportIssueGroups(result.Issues); len(groups) > 0 {
+		response.IssueGroups = &groups
+	}
 
 	return response, nil
 }
 
+// convertImportIssueGroups groups import issues by code for the response.
+func convertImportIssueGroups(issues []gedcom.ImportIssue) []ImportIssueGroup {
+	groups := gedcom.GroupImportIssues(issues)
+	converted := make([]ImportIssueGroup, len(groups))
+	for i, g := range groups {
+		items := make([]ImportIssue, len(g.Issues))
+		for j, issue := range g.Issues {
+			items[j] = ImportIssue{
+				Severity: ImportIssueSeverity(issue.Severity),
+				Code:     issue.Code,
+				Line:     issue.Line,
+				Context:  strPtr(issue.Context),
+				Message:  issue.Message,
+			}
+		}
+		converted[i] = ImportIssueGroup{
+			Code:     g.Code,
+			Severity: ImportIssueGroupSeverity(g.Severity),
+			Count:    len(items),
+			Issues:   items,
+		}
+	}
+	return converted
+}
+
+// openMediaArchive opens an uploaded ZIP archive of media files accompanying
+// a GEDCOM import.
+func openMediaArchive(data []byte) (fs.FS, error) {
+	return zip.NewReader(bytes.NewReader(data), int64(len(data)))
+}
+
 // ============================================================================
 // History endpoints
 // ============================================================================
 
 // ListHistory implements StrictServerInterface.
 func (ss *StrictServer) ListHistory(ctx context.Context, request ListHistoryRequestObject) (ListHistoryResponseObject, error) {
+	if !validEnumParam(request.Params.Action) {
+		return ListHistory400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_parameter",
+			Message: "Invalid action parameter",
+		}}, nil
+	}
+
 	fromTime := time.Time{}
 	toTime := time.Now().Add(24 * time.Hour)
 
@@ -1580,6 +2373,10 @@ func (ss *StrictServer) ListHistory(ctx context.Context, request ListHistoryRequ
 	if request.Params.EntityType != nil {
 		eventTypes = mapEntityTypeToEventTypes(string(*request.Params.EntityType))
 	}
+	action := ""
+	if request.Params.Action != nil {
+		action = string(*request.Params.Action)
+	}
 
 	limit := 20
 	offset := 0
@@ -1594,6 +2391,7 @@ func (ss *StrictServer) ListHistory(ctx context.Context, request ListHistoryRequ
 		FromTime:   fromTime,
 		ToTime:     toTime,
 		EventTypes: eventTypes,
+		Action:     action,
 		Limit:      limit,
 		Offset:     offset,
 	})
@@ -1604,6 +2402,25 @@ func (ss *StrictServer) ListHistory(ctx context.Context, request ListHistoryRequ
 	return ListHistory200JSONResponse(convertHistoryResult(result)), nil
 }
 
+// ListRecentChanges implements StrictServerInterface.
+func (ss *StrictServer) ListRecentChanges(ctx context.Context, request ListRecentChangesRequestObject) (ListRecentChangesResponseObject, error) {
+	limit := 20
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	entries, err := ss.server.historyService.GetRecentChanges(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ChangeEntry, len(entries))
+	for i, entry := range entries {
+		items[i] = convertQueryChangeEntryToGenerated(entry)
+	}
+	return ListRecentChanges200JSONResponse{Items: items}, nil
+}
+
 // ============================================================================
 // Media endpoints
 // ============================================================================
@@ -1619,6 +2436,7 @@ func (ss *StrictServer) DeleteMedia(ctx context.Context, request DeleteMediaRequ
 		}
 		return nil, err
 	}
+	ss.server.thumbnails.Remove(request.Id)
 
 	return DeleteMedia204Response{}, nil
 }
@@ -1639,6 +2457,31 @@ func (ss *StrictServer) GetMedia(ctx context.Context, request GetMediaRequestObj
 	return GetMedia200JSONResponse(convertMediaReadModelToGenerated(*media)), nil
 }
 
+// ListDuplicateMedia implements StrictServerInterface.
+func (ss *StrictServer) ListDuplicateMedia(ctx context.Context, request ListDuplicateMediaRequestObject) (ListDuplicateMediaResponseObject, error) {
+	groups, err := ss.server.readStore.ListDuplicateMedia(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := ListDuplicateMedia200JSONResponse{
+		Groups: make([]MediaDuplicateGroup, len(groups)),
+		Total:  len(groups),
+	}
+	for i, g := range groups {
+		items := make([]Media, len(g.Media))
+		for j, m := range g.Media {
+			items[j] = convertMediaReadModelToGenerated(m)
+		}
+		resp.Groups[i] = MediaDuplicateGroup{
+			ContentHash: g.ContentHash,
+			FileSize:    g.FileSize,
+			Media:       items,
+		}
+	}
+	return resp, nil
+}
+
 // UpdateMedia implements StrictServerInterface.
 func (ss *StrictServer) UpdateMedia(ctx context.Context, request UpdateMediaRequestObject) (UpdateMediaResponseObject, error) {
 	var mediaType *string
@@ -1647,7 +2490,7 @@ func (ss *StrictServer) UpdateMedia(ctx context.Context, request UpdateMediaRequ
 		mediaType = &mt
 	}
 
-	result, err := ss.server.commandHandler.UpdateMedia(ctx, command.UpdateMediaInput{
+	input := command.UpdateMediaInput{
 		ID:          request.Id,
 		Title:       request.Body.Title,
 		Description: request.Body.Description,
@@ -1657,6 +2500,13 @@ func (ss *StrictServer) UpdateMedia(ctx context.Context, request UpdateMediaRequ
 		CropWidth:   request.Body.CropWidth,
 		CropHeight:  request.Body.CropHeight,
 		Version:     request.Body.Version,
+	}
+
+	var result *command.UpdateMediaResult
+	err := ss.runUpdate(ctx, request.Params.Retry, request.Id, &input.Version, func(ctx context.Context) error {
+		var err error
+		result, err = ss.server.commandHandler.UpdateMedia(ctx, input)
+		return err
 	})
 	if err != nil {
 		if errors.Is(err, repository.ErrConcurrencyConflict) {
@@ -1691,7 +2541,7 @@ func (ss *StrictServer) UpdateMedia(ctx context.Context, request UpdateMediaRequ
 
 // DownloadMedia implements StrictServerInterface.
 func (ss *StrictServer) DownloadMedia(ctx context.Context, request DownloadMediaRequestObject) (DownloadMediaResponseObject, error) {
-	media, err := ss.server.readStore.GetMediaWithData(ctx, request.Id)
+	media, err := ss.mediaWithContent(ctx, request.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -1715,6 +2565,14 @@ func (ss *StrictServer) DownloadMedia(ctx context.Context, request DownloadMedia
 		return DownloadMedia200ImagegifResponse{Body: reader, ContentLength: contentLength}, nil
 	case "application/pdf":
 		return DownloadMedia200ApplicationpdfResponse{Body: reader, ContentLength: contentLength}, nil
+	case "audio/mpeg":
+		return DownloadMedia200AudiompegResponse{Body: reader, ContentLength: contentLength}, nil
+	case "audio/wave":
+		return DownloadMedia200AudiowaveResponse{Body: reader, ContentLength: contentLength}, nil
+	case "video/mp4":
+		return DownloadMedia200Videomp4Response{Body: reader, ContentLength: contentLength}, nil
+	case "video/webm":
+		return DownloadMedia200VideowebmResponse{Body: reader, ContentLength: contentLength}, nil
 	default:
 		return DownloadMedia200ApplicationoctetStreamResponse{Body: reader, ContentLength: contentLength}, nil
 	}
@@ -1722,7 +2580,21 @@ func (ss *StrictServer) DownloadMedia(ctx context.Context, request DownloadMedia
 
 // GetMediaThumbnail implements StrictServerInterface.
 func (ss *StrictServer) GetMediaThumbnail(ctx context.Context, request GetMediaThumbnailRequestObject) (GetMediaThumbnailResponseObject, error) {
-	thumbnail, err := ss.server.readStore.GetMediaThumbnail(ctx, request.Id)
+	var (
+		thumbnail []byte
+		err       error
+	)
+	if size := request.Params.Size; size != nil && *size != ss.server.config.ThumbnailSize {
+		if *size < 16 || *size > 1024 {
+			return GetMediaThumbnail400JSONResponse{BadRequestJSONResponse{
+				Code:    "bad_request",
+				Message: "size must be between 16 and 1024",
+			}}, nil
+		}
+		thumbnail, err = ss.sizedThumbnail(ctx, request.Id, *size)
+	} else {
+		thumbnail, err = ss.server.readStore.GetMediaThumbnail(ctx, request.Id)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1738,20 +2610,64 @@ func (ss *StrictServer) GetMediaThumbnail(ctx context.Context, request GetMediaT
 	}, nil
 }
 
+// sizedThumbnail returns a thumbnail at a non-default size, generating it from
+// the original file on first request. Returns nil when the media does not exist
+// or its type has no thumbnail.
+func (ss *StrictServer) sizedThumbnail(ctx context.Context, id uuid.UUID, size int) ([]byte, error) {
+	if cached, ok := ss.server.thumbnails.Get(id, size); ok {
+		return cached, nil
+	}
+	m, err := ss.mediaWithContent(ctx, id)
+	if err != nil || m == nil {
+		return nil, err
+	}
+	thumbnail, err := media.GenerateThumbnailForMimeType(m.FileData, m.MimeType, media.ThumbnailOptionsForSize(size))
+	if err != nil {
+		return nil, fmt.Errorf("generate %dpx thumbnail: %w", size, err)
+	}
+	if len(thumbnail) > 0 {
+		ss.server.thumbnails.Put(id, size, thumbnail)
+	}
+	return thumbnail, nil
+}
+
+// mediaWithContent returns the full media record, loading its file data from
+// the blob store when the content is stored externally.
+func (ss *StrictServer) mediaWithContent(ctx context.Context, id uuid.UUID) (*repository.MediaReadModel, error) {
+	m, err := ss.server.readStore.GetMediaWithData(ctx, id)
+	if err != nil || m == nil || m.StorageKey == "" {
+		return m, err
+	}
+	if ss.server.mediaBlobs == nil {
+		return nil, fmt.Errorf("media %s is in external storage, but none is configured", id)
+	}
+	data, err := ss.server.mediaBlobs.GetBlob(ctx, m.StorageKey)
+	if err != nil {
+		return nil, fmt.Errorf("load content of media %s: %w", id, err)
+	}
+	m.FileData = data
+	return m, nil
+}
+
 // ============================================================================
 // Pedigree endpoint
 // ============================================================================
 
 // GetPedigree implements StrictServerInterface.
 func (ss *StrictServer) GetPedigree(ctx context.Context, request GetPedigreeRequestObject) (GetPedigreeResponseObject, error) {
-	maxGen := 5
-	if request.Params.Generations != nil {
-		maxGen = *request.Params.Generations
+	if request.Params.Format != nil && !request.Params.Format.Valid() {
+		return GetPedigree400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_format",
+			Message: "Invalid format: must be 'json' or 'text'",
+		}}, nil
 	}
 
+	maxGen := treeGenerations(ss.server.config, request.Params.Generations, 5)
+
 	result, err := ss.server.pedigreeService.GetPedigree(ctx, query.GetPedigreeInput{
 		PersonID:       request.Id,
 		MaxGenerations: maxGen,
+		IncludeSources: request.Params.IncludeSources != nil && *request.Params.IncludeSources,
 	})
 	if err != nil {
 		if errors.Is(err, query.ErrNotFound) {
@@ -1763,6 +2679,19 @@ func (ss *StrictServer) GetPedigree(ctx context.Context, request GetPedigreeRequ
 		return nil, err
 	}
 
+	if request.Params.Format != nil && *request.Params.Format == GetPedigreeParamsFormatText {
+		var sb strings.Builder
+		sb.WriteString("PEDIGREE CHART\n")
+		sb.WriteString("==============\n")
+		sb.WriteString(fmt.Sprintf("Subject: %s %s\n\n", result.Root.GivenName, result.Root.Surname))
+		writePedigreeOutline(&sb, result.Root, "")
+		sb.WriteString(fmt.Sprintf("Generated: %s\n", time.Now().Format("2006-01-02")))
+		sb.WriteString(fmt.Sprintf("Total ancestors: %d\n", result.TotalAncestors))
+		sb.WriteString(fmt.Sprintf("Generations: %d\n", result.MaxGeneration))
+
+		return GetPedigree200TextResponse(sb.String()), nil
+	}
+
 	generations := result.MaxGeneration
 	totalAncestors := result.TotalAncestors
 	maxGeneration := result.MaxGeneration
@@ -1774,20 +2703,52 @@ func (ss *StrictServer) GetPedigree(ctx context.Context, request GetPedigreeRequ
 	}, nil
 }
 
-// ============================================================================
+// writePedigreeOutline writes node and its ancestors as an indented outline,
+// each generation two spaces deeper than its child, fathers before mothers.
+func writePedigreeOutline(sb *strings.Builder, node *query.PedigreeNode, label string) {
+	indent := strings.Repeat("  ", node.Generation)
+	if label != "" {
+		label += ": "
+	}
+	sb.WriteString(fmt.Sprintf("%s%s%s %s\n", indent, label, node.GivenName, node.Surname))
+
+	var birthDateStr, deathDateStr string
+	if node.BirthDate != nil {
+		birthDateStr = node.BirthDate.String()
+	}
+	if node.DeathDate != nil {
+		deathDateStr = node.DeathDate.String()
+	}
+	birthLine := formatEventLineStr(birthDateStr, node.BirthPlace)
+	deathLine := formatEventLineStr(deathDateStr, node.DeathPlace)
+	if node.Sources != nil {
+		birthLine += formatSourceCount(birthLine, node.Sources.Birth)
+		deathLine += formatSourceCount(deathLine, node.Sources.Death)
+	}
+	sb.WriteString(fmt.Sprintf("%s   b. %s\n", indent, birthLine))
+	sb.WriteString(fmt.Sprintf("%s   d. %s\n\n", indent, deathLine))
+
+	if node.Father != nil {
+		writePedigreeOutline(sb, node.Father, "Father")
+	}
+	if node.Mother != nil {
+		writePedigreeOutline(sb, node.Mother, "Mother")
+	}
+}
+
+// ============================================================================
 // Descendancy endpoint
 // ============================================================================
 
 // GetDescendancy implements StrictServerInterface.
 func (ss *StrictServer) GetDescendancy(ctx context.Context, request GetDescendancyRequestObject) (GetDescendancyResponseObject, error) {
-	maxGen := 4
-	if request.Params.Generations != nil {
-		maxGen = *request.Params.Generations
-	}
+	maxGen := treeGenerations(ss.server.config, request.Params.Generations, 4)
 
 	result, err := ss.server.descendancyService.GetDescendancy(ctx, query.GetDescendancyInput{
 		PersonID:       request.Id,
 		MaxGenerations: maxGen,
+		BirthYearFrom:  request.Params.BirthYearFrom,
+		LivingOnly:     request.Params.IncludeDeceased != nil && !*request.Params.IncludeDeceased,
 	})
 	if err != nil {
 		if errors.Is(err, query.ErrNotFound) {
@@ -1807,6 +2768,7 @@ func (ss *StrictServer) GetDescendancy(ctx context.Context, request GetDescendan
 		Generations:      &generations,
 		TotalDescendants: &totalDescendants,
 		MaxGeneration:    &maxGeneration,
+		GenerationCounts: &result.GenerationCounts,
 	}, nil
 }
 
@@ -1816,10 +2778,10 @@ func (ss *StrictServer) GetDescendancy(ctx context.Context, request GetDescendan
 
 // ListPersons implements StrictServerInterface.
 func (ss *StrictServer) ListPersons(ctx context.Context, request ListPersonsRequestObject) (ListPersonsResponseObject, error) {
-	if !validEnumParam(request.Params.Sort) || !validEnumParam(request.Params.Order) {
+	if !validEnumParam(request.Params.Sort) || !validEnumParam(request.Params.Order) || !validEnumParam(request.Params.Gender) {
 		return ListPersons400JSONResponse{BadRequestJSONResponse{
 			Code:    "invalid_parameter",
-			Message: "Invalid sort or order parameter",
+			Message: "Invalid sort, order, or gender parameter",
 		}}, nil
 	}
 	limit := 20
@@ -1845,11 +2807,17 @@ func (ss *StrictServer) ListPersons(ctx context.Context, request ListPersonsRequ
 		Offset: offset,
 		Sort:   sort,
 		Order:  order,
+
+		IgnoreSurnamePrefix: ss.server.config.IgnoreSurnamePrefix,
 	}
 	if request.Params.ResearchStatus != nil {
 		rs := string(*request.Params.ResearchStatus)
 		input.ResearchStatus = &rs
 	}
+	input.Surname = stringFromParam(request.Params.Surname)
+	if request.Params.Gender != nil {
+		input.Gender = string(*request.Params.Gender)
+	}
 
 	result, err := ss.server.personService.ListPersons(ctx, input)
 	if err != nil {
@@ -1873,35 +2841,7 @@ func (ss *StrictServer) ListPersons(ctx context.Context, request ListPersonsRequ
 
 // CreatePerson implements StrictServerInterface.
 func (ss *StrictServer) CreatePerson(ctx context.Context, request CreatePersonRequestObject) (CreatePersonResponseObject, error) {
-	var surname string
-	if request.Body.Surname != nil {
-		surname = *request.Body.Surname
-	}
-	input := command.CreatePersonInput{
-		GivenName: request.Body.GivenName,
-		Surname:   surname,
-	}
-	if request.Body.Gender != nil {
-		input.Gender = string(*request.Body.Gender)
-	}
-	if request.Body.BirthDate != nil {
-		input.BirthDate = *request.Body.BirthDate
-	}
-	if request.Body.BirthPlace != nil {
-		input.BirthPlace = *request.Body.BirthPlace
-	}
-	if request.Body.DeathDate != nil {
-		input.DeathDate = *request.Body.DeathDate
-	}
-	if request.Body.DeathPlace != nil {
-		input.DeathPlace = *request.Body.DeathPlace
-	}
-	if request.Body.Notes != nil {
-		input.Notes = *request.Body.Notes
-	}
-	if request.Body.ResearchStatus != nil {
-		input.ResearchStatus = string(*request.Body.ResearchStatus)
-	}
+	input := convertPersonCreateToInput(*request.Body)
 
 	result, err := ss.server.commandHandler.CreatePerson(ctx, input)
 	if err != nil {
@@ -1910,10 +2850,13 @@ func (ss *StrictServer) CreatePerson(ctx context.Context, request CreatePersonRe
 
 	// Create the primary name
 	_, _ = ss.server.commandHandler.AddName(ctx, command.AddNameInput{
-		PersonID:  result.ID,
-		GivenName: request.Body.GivenName,
-		Surname:   surname,
-		IsPrimary: true,
+		PersonID:      result.ID,
+		GivenName:     input.GivenName,
+		Surname:       input.Surname,
+		NamePrefix:    input.NamePrefix,
+		NameSuffix:    input.NameSuffix,
+		SurnamePrefix: input.SurnamePrefix,
+		IsPrimary:     true,
 	})
 
 	person, err := ss.server.personService.GetPerson(ctx, result.ID)
@@ -1924,6 +2867,81 @@ func (ss *StrictServer) CreatePerson(ctx context.Context, request CreatePersonRe
 	return CreatePerson201JSONResponse(convertQueryPersonToGenerated(person.Person)), nil
 }
 
+// convertPersonCreateToInput maps a person creation request to command input.
+// A full_name is split into name parts; explicit given_name and surname win.
+func convertPersonCreateToInput(body PersonCreate) command.CreatePersonInput {
+	var input command.CreatePersonInput
+	if body.FullName != nil {
+		parsed := domain.ParseFullName(*body.FullName)
+		input.GivenName = parsed.GivenName
+		input.Surname = parsed.Surname
+		input.NamePrefix = parsed.NamePrefix
+		input.NameSuffix = parsed.NameSuffix
+		input.SurnamePrefix = parsed.SurnamePrefix
+	}
+	if body.GivenName != nil {
+		input.GivenName = *body.GivenName
+	}
+	if body.Surname != nil {
+		input.Surname = *body.Surname
+	}
+	if body.Gender != nil {
+		input.Gender = string(*body.Gender)
+	}
+	if body.BirthDate != nil {
+		input.BirthDate = *body.BirthDate
+	}
+	if body.BirthPlace != nil {
+		input.BirthPlace = *body.BirthPlace
+	}
+	if body.DeathDate != nil {
+		input.DeathDate = *body.DeathDate
+	}
+	if body.DeathPlace != nil {
+		input.DeathPlace = *body.DeathPlace
+	}
+	if body.Notes != nil {
+		input.Notes = *body.Notes
+	}
+	if body.ResearchStatus != nil {
+		input.ResearchStatus = string(*body.ResearchStatus)
+	}
+	return input
+}
+
+// ListOrphanedPersons implements StrictServerInterface.
+func (ss *StrictServer) ListOrphanedPersons(ctx context.Context, request ListOrphanedPersonsRequestObject) (ListOrphanedPersonsResponseObject, error) {
+	input := query.ListPersonsInput{
+		Limit:    20,
+		Unlinked: true,
+
+		IgnoreSurnamePrefix: ss.server.config.IgnoreSurnamePrefix,
+	}
+	if request.Params.Limit != nil {
+		input.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		input.Offset = *request.Params.Offset
+	}
+
+	result, err := ss.server.personService.ListPersons(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Person, len(result.Items))
+	for i, p := range result.Items {
+		items[i] = convertQueryPersonToGenerated(p)
+	}
+
+	return ListOrphanedPersons200JSONResponse{
+		Items:  items,
+		Total:  result.Total,
+		Limit:  &result.Limit,
+		Offset: &result.Offset,
+	}, nil
+}
+
 // GetPerson implements StrictServerInterface.
 func (ss *StrictServer) GetPerson(ctx context.Context, request GetPersonRequestObject) (GetPersonResponseObject, error) {
 	person, err := ss.server.personService.GetPerson(ctx, request.Id)
@@ -1937,14 +2955,60 @@ func (ss *StrictServer) GetPerson(ctx context.Context, request GetPersonRequestO
 		return nil, err
 	}
 
-	return GetPerson200JSONResponse(convertQueryPersonDetailToGenerated(person)), nil
+	body := convertQueryPersonDetailToGenerated(person)
+	body.Relationship, err = ss.homeRelationship(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	etag, err := personETag(person.Version, body)
+	if err != nil {
+		return nil, err
+	}
+	if etagMatches(request.Params.IfNoneMatch, etag) {
+		return GetPerson304Response{Headers: NotModifiedResponseHeaders{ETag: &etag}}, nil
+	}
+
+	return GetPerson200JSONResponse{
+		Body:    body,
+		Headers: GetPerson200ResponseHeaders{ETag: &etag},
+	}, nil
+}
+
+// homeRelationship names what a person is to the configured home person. It
+// returns nil when no home person is set, the home person is not in this
+// tree, or the two are not related.
+func (ss *StrictServer) homeRelationship(ctx context.Context, personID uuid.UUID) (*string, error) {
+	homeID := ss.server.config.HomePersonID()
+	if homeID == uuid.Nil {
+		return nil, nil
+	}
+	result, err := ss.server.relationshipService.GetRelationship(ctx, homeID, personID)
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !result.IsRelated {
+		return nil, nil
+	}
+	return &result.Summary, nil
 }
 
 // UpdatePerson implements StrictServerInterface.
 func (ss *StrictServer) UpdatePerson(ctx context.Context, request UpdatePersonRequestObject) (UpdatePersonResponseObject, error) {
+	version, msg := updateVersion(request.Params.IfMatch, request.Body.Version)
+	if msg != "" {
+		return UpdatePerson400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_version",
+			Message: msg,
+		}}, nil
+	}
+
 	input := command.UpdatePersonInput{
 		ID:      request.Id,
-		Version: request.Body.Version,
+		Version: version,
 	}
 
 	if request.Body.GivenName != nil {
@@ -1977,8 +3041,17 @@ func (ss *StrictServer) UpdatePerson(ctx context.Context, request UpdatePersonRe
 		input.ResearchStatus = &rs
 	}
 
-	_, err := ss.server.commandHandler.UpdatePerson(ctx, input)
+	err := ss.runUpdate(ctx, updateRetry(request.Params.Retry, request.Params.IfMatch), request.Id, &input.Version, func(ctx context.Context) error {
+		_, err := ss.server.commandHandler.UpdatePerson(ctx, input)
+		return err
+	})
 	if err != nil {
+		if errors.Is(err, repository.ErrConcurrencyConflict) && request.Params.IfMatch != nil {
+			return UpdatePerson412JSONResponse{PreconditionFailedJSONResponse{
+				Code:    "precondition_failed",
+				Message: "Person has changed since the If-Match version",
+			}}, nil
+		}
 		if errors.Is(err, repository.ErrConcurrencyConflict) {
 			return UpdatePerson409JSONResponse{ConflictJSONResponse{
 				Code:    "conflict",
@@ -2033,9 +3106,85 @@ func (ss *StrictServer) DeletePerson(ctx context.Context, request DeletePersonRe
 	return DeletePerson204Response{}, nil
 }
 
+// BulkDeletePersons implements StrictServerInterface.
+func (ss *StrictServer) BulkDeletePersons(ctx context.Context, request BulkDeletePersonsRequestObject) (BulkDeletePersonsResponseObject, error) {
+	if len(request.Body.Deletions) == 0 {
+		return BulkDeletePersons400JSONResponse{BadRequestJSONResponse{
+			Code:    "bad_request",
+			Message: "At least one deletion is required",
+		}}, nil
+	}
+
+	if len(request.Body.Deletions) > 500 {
+		return BulkDeletePersons400JSONResponse{BadRequestJSONResponse{
+			Code:    "bad_request",
+			Message: "Maximum 500 deletions per request",
+		}}, nil
+	}
+
+	reason := ""
+	if request.Body.Reason != nil {
+		reason = *request.Body.Reason
+	}
+
+	results := make([]BulkDeleteResult, len(request.Body.Deletions))
+	successful := 0
+	failed := 0
+
+	for i, del := range request.Body.Deletions {
+		results[i] = BulkDeleteResult{Id: del.Id}
+
+		err := ss.server.commandHandler.DeletePerson(ctx, command.DeletePersonInput{
+			ID:      del.Id,
+			Version: del.Version,
+			Reason:  reason,
+		})
+		if err == nil {
+			successful++
+			results[i].Success = true
+			results[i].Status = BulkDeleteResultStatusDeleted
+			continue
+		}
+
+		failed++
+		var errMsg string
+		switch {
+		case errors.Is(err, repository.ErrConcurrencyConflict):
+			results[i].Status = BulkDeleteResultStatusConflict
+			errMsg = "Person was modified since it was read"
+		case errors.Is(err, command.ErrPersonNotFound):
+			results[i].Status = BulkDeleteResultStatusNotFound
+			errMsg = "Person not found"
+		case errors.Is(err, command.ErrPersonHasFamilies):
+			results[i].Status = BulkDeleteResultStatusHasFamilies
+			errMsg = "Person is linked to families and cannot be deleted"
+		default:
+			results[i].Status = BulkDeleteResultStatusError
+			errMsg = "An unexpected error occurred"
+			requestLogger(ctx).Error("bulk delete person failed", "person_id", del.Id, "error", err)
+		}
+		results[i].Error = &errMsg
+	}
+
+	return BulkDeletePersons200JSONResponse{
+		Total:      len(request.Body.Deletions),
+		Successful: successful,
+		Failed:     failed,
+		Results:    results,
+	}, nil
+}
+
 // GetCitationsForPerson implements StrictServerInterface.
 func (ss *StrictServer) GetCitationsForPerson(ctx context.Context, request GetCitationsForPersonRequestObject) (GetCitationsForPersonResponseObject, error) {
-	citations, err := ss.server.sourceService.GetCitationsForPerson(ctx, request.Id)
+	if !validEnumParam(request.Params.SourceQuality) || !validEnumParam(request.Params.EvidenceType) {
+		return GetCitationsForPerson400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_parameter",
+			Message: "Invalid source_quality or evidence_type parameter",
+		}}, nil
+	}
+
+	filter := citationFilter(request.Params.SourceQuality, request.Params.EvidenceType)
+	citations, err := ss.server.sourceService.ListCitationsForPerson(ctx, request.Id, filter)
 	if err != nil {
 		if errors.Is(err, query.ErrNotFound) {
 			return GetCitationsForPerson404JSONResponse{NotFoundJSONResponse{
@@ -2057,6 +3206,57 @@ func (ss *StrictServer) GetCitationsForPerson(ctx context.Context, request GetCi
 	}, nil
 }
 
+// citationFilter converts the citation quality query parameters into a
+// query filter.
+func citationFilter[Q, E ~string](quality *Q, evidence *E) query.CitationFilter {
+	var filter query.CitationFilter
+	if quality != nil {
+		filter.SourceQuality = string(*quality)
+	}
+	if evidence != nil {
+		filter.EvidenceType = string(*evidence)
+	}
+	return filter
+}
+
+// GetPersonSourceReport implements StrictServerInterface.
+func (ss *StrictServer) GetPersonSourceReport(ctx context.Context, request GetPersonSourceReportRequestObject) (GetPersonSourceReportResponseObject, error) {
+	report, err := ss.server.sourceService.GetPersonSourceReport(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return GetPersonSourceReport404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: "Person not found",
+			}}, nil
+		}
+		return nil, err
+	}
+
+	facts := make([]FactCoverage, len(report.Facts))
+	for i, f := range report.Facts {
+		facts[i] = FactCoverage{
+			FactType:      f.FactType,
+			EventId:       f.EventID,
+			Date:          f.Date,
+			Place:         f.Place,
+			Value:         f.Value,
+			CitationCount: f.CitationCount,
+		}
+		if f.BestQuality != nil {
+			q := FactCoverageBestQuality(*f.BestQuality)
+			facts[i].BestQuality = &q
+		}
+	}
+
+	return GetPersonSourceReport200JSONResponse{
+		PersonId:     report.PersonID,
+		PersonName:   report.PersonName,
+		Facts:        facts,
+		CitedCount:   report.CitedCount,
+		UncitedCount: report.UncitedCount,
+	}, nil
+}
+
 // GetPersonHistory implements StrictServerInterface.
 func (ss *StrictServer) GetPersonHistory(ctx context.Context, request GetPersonHistoryRequestObject) (GetPersonHistoryResponseObject, error) {
 	_, err := ss.server.personService.GetPerson(ctx, request.Id)
@@ -2128,6 +3328,135 @@ func (ss *StrictServer) ListPersonMedia(ctx context.Context, request ListPersonM
 	}, nil
 }
 
+// DownloadPersonMediaArchive implements StrictServerInterface. The archive is
+// written as the response streams, so only one media file is held in memory
+// at a time.
+func (ss *StrictServer) DownloadPersonMediaArchive(ctx context.Context, request DownloadPersonMediaArchiveRequestObject) (DownloadPersonMediaArchiveResponseObject, error) {
+	person, err := ss.server.readStore.GetPerson(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if person == nil {
+		return DownloadPersonMediaArchive404JSONResponse{NotFoundJSONResponse{
+			Code:    "not_found",
+			Message: "Person not found",
+		}}, nil
+	}
+
+	items, err := repository.ListAll(ctx, 100, func(ctx context.Context, opts repository.ListOptions) ([]repository.MediaReadModel, int, error) {
+		return ss.server.readStore.ListMediaForEntity(ctx, "person", request.Id, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The pipe reader is closed once the response is written, which stops the
+	// writer early if the client goes away
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(ss.writeMediaArchive(ctx, pw, items))
+	}()
+
+	filename := archiveSlug(person.FullName) + "-media.zip"
+	return DownloadPersonMediaArchive200ApplicationzipResponse{
+		Body: pr,
+		Headers: DownloadPersonMediaArchive200ResponseHeaders{
+			ContentDisposition: strPtr(fmt.Sprintf("attachment; filename=%q", filename)),
+		},
+	}, nil
+}
+
+// writeMediaArchive writes a ZIP of the given media's original files to w.
+func (ss *StrictServer) writeMediaArchive(ctx context.Context, w io.Writer, items []repository.MediaReadModel) error {
+	zw := zip.NewWriter(w)
+	used := make(map[string]bool, len(items))
+	for _, item := range items {
+		m, err := ss.mediaWithContent(ctx, item.ID)
+		if err != nil {
+			return err
+		}
+		if m == nil {
+			continue // Deleted since it was listed
+		}
+
+		header := &zip.FileHeader{
+			Name:     uniqueArchiveName(mediaArchiveName(m), used),
+			Method:   zip.Deflate,
+			Modified: m.CreatedAt,
+		}
+		// Photos, audio and video are already compressed
+		if !strings.HasPrefix(m.MimeType, "text/") && m.MimeType != "application/pdf" {
+			header.Method = zip.Store
+		}
+		entry, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if _, err := entry.Write(m.FileData); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// mediaArchiveName names a media file in an archive after its title, keeping
+// the extension of the original upload.
+func mediaArchiveName(m *repository.MediaReadModel) string {
+	name := archiveEntryName(m.Title, "media")
+	ext := path.Ext(m.Filename)
+	if strings.EqualFold(path.Ext(name), ext) {
+		return name // Title already carries the extension, e.g. from the upload filename
+	}
+	return name + ext
+}
+
+// archiveEntryName makes a display name safe to use as an archive entry name,
+// replacing path separators and characters Windows rejects. Returns fallback
+// when nothing usable remains.
+func archiveEntryName(name, fallback string) string {
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	name = strings.Trim(name, " .")
+	if name == "" {
+		return fallback
+	}
+	return name
+}
+
+// uniqueArchiveName returns name, or name with a numeric suffix before the
+// extension if it is already used, and marks the result as used.
+func uniqueArchiveName(name string, used map[string]bool) string {
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	candidate := name
+	for i := 2; used[strings.ToLower(candidate)]; i++ {
+		candidate = fmt.Sprintf("%s (%d)%s", base, i, ext)
+	}
+	used[strings.ToLower(candidate)] = true
+	return candidate
+}
+
+// archiveSlug turns a display name into a lowercase, hyphenated filename part.
+func archiveSlug(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "-"):
+			b.WriteByte('-')
+		}
+	}
+	if slug := strings.TrimSuffix(b.String(), "-"); slug != "" {
+		return slug
+	}
+	return "person"
+}
+
 // UploadPersonMedia implements StrictServerInterface.
 func (ss *StrictServer) UploadPersonMedia(ctx context.Context, request UploadPersonMediaRequestObject) (UploadPersonMediaResponseObject, error) {
 	person, err := ss.server.readStore.GetPerson(ctx, request.Id)
@@ -2158,16 +3487,19 @@ func (ss *StrictServer) UploadPersonMedia(ctx context.Context, request UploadPer
 	}
 	defer part.Close()
 
-	fileData, err := io.ReadAll(part)
+	// Read at most one byte past the limit so oversized uploads are rejected
+	// without buffering them whole
+	limit := maxMediaSize(ss.server.config)
+	fileData, err := io.ReadAll(io.LimitReader(part, limit+1))
 	if err != nil {
 		return nil, err
 	}
 
-	if int64(len(fileData)) > domain.MaxMediaFileSize {
-		return UploadPersonMedia400JSONResponse{BadRequestJSONResponse{
-			Code:    "bad_request",
-			Message: "File too large (max 10MB)",
-		}}, nil
+	if int64(len(fileData)) > limit {
+		return UploadPersonMedia413JSONResponse{
+			Code:    "file_too_large",
+			Message: fmt.Sprintf("File too large (max %s)", domain.FormatMediaFileSize(limit)),
+		}, nil
 	}
 
 	// For now, use the part name and filename as title if available
@@ -2202,46 +3534,41 @@ func (ss *StrictServer) UploadPersonMedia(ctx context.Context, request UploadPer
 
 // GetPersonNames implements StrictServerInterface.
 func (ss *StrictServer) GetPersonNames(ctx context.Context, request GetPersonNamesRequestObject) (GetPersonNamesResponseObject, error) {
-	names, err := ss.server.readStore.GetPersonNames(ctx, request.Id)
+	limit := 20
+	offset := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		offset = *request.Params.Offset
+	}
+
+	result, err := ss.server.personService.ListPersonNames(ctx, request.Id, limit, offset)
 	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return GetPersonNames404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: "Person not found",
+			}}, nil
+		}
 		return nil, err
 	}
 
-	items := make([]PersonName, len(names))
-	for i, n := range names {
-		items[i] = convertPersonNameReadModelToGenerated(n)
+	items := make([]PersonName, len(result.Items))
+	for i, n := range result.Items {
+		items[i] = convertQueryPersonNameToGenerated(n)
 	}
 
 	return GetPersonNames200JSONResponse{
 		Items: items,
-		Total: len(items),
+		Total: result.Total,
 	}, nil
 }
 
 // AddPersonName implements StrictServerInterface.
 func (ss *StrictServer) AddPersonName(ctx context.Context, request AddPersonNameRequestObject) (AddPersonNameResponseObject, error) {
-	input := command.AddNameInput{
-		PersonID:  request.Id,
-		GivenName: request.Body.GivenName,
-		Surname:   request.Body.Surname,
-	}
-	if request.Body.NamePrefix != nil {
-		input.NamePrefix = *request.Body.NamePrefix
-	}
-	if request.Body.NameSuffix != nil {
-		input.NameSuffix = *request.Body.NameSuffix
-	}
-	if request.Body.SurnamePrefix != nil {
-		input.SurnamePrefix = *request.Body.SurnamePrefix
-	}
-	if request.Body.Nickname != nil {
-		input.Nickname = *request.Body.Nickname
-	}
-	// NameType is required (not a pointer) per OpenAPI spec
-	input.NameType = string(request.Body.NameType)
-	if request.Body.IsPrimary != nil {
-		input.IsPrimary = *request.Body.IsPrimary
-	}
+	input := convertPersonNameCreateToInput(*request.Body)
+	input.PersonID = request.Id
 
 	result, err := ss.server.commandHandler.AddName(ctx, input)
 	if err != nil {
@@ -2262,6 +3589,36 @@ func (ss *StrictServer) AddPersonName(ctx context.Context, request AddPersonName
 	return AddPersonName201JSONResponse(convertPersonNameReadModelToGenerated(*name)), nil
 }
 
+// convertPersonNameCreateToInput maps a name creation request to command
+// input. The caller sets PersonID.
+func convertPersonNameCreateToInput(body PersonNameCreate) command.AddNameInput {
+	input := command.AddNameInput{
+		GivenName: body.GivenName,
+		Surname:   body.Surname,
+		// NameType is required (not a pointer) per OpenAPI spec
+		NameType: string(body.NameType),
+	}
+	if body.NamePrefix != nil {
+		input.NamePrefix = *body.NamePrefix
+	}
+	if body.NameSuffix != nil {
+		input.NameSuffix = *body.NameSuffix
+	}
+	if body.SurnamePrefix != nil {
+		input.SurnamePrefix = *body.SurnamePrefix
+	}
+	if body.Nickname != nil {
+		input.Nickname = *body.Nickname
+	}
+	if body.Romanized != nil {
+		input.Romanized = *body.Romanized
+	}
+	if body.IsPrimary != nil {
+		input.IsPrimary = *body.IsPrimary
+	}
+	return input
+}
+
 // UpdatePersonName implements StrictServerInterface.
 func (ss *StrictServer) UpdatePersonName(ctx context.Context, request UpdatePersonNameRequestObject) (UpdatePersonNameResponseObject, error) {
 	var nameType *string
@@ -2279,6 +3636,7 @@ func (ss *StrictServer) UpdatePersonName(ctx context.Context, request UpdatePers
 		NameSuffix:    request.Body.NameSuffix,
 		SurnamePrefix: request.Body.SurnamePrefix,
 		Nickname:      request.Body.Nickname,
+		Romanized:     request.Body.Romanized,
 		NameType:      nameType,
 		IsPrimary:     request.Body.IsPrimary,
 	}
@@ -2321,6 +3679,37 @@ func (ss *StrictServer) DeletePersonName(ctx context.Context, request DeletePers
 	return DeletePersonName204Response{}, nil
 }
 
+// AddPersonExternalId implements StrictServerInterface.
+func (ss *StrictServer) AddPersonExternalId(ctx context.Context, request AddPersonExternalIdRequestObject) (AddPersonExternalIdResponseObject, error) {
+	input := command.AddPersonExternalIDInput{
+		PersonID: request.Id,
+		Value:    request.Body.Value,
+	}
+	if request.Body.Type != nil {
+		input.Type = *request.Body.Type
+	}
+
+	result, err := ss.server.commandHandler.AddPersonExternalID(ctx, input)
+	if err != nil {
+		if errors.Is(err, command.ErrPersonNotFound) {
+			return AddPersonExternalId404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: "Person not found",
+			}}, nil
+		}
+		if errors.Is(err, command.ErrInvalidInput) {
+			return AddPersonExternalId400JSONResponse{BadRequestJSONResponse{
+				Code:    "invalid_input",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, err
+	}
+
+	links := convertExternalIDsToLinks([]domain.ExternalIdentifier{result.ExternalID})
+	return AddPersonExternalId201JSONResponse((*links)[0]), nil
+}
+
 // GetPersonRestorePoints implements StrictServerInterface.
 func (ss *StrictServer) GetPersonRestorePoints(ctx context.Context, request GetPersonRestorePointsRequestObject) (GetPersonRestorePointsResponseObject, error) {
 	_, err := ss.server.personService.GetPerson(ctx, request.Id)
@@ -2390,7 +3779,77 @@ func (ss *StrictServer) RollbackPerson(ctx context.Context, request RollbackPers
 		)
 	}
 
-	return RollbackPerson200JSONResponse(convertRollbackResult(result, "Person rolled back successfully")), nil
+	return RollbackPerson200JSONResponse(convertRollbackResult(result, "Person rolled back successfully")), nil
+}
+
+// ListTrash implements StrictServerInterface.
+func (ss *StrictServer) ListTrash(ctx context.Context, request ListTrashRequestObject) (ListTrashResponseObject, error) {
+	limit := 20
+	offset := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		offset = *request.Params.Offset
+	}
+
+	result, err := ss.server.historyService.ListDeleted(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]TrashItem, len(result.Entries))
+	for i, entry := range result.Entries {
+		items[i] = TrashItem{
+			EntityType: TrashItemEntityType(entry.EntityType),
+			EntityId:   entry.EntityID,
+			EntityName: entry.EntityName,
+			DeletedAt:  entry.DeletedAt,
+			UserId:     entry.UserID,
+		}
+		if entry.Reason != "" {
+			items[i].Reason = strPtr(entry.Reason)
+		}
+	}
+
+	return ListTrash200JSONResponse{
+		Items:  items,
+		Total:  result.TotalCount,
+		Limit:  result.Limit,
+		Offset: result.Offset,
+	}, nil
+}
+
+// RestoreFromTrash implements StrictServerInterface.
+func (ss *StrictServer) RestoreFromTrash(ctx context.Context, request RestoreFromTrashRequestObject) (RestoreFromTrashResponseObject, error) {
+	result, err := ss.server.commandHandler.RestoreDeleted(ctx, request.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, query.ErrNoEvents):
+			return RestoreFromTrash404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: "No deleted entity found with this ID",
+			}}, nil
+		case errors.Is(err, command.ErrRestoreNotDeleted):
+			return RestoreFromTrash409JSONResponse{
+				Code:    "conflict",
+				Message: "Entity is not deleted",
+			}, nil
+		case errors.Is(err, command.ErrRestoreMissingRef):
+			return RestoreFromTrash409JSONResponse{
+				Code:    "conflict",
+				Message: "Entity references a record that has since been deleted; restore that first",
+			}, nil
+		case errors.Is(err, repository.ErrConcurrencyConflict):
+			return RestoreFromTrash409JSONResponse{
+				Code:    "conflict",
+				Message: "Entity was modified concurrently",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RestoreFromTrash200JSONResponse(convertRollbackResult(result, result.EntityType+" restored successfully")), nil
 }
 
 // ============================================================================
@@ -2420,6 +3879,81 @@ func (ss *StrictServer) GetQualityOverview(ctx context.Context, request GetQuali
 	}, nil
 }
 
+// GetMissingDataReport implements StrictServerInterface.
+func (ss *StrictServer) GetMissingDataReport(ctx context.Context, request GetMissingDataReportRequestObject) (GetMissingDataReportResponseObject, error) {
+	limit := 20
+	offset := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		offset = *request.Params.Offset
+	}
+
+	result, err := ss.server.qualityService.GetMissingDataReport(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]MissingDataItem, len(result.Items))
+	for i, item := range result.Items {
+		missing := make([]MissingDataItemMissing, len(item.Missing))
+		for j, m := range item.Missing {
+			missing[j] = MissingDataItemMissing(m)
+		}
+		items[i] = MissingDataItem{
+			PersonId:          item.PersonID,
+			PersonName:        item.PersonName,
+			Missing:           missing,
+			PriorityScore:     item.PriorityScore,
+			CompletenessScore: float32(item.CompletenessScore),
+		}
+	}
+
+	return GetMissingDataReport200JSONResponse{
+		Items:  items,
+		Total:  result.Total,
+		Limit:  result.Limit,
+		Offset: result.Offset,
+	}, nil
+}
+
+// GetQualityBySurname implements StrictServerInterface.
+func (ss *StrictServer) GetQualityBySurname(ctx context.Context, request GetQualityBySurnameRequestObject) (GetQualityBySurnameResponseObject, error) {
+	limit := 20
+	offset := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		offset = *request.Params.Offset
+	}
+
+	result, err := ss.server.qualityService.GetQualityBySurname(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]SurnameQuality, len(result.Items))
+	for i, item := range result.Items {
+		items[i] = SurnameQuality{
+			Surname:             item.Surname,
+			PersonCount:         item.PersonCount,
+			AverageCompleteness: float32(item.AverageCompleteness),
+			RecordsWithIssues:   item.RecordsWithIssues,
+			MissingBirthDates:   item.MissingBirthDates,
+			UnsourcedBirths:     item.UnsourcedBirths,
+		}
+	}
+
+	return GetQualityBySurname200JSONResponse{
+		Items:  items,
+		Total:  result.Total,
+		Limit:  result.Limit,
+		Offset: result.Offset,
+	}, nil
+}
+
 // GetPersonQuality implements StrictServerInterface.
 func (ss *StrictServer) GetPersonQuality(ctx context.Context, request GetPersonQualityRequestObject) (GetPersonQualityResponseObject, error) {
 	result, err := ss.server.qualityService.GetPersonQuality(ctx, request.Id)
@@ -2491,11 +4025,50 @@ func (ss *StrictServer) GetDiscoveryFeed(ctx context.Context, request GetDiscove
 
 // GetQualityReport implements StrictServerInterface.
 func (ss *StrictServer) GetQualityReport(ctx context.Context, request GetQualityReportRequestObject) (GetQualityReportResponseObject, error) {
+	format := GetQualityReportParamsFormatJson
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+	if !format.Valid() {
+		return GetQualityReport400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_format",
+			Message: "Invalid format: must be 'json', 'csv', or 'pdf'",
+		}}, nil
+	}
+
 	result, err := ss.server.validationService.GetQualityReport(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	// Downloadable documents for sharing or printing
+	switch format {
+	case GetQualityReportParamsFormatCsv:
+		var buf bytes.Buffer
+		if err := exporter.WriteQualityReportCSV(&buf, result); err != nil {
+			return nil, err
+		}
+		return GetQualityReport200TextcsvResponse{
+			Body:          &buf,
+			ContentLength: int64(buf.Len()),
+			Headers: GetQualityReport200ResponseHeaders{
+				ContentDisposition: strPtr("attachment; filename=quality-report.csv"),
+			},
+		}, nil
+	case GetQualityReportParamsFormatPdf:
+		var buf bytes.Buffer
+		if err := exporter.WriteQualityReportPDF(&buf, result, time.Now()); err != nil {
+			return nil, err
+		}
+		return GetQualityReport200ApplicationpdfResponse{
+			Body:          &buf,
+			ContentLength: int64(buf.Len()),
+			Headers: GetQualityReport200ResponseHeaders{
+				ContentDisposition: strPtr("attachment; filename=quality-report.pdf"),
+			},
+		}, nil
+	}
+
 	// Map domain type to API type
 	topIssues := make([]QualityReportIssue, len(result.TopIssues))
 	for i, issue := range result.TopIssues {
@@ -2505,7 +4078,7 @@ func (ss *StrictServer) GetQualityReport(ctx context.Context, request GetQuality
 		}
 	}
 
-	return GetQualityReport200JSONResponse{
+	return GetQualityReport200JSONResponse{Body: QualityReport{
 		TotalIndividuals:  result.TotalIndividuals,
 		TotalFamilies:     result.TotalFamilies,
 		TotalSources:      result.TotalSources,
@@ -2516,7 +4089,23 @@ func (ss *StrictServer) GetQualityReport(ctx context.Context, request GetQuality
 		WarningCount:      result.WarningCount,
 		InfoCount:         result.InfoCount,
 		TopIssues:         topIssues,
-	}, nil
+		ResearchStatus: ResearchStatusSummary{
+			Persons:    convertResearchStatusCounts(result.ResearchStatus.Persons),
+			Families:   convertResearchStatusCounts(result.ResearchStatus.Families),
+			Events:     convertResearchStatusCounts(result.ResearchStatus.Events),
+			Attributes: convertResearchStatusCounts(result.ResearchStatus.Attributes),
+		},
+	}}, nil
+}
+
+// convertResearchStatusCounts converts query research status counts to the API type.
+func convertResearchStatusCounts(c query.ResearchStatusCounts) ResearchStatusCounts {
+	return ResearchStatusCounts{
+		Certain:  c.Certain,
+		Probable: c.Probable,
+		Possible: c.Possible,
+		Unknown:  c.Unknown,
+	}
 }
 
 // GetValidationIssues implements StrictServerInterface.
@@ -2880,6 +4469,12 @@ func (ss *StrictServer) SearchPersons(ctx context.Context, request SearchPersons
 			Message: "Invalid sort or order parameter",
 		}}, nil
 	}
+	if !validEnumParam(request.Params.Algorithm) {
+		return SearchPersons400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_parameter",
+			Message: "Invalid algorithm parameter",
+		}}, nil
+	}
 	queryStr := stringFromParam(request.Params.Q)
 	birthPlace := stringFromParam(request.Params.BirthPlace)
 	deathPlace := stringFromParam(request.Params.DeathPlace)
@@ -2900,15 +4495,19 @@ func (ss *StrictServer) SearchPersons(ctx context.Context, request SearchPersons
 		}}, nil
 	}
 
-	if hasQuery && len(queryStr) < 2 {
+	if minLen := searchMinQueryLength(ss.server.config); hasQuery && utf8.RuneCountInString(queryStr) < minLen {
 		return SearchPersons400JSONResponse{BadRequestJSONResponse{
 			Code:    "bad_request",
-			Message: "Search query must be at least 2 characters",
+			Message: fmt.Sprintf("Search query must be at least %d characters", minLen),
 		}}, nil
 	}
 
 	fuzzy := request.Params.Fuzzy != nil && *request.Params.Fuzzy
 	soundex := request.Params.Soundex != nil && *request.Params.Soundex
+	algorithm := ""
+	if request.Params.Algorithm != nil {
+		algorithm = string(*request.Params.Algorithm)
+	}
 
 	sortField := ""
 	if request.Params.Sort != nil {
@@ -2919,13 +4518,11 @@ func (ss *StrictServer) SearchPersons(ctx context.Context, request SearchPersons
 		order = string(*request.Params.Order)
 	}
 
-	limit := 20
-	if request.Params.Limit != nil {
-		limit = *request.Params.Limit
-	}
+	limit := searchLimit(ss.server.config, request.Params.Limit)
 
 	result, err := ss.server.personService.SearchPersons(ctx, query.SearchPersonsInput{
 		Query:         queryStr,
+		Algorithm:     algorithm,
 		Fuzzy:         fuzzy,
 		Soundex:       soundex,
 		BirthDateFrom: birthDateFrom,
@@ -2942,14 +4539,108 @@ func (ss *StrictServer) SearchPersons(ctx context.Context, request SearchPersons
 		return nil, err
 	}
 
-	items := make([]SearchResult, len(result.Items))
-	for i, r := range result.Items {
+	resultQuery := result.Query
+	return SearchPersons200JSONResponse{
+		Items: convertSearchResults(result.Items),
+		Total: result.Total,
+		Query: &resultQuery,
+	}, nil
+}
+
+// AdvancedSearchPersons implements StrictServerInterface.
+func (ss *StrictServer) AdvancedSearchPersons(ctx context.Context, request AdvancedSearchPersonsRequestObject) (AdvancedSearchPersonsResponseObject, error) {
+	if request.Body == nil {
+		return AdvancedSearchPersons400JSONResponse{BadRequestJSONResponse{
+			Code:    "bad_request",
+			Message: "Request body is required",
+		}}, nil
+	}
+	body := request.Body
+	if !validEnumParam(body.Gender) || !validEnumParam(body.Sort) || !validEnumParam(body.Order) {
+		return AdvancedSearchPersons400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_parameter",
+			Message: "Invalid gender, sort, or order",
+		}}, nil
+	}
+
+	minLen := searchMinQueryLength(ss.server.config)
+	for _, name := range []*string{body.GivenName, body.Surname} {
+		if n := utf8.RuneCountInString(strings.TrimSpace(stringFromParam(name))); n > 0 && n < minLen {
+			return AdvancedSearchPersons400JSONResponse{BadRequestJSONResponse{
+				Code:    "bad_request",
+				Message: fmt.Sprintf("Given name and surname must be at least %d characters", minLen),
+			}}, nil
+		}
+	}
+
+	input := query.AdvancedSearchInput{
+		GivenName:     stringFromParam(body.GivenName),
+		Surname:       stringFromParam(body.Surname),
+		BirthYearFrom: body.BirthYearFrom,
+		BirthYearTo:   body.BirthYearTo,
+		DeathYearFrom: body.DeathYearFrom,
+		DeathYearTo:   body.DeathYearTo,
+		BirthPlace:    stringFromParam(body.BirthPlace),
+	}
+	if body.Gender != nil {
+		input.Gender = string(*body.Gender)
+	}
+	if body.Sort != nil {
+		input.Sort = string(*body.Sort)
+	}
+	if body.Order != nil {
+		input.Order = string(*body.Order)
+	}
+	input.Limit = searchLimit(ss.server.config, body.Limit)
+
+	result, err := ss.server.personService.AdvancedSearchPersons(ctx, input)
+	if errors.Is(err, query.ErrNoSearchCriteria) || errors.Is(err, query.ErrInvalidYearRange) {
+		return AdvancedSearchPersons400JSONResponse{BadRequestJSONResponse{
+			Code:    "bad_request",
+			Message: err.Error(),
+		}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return AdvancedSearchPersons200JSONResponse{
+		Items: convertSearchResults(result.Items),
+		Total: result.Total,
+	}, nil
+}
+
+// convertSearchResults converts query search results to API search results.
+func convertSearchResults(results []query.SearchResult) []SearchResult {
+	items := make([]SearchResult, len(results))
+	for i, r := range results {
 		score := float32(r.Score)
 		items[i] = SearchResult{
-			Id:        r.ID,
-			GivenName: r.GivenName,
-			Surname:   r.Surname,
-			Score:     &score,
+			Id:         r.ID,
+			GivenName:  r.GivenName,
+			Surname:    r.Surname,
+			BirthPlace: r.BirthPlace,
+			DeathPlace: r.DeathPlace,
+			Score:      &score,
+		}
+		if r.MatchedBy != "" {
+			matchedBy := SearchResultMatchedBy(r.MatchedBy)
+			items[i].MatchedBy = &matchedBy
+		}
+		if r.Patronymic {
+			items[i].Patronymic = &r.Patronymic
+		}
+		if len(r.Matches) > 0 {
+			matches := make([]SearchMatch, len(r.Matches))
+			for j, m := range r.Matches {
+				matches[j] = SearchMatch{
+					Field: SearchMatchField(m.Field),
+					Value: m.Value,
+					Start: m.Start,
+					End:   m.End,
+				}
+			}
+			items[i].Matches = &matches
 		}
 		if r.BirthDate != nil {
 			items[i].BirthDate = convertDomainGenDateToGenerated(r.BirthDate)
@@ -2958,13 +4649,7 @@ func (ss *StrictServer) SearchPersons(ctx context.Context, request SearchPersons
 			items[i].DeathDate = convertDomainGenDateToGenerated(r.DeathDate)
 		}
 	}
-
-	resultQuery := result.Query
-	return SearchPersons200JSONResponse{
-		Items: items,
-		Total: result.Total,
-		Query: &resultQuery,
-	}, nil
+	return items
 }
 
 // ============================================================================
@@ -3069,18 +4754,14 @@ func (ss *StrictServer) CreateSource(ctx context.Context, request CreateSourceRe
 
 // SearchSources implements StrictServerInterface.
 func (ss *StrictServer) SearchSources(ctx context.Context, request SearchSourcesRequestObject) (SearchSourcesResponseObject, error) {
-	if len(request.Params.Q) < 2 {
+	if minLen := searchMinQueryLength(ss.server.config); utf8.RuneCountInString(request.Params.Q) < minLen {
 		return SearchSources400JSONResponse{BadRequestJSONResponse{
 			Code:    "bad_request",
-			Message: "Search query must be at least 2 characters",
+			Message: fmt.Sprintf("Search query must be at least %d characters", minLen),
 		}}, nil
 	}
 
-	limit := 20
-	if request.Params.Limit != nil {
-		limit = *request.Params.Limit
-	}
-
+	limit := searchLimit(ss.server.config, request.Params.Limit)
 	sources, err := ss.server.sourceService.SearchSources(ctx, request.Params.Q, limit)
 	if err != nil {
 		return nil, err
@@ -3110,14 +4791,30 @@ func (ss *StrictServer) GetSource(ctx context.Context, request GetSourceRequestO
 		return nil, err
 	}
 
-	return GetSource200JSONResponse(convertQuerySourceDetailToGenerated(*source)), nil
+	etag := versionETag(source.Version)
+	if etagMatches(request.Params.IfNoneMatch, etag) {
+		return GetSource304Response{Headers: NotModifiedResponseHeaders{ETag: &etag}}, nil
+	}
+
+	return GetSource200JSONResponse{
+		Body:    convertQuerySourceDetailToGenerated(*source),
+		Headers: GetSource200ResponseHeaders{ETag: &etag},
+	}, nil
 }
 
 // UpdateSource implements StrictServerInterface.
 func (ss *StrictServer) UpdateSource(ctx context.Context, request UpdateSourceRequestObject) (UpdateSourceResponseObject, error) {
+	version, msg := updateVersion(request.Params.IfMatch, request.Body.Version)
+	if msg != "" {
+		return UpdateSource400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_version",
+			Message: msg,
+		}}, nil
+	}
+
 	input := command.UpdateSourceInput{
 		ID:      request.Id,
-		Version: request.Body.Version,
+		Version: version,
 	}
 
 	if request.Body.SourceType != nil {
@@ -3151,8 +4848,17 @@ func (ss *StrictServer) UpdateSource(ctx context.Context, request UpdateSourceRe
 		input.Notes = request.Body.Notes
 	}
 
-	_, err := ss.server.commandHandler.UpdateSource(ctx, input)
+	err := ss.runUpdate(ctx, updateRetry(request.Params.Retry, request.Params.IfMatch), request.Id, &input.Version, func(ctx context.Context) error {
+		_, err := ss.server.commandHandler.UpdateSource(ctx, input)
+		return err
+	})
 	if err != nil {
+		if errors.Is(err, repository.ErrConcurrencyConflict) && request.Params.IfMatch != nil {
+			return UpdateSource412JSONResponse{PreconditionFailedJSONResponse{
+				Code:    "precondition_failed",
+				Message: "Source has changed since the If-Match version",
+			}}, nil
+		}
 		if errors.Is(err, repository.ErrConcurrencyConflict) {
 			return UpdateSource409JSONResponse{ConflictJSONResponse{
 				Code:    "conflict",
@@ -3194,7 +4900,27 @@ func (ss *StrictServer) DeleteSource(ctx context.Context, request DeleteSourceRe
 
 // GetCitationsForSource implements StrictServerInterface.
 func (ss *StrictServer) GetCitationsForSource(ctx context.Context, request GetCitationsForSourceRequestObject) (GetCitationsForSourceResponseObject, error) {
-	source, err := ss.server.sourceService.GetSource(ctx, request.Id)
+	if !validEnumParam(request.Params.SourceQuality) || !validEnumParam(request.Params.EvidenceType) {
+		return GetCitationsForSource400JSONResponse{BadRequestJSONResponse{
+			Code:    "invalid_parameter",
+			Message: "Invalid source_quality or evidence_type parameter",
+		}}, nil
+	}
+
+	input := query.ListSourceCitationsInput{
+		CitationFilter: citationFilter(request.Params.SourceQuality, request.Params.EvidenceType),
+	}
+	if request.Params.Limit != nil {
+		input.Limit = *request.Params.Limit
+	}
+	if request.Params.Offset != nil {
+		input.Offset = *request.Params.Offset
+	}
+	if request.Params.FactType != nil {
+		input.FactType = *request.Params.FactType
+	}
+
+	result, err := ss.server.sourceService.ListSourceCitations(ctx, request.Id, input)
 	if err != nil {
 		if errors.Is(err, query.ErrNotFound) {
 			return GetCitationsForSource404JSONResponse{NotFoundJSONResponse{
@@ -3205,14 +4931,59 @@ func (ss *StrictServer) GetCitationsForSource(ctx context.Context, request GetCi
 		return nil, err
 	}
 
-	items := make([]Citation, len(source.Citations))
-	for i, c := range source.Citations {
+	items := make([]Citation, len(result.Citations))
+	for i, c := range result.Citations {
 		items[i] = convertQueryCitationToGenerated(c)
 	}
 
 	return GetCitationsForSource200JSONResponse{
 		Citations: items,
-		Total:     len(items),
+		Total:     result.Total,
+		Limit:     &result.Limit,
+		Offset:    &result.Offset,
+	}, nil
+}
+
+// GetSourceUsage implements StrictServerInterface.
+func (ss *StrictServer) GetSourceUsage(ctx context.Context, request GetSourceUsageRequestObject) (GetSourceUsageResponseObject, error) {
+	usage, err := ss.server.sourceService.GetSourceUsage(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return GetSourceUsage404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: "Source not found",
+			}}, nil
+		}
+		return nil, err
+	}
+
+	owners := make([]CitationOwner, len(usage.Owners))
+	for i, o := range usage.Owners {
+		facts := make([]CitedFact, len(o.Facts))
+		for j, f := range o.Facts {
+			facts[j] = CitedFact{
+				FactType:      f.FactType,
+				CitationIds:   f.CitationIDs,
+				CitationCount: f.CitationCount,
+			}
+			if f.BestQuality != nil {
+				q := CitedFactBestQuality(*f.BestQuality)
+				facts[j].BestQuality = &q
+			}
+		}
+		owners[i] = CitationOwner{
+			OwnerType: CitationOwnerOwnerType(o.OwnerType),
+			OwnerId:   o.OwnerID,
+			OwnerName: o.OwnerName,
+			Facts:     facts,
+		}
+	}
+
+	return GetSourceUsage200JSONResponse{
+		SourceId:      usage.SourceID,
+		SourceTitle:   usage.SourceTitle,
+		CitationCount: usage.CitationCount,
+		Owners:        owners,
 	}, nil
 }
 
@@ -3337,21 +5108,106 @@ func (ss *StrictServer) GetStatistics(ctx context.Context, request GetStatistics
 		}
 	}
 
+	topGivenNames := make([]NameCount, len(result.TopGivenNames))
+	for i, n := range result.TopGivenNames {
+		topGivenNames[i] = NameCount{
+			Name:  n.Name,
+			Count: n.Count,
+		}
+	}
+
 	dateRange := DateRange{
 		EarliestBirth: result.DateRange.EarliestBirth,
 		LatestBirth:   result.DateRange.LatestBirth,
 	}
 
+	byCentury := make([]CenturyLifespan, len(result.Lifespan.ByCentury))
+	for i, c := range result.Lifespan.ByCentury {
+		byCentury[i] = CenturyLifespan{
+			Century:     c.Century,
+			PersonCount: c.PersonCount,
+			AverageAge:  float32(c.AverageAge),
+			MedianAge:   float32(c.MedianAge),
+		}
+	}
+	birthsByDecade := make([]DecadeCount, len(result.BirthsByDecade))
+	for i, d := range result.BirthsByDecade {
+		birthsByDecade[i] = DecadeCount{
+			Decade: d.Decade,
+			Count:  d.Count,
+		}
+	}
+
+	lifespan := LifespanStatistics{
+		PersonCount: result.Lifespan.PersonCount,
+		ByCentury:   byCentury,
+	}
+	if result.Lifespan.AverageAge != nil {
+		avg := float32(*result.Lifespan.AverageAge)
+		lifespan.AverageAge = &avg
+	}
+	if result.Lifespan.MedianAge != nil {
+		median := float32(*result.Lifespan.MedianAge)
+		lifespan.MedianAge = &median
+	}
+
+	familySize := FamilySizeStatistics{
+		FamilyCount:  result.FamilySize.FamilyCount,
+		Distribution: make([]FamilySizeCount, len(result.FamilySize.Distribution)),
+	}
+	for i, d := range result.FamilySize.Distribution {
+		familySize.Distribution[i] = FamilySizeCount{
+			Children: d.Children,
+			Families: d.Families,
+		}
+	}
+	if result.FamilySize.AverageChildren != nil {
+		avg := float32(*result.FamilySize.AverageChildren)
+		familySize.AverageChildren = &avg
+	}
+	if result.FamilySize.MedianChildren != nil {
+		median := float32(*result.FamilySize.MedianChildren)
+		familySize.MedianChildren = &median
+	}
+
 	return GetStatistics200JSONResponse{
 		TotalPersons:  result.TotalPersons,
 		TotalFamilies: result.TotalFamilies,
 		TopSurnames:   topSurnames,
+		TopGivenNames: topGivenNames,
 		DateRange:     dateRange,
 		GenderDistribution: GenderDistribution{
-			Male:    result.GenderDistribution.Male,
-			Female:  result.GenderDistribution.Female,
-			Unknown: result.GenderDistribution.Unknown,
+			Male:      result.GenderDistribution.Male,
+			Female:    result.GenderDistribution.Female,
+			Nonbinary: result.GenderDistribution.Nonbinary,
+			Unknown:   result.GenderDistribution.Unknown,
 		},
+		Living: LivingStatistics{
+			Living:           result.Living.Living,
+			Deceased:         result.Living.Deceased,
+			PresumedDeceased: result.Living.PresumedDeceased,
+			Unknown:          result.Living.Unknown,
+			PresumedLifespan: result.Living.PresumedLifespan,
+			AsOfYear:         result.Living.AsOfYear,
+		},
+		Lifespan:       lifespan,
+		BirthsByDecade: birthsByDecade,
+		FamilySize:     familySize,
+	}, nil
+}
+
+// GetMarriageAgeStatistics implements StrictServerInterface.
+func (ss *StrictServer) GetMarriageAgeStatistics(ctx context.Context, request GetMarriageAgeStatisticsRequestObject) (GetMarriageAgeStatisticsResponseObject, error) {
+	result, err := ss.server.qualityService.GetMarriageAgeStatistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetMarriageAgeStatistics200JSONResponse{
+		Overall: convertAgeSummary(result.Overall),
+		Male:    convertAgeSummary(result.Male),
+		Female:  convertAgeSummary(result.Female),
+		Unknown: convertAgeSummary(result.Unknown),
 	}, nil
 }
 
@@ -3359,6 +5215,53 @@ func (ss *StrictServer) GetStatistics(ctx context.Context, request GetStatistics
 // Conversion helpers
 // ============================================================================
 
+// versionETag returns the strong ETag for an entity version.
+func versionETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// personETag returns the strong ETag for a person detail: the person's
+// version, a dash, and a hash of the body. The body embeds families, partner
+// names, and the relationship to the home person, all of which can change
+// without the person's version changing.
+func personETag(version int64, body PersonDetail) (string, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return `"` + strconv.FormatInt(version, 10) + "-" + hex.EncodeToString(sum[:8]) + `"`, nil
+}
+
+// etagMatches reports whether an If-None-Match header value matches etag.
+// The header may list several tags, use weak W/ prefixes, or be "*".
+func etagMatches(ifNoneMatch *string, etag string) bool {
+	if ifNoneMatch == nil {
+		return false
+	}
+	for _, tag := range strings.Split(*ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// convertAgeSummary converts a query age summary to the API type.
+func convertAgeSummary(s query.AgeSummary) AgeSummary {
+	out := AgeSummary{PersonCount: s.PersonCount}
+	if s.AverageAge != nil {
+		avg := float32(*s.AverageAge)
+		out.AverageAge = &avg
+	}
+	if s.MedianAge != nil {
+		median := float32(*s.MedianAge)
+		out.MedianAge = &median
+	}
+	return out
+}
+
 // handleRollbackErrorStrict handles rollback errors and returns appropriate response types.
 func handleRollbackErrorStrict[T any](err error, badReq func(Error) T, notFound func(Error) T, conflict func(Error) T) (T, error) {
 	switch {
@@ -3419,6 +5322,10 @@ func convertQueryPersonToGenerated(p query.Person) Person {
 	if p.BrickWallResolvedAt != nil {
 		resp.BrickWallResolvedAt = p.BrickWallResolvedAt
 	}
+	resp.CreatedAt = p.CreatedAt
+	if !p.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &p.UpdatedAt
+	}
 
 	return resp
 }
@@ -3464,6 +5371,10 @@ func convertQueryPersonDetailToGenerated(pd *query.PersonDetail) PersonDetail {
 	if pd.BrickWallResolvedAt != nil {
 		resp.BrickWallResolvedAt = pd.BrickWallResolvedAt
 	}
+	resp.CreatedAt = pd.CreatedAt
+	if !pd.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &pd.UpdatedAt
+	}
 
 	if len(pd.Names) > 0 {
 		names := make([]PersonName, len(pd.Names))
@@ -3534,6 +5445,7 @@ func convertQueryPersonNameToGenerated(n query.PersonName) PersonName {
 		NameSuffix:    &n.NameSuffix,
 		SurnamePrefix: &n.SurnamePrefix,
 		Nickname:      &n.Nickname,
+		Romanized:     &n.Romanized,
 		NameType:      PersonNameNameType(n.NameType),
 		IsPrimary:     n.IsPrimary,
 	}
@@ -3550,6 +5462,7 @@ func convertPersonNameReadModelToGenerated(n repository.PersonNameReadModel) Per
 		NameSuffix:    &n.NameSuffix,
 		SurnamePrefix: &n.SurnamePrefix,
 		Nickname:      &n.Nickname,
+		Romanized:     &n.Romanized,
 		NameType:      PersonNameNameType(n.NameType),
 		IsPrimary:     n.IsPrimary,
 	}
@@ -3580,6 +5493,14 @@ func convertQueryFamilyToGenerated(f query.Family) Family {
 	if f.MarriagePlace != nil {
 		resp.MarriagePlace = f.MarriagePlace
 	}
+	if f.ResearchStatus != nil {
+		rs := ResearchStatus(*f.ResearchStatus)
+		resp.ResearchStatus = &rs
+	}
+	resp.CreatedAt = f.CreatedAt
+	if !f.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &f.UpdatedAt
+	}
 
 	return resp
 }
@@ -3618,6 +5539,14 @@ func convertQueryFamilyDetailToGenerated(fd query.FamilyDetail) FamilyDetail {
 	if fd.MarriagePlace != nil {
 		resp.MarriagePlace = fd.MarriagePlace
 	}
+	if fd.ResearchStatus != nil {
+		rs := ResearchStatus(*fd.ResearchStatus)
+		resp.ResearchStatus = &rs
+	}
+	resp.CreatedAt = fd.CreatedAt
+	if !fd.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &fd.UpdatedAt
+	}
 
 	// Emit the partner summary whenever the partner ID is set so callers can
 	// always pair partner1_id with a partner1 object. Either name part may be
@@ -3677,6 +5606,10 @@ func convertQueryFamilyToFamilyDetail(f query.Family) FamilyDetail {
 	if f.MarriagePlace != nil {
 		resp.MarriagePlace = f.MarriagePlace
 	}
+	if f.ResearchStatus != nil {
+		rs := ResearchStatus(*f.ResearchStatus)
+		resp.ResearchStatus = &rs
+	}
 
 	if f.Partner1ID != nil {
 		resp.Partner1 = partnerSummary(*f.Partner1ID, stringValue(f.Partner1GivenName), stringValue(f.Partner1Surname))
@@ -3928,6 +5861,7 @@ func convertQueryPedigreeNodeToGenerated(node *query.PedigreeNode) PedigreeNode
 	if node.DeathDate != nil {
 		resp.DeathDate = convertDomainGenDateToGenerated(node.DeathDate)
 	}
+	resp.Sources = convertQueryFactSourceCountsToGenerated(node.Sources)
 	if node.Father != nil {
 		f := convertQueryPedigreeNodeToGenerated(node.Father)
 		resp.Father = &f
@@ -3948,6 +5882,7 @@ func convertQueryDescendancyNodeToGenerated(node *query.DescendancyNode) Descend
 	gen := node.Generation
 	resp := DescendancyNode{
 		Id:         node.ID,
+		FamilyId:   node.FamilyID,
 		Generation: &gen,
 	}
 
@@ -3970,8 +5905,9 @@ func convertQueryDescendancyNodeToGenerated(node *query.DescendancyNode) Descend
 		spouses := make([]SpouseInfo, len(node.Spouses))
 		for i, s := range node.Spouses {
 			spouses[i] = SpouseInfo{
-				Id:   s.ID,
-				Name: s.Name,
+				Id:       s.ID,
+				Name:     s.Name,
+				FamilyId: s.FamilyID,
 			}
 			if s.MarriageDate != nil {
 				spouses[i].MarriageDate = convertDomainGenDateToGenerated(s.MarriageDate)
@@ -4024,6 +5960,7 @@ func convertRestorePointsResult(result *query.RestorePointsResult) RestorePoints
 			Timestamp: rp.Timestamp,
 			Action:    RestorePointAction(rp.Action),
 			Summary:   rp.Summary,
+			UserId:    rp.UserID,
 		}
 	}
 
@@ -4081,6 +6018,16 @@ func convertMediaReadModelToGenerated(m repository.MediaReadModel) Media {
 	if !m.UpdatedAt.IsZero() {
 		resp.UpdatedAt = &m.UpdatedAt
 	}
+	if m.ContentHash != "" {
+		resp.ContentHash = &m.ContentHash
+	}
+	if m.CapturedAt != nil {
+		resp.CapturedAt = m.CapturedAt
+		suggested := strings.ToUpper(m.CapturedAt.Format("2 Jan 2006"))
+		resp.SuggestedEventDate = &suggested
+	}
+	resp.GpsLatitude = m.GPSLatitude
+	resp.GpsLongitude = m.GPSLongitude
 
 	return resp
 }
@@ -4147,6 +6094,43 @@ func (ss *StrictServer) GetRelationship(ctx context.Context, request GetRelation
 	}, nil
 }
 
+// GetPersonKin implements StrictServerInterface.
+func (ss *StrictServer) GetPersonKin(ctx context.Context, request GetPersonKinRequestObject) (GetPersonKinResponseObject, error) {
+	maxDegree := 4
+	if request.Params.MaxDegree != nil {
+		maxDegree = *request.Params.MaxDegree
+	}
+
+	result, err := ss.server.relationshipService.GetKin(ctx, request.Id, maxDegree)
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			return GetPersonKin404JSONResponse{NotFoundJSONResponse{
+				Code:    "not_found",
+				Message: "Person not found",
+			}}, nil
+		}
+		return nil, err
+	}
+
+	kin := make([]KinMember, len(result.Kin))
+	for i, k := range result.Kin {
+		kin[i] = KinMember{
+			Person:          convertQueryPersonToGenerated(k.Person),
+			Relationship:    k.Relationship,
+			Degree:          k.Degree,
+			GenerationsUp:   k.GenerationsUp,
+			GenerationsDown: k.GenerationsDown,
+		}
+	}
+
+	return GetPersonKin200JSONResponse{
+		Person:    convertQueryPersonToGenerated(result.Person),
+		MaxDegree: result.MaxDegree,
+		Kin:       kin,
+		Total:     result.Total,
+	}, nil
+}
+
 // ============================================================================
 // Note endpoints
 // ============================================================================
@@ -4177,6 +6161,7 @@ func (ss *StrictServer) ListNotes(ctx context.Context, request ListNotesRequestO
 		Limit:     limit,
 		Offset:    offset,
 		SortOrder: order,
+		EntityID:  request.Params.EntityId,
 	})
 	if err != nil {
 		return nil, err
@@ -4212,6 +6197,7 @@ func (ss *StrictServer) CreateNote(ctx context.Context, request CreateNoteReques
 			return CreateNote400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -4252,7 +6238,12 @@ func (ss *StrictServer) UpdateNote(ctx context.Context, request UpdateNoteReques
 		input.Text = request.Body.Text
 	}
 
-	result, err := ss.server.commandHandler.UpdateNote(ctx, input)
+	var result *command.UpdateNoteResult
+	err := ss.runUpdate(ctx, request.Params.Retry, request.Id, &input.Version, func(ctx context.Context) error {
+		var err error
+		result, err = ss.server.commandHandler.UpdateNote(ctx, input)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, command.ErrNoteNotFound) {
 			return UpdateNote404JSONResponse{NotFoundJSONResponse{
@@ -4270,6 +6261,7 @@ func (ss *StrictServer) UpdateNote(ctx context.Context, request UpdateNoteReques
 			return UpdateNote400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -4320,6 +6312,14 @@ func convertQueryNoteToGenerated(n query.Note) Note {
 		resp.GedcomXref = n.GedcomXref
 	}
 
+	if len(n.Links) > 0 {
+		links := make([]NoteLink, len(n.Links))
+		for i, l := range n.Links {
+			links[i] = NoteLink{EntityType: NoteLinkEntityType(l.EntityType), EntityId: l.EntityID}
+		}
+		resp.Links = &links
+	}
+
 	// GEDCOM 7.0 shared note (SNOTE) metadata.
 	if n.MIME != "" {
 		resp.Mime = &n.MIME
@@ -4430,6 +6430,7 @@ func (ss *StrictServer) CreateSubmitter(ctx context.Context, request CreateSubmi
 			return CreateSubmitter400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -4486,7 +6487,12 @@ func (ss *StrictServer) UpdateSubmitter(ctx context.Context, request UpdateSubmi
 		input.MediaID = &id
 	}
 
-	result, err := ss.server.commandHandler.UpdateSubmitter(ctx, input)
+	var result *command.UpdateSubmitterResult
+	err := ss.runUpdate(ctx, request.Params.Retry, request.Id, &input.Version, func(ctx context.Context) error {
+		var err error
+		result, err = ss.server.commandHandler.UpdateSubmitter(ctx, input)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, command.ErrSubmitterNotFound) {
 			return UpdateSubmitter404JSONResponse{NotFoundJSONResponse{
@@ -4504,6 +6510,7 @@ func (ss *StrictServer) UpdateSubmitter(ctx context.Context, request UpdateSubmi
 			return UpdateSubmitter400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -4668,6 +6675,7 @@ func (ss *StrictServer) CreateRepository(ctx context.Context, request CreateRepo
 			return CreateRepository400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -4717,7 +6725,10 @@ func (ss *StrictServer) UpdateRepository(ctx context.Context, request UpdateRepo
 		input.GedcomXref = request.Body.GedcomXref
 	}
 
-	_, err := ss.server.commandHandler.UpdateRepository(ctx, input)
+	err := ss.runUpdate(ctx, request.Params.Retry, request.Id, &input.Version, func(ctx context.Context) error {
+		_, err := ss.server.commandHandler.UpdateRepository(ctx, input)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, command.ErrRepositoryNotFound) {
 			return UpdateRepository404JSONResponse{NotFoundJSONResponse{
@@ -4735,6 +6746,7 @@ func (ss *StrictServer) UpdateRepository(ctx context.Context, request UpdateRepo
 			return UpdateRepository400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -4879,6 +6891,7 @@ func (ss *StrictServer) CreateAssociation(ctx context.Context, request CreateAss
 			return CreateAssociation400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -4927,7 +6940,10 @@ func (ss *StrictServer) UpdateAssociation(ctx context.Context, request UpdateAss
 		input.NoteIDs = &noteIDs
 	}
 
-	_, err := ss.server.commandHandler.UpdateAssociation(ctx, input)
+	err := ss.runUpdate(ctx, request.Params.Retry, request.Id, &input.Version, func(ctx context.Context) error {
+		_, err := ss.server.commandHandler.UpdateAssociation(ctx, input)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, command.ErrAssociationNotFound) {
 			return UpdateAssociation404JSONResponse{NotFoundJSONResponse{
@@ -4945,6 +6961,7 @@ func (ss *StrictServer) UpdateAssociation(ctx context.Context, request UpdateAss
 			return UpdateAssociation400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -5161,6 +7178,7 @@ func (ss *StrictServer) CreateLDSOrdinance(ctx context.Context, request CreateLD
 			return CreateLDSOrdinance400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -5210,7 +7228,10 @@ func (ss *StrictServer) UpdateLDSOrdinance(ctx context.Context, request UpdateLD
 		input.Status = request.Body.Status
 	}
 
-	_, err := ss.server.commandHandler.UpdateLDSOrdinance(ctx, input)
+	err := ss.runUpdate(ctx, request.Params.Retry, request.Id, &input.Version, func(ctx context.Context) error {
+		_, err := ss.server.commandHandler.UpdateLDSOrdinance(ctx, input)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, command.ErrLDSOrdinanceNotFound) {
 			return UpdateLDSOrdinance404JSONResponse{NotFoundJSONResponse{
@@ -5228,6 +7249,7 @@ func (ss *StrictServer) UpdateLDSOrdinance(ctx context.Context, request UpdateLD
 			return UpdateLDSOrdinance400JSONResponse{BadRequestJSONResponse{
 				Code:    "invalid_input",
 				Message: err.Error(),
+				Fields:  fieldErrors(err),
 			}}, nil
 		}
 		return nil, err
@@ -5375,6 +7397,12 @@ func convertReadModelPersonToGenerated(rm repository.PersonReadModel) Person {
 		rs := ResearchStatus(rm.ResearchStatus)
 		resp.ResearchStatus = &rs
 	}
+	if !rm.CreatedAt.IsZero() {
+		resp.CreatedAt = &rm.CreatedAt
+	}
+	if !rm.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &rm.UpdatedAt
+	}
 	return resp
 }
 
@@ -5401,6 +7429,16 @@ func convertReadModelFamilyToGenerated(rm repository.FamilyReadModel) Family {
 	if rm.MarriagePlace != "" {
 		resp.MarriagePlace = &rm.MarriagePlace
 	}
+	if rm.ResearchStatus != "" {
+		rs := ResearchStatus(rm.ResearchStatus)
+		resp.ResearchStatus = &rs
+	}
+	if !rm.CreatedAt.IsZero() {
+		resp.CreatedAt = &rm.CreatedAt
+	}
+	if !rm.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &rm.UpdatedAt
+	}
 	return resp
 }
 
@@ -7,6 +7,10 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+
+	"github.com/cacack/my-family/internal/api"
+	"github.com/cacack/my-family/internal/config"
+	"github.com/cacack/my-family/internal/repository/memory"
 )
 
 func TestCreateSource(t *testing.T) {
@@ -123,6 +127,38 @@ func TestGetSource(t *testing.T) {
 	}
 }
 
+func TestGetSource_ETag(t *testing.T) {
+	server := setupTestServer()
+
+	body := `{"source_type":"book","title":"My Source"}`
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/sources", strings.NewReader(body))
+	createReq.Header.Set("Content-Type", "application/json")
+	createRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(createRec, createReq)
+
+	var createResp map[string]any
+	json.Unmarshal(createRec.Body.Bytes(), &createResp)
+	sourceID := createResp["id"].(string)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sources/"+sourceID, http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	etag := rec.Header().Get("ETag")
+	if etag != `"1"` {
+		t.Fatalf("ETag = %q, want %q", etag, `"1"`)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/sources/"+sourceID, http.NoBody)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotModified)
+	}
+}
+
 func TestGetSource_NotFound(t *testing.T) {
 	server := setupTestServer()
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/sources/00000000-0000-0000-0000-000000000001", http.NoBody)
@@ -265,6 +301,33 @@ func TestSearchSources(t *testing.T) {
 	}
 }
 
+func TestSearchSources_ConfiguredSearchLimits(t *testing.T) {
+	cfg := &config.Config{SearchMinQueryLength: 1, SearchMaxLimit: 1}
+	eventStore := memory.NewEventStore()
+	server := api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil)
+
+	for _, title := range []string{"Census of 1900", "Census of 1910"} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/sources", strings.NewReader(`{"source_type":"book","title":"`+title+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		server.Echo().ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sources/search?q=C&limit=50", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var resp struct {
+		Sources []map[string]any `json:"sources"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+	if len(resp.Sources) != 1 {
+		t.Errorf("len(sources) = %d, want 1", len(resp.Sources))
+	}
+}
+
 func TestCreateCitation(t *testing.T) {
 	server := setupTestServer()
 
@@ -587,6 +650,244 @@ func TestGetCitationsForSource(t *testing.T) {
 	}
 }
 
+func TestGetCitationsForSource_PaginationAndFilter(t *testing.T) {
+	server := setupTestServer()
+
+	sourceReq := httptest.NewRequest(http.MethodPost, "/api/v1/sources", strings.NewReader(`{"source_type":"census","title":"1900 Census"}`))
+	sourceReq.Header.Set("Content-Type", "application/json")
+	sourceRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(sourceRec, sourceReq)
+	var sourceResp map[string]any
+	json.Unmarshal(sourceRec.Body.Bytes(), &sourceResp)
+	sourceID := sourceResp["id"].(string)
+
+	personReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(`{"given_name":"John","surname":"Doe"}`))
+	personReq.Header.Set("Content-Type", "application/json")
+	personRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(personRec, personReq)
+	var personResp map[string]any
+	json.Unmarshal(personRec.Body.Bytes(), &personResp)
+	personID := personResp["id"].(string)
+
+	// Three birth citations and two death citations
+	for _, factType := range []string{"person_birth", "person_death", "person_birth", "person_death", "person_birth"} {
+		body := `{"source_id":"` + sourceID + `","fact_type":"` + factType + `","fact_owner_id":"` + personID + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/citations", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("create citation: status = %d. Body: %s", rec.Code, rec.Body.String())
+		}
+	}
+
+	tests := []struct {
+		query     string
+		wantCount int
+		wantTotal int
+	}{
+		{"?limit=2", 2, 5},
+		{"?limit=2&offset=4", 1, 5},
+		{"?fact_type=person_birth", 3, 3},
+		{"?fact_type=person_death&limit=1&offset=1", 1, 2},
+		{"?fact_type=person_burial", 0, 0},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/sources/"+sourceID+"/citations"+tt.query, http.NoBody)
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d, want %d", tt.query, rec.Code, http.StatusOK)
+		}
+
+		var resp struct {
+			Citations []map[string]any `json:"citations"`
+			Total     int              `json:"total"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		if len(resp.Citations) != tt.wantCount || resp.Total != tt.wantTotal {
+			t.Errorf("%s: got %d citations of %d, want %d of %d", tt.query, len(resp.Citations), resp.Total, tt.wantCount, tt.wantTotal)
+		}
+	}
+}
+
+func TestGetCitationsForSource_NotFound(t *testing.T) {
+	server := setupTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sources/00000000-0000-0000-0000-000000000001/citations", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestCitations_QualityFilter(t *testing.T) {
+	server := setupTestServer()
+
+	post := func(path, body string) string {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("POST %s: status = %d. Body: %s", path, rec.Code, rec.Body.String())
+		}
+		var resp map[string]any
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		return resp["id"].(string)
+	}
+
+	sourceID := post("/api/v1/sources", `{"source_type":"census","title":"1900 Census"}`)
+	personID := post("/api/v1/persons", `{"given_name":"John","surname":"Doe"}`)
+	for _, c := range []struct{ factType, quality, evidence string }{
+		{"person_birth", "original", "direct"},
+		{"person_death", "derivative", "indirect"},
+		{"person_name", "original", "indirect"},
+		{"person_gender", "", ""},
+	} {
+		body := `{"source_id":"` + sourceID + `","fact_type":"` + c.factType + `","fact_owner_id":"` + personID + `"`
+		if c.quality != "" {
+			body += `,"source_quality":"` + c.quality + `","evidence_type":"` + c.evidence + `"`
+		}
+		post("/api/v1/citations", body+"}")
+	}
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		wantTotal  int
+	}{
+		{"/api/v1/sources/" + sourceID + "/citations?source_quality=original", http.StatusOK, 2},
+		{"/api/v1/sources/" + sourceID + "/citations?source_quality=original&evidence_type=direct", http.StatusOK, 1},
+		{"/api/v1/sources/" + sourceID + "/citations?evidence_type=indirect", http.StatusOK, 2},
+		{"/api/v1/sources/" + sourceID + "/citations?source_quality=excellent", http.StatusBadRequest, 0},
+		{"/api/v1/persons/" + personID + "/citations?source_quality=derivative", http.StatusOK, 1},
+		{"/api/v1/persons/" + personID + "/citations?evidence_type=indirect", http.StatusOK, 2},
+		{"/api/v1/persons/" + personID + "/citations", http.StatusOK, 4},
+		{"/api/v1/persons/" + personID + "/citations?evidence_type=circumstantial", http.StatusBadRequest, 0},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, http.NoBody)
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != tt.wantStatus {
+			t.Errorf("%s: status = %d, want %d", tt.path, rec.Code, tt.wantStatus)
+			continue
+		}
+		if rec.Code != http.StatusOK {
+			continue
+		}
+		var resp struct {
+			Citations []map[string]any `json:"citations"`
+			Total     int              `json:"total"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		if resp.Total != tt.wantTotal || len(resp.Citations) != tt.wantTotal {
+			t.Errorf("%s: got %d citations (total %d), want %d", tt.path, len(resp.Citations), resp.Total, tt.wantTotal)
+		}
+	}
+}
+
+func TestGetSourceUsage(t *testing.T) {
+	server := setupTestServer()
+
+	post := func(path, body string) string {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("POST %s: status = %d. Body: %s", path, rec.Code, rec.Body.String())
+		}
+		var resp map[string]any
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		return resp["id"].(string)
+	}
+
+	sourceID := post("/api/v1/sources", `{"source_type":"church_record","title":"St. Mary Parish Register"}`)
+	zoeID := post("/api/v1/persons", `{"given_name":"Zoe","surname":"Smith"}`)
+	adamID := post("/api/v1/persons", `{"given_name":"Adam","surname":"Smith"}`)
+	familyID := post("/api/v1/families", `{"partner1_id":"`+adamID+`","partner2_id":"`+zoeID+`"}`)
+
+	cite := func(factType, ownerID, quality string) {
+		body := `{"source_id":"` + sourceID + `","fact_type":"` + factType + `","fact_owner_id":"` + ownerID + `"`
+		if quality != "" {
+			body += `,"source_quality":"` + quality + `"`
+		}
+		post("/api/v1/citations", body+"}")
+	}
+	cite("person_birth", zoeID, "derivative")
+	cite("person_birth", zoeID, "original")
+	cite("person_death", zoeID, "")
+	cite("person_birth", adamID, "")
+	cite("family_marriage", familyID, "original")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sources/"+sourceID+"/usage", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp struct {
+		CitationCount int `json:"citation_count"`
+		Owners        []struct {
+			OwnerType string `json:"owner_type"`
+			OwnerID   string `json:"owner_id"`
+			OwnerName string `json:"owner_name"`
+			Facts     []struct {
+				FactType      string   `json:"fact_type"`
+				CitationIDs   []string `json:"citation_ids"`
+				CitationCount int      `json:"citation_count"`
+				BestQuality   string   `json:"best_quality"`
+			} `json:"facts"`
+		} `json:"owners"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.CitationCount != 5 {
+		t.Errorf("citation_count = %d, want 5", resp.CitationCount)
+	}
+	if len(resp.Owners) != 3 {
+		t.Fatalf("len(owners) = %d, want 3", len(resp.Owners))
+	}
+
+	adam, family, zoe := resp.Owners[0], resp.Owners[1], resp.Owners[2]
+	if adam.OwnerID != adamID || adam.OwnerType != "person" || adam.OwnerName != "Adam Smith" {
+		t.Errorf("owners[0] = %s %s %q, want person Adam Smith", adam.OwnerType, adam.OwnerID, adam.OwnerName)
+	}
+	if family.OwnerID != familyID || family.OwnerType != "family" || family.OwnerName != "Adam Smith & Zoe Smith" {
+		t.Errorf("owners[1] = %s %s %q, want family Adam Smith & Zoe Smith", family.OwnerType, family.OwnerID, family.OwnerName)
+	}
+	if zoe.OwnerID != zoeID || len(zoe.Facts) != 2 {
+		t.Fatalf("owners[2] = %s with %d facts, want Zoe with 2", zoe.OwnerID, len(zoe.Facts))
+	}
+	birth := zoe.Facts[0]
+	if birth.FactType != "person_birth" || birth.CitationCount != 2 || len(birth.CitationIDs) != 2 || birth.BestQuality != "original" {
+		t.Errorf("Zoe birth = %+v, want two citations, best original", birth)
+	}
+	if death := zoe.Facts[1]; death.FactType != "person_death" || death.CitationCount != 1 || death.BestQuality != "" {
+		t.Errorf("Zoe death = %+v, want one unassessed citation", death)
+	}
+}
+
+func TestGetSourceUsage_NotFound(t *testing.T) {
+	server := setupTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sources/00000000-0000-0000-0000-000000000001/usage", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
 func TestUpdateCitation(t *testing.T) {
 	server := setupTestServer()
 
@@ -1196,3 +1497,150 @@ func TestFormatCitation_NoTemplate(t *testing.T) {
 		t.Errorf("Expected empty full citation for template-less citation, got %q", formatResp["full"])
 	}
 }
+
+func TestGetFormattedCitation(t *testing.T) {
+	server := setupTestServer()
+
+	sourceBody := `{"source_type":"book","title":"History of Augusta County","author":"John Smith","publisher":"Shenandoah Press","publish_date":"1882"}`
+	sourceReq := httptest.NewRequest(http.MethodPost, "/api/v1/sources", strings.NewReader(sourceBody))
+	sourceReq.Header.Set("Content-Type", "application/json")
+	sourceRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(sourceRec, sourceReq)
+	var sourceResp map[string]any
+	json.Unmarshal(sourceRec.Body.Bytes(), &sourceResp)
+	sourceID := sourceResp["id"].(string)
+
+	personBody := `{"given_name":"John","surname":"Doe"}`
+	personReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(personBody))
+	personReq.Header.Set("Content-Type", "application/json")
+	personRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(personRec, personReq)
+	var personResp map[string]any
+	json.Unmarshal(personRec.Body.Bytes(), &personResp)
+	personID := personResp["id"].(string)
+
+	citationBody := fmt.Sprintf(`{"source_id":%q,"fact_type":"person_birth","fact_owner_id":%q,"page":"p. 42"}`, sourceID, personID)
+	citReq := httptest.NewRequest(http.MethodPost, "/api/v1/citations", strings.NewReader(citationBody))
+	citReq.Header.Set("Content-Type", "application/json")
+	citRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(citRec, citReq)
+	if citRec.Code != http.StatusCreated {
+		t.Fatalf("Create citation: status = %d, want %d. Body: %s", citRec.Code, http.StatusCreated, citRec.Body.String())
+	}
+	var citResp map[string]any
+	json.Unmarshal(citRec.Body.Bytes(), &citResp)
+	citationID := citResp["id"].(string)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/citations/"+citationID+"/formatted?style=evidence_explained", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+	if resp["style"] != "evidence_explained" {
+		t.Errorf("style = %v, want evidence_explained", resp["style"])
+	}
+	wantFootnote := "John Smith, History of Augusta County (Shenandoah Press, 1882), p. 42."
+	if resp["footnote"] != wantFootnote {
+		t.Errorf("footnote = %q, want %q", resp["footnote"], wantFootnote)
+	}
+	wantBib := "Smith, John. History of Augusta County. Shenandoah Press, 1882."
+	if resp["bibliography"] != wantBib {
+		t.Errorf("bibliography = %q, want %q", resp["bibliography"], wantBib)
+	}
+
+	// Invalid style
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/citations/"+citationID+"/formatted?style=chicago", http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Invalid style: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGetFormattedCitation_NotFound(t *testing.T) {
+	server := setupTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/citations/00000000-0000-0000-0000-000000000001/formatted", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestGetPersonSourceReport(t *testing.T) {
+	server := setupTestServer()
+
+	sourceBody := `{"source_type":"vital_record","title":"Birth Register"}`
+	sourceReq := httptest.NewRequest(http.MethodPost, "/api/v1/sources", strings.NewReader(sourceBody))
+	sourceReq.Header.Set("Content-Type", "application/json")
+	sourceRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(sourceRec, sourceReq)
+	var sourceResp map[string]any
+	json.Unmarshal(sourceRec.Body.Bytes(), &sourceResp)
+	sourceID := sourceResp["id"].(string)
+
+	personBody := `{"given_name":"John","surname":"Doe","birth_date":"1 JAN 1850","death_date":"1920"}`
+	personReq := httptest.NewRequest(http.MethodPost, "/api/v1/persons", strings.NewReader(personBody))
+	personReq.Header.Set("Content-Type", "application/json")
+	personRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(personRec, personReq)
+	var personResp map[string]any
+	json.Unmarshal(personRec.Body.Bytes(), &personResp)
+	personID := personResp["id"].(string)
+
+	citationBody := fmt.Sprintf(`{"source_id":%q,"fact_type":"person_birth","fact_owner_id":%q,"source_quality":"original"}`, sourceID, personID)
+	citReq := httptest.NewRequest(http.MethodPost, "/api/v1/citations", strings.NewReader(citationBody))
+	citReq.Header.Set("Content-Type", "application/json")
+	citRec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(citRec, citReq)
+	if citRec.Code != http.StatusCreated {
+		t.Fatalf("Create citation: status = %d, want %d. Body: %s", citRec.Code, http.StatusCreated, citRec.Body.String())
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/"+personID+"/source-report", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var resp struct {
+		Facts []struct {
+			FactType      string `json:"fact_type"`
+			CitationCount int    `json:"citation_count"`
+			BestQuality   string `json:"best_quality"`
+		} `json:"facts"`
+		CitedCount   int `json:"cited_count"`
+		UncitedCount int `json:"uncited_count"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+
+	if len(resp.Facts) != 3 {
+		t.Fatalf("Got %d facts, want 3 (name, birth, death)", len(resp.Facts))
+	}
+	if resp.CitedCount != 1 || resp.UncitedCount != 2 {
+		t.Errorf("cited/uncited = %d/%d, want 1/2", resp.CitedCount, resp.UncitedCount)
+	}
+	birth := resp.Facts[1]
+	if birth.FactType != "person_birth" || birth.CitationCount != 1 || birth.BestQuality != "original" {
+		t.Errorf("birth coverage = %+v, want 1 original citation", birth)
+	}
+}
+
+func TestGetPersonSourceReport_NotFound(t *testing.T) {
+	server := setupTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/persons/00000000-0000-0000-0000-000000000001/source-report", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
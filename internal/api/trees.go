@@ -0,0 +1,85 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/cacack/my-family/internal/config"
+)
+
+// HeaderTree selects the family tree a request applies to, as an
+// alternative to prefixing the API path with /api/v1/trees/{treeId}.
+// Requests naming neither use the default tree.
+const HeaderTree = "X-Tree-ID"
+
+// treePathPrefix starts API paths addressed to a specific tree.
+const treePathPrefix = "/api/v1/trees/"
+
+// WithTrees hosts additional family trees alongside the server's own, which
+// becomes the default tree. Each tree is a complete Server over its own
+// stores, so events and read models never mix between trees, but rate limits
+// are shared so clients have one budget across all trees.
+func WithTrees(trees map[string]*Server) ServerOption {
+	return func(s *Server) {
+		s.trees = trees
+		for id, tree := range trees {
+			tree.treeID = id
+			if tree.rateLimits != nil && s.rateLimits != nil {
+				tree.rateLimits.RateLimiterStore = s.rateLimits.RateLimiterStore
+			}
+			if tree.expensiveRateLimits != nil && s.expensiveRateLimits != nil {
+				tree.expensiveRateLimits.RateLimiterStore = s.expensiveRateLimits.RateLimiterStore
+			}
+		}
+	}
+}
+
+// treeIDs lists the trees the server hosts, the default tree first.
+func (s *Server) treeIDs() []string {
+	ids := []string{config.DefaultTreeID}
+	for _, id := range s.config.Trees {
+		if _, ok := s.trees[id]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// routeToTree is pre-routing middleware that hands requests for another tree
+// to that tree's server, with the tree prefix stripped from the path.
+// Requests for the default tree continue here.
+func (s *Server) routeToTree(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		path := req.URL.Path
+		if !strings.HasPrefix(path, "/api/") {
+			return next(c)
+		}
+
+		treeID := req.Header.Get(HeaderTree)
+		if rest, ok := strings.CutPrefix(path, treePathPrefix); ok {
+			id, subpath, _ := strings.Cut(rest, "/")
+			treeID = id
+			path = "/api/v1/" + subpath
+			req.URL.Path = path
+			req.URL.RawPath = ""
+		}
+		if treeID == "" || treeID == config.DefaultTreeID || treeID == s.treeID {
+			return next(c)
+		}
+
+		tree, ok := s.trees[treeID]
+		if !ok {
+			return c.JSON(http.StatusNotFound, APIError{
+				Code:      CodeNotFound,
+				Message:   "Unknown family tree " + treeID,
+				RequestID: requestID(c),
+			})
+		}
+		req.Header.Set(HeaderTree, treeID)
+		tree.echo.ServeHTTP(c.Response().Writer, req)
+		return nil
+	}
+}
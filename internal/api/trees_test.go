@@ -0,0 +1,174 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cacack/my-family/internal/api"
+	"github.com/cacack/my-family/internal/config"
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/memory"
+)
+
+func setupTreesTestServer() *api.Server {
+	cfg := &config.Config{
+		Port:      8080,
+		LogFormat: "text",
+		Trees:     []string{"maternal"},
+	}
+	newServer := func(opts ...api.ServerOption) *api.Server {
+		eventStore := memory.NewEventStore()
+		readStore := memory.NewReadModelStore()
+		snapshotStore := memory.NewSnapshotStore(eventStore)
+		return api.NewServer(cfg, eventStore, readStore, snapshotStore, nil, opts...)
+	}
+	maternal := newServer()
+	return newServer(api.WithTrees(map[string]*api.Server{"maternal": maternal}))
+}
+
+func countPersons(t *testing.T, server *api.Server, path, treeHeader string) int {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+	if treeHeader != "" {
+		req.Header.Set(api.HeaderTree, treeHeader)
+	}
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET %s: status = %d, want %d. Body: %s", path, rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var resp struct {
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	return resp.Total
+}
+
+func TestTrees_SeparateStores(t *testing.T) {
+	server := setupTreesTestServer()
+
+	body := `{"given_name":"Mary","surname":"Brown"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/trees/maternal/persons", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Status = %d, want %d. Body: %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+
+	if n := countPersons(t, server, "/api/v1/persons", ""); n != 0 {
+		t.Errorf("default tree persons = %d, want 0", n)
+	}
+	if n := countPersons(t, server, "/api/v1/trees/maternal/persons", ""); n != 1 {
+		t.Errorf("maternal tree persons (path) = %d, want 1", n)
+	}
+	if n := countPersons(t, server, "/api/v1/persons", "maternal"); n != 1 {
+		t.Errorf("maternal tree persons (header) = %d, want 1", n)
+	}
+	if n := countPersons(t, server, "/api/v1/trees/default/persons", ""); n != 0 {
+		t.Errorf("default tree persons (path) = %d, want 0", n)
+	}
+}
+
+func TestTrees_UnknownTree(t *testing.T) {
+	server := setupTreesTestServer()
+
+	for _, tc := range []struct{ path, header string }{
+		{"/api/v1/trees/paternal/persons", ""},
+		{"/api/v1/persons", "paternal"},
+	} {
+		req := httptest.NewRequest(http.MethodGet, tc.path, http.NoBody)
+		if tc.header != "" {
+			req.Header.Set(api.HeaderTree, tc.header)
+		}
+		rec := httptest.NewRecorder()
+		server.Echo().ServeHTTP(rec, req)
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("%s (header %q): status = %d, want %d", tc.path, tc.header, rec.Code, http.StatusNotFound)
+		}
+	}
+}
+
+func TestTrees_ListedInConfig(t *testing.T) {
+	server := setupTreesTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/config", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var resp struct {
+		Trees []string `json:"trees"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if len(resp.Trees) != 2 || resp.Trees[0] != "default" || resp.Trees[1] != "maternal" {
+		t.Errorf("trees = %v, want [default maternal]", resp.Trees)
+	}
+}
+
+// uploadTreeMedia creates a person under base and attaches data to them,
+// returning the media ID and version.
+func uploadTreeMedia(t *testing.T, server *api.Server, base string, data []byte) (string, int64) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, base+"/persons", strings.NewReader(`{"given_name":"Mary","surname":"Brown"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	var person map[string]any
+	_ = json.Unmarshal(rec.Body.Bytes(), &person)
+
+	req, _ = createMultipartRequest(fmt.Sprintf("%s/persons/%s/media", base, person["id"]), "file", "scan.jpg", data,
+		map[string]string{"title": "Scan"})
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Upload to %s: status = %d, want %d. Body: %s", base, rec.Code, http.StatusCreated, rec.Body.String())
+	}
+	var media map[string]any
+	_ = json.Unmarshal(rec.Body.Bytes(), &media)
+	return media["id"].(string), int64(media["version"].(float64))
+}
+
+func TestTrees_MediaBlobsIsolated(t *testing.T) {
+	cfg := &config.Config{Port: 8080, LogFormat: "text", Trees: []string{"maternal"}}
+	blobs := memory.NewMediaBlobStore()
+	newServer := func(opts ...api.ServerOption) *api.Server {
+		eventStore := memory.NewEventStore()
+		return api.NewServer(cfg, eventStore, memory.NewReadModelStore(), memory.NewSnapshotStore(eventStore), nil, opts...)
+	}
+	maternal := newServer(api.WithMediaBlobStore(repository.NewTreeMediaBlobStore(blobs, "maternal")))
+	server := newServer(api.WithMediaBlobStore(blobs), api.WithTrees(map[string]*api.Server{"maternal": maternal}))
+
+	// The same content uploaded to both trees
+	data := createTestJPEGImage()
+	defaultID, _ := uploadTreeMedia(t, server, "/api/v1", data)
+	maternalID, maternalVersion := uploadTreeMedia(t, server, "/api/v1/trees/maternal", data)
+
+	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/trees/maternal/media/%s?version=%d", maternalID, maternalVersion), http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("Delete status = %d, want %d. Body: %s", rec.Code, http.StatusNoContent, rec.Body.String())
+	}
+
+	// Deleting it in one tree leaves the other's copy readable
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/media/%s/content", defaultID), http.NoBody)
+	rec = httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Content status = %d, want %d. Body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if !bytes.Equal(rec.Body.Bytes(), data) {
+		t.Errorf("content length = %d, want %d", rec.Body.Len(), len(data))
+	}
+}
@@ -2,9 +2,11 @@ package api_test
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -172,6 +174,81 @@ func TestGetQualityReport_ResponseSchema(t *testing.T) {
 	}
 }
 
+// TestGetQualityReport_CSV tests GET /quality/report?format=csv
+func TestGetQualityReport_CSV(t *testing.T) {
+	server, readStore := setupValidationTestServer()
+	addTestPerson(readStore, "John", "Doe", "1950")
+	addTestPerson(readStore, "Bob", "Smith", "")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/quality/report?format=csv", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/csv") {
+		t.Errorf("Content-Type = %q, want text/csv", ct)
+	}
+	if cd := rec.Header().Get("Content-Disposition"); !strings.Contains(cd, "quality-report.csv") {
+		t.Errorf("Content-Disposition = %q, want quality-report.csv attachment", cd)
+	}
+
+	rows, err := csv.NewReader(rec.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("Response is not valid CSV: %v", err)
+	}
+	if len(rows) < 10 {
+		t.Fatalf("Got %d rows, want at least 10", len(rows))
+	}
+	if got := strings.Join(rows[0], ","); got != "section,metric,value" {
+		t.Errorf("Header = %q, want section,metric,value", got)
+	}
+	if got := strings.Join(rows[1], ","); got != "totals,individuals,2" {
+		t.Errorf("First row = %q, want totals,individuals,2", got)
+	}
+	if got := strings.Join(rows[4], ","); got != "coverage,birth_date,50.0" {
+		t.Errorf("Birth coverage row = %q, want coverage,birth_date,50.0", got)
+	}
+}
+
+// TestGetQualityReport_PDF tests GET /quality/report?format=pdf
+func TestGetQualityReport_PDF(t *testing.T) {
+	server, readStore := setupValidationTestServer()
+	addTestPerson(readStore, "John", "Doe", "1950")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/quality/report?format=pdf", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/pdf" {
+		t.Errorf("Content-Type = %q, want application/pdf", ct)
+	}
+	if cd := rec.Header().Get("Content-Disposition"); !strings.Contains(cd, "quality-report.pdf") {
+		t.Errorf("Content-Disposition = %q, want quality-report.pdf attachment", cd)
+	}
+	body := rec.Body.String()
+	if !strings.HasPrefix(body, "%PDF-") || !strings.HasSuffix(body, "%%EOF\n") {
+		t.Error("Response is not a complete PDF document")
+	}
+}
+
+// TestGetQualityReport_InvalidFormat tests GET /quality/report with an unknown format
+func TestGetQualityReport_InvalidFormat(t *testing.T) {
+	server, _ := setupValidationTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/quality/report?format=xlsx", http.NoBody)
+	rec := httptest.NewRecorder()
+	server.Echo().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Status = %d, want %d: %s", rec.Code, http.StatusBadRequest, rec.Body.String())
+	}
+}
+
 // ============================================================================
 // GetPersonsDuplicates Tests
 // ============================================================================
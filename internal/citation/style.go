@@ -0,0 +1,191 @@
+package citation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Style identifies a citation style.
+type Style string
+
+// Supported citation styles.
+const (
+	StyleEvidenceExplained Style = "evidence_explained"
+)
+
+// IsValid reports whether the style is supported.
+func (s Style) IsValid() bool {
+	return s == StyleEvidenceExplained
+}
+
+// Reference is the source and citation data a formatted reference is built
+// from. Template fields the citation leaves empty are filled from the matching
+// source or citation value (e.g. "author", "title", "page").
+type Reference struct {
+	// Source fields
+	Author         string
+	Title          string
+	Publisher      string
+	PublishDate    string
+	URL            string
+	RepositoryName string
+	CollectionName string
+	CallNumber     string
+
+	// Citation fields
+	Page       string
+	Volume     string
+	TemplateID string
+	Fields     map[string]string
+}
+
+// FormattedReference is a reference rendered in a citation style.
+type FormattedReference struct {
+	Style        Style
+	Footnote     string // First reference note
+	ShortNote    string // Subsequent reference note
+	Bibliography string // Source list entry
+	Issues       []ValidationIssue
+}
+
+// Format renders a reference in the given style. When the reference names a
+// known template, notes are rendered from it; otherwise they are assembled
+// from the source and citation fields.
+func Format(style Style, ref Reference) (*FormattedReference, error) {
+	if !style.IsValid() {
+		return nil, fmt.Errorf("unknown citation style %q", style)
+	}
+
+	result := &FormattedReference{
+		Style:        style,
+		Bibliography: bibliographyEntry(ref),
+	}
+
+	tmpl := GetTemplate(ref.TemplateID)
+	if tmpl == nil {
+		result.Footnote = genericFootnote(ref)
+		result.ShortNote = genericShortNote(ref)
+		return result, nil
+	}
+
+	fields := templateFields(tmpl, ref)
+	var err error
+	if result.Footnote, err = FormatFull(tmpl, fields); err != nil {
+		return nil, err
+	}
+	if result.ShortNote, err = FormatShort(tmpl, fields); err != nil {
+		return nil, err
+	}
+	result.Issues = ValidateFields(tmpl, fields)
+	return result, nil
+}
+
+// templateFields returns the citation's template fields, with empty fields
+// the template defines filled from the source and citation.
+func templateFields(tmpl *Template, ref Reference) map[string]string {
+	defaults := map[string]string{
+		"author":     ref.Author,
+		"title":      ref.Title,
+		"publisher":  ref.Publisher,
+		"url":        ref.URL,
+		"repository": ref.RepositoryName,
+		"archive":    ref.RepositoryName,
+		"page":       ref.Page,
+		"volume":     ref.Volume,
+	}
+
+	fields := make(map[string]string, len(tmpl.Fields))
+	for k, v := range ref.Fields {
+		fields[k] = v
+	}
+	for _, f := range tmpl.Fields {
+		if strings.TrimSpace(fields[f.Key]) == "" && defaults[f.Key] != "" {
+			fields[f.Key] = defaults[f.Key]
+		}
+	}
+	return fields
+}
+
+// genericFootnote builds a first reference note in the Evidence Explained
+// pattern: Author, Title (Publisher, Date), volume, page; repository.
+func genericFootnote(ref Reference) string {
+	note := joinNonEmpty(", ", ref.Author, ref.Title)
+	if pub := joinNonEmpty(", ", ref.Publisher, ref.PublishDate); pub != "" {
+		note = joinNonEmpty(" ", note, "("+pub+")")
+	}
+	if ref.Volume != "" {
+		note = joinNonEmpty(", ", note, "vol. "+ref.Volume)
+	}
+	note = joinNonEmpty(", ", note, ref.Page)
+	if ref.URL != "" {
+		note = joinNonEmpty(" ", note, "("+ref.URL+")")
+	}
+	note = joinNonEmpty("; ", note, ref.RepositoryName)
+	return sentence(note)
+}
+
+// genericShortNote builds a subsequent reference note: surname, title, page.
+func genericShortNote(ref Reference) string {
+	return sentence(joinNonEmpty(", ", authorSurname(ref.Author), ref.Title, ref.Page))
+}
+
+// bibliographyEntry builds a source list entry. Entries describe the source
+// as a whole, so citation details such as the page are omitted.
+func bibliographyEntry(ref Reference) string {
+	parts := []string{
+		sentence(invertAuthor(ref.Author)),
+		sentence(ref.Title),
+		sentence(joinNonEmpty(", ", ref.Publisher, ref.PublishDate)),
+		sentence(ref.URL),
+		sentence(joinNonEmpty(", ", ref.CollectionName, ref.CallNumber, ref.RepositoryName)),
+	}
+	return joinNonEmpty(" ", parts...)
+}
+
+// invertAuthor puts a simple "Given Surname" name in "Surname, Given" order
+// for source list sorting. Names already containing a comma are unchanged.
+func invertAuthor(author string) string {
+	author = strings.TrimSpace(author)
+	if strings.Contains(author, ",") {
+		return author
+	}
+	words := strings.Fields(author)
+	if len(words) < 2 {
+		return author
+	}
+	return words[len(words)-1] + ", " + strings.Join(words[:len(words)-1], " ")
+}
+
+// authorSurname returns the surname of an author for short notes.
+func authorSurname(author string) string {
+	author = strings.TrimSpace(author)
+	if surname, _, ok := strings.Cut(author, ","); ok {
+		return strings.TrimSpace(surname)
+	}
+	words := strings.Fields(author)
+	if len(words) == 0 {
+		return ""
+	}
+	return words[len(words)-1]
+}
+
+// joinNonEmpty joins the non-blank values with sep.
+func joinNonEmpty(sep string, values ...string) string {
+	var kept []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			kept = append(kept, v)
+		}
+	}
+	return strings.Join(kept, sep)
+}
+
+// sentence terminates non-empty text with a period unless it already ends in
+// punctuation.
+func sentence(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.HasSuffix(s, ".") || strings.HasSuffix(s, "?") || strings.HasSuffix(s, "!") {
+		return s
+	}
+	return s + "."
+}
@@ -0,0 +1,118 @@
+package citation
+
+import (
+	"testing"
+)
+
+func TestFormat_TemplateFilledFromSource(t *testing.T) {
+	ref := Reference{
+		Author:     "John Smith",
+		Title:      "History of Augusta County",
+		Publisher:  "Shenandoah Press",
+		Page:       "p. 42",
+		TemplateID: "published.book",
+		Fields:     map[string]string{"publisher_loc": "Staunton, Va.", "year": "1882"},
+	}
+
+	got, err := Format(StyleEvidenceExplained, ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantFootnote := `John Smith, History of Augusta County (Staunton, Va.: Shenandoah Press, 1882), p. 42.`
+	if got.Footnote != wantFootnote {
+		t.Errorf("footnote:\ngot:  %s\nwant: %s", got.Footnote, wantFootnote)
+	}
+	wantShort := `John Smith, History of Augusta County, p. 42.`
+	if got.ShortNote != wantShort {
+		t.Errorf("short note:\ngot:  %s\nwant: %s", got.ShortNote, wantShort)
+	}
+	wantBib := `Smith, John. History of Augusta County. Shenandoah Press.`
+	if got.Bibliography != wantBib {
+		t.Errorf("bibliography:\ngot:  %s\nwant: %s", got.Bibliography, wantBib)
+	}
+	if len(got.Issues) != 0 {
+		t.Errorf("expected no issues once source fields fill the template, got %v", got.Issues)
+	}
+}
+
+func TestFormat_CitationFieldsTakePrecedence(t *testing.T) {
+	ref := Reference{
+		Author:     "Source Author",
+		Title:      "Source Title",
+		TemplateID: "published.book",
+		Fields:     map[string]string{"author": "Cited Author", "title": "Cited Title"},
+	}
+
+	got, err := Format(StyleEvidenceExplained, ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ShortNote != "Cited Author, Cited Title." {
+		t.Errorf("short note = %q, want citation fields", got.ShortNote)
+	}
+}
+
+func TestFormat_WithoutTemplate(t *testing.T) {
+	ref := Reference{
+		Author:         "Mary Jones",
+		Title:          "Jones Family Bible",
+		Publisher:      "American Bible Society",
+		PublishDate:    "1850",
+		RepositoryName: "Virginia Historical Society",
+		CollectionName: "Jones Family Papers",
+		CallNumber:     "Mss1 J7105",
+		Volume:         "2",
+		Page:           "family record page",
+	}
+
+	got, err := Format(StyleEvidenceExplained, ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantFootnote := `Mary Jones, Jones Family Bible (American Bible Society, 1850), vol. 2, family record page; Virginia Historical Society.`
+	if got.Footnote != wantFootnote {
+		t.Errorf("footnote:\ngot:  %s\nwant: %s", got.Footnote, wantFootnote)
+	}
+	wantShort := `Jones, Jones Family Bible, family record page.`
+	if got.ShortNote != wantShort {
+		t.Errorf("short note:\ngot:  %s\nwant: %s", got.ShortNote, wantShort)
+	}
+	wantBib := `Jones, Mary. Jones Family Bible. American Bible Society, 1850. Jones Family Papers, Mss1 J7105, Virginia Historical Society.`
+	if got.Bibliography != wantBib {
+		t.Errorf("bibliography:\ngot:  %s\nwant: %s", got.Bibliography, wantBib)
+	}
+}
+
+func TestFormat_TitleOnly(t *testing.T) {
+	got, err := Format(StyleEvidenceExplained, Reference{Title: "Parish Register."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Footnote != "Parish Register." || got.ShortNote != "Parish Register." || got.Bibliography != "Parish Register." {
+		t.Errorf("got %+v, want title without doubled periods", got)
+	}
+}
+
+func TestFormat_UnknownStyle(t *testing.T) {
+	if _, err := Format(Style("chicago"), Reference{Title: "Anything"}); err == nil {
+		t.Error("expected error for unknown style")
+	}
+}
+
+func TestInvertAuthor(t *testing.T) {
+	tests := map[string]string{
+		"John Smith":          "Smith, John",
+		"Mary Ann Jones":      "Jones, Mary Ann",
+		"Smith, John":         "Smith, John",
+		"Anonymous":           "Anonymous",
+		"":                    "",
+		"  Elizabeth  Shown ": "Shown, Elizabeth",
+	}
+	for in, want := range tests {
+		if got := invertAuthor(in); got != want {
+			t.Errorf("invertAuthor(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
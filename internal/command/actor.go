@@ -0,0 +1,41 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/domain"
+	"github.com/cacack/my-family/internal/repository"
+)
+
+type actorKey struct{}
+
+// WithActor returns a context whose commands are attributed to actor. An
+// empty actor leaves changes unattributed.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor set by WithActor, or "" if none.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// actorEventStore stamps appended events with the actor from the context.
+type actorEventStore struct {
+	repository.EventStore
+}
+
+// Append attributes events to the context's actor, if any, before appending.
+func (s actorEventStore) Append(ctx context.Context, streamID uuid.UUID, streamType string, events []domain.Event, expectedVersion int64) error {
+	if actor := ActorFromContext(ctx); actor != "" {
+		stamped := make([]domain.Event, len(events))
+		for i, event := range events {
+			stamped[i] = domain.WithActor(event, actor)
+		}
+		events = stamped
+	}
+	return s.EventStore.Append(ctx, streamID, streamType, events, expectedVersion)
+}
@@ -53,7 +53,7 @@ func (h *Handler) CreateAssociation(ctx context.Context, input CreateAssociation
 
 	// Validate association
 	if err := association.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Verify that PersonID and AssociateID exist
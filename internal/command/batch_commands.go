@@ -0,0 +1,352 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Batch operation kinds.
+const (
+	BatchCreatePerson = "create_person"
+	BatchAddName      = "add_name"
+	BatchCreateFamily = "create_family"
+	BatchLinkChild    = "link_child"
+)
+
+// Batch operation result statuses.
+const (
+	BatchStatusOK         = "ok"
+	BatchStatusError      = "error"
+	BatchStatusSkipped    = "skipped"
+	BatchStatusRolledBack = "rolled_back"
+)
+
+// MaxBatchOperations is the largest number of operations accepted in one batch.
+const MaxBatchOperations = 500
+
+// BatchOperation is one step of a batch. Reference fields hold either a UUID
+// or "$alias", naming the entity created by an earlier operation in the batch.
+type BatchOperation struct {
+	Op    string // One of the Batch* operation kinds
+	Alias string // Optional name later operations use to reference the created entity
+
+	PersonRef   string // add_name: person receiving the name
+	Partner1Ref string // create_family: optional first partner
+	Partner2Ref string // create_family: optional second partner
+	FamilyRef   string // link_child: family receiving the child
+	ChildRef    string // link_child: person to link
+
+	Person       CreatePersonInput // create_person
+	Name         AddNameInput      // add_name; PersonID is set from PersonRef
+	Family       CreateFamilyInput // create_family; partner IDs are set from the refs
+	RelationType string            // link_child: "biological" (default), "adopted", "foster"
+}
+
+// BatchOperationResult is the outcome of one batch operation.
+type BatchOperationResult struct {
+	Index  int
+	Op     string
+	Alias  string
+	Status string     // BatchStatusOK, BatchStatusError, BatchStatusSkipped, or BatchStatusRolledBack
+	ID     *uuid.UUID // Created person, name, or family; the family for link_child
+	Error  string
+}
+
+// BatchResult contains the per-operation outcomes of a batch.
+type BatchResult struct {
+	Results    []BatchOperationResult
+	Succeeded  int
+	Failed     int
+	Skipped    int
+	RolledBack int
+}
+
+// batchUndo reverts one applied batch operation.
+type batchUndo func(ctx context.Context) error
+
+// ExecuteBatch runs operations in order. With stopOnError, the first failure
+// rolls back the operations already applied, newest first, and skips the rest;
+// an operation whose rollback fails stays BatchStatusOK with the rollback error.
+// Otherwise each operation is committed as soon as it succeeds and the rest
+// still run (failing if they reference an alias the failed operation would have
+// created).
+//
+// The batch is checked before anything runs: unknown operations, duplicate
+// aliases, and references to aliases not defined by an earlier operation
+// return ErrInvalidInput.
+func (h *Handler) ExecuteBatch(ctx context.Context, ops []BatchOperation, stopOnError bool) (*BatchResult, error) {
+	if err := validateBatch(ops); err != nil {
+		return nil, err
+	}
+
+	aliases := make(map[string]uuid.UUID)
+	result := &BatchResult{Results: make([]BatchOperationResult, len(ops))}
+	undos := make([]batchUndo, len(ops))
+	failed := false
+
+	for i, op := range ops {
+		r := BatchOperationResult{Index: i, Op: op.Op, Alias: op.Alias}
+		if failed && stopOnError {
+			r.Status = BatchStatusSkipped
+			result.Skipped++
+			result.Results[i] = r
+			continue
+		}
+
+		id, undo, err := h.executeBatchOperation(ctx, op, aliases)
+		if err != nil {
+			r.Status = BatchStatusError
+			r.Error = err.Error()
+			result.Failed++
+			failed = true
+		} else {
+			r.Status = BatchStatusOK
+			r.ID = &id
+			result.Succeeded++
+			undos[i] = undo
+			if op.Alias != "" {
+				aliases[op.Alias] = id
+			}
+		}
+		result.Results[i] = r
+	}
+
+	if failed && stopOnError {
+		h.rollbackBatch(context.WithoutCancel(ctx), result, undos)
+	}
+
+	return result, nil
+}
+
+// rollbackBatch reverts the successful operations of a batch, newest first.
+func (h *Handler) rollbackBatch(ctx context.Context, result *BatchResult, undos []batchUndo) {
+	for i := len(undos) - 1; i >= 0; i-- {
+		if undos[i] == nil {
+			continue
+		}
+		r := &result.Results[i]
+		if err := undos[i](ctx); err != nil {
+			r.Error = fmt.Sprintf("rollback failed: %v", err)
+			continue
+		}
+		r.Status = BatchStatusRolledBack
+		result.Succeeded--
+		result.RolledBack++
+	}
+}
+
+// executeBatchOperation runs a single operation and returns the ID it reports
+// and how to revert it.
+func (h *Handler) executeBatchOperation(ctx context.Context, op BatchOperation, aliases map[string]uuid.UUID) (uuid.UUID, batchUndo, error) {
+	switch op.Op {
+	case BatchCreatePerson:
+		created, err := h.CreatePerson(ctx, op.Person)
+		if err != nil {
+			return uuid.Nil, nil, err
+		}
+		undo := h.undoCreatePerson(created.ID)
+		// Match POST /persons, which also records the primary name
+		if _, err := h.AddName(ctx, AddNameInput{
+			PersonID:      created.ID,
+			GivenName:     op.Person.GivenName,
+			Surname:       op.Person.Surname,
+			NamePrefix:    op.Person.NamePrefix,
+			NameSuffix:    op.Person.NameSuffix,
+			SurnamePrefix: op.Person.SurnamePrefix,
+			IsPrimary:     true,
+		}); err != nil {
+			if undoErr := undo(ctx); undoErr != nil {
+				return uuid.Nil, nil, fmt.Errorf("adding primary name: %w (removing person: %v)", err, undoErr)
+			}
+			return uuid.Nil, nil, fmt.Errorf("adding primary name: %w", err)
+		}
+		return created.ID, undo, nil
+
+	case BatchAddName:
+		personID, err := resolveBatchRef(op.PersonRef, aliases)
+		if err != nil {
+			return uuid.Nil, nil, fmt.Errorf("person_id: %w", err)
+		}
+		input := op.Name
+		input.PersonID = personID
+		// Adding a primary name demotes the current one, which a rollback restores
+		var prevPrimary *uuid.UUID
+		if input.IsPrimary {
+			names, err := h.readStore.GetPersonNames(ctx, personID)
+			if err != nil {
+				return uuid.Nil, nil, fmt.Errorf("getting person names: %w", err)
+			}
+			for _, n := range names {
+				if n.IsPrimary {
+					prevPrimary = &n.ID
+					break
+				}
+			}
+		}
+		added, err := h.AddName(ctx, input)
+		if err != nil {
+			return uuid.Nil, nil, err
+		}
+		return added.ID, h.undoAddName(personID, added.ID, prevPrimary), nil
+
+	case BatchCreateFamily:
+		input := op.Family
+		for _, partner := range []struct {
+			field string
+			ref   string
+			dst   **uuid.UUID
+		}{
+			{"partner1_id", op.Partner1Ref, &input.Partner1ID},
+			{"partner2_id", op.Partner2Ref, &input.Partner2ID},
+		} {
+			if partner.ref == "" {
+				continue
+			}
+			id, err := resolveBatchRef(partner.ref, aliases)
+			if err != nil {
+				return uuid.Nil, nil, fmt.Errorf("%s: %w", partner.field, err)
+			}
+			*partner.dst = &id
+		}
+		created, err := h.CreateFamily(ctx, input)
+		if err != nil {
+			return uuid.Nil, nil, err
+		}
+		return created.ID, h.undoCreateFamily(created.ID), nil
+
+	case BatchLinkChild:
+		familyID, err := resolveBatchRef(op.FamilyRef, aliases)
+		if err != nil {
+			return uuid.Nil, nil, fmt.Errorf("family_id: %w", err)
+		}
+		childID, err := resolveBatchRef(op.ChildRef, aliases)
+		if err != nil {
+			return uuid.Nil, nil, fmt.Errorf("child_id: %w", err)
+		}
+		relType := op.RelationType
+		if relType == "" {
+			relType = "biological"
+		}
+		if _, err := h.LinkChild(ctx, LinkChildInput{FamilyID: familyID, ChildID: childID, RelationType: relType}); err != nil {
+			return uuid.Nil, nil, err
+		}
+		undo := func(ctx context.Context) error {
+			return h.UnlinkChild(ctx, UnlinkChildInput{FamilyID: familyID, ChildID: childID})
+		}
+		return familyID, undo, nil
+	}
+
+	// Unreachable: validateBatch rejects unknown operations
+	return uuid.Nil, nil, fmt.Errorf("%w: unknown operation %q", ErrInvalidInput, op.Op)
+}
+
+// undoCreatePerson deletes a person created by a batch.
+func (h *Handler) undoCreatePerson(id uuid.UUID) batchUndo {
+	return func(ctx context.Context) error {
+		person, err := h.readStore.GetPerson(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting person: %w", err)
+		}
+		if person == nil {
+			return ErrPersonNotFound
+		}
+		return h.DeletePerson(ctx, DeletePersonInput{ID: id, Version: person.Version, Reason: "batch rolled back"})
+	}
+}
+
+// undoAddName removes a name added by a batch, first restoring the primary
+// name it demoted.
+func (h *Handler) undoAddName(personID, nameID uuid.UUID, prevPrimary *uuid.UUID) batchUndo {
+	return func(ctx context.Context) error {
+		if prevPrimary != nil {
+			primary := true
+			if _, err := h.UpdateName(ctx, UpdateNameInput{PersonID: personID, NameID: *prevPrimary, IsPrimary: &primary}); err != nil {
+				return fmt.Errorf("restoring primary name: %w", err)
+			}
+		}
+		return h.DeleteName(ctx, DeleteNameInput{PersonID: personID, NameID: nameID})
+	}
+}
+
+// undoCreateFamily deletes a family created by a batch.
+func (h *Handler) undoCreateFamily(id uuid.UUID) batchUndo {
+	return func(ctx context.Context) error {
+		family, err := h.readStore.GetFamily(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting family: %w", err)
+		}
+		if family == nil {
+			return ErrFamilyNotFound
+		}
+		return h.DeleteFamily(ctx, DeleteFamilyInput{ID: id, Version: family.Version})
+	}
+}
+
+// validateBatch checks the structure of a batch before any operation runs.
+func validateBatch(ops []BatchOperation) error {
+	if len(ops) == 0 {
+		return fmt.Errorf("%w: at least one operation is required", ErrInvalidInput)
+	}
+	if len(ops) > MaxBatchOperations {
+		return fmt.Errorf("%w: at most %d operations are allowed", ErrInvalidInput, MaxBatchOperations)
+	}
+
+	defined := make(map[string]bool)
+	for i, op := range ops {
+		var refs map[string]string
+		switch op.Op {
+		case BatchCreatePerson:
+		case BatchAddName:
+			refs = map[string]string{"person_id": op.PersonRef}
+		case BatchCreateFamily:
+			refs = map[string]string{"partner1_id": op.Partner1Ref, "partner2_id": op.Partner2Ref}
+		case BatchLinkChild:
+			refs = map[string]string{"family_id": op.FamilyRef, "child_id": op.ChildRef}
+		default:
+			return fmt.Errorf("%w: operation %d: unknown operation %q", ErrInvalidInput, i, op.Op)
+		}
+
+		for field, ref := range refs {
+			alias, isAlias := strings.CutPrefix(ref, "$")
+			if isAlias && !defined[alias] {
+				return fmt.Errorf("%w: operation %d: %s references undefined alias %q", ErrInvalidInput, i, field, ref)
+			}
+		}
+
+		if op.Alias != "" {
+			if strings.HasPrefix(op.Alias, "$") {
+				return fmt.Errorf("%w: operation %d: alias %q must not start with $", ErrInvalidInput, i, op.Alias)
+			}
+			if op.Op == BatchLinkChild {
+				return fmt.Errorf("%w: operation %d: link_child does not create an entity and cannot have an alias", ErrInvalidInput, i)
+			}
+			if defined[op.Alias] {
+				return fmt.Errorf("%w: operation %d: duplicate alias %q", ErrInvalidInput, i, op.Alias)
+			}
+			defined[op.Alias] = true
+		}
+	}
+	return nil
+}
+
+// resolveBatchRef resolves a UUID or "$alias" reference.
+func resolveBatchRef(ref string, aliases map[string]uuid.UUID) (uuid.UUID, error) {
+	if ref == "" {
+		return uuid.Nil, fmt.Errorf("%w: reference is required", ErrInvalidInput)
+	}
+	if alias, ok := strings.CutPrefix(ref, "$"); ok {
+		id, ok := aliases[alias]
+		if !ok {
+			return uuid.Nil, fmt.Errorf("%w: alias %q was not created because its operation failed", ErrInvalidInput, ref)
+		}
+		return id, nil
+	}
+	id, err := uuid.Parse(ref)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %q is neither a UUID nor a $alias", ErrInvalidInput, ref)
+	}
+	return id, nil
+}
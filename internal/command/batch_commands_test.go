@@ -0,0 +1,194 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/memory"
+)
+
+func TestExecuteBatch(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(memory.NewEventStore(), readStore)
+	ctx := context.Background()
+
+	result, err := handler.ExecuteBatch(ctx, []command.BatchOperation{
+		{Op: command.BatchCreatePerson, Alias: "father", Person: command.CreatePersonInput{GivenName: "John", Surname: "Doe", Gender: "male"}},
+		{Op: command.BatchCreatePerson, Alias: "mother", Person: command.CreatePersonInput{GivenName: "Jane", Surname: "Smith", Gender: "female"}},
+		{Op: command.BatchCreatePerson, Alias: "child", Person: command.CreatePersonInput{GivenName: "Jimmy", Surname: "Doe"}},
+		{Op: command.BatchAddName, PersonRef: "$mother", Name: command.AddNameInput{GivenName: "Jane", Surname: "Doe", NameType: "married"}},
+		{Op: command.BatchCreateFamily, Alias: "family", Partner1Ref: "$father", Partner2Ref: "$mother", Family: command.CreateFamilyInput{MarriagePlace: "Boston, MA"}},
+		{Op: command.BatchLinkChild, FamilyRef: "$family", ChildRef: "$child"},
+	}, true)
+	if err != nil {
+		t.Fatalf("ExecuteBatch failed: %v", err)
+	}
+
+	if result.Succeeded != 6 || result.Failed != 0 || result.Skipped != 0 {
+		t.Fatalf("result = %d ok, %d failed, %d skipped; want 6/0/0: %+v", result.Succeeded, result.Failed, result.Skipped, result.Results)
+	}
+
+	familyID := *result.Results[4].ID
+	family, err := readStore.GetFamily(ctx, familyID)
+	if err != nil || family == nil {
+		t.Fatalf("GetFamily failed: %v", err)
+	}
+	if *family.Partner1ID != *result.Results[0].ID || *family.Partner2ID != *result.Results[1].ID {
+		t.Errorf("family partners = %v/%v, want the created father and mother", family.Partner1ID, family.Partner2ID)
+	}
+
+	children, err := readStore.GetFamilyChildren(ctx, familyID)
+	if err != nil {
+		t.Fatalf("GetFamilyChildren failed: %v", err)
+	}
+	if len(children) != 1 || children[0].PersonID != *result.Results[2].ID {
+		t.Errorf("children = %+v, want the created child", children)
+	}
+
+	names, err := readStore.GetPersonNames(ctx, *result.Results[1].ID)
+	if err != nil {
+		t.Fatalf("GetPersonNames failed: %v", err)
+	}
+	if len(names) != 2 {
+		t.Errorf("mother has %d names, want primary and married", len(names))
+	}
+}
+
+func TestExecuteBatch_StopOnError(t *testing.T) {
+	handler := command.NewHandler(memory.NewEventStore(), memory.NewReadModelStore())
+	ctx := context.Background()
+
+	ops := []command.BatchOperation{
+		{Op: command.BatchCreatePerson, Alias: "bad", Person: command.CreatePersonInput{GivenName: "", Surname: "Doe"}},
+		{Op: command.BatchAddName, PersonRef: "$bad", Name: command.AddNameInput{GivenName: "J", Surname: "Doe", NameType: "aka"}},
+		{Op: command.BatchCreatePerson, Person: command.CreatePersonInput{GivenName: "Jane"}},
+	}
+
+	result, err := handler.ExecuteBatch(ctx, ops, true)
+	if err != nil {
+		t.Fatalf("ExecuteBatch failed: %v", err)
+	}
+	want := []string{command.BatchStatusError, command.BatchStatusSkipped, command.BatchStatusSkipped}
+	for i, w := range want {
+		if result.Results[i].Status != w {
+			t.Errorf("stopOnError: result %d status = %s, want %s", i, result.Results[i].Status, w)
+		}
+	}
+
+	result, err = handler.ExecuteBatch(ctx, ops, false)
+	if err != nil {
+		t.Fatalf("ExecuteBatch failed: %v", err)
+	}
+	want = []string{command.BatchStatusError, command.BatchStatusError, command.BatchStatusOK}
+	for i, w := range want {
+		if result.Results[i].Status != w {
+			t.Errorf("continue: result %d status = %s, want %s (%s)", i, result.Results[i].Status, w, result.Results[i].Error)
+		}
+	}
+	if result.Results[0].Error == "" {
+		t.Error("expected an error message for the failed operation")
+	}
+}
+
+func TestExecuteBatch_StopOnErrorRollsBack(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(memory.NewEventStore(), readStore)
+	ctx := context.Background()
+
+	existing, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Mary", Surname: "Jones"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+	original, err := handler.AddName(ctx, command.AddNameInput{PersonID: existing.ID, GivenName: "Mary", Surname: "Jones", IsPrimary: true})
+	if err != nil {
+		t.Fatalf("AddName failed: %v", err)
+	}
+
+	result, err := handler.ExecuteBatch(ctx, []command.BatchOperation{
+		{Op: command.BatchCreatePerson, Alias: "husband", Person: command.CreatePersonInput{GivenName: "Tom", Surname: "Brown"}},
+		{Op: command.BatchAddName, PersonRef: existing.ID.String(), Name: command.AddNameInput{GivenName: "Mary", Surname: "Brown", NameType: "married", IsPrimary: true}},
+		{Op: command.BatchCreateFamily, Alias: "family", Partner1Ref: "$husband", Partner2Ref: existing.ID.String()},
+		{Op: command.BatchCreatePerson, Alias: "child", Person: command.CreatePersonInput{GivenName: "Ann", Surname: "Brown"}},
+		{Op: command.BatchLinkChild, FamilyRef: "$family", ChildRef: "$child"},
+		{Op: command.BatchLinkChild, FamilyRef: uuid.NewString(), ChildRef: "$child"},
+		{Op: command.BatchCreatePerson, Person: command.CreatePersonInput{GivenName: "Never"}},
+	}, true)
+	if err != nil {
+		t.Fatalf("ExecuteBatch failed: %v", err)
+	}
+
+	if result.Succeeded != 0 || result.RolledBack != 5 || result.Failed != 1 || result.Skipped != 1 {
+		t.Fatalf("result = %d ok, %d rolled back, %d failed, %d skipped; want 0/5/1/1: %+v",
+			result.Succeeded, result.RolledBack, result.Failed, result.Skipped, result.Results)
+	}
+	for i, r := range result.Results[:5] {
+		if r.Status != command.BatchStatusRolledBack {
+			t.Errorf("result %d status = %s, want %s (%s)", i, r.Status, command.BatchStatusRolledBack, r.Error)
+		}
+	}
+
+	// Only the person created before the batch remains, with its original name
+	_, total, _ := readStore.ListPersons(ctx, repository.ListOptions{Limit: 10})
+	if total != 1 {
+		t.Errorf("expected 1 person after rollback, got %d", total)
+	}
+	families, err := readStore.GetFamiliesForPerson(ctx, existing.ID)
+	if err != nil {
+		t.Fatalf("GetFamiliesForPerson failed: %v", err)
+	}
+	if len(families) != 0 {
+		t.Errorf("expected no families after rollback, got %d", len(families))
+	}
+	names, err := readStore.GetPersonNames(ctx, existing.ID)
+	if err != nil {
+		t.Fatalf("GetPersonNames failed: %v", err)
+	}
+	if len(names) != 1 || names[0].ID != original.ID || !names[0].IsPrimary {
+		t.Errorf("names = %+v, want only the original primary name", names)
+	}
+}
+
+func TestExecuteBatch_InvalidBatch(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(memory.NewEventStore(), readStore)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		ops  []command.BatchOperation
+	}{
+		{"empty", nil},
+		{"unknown op", []command.BatchOperation{{Op: "delete_everything"}}},
+		{"forward alias reference", []command.BatchOperation{
+			{Op: command.BatchAddName, PersonRef: "$later"},
+			{Op: command.BatchCreatePerson, Alias: "later", Person: command.CreatePersonInput{GivenName: "A"}},
+		}},
+		{"duplicate alias", []command.BatchOperation{
+			{Op: command.BatchCreatePerson, Alias: "p", Person: command.CreatePersonInput{GivenName: "A"}},
+			{Op: command.BatchCreatePerson, Alias: "p", Person: command.CreatePersonInput{GivenName: "B"}},
+		}},
+		{"link_child alias", []command.BatchOperation{
+			{Op: command.BatchLinkChild, Alias: "x", FamilyRef: uuid.NewString(), ChildRef: uuid.NewString()},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.ExecuteBatch(ctx, tt.ops, true)
+			if !errors.Is(err, command.ErrInvalidInput) {
+				t.Errorf("err = %v, want ErrInvalidInput", err)
+			}
+		})
+	}
+
+	// Invalid batches are rejected before any operation runs
+	_, total, _ := readStore.ListPersons(ctx, repository.ListOptions{Limit: 10})
+	if total != 0 {
+		t.Errorf("expected no persons, got %d", total)
+	}
+}
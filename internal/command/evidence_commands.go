@@ -61,7 +61,7 @@ func (h *Handler) CreateEvidenceAnalysis(ctx context.Context, input CreateEviden
 
 	// Validate
 	if err := analysis.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
@@ -177,7 +177,7 @@ func (h *Handler) UpdateEvidenceAnalysis(ctx context.Context, input UpdateEviden
 
 	// Validate updated entity
 	if err := testAnalysis.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
@@ -292,7 +292,7 @@ func (h *Handler) CreateResearchLog(ctx context.Context, input CreateResearchLog
 	}
 
 	if err := log.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	event := domain.NewResearchLogCreated(log)
@@ -382,7 +382,7 @@ func (h *Handler) UpdateResearchLog(ctx context.Context, input UpdateResearchLog
 	}
 
 	if err := testLog.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	event := domain.NewResearchLogUpdated(input.ID, changes)
@@ -450,7 +450,7 @@ func (h *Handler) CreateProofSummary(ctx context.Context, input CreateProofSumma
 	}
 
 	if err := summary.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	event := domain.NewProofSummaryCreated(summary)
@@ -541,7 +541,7 @@ func (h *Handler) UpdateProofSummary(ctx context.Context, input UpdateProofSumma
 	}
 
 	if err := testSummary.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	event := domain.NewProofSummaryUpdated(input.ID, changes)
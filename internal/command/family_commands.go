@@ -28,6 +28,7 @@ type CreateFamilyInput struct {
 	RelationshipType string
 	MarriageDate     string
 	MarriagePlace    string
+	ResearchStatus   string
 }
 
 // CreateFamilyResult contains the result of creating a family.
@@ -82,10 +83,13 @@ func (h *Handler) CreateFamily(ctx context.Context, input CreateFamilyInput) (*C
 	if input.MarriagePlace != "" {
 		family.MarriagePlace = input.MarriagePlace
 	}
+	if input.ResearchStatus != "" {
+		family.ResearchStatus = domain.ResearchStatus(input.ResearchStatus)
+	}
 
 	// Validate
 	if err := family.Validate(); err != nil {
-		return nil, errors.New("invalid family input: " + err.Error())
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event using the helper function
@@ -116,6 +120,7 @@ type UpdateFamilyInput struct {
 	RelationshipType *string
 	MarriageDate     *string
 	MarriagePlace    *string
+	ResearchStatus   *string
 	Version          int64
 }
 
@@ -157,6 +162,13 @@ func (h *Handler) UpdateFamily(ctx context.Context, input UpdateFamilyInput) (*U
 	if input.MarriagePlace != nil {
 		changes["marriage_place"] = *input.MarriagePlace
 	}
+	if input.ResearchStatus != nil {
+		rs := domain.ResearchStatus(*input.ResearchStatus)
+		if !rs.IsValid() {
+			return nil, fmt.Errorf("%w: research_status: invalid value: %s", ErrInvalidInput, rs)
+		}
+		changes["research_status"] = *input.ResearchStatus
+	}
 
 	if len(changes) == 0 {
 		return &UpdateFamilyResult{Version: family.Version}, nil
@@ -179,6 +191,8 @@ func (h *Handler) UpdateFamily(ctx context.Context, input UpdateFamilyInput) (*U
 		return nil, fmt.Errorf("applying family updated event: %w", err)
 	}
 
+	h.maybeSnapshot(ctx, input.ID, "Family", input.Version, input.Version+1)
+
 	return &UpdateFamilyResult{
 		Version: input.Version + 1,
 	}, nil
@@ -307,6 +321,8 @@ func (h *Handler) LinkChild(ctx context.Context, input LinkChildInput) (*LinkChi
 		return nil, fmt.Errorf("applying child linked event: %w", err)
 	}
 
+	h.maybeSnapshot(ctx, input.FamilyID, "Family", family.Version, family.Version+1)
+
 	return &LinkChildResult{
 		FamilyVersion: family.Version + 1,
 	}, nil
@@ -351,7 +367,13 @@ func (h *Handler) UnlinkChild(ctx context.Context, input UnlinkChildInput) error
 	}
 
 	// Update read model
-	return h.projector.Apply(ctx, event)
+	if err := h.projector.Apply(ctx, event); err != nil {
+		return err
+	}
+
+	h.maybeSnapshot(ctx, input.FamilyID, "Family", family.Version, family.Version+1)
+
+	return nil
 }
 
 // isAncestor checks if potentialAncestor is an ancestor of personID.
@@ -2,6 +2,7 @@ package command_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
@@ -1897,3 +1898,51 @@ func TestFamilyMultipleUpdatesVersionTracking(t *testing.T) {
 		t.Errorf("Event store version = %d, want 4", eventStoreVersion)
 	}
 }
+
+func TestFamilyResearchStatus(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	p1, _ := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+
+	createResult, err := handler.CreateFamily(ctx, command.CreateFamilyInput{
+		Partner1ID:     &p1.ID,
+		MarriageDate:   "ABT 1900",
+		ResearchStatus: "possible",
+	})
+	if err != nil {
+		t.Fatalf("CreateFamily failed: %v", err)
+	}
+	family, _ := readStore.GetFamily(ctx, createResult.ID)
+	if family.ResearchStatus != "possible" {
+		t.Errorf("ResearchStatus = %q, want possible", family.ResearchStatus)
+	}
+
+	certain := "certain"
+	if _, err := handler.UpdateFamily(ctx, command.UpdateFamilyInput{
+		ID:             createResult.ID,
+		ResearchStatus: &certain,
+		Version:        createResult.Version,
+	}); err != nil {
+		t.Fatalf("UpdateFamily failed: %v", err)
+	}
+	family, _ = readStore.GetFamily(ctx, createResult.ID)
+	if family.ResearchStatus != "certain" {
+		t.Errorf("ResearchStatus = %q, want certain", family.ResearchStatus)
+	}
+
+	invalid := "definitely"
+	_, err = handler.UpdateFamily(ctx, command.UpdateFamilyInput{
+		ID:             createResult.ID,
+		ResearchStatus: &invalid,
+		Version:        family.Version,
+	})
+	if !errors.Is(err, command.ErrInvalidInput) {
+		t.Errorf("UpdateFamily() error = %v, want ErrInvalidInput", err)
+	}
+	if _, err := handler.CreateFamily(ctx, command.CreateFamilyInput{Partner1ID: &p1.ID, ResearchStatus: invalid}); !errors.Is(err, command.ErrInvalidInput) {
+		t.Errorf("CreateFamily() error = %v, want ErrInvalidInput", err)
+	}
+}
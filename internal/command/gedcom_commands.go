@@ -2,8 +2,12 @@ package command
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"path"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -18,11 +22,51 @@ type ImportGedcomInput struct {
 	FileSize int64
 	Reader   io.Reader
 
+	// MediaFiles, when non-nil, holds the media files accompanying the GEDCOM
+	// file (such as an uploaded ZIP archive). Media objects whose FILE
+	// reference resolves to one of them are imported and linked to the
+	// persons, families, and sources that reference them.
+	MediaFiles fs.FS
+
 	// OnProgress, when non-nil, is invoked periodically while the GEDCOM file is
 	// being parsed. bytesRead is the cumulative bytes read and totalBytes is the
 	// expected total (FileSize), or -1 when unknown. Used to surface real-time
 	// import progress to clients. When nil there is zero overhead.
 	OnProgress gedcom.ImportProgressCallback
+
+	// OnRecordProgress, when non-nil, is invoked periodically while the parsed
+	// records are being created, which dominates the run time of large imports.
+	OnRecordProgress func(ImportRecordProgress)
+}
+
+// ImportRecordProgress reports how far one phase of record creation has got.
+type ImportRecordProgress struct {
+	ImportID uuid.UUID
+	Phase    string // kind of record being created, e.g. "persons"
+	Done     int
+	Total    int
+}
+
+// recordProgressInterval is how many records are created between progress reports.
+const recordProgressInterval = 100
+
+// recordProgress throttles record creation progress reports for one import.
+type recordProgress struct {
+	importID uuid.UUID
+	fn       func(ImportRecordProgress)
+}
+
+// report announces that done of total records in phase have been processed.
+// Reports are sent at the start and end of a phase and every
+// recordProgressInterval records in between; empty phases are skipped.
+func (p recordProgress) report(phase string, done, total int) {
+	if p.fn == nil || total == 0 {
+		return
+	}
+	if done%recordProgressInterval != 0 && done != total {
+		return
+	}
+	p.fn(ImportRecordProgress{ImportID: p.importID, Phase: phase, Done: done, Total: total})
 }
 
 // ImportGedcomResult contains the result of a GEDCOM import.
@@ -39,8 +83,18 @@ type ImportGedcomResult struct {
 	SubmittersImported    int
 	AssociationsImported  int
 	LDSOrdinancesImported int
+	MediaImported         int
 	Warnings              []string
 	Errors                []string
+	Issues                []gedcom.ImportIssue // Warnings and parse errors in machine-readable form
+}
+
+// addIssue records a problem met while creating records as a flat warning and
+// as an import issue.
+func (r *ImportGedcomResult) addIssue(severity gedcom.ImportIssueSeverity, code, xref, format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	r.Warnings = append(r.Warnings, msg)
+	r.Issues = append(r.Issues, gedcom.ImportIssue{Severity: severity, Code: code, Context: xref, Message: msg})
 }
 
 // ImportGedcom imports persons and families from a GEDCOM file.
@@ -48,7 +102,7 @@ func (h *Handler) ImportGedcom(ctx context.Context, input ImportGedcomInput) (*I
 	importer := gedcom.NewImporter()
 
 	// Parse the GEDCOM file, forwarding progress callbacks when requested.
-	importResult, persons, families, sources, citations, repositories, events, attributes, notes, submitters, associations, ldsOrdinances, _, err := importer.ImportWithOptions(ctx, input.Reader, gedcom.ImportOptions{
+	importResult, persons, families, sources, citations, repositories, events, attributes, notes, submitters, associations, ldsOrdinances, mediaObjects, err := importer.ImportWithOptions(ctx, input.Reader, gedcom.ImportOptions{
 		TotalSize:  input.FileSize,
 		OnProgress: input.OnProgress,
 	})
@@ -65,10 +119,13 @@ func (h *Handler) ImportGedcom(ctx context.Context, input ImportGedcomInput) (*I
 		ImportID: uuid.New(),
 		Warnings: importResult.Warnings,
 		Errors:   importResult.Errors,
+		Issues:   importResult.Issues,
 	}
+	progress := recordProgress{importID: result.ImportID, fn: input.OnRecordProgress}
 
 	// Import repositories first (before sources that reference them)
-	for _, r := range repositories {
+	for i, r := range repositories {
+		progress.report("repositories", i, len(repositories))
 		err := h.importRepository(ctx, r)
 		if err != nil {
 			result.Errors = append(result.Errors,
@@ -77,9 +134,11 @@ func (h *Handler) ImportGedcom(ctx context.Context, input ImportGedcomInput) (*I
 		}
 		result.RepositoriesImported++
 	}
+	progress.report("repositories", len(repositories), len(repositories))
 
 	// Import sources (after repositories so we can link them)
-	for _, s := range sources {
+	for i, s := range sources {
+		progress.report("sources", i, len(sources))
 		err := h.importSource(ctx, s)
 		if err != nil {
 			result.Errors = append(result.Errors,
@@ -88,9 +147,11 @@ func (h *Handler) ImportGedcom(ctx context.Context, input ImportGedcomInput) (*I
 		}
 		result.SourcesImported++
 	}
+	progress.report("sources", len(sources), len(sources))
 
 	// Import persons
-	for _, p := range persons {
+	for i, p := range persons {
+		progress.report("persons", i, len(persons))
 		err := h.importPerson(ctx, p)
 		if err != nil {
 			result.Errors = append(result.Errors,
@@ -99,9 +160,11 @@ func (h *Handler) ImportGedcom(ctx context.Context, input ImportGedcomInput) (*I
 		}
 		result.PersonsImported++
 	}
+	progress.report("persons", len(persons), len(persons))
 
 	// Import families (after persons so we can link them)
-	for _, f := range families {
+	for i, f := range families {
+		progress.report("families", i, len(families))
 		err := h.importFamily(ctx, f)
 		if err != nil {
 			result.Errors = append(result.Errors,
@@ -118,11 +181,12 @@ func (h *Handler) ImportGedcom(ctx context.Context, input ImportGedcomInput) (*I
 			}
 			err := h.linkChildToFamily(ctx, f.ID, childID, relType)
 			if err != nil {
-				result.Warnings = append(result.Warnings,
-					fmt.Sprintf("Failed to link child to family %s: %v", f.GedcomXref, err))
+				result.addIssue(gedcom.ImportIssueError, gedcom.IssueNotImported, f.GedcomXref,
+					"Failed to link child to family %s: %v", f.GedcomXref, err)
 			}
 		}
 	}
+	progress.report("families", len(families), len(families))
 
 	// Import citations (after persons, families, and sources exist)
 	// Build source lookup map from XRef to ID
@@ -131,90 +195,113 @@ func (h *Handler) ImportGedcom(ctx context.Context, input ImportGedcomInput) (*I
 		sourceXrefToID[s.GedcomXref] = s.ID
 	}
 
-	for _, c := range citations {
+	for i, c := range citations {
+		progress.report("citations", i, len(citations))
 		// Resolve source XRef to ID
 		sourceID, ok := sourceXrefToID[c.SourceXref]
 		if !ok {
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Citation references unknown source %s", c.SourceXref))
-			continue
+			continue // dangling source pointer, reported by the importer
 		}
 
 		err := h.importCitation(ctx, c, sourceID)
 		if err != nil {
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Failed to import citation: %v", err))
+			result.addIssue(gedcom.ImportIssueError, gedcom.IssueNotImported, c.SourceXref,
+				"Failed to import citation: %v", err)
 			continue
 		}
 		result.CitationsImported++
 	}
+	progress.report("citations", len(citations), len(citations))
 
 	// Import events (after persons and families exist)
-	for _, e := range events {
+	for i, e := range events {
+		progress.report("events", i, len(events))
 		err := h.importEvent(ctx, e)
 		if err != nil {
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Failed to import event (%s): %v", e.FactType, err))
+			result.addIssue(gedcom.ImportIssueError, gedcom.IssueNotImported, "",
+				"Failed to import event (%s): %v", e.FactType, err)
 			continue
 		}
 		result.EventsImported++
 	}
+	progress.report("events", len(events), len(events))
 
 	// Import attributes (after persons exist)
-	for _, a := range attributes {
+	for i, a := range attributes {
+		progress.report("attributes", i, len(attributes))
 		err := h.importAttribute(ctx, a)
 		if err != nil {
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Failed to import attribute (%s): %v", a.FactType, err))
+			result.addIssue(gedcom.ImportIssueError, gedcom.IssueNotImported, "",
+				"Failed to import attribute (%s): %v", a.FactType, err)
 			continue
 		}
 		result.AttributesImported++
 	}
+	progress.report("attributes", len(attributes), len(attributes))
 
 	// Import shared notes
-	for _, n := range notes {
+	for i, n := range notes {
+		progress.report("notes", i, len(notes))
 		err := h.importNote(ctx, n)
 		if err != nil {
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Failed to import note (%s): %v", n.GedcomXref, err))
+			result.addIssue(gedcom.ImportIssueError, gedcom.IssueNotImported, n.GedcomXref,
+				"Failed to import note (%s): %v", n.GedcomXref, err)
 			continue
 		}
 		result.NotesImported++
 	}
+	progress.report("notes", len(notes), len(notes))
 
 	// Import submitters
-	for _, s := range submitters {
+	for i, s := range submitters {
+		progress.report("submitters", i, len(submitters))
 		err := h.importSubmitter(ctx, s)
 		if err != nil {
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Failed to import submitter (%s): %v", s.GedcomXref, err))
+			result.addIssue(gedcom.ImportIssueError, gedcom.IssueNotImported, s.GedcomXref,
+				"Failed to import submitter (%s): %v", s.GedcomXref, err)
 			continue
 		}
 		result.SubmittersImported++
 	}
+	progress.report("submitters", len(submitters), len(submitters))
 
 	// Import associations (after persons exist, since they reference PersonID and AssociateID)
-	for _, a := range associations {
+	for i, a := range associations {
+		progress.report("associations", i, len(associations))
 		err := h.importAssociation(ctx, a)
 		if err != nil {
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Failed to import association (%s -> %s, %s): %v",
-					a.PersonID.String(), a.AssociateID.String(), a.Role, err))
+			result.addIssue(gedcom.ImportIssueError, gedcom.IssueNotImported, "",
+				"Failed to import association (%s -> %s, %s): %v",
+				a.PersonID.String(), a.AssociateID.String(), a.Role, err)
 			continue
 		}
 		result.AssociationsImported++
 	}
+	progress.report("associations", len(associations), len(associations))
 
 	// Import LDS ordinances (after persons and families exist)
-	for _, o := range ldsOrdinances {
+	for i, o := range ldsOrdinances {
+		progress.report("lds_ordinances", i, len(ldsOrdinances))
 		err := h.importLDSOrdinance(ctx, o)
 		if err != nil {
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Failed to import LDS ordinance (%s): %v", o.Type, err))
+			result.addIssue(gedcom.ImportIssueError, gedcom.IssueNotImported, "",
+				"Failed to import LDS ordinance (%s): %v", o.Type, err)
 			continue
 		}
 		result.LDSOrdinancesImported++
 	}
+	progress.report("lds_ordinances", len(ldsOrdinances), len(ldsOrdinances))
+
+	// Import media (after persons, families, and sources exist). Only media
+	// whose file was supplied alongside the GEDCOM file can be ingested.
+	if input.MediaFiles != nil {
+		files := newMediaFileIndex(input.MediaFiles)
+		for i, m := range mediaObjects {
+			progress.report("media", i, len(mediaObjects))
+			result.MediaImported += h.importMedia(ctx, m, files, result)
+		}
+		progress.report("media", len(mediaObjects), len(mediaObjects))
+	}
 
 	// Record the import event
 	importEvent := domain.NewGedcomImported(
@@ -225,6 +312,7 @@ func (h *Handler) ImportGedcom(ctx context.Context, input ImportGedcomInput) (*I
 		result.Warnings,
 		result.Errors,
 	)
+	importEvent.ImportID = result.ImportID
 
 	// Store import event (using a special "import" stream)
 	_ = h.eventStore.Append(ctx, importEvent.ImportID, "import", []domain.Event{importEvent}, -1)
@@ -318,6 +406,7 @@ func (h *Handler) importPerson(ctx context.Context, p gedcom.PersonData) error {
 		personName.NameSuffix = nameData.NameSuffix
 		personName.SurnamePrefix = nameData.SurnamePrefix
 		personName.Nickname = nameData.Nickname
+		personName.Romanized = nameData.Romanized
 		personName.NameType = nameData.NameType
 		personName.IsPrimary = nameData.IsPrimary
 
@@ -687,6 +776,8 @@ func (h *Handler) importNote(ctx context.Context, n gedcom.NoteData) error {
 	note.SetGedcomXref(n.GedcomXref)
 	// Preserve GEDCOM 7.0 shared note (SNOTE) metadata when present.
 	note.SetSharedNoteMetadata(n.MIME, n.Language, n.Translations)
+	// Attach the note to the persons, families, and sources referencing it
+	note.Links = n.Links
 
 	// Create event
 	event := domain.NewNoteCreated(note)
@@ -701,6 +792,156 @@ func (h *Handler) importNote(ctx context.Context, n gedcom.NoteData) error {
 	return h.projector.Project(ctx, event, 1)
 }
 
+// importMedia creates a media record for each person, family, or source that
+// references a GEDCOM media object, reading the file from files. It returns
+// the number of media records created; problems are recorded as warnings.
+func (h *Handler) importMedia(ctx context.Context, m gedcom.MediaData, files *mediaFileIndex, result *ImportGedcomResult) int {
+	if len(m.Links) == 0 {
+		return 0
+	}
+	label := m.GedcomXref
+	if label == "" {
+		label = m.FileRef
+	}
+
+	refs := make([]string, 0, len(m.Files)+1)
+	for _, f := range m.Files {
+		refs = append(refs, f.Path)
+	}
+	refs = append(refs, m.FileRef)
+	maxSize := h.mediaSizeLimit()
+	var name string
+	var data []byte
+	for _, ref := range refs {
+		var err error
+		name, data, err = files.find(ref, maxSize)
+		if errors.Is(err, errMediaFileTooLarge) {
+			result.addIssue(gedcom.ImportIssueError, gedcom.IssueMediaTooLarge, m.GedcomXref,
+				"Media %s: file %s is larger than the %s limit", label, name, domain.FormatMediaFileSize(maxSize))
+			return 0
+		}
+		if data != nil {
+			break
+		}
+	}
+	if data == nil {
+		result.addIssue(gedcom.ImportIssueWarning, gedcom.IssueMediaNotFound, m.GedcomXref,
+			"Media %s: file %s not found among uploaded media", label, m.FileRef)
+		return 0
+	}
+
+	// Only an explicit MEDI overrides the type detected from the content
+	var mediaType string
+	if len(m.Files) > 0 && m.Files[0].MediaType != "" {
+		mediaType = string(m.MediaType)
+	}
+
+	imported := 0
+	for _, link := range m.Links {
+		title := link.Title
+		if title == "" {
+			title = m.Title
+		}
+		_, err := h.UploadMedia(ctx, UploadMediaInput{
+			EntityType: link.EntityType,
+			EntityID:   link.EntityID,
+			Title:      title,
+			MediaType:  mediaType,
+			Filename:   path.Base(name),
+			FileData:   data,
+			GedcomXref: m.GedcomXref,
+		})
+		if err != nil {
+			result.addIssue(gedcom.ImportIssueError, gedcom.IssueNotImported, m.GedcomXref,
+				"Failed to import media %s: %v", label, err)
+			continue
+		}
+		imported++
+	}
+	return imported
+}
+
+// mediaFileIndex resolves GEDCOM FILE references against uploaded media
+// files. References are often absolute paths from the exporting machine
+// ("C:\Photos\john.jpg"), so a reference that does not match a path in the
+// archive falls back to a unique match on the file name.
+type mediaFileIndex struct {
+	fsys   fs.FS
+	byName map[string][]string // lower-cased base name -> archive paths
+}
+
+func newMediaFileIndex(fsys fs.FS) *mediaFileIndex {
+	idx := &mediaFileIndex{fsys: fsys, byName: make(map[string][]string)}
+	_ = fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		name := strings.ToLower(path.Base(p))
+		idx.byName[name] = append(idx.byName[name], p)
+		return nil
+	})
+	return idx
+}
+
+// errMediaFileTooLarge is returned by mediaFileIndex.find for a file larger
+// than the media size limit.
+var errMediaFileTooLarge = errors.New("media file too large")
+
+// find returns the archive path and content of the file a reference points
+// to, or a nil slice when it cannot be resolved unambiguously. A file larger
+// than maxSize is not read; find returns its path and errMediaFileTooLarge.
+func (idx *mediaFileIndex) find(ref string, maxSize int64) (string, []byte, error) {
+	ref = strings.TrimPrefix(strings.ReplaceAll(ref, "\\", "/"), "file://")
+	if ref == "" {
+		return "", nil, nil
+	}
+	if p := strings.TrimLeft(ref, "/"); fs.ValidPath(p) {
+		if data, err := idx.read(p, maxSize); err == nil || errors.Is(err, errMediaFileTooLarge) {
+			return p, data, err
+		}
+	}
+	matches := idx.byName[strings.ToLower(path.Base(ref))]
+	if len(matches) != 1 {
+		return "", nil, nil
+	}
+	data, err := idx.read(matches[0], maxSize)
+	if errors.Is(err, errMediaFileTooLarge) {
+		return matches[0], nil, err
+	}
+	if err != nil {
+		return "", nil, nil
+	}
+	return matches[0], data, nil
+}
+
+// read returns the content of the file at p, or errMediaFileTooLarge when it
+// exceeds maxSize. The declared size (a zip entry's uncompressed size) is
+// checked before reading, and at most maxSize+1 bytes are read in case it
+// understates the content, so an archive cannot inflate past the limit.
+func (idx *mediaFileIndex) read(p string, maxSize int64) ([]byte, error) {
+	f, err := idx.fsys.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxSize {
+		return nil, errMediaFileTooLarge
+	}
+	data, err := io.ReadAll(io.LimitReader(f, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, errMediaFileTooLarge
+	}
+	return data, nil
+}
+
 // importSubmitter creates a submitter from GEDCOM data.
 func (h *Handler) importSubmitter(ctx context.Context, s gedcom.SubmitterData) error {
 	// Create submitter entity
@@ -1,10 +1,13 @@
 package command_test
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"io"
 	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/google/uuid"
 
@@ -122,6 +125,49 @@ func TestImportGedcom_ValidData(t *testing.T) {
 	}
 }
 
+func TestImportGedcom_RecordProgress(t *testing.T) {
+	handler := command.NewHandler(memory.NewEventStore(), memory.NewReadModelStore())
+
+	var reports []command.ImportRecordProgress
+	result, err := handler.ImportGedcom(context.Background(), command.ImportGedcomInput{
+		Filename: "test.ged",
+		FileSize: int64(len(minimalGedcom)),
+		Reader:   strings.NewReader(minimalGedcom),
+		OnRecordProgress: func(p command.ImportRecordProgress) {
+			reports = append(reports, p)
+		},
+	})
+	if err != nil {
+		t.Fatalf("ImportGedcom failed: %v", err)
+	}
+
+	// Each non-empty phase reports its start and its completion
+	want := []command.ImportRecordProgress{
+		{Phase: "persons", Done: 0, Total: 3},
+		{Phase: "persons", Done: 3, Total: 3},
+		{Phase: "families", Done: 0, Total: 1},
+		{Phase: "families", Done: 1, Total: 1},
+	}
+	var got []command.ImportRecordProgress
+	for _, r := range reports {
+		if r.ImportID != result.ImportID {
+			t.Errorf("ImportID = %v, want %v", r.ImportID, result.ImportID)
+		}
+		if r.Phase == "persons" || r.Phase == "families" {
+			r.ImportID = uuid.Nil
+			got = append(got, r)
+		}
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d person/family reports, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("report %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
 func TestImportGedcom_InvalidData(t *testing.T) {
 	eventStore := memory.NewEventStore()
 	readStore := memory.NewReadModelStore()
@@ -223,6 +269,46 @@ func TestImportGedcom_WarningsAndErrors(t *testing.T) {
 	}
 }
 
+func TestImportGedcom_DanglingSourceReported(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	gedcomData := `0 HEAD
+1 GEDC
+2 VERS 5.5.1
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME John /Doe/
+1 BIRT
+2 DATE 1900
+2 SOUR @S9@
+0 TRLR
+`
+	result, err := handler.ImportGedcom(ctx, command.ImportGedcomInput{
+		Filename: "dangling.ged",
+		FileSize: int64(len(gedcomData)),
+		Reader:   strings.NewReader(gedcomData),
+	})
+	if err != nil {
+		t.Fatalf("ImportGedcom failed: %v", err)
+	}
+
+	if result.CitationsImported != 0 {
+		t.Errorf("CitationsImported = %d, want 0", result.CitationsImported)
+	}
+	var broken []gedcom.ImportIssue
+	for _, issue := range result.Issues {
+		if issue.Code == gedcom.IssueBrokenXRef {
+			broken = append(broken, issue)
+		}
+	}
+	if len(broken) != 1 || broken[0].Context != "@I1@" || broken[0].Line != 9 {
+		t.Errorf("BROKEN_XREF issues = %+v, want one for @I1@ at line 9", broken)
+	}
+}
+
 func TestImportPerson_Success(t *testing.T) {
 	eventStore := memory.NewEventStore()
 	readStore := memory.NewReadModelStore()
@@ -2099,3 +2185,161 @@ func TestImportGedcom_ExternalIDs(t *testing.T) {
 		t.Errorf("unexpected second external id: %+v", ids[1])
 	}
 }
+
+// TestImportGedcom_WithMediaFiles tests that OBJE references are imported as
+// media linked to the referencing records when the files are supplied.
+func TestImportGedcom_WithMediaFiles(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	gedcomWithMedia := `0 HEAD
+1 GEDC
+2 VERS 5.5.1
+1 CHAR UTF-8
+0 @M1@ OBJE
+1 FILE C:\Users\me\Photos\portrait.jpg
+2 FORM jpg
+2 TITL Wedding portrait
+0 @I1@ INDI
+1 NAME John /Doe/
+1 OBJE @M1@
+1 OBJE
+2 FILE photos/grave.jpg
+3 FORM jpg
+0 @I2@ INDI
+1 NAME Jane /Doe/
+1 OBJE @M1@
+0 @F1@ FAM
+1 HUSB @I1@
+1 WIFE @I2@
+1 OBJE
+2 FILE missing.jpg
+0 TRLR
+`
+
+	jpegData := createTestJPEG()
+	input := command.ImportGedcomInput{
+		Filename: "media.ged",
+		FileSize: int64(len(gedcomWithMedia)),
+		Reader:   strings.NewReader(gedcomWithMedia),
+		MediaFiles: fstest.MapFS{
+			"export/portrait.jpg":     {Data: jpegData},
+			"export/photos/grave.jpg": {Data: jpegData},
+			"photos/grave.jpg":        {Data: jpegData},
+		},
+	}
+
+	result, err := handler.ImportGedcom(ctx, input)
+	if err != nil {
+		t.Fatalf("ImportGedcom failed: %v", err)
+	}
+
+	// The shared portrait is linked to both persons, plus John's inline photo
+	if result.MediaImported != 3 {
+		t.Errorf("MediaImported = %d, want 3", result.MediaImported)
+	}
+
+	persons, _, err := readStore.ListPersons(ctx, repository.DefaultListOptions())
+	if err != nil {
+		t.Fatalf("ListPersons failed: %v", err)
+	}
+	portraits := 0
+	for _, p := range persons {
+		media, _, err := readStore.ListMediaForEntity(ctx, "person", p.ID, repository.DefaultListOptions())
+		if err != nil {
+			t.Fatalf("ListMediaForEntity failed: %v", err)
+		}
+		want := 1
+		if p.GivenName == "John" {
+			want = 2
+		}
+		if len(media) != want {
+			t.Errorf("%s has %d media, want %d", p.GivenName, len(media), want)
+		}
+		for _, m := range media {
+			if m.GedcomXref == "@M1@" {
+				portraits++
+				if m.Title != "Wedding portrait" {
+					t.Errorf("Title = %q, want Wedding portrait", m.Title)
+				}
+			}
+		}
+	}
+	if portraits != 2 {
+		t.Errorf("portrait linked %d times, want 2", portraits)
+	}
+
+	found := false
+	for _, w := range result.Warnings {
+		if strings.Contains(w, "missing.jpg not found") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected warning for missing media file, got %v", result.Warnings)
+	}
+}
+
+// TestImportGedcom_MediaFileTooLarge tests that archive entries larger than
+// the media size limit are reported as issues without being read.
+func TestImportGedcom_MediaFileTooLarge(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore, command.WithMaxMediaSize(1024))
+	ctx := context.Background()
+
+	gedcomWithMedia := `0 HEAD
+1 GEDC
+2 VERS 5.5.1
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME John /Doe/
+1 OBJE
+2 FILE bomb.jpg
+3 FORM jpg
+0 TRLR
+`
+
+	// A megabyte of zeros compresses to about a kilobyte, so the archive
+	// itself is small
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("bomb.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(make([]byte, 1<<20)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := handler.ImportGedcom(ctx, command.ImportGedcomInput{
+		Filename:   "media.ged",
+		FileSize:   int64(len(gedcomWithMedia)),
+		Reader:     strings.NewReader(gedcomWithMedia),
+		MediaFiles: archive,
+	})
+	if err != nil {
+		t.Fatalf("ImportGedcom failed: %v", err)
+	}
+	if result.MediaImported != 0 {
+		t.Errorf("MediaImported = %d, want 0", result.MediaImported)
+	}
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Code == gedcom.IssueMediaTooLarge && strings.Contains(issue.Message, "bomb.jpg") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected %s issue, got %+v", gedcom.IssueMediaTooLarge, result.Issues)
+	}
+}
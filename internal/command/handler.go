@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -18,6 +20,8 @@ var (
 	ErrRollbackInvalidVersion = errors.New("invalid rollback version: must be positive and less than current version")
 	ErrRollbackDeletedEntity  = errors.New("cannot rollback a deleted entity")
 	ErrRollbackNoChanges      = errors.New("rollback to current version is a no-op")
+	ErrRestoreNotDeleted      = errors.New("entity is not deleted")
+	ErrRestoreMissingRef      = errors.New("entity references a record that no longer exists")
 )
 
 // RollbackResult contains the result of a rollback operation.
@@ -34,23 +38,86 @@ type Handler struct {
 	readStore       repository.ReadModelStore
 	projector       *repository.Projector
 	rollbackService *query.RollbackService
+	snapshots       repository.StreamSnapshotStore // nil disables automatic snapshots
+	snapshotEvery   int64
+	thumbnailSize   int                       // 0 uses media.MaxThumbnailSize
+	mediaBlobs      repository.MediaBlobStore // nil keeps media content inline in events
+	maxMediaSize    int64                     // 0 uses domain.DefaultMaxMediaFileSize
+}
+
+// HandlerOption configures optional command handler behavior.
+type HandlerOption func(*Handler)
+
+// WithStreamSnapshots enables automatic stream snapshots. After a command moves
+// a stream across a multiple of every events, the handler stores a snapshot of
+// the stream's state so later reconstruction can start from it. A non-positive
+// every disables snapshotting.
+func WithStreamSnapshots(snapshots repository.StreamSnapshotStore, every int) HandlerOption {
+	return func(h *Handler) {
+		if every <= 0 {
+			return
+		}
+		h.snapshots = snapshots
+		h.snapshotEvery = int64(every)
+		h.rollbackService = query.NewRollbackServiceWithSnapshots(h.eventStore, h.readStore, snapshots)
+	}
+}
+
+// WithThumbnailSize sets the maximum width and height of the thumbnail stored
+// for uploaded media. A non-positive size keeps media.MaxThumbnailSize.
+func WithThumbnailSize(size int) HandlerOption {
+	return func(h *Handler) {
+		if size > 0 {
+			h.thumbnailSize = size
+		}
+	}
+}
+
+// mediaSizeLimit returns the largest media upload accepted, in bytes.
+func (h *Handler) mediaSizeLimit() int64 {
+	if h.maxMediaSize == 0 {
+		return domain.DefaultMaxMediaFileSize
+	}
+	return h.maxMediaSize
+}
+
+// WithMaxMediaSize sets the largest media upload accepted, in bytes. A
+// non-positive size keeps domain.DefaultMaxMediaFileSize.
+func WithMaxMediaSize(size int64) HandlerOption {
+	return func(h *Handler) {
+		if size > 0 {
+			h.maxMediaSize = size
+		}
+	}
+}
+
+// WithMediaBlobStore stores uploaded media content in blobs, keyed by content
+// hash, instead of inline in MediaCreated events and the read model.
+func WithMediaBlobStore(blobs repository.MediaBlobStore) HandlerOption {
+	return func(h *Handler) {
+		h.mediaBlobs = blobs
+	}
 }
 
 // NewHandler creates a new command handler.
-func NewHandler(eventStore repository.EventStore, readStore repository.ReadModelStore) *Handler {
-	return &Handler{
-		eventStore:      eventStore,
+func NewHandler(eventStore repository.EventStore, readStore repository.ReadModelStore, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		eventStore:      actorEventStore{eventStore},
 		readStore:       readStore,
 		projector:       repository.NewProjector(readStore),
 		rollbackService: query.NewRollbackService(eventStore, readStore),
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // NewHandlerWithRollbackService creates a new command handler with a custom rollback service.
 // This is primarily useful for testing.
 func NewHandlerWithRollbackService(eventStore repository.EventStore, readStore repository.ReadModelStore, rollbackService *query.RollbackService) *Handler {
 	return &Handler{
-		eventStore:      eventStore,
+		eventStore:      actorEventStore{eventStore},
 		readStore:       readStore,
 		projector:       repository.NewProjector(readStore),
 		rollbackService: rollbackService,
@@ -83,9 +150,26 @@ func (h *Handler) execute(ctx context.Context, streamID string, streamType strin
 		}
 	}
 
+	h.maybeSnapshot(ctx, id, streamType, max(expectedVersion, 0), newVersion)
+
 	return newVersion, nil
 }
 
+// maybeSnapshot stores a stream snapshot when the append moved the stream
+// across a snapshot threshold. Snapshots are an optimization, so failures are
+// ignored and reconstruction falls back to replaying events.
+func (h *Handler) maybeSnapshot(ctx context.Context, streamID uuid.UUID, streamType string, oldVersion, newVersion int64) {
+	if h.snapshots == nil || newVersion/h.snapshotEvery == oldVersion/h.snapshotEvery {
+		return
+	}
+
+	snapshot, err := h.rollbackService.BuildStreamSnapshot(ctx, streamType, streamID, newVersion)
+	if err != nil {
+		return
+	}
+	_ = h.snapshots.SaveStreamSnapshot(ctx, snapshot)
+}
+
 // RollbackPerson rolls back a person to a specific version.
 // It computes the changes needed and generates a compensating PersonUpdated event.
 func (h *Handler) RollbackPerson(ctx context.Context, personID uuid.UUID, targetVersion int64) (*RollbackResult, error) {
@@ -214,3 +298,196 @@ func (h *Handler) rollbackEntity(ctx context.Context, entityType string, entityI
 		Changes:    changes.Changes,
 	}, nil
 }
+
+// RestoreDeleted recreates a deleted person, family, source, or citation with
+// the state it had just before it was deleted. The restore appends the
+// entity's original creation event followed by an update carrying every change
+// made between its creation and deletion, so the stream's history is kept. A
+// person's name and external ID events from that span are appended again too.
+func (h *Handler) RestoreDeleted(ctx context.Context, entityID uuid.UUID) (*RollbackResult, error) {
+	events, err := h.eventStore.ReadStream(ctx, entityID)
+	if err != nil {
+		if errors.Is(err, repository.ErrStreamNotFound) {
+			return nil, query.ErrNoEvents
+		}
+		return nil, fmt.Errorf("reading event stream: %w", err)
+	}
+	if len(events) == 0 {
+		return nil, query.ErrNoEvents
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Version < events[j].Version
+	})
+
+	deletion := events[len(events)-1]
+	entityType, ok := strings.CutSuffix(deletion.EventType, "Deleted")
+	if !ok || !restorableTypes[entityType] {
+		return nil, ErrRestoreNotDeleted
+	}
+
+	// Find the creation that the deletion ended
+	var creation *repository.StoredEvent
+	for i := len(events) - 2; i >= 0; i-- {
+		if events[i].EventType == entityType+"Created" {
+			creation = &events[i]
+			break
+		}
+	}
+	if creation == nil {
+		return nil, query.ErrNoEvents
+	}
+
+	created, err := creation.DecodeEvent()
+	if err != nil {
+		return nil, fmt.Errorf("decoding creation event: %w", err)
+	}
+	changes, err := h.rollbackService.ComputeChangesBetween(ctx, entityType, entityID, creation.Version, deletion.Version-1)
+	if err != nil {
+		return nil, err
+	}
+	// Children were unlinked before the family could be deleted
+	delete(changes, "children")
+
+	if err := h.checkRestoreReferences(ctx, created, changes); err != nil {
+		return nil, err
+	}
+
+	restored := []domain.Event{}
+	switch e := created.(type) {
+	case domain.PersonCreated:
+		e.BaseEvent = domain.NewBaseEvent()
+		restored = append(restored, e)
+		if len(changes) > 0 {
+			restored = append(restored, domain.NewPersonUpdated(entityID, changes))
+		}
+		// Deleting the person dropped their names and external IDs, which
+		// live outside the person's fields, so replay the events that built
+		// them
+		for _, evt := range events[:len(events)-1] {
+			if evt.Version <= creation.Version {
+				continue
+			}
+			decoded, err := evt.DecodeEvent()
+			if err != nil {
+				return nil, fmt.Errorf("decoding %s event: %w", evt.EventType, err)
+			}
+			switch d := decoded.(type) {
+			case domain.NameAdded:
+				d.BaseEvent = domain.NewBaseEvent()
+				restored = append(restored, d)
+			case domain.NameUpdated:
+				d.BaseEvent = domain.NewBaseEvent()
+				restored = append(restored, d)
+			case domain.NameRemoved:
+				d.BaseEvent = domain.NewBaseEvent()
+				restored = append(restored, d)
+			case domain.PersonExternalIDAdded:
+				d.BaseEvent = domain.NewBaseEvent()
+				restored = append(restored, d)
+			}
+		}
+	case domain.FamilyCreated:
+		e.BaseEvent = domain.NewBaseEvent()
+		restored = append(restored, e)
+		if len(changes) > 0 {
+			restored = append(restored, domain.NewFamilyUpdated(entityID, changes))
+		}
+	case domain.SourceCreated:
+		e.BaseEvent = domain.NewBaseEvent()
+		restored = append(restored, e)
+		if len(changes) > 0 {
+			restored = append(restored, domain.NewSourceUpdated(entityID, changes))
+		}
+	case domain.CitationCreated:
+		e.BaseEvent = domain.NewBaseEvent()
+		restored = append(restored, e)
+		if len(changes) > 0 {
+			restored = append(restored, domain.NewCitationUpdated(entityID, changes))
+		}
+	}
+
+	newVersion, err := h.execute(ctx, entityID.String(), deletion.StreamType, restored, deletion.Version)
+	if err != nil {
+		return nil, fmt.Errorf("executing restore: %w", err)
+	}
+
+	return &RollbackResult{
+		EntityID:   entityID,
+		EntityType: entityType,
+		NewVersion: newVersion,
+		Changes:    changes,
+	}, nil
+}
+
+// restorableTypes are the entity types RestoreDeleted can recreate.
+var restorableTypes = map[string]bool{
+	"Person":   true,
+	"Family":   true,
+	"Source":   true,
+	"Citation": true,
+}
+
+// checkRestoreReferences returns ErrRestoreMissingRef when a family's partners
+// or a citation's source or fact owner were deleted after the entity was.
+func (h *Handler) checkRestoreReferences(ctx context.Context, created domain.Event, changes map[string]any) error {
+	// ref returns the referenced ID, preferring the value from later changes
+	ref := func(field string, original *uuid.UUID) *uuid.UUID {
+		value, changed := changes[field]
+		if !changed {
+			return original
+		}
+		s, _ := value.(string)
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil
+		}
+		return &id
+	}
+	personExists := func(id *uuid.UUID) error {
+		if id == nil {
+			return nil
+		}
+		person, err := h.readStore.GetPerson(ctx, *id)
+		if err != nil {
+			return err
+		}
+		if person == nil {
+			return ErrRestoreMissingRef
+		}
+		return nil
+	}
+
+	switch e := created.(type) {
+	case domain.FamilyCreated:
+		if err := personExists(ref("partner1_id", e.Partner1ID)); err != nil {
+			return err
+		}
+		return personExists(ref("partner2_id", e.Partner2ID))
+	case domain.CitationCreated:
+		sourceID := ref("source_id", &e.SourceID)
+		if sourceID == nil {
+			return ErrRestoreMissingRef
+		}
+		source, err := h.readStore.GetSource(ctx, *sourceID)
+		if err != nil {
+			return err
+		}
+		if source == nil {
+			return ErrRestoreMissingRef
+		}
+		owner := ref("fact_owner_id", &e.FactOwnerID)
+		switch {
+		case strings.HasPrefix(string(e.FactType), "person_"):
+			return personExists(owner)
+		case strings.HasPrefix(string(e.FactType), "family_") && owner != nil:
+			family, err := h.readStore.GetFamily(ctx, *owner)
+			if err != nil {
+				return err
+			}
+			if family == nil {
+				return ErrRestoreMissingRef
+			}
+		}
+	}
+	return nil
+}
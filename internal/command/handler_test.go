@@ -44,6 +44,38 @@ func TestNewHandler(t *testing.T) {
 	}
 }
 
+func TestHandler_AttributesActor(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+
+	ctx := command.WithActor(context.Background(), "cousin")
+	result, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+	unattributed, err := handler.CreatePerson(context.Background(), command.CreatePersonInput{GivenName: "Jane", Surname: "Doe"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+
+	events, err := eventStore.ReadStream(context.Background(), result.ID)
+	if err != nil || len(events) != 1 {
+		t.Fatalf("ReadStream = %d events, %v; want 1", len(events), err)
+	}
+	if actor := events[0].Actor(); actor != "cousin" {
+		t.Errorf("Actor = %q, want cousin", actor)
+	}
+
+	events, err = eventStore.ReadStream(context.Background(), unattributed.ID)
+	if err != nil || len(events) != 1 {
+		t.Fatalf("ReadStream = %d events, %v; want 1", len(events), err)
+	}
+	if actor := events[0].Actor(); actor != "" {
+		t.Errorf("Actor = %q, want unattributed", actor)
+	}
+}
+
 func TestHandler_Execute_ValidEvents(t *testing.T) {
 	eventStore := memory.NewEventStore()
 	readStore := memory.NewReadModelStore()
@@ -1355,3 +1387,277 @@ func TestRollbackPerson_NoChangesRequiredFromState(t *testing.T) {
 		t.Errorf("Expected NewVersion=2 (no change), got %d", result.NewVersion)
 	}
 }
+
+func TestHandler_StreamSnapshots(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	snapshots := memory.NewStreamSnapshotStore()
+	handler := command.NewHandler(eventStore, readStore, command.WithStreamSnapshots(snapshots, 2))
+	ctx := context.Background()
+
+	createResult, err := handler.CreatePerson(ctx, command.CreatePersonInput{
+		GivenName: "John",
+		Surname:   "Doe",
+	})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+
+	// Versions 2..5
+	version := createResult.Version
+	for _, name := range []string{"Jane", "Jim", "Joan", "Jack"} {
+		givenName := name
+		result, err := handler.UpdatePerson(ctx, command.UpdatePersonInput{
+			ID:        createResult.ID,
+			GivenName: &givenName,
+			Version:   version,
+		})
+		if err != nil {
+			t.Fatalf("UpdatePerson failed: %v", err)
+		}
+		version = result.Version
+	}
+
+	// Snapshots are taken at versions 2 and 4, not at 5
+	snapshot, err := snapshots.GetLatestStreamSnapshot(ctx, createResult.ID, version)
+	if err != nil {
+		t.Fatalf("GetLatestStreamSnapshot failed: %v", err)
+	}
+	if snapshot.Version != 4 {
+		t.Errorf("latest snapshot version = %d, want 4", snapshot.Version)
+	}
+	if snapshot.State["given_name"] != "Joan" {
+		t.Errorf("snapshot given_name = %v, want Joan", snapshot.State["given_name"])
+	}
+
+	// Snapshot-based reconstruction matches a full replay at every version
+	withSnapshots := query.NewRollbackServiceWithSnapshots(eventStore, readStore, snapshots)
+	fullReplay := query.NewRollbackService(eventStore, readStore)
+	for v := int64(1); v <= version; v++ {
+		got, err := withSnapshots.GetStateAtVersion(ctx, "Person", createResult.ID, v)
+		if err != nil {
+			t.Fatalf("GetStateAtVersion(%d) with snapshots failed: %v", v, err)
+		}
+		want, err := fullReplay.GetStateAtVersion(ctx, "Person", createResult.ID, v)
+		if err != nil {
+			t.Fatalf("GetStateAtVersion(%d) failed: %v", v, err)
+		}
+		if got.State["given_name"] != want.State["given_name"] {
+			t.Errorf("version %d: given_name = %v, want %v", v, got.State["given_name"], want.State["given_name"])
+		}
+	}
+
+	// Versions beyond the stream are still rejected
+	if _, err := withSnapshots.GetStateAtVersion(ctx, "Person", createResult.ID, version+1); !errors.Is(err, query.ErrInvalidVersion) {
+		t.Errorf("expected ErrInvalidVersion, got %v", err)
+	}
+
+	// Rollback resumes from the snapshot
+	rollbackResult, err := handler.RollbackPerson(ctx, createResult.ID, 3)
+	if err != nil {
+		t.Fatalf("RollbackPerson failed: %v", err)
+	}
+	if rollbackResult.Changes["given_name"] != "Jim" {
+		t.Errorf("given_name change = %v, want Jim", rollbackResult.Changes["given_name"])
+	}
+}
+
+func TestHandler_StreamSnapshots_FamilyChildren(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	snapshots := memory.NewStreamSnapshotStore()
+	handler := command.NewHandler(eventStore, readStore, command.WithStreamSnapshots(snapshots, 2))
+	ctx := context.Background()
+
+	partner, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+	familyResult, err := handler.CreateFamily(ctx, command.CreateFamilyInput{
+		Partner1ID:       &partner.ID,
+		RelationshipType: "marriage",
+	})
+	if err != nil {
+		t.Fatalf("CreateFamily failed: %v", err)
+	}
+
+	var childIDs []uuid.UUID
+	for _, name := range []string{"Alice", "Bob"} {
+		child, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: name, Surname: "Doe"})
+		if err != nil {
+			t.Fatalf("CreatePerson failed: %v", err)
+		}
+		if _, err := handler.LinkChild(ctx, command.LinkChildInput{FamilyID: familyResult.ID, ChildID: child.ID}); err != nil {
+			t.Fatalf("LinkChild failed: %v", err)
+		}
+		childIDs = append(childIDs, child.ID)
+	}
+
+	// Version 4 crosses the threshold again and is built from the version 2 snapshot
+	if err := handler.UnlinkChild(ctx, command.UnlinkChildInput{FamilyID: familyResult.ID, ChildID: childIDs[0]}); err != nil {
+		t.Fatalf("UnlinkChild failed: %v", err)
+	}
+
+	service := query.NewRollbackServiceWithSnapshots(eventStore, readStore, snapshots)
+	state, err := service.GetStateAtVersion(ctx, "Family", familyResult.ID, 4)
+	if err != nil {
+		t.Fatalf("GetStateAtVersion failed: %v", err)
+	}
+	children, ok := state.State["children"].([]string)
+	if !ok {
+		t.Fatalf("children = %T, want []string", state.State["children"])
+	}
+	if len(children) != 1 || children[0] != childIDs[1].String() {
+		t.Errorf("children = %v, want [%s]", children, childIDs[1])
+	}
+}
+
+func TestHandler_StreamSnapshots_Disabled(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	snapshots := memory.NewStreamSnapshotStore()
+	handler := command.NewHandler(eventStore, readStore, command.WithStreamSnapshots(snapshots, 0))
+	ctx := context.Background()
+
+	createResult, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+
+	if _, err := snapshots.GetLatestStreamSnapshot(ctx, createResult.ID, 1); !errors.Is(err, repository.ErrStreamSnapshotNotFound) {
+		t.Errorf("expected ErrStreamSnapshotNotFound, got %v", err)
+	}
+}
+
+// Restore tests
+
+func TestRestoreDeleted_Person(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	created, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+	for _, name := range []command.AddNameInput{
+		{PersonID: created.ID, GivenName: "John", Surname: "Doe", IsPrimary: true},
+		{PersonID: created.ID, GivenName: "Johnny", Surname: "Doe", NameType: "aka"},
+	} {
+		if _, err := handler.AddName(ctx, name); err != nil {
+			t.Fatalf("AddName failed: %v", err)
+		}
+	}
+	if _, err := handler.AddPersonExternalID(ctx, command.AddPersonExternalIDInput{PersonID: created.ID, Value: "LZ7X-1K9"}); err != nil {
+		t.Fatalf("AddPersonExternalID failed: %v", err)
+	}
+	place := "Boston"
+	updated, err := handler.UpdatePerson(ctx, command.UpdatePersonInput{
+		ID:         created.ID,
+		BirthPlace: &place,
+		Version:    4,
+	})
+	if err != nil {
+		t.Fatalf("UpdatePerson failed: %v", err)
+	}
+	if err := handler.DeletePerson(ctx, command.DeletePersonInput{ID: created.ID, Version: updated.Version}); err != nil {
+		t.Fatalf("DeletePerson failed: %v", err)
+	}
+
+	result, err := handler.RestoreDeleted(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("RestoreDeleted failed: %v", err)
+	}
+	if result.EntityType != "Person" {
+		t.Errorf("EntityType = %s, want Person", result.EntityType)
+	}
+	// Created, two names, an external ID, updated, deleted, then the
+	// recreation, its update, and the replayed names and external ID
+	if result.NewVersion != 11 {
+		t.Errorf("NewVersion = %d, want 11", result.NewVersion)
+	}
+
+	person, err := readStore.GetPerson(ctx, created.ID)
+	if err != nil || person == nil {
+		t.Fatalf("GetPerson after restore = %v, %v; want the person", person, err)
+	}
+	if person.GivenName != "John" || person.BirthPlace != "Boston" {
+		t.Errorf("Restored person = %s / %s, want John / Boston", person.GivenName, person.BirthPlace)
+	}
+	if person.Version != 11 {
+		t.Errorf("Restored version = %d, want 11", person.Version)
+	}
+
+	names, err := query.NewPersonService(readStore).GetPersonNames(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetPersonNames after restore failed: %v", err)
+	}
+	if len(names) != 2 {
+		t.Fatalf("Restored names = %+v, want 2", names)
+	}
+	for _, n := range names {
+		if n.IsPrimary != (n.GivenName == "John") {
+			t.Errorf("Restored name %s: IsPrimary = %v", n.GivenName, n.IsPrimary)
+		}
+	}
+	ids, err := readStore.GetPersonExternalIDs(ctx, created.ID)
+	if err != nil || len(ids) != 1 || ids[0].Value != "LZ7X-1K9" {
+		t.Errorf("Restored external IDs = %+v, %v; want LZ7X-1K9", ids, err)
+	}
+
+	// Restoring again is refused: the person is no longer deleted
+	if _, err := handler.RestoreDeleted(ctx, created.ID); !errors.Is(err, command.ErrRestoreNotDeleted) {
+		t.Errorf("Second restore error = %v, want ErrRestoreNotDeleted", err)
+	}
+}
+
+func TestRestoreDeleted_NotFound(t *testing.T) {
+	handler := command.NewHandler(memory.NewEventStore(), memory.NewReadModelStore())
+
+	_, err := handler.RestoreDeleted(context.Background(), uuid.New())
+	if !errors.Is(err, query.ErrNoEvents) {
+		t.Errorf("Error = %v, want ErrNoEvents", err)
+	}
+}
+
+func TestRestoreDeleted_FamilyWithDeletedPartner(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	partner, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+	family, err := handler.CreateFamily(ctx, command.CreateFamilyInput{Partner1ID: &partner.ID})
+	if err != nil {
+		t.Fatalf("CreateFamily failed: %v", err)
+	}
+	if err := handler.DeleteFamily(ctx, command.DeleteFamilyInput{ID: family.ID, Version: family.Version}); err != nil {
+		t.Fatalf("DeleteFamily failed: %v", err)
+	}
+	if err := handler.DeletePerson(ctx, command.DeletePersonInput{ID: partner.ID, Version: partner.Version}); err != nil {
+		t.Fatalf("DeletePerson failed: %v", err)
+	}
+
+	if _, err := handler.RestoreDeleted(ctx, family.ID); !errors.Is(err, command.ErrRestoreMissingRef) {
+		t.Fatalf("Restore family error = %v, want ErrRestoreMissingRef", err)
+	}
+
+	// Restoring the partner first lets the family come back
+	if _, err := handler.RestoreDeleted(ctx, partner.ID); err != nil {
+		t.Fatalf("Restore partner failed: %v", err)
+	}
+	if _, err := handler.RestoreDeleted(ctx, family.ID); err != nil {
+		t.Fatalf("Restore family failed: %v", err)
+	}
+	restored, err := readStore.GetFamily(ctx, family.ID)
+	if err != nil || restored == nil {
+		t.Fatalf("GetFamily after restore = %v, %v; want the family", restored, err)
+	}
+	if restored.Partner1ID == nil || *restored.Partner1ID != partner.ID {
+		t.Errorf("Partner1ID = %v, want %s", restored.Partner1ID, partner.ID)
+	}
+}
@@ -59,7 +59,7 @@ func (h *Handler) CreateLDSOrdinance(ctx context.Context, input CreateLDSOrdinan
 
 	// Validate ordinance
 	if err := ordinance.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/domain"
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// Life event and attribute errors.
+var (
+	ErrLifeEventNotFound = errors.New("life event not found")
+	ErrAttributeNotFound = errors.New("attribute not found")
+)
+
+// SetResearchStatusInput contains the data for setting the research status
+// of a life event or attribute.
+type SetResearchStatusInput struct {
+	ID             uuid.UUID
+	ResearchStatus string
+	Version        int64
+}
+
+// SetResearchStatusResult contains the result of setting a research status.
+type SetResearchStatusResult struct {
+	Version int64
+}
+
+// SetLifeEventResearchStatus records how confident the researcher is in a
+// person or family event, such as a tentative marriage date.
+func (h *Handler) SetLifeEventResearchStatus(ctx context.Context, input SetResearchStatusInput) (*SetResearchStatusResult, error) {
+	current, err := h.readStore.GetEvent(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getting life event: %w", err)
+	}
+	if current == nil {
+		return nil, ErrLifeEventNotFound
+	}
+
+	rs := domain.ResearchStatus(input.ResearchStatus)
+	if !rs.IsValid() {
+		return nil, fmt.Errorf("%w: research_status: invalid value: %s", ErrInvalidInput, rs)
+	}
+
+	// Check version for optimistic locking
+	if current.Version != input.Version {
+		return nil, repository.ErrConcurrencyConflict
+	}
+	if rs == current.ResearchStatus {
+		return &SetResearchStatusResult{Version: current.Version}, nil
+	}
+
+	event := domain.NewLifeEventUpdated(input.ID, map[string]any{"research_status": string(rs)})
+	version, err := h.execute(ctx, input.ID.String(), "event", []domain.Event{event}, input.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetResearchStatusResult{Version: version}, nil
+}
+
+// SetAttributeResearchStatus records how confident the researcher is in a
+// person attribute, such as an occupation.
+func (h *Handler) SetAttributeResearchStatus(ctx context.Context, input SetResearchStatusInput) (*SetResearchStatusResult, error) {
+	current, err := h.readStore.GetAttribute(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getting attribute: %w", err)
+	}
+	if current == nil {
+		return nil, ErrAttributeNotFound
+	}
+
+	rs := domain.ResearchStatus(input.ResearchStatus)
+	if !rs.IsValid() {
+		return nil, fmt.Errorf("%w: research_status: invalid value: %s", ErrInvalidInput, rs)
+	}
+
+	// Check version for optimistic locking
+	if current.Version != input.Version {
+		return nil, repository.ErrConcurrencyConflict
+	}
+	if rs == current.ResearchStatus {
+		return &SetResearchStatusResult{Version: current.Version}, nil
+	}
+
+	event := domain.NewAttributeUpdated(input.ID, map[string]any{"research_status": string(rs)})
+	version, err := h.execute(ctx, input.ID.String(), "attribute", []domain.Event{event}, input.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetResearchStatusResult{Version: version}, nil
+}
@@ -0,0 +1,108 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/domain"
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/memory"
+)
+
+func TestSetLifeEventResearchStatus(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	le := domain.NewFamilyLifeEvent(uuid.New(), domain.FactFamilyMarriage)
+	le.SetDate("12 JUN 1902")
+	created := domain.NewLifeEventCreatedFromModel(le)
+	if err := eventStore.Append(ctx, le.ID, "event", []domain.Event{created}, -1); err != nil {
+		t.Fatal(err)
+	}
+	if err := repository.NewProjector(readStore).Project(ctx, created, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := handler.SetLifeEventResearchStatus(ctx, command.SetResearchStatusInput{
+		ID:             le.ID,
+		ResearchStatus: "possible",
+		Version:        1,
+	})
+	if err != nil {
+		t.Fatalf("SetLifeEventResearchStatus failed: %v", err)
+	}
+	if result.Version != 2 {
+		t.Errorf("Version = %d, want 2", result.Version)
+	}
+	event, _ := readStore.GetEvent(ctx, le.ID)
+	if event.ResearchStatus != domain.ResearchStatusPossible {
+		t.Errorf("ResearchStatus = %q, want possible", event.ResearchStatus)
+	}
+
+	_, err = handler.SetLifeEventResearchStatus(ctx, command.SetResearchStatusInput{ID: le.ID, ResearchStatus: "certain", Version: 1})
+	if !errors.Is(err, repository.ErrConcurrencyConflict) {
+		t.Errorf("stale version error = %v, want ErrConcurrencyConflict", err)
+	}
+	_, err = handler.SetLifeEventResearchStatus(ctx, command.SetResearchStatusInput{ID: le.ID, ResearchStatus: "definitely", Version: 2})
+	if !errors.Is(err, command.ErrInvalidInput) {
+		t.Errorf("invalid status error = %v, want ErrInvalidInput", err)
+	}
+	_, err = handler.SetLifeEventResearchStatus(ctx, command.SetResearchStatusInput{ID: uuid.New(), ResearchStatus: "certain", Version: 1})
+	if !errors.Is(err, command.ErrLifeEventNotFound) {
+		t.Errorf("missing event error = %v, want ErrLifeEventNotFound", err)
+	}
+}
+
+func TestSetAttributeResearchStatus(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	person, _ := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	attr := domain.NewAttribute(person.ID, domain.FactPersonOccupation, "Blacksmith")
+	attr.ResearchStatus = domain.ResearchStatusProbable
+	created := domain.NewAttributeCreatedFromModel(attr)
+	if err := eventStore.Append(ctx, attr.ID, "attribute", []domain.Event{created}, -1); err != nil {
+		t.Fatal(err)
+	}
+	if err := repository.NewProjector(readStore).Project(ctx, created, 1); err != nil {
+		t.Fatal(err)
+	}
+	stored, _ := readStore.GetAttribute(ctx, attr.ID)
+	if stored.ResearchStatus != domain.ResearchStatusProbable {
+		t.Errorf("created ResearchStatus = %q, want probable", stored.ResearchStatus)
+	}
+
+	result, err := handler.SetAttributeResearchStatus(ctx, command.SetResearchStatusInput{
+		ID:             attr.ID,
+		ResearchStatus: "certain",
+		Version:        1,
+	})
+	if err != nil {
+		t.Fatalf("SetAttributeResearchStatus failed: %v", err)
+	}
+	if result.Version != 2 {
+		t.Errorf("Version = %d, want 2", result.Version)
+	}
+	stored, _ = readStore.GetAttribute(ctx, attr.ID)
+	if stored.ResearchStatus != domain.ResearchStatusCertain {
+		t.Errorf("ResearchStatus = %q, want certain", stored.ResearchStatus)
+	}
+
+	// Setting the current status is a no-op
+	result, err = handler.SetAttributeResearchStatus(ctx, command.SetResearchStatusInput{ID: attr.ID, ResearchStatus: "certain", Version: 2})
+	if err != nil || result.Version != 2 {
+		t.Errorf("no-op = (%+v, %v), want version 2", result, err)
+	}
+	_, err = handler.SetAttributeResearchStatus(ctx, command.SetResearchStatusInput{ID: uuid.New(), ResearchStatus: "certain", Version: 1})
+	if !errors.Is(err, command.ErrAttributeNotFound) {
+		t.Errorf("missing attribute error = %v, want ErrAttributeNotFound", err)
+	}
+}
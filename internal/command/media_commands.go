@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"mime"
 	"net/http"
+	"path/filepath"
 
 	"github.com/google/uuid"
 
@@ -26,6 +28,25 @@ var allowedMimeTypes = map[string]bool{
 	"image/webp":      true,
 	"application/pdf": true,
 	"image/tiff":      true,
+	"audio/mpeg":      true,
+	"audio/wave":      true,
+	"video/mp4":       true,
+	"video/webm":      true,
+}
+
+// detectMimeType sniffs the MIME type of uploaded data. Content sniffing only
+// recognizes MP3 files that carry an ID3 tag, so when the data is unrecognized
+// an audio or video type implied by the filename extension is used instead.
+func detectMimeType(data []byte, filename string) string {
+	mimeType := http.DetectContentType(data)
+	if mimeType != "application/octet-stream" {
+		return mimeType
+	}
+	byExt, _, _ := mime.ParseMediaType(mime.TypeByExtension(filepath.Ext(filename)))
+	if allowedMimeTypes[byExt] && (media.IsAudioMimeType(byExt) || media.IsVideoMimeType(byExt)) {
+		return byExt
+	}
+	return mimeType
 }
 
 // UploadMediaInput contains the data for uploading new media.
@@ -37,6 +58,7 @@ type UploadMediaInput struct {
 	MediaType   string // "photo", "document", etc.
 	Filename    string
 	FileData    []byte
+	GedcomXref  string // Original OBJE @XREF@ when imported from GEDCOM
 }
 
 // UploadMediaResult contains the result of uploading media.
@@ -59,7 +81,7 @@ func (h *Handler) UploadMedia(ctx context.Context, input UploadMediaInput) (*Upl
 	}
 
 	// Detect MIME type
-	mimeType := http.DetectContentType(input.FileData)
+	mimeType := detectMimeType(input.FileData, input.Filename)
 
 	// Validate MIME type
 	if !allowedMimeTypes[mimeType] {
@@ -71,26 +93,67 @@ func (h *Handler) UploadMedia(ctx context.Context, input UploadMediaInput) (*Upl
 	m.Description = input.Description
 	m.MimeType = mimeType
 	m.MediaType = domain.MediaType(input.MediaType)
+	if m.MediaType == "" {
+		switch {
+		case media.IsAudioMimeType(mimeType):
+			m.MediaType = domain.MediaAudio
+		case media.IsVideoMimeType(mimeType):
+			m.MediaType = domain.MediaVideo
+		}
+	}
 	m.Filename = input.Filename
+	m.GedcomXref = input.GedcomXref
 	m.FileSize = int64(len(input.FileData))
 	m.FileData = input.FileData
 
-	// Generate thumbnail for images
-	if media.IsImageMimeType(mimeType) {
-		opts := media.DefaultThumbnailOptions()
-		thumbnail, err := media.GenerateThumbnail(input.FileData, opts)
-		if err == nil && len(thumbnail) > 0 {
-			m.ThumbnailData = thumbnail
-		}
+	// Generate a thumbnail for images and a play icon for videos; other types
+	// (audio, PDF, undecodable images) have none
+	opts := media.ThumbnailOptionsForSize(h.thumbnailSize)
+	thumbnail, err := media.GenerateThumbnailForMimeType(input.FileData, mimeType, opts)
+	if err == nil && len(thumbnail) > 0 {
+		m.ThumbnailData = thumbnail
+	}
+
+	// Capture date and GPS position from photo EXIF, when present
+	if mimeType == "image/jpeg" {
+		exif := media.ExtractExif(input.FileData)
+		m.CapturedAt = exif.CapturedAt
+		m.GPSLatitude = exif.Latitude
+		m.GPSLongitude = exif.Longitude
 	}
 
 	// Validate media
 	if err := m.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	if err := m.ValidateFileSize(h.mediaSizeLimit()); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	// Identical content already stored is linked by hash rather than
+	// recorded again in the event
+	m.ContentHash = domain.MediaContentHash(input.FileData)
+	existing, err := h.readStore.FindMediaByContentHash(ctx, m.ContentHash)
+	if err != nil {
+		return nil, fmt.Errorf("checking for duplicate media: %w", err)
+	}
+
+	// New content goes to the blob store when one is configured, leaving only
+	// the storage key in the event
+	if existing == nil && h.mediaBlobs != nil {
+		if err := h.mediaBlobs.PutBlob(ctx, m.ContentHash, m.FileData); err != nil {
+			return nil, fmt.Errorf("storing media content: %w", err)
+		}
+		m.StorageKey = m.ContentHash
+		m.FileData = nil
 	}
 
 	// Create event
 	event := domain.NewMediaCreated(m)
+	if existing != nil {
+		event.FileData = nil
+		event.ThumbnailData = nil
+	}
 
 	// Execute command (append + project)
 	version, err := h.execute(ctx, m.ID.String(), "Media", []domain.Event{event}, -1)
@@ -204,6 +267,15 @@ func (h *Handler) DeleteMedia(ctx context.Context, id uuid.UUID, version int64,
 	if err != nil {
 		return fmt.Errorf("executing delete media command: %w", err)
 	}
+
+	// Drop the stored blob once no remaining media shares its content. The
+	// delete has already been recorded, so a failure here only leaves an
+	// orphaned blob behind and is ignored.
+	if h.mediaBlobs != nil && current.StorageKey != "" {
+		if shared, err := h.readStore.FindMediaByContentHash(ctx, current.ContentHash); err == nil && shared == nil {
+			_ = h.mediaBlobs.DeleteBlob(ctx, current.StorageKey)
+		}
+	}
 	return nil
 }
 
@@ -3,14 +3,17 @@ package command_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"image"
 	"image/color"
 	"image/jpeg"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/domain"
 	"github.com/cacack/my-family/internal/repository"
 	"github.com/cacack/my-family/internal/repository/memory"
 )
@@ -434,6 +437,317 @@ func TestUploadMedia_WithPDF(t *testing.T) {
 	}
 }
 
+// TestUploadMedia_WithThumbnailSize tests the configured thumbnail size.
+func TestUploadMedia_WithThumbnailSize(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore, command.WithThumbnailSize(40))
+	ctx := context.Background()
+
+	result, err := handler.UploadMedia(ctx, command.UploadMediaInput{
+		EntityType: "person",
+		EntityID:   uuid.New(),
+		Title:      "Portrait",
+		Filename:   "portrait.jpg",
+		FileData:   createTestJPEG(),
+	})
+	if err != nil {
+		t.Fatalf("UploadMedia() error = %v", err)
+	}
+
+	thumbnail, _ := readStore.GetMediaThumbnail(ctx, result.ID)
+	img, err := jpeg.Decode(bytes.NewReader(thumbnail))
+	if err != nil {
+		t.Fatalf("decode thumbnail: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != 40 || b.Dy() != 40 {
+		t.Errorf("thumbnail = %dx%d, want 40x40", b.Dx(), b.Dy())
+	}
+}
+
+// TestUploadMedia_AudioAndVideo tests uploading recordings.
+func TestUploadMedia_AudioAndVideo(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	personResult, err := handler.CreatePerson(ctx, command.CreatePersonInput{
+		GivenName: "Rose",
+		Surname:   "Smith",
+		Gender:    "female",
+	})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		filename      string
+		data          []byte
+		wantMime      string
+		wantType      domain.MediaType
+		wantThumbnail bool
+	}{
+		{
+			name:     "mp3 with ID3 tag",
+			filename: "interview.mp3",
+			data:     append([]byte("ID3\x03\x00\x00\x00\x00\x00\x00"), make([]byte, 32)...),
+			wantMime: "audio/mpeg",
+			wantType: domain.MediaAudio,
+		},
+		{
+			name:     "mp3 without tag falls back to extension",
+			filename: "interview.mp3",
+			data:     append([]byte{0xFF, 0xFB, 0x90, 0x64}, make([]byte, 32)...),
+			wantMime: "audio/mpeg",
+			wantType: domain.MediaAudio,
+		},
+		{
+			name:          "mp4 video",
+			filename:      "reunion.mp4",
+			data:          append([]byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom"), make([]byte, 32)...),
+			wantMime:      "video/mp4",
+			wantType:      domain.MediaVideo,
+			wantThumbnail: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler.UploadMedia(ctx, command.UploadMediaInput{
+				EntityType: "person",
+				EntityID:   personResult.ID,
+				Title:      tt.name,
+				Filename:   tt.filename,
+				FileData:   tt.data,
+			})
+			if err != nil {
+				t.Fatalf("UploadMedia() error = %v", err)
+			}
+
+			media, _ := readStore.GetMedia(ctx, result.ID)
+			if media == nil {
+				t.Fatal("Media not found in read model")
+			}
+			if media.MimeType != tt.wantMime {
+				t.Errorf("MimeType = %s, want %s", media.MimeType, tt.wantMime)
+			}
+			if media.MediaType != tt.wantType {
+				t.Errorf("MediaType = %s, want %s", media.MediaType, tt.wantType)
+			}
+			thumbnail, _ := readStore.GetMediaThumbnail(ctx, result.ID)
+			if got := len(thumbnail) > 0; got != tt.wantThumbnail {
+				t.Errorf("has thumbnail = %v, want %v", got, tt.wantThumbnail)
+			}
+		})
+	}
+
+	// Unrecognized content with a non-media extension is still rejected
+	_, err = handler.UploadMedia(ctx, command.UploadMediaInput{
+		EntityType: "person",
+		EntityID:   personResult.ID,
+		Title:      "Binary",
+		Filename:   "data.bin",
+		FileData:   []byte{0xFF, 0xFB, 0x90, 0x64},
+	})
+	if err == nil {
+		t.Error("expected unsupported file type error")
+	}
+}
+
+// TestUploadMedia_ExifCaptureDate tests that the EXIF capture date is stored.
+func TestUploadMedia_ExifCaptureDate(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	// Big-endian TIFF with a single IFD0 DateTime entry, wrapped in an APP1 segment
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08" +
+		"\x00\x01" + "\x01\x32\x00\x02\x00\x00\x00\x14\x00\x00\x00\x1a" + "\x00\x00\x00\x00" +
+		"1962:03:12 14:30:05\x00")
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	jpg := createTestJPEG()
+	data := append([]byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00, byte(len(segment) + 2)}, segment...)
+	data = append(data, jpg[2:]...)
+
+	result, err := handler.UploadMedia(ctx, command.UploadMediaInput{
+		EntityType: "person",
+		EntityID:   uuid.New(),
+		Title:      "Wedding",
+		Filename:   "wedding.jpg",
+		FileData:   data,
+	})
+	if err != nil {
+		t.Fatalf("UploadMedia() error = %v", err)
+	}
+
+	media, _ := readStore.GetMedia(ctx, result.ID)
+	if media == nil {
+		t.Fatal("Media not found in read model")
+	}
+	want := time.Date(1962, 3, 12, 14, 30, 5, 0, time.UTC)
+	if media.CapturedAt == nil || !media.CapturedAt.Equal(want) {
+		t.Errorf("CapturedAt = %v, want %v", media.CapturedAt, want)
+	}
+	if media.GPSLatitude != nil || media.GPSLongitude != nil {
+		t.Errorf("expected no GPS position, got %v, %v", media.GPSLatitude, media.GPSLongitude)
+	}
+}
+
+// TestUploadMedia_Duplicate tests that identical uploads share one stored copy.
+func TestUploadMedia_Duplicate(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	data := createTestJPEG()
+	upload := func(entityID uuid.UUID) *command.UploadMediaResult {
+		t.Helper()
+		result, err := handler.UploadMedia(ctx, command.UploadMediaInput{
+			EntityType: "person",
+			EntityID:   entityID,
+			Title:      "Family Photo",
+			Filename:   "family.jpg",
+			FileData:   data,
+		})
+		if err != nil {
+			t.Fatalf("UploadMedia() error = %v", err)
+		}
+		return result
+	}
+	first := upload(uuid.New())
+	second := upload(uuid.New())
+
+	// Only the first upload records the file in the event stream
+	stream, _ := eventStore.ReadStream(ctx, second.ID)
+	if len(stream) != 1 || bytes.Contains(stream[0].Data, []byte("file_data\":\"")) {
+		t.Errorf("duplicate upload event should not carry file data: %s", stream[0].Data)
+	}
+
+	m, _ := readStore.GetMediaWithData(ctx, second.ID)
+	if m == nil || !bytes.Equal(m.FileData, data) {
+		t.Fatal("duplicate media should resolve to the shared file data")
+	}
+	if len(m.ThumbnailData) == 0 {
+		t.Error("duplicate media should share the thumbnail")
+	}
+
+	groups, _ := readStore.ListDuplicateMedia(ctx)
+	if len(groups) != 1 || len(groups[0].Media) != 2 {
+		t.Fatalf("ListDuplicateMedia() = %+v, want one group of two", groups)
+	}
+
+	// Deleting the original leaves the duplicate's content intact
+	if err := handler.DeleteMedia(ctx, first.ID, first.Version, "duplicate"); err != nil {
+		t.Fatalf("DeleteMedia() error = %v", err)
+	}
+	m, _ = readStore.GetMediaWithData(ctx, second.ID)
+	if m == nil || !bytes.Equal(m.FileData, data) {
+		t.Error("content should survive deletion of the original upload")
+	}
+	if groups, _ := readStore.ListDuplicateMedia(ctx); len(groups) != 0 {
+		t.Errorf("ListDuplicateMedia() = %d groups after delete, want 0", len(groups))
+	}
+
+	// With every reference gone the content is no longer linkable
+	if err := handler.DeleteMedia(ctx, second.ID, second.Version, "cleanup"); err != nil {
+		t.Fatalf("DeleteMedia() error = %v", err)
+	}
+	if found, _ := readStore.FindMediaByContentHash(ctx, domain.MediaContentHash(data)); found != nil {
+		t.Error("content should be released once no media references it")
+	}
+	third := upload(uuid.New())
+	stream, _ = eventStore.ReadStream(ctx, third.ID)
+	if !bytes.Contains(stream[0].Data, []byte("file_data\":\"")) {
+		t.Error("re-upload after release should store the file again")
+	}
+}
+
+func TestUploadMedia_WithMediaBlobStore(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	blobs := memory.NewMediaBlobStore()
+	handler := command.NewHandler(eventStore, readStore, command.WithMediaBlobStore(blobs))
+	ctx := context.Background()
+
+	data := createTestJPEG()
+	hash := domain.MediaContentHash(data)
+	upload := func() *command.UploadMediaResult {
+		t.Helper()
+		result, err := handler.UploadMedia(ctx, command.UploadMediaInput{
+			EntityType: "person",
+			EntityID:   uuid.New(),
+			Title:      "Census Scan",
+			Filename:   "census.jpg",
+			FileData:   data,
+		})
+		if err != nil {
+			t.Fatalf("UploadMedia() error = %v", err)
+		}
+		return result
+	}
+	first := upload()
+	second := upload()
+
+	// Content lives in the blob store; the event and read model keep the key
+	stream, _ := eventStore.ReadStream(ctx, first.ID)
+	if len(stream) != 1 || bytes.Contains(stream[0].Data, []byte("file_data\":\"")) {
+		t.Errorf("upload event should not carry file data: %s", stream[0].Data)
+	}
+	if stored, err := blobs.GetBlob(ctx, hash); err != nil || !bytes.Equal(stored, data) {
+		t.Fatalf("GetBlob() = %d bytes, %v; want uploaded content", len(stored), err)
+	}
+	for _, id := range []uuid.UUID{first.ID, second.ID} {
+		m, _ := readStore.GetMediaWithData(ctx, id)
+		if m == nil || m.StorageKey != hash || len(m.FileData) != 0 {
+			t.Fatalf("media %s should reference blob %s without inline data, got %+v", id, hash, m)
+		}
+		if len(m.ThumbnailData) == 0 {
+			t.Errorf("media %s should keep its thumbnail inline", id)
+		}
+	}
+
+	// The blob is removed only with the last media sharing it
+	if err := handler.DeleteMedia(ctx, first.ID, first.Version, "duplicate"); err != nil {
+		t.Fatalf("DeleteMedia() error = %v", err)
+	}
+	if _, err := blobs.GetBlob(ctx, hash); err != nil {
+		t.Errorf("blob should survive while shared, got %v", err)
+	}
+	if err := handler.DeleteMedia(ctx, second.ID, second.Version, "cleanup"); err != nil {
+		t.Fatalf("DeleteMedia() error = %v", err)
+	}
+	if _, err := blobs.GetBlob(ctx, hash); err != repository.ErrMediaBlobNotFound {
+		t.Errorf("GetBlob() after last delete error = %v, want ErrMediaBlobNotFound", err)
+	}
+}
+
+func TestUploadMedia_WithMaxMediaSize(t *testing.T) {
+	data := createTestJPEG()
+	input := command.UploadMediaInput{
+		EntityType: "person",
+		EntityID:   uuid.New(),
+		Title:      "Deed",
+		Filename:   "deed.jpg",
+		FileData:   data,
+	}
+
+	handler := command.NewHandler(memory.NewEventStore(), memory.NewReadModelStore(),
+		command.WithMaxMediaSize(int64(len(data)-1)))
+	if _, err := handler.UploadMedia(context.Background(), input); !errors.Is(err, command.ErrInvalidInput) {
+		t.Errorf("UploadMedia() over limit error = %v, want ErrInvalidInput", err)
+	}
+
+	handler = command.NewHandler(memory.NewEventStore(), memory.NewReadModelStore(),
+		command.WithMaxMediaSize(int64(len(data))))
+	if _, err := handler.UploadMedia(context.Background(), input); err != nil {
+		t.Errorf("UploadMedia() at limit error = %v", err)
+	}
+}
+
 // TestUploadMedia_WithInvalidEntityType tests upload with invalid entity type.
 func TestUploadMedia_WithInvalidEntityType(t *testing.T) {
 	eventStore := memory.NewEventStore()
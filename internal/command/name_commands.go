@@ -27,6 +27,7 @@ type AddNameInput struct {
 	NameSuffix    string
 	SurnamePrefix string
 	Nickname      string
+	Romanized     string
 	NameType      string
 	IsPrimary     bool
 }
@@ -66,14 +67,20 @@ func (h *Handler) AddName(ctx context.Context, input AddNameInput) (*AddNameResu
 	pn.NameSuffix = input.NameSuffix
 	pn.SurnamePrefix = input.SurnamePrefix
 	pn.Nickname = input.Nickname
+	pn.Romanized = input.Romanized
 	if input.NameType != "" {
 		pn.NameType = domain.NameType(input.NameType)
 	}
 	pn.IsPrimary = input.IsPrimary
 
+	// A patronymic left blank is derived from the father's given name
+	if pn.NameType == domain.NameTypePatronymic && pn.Surname == "" {
+		pn.Surname = domain.DerivePatronymic(h.fatherGivenName(ctx, input.PersonID), person.Gender)
+	}
+
 	// Validate the name
 	if err := pn.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// If this is the first name, make it primary
@@ -81,28 +88,10 @@ func (h *Handler) AddName(ctx context.Context, input AddNameInput) (*AddNameResu
 		pn.IsPrimary = true
 	}
 
-	// If setting as primary, we need to demote the existing primary
+	// A person has one primary name, so adding a primary demotes the others
 	var events []domain.Event
 	if pn.IsPrimary {
-		for _, existing := range existingNames {
-			if existing.IsPrimary {
-				// Create updated name to demote
-				demoted := &domain.PersonName{
-					ID:            existing.ID,
-					PersonID:      existing.PersonID,
-					GivenName:     existing.GivenName,
-					Surname:       existing.Surname,
-					NamePrefix:    existing.NamePrefix,
-					NameSuffix:    existing.NameSuffix,
-					SurnamePrefix: existing.SurnamePrefix,
-					Nickname:      existing.Nickname,
-					NameType:      existing.NameType,
-					IsPrimary:     false,
-				}
-				events = append(events, domain.NewNameUpdated(demoted))
-				break
-			}
-		}
+		events = demotePrimaryNames(existingNames, pn.ID)
 	}
 
 	// Add the new name event
@@ -121,6 +110,29 @@ func (h *Handler) AddName(ctx context.Context, input AddNameInput) (*AddNameResu
 	}, nil
 }
 
+// fatherGivenName returns the given name of a person's father from their
+// parent family: the male partner, or partner 1 when neither is recorded as
+// male. Returns "" when no father is known.
+func (h *Handler) fatherGivenName(ctx context.Context, personID uuid.UUID) string {
+	family, err := h.readStore.GetChildFamily(ctx, personID)
+	if err != nil || family == nil {
+		return ""
+	}
+	for _, partnerID := range []*uuid.UUID{family.Partner1ID, family.Partner2ID} {
+		if partnerID == nil {
+			continue
+		}
+		partner, err := h.readStore.GetPerson(ctx, *partnerID)
+		if err == nil && partner != nil && partner.Gender == domain.GenderMale {
+			return partner.GivenName
+		}
+	}
+	if family.Partner1ID != nil {
+		return family.Partner1GivenName
+	}
+	return ""
+}
+
 // UpdateNameInput contains the data for updating a name.
 type UpdateNameInput struct {
 	PersonID      uuid.UUID
@@ -131,6 +143,7 @@ type UpdateNameInput struct {
 	NameSuffix    *string
 	SurnamePrefix *string
 	Nickname      *string
+	Romanized     *string
 	NameType      *string
 	IsPrimary     *bool
 }
@@ -162,6 +175,9 @@ func applyNameUpdates(pn *domain.PersonName, input UpdateNameInput) {
 	if input.Nickname != nil {
 		pn.Nickname = *input.Nickname
 	}
+	if input.Romanized != nil {
+		pn.Romanized = *input.Romanized
+	}
 	if input.NameType != nil {
 		pn.NameType = domain.NameType(*input.NameType)
 	}
@@ -182,10 +198,10 @@ func (h *Handler) UpdateName(ctx context.Context, input UpdateNameInput) (*Updat
 	applyNameUpdates(pn, input)
 
 	if err := pn.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
-	events, err := h.buildNameUpdateEvents(ctx, pn, existingName, input)
+	events, err := h.buildNameUpdateEvents(ctx, pn, input)
 	if err != nil {
 		return nil, fmt.Errorf("building name update events: %w", err)
 	}
@@ -233,35 +249,45 @@ func personNameFromReadModel(rm *repository.PersonNameReadModel) *domain.PersonN
 		NameSuffix:    rm.NameSuffix,
 		SurnamePrefix: rm.SurnamePrefix,
 		Nickname:      rm.Nickname,
+		Romanized:     rm.Romanized,
 		NameType:      rm.NameType,
 		IsPrimary:     rm.IsPrimary,
 	}
 }
 
-// buildNameUpdateEvents creates the events needed for a name update, including demoting existing primary if needed.
-func (h *Handler) buildNameUpdateEvents(ctx context.Context, pn *domain.PersonName, existingName *repository.PersonNameReadModel, input UpdateNameInput) ([]domain.Event, error) {
+// buildNameUpdateEvents creates the events needed for a name update, including demoting other primaries if needed.
+func (h *Handler) buildNameUpdateEvents(ctx context.Context, pn *domain.PersonName, input UpdateNameInput) ([]domain.Event, error) {
 	var events []domain.Event
 
-	// If setting as primary and it wasn't before, demote existing primary
-	if pn.IsPrimary && !existingName.IsPrimary {
+	// Demote every other primary, even when this name already was one, so a
+	// person left with several primaries ends up with exactly one
+	if pn.IsPrimary {
 		existingNames, err := h.readStore.GetPersonNames(ctx, input.PersonID)
 		if err != nil {
 			return nil, fmt.Errorf("getting person names: %w", err)
 		}
-		for _, existing := range existingNames {
-			if existing.IsPrimary && existing.ID != input.NameID {
-				demoted := personNameFromReadModel(&existing)
-				demoted.IsPrimary = false
-				events = append(events, domain.NewNameUpdated(demoted))
-				break
-			}
-		}
+		events = demotePrimaryNames(existingNames, pn.ID)
 	}
 
 	events = append(events, domain.NewNameUpdated(pn))
 	return events, nil
 }
 
+// demotePrimaryNames returns NameUpdated events clearing IsPrimary on every
+// primary name except keepID.
+func demotePrimaryNames(names []repository.PersonNameReadModel, keepID uuid.UUID) []domain.Event {
+	var events []domain.Event
+	for i := range names {
+		if !names[i].IsPrimary || names[i].ID == keepID {
+			continue
+		}
+		demoted := personNameFromReadModel(&names[i])
+		demoted.IsPrimary = false
+		events = append(events, domain.NewNameUpdated(demoted))
+	}
+	return events
+}
+
 // DeleteNameInput contains the data for deleting a name.
 type DeleteNameInput struct {
 	PersonID uuid.UUID
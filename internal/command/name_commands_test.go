@@ -7,6 +7,8 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/domain"
+	"github.com/cacack/my-family/internal/repository"
 	"github.com/cacack/my-family/internal/repository/memory"
 )
 
@@ -128,6 +130,69 @@ func TestAddName_DemoteExistingPrimary(t *testing.T) {
 	}
 }
 
+// primaryNameIDs returns the IDs of a person's names marked primary.
+func primaryNameIDs(t *testing.T, readStore *memory.ReadModelStore, personID uuid.UUID) []uuid.UUID {
+	t.Helper()
+	names, err := readStore.GetPersonNames(context.Background(), personID)
+	if err != nil {
+		t.Fatalf("GetPersonNames failed: %v", err)
+	}
+	var ids []uuid.UUID
+	for _, n := range names {
+		if n.IsPrimary {
+			ids = append(ids, n.ID)
+		}
+	}
+	return ids
+}
+
+// seedDuplicatePrimaries gives a person two primary names, a state the
+// command handler no longer produces but older data may contain.
+func seedDuplicatePrimaries(t *testing.T, readStore *memory.ReadModelStore, personID uuid.UUID) {
+	t.Helper()
+	for _, given := range []string{"John", "Jack"} {
+		if err := readStore.SavePersonName(context.Background(), &repository.PersonNameReadModel{
+			ID:        uuid.New(),
+			PersonID:  personID,
+			GivenName: given,
+			Surname:   "Doe",
+			NameType:  domain.NameTypeBirth,
+			IsPrimary: true,
+		}); err != nil {
+			t.Fatalf("SavePersonName failed: %v", err)
+		}
+	}
+}
+
+func TestAddName_DemotesAllOtherPrimaries(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	personResult, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+	seedDuplicatePrimaries(t, readStore, personResult.ID)
+
+	result, err := handler.AddName(ctx, command.AddNameInput{
+		PersonID:  personResult.ID,
+		GivenName: "Johann",
+		Surname:   "Doe",
+		NameType:  "immigrant",
+		IsPrimary: true,
+	})
+	if err != nil {
+		t.Fatalf("AddName failed: %v", err)
+	}
+
+	primaries := primaryNameIDs(t, readStore, personResult.ID)
+	if len(primaries) != 1 || primaries[0] != result.ID {
+		t.Errorf("primary names = %v, want only %s", primaries, result.ID)
+	}
+}
+
 func TestAddName_PersonNotFound(t *testing.T) {
 	eventStore := memory.NewEventStore()
 	readStore := memory.NewReadModelStore()
@@ -277,6 +342,35 @@ func TestUpdateName_SetAsPrimary(t *testing.T) {
 	}
 }
 
+func TestUpdateName_PrimaryDemotesOtherPrimaries(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	personResult, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+	seedDuplicatePrimaries(t, readStore, personResult.ID)
+
+	// Re-saving an already-primary name resolves the duplicate
+	keep := primaryNameIDs(t, readStore, personResult.ID)[0]
+	isPrimary := true
+	if _, err := handler.UpdateName(ctx, command.UpdateNameInput{
+		PersonID:  personResult.ID,
+		NameID:    keep,
+		IsPrimary: &isPrimary,
+	}); err != nil {
+		t.Fatalf("UpdateName failed: %v", err)
+	}
+
+	primaries := primaryNameIDs(t, readStore, personResult.ID)
+	if len(primaries) != 1 || primaries[0] != keep {
+		t.Errorf("primary names = %v, want only %s", primaries, keep)
+	}
+}
+
 func TestUpdateName_NameNotFound(t *testing.T) {
 	eventStore := memory.NewEventStore()
 	readStore := memory.NewReadModelStore()
@@ -613,3 +707,62 @@ func TestUpdateName_AllFields(t *testing.T) {
 		t.Errorf("Expected ID %s, got %s", nameResult.ID, result.ID)
 	}
 }
+
+func TestAddName_PatronymicDerivedFromFather(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	father, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Jón", Gender: "male"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+	mother, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Guðrún", Gender: "female"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+	child, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Sigríður", Gender: "female"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+
+	family, err := handler.CreateFamily(ctx, command.CreateFamilyInput{
+		Partner1ID: &mother.ID,
+		Partner2ID: &father.ID,
+	})
+	if err != nil {
+		t.Fatalf("CreateFamily failed: %v", err)
+	}
+	if _, err := handler.LinkChild(ctx, command.LinkChildInput{
+		FamilyID:     family.ID,
+		ChildID:      child.ID,
+		RelationType: "biological",
+	}); err != nil {
+		t.Fatalf("LinkChild failed: %v", err)
+	}
+
+	result, err := handler.AddName(ctx, command.AddNameInput{
+		PersonID:  child.ID,
+		GivenName: "Sigríður",
+		NameType:  "patronymic",
+	})
+	if err != nil {
+		t.Fatalf("AddName failed: %v", err)
+	}
+
+	names, err := readStore.GetPersonNames(ctx, child.ID)
+	if err != nil {
+		t.Fatalf("GetPersonNames failed: %v", err)
+	}
+	for _, n := range names {
+		if n.ID != result.ID {
+			continue
+		}
+		if n.Surname != "Jónsdóttir" {
+			t.Errorf("Surname = %q, want %q", n.Surname, "Jónsdóttir")
+		}
+		return
+	}
+	t.Fatalf("name %s not found", result.ID)
+}
@@ -39,7 +39,7 @@ func (h *Handler) CreateNote(ctx context.Context, input CreateNoteInput) (*Creat
 
 	// Validate note
 	if err := note.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -30,6 +31,11 @@ type CreatePersonInput struct {
 	DeathPlace     string
 	Notes          string
 	ResearchStatus string
+
+	// Optional parts of the primary name recorded alongside the person
+	NamePrefix    string
+	NameSuffix    string
+	SurnamePrefix string
 }
 
 // CreatePersonResult contains the result of creating a person.
@@ -40,11 +46,6 @@ type CreatePersonResult struct {
 
 // CreatePerson creates a new person record.
 func (h *Handler) CreatePerson(ctx context.Context, input CreatePersonInput) (*CreatePersonResult, error) {
-	// Validate required fields
-	if input.GivenName == "" {
-		return nil, fmt.Errorf("%w: given_name is required", ErrInvalidInput)
-	}
-
 	// Create person entity
 	person := domain.NewPerson(input.GivenName, input.Surname)
 
@@ -72,7 +73,7 @@ func (h *Handler) CreatePerson(ctx context.Context, input CreatePersonInput) (*C
 
 	// Validate person
 	if err := person.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
@@ -194,7 +195,7 @@ func (h *Handler) UpdatePerson(ctx context.Context, input UpdatePersonInput) (*U
 
 	// Validate updated person
 	if err := testPerson.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
@@ -261,6 +262,55 @@ func (h *Handler) DeletePerson(ctx context.Context, input DeletePersonInput) err
 	return nil
 }
 
+// AddPersonExternalIDInput contains the data for linking a person to an
+// external tree.
+type AddPersonExternalIDInput struct {
+	PersonID uuid.UUID
+	Value    string
+	Type     string // URI naming the external system, e.g. domain.FamilySearchArkType
+}
+
+// AddPersonExternalIDResult contains the result of adding an external identifier.
+type AddPersonExternalIDResult struct {
+	ExternalID domain.ExternalIdentifier
+	Version    int64
+}
+
+// AddPersonExternalID records that a person is the same individual as a profile
+// in an external tree (FamilySearch, WikiTree, Ancestry, ...).
+func (h *Handler) AddPersonExternalID(ctx context.Context, input AddPersonExternalIDInput) (*AddPersonExternalIDResult, error) {
+	ext := domain.ExternalIdentifier{Value: strings.TrimSpace(input.Value), Type: strings.TrimSpace(input.Type)}
+	if err := ext.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	person, err := h.readStore.GetPerson(ctx, input.PersonID)
+	if err != nil {
+		return nil, fmt.Errorf("getting person: %w", err)
+	}
+	if person == nil {
+		return nil, ErrPersonNotFound
+	}
+
+	existing, err := h.readStore.GetPersonExternalIDs(ctx, input.PersonID)
+	if err != nil {
+		return nil, fmt.Errorf("getting person external identifiers: %w", err)
+	}
+	for _, e := range existing {
+		if e.Value == ext.Value && strings.EqualFold(e.Type, ext.Type) {
+			return nil, fmt.Errorf("%w: external identifier %s is already recorded", ErrInvalidInput, ext.Value)
+		}
+	}
+
+	event := domain.NewPersonExternalIDAdded(input.PersonID, ext)
+	version, err := h.execute(ctx, input.PersonID.String(), "Person", []domain.Event{event}, person.Version)
+	if err != nil {
+		return nil, fmt.Errorf("executing add external identifier command: %w", err)
+	}
+
+	return &AddPersonExternalIDResult{ExternalID: ext, Version: version}, nil
+}
+
 // parseUUID parses a string to UUID.
 func parseUUID(s string) (uuid.UUID, error) {
 	return uuid.Parse(s)
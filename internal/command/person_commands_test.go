@@ -2,11 +2,13 @@ package command_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
 
 	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/domain"
 	"github.com/cacack/my-family/internal/repository/memory"
 )
 
@@ -692,3 +694,53 @@ func TestPersonVersionConflictNoPartialState(t *testing.T) {
 		t.Errorf("Notes = %s, want empty (stale update should not apply)", person.Notes)
 	}
 }
+
+func TestAddPersonExternalID(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	person, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+
+	input := command.AddPersonExternalIDInput{
+		PersonID: person.ID,
+		Value:    " KWCJ-QN7 ",
+		Type:     domain.FamilySearchArkType,
+	}
+	result, err := handler.AddPersonExternalID(ctx, input)
+	if err != nil {
+		t.Fatalf("AddPersonExternalID failed: %v", err)
+	}
+	if result.ExternalID.Value != "KWCJ-QN7" {
+		t.Errorf("Value = %q, want KWCJ-QN7", result.ExternalID.Value)
+	}
+	if result.Version != 2 {
+		t.Errorf("Version = %d, want 2", result.Version)
+	}
+
+	ids, _ := readStore.GetPersonExternalIDs(ctx, person.ID)
+	if len(ids) != 1 || ids[0].Value != "KWCJ-QN7" || ids[0].Type != domain.FamilySearchArkType {
+		t.Errorf("external IDs = %+v, want the FamilySearch ID", ids)
+	}
+
+	// The same identifier twice is rejected
+	if _, err := handler.AddPersonExternalID(ctx, input); !errors.Is(err, command.ErrInvalidInput) {
+		t.Errorf("duplicate: err = %v, want ErrInvalidInput", err)
+	}
+
+	// Empty value
+	_, err = handler.AddPersonExternalID(ctx, command.AddPersonExternalIDInput{PersonID: person.ID, Type: domain.FamilySearchArkType})
+	if !errors.Is(err, command.ErrInvalidInput) {
+		t.Errorf("empty value: err = %v, want ErrInvalidInput", err)
+	}
+
+	// Unknown person
+	_, err = handler.AddPersonExternalID(ctx, command.AddPersonExternalIDInput{PersonID: uuid.New(), Value: "X1"})
+	if !errors.Is(err, command.ErrPersonNotFound) {
+		t.Errorf("unknown person: err = %v, want ErrPersonNotFound", err)
+	}
+}
@@ -0,0 +1,139 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/domain"
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// MergePlacesInput contains the data for merging place name variants.
+type MergePlacesInput struct {
+	Canonical string   // Place name to keep
+	Variants  []string // Place names to rewrite to Canonical
+}
+
+// MergePlacesResult contains the number of records rewritten by a place merge.
+type MergePlacesResult struct {
+	PersonsUpdated  int
+	FamiliesUpdated int
+	EventsUpdated   int
+}
+
+// MergePlaces rewrites every person, family, and life event place that exactly
+// matches one of the variants to the canonical place name. Each record is
+// updated through its own command, so the rewrite appears in the record's
+// history. Records updated before a failure keep the canonical name.
+func (h *Handler) MergePlaces(ctx context.Context, input MergePlacesInput) (*MergePlacesResult, error) {
+	canonical := strings.TrimSpace(input.Canonical)
+	if canonical == "" {
+		return nil, fmt.Errorf("%w: canonical place is required", ErrInvalidInput)
+	}
+
+	seen := map[string]bool{canonical: true}
+	var variants []string
+	for _, v := range input.Variants {
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		variants = append(variants, v)
+	}
+	if len(variants) == 0 {
+		return nil, fmt.Errorf("%w: at least one variant different from the canonical place is required", ErrInvalidInput)
+	}
+
+	// Collect the fields to rewrite per record, so a person with several
+	// matching fields is updated once.
+	personFields := make(map[uuid.UUID]map[string]bool)
+	var personOrder, familyIDs, eventIDs []uuid.UUID
+	seenIDs := make(map[uuid.UUID]bool)
+	for _, variant := range variants {
+		refs, err := h.readStore.FindPlaceReferences(ctx, variant)
+		if err != nil {
+			return nil, fmt.Errorf("finding place references: %w", err)
+		}
+		for _, ref := range refs {
+			switch ref.EntityType {
+			case repository.PlaceRefPerson:
+				if personFields[ref.EntityID] == nil {
+					personFields[ref.EntityID] = make(map[string]bool)
+					personOrder = append(personOrder, ref.EntityID)
+				}
+				personFields[ref.EntityID][ref.Field] = true
+			case repository.PlaceRefFamily, repository.PlaceRefEvent:
+				if seenIDs[ref.EntityID] {
+					continue
+				}
+				seenIDs[ref.EntityID] = true
+				if ref.EntityType == repository.PlaceRefFamily {
+					familyIDs = append(familyIDs, ref.EntityID)
+				} else {
+					eventIDs = append(eventIDs, ref.EntityID)
+				}
+			}
+		}
+	}
+
+	result := &MergePlacesResult{}
+
+	for _, id := range personOrder {
+		person, err := h.readStore.GetPerson(ctx, id)
+		if err != nil {
+			return result, fmt.Errorf("getting person: %w", err)
+		}
+		if person == nil {
+			continue
+		}
+		update := UpdatePersonInput{ID: id, Version: person.Version}
+		if personFields[id]["birth_place"] {
+			update.BirthPlace = &canonical
+		}
+		if personFields[id]["death_place"] {
+			update.DeathPlace = &canonical
+		}
+		if _, err := h.UpdatePerson(ctx, update); err != nil {
+			return result, fmt.Errorf("updating person %s: %w", id, err)
+		}
+		result.PersonsUpdated++
+	}
+
+	for _, id := range familyIDs {
+		family, err := h.readStore.GetFamily(ctx, id)
+		if err != nil {
+			return result, fmt.Errorf("getting family: %w", err)
+		}
+		if family == nil {
+			continue
+		}
+		if _, err := h.UpdateFamily(ctx, UpdateFamilyInput{
+			ID:            id,
+			MarriagePlace: &canonical,
+			Version:       family.Version,
+		}); err != nil {
+			return result, fmt.Errorf("updating family %s: %w", id, err)
+		}
+		result.FamiliesUpdated++
+	}
+
+	for _, id := range eventIDs {
+		lifeEvent, err := h.readStore.GetEvent(ctx, id)
+		if err != nil {
+			return result, fmt.Errorf("getting life event: %w", err)
+		}
+		if lifeEvent == nil {
+			continue
+		}
+		event := domain.NewLifeEventUpdated(id, map[string]any{"place": canonical})
+		if _, err := h.execute(ctx, id.String(), "event", []domain.Event{event}, lifeEvent.Version); err != nil {
+			return result, fmt.Errorf("updating life event %s: %w", id, err)
+		}
+		result.EventsUpdated++
+	}
+
+	return result, nil
+}
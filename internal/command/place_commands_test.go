@@ -0,0 +1,116 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/repository/memory"
+)
+
+const placeVariantsGedcom = `0 HEAD
+1 GEDC
+2 VERS 5.5.1
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME John /Doe/
+1 BIRT
+2 DATE 1 JAN 1850
+2 PLAC Springfield, IL
+1 DEAT
+2 DATE 1 JAN 1920
+2 PLAC Springfield, Illinois
+1 RESI
+2 DATE 1880
+2 PLAC Springfield, IL
+0 @I2@ INDI
+1 NAME Jane /Smith/
+1 BIRT
+2 PLAC Boston, MA
+0 @F1@ FAM
+1 HUSB @I1@
+1 WIFE @I2@
+1 MARR
+2 PLAC Springfield, IL
+0 TRLR
+`
+
+func TestMergePlaces(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	_, err := handler.ImportGedcom(ctx, command.ImportGedcomInput{
+		Filename: "places.ged",
+		FileSize: int64(len(placeVariantsGedcom)),
+		Reader:   strings.NewReader(placeVariantsGedcom),
+	})
+	if err != nil {
+		t.Fatalf("ImportGedcom failed: %v", err)
+	}
+
+	canonical := "Springfield, Sangamon, Illinois, USA"
+	result, err := handler.MergePlaces(ctx, command.MergePlacesInput{
+		Canonical: canonical,
+		Variants:  []string{"Springfield, IL", "Springfield, Illinois", canonical},
+	})
+	if err != nil {
+		t.Fatalf("MergePlaces failed: %v", err)
+	}
+
+	if result.PersonsUpdated != 1 {
+		t.Errorf("PersonsUpdated = %d, want 1", result.PersonsUpdated)
+	}
+	if result.FamiliesUpdated != 1 {
+		t.Errorf("FamiliesUpdated = %d, want 1", result.FamiliesUpdated)
+	}
+	if result.EventsUpdated == 0 {
+		t.Error("EventsUpdated = 0, want the residence event rewritten")
+	}
+
+	usage, err := readStore.ListPlaceUsage(ctx)
+	if err != nil {
+		t.Fatalf("ListPlaceUsage failed: %v", err)
+	}
+	for _, u := range usage {
+		if strings.HasPrefix(u.Place, "Springfield") && u.Place != canonical {
+			t.Errorf("variant %q still referenced %d times", u.Place, u.Count)
+		}
+	}
+
+	// Unrelated places are untouched
+	refs, err := readStore.FindPlaceReferences(ctx, "Boston, MA")
+	if err != nil {
+		t.Fatalf("FindPlaceReferences failed: %v", err)
+	}
+	if len(refs) == 0 {
+		t.Error("expected Boston, MA to remain referenced")
+	}
+}
+
+func TestMergePlaces_InvalidInput(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		input command.MergePlacesInput
+	}{
+		{"missing canonical", command.MergePlacesInput{Canonical: " ", Variants: []string{"Boston, MA"}}},
+		{"no variants", command.MergePlacesInput{Canonical: "Boston, Massachusetts"}},
+		{"only canonical", command.MergePlacesInput{Canonical: "Boston, MA", Variants: []string{"Boston, MA", ""}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.MergePlaces(ctx, tt.input)
+			if !errors.Is(err, command.ErrInvalidInput) {
+				t.Errorf("MergePlaces error = %v, want ErrInvalidInput", err)
+			}
+		})
+	}
+}
@@ -47,7 +47,7 @@ func (h *Handler) CreateRepository(ctx context.Context, input CreateRepositoryIn
 
 	// Validate repository
 	if err := repo.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// DefaultRetryAttempts is the number of attempts used for opt-in automatic
+// retries of update commands.
+const DefaultRetryAttempts = 3
+
+// WithRetry calls fn and, while it fails with repository.ErrConcurrencyConflict,
+// calls it again until maxAttempts attempts have been made. fn receives the
+// 1-based attempt number; on attempts after the first it should re-read the
+// current version before re-applying its changes. The last error is returned
+// if every attempt conflicts.
+func WithRetry(ctx context.Context, fn func(ctx context.Context, attempt int) error, maxAttempts int) error {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		err = fn(ctx, attempt)
+		if !errors.Is(err, repository.ErrConcurrencyConflict) {
+			return err
+		}
+	}
+	return err
+}
+
+// RetryUpdate runs update with WithRetry. Before each retry it refreshes
+// *version from the entity's event stream, so update re-applies the caller's
+// changes on top of the latest version. Concurrent changes to the same fields
+// are overwritten (last writer wins).
+func (h *Handler) RetryUpdate(ctx context.Context, entityID uuid.UUID, version *int64, update func(ctx context.Context) error, maxAttempts int) error {
+	return WithRetry(ctx, func(ctx context.Context, attempt int) error {
+		if attempt > 1 {
+			current, err := h.eventStore.GetStreamVersion(ctx, entityID)
+			if err != nil {
+				return fmt.Errorf("getting stream version: %w", err)
+			}
+			*version = current
+		}
+		return update(ctx)
+	}, maxAttempts)
+}
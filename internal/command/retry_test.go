@@ -0,0 +1,115 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/memory"
+)
+
+func TestWithRetry(t *testing.T) {
+	errOther := errors.New("other failure")
+	wrappedConflict := fmt.Errorf("executing command: %w", repository.ErrConcurrencyConflict)
+
+	tests := []struct {
+		name         string
+		maxAttempts  int
+		results      []error
+		wantErr      error
+		wantAttempts int
+	}{
+		{name: "succeeds first time", maxAttempts: 3, results: []error{nil}, wantErr: nil, wantAttempts: 1},
+		{name: "succeeds after conflict", maxAttempts: 3, results: []error{wrappedConflict, nil}, wantErr: nil, wantAttempts: 2},
+		{name: "gives up after max attempts", maxAttempts: 3, results: []error{wrappedConflict, wrappedConflict, wrappedConflict}, wantErr: repository.ErrConcurrencyConflict, wantAttempts: 3},
+		{name: "does not retry other errors", maxAttempts: 3, results: []error{errOther}, wantErr: errOther, wantAttempts: 1},
+		{name: "non-positive max attempts runs once", maxAttempts: 0, results: []error{wrappedConflict}, wantErr: repository.ErrConcurrencyConflict, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			err := command.WithRetry(context.Background(), func(_ context.Context, attempt int) error {
+				attempts++
+				if attempt != attempts {
+					t.Errorf("attempt = %d, want %d", attempt, attempts)
+				}
+				return tt.results[attempt-1]
+			}, tt.maxAttempts)
+
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestWithRetry_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	attempts := 0
+	err := command.WithRetry(ctx, func(_ context.Context, _ int) error {
+		attempts++
+		cancel()
+		return repository.ErrConcurrencyConflict
+	}, 3)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestRetryUpdate_ReappliesChangesOnLatestVersion(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	ctx := context.Background()
+
+	created, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+	staleVersion := created.Version
+
+	// A concurrent edit to a different field bumps the version
+	birthPlace := "Boston"
+	if _, err := handler.UpdatePerson(ctx, command.UpdatePersonInput{
+		ID:         created.ID,
+		BirthPlace: &birthPlace,
+		Version:    staleVersion,
+	}); err != nil {
+		t.Fatalf("UpdatePerson failed: %v", err)
+	}
+
+	givenName := "Jane"
+	input := command.UpdatePersonInput{ID: created.ID, GivenName: &givenName, Version: staleVersion}
+	var result *command.UpdatePersonResult
+	err = handler.RetryUpdate(ctx, created.ID, &input.Version, func(ctx context.Context) error {
+		var err error
+		result, err = handler.UpdatePerson(ctx, input)
+		return err
+	}, command.DefaultRetryAttempts)
+	if err != nil {
+		t.Fatalf("RetryUpdate failed: %v", err)
+	}
+	if result.Version != staleVersion+2 {
+		t.Errorf("Version = %d, want %d", result.Version, staleVersion+2)
+	}
+
+	person, err := readStore.GetPerson(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetPerson failed: %v", err)
+	}
+	if person.GivenName != "Jane" || person.BirthPlace != "Boston" {
+		t.Errorf("person = %s / %s, want Jane / Boston", person.GivenName, person.BirthPlace)
+	}
+}
@@ -76,7 +76,7 @@ func (h *Handler) CreateSource(ctx context.Context, input CreateSourceInput) (*C
 
 	// Validate source
 	if err := source.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
@@ -202,7 +202,7 @@ func (h *Handler) UpdateSource(ctx context.Context, input UpdateSourceInput) (*U
 
 	// Validate updated source
 	if err := testSource.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
@@ -335,7 +335,7 @@ func (h *Handler) CreateCitation(ctx context.Context, input CreateCitationInput)
 
 	// Validate citation
 	if err := citation.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
@@ -475,7 +475,7 @@ func (h *Handler) UpdateCitation(ctx context.Context, input UpdateCitationInput)
 
 	// Validate updated citation
 	if err := testCitation.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
@@ -59,7 +59,7 @@ func (h *Handler) CreateSubmitter(ctx context.Context, input CreateSubmitterInpu
 
 	// Validate submitter
 	if err := submitter.Validate(); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
 	// Create event
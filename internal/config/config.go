@@ -2,39 +2,309 @@
 package config
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
 )
 
 // Config holds the application configuration.
+//
+// YAML keys in a config file are the environment variable names in lower case.
 type Config struct {
 	// Database configuration
-	DatabaseURL string // PostgreSQL connection string (if set, uses PostgreSQL)
-	SQLitePath  string // SQLite database path (default: ./myfamily.db)
+	DatabaseURL string `yaml:"database_url"` // PostgreSQL connection string (if set, uses PostgreSQL)
+	SQLitePath  string `yaml:"sqlite_path"`  // SQLite database path (default: ./myfamily.db)
 
 	// Server configuration
-	Port      int    // HTTP server port (default: 8080)
-	LogLevel  string // Logging level: debug, info, warn, error (default: info)
-	LogFormat string // Log format: text, json (default: text)
+	Port      int    `yaml:"port"`       // HTTP server port (default: 8080)
+	LogLevel  string `yaml:"log_level"`  // Logging level: debug, info, warn, error (default: info)
+	LogFormat string `yaml:"log_format"` // Log format: text, json (default: text)
+
+	// TLS: either a certificate/key pair or automatic certificates from
+	// Let's Encrypt for the listed domains
+	TLSCert      string   `yaml:"tls_cert"`       // PEM certificate file
+	TLSKey       string   `yaml:"tls_key"`        // PEM private key file
+	ACMEDomains  []string `yaml:"acme_domain"`    // Domains to obtain certificates for via ACME
+	ACMECacheDir string   `yaml:"acme_cache_dir"` // Directory for ACME account and certificates (default: ./acme-cache)
+
+	// Authentication
+	APITokens       []string `yaml:"api_tokens"`        // Accepted bearer tokens; empty disables authentication
+	AuthPublicReads bool     `yaml:"auth_public_reads"` // When tokens are set, allow GET/HEAD requests without a token
+	AdminTokens     []string `yaml:"admin_tokens"`      // Bearer tokens for /admin endpoints; empty disables them
+
+	// Rate limiting (per client IP, token bucket)
+	RateLimit          int `yaml:"rate_limit"`           // API requests per minute; 0 disables (default: 0)
+	RateLimitExpensive int `yaml:"rate_limit_expensive"` // Search, export, GraphQL and admin requests per minute, on top of RateLimit; 0 disables (default: 0)
+
+	// Reverse proxies whose X-Forwarded-For header names the client IP. With
+	// none, the client IP is the connection's peer address and forwarding
+	// headers are ignored, so clients cannot choose their own rate limit key.
+	TrustedProxies []string `yaml:"trusted_proxies"` // Proxy IP addresses or CIDR ranges, e.g. 10.0.0.0/8
+
+	// Cross-origin requests, for a frontend served from another origin
+	CORSAllowOrigins []string `yaml:"cors_allow_origins"` // Origins allowed to call the API, e.g. https://app.example.com; "*" allows any (default: *)
+	CORSAllowMethods []string `yaml:"cors_allow_methods"` // Methods allowed in cross-origin requests (default: GET,POST,PUT,DELETE,OPTIONS)
+	CORSAllowHeaders []string `yaml:"cors_allow_headers"` // Request headers allowed in addition to those the API itself reads
+
+	// Name handling
+	IgnoreSurnamePrefix bool `yaml:"ignore_surname_prefix"` // Sort and group surnames without particles ("van Gogh" under G)
+
+	// Home person: the tree owner, whose relationship to each person is
+	// reported on person details
+	HomePerson string `yaml:"home_person"` // Person ID, as shown in the person's URL
+
+	// Event store configuration
+	SnapshotEvery int `yaml:"snapshot_every"` // Snapshot a stream after every N events; 0 disables (default: 50)
+
+	// Media configuration
+	ThumbnailSize int `yaml:"thumbnail_size"` // Default thumbnail width/height in pixels (default: 300)
+	MaxMediaSize  int `yaml:"max_media_size"` // Largest accepted media upload in megabytes (default: 10)
+
+	// GEDCOM import
+	MaxImportSize int `yaml:"max_import_size"` // Largest accepted import upload in megabytes, including any media archive (default: 100)
+
+	// Tree views (pedigree, ahnentafel, descendancy)
+	DefaultGenerations int `yaml:"default_generations"` // Generations shown when a request does not ask; 0 keeps each view's own default (5 ancestors, 4 descendants)
+	MaxGenerations     int `yaml:"max_generations"`     // Most generations a request may ask for (default: 10)
+
+	// Person, family, and source search
+	SearchMinQueryLength int `yaml:"search_min_query_length"` // Fewest characters a search query may have; 1 suits CJK names (default: 2)
+	SearchMaxLimit       int `yaml:"search_max_limit"`        // Most results a search request may ask for (default: 100)
+
+	// Media storage: "database" keeps file content in the read model,
+	// "filesystem" and "s3" keep it in an external blob store
+	MediaStorage       string `yaml:"media_storage"`              // Storage backend (default: database)
+	MediaStoragePath   string `yaml:"media_storage_path"`         // Directory for filesystem storage (default: ./media)
+	MediaS3Endpoint    string `yaml:"media_s3_endpoint"`          // S3-compatible endpoint URL, e.g. https://s3.us-east-1.amazonaws.com
+	MediaS3Bucket      string `yaml:"media_s3_bucket"`            // Bucket for S3 storage
+	MediaS3Region      string `yaml:"media_s3_region"`            // Signing region for S3 storage (default: us-east-1)
+	MediaS3AccessKeyID string `yaml:"media_s3_access_key_id"`     // Access key for S3 storage
+	MediaS3SecretKey   string `yaml:"media_s3_secret_access_key"` // Secret key for S3 storage
 
 	// Demo mode
-	DemoMode bool // Run with pre-loaded sample data (ephemeral)
+	DemoMode bool `yaml:"demo_mode"` // Run with pre-loaded sample data (ephemeral)
+
+	// Family trees hosted alongside the default one, each with its own
+	// database: a sibling SQLite file or a PostgreSQL schema
+	Trees []string `yaml:"trees"` // Additional tree IDs, e.g. paternal,maternal
+}
+
+// defaults returns the configuration used when neither a file nor the
+// environment sets a value.
+func defaults() *Config {
+	return &Config{
+		SQLitePath:           "./myfamily.db",
+		Port:                 8080,
+		LogLevel:             "info",
+		LogFormat:            "text",
+		ACMECacheDir:         "./acme-cache",
+		SnapshotEvery:        50,
+		ThumbnailSize:        300,
+		MaxMediaSize:         10,
+		MaxImportSize:        100,
+		MaxGenerations:       10,
+		SearchMinQueryLength: 2,
+		SearchMaxLimit:       100,
+		MediaStorage:         "database",
+		MediaStoragePath:     "./media",
+		MediaS3Region:        "us-east-1",
+		CORSAllowOrigins:     []string{"*"},
+		CORSAllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+	}
 }
 
 // Load reads configuration from environment variables.
 func Load() *Config {
-	cfg := &Config{
-		DatabaseURL: os.Getenv("DATABASE_URL"),
-		SQLitePath:  getEnvOrDefault("SQLITE_PATH", "./myfamily.db"),
-		Port:        getEnvIntOrDefault("PORT", 8080),
-		LogLevel:    getEnvOrDefault("LOG_LEVEL", "info"),
-		LogFormat:   getEnvOrDefault("LOG_FORMAT", "text"),
-		DemoMode:    getEnvBoolOrDefault("DEMO_MODE", false),
+	return applyEnv(defaults())
+}
+
+// LoadFile reads configuration from a YAML file, then applies environment
+// variables on top so they override values from the file. Unknown keys are
+// rejected to catch typos.
+func LoadFile(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config file: %w", err)
+	}
+
+	cfg := defaults()
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parse config file %s: %w", path, err)
 	}
+	return applyEnv(cfg), nil
+}
+
+// applyEnv overrides cfg with any configuration set in environment variables.
+func applyEnv(cfg *Config) *Config {
+	cfg.DatabaseURL = getEnvOrDefault("DATABASE_URL", cfg.DatabaseURL)
+	cfg.SQLitePath = getEnvOrDefault("SQLITE_PATH", cfg.SQLitePath)
+	cfg.Port = getEnvIntOrDefault("PORT", cfg.Port)
+	cfg.LogLevel = getEnvOrDefault("LOG_LEVEL", cfg.LogLevel)
+	cfg.LogFormat = getEnvOrDefault("LOG_FORMAT", cfg.LogFormat)
+	cfg.TLSCert = getEnvOrDefault("TLS_CERT", cfg.TLSCert)
+	cfg.TLSKey = getEnvOrDefault("TLS_KEY", cfg.TLSKey)
+	cfg.ACMEDomains = getEnvListOrDefault("ACME_DOMAIN", cfg.ACMEDomains)
+	cfg.ACMECacheDir = getEnvOrDefault("ACME_CACHE_DIR", cfg.ACMECacheDir)
+	cfg.SnapshotEvery = getEnvIntOrDefault("SNAPSHOT_EVERY", cfg.SnapshotEvery)
+	cfg.ThumbnailSize = getEnvIntOrDefault("THUMBNAIL_SIZE", cfg.ThumbnailSize)
+	cfg.MaxMediaSize = getEnvIntOrDefault("MAX_MEDIA_SIZE", cfg.MaxMediaSize)
+	cfg.MaxImportSize = getEnvIntOrDefault("MAX_IMPORT_SIZE", cfg.MaxImportSize)
+	cfg.DefaultGenerations = getEnvIntOrDefault("DEFAULT_GENERATIONS", cfg.DefaultGenerations)
+	cfg.MaxGenerations = getEnvIntOrDefault("MAX_GENERATIONS", cfg.MaxGenerations)
+	cfg.SearchMinQueryLength = getEnvIntOrDefault("SEARCH_MIN_QUERY_LENGTH", cfg.SearchMinQueryLength)
+	cfg.SearchMaxLimit = getEnvIntOrDefault("SEARCH_MAX_LIMIT", cfg.SearchMaxLimit)
+	cfg.DemoMode = getEnvBoolOrDefault("DEMO_MODE", cfg.DemoMode)
+	cfg.IgnoreSurnamePrefix = getEnvBoolOrDefault("IGNORE_SURNAME_PREFIX", cfg.IgnoreSurnamePrefix)
+	cfg.HomePerson = getEnvOrDefault("HOME_PERSON", cfg.HomePerson)
+
+	cfg.MediaStorage = strings.ToLower(getEnvOrDefault("MEDIA_STORAGE", cfg.MediaStorage))
+	cfg.MediaStoragePath = getEnvOrDefault("MEDIA_STORAGE_PATH", cfg.MediaStoragePath)
+	cfg.MediaS3Endpoint = getEnvOrDefault("MEDIA_S3_ENDPOINT", cfg.MediaS3Endpoint)
+	cfg.MediaS3Bucket = getEnvOrDefault("MEDIA_S3_BUCKET", cfg.MediaS3Bucket)
+	cfg.MediaS3Region = getEnvOrDefault("MEDIA_S3_REGION", cfg.MediaS3Region)
+	cfg.MediaS3AccessKeyID = getEnvOrDefault("MEDIA_S3_ACCESS_KEY_ID", cfg.MediaS3AccessKeyID)
+	cfg.MediaS3SecretKey = getEnvOrDefault("MEDIA_S3_SECRET_ACCESS_KEY", cfg.MediaS3SecretKey)
+
+	cfg.APITokens = getEnvListOrDefault("API_TOKENS", cfg.APITokens)
+	cfg.Trees = getEnvListOrDefault("TREES", cfg.Trees)
+	cfg.AuthPublicReads = getEnvBoolOrDefault("AUTH_PUBLIC_READS", cfg.AuthPublicReads)
+	cfg.AdminTokens = getEnvListOrDefault("ADMIN_TOKENS", cfg.AdminTokens)
+
+	cfg.RateLimit = getEnvIntOrDefault("RATE_LIMIT", cfg.RateLimit)
+	cfg.RateLimitExpensive = getEnvIntOrDefault("RATE_LIMIT_EXPENSIVE", cfg.RateLimitExpensive)
+	cfg.TrustedProxies = getEnvListOrDefault("TRUSTED_PROXIES", cfg.TrustedProxies)
+
+	cfg.CORSAllowOrigins = getEnvListOrDefault("CORS_ALLOW_ORIGINS", cfg.CORSAllowOrigins)
+	cfg.CORSAllowMethods = getEnvListOrDefault("CORS_ALLOW_METHODS", cfg.CORSAllowMethods)
+	cfg.CORSAllowHeaders = getEnvListOrDefault("CORS_ALLOW_HEADERS", cfg.CORSAllowHeaders)
 	return cfg
 }
 
+// AuthEnabled returns true if API requests must carry a bearer token.
+func (c *Config) AuthEnabled() bool {
+	return len(c.APITokens) > 0
+}
+
+// TLSEnabled returns true if the server should listen over TLS.
+func (c *Config) TLSEnabled() bool {
+	return c.TLSCert != "" || c.TLSKey != "" || len(c.ACMEDomains) > 0
+}
+
+// ValidateTLS reports an incomplete or conflicting TLS configuration.
+func (c *Config) ValidateTLS() error {
+	if (c.TLSCert == "") != (c.TLSKey == "") {
+		return errors.New("TLS_CERT and TLS_KEY must be set together")
+	}
+	if c.TLSCert != "" && len(c.ACMEDomains) > 0 {
+		return errors.New("TLS_CERT/TLS_KEY and ACME_DOMAIN are mutually exclusive")
+	}
+	return nil
+}
+
+// ValidateCORS reports allowed origins that can never match a browser's
+// Origin header, such as a bare host name without a scheme.
+func (c *Config) ValidateCORS() error {
+	for _, origin := range c.CORSAllowOrigins {
+		if origin != "*" && !strings.Contains(origin, "://") {
+			return fmt.Errorf("CORS origin %q must be \"*\" or include a scheme, e.g. https://%s", origin, origin)
+		}
+	}
+	return nil
+}
+
+// ValidateTrustedProxies reports trusted proxies that are neither an IP
+// address nor a CIDR range.
+func (c *Config) ValidateTrustedProxies() error {
+	_, err := c.TrustedProxyRanges()
+	return err
+}
+
+// TrustedProxyRanges returns the trusted proxies as IP ranges, a single
+// address becoming a one-address range.
+func (c *Config) TrustedProxyRanges() ([]*net.IPNet, error) {
+	ranges := make([]*net.IPNet, 0, len(c.TrustedProxies))
+	for _, proxy := range c.TrustedProxies {
+		if ip := net.ParseIP(proxy); ip != nil {
+			bits := 8 * len(ip.To16())
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 32
+			}
+			ranges = append(ranges, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q is not an IP address or CIDR range", proxy)
+		}
+		ranges = append(ranges, ipNet)
+	}
+	return ranges, nil
+}
+
+// ValidateHomePerson reports a home person that is not a person ID.
+func (c *Config) ValidateHomePerson() error {
+	if c.HomePerson == "" {
+		return nil
+	}
+	if _, err := uuid.Parse(c.HomePerson); err != nil {
+		return fmt.Errorf("HOME_PERSON %q is not a person ID: %w", c.HomePerson, err)
+	}
+	return nil
+}
+
+// HomePersonID returns the home person's ID, or uuid.Nil when none is set.
+func (c *Config) HomePersonID() uuid.UUID {
+	id, err := uuid.Parse(c.HomePerson)
+	if err != nil {
+		return uuid.Nil
+	}
+	return id
+}
+
+// DefaultTreeID identifies the tree served at the unprefixed API routes.
+const DefaultTreeID = "default"
+
+// treeIDPattern is what a tree ID may look like. IDs appear in URLs, file
+// names, and PostgreSQL schema names, so they are kept to a safe subset.
+var treeIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,39}$`)
+
+// ValidateTrees reports malformed, reserved, or repeated tree IDs.
+func (c *Config) ValidateTrees() error {
+	seen := map[string]bool{}
+	for _, id := range c.Trees {
+		switch {
+		case !treeIDPattern.MatchString(id):
+			return fmt.Errorf("tree ID %q must be 1-40 lowercase letters, digits, hyphens, or underscores", id)
+		case id == DefaultTreeID:
+			return fmt.Errorf("tree ID %q is reserved for the default tree", id)
+		case seen[id]:
+			return fmt.Errorf("tree ID %q is listed more than once", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// TreeSQLitePath returns the SQLite database path for an additional tree: the
+// default database's path with the tree ID appended to its name, e.g.
+// ./myfamily-maternal.db.
+func (c *Config) TreeSQLitePath(treeID string) string {
+	ext := filepath.Ext(c.SQLitePath)
+	return strings.TrimSuffix(c.SQLitePath, ext) + "-" + treeID + ext
+}
+
 // UsePostgreSQL returns true if PostgreSQL should be used.
 func (c *Config) UsePostgreSQL() bool {
 	return c.DatabaseURL != ""
@@ -70,3 +340,18 @@ func getEnvIntOrDefault(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// getEnvListOrDefault returns the comma-separated environment variable as a
+// list with blank entries dropped, or a default.
+func getEnvListOrDefault(key string, defaultValue []string) []string {
+	var values []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	if len(values) == 0 {
+		return defaultValue
+	}
+	return values
+}
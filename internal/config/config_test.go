@@ -1,6 +1,10 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -28,6 +32,38 @@ func TestLoad_Defaults(t *testing.T) {
 		t.Errorf("expected LogFormat to be 'text', got %q", cfg.LogFormat)
 	}
 
+	if cfg.SnapshotEvery != 50 {
+		t.Errorf("expected SnapshotEvery to be 50, got %d", cfg.SnapshotEvery)
+	}
+
+	if cfg.ThumbnailSize != 300 {
+		t.Errorf("expected ThumbnailSize to be 300, got %d", cfg.ThumbnailSize)
+	}
+
+	if cfg.MaxMediaSize != 10 {
+		t.Errorf("expected MaxMediaSize to be 10, got %d", cfg.MaxMediaSize)
+	}
+
+	if cfg.MaxImportSize != 100 {
+		t.Errorf("expected MaxImportSize to be 100, got %d", cfg.MaxImportSize)
+	}
+
+	if cfg.DefaultGenerations != 0 {
+		t.Errorf("expected DefaultGenerations to be 0, got %d", cfg.DefaultGenerations)
+	}
+
+	if cfg.MaxGenerations != 10 {
+		t.Errorf("expected MaxGenerations to be 10, got %d", cfg.MaxGenerations)
+	}
+
+	if cfg.SearchMinQueryLength != 2 {
+		t.Errorf("expected SearchMinQueryLength to be 2, got %d", cfg.SearchMinQueryLength)
+	}
+
+	if cfg.SearchMaxLimit != 100 {
+		t.Errorf("expected SearchMaxLimit to be 100, got %d", cfg.SearchMaxLimit)
+	}
+
 	if cfg.DemoMode {
 		t.Error("expected DemoMode to be false by default")
 	}
@@ -40,6 +76,14 @@ func TestLoad_AllEnvVarsSet(t *testing.T) {
 	t.Setenv("PORT", "3000")
 	t.Setenv("LOG_LEVEL", "debug")
 	t.Setenv("LOG_FORMAT", "json")
+	t.Setenv("SNAPSHOT_EVERY", "10")
+	t.Setenv("THUMBNAIL_SIZE", "200")
+	t.Setenv("MAX_MEDIA_SIZE", "25")
+	t.Setenv("MAX_IMPORT_SIZE", "250")
+	t.Setenv("DEFAULT_GENERATIONS", "6")
+	t.Setenv("MAX_GENERATIONS", "15")
+	t.Setenv("SEARCH_MIN_QUERY_LENGTH", "1")
+	t.Setenv("SEARCH_MAX_LIMIT", "500")
 
 	cfg := Load()
 
@@ -62,6 +106,38 @@ func TestLoad_AllEnvVarsSet(t *testing.T) {
 	if cfg.LogFormat != "json" {
 		t.Errorf("expected LogFormat to be 'json', got %q", cfg.LogFormat)
 	}
+
+	if cfg.SnapshotEvery != 10 {
+		t.Errorf("expected SnapshotEvery to be 10, got %d", cfg.SnapshotEvery)
+	}
+
+	if cfg.ThumbnailSize != 200 {
+		t.Errorf("expected ThumbnailSize to be 200, got %d", cfg.ThumbnailSize)
+	}
+
+	if cfg.MaxMediaSize != 25 {
+		t.Errorf("expected MaxMediaSize to be 25, got %d", cfg.MaxMediaSize)
+	}
+
+	if cfg.MaxImportSize != 250 {
+		t.Errorf("expected MaxImportSize to be 250, got %d", cfg.MaxImportSize)
+	}
+
+	if cfg.DefaultGenerations != 6 {
+		t.Errorf("expected DefaultGenerations to be 6, got %d", cfg.DefaultGenerations)
+	}
+
+	if cfg.MaxGenerations != 15 {
+		t.Errorf("expected MaxGenerations to be 15, got %d", cfg.MaxGenerations)
+	}
+
+	if cfg.SearchMinQueryLength != 1 {
+		t.Errorf("expected SearchMinQueryLength to be 1, got %d", cfg.SearchMinQueryLength)
+	}
+
+	if cfg.SearchMaxLimit != 500 {
+		t.Errorf("expected SearchMaxLimit to be 500, got %d", cfg.SearchMaxLimit)
+	}
 }
 
 func TestUsePostgreSQL_WithDatabaseURL(t *testing.T) {
@@ -193,3 +269,313 @@ func TestLoad_DemoMode(t *testing.T) {
 		t.Error("expected DemoMode to be true when DEMO_MODE=true")
 	}
 }
+
+func TestLoad_Auth(t *testing.T) {
+	cfg := Load()
+	if cfg.AuthEnabled() {
+		t.Error("expected auth to be disabled without API_TOKENS")
+	}
+
+	t.Setenv("API_TOKENS", " alpha, ,beta ")
+	t.Setenv("AUTH_PUBLIC_READS", "true")
+	t.Setenv("ADMIN_TOKENS", "root")
+	cfg = Load()
+	if !cfg.AuthEnabled() {
+		t.Error("expected auth to be enabled when API_TOKENS is set")
+	}
+	if len(cfg.APITokens) != 2 || cfg.APITokens[0] != "alpha" || cfg.APITokens[1] != "beta" {
+		t.Errorf("expected APITokens [alpha beta], got %q", cfg.APITokens)
+	}
+	if !cfg.AuthPublicReads {
+		t.Error("expected AuthPublicReads to be true when AUTH_PUBLIC_READS=true")
+	}
+	if !slices.Equal(cfg.AdminTokens, []string{"root"}) {
+		t.Errorf("expected AdminTokens [root], got %q", cfg.AdminTokens)
+	}
+}
+
+func TestLoad_RateLimit(t *testing.T) {
+	cfg := Load()
+	if cfg.RateLimit != 0 || cfg.RateLimitExpensive != 0 {
+		t.Errorf("expected rate limiting disabled by default, got %d/%d", cfg.RateLimit, cfg.RateLimitExpensive)
+	}
+
+	t.Setenv("RATE_LIMIT", "600")
+	t.Setenv("RATE_LIMIT_EXPENSIVE", "30")
+	cfg = Load()
+	if cfg.RateLimit != 600 {
+		t.Errorf("expected RateLimit 600, got %d", cfg.RateLimit)
+	}
+	if cfg.RateLimitExpensive != 30 {
+		t.Errorf("expected RateLimitExpensive 30, got %d", cfg.RateLimitExpensive)
+	}
+}
+
+func TestTrustedProxyRanges(t *testing.T) {
+	cfg := &Config{TrustedProxies: []string{"10.0.0.0/8", "192.168.1.5", "::1"}}
+	ranges, err := cfg.TrustedProxyRanges()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"10.0.0.0/8", "192.168.1.5/32", "::1/128"}
+	if len(ranges) != len(want) {
+		t.Fatalf("got %d ranges, want %d", len(ranges), len(want))
+	}
+	for i, w := range want {
+		if ranges[i].String() != w {
+			t.Errorf("ranges[%d] = %s, want %s", i, ranges[i], w)
+		}
+	}
+
+	invalid := &Config{TrustedProxies: []string{"proxy.example.com"}}
+	if err := invalid.ValidateTrustedProxies(); err == nil {
+		t.Error("expected an error for a host name")
+	}
+}
+
+func TestLoad_CORS(t *testing.T) {
+	cfg := Load()
+	if len(cfg.CORSAllowOrigins) != 1 || cfg.CORSAllowOrigins[0] != "*" {
+		t.Errorf("expected CORSAllowOrigins [*] by default, got %q", cfg.CORSAllowOrigins)
+	}
+	if len(cfg.CORSAllowMethods) != 5 {
+		t.Errorf("expected 5 default CORSAllowMethods, got %q", cfg.CORSAllowMethods)
+	}
+
+	t.Setenv("CORS_ALLOW_ORIGINS", "http://localhost:5173, https://app.example.com")
+	t.Setenv("CORS_ALLOW_METHODS", "GET,POST")
+	t.Setenv("CORS_ALLOW_HEADERS", "X-Custom")
+	cfg = Load()
+	if len(cfg.CORSAllowOrigins) != 2 || cfg.CORSAllowOrigins[1] != "https://app.example.com" {
+		t.Errorf("expected two CORSAllowOrigins, got %q", cfg.CORSAllowOrigins)
+	}
+	if len(cfg.CORSAllowMethods) != 2 || cfg.CORSAllowMethods[0] != "GET" {
+		t.Errorf("expected CORSAllowMethods [GET POST], got %q", cfg.CORSAllowMethods)
+	}
+	if len(cfg.CORSAllowHeaders) != 1 || cfg.CORSAllowHeaders[0] != "X-Custom" {
+		t.Errorf("expected CORSAllowHeaders [X-Custom], got %q", cfg.CORSAllowHeaders)
+	}
+}
+
+func TestValidateCORS(t *testing.T) {
+	valid := &Config{CORSAllowOrigins: []string{"*", "https://app.example.com", "http://localhost:5173"}}
+	if err := valid.ValidateCORS(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	invalid := &Config{CORSAllowOrigins: []string{"app.example.com"}}
+	if err := invalid.ValidateCORS(); err == nil {
+		t.Error("expected an error for an origin without a scheme")
+	}
+}
+
+func TestValidateHomePerson(t *testing.T) {
+	for _, home := range []string{"", "3f2b9c1e-8a4d-4b7e-9c2a-1d5e6f7a8b9c"} {
+		cfg := &Config{HomePerson: home}
+		if err := cfg.ValidateHomePerson(); err != nil {
+			t.Errorf("HomePerson %q: unexpected error: %v", home, err)
+		}
+	}
+	cfg := &Config{HomePerson: "me"}
+	if err := cfg.ValidateHomePerson(); err == nil {
+		t.Error("expected an error for a home person that is not a UUID")
+	}
+	if id := cfg.HomePersonID(); id.String() != "00000000-0000-0000-0000-000000000000" {
+		t.Errorf("HomePersonID() = %s, want nil UUID", id)
+	}
+}
+
+func TestLoad_MediaStorage(t *testing.T) {
+	cfg := Load()
+	if cfg.MediaStorage != "database" {
+		t.Errorf("expected MediaStorage 'database' by default, got %q", cfg.MediaStorage)
+	}
+	if cfg.MediaStoragePath != "./media" {
+		t.Errorf("expected MediaStoragePath './media' by default, got %q", cfg.MediaStoragePath)
+	}
+	if cfg.MediaS3Region != "us-east-1" {
+		t.Errorf("expected MediaS3Region 'us-east-1' by default, got %q", cfg.MediaS3Region)
+	}
+
+	t.Setenv("MEDIA_STORAGE", "S3")
+	t.Setenv("MEDIA_S3_ENDPOINT", "http://minio:9000")
+	t.Setenv("MEDIA_S3_BUCKET", "family")
+	t.Setenv("MEDIA_S3_REGION", "eu-west-1")
+	t.Setenv("MEDIA_S3_ACCESS_KEY_ID", "key")
+	t.Setenv("MEDIA_S3_SECRET_ACCESS_KEY", "secret")
+	cfg = Load()
+	if cfg.MediaStorage != "s3" {
+		t.Errorf("expected MediaStorage 's3', got %q", cfg.MediaStorage)
+	}
+	if cfg.MediaS3Endpoint != "http://minio:9000" || cfg.MediaS3Bucket != "family" || cfg.MediaS3Region != "eu-west-1" {
+		t.Errorf("unexpected S3 location %q/%q in %q", cfg.MediaS3Endpoint, cfg.MediaS3Bucket, cfg.MediaS3Region)
+	}
+	if cfg.MediaS3AccessKeyID != "key" || cfg.MediaS3SecretKey != "secret" {
+		t.Errorf("unexpected S3 credentials %q/%q", cfg.MediaS3AccessKeyID, cfg.MediaS3SecretKey)
+	}
+}
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "myfamily.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadFile(t *testing.T) {
+	path := writeConfigFile(t, `
+sqlite_path: /data/family.db
+port: 9090
+snapshot_every: 100
+media_storage: Filesystem
+api_tokens:
+  - one
+  - two
+auth_public_reads: true
+`)
+
+	cfg, err := LoadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.SQLitePath != "/data/family.db" || cfg.Port != 9090 || cfg.SnapshotEvery != 100 {
+		t.Errorf("file values not applied: %+v", cfg)
+	}
+	if cfg.MediaStorage != "filesystem" {
+		t.Errorf("expected MediaStorage 'filesystem', got %q", cfg.MediaStorage)
+	}
+	if !slices.Equal(cfg.APITokens, []string{"one", "two"}) || !cfg.AuthPublicReads {
+		t.Errorf("unexpected auth settings %v/%v", cfg.APITokens, cfg.AuthPublicReads)
+	}
+	// Keys missing from the file keep their defaults
+	if cfg.LogLevel != "info" || cfg.ThumbnailSize != 300 {
+		t.Errorf("expected defaults for unset keys, got %q/%d", cfg.LogLevel, cfg.ThumbnailSize)
+	}
+}
+
+func TestLoadFile_EnvOverridesFile(t *testing.T) {
+	path := writeConfigFile(t, "port: 9090\nlog_level: warn\n")
+	t.Setenv("PORT", "7070")
+
+	cfg, err := LoadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Port != 7070 {
+		t.Errorf("expected PORT to override file, got %d", cfg.Port)
+	}
+	if cfg.LogLevel != "warn" {
+		t.Errorf("expected LogLevel 'warn' from file, got %q", cfg.LogLevel)
+	}
+}
+
+func TestLoadFile_Empty(t *testing.T) {
+	cfg, err := LoadFile(writeConfigFile(t, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Port != 8080 {
+		t.Errorf("expected default Port 8080, got %d", cfg.Port)
+	}
+}
+
+func TestLoadFile_Errors(t *testing.T) {
+	if _, err := LoadFile(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("expected error for missing file")
+	}
+
+	_, err := LoadFile(writeConfigFile(t, "prot: 9090\n"))
+	if err == nil || !strings.Contains(err.Error(), "prot") {
+		t.Errorf("expected unknown key error, got %v", err)
+	}
+
+	if _, err := LoadFile(writeConfigFile(t, "port: eighty\n")); err == nil {
+		t.Error("expected error for invalid value")
+	}
+}
+
+func TestLoad_TLS(t *testing.T) {
+	cfg := Load()
+	if cfg.TLSEnabled() {
+		t.Error("expected TLS to be disabled by default")
+	}
+	if cfg.ACMECacheDir != "./acme-cache" {
+		t.Errorf("expected ACMECacheDir './acme-cache' by default, got %q", cfg.ACMECacheDir)
+	}
+
+	t.Setenv("ACME_DOMAIN", "family.example.com, www.family.example.com")
+	cfg = Load()
+	if !cfg.TLSEnabled() {
+		t.Error("expected TLS to be enabled with ACME_DOMAIN")
+	}
+	if !slices.Equal(cfg.ACMEDomains, []string{"family.example.com", "www.family.example.com"}) {
+		t.Errorf("unexpected ACMEDomains %v", cfg.ACMEDomains)
+	}
+}
+
+func TestValidateTLS(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{"disabled", Config{}, false},
+		{"cert and key", Config{TLSCert: "cert.pem", TLSKey: "key.pem"}, false},
+		{"acme", Config{ACMEDomains: []string{"family.example.com"}}, false},
+		{"cert without key", Config{TLSCert: "cert.pem"}, true},
+		{"key without cert", Config{TLSKey: "key.pem"}, true},
+		{"cert and acme", Config{TLSCert: "cert.pem", TLSKey: "key.pem", ACMEDomains: []string{"family.example.com"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.ValidateTLS(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTLS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoad_Trees(t *testing.T) {
+	t.Setenv("TREES", "paternal, maternal")
+	cfg := Load()
+	if len(cfg.Trees) != 2 || cfg.Trees[0] != "paternal" || cfg.Trees[1] != "maternal" {
+		t.Errorf("expected Trees [paternal maternal], got %q", cfg.Trees)
+	}
+	if got := cfg.TreeSQLitePath("maternal"); got != "./myfamily-maternal.db" {
+		t.Errorf("TreeSQLitePath = %q, want ./myfamily-maternal.db", got)
+	}
+}
+
+func TestValidateTrees(t *testing.T) {
+	tests := []struct {
+		name    string
+		trees   []string
+		wantErr bool
+	}{
+		{"none", nil, false},
+		{"valid", []string{"paternal", "maternal-2"}, false},
+		{"uppercase", []string{"Paternal"}, true},
+		{"path separator", []string{"../x"}, true},
+		{"reserved", []string{"default"}, true},
+		{"duplicate", []string{"a", "a"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{Trees: tt.trees}
+			if err := cfg.ValidateTrees(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTrees() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoad_IgnoreSurnamePrefix(t *testing.T) {
+	if Load().IgnoreSurnamePrefix {
+		t.Error("expected IgnoreSurnamePrefix to be false by default")
+	}
+	t.Setenv("IGNORE_SURNAME_PREFIX", "true")
+	if !Load().IgnoreSurnamePrefix {
+		t.Error("expected IgnoreSurnamePrefix to be true")
+	}
+}
@@ -25,6 +25,11 @@ func (e AssociationValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e AssociationValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // Association represents a GEDCOM ASSO (association) record.
 // GEDCOM supports associations to link individuals with specific roles like
 // godparents, witnesses, business partners, mentors, etc.
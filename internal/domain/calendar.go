@@ -30,7 +30,8 @@ func domainToGedcomCalendar(calendar string) (gedcomlib.Calendar, bool) {
 //
 // The calendrical arithmetic is delegated to gedcom-go. A year is required;
 // partial dates (missing day or month) convert the components that are present.
-// Range/period end dates (Year2/Month2/Day2) are converted as well.
+// Range/period end dates (Year2/Month2/Day2) are converted as well. Dual-dated
+// years convert from their New Style year, so the result has no DualYear.
 //
 // Returns an error if the calendar is unrecognized or the date lacks a year.
 func (g GenDate) ToGregorian() (GenDate, error) {
@@ -43,7 +44,7 @@ func (g GenDate) ToGregorian() (GenDate, error) {
 		return g, fmt.Errorf("unrecognized calendar: %q", g.Calendar)
 	}
 
-	year, month, day, err := gregorianComponents(cal, g.Year, g.Month, g.Day)
+	year, month, day, err := gregorianComponents(cal, newStyleYear(g.Year, g.DualYear), g.Month, g.Day)
 	if err != nil {
 		return g, fmt.Errorf("converting %q to Gregorian: %w", g.Calendar, err)
 	}
@@ -59,7 +60,7 @@ func (g GenDate) ToGregorian() (GenDate, error) {
 	// Convert the range/period end date when present so BET/FROM ranges remain
 	// intact and comparable after conversion.
 	if g.Year2 != nil {
-		year2, month2, day2, err := gregorianComponents(cal, g.Year2, g.Month2, g.Day2)
+		year2, month2, day2, err := gregorianComponents(cal, newStyleYear(g.Year2, g.DualYear2), g.Month2, g.Day2)
 		if err != nil {
 			return g, fmt.Errorf("converting %q range end to Gregorian: %w", g.Calendar, err)
 		}
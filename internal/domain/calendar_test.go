@@ -121,6 +121,29 @@ func TestParseGenDate_CalendarRange(t *testing.T) {
 	assertIntPtr(t, "Year2", got.Year2, intPtr(1700))
 }
 
+// TestParseGenDate_CalendarRangeEscapes ensures a range that repeats the escape
+// before each date, as GEDCOM specifies, keeps its end date.
+func TestParseGenDate_CalendarRangeEscapes(t *testing.T) {
+	in := "FROM @#DFRENCH R@ VEND 3 TO @#DFRENCH R@ 5 FRUC 8"
+	got := ParseGenDate(in)
+	if got.Calendar != CalendarFrench {
+		t.Fatalf("Calendar = %q, want %q", got.Calendar, CalendarFrench)
+	}
+	assertIntPtr(t, "Year", got.Year, intPtr(3))
+	assertIntPtr(t, "Month", got.Month, intPtr(1))
+	assertIntPtr(t, "Year2", got.Year2, intPtr(8))
+	assertIntPtr(t, "Month2", got.Month2, intPtr(12))
+	assertIntPtr(t, "Day2", got.Day2, intPtr(5))
+	if f := got.Format(); f != in {
+		t.Errorf("Format() = %q, want %q", f, in)
+	}
+
+	// 1 Vendemiaire III is 22 September 1794
+	if sort := got.SortDate(); !sort.Equal(time.Date(1794, 9, 22, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("SortDate() = %v, want 1794-09-22", sort)
+	}
+}
+
 func TestGenDate_Format_Calendars(t *testing.T) {
 	tests := []struct {
 		name string
@@ -147,6 +170,11 @@ func TestGenDate_Format_Calendars(t *testing.T) {
 			date: GenDate{Calendar: CalendarJulian, Qualifier: DateAbout, Year: intPtr(1600)},
 			want: "ABT @#DJULIAN@ 1600",
 		},
+		{
+			name: "range repeats escape on both dates",
+			date: GenDate{Calendar: CalendarJulian, Qualifier: DateBet, Year: intPtr(1600), Year2: intPtr(1610)},
+			want: "BET @#DJULIAN@ 1600 AND @#DJULIAN@ 1610",
+		},
 		{
 			name: "gregorian has no escape",
 			date: GenDate{Calendar: CalendarGregorian, Qualifier: DateExact, Year: intPtr(2020), Month: intPtr(12), Day: intPtr(25)},
@@ -171,6 +199,7 @@ func TestGenDate_CalendarRoundTrip(t *testing.T) {
 		"@#DHEBREW@ 15 NSN 5785",
 		"@#DFRENCH R@ 1 VEND 1",
 		"ABT @#DJULIAN@ 1600",
+		"FROM @#DFRENCH R@ 1 VEND 2 TO @#DFRENCH R@ 3 COMP 3",
 		"25 DEC 2020",
 	}
 	for _, in := range inputs {
@@ -212,6 +241,25 @@ func TestGenDate_Validate_Calendars(t *testing.T) {
 			date:    GenDate{Calendar: CalendarJulian, Year: intPtr(1600), Month: intPtr(13)},
 			wantErr: true,
 		},
+		{
+			name:    "french months have 30 days",
+			date:    GenDate{Calendar: CalendarFrench, Year: intPtr(2), Month: intPtr(11), Day: intPtr(31)},
+			wantErr: true,
+		},
+		{
+			name: "french sixth complementary day is valid",
+			date: GenDate{Calendar: CalendarFrench, Year: intPtr(3), Month: intPtr(13), Day: intPtr(6)},
+		},
+		{
+			name:    "french complementary days end at 6",
+			date:    GenDate{Calendar: CalendarFrench, Year: intPtr(3), Month: intPtr(13), Day: intPtr(7)},
+			wantErr: true,
+		},
+		{
+			name:    "hebrew months have at most 30 days",
+			date:    GenDate{Calendar: CalendarHebrew, Year: intPtr(5785), Month: intPtr(1), Day: intPtr(31)},
+			wantErr: true,
+		},
 		{
 			name:    "hebrew month 14 is invalid",
 			date:    GenDate{Calendar: CalendarHebrew, Year: intPtr(5785), Month: intPtr(14)},
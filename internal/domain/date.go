@@ -18,14 +18,15 @@ const (
 	DateBef   DateQualifier = "bef"   // Before (BEF)
 	DateAft   DateQualifier = "aft"   // After (AFT)
 	DateBet   DateQualifier = "bet"   // Between (BET ... AND ...)
-	DateFrom  DateQualifier = "from"  // From/to range (FROM ... TO ...)
+	DateFrom  DateQualifier = "from"  // From/to range (FROM ... TO ...), or open-ended (FROM ...)
+	DateTo    DateQualifier = "to"    // Period ending at a date (TO ...)
 	DateInt   DateQualifier = "int"   // Interpreted (INT <date> (<original phrase>))
 )
 
 // IsValid checks if the date qualifier is valid.
 func (d DateQualifier) IsValid() bool {
 	switch d {
-	case DateExact, DateAbout, DateCalc, DateEst, DateBef, DateAft, DateBet, DateFrom, DateInt:
+	case DateExact, DateAbout, DateCalc, DateEst, DateBef, DateAft, DateBet, DateFrom, DateTo, DateInt:
 		return true
 	default:
 		return false
@@ -44,6 +45,13 @@ type GenDate struct {
 	Day2      *int          `json:"day2,omitempty"`     // End day for ranges
 	Calendar  string        `json:"calendar,omitempty"` // DGREGORIAN (default), DJULIAN, etc.
 
+	// DualYear holds the New Style year of a dual-dated year such as "1719/20"
+	// (1720), written for dates between 1 January and 24 March before England
+	// moved the start of the year in 1752. Year keeps the Old Style year (1719).
+	// Dates sort by the New Style year. DualYear2 is the same for a range end.
+	DualYear  *int `json:"dual_year,omitempty"`
+	DualYear2 *int `json:"dual_year2,omitempty"`
+
 	// InterpretedFrom holds the original ambiguous phrase for interpreted (INT)
 	// dates, e.g. "about eighteen fifty" from "INT 1850 (about eighteen fifty)".
 	// Preserved for research transparency so others can evaluate the interpretation.
@@ -131,6 +139,23 @@ func reverseMonthMapFor(calendar string) map[int]string {
 	}
 }
 
+// maxDayFor returns the highest valid day number for a month in the given
+// calendar. French Republican months have 30 days and the complementary days
+// at most 6; Hebrew months have at most 30 days.
+func maxDayFor(calendar string, month *int) int {
+	switch calendar {
+	case CalendarFrench:
+		if month != nil && *month == 13 {
+			return 6
+		}
+		return 30
+	case CalendarHebrew:
+		return 30
+	default:
+		return 31
+	}
+}
+
 // maxMonthFor returns the highest valid month number for the given calendar.
 // Hebrew and French Republican calendars have a 13th month.
 func maxMonthFor(calendar string) int {
@@ -140,21 +165,25 @@ func maxMonthFor(calendar string) int {
 	return 12
 }
 
-// qualifierPrefixes maps GEDCOM date prefixes to their qualifiers.
-var qualifierPrefixes = []struct {
-	prefix    string
-	qualifier DateQualifier
-}{
-	{"ABT ", DateAbout},
-	{"ABOUT ", DateAbout},
-	{"CAL ", DateCalc},
-	{"EST ", DateEst},
-	{"BEF ", DateBef},
-	{"BEFORE ", DateBef},
-	{"AFT ", DateAft},
-	{"AFTER ", DateAft},
-	{"BET ", DateBet},
-	{"FROM ", DateFrom},
+// monthNames maps full English month names to month numbers, so dates copied
+// from records ("24 March 1719/20") parse alongside the GEDCOM codes.
+var monthNames = map[string]int{
+	"JANUARY": 1, "FEBRUARY": 2, "MARCH": 3, "APRIL": 4, "JUNE": 6, "JULY": 7,
+	"AUGUST": 8, "SEPTEMBER": 9, "OCTOBER": 10, "NOVEMBER": 11, "DECEMBER": 12,
+}
+
+// qualifierWords maps GEDCOM date qualifiers, their spelled-out forms, and
+// abbreviations common in files exported by other programs ("CIRCA", "C.") to
+// qualifiers. A trailing period ("ABT.") is ignored when matching.
+var qualifierWords = map[string]DateQualifier{
+	"ABT": DateAbout, "ABOUT": DateAbout, "CIRCA": DateAbout, "CA": DateAbout, "C": DateAbout,
+	"CAL": DateCalc, "CALCULATED": DateCalc,
+	"EST": DateEst, "ESTIMATED": DateEst,
+	"BEF": DateBef, "BEFORE": DateBef,
+	"AFT": DateAft, "AFTER": DateAft,
+	"BET": DateBet, "BETWEEN": DateBet,
+	"FROM": DateFrom,
+	"TO":   DateTo,
 }
 
 // ParseGenDate parses a GEDCOM-format date string into a GenDate. It detects and
@@ -188,33 +217,33 @@ func ParseGenDate(s string) GenDate {
 		return parseInterpretedGenDate(s, gd)
 	}
 
-	// Check for qualifiers using table-driven lookup
-	for _, qp := range qualifierPrefixes {
-		if strings.HasPrefix(work, qp.prefix) {
-			gd.Qualifier = qp.qualifier
-			work = strings.TrimPrefix(work, qp.prefix)
-			break
+	// Check for a leading qualifier word using table-driven lookup
+	if word, rest, ok := strings.Cut(work, " "); ok {
+		if q, found := qualifierWords[strings.TrimSuffix(word, ".")]; found {
+			gd.Qualifier = q
+			work = strings.TrimSpace(rest)
 		}
 	}
 
-	// Handle ranges (BET ... AND ..., FROM ... TO ...)
+	// Handle ranges (BET ... AND ..., FROM ... TO ...). Without the second
+	// part, only the start is parsed ("FROM 1850" is an open-ended period).
 	if gd.Qualifier == DateBet {
 		if parts := strings.SplitN(work, " AND ", 2); len(parts) == 2 {
-			parseSimpleDate(strings.TrimSpace(parts[0]), gd.Calendar, &gd.Year, &gd.Month, &gd.Day)
-			parseSimpleDate(strings.TrimSpace(parts[1]), gd.Calendar, &gd.Year2, &gd.Month2, &gd.Day2)
+			parseSimpleDate(strings.TrimSpace(parts[0]), gd.Calendar, &gd.Year, &gd.DualYear, &gd.Month, &gd.Day)
+			parseSimpleDate(strings.TrimSpace(parts[1]), gd.Calendar, &gd.Year2, &gd.DualYear2, &gd.Month2, &gd.Day2)
 			return gd
 		}
 	}
 	if gd.Qualifier == DateFrom {
 		if parts := strings.SplitN(work, " TO ", 2); len(parts) == 2 {
-			parseSimpleDate(strings.TrimSpace(parts[0]), gd.Calendar, &gd.Year, &gd.Month, &gd.Day)
-			parseSimpleDate(strings.TrimSpace(parts[1]), gd.Calendar, &gd.Year2, &gd.Month2, &gd.Day2)
+			parseSimpleDate(strings.TrimSpace(parts[0]), gd.Calendar, &gd.Year, &gd.DualYear, &gd.Month, &gd.Day)
+			parseSimpleDate(strings.TrimSpace(parts[1]), gd.Calendar, &gd.Year2, &gd.DualYear2, &gd.Month2, &gd.Day2)
 			return gd
 		}
 	}
 
 	// Parse simple date
-	parseSimpleDate(work, gd.Calendar, &gd.Year, &gd.Month, &gd.Day)
+	parseSimpleDate(work, gd.Calendar, &gd.Year, &gd.DualYear, &gd.Month, &gd.Day)
 	return gd
 }
 
@@ -237,64 +266,124 @@ func parseInterpretedGenDate(s string, gd GenDate) GenDate {
 		gd.InterpretedFrom = phrase
 	}
 
-	parseSimpleDate(strings.ToUpper(datePart), gd.Calendar, &gd.Year, &gd.Month, &gd.Day)
+	parseSimpleDate(strings.ToUpper(datePart), gd.Calendar, &gd.Year, &gd.DualYear, &gd.Month, &gd.Day)
 	return gd
 }
 
-// extractCalendarEscape finds and removes a GEDCOM calendar escape sequence
-// (e.g. "@#DJULIAN@") from an upper-cased date string. It returns the calendar
-// token, the remaining string with whitespace normalized, and whether a known
-// escape was found. Unknown escapes are left in place and reported as not found.
+// extractCalendarEscape finds and removes GEDCOM calendar escape sequences
+// (e.g. "@#DJULIAN@") from an upper-cased date string. GEDCOM repeats the escape
+// before each date of a range ("FROM @#DJULIAN@ 1700 TO @#DJULIAN@ 1710"); a
+// GenDate has a single calendar, taken from the first escape, and every known
+// escape is removed. It returns the calendar token, the remaining string with
+// whitespace normalized, and whether a known escape was found. Unknown escapes
+// are left in place and reported as not found.
 func extractCalendarEscape(s string) (calendar, rest string, found bool) {
-	start := strings.Index(s, "@#")
-	if start == -1 {
-		return "", s, false
-	}
-	end := strings.Index(s[start+2:], "@")
-	if end == -1 {
-		return "", s, false
+	rest = s
+	for offset := 0; ; {
+		start := strings.Index(rest[offset:], "@#")
+		if start == -1 {
+			break
+		}
+		start += offset
+		end := strings.Index(rest[start+2:], "@")
+		if end == -1 {
+			break
+		}
+		token := rest[start+2 : start+2+end]
+		if !calendarTokens[token] {
+			if !found {
+				return "", s, false
+			}
+			offset = start + 2
+			continue
+		}
+		if !found {
+			calendar, found = token, true
+		}
+		rest = rest[:start] + " " + rest[start+2+end+1:]
+		offset = start
 	}
-	token := s[start+2 : start+2+end]
-	if !calendarTokens[token] {
+	if !found {
 		return "", s, false
 	}
-	remainder := s[:start] + " " + s[start+2+end+1:]
-	return token, strings.Join(strings.Fields(remainder), " "), true
+	return calendar, strings.Join(strings.Fields(rest), " "), true
 }
 
 // parseSimpleDate parses a simple date like "1 JAN 1850", "JAN 1850", or "1850".
-// Month codes are interpreted according to the given calendar system.
-func parseSimpleDate(s, calendar string, year, month, day **int) {
+// Month codes are interpreted according to the given calendar system; Gregorian
+// and Julian dates also accept full English month names. The year may be
+// dual-dated ("1719/20"), in which case the New Style year is stored in dual.
+func parseSimpleDate(s, calendar string, year, dual, month, day **int) {
 	s = strings.TrimSpace(s)
 	parts := strings.Fields(s)
 	months := monthMapFor(calendar)
+	lookupMonth := func(code string) (int, bool) {
+		if m, ok := months[code]; ok {
+			return m, true
+		}
+		if calendar == CalendarHebrew || calendar == CalendarFrench {
+			return 0, false
+		}
+		m, ok := monthNames[code]
+		return m, ok
+	}
 
 	switch len(parts) {
 	case 1:
 		// Year only: "1850"
-		if y, err := strconv.Atoi(parts[0]); err == nil {
-			*year = &y
-		}
+		parseYear(parts[0], year, dual)
 	case 2:
 		// Month Year: "JAN 1850"
-		if m, ok := months[parts[0]]; ok {
+		if m, ok := lookupMonth(parts[0]); ok {
 			*month = &m
-			if y, err := strconv.Atoi(parts[1]); err == nil {
-				*year = &y
-			}
+			parseYear(parts[1], year, dual)
 		}
 	case 3:
 		// Day Month Year: "1 JAN 1850"
 		if d, err := strconv.Atoi(parts[0]); err == nil {
 			*day = &d
 		}
-		if m, ok := months[parts[1]]; ok {
+		if m, ok := lookupMonth(parts[1]); ok {
 			*month = &m
 		}
-		if y, err := strconv.Atoi(parts[2]); err == nil {
-			*year = &y
+		parseYear(parts[2], year, dual)
+	}
+}
+
+// parseYear parses a year, which may be dual-dated as "1719/20" or "1719/1720".
+// A two-digit New Style year takes the century of the Old Style year, rolling
+// over when needed ("1699/00" is 1700).
+func parseYear(s string, year, dual **int) {
+	oldStyle, newStyle, isDual := strings.Cut(s, "/")
+	y, err := strconv.Atoi(oldStyle)
+	if err != nil {
+		return
+	}
+	*year = &y
+	if !isDual {
+		return
+	}
+
+	n, err := strconv.Atoi(newStyle)
+	if err != nil || n < 0 {
+		return
+	}
+	if len(newStyle) <= 2 {
+		n += y / 100 * 100
+		if n < y {
+			n += 100
 		}
 	}
+	*dual = &n
+}
+
+// newStyleYear returns the year used for ordering: the New Style year of a
+// dual-dated year, otherwise the year itself.
+func newStyleYear(year, dual *int) *int {
+	if dual != nil {
+		return dual
+	}
+	return year
 }
 
 // String returns the GEDCOM-format string representation.
@@ -324,19 +413,32 @@ func (g *GenDate) Format() string {
 		qualPrefix = "BEF "
 	case DateAft:
 		qualPrefix = "AFT "
+	case DateTo:
+		qualPrefix = "TO "
 	case DateBet:
-		return fmt.Sprintf("BET %s AND %s", g.calendarEscape()+formatSimpleDate(g.Calendar, g.Year, g.Month, g.Day), formatSimpleDate(g.Calendar, g.Year2, g.Month2, g.Day2))
+		return g.formatRange("BET ", " AND ")
 	case DateFrom:
-		return fmt.Sprintf("FROM %s TO %s", g.calendarEscape()+formatSimpleDate(g.Calendar, g.Year, g.Month, g.Day), formatSimpleDate(g.Calendar, g.Year2, g.Month2, g.Day2))
+		return g.formatRange("FROM ", " TO ")
 	case DateInt:
-		datePart := g.calendarEscape() + formatSimpleDate(g.Calendar, g.Year, g.Month, g.Day)
+		datePart := g.calendarEscape() + formatSimpleDate(g.Calendar, g.Year, g.DualYear, g.Month, g.Day)
 		if g.InterpretedFrom != "" {
 			return fmt.Sprintf("INT %s (%s)", datePart, g.InterpretedFrom)
 		}
 		return "INT " + datePart
 	}
 
-	return qualPrefix + g.calendarEscape() + formatSimpleDate(g.Calendar, g.Year, g.Month, g.Day)
+	return qualPrefix + g.calendarEscape() + formatSimpleDate(g.Calendar, g.Year, g.DualYear, g.Month, g.Day)
+}
+
+// formatRange formats a BET or FROM range, omitting the separator and end
+// date when the range is open-ended. Both dates carry the calendar escape, since
+// GEDCOM reads an end date without one as Gregorian.
+func (g *GenDate) formatRange(prefix, separator string) string {
+	start := prefix + g.calendarEscape() + formatSimpleDate(g.Calendar, g.Year, g.DualYear, g.Month, g.Day)
+	if g.Year2 == nil {
+		return start
+	}
+	return start + separator + g.calendarEscape() + formatSimpleDate(g.Calendar, g.Year2, g.DualYear2, g.Month2, g.Day2)
 }
 
 // calendarEscape returns the GEDCOM escape prefix (with trailing space) for a
@@ -348,7 +450,7 @@ func (g *GenDate) calendarEscape() string {
 	return "@#" + g.Calendar + "@ "
 }
 
-func formatSimpleDate(calendar string, year, month, day *int) string {
+func formatSimpleDate(calendar string, year, dual, month, day *int) string {
 	if year == nil {
 		return ""
 	}
@@ -362,7 +464,12 @@ func formatSimpleDate(calendar string, year, month, day *int) string {
 			parts = append(parts, code)
 		}
 	}
-	parts = append(parts, strconv.Itoa(*year))
+	if dual != nil {
+		// GEDCOM writes the New Style year as its last two digits
+		parts = append(parts, fmt.Sprintf("%d/%02d", *year, *dual%100))
+	} else {
+		parts = append(parts, strconv.Itoa(*year))
+	}
 	return strings.Join(parts, " ")
 }
 
@@ -381,13 +488,24 @@ func (g *GenDate) ToTime() time.Time {
 	// timeline directly, so convert them first. A conversion failure (e.g. a
 	// malformed date) sorts as unknown rather than at an arbitrary instant.
 	if g.Calendar != "" && g.Calendar != CalendarGregorian {
-		greg, err := g.ToGregorian()
+		// Convert the first day of the period a partial date names, so
+		// "@#DFRENCH R@ VEND 3" places at 22 September 1794 rather than at the
+		// start of the Gregorian month it converts to.
+		first := 1
+		start := GenDate{Calendar: g.Calendar, Year: g.Year, DualYear: g.DualYear, Month: g.Month, Day: g.Day}
+		if start.Month == nil {
+			start.Month = &first
+		}
+		if start.Day == nil {
+			start.Day = &first
+		}
+		greg, err := start.ToGregorian()
 		if err != nil {
 			return time.Time{}
 		}
 		return greg.ToTime()
 	}
-	year := *g.Year
+	year := *newStyleYear(g.Year, g.DualYear)
 	month := time.January
 	day := 1
 	if g.Month != nil {
@@ -399,12 +517,36 @@ func (g *GenDate) ToTime() time.Time {
 	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
 }
 
-// SortDate returns a date suitable for sorting comparisons.
-// For "before" dates, returns the date itself.
-// For "after" dates, returns the date itself.
-// For ranges, returns the start date.
+// SortDate returns the resolved point used to order dates. Exact, approximate,
+// BEF/AFT, INT, and TO dates sort at the date they name and FROM periods at
+// their start. BET ranges sort at the midpoint of their bounds, so
+// "BET 1840 AND 1850" falls between an exact 1840 and an exact 1850 date.
+// Returns the zero time when the date cannot be placed on a timeline.
 func (g *GenDate) SortDate() time.Time {
-	return g.ToTime()
+	start := g.ToTime()
+	if start.IsZero() || g.Qualifier != DateBet || g.Year2 == nil {
+		return start
+	}
+	rangeEnd := GenDate{Calendar: g.Calendar, Year: g.Year2, DualYear: g.DualYear2, Month: g.Month2, Day: g.Day2}
+	end := rangeEnd.ToTime()
+	if end.IsZero() || end.Before(start) {
+		return start
+	}
+	// Average in seconds; a time.Duration cannot span more than ~292 years
+	return time.Unix(start.Unix()+(end.Unix()-start.Unix())/2, 0).UTC()
+}
+
+// IsUncertain reports whether the qualifier leaves the date imprecise:
+// approximate (ABT, CAL, EST), bounded (BEF, AFT, BET), or interpreted (INT).
+// Exact dates and FROM/TO periods, which state a known span, are not uncertain.
+// Partial dates such as "1850" are not uncertain by themselves.
+func (g *GenDate) IsUncertain() bool {
+	switch g.Qualifier {
+	case DateAbout, DateCalc, DateEst, DateBef, DateAft, DateBet, DateInt:
+		return true
+	default:
+		return false
+	}
 }
 
 // Validate checks if the date components are valid.
@@ -413,15 +555,22 @@ func (g *GenDate) Validate() error {
 	if g.Month != nil && (*g.Month < 1 || *g.Month > maxMonth) {
 		return fmt.Errorf("invalid month: %d", *g.Month)
 	}
-	if g.Day != nil && (*g.Day < 1 || *g.Day > 31) {
+	if g.Day != nil && (*g.Day < 1 || *g.Day > maxDayFor(g.Calendar, g.Month)) {
 		return fmt.Errorf("invalid day: %d", *g.Day)
 	}
 	if g.Month2 != nil && (*g.Month2 < 1 || *g.Month2 > maxMonth) {
 		return fmt.Errorf("invalid month2: %d", *g.Month2)
 	}
-	if g.Day2 != nil && (*g.Day2 < 1 || *g.Day2 > 31) {
+	if g.Day2 != nil && (*g.Day2 < 1 || *g.Day2 > maxDayFor(g.Calendar, g.Month2)) {
 		return fmt.Errorf("invalid day2: %d", *g.Day2)
 	}
+	// Dual dating only ever spans consecutive years ("1719/20")
+	if g.DualYear != nil && (g.Year == nil || *g.DualYear != *g.Year+1) {
+		return fmt.Errorf("invalid dual year: %s", formatSimpleDate(g.Calendar, g.Year, g.DualYear, nil, nil))
+	}
+	if g.DualYear2 != nil && (g.Year2 == nil || *g.DualYear2 != *g.Year2+1) {
+		return fmt.Errorf("invalid dual year2: %s", formatSimpleDate(g.Calendar, g.Year2, g.DualYear2, nil, nil))
+	}
 	return nil
 }
 
@@ -434,3 +583,63 @@ func (g *GenDate) Before(other *GenDate) bool {
 func (g *GenDate) After(other *GenDate) bool {
 	return g.ToTime().After(other.ToTime())
 }
+
+// qualifierSortOrder ranks qualifiers for dates that resolve to the same point:
+// stated dates first, then approximations, then bounds and periods.
+var qualifierSortOrder = map[DateQualifier]int{
+	DateExact: 0,
+	DateInt:   1,
+	DateAbout: 2,
+	DateCalc:  3,
+	DateEst:   4,
+	DateBef:   5,
+	DateAft:   6,
+	DateBet:   7,
+	DateFrom:  8,
+	DateTo:    9,
+}
+
+// SortRank breaks ties between dates with the same SortDate. Coarser dates rank
+// first, since a year or month begins at the point it resolves to: "1850"
+// precedes "JAN 1850", which precedes "1 JAN 1850". At equal precision an exact
+// date precedes an approximate or bounded one. Lower ranks sort earlier.
+func (g *GenDate) SortRank() int {
+	precision := 0
+	if g.Month != nil {
+		precision = 1
+		if g.Day != nil {
+			precision = 2
+		}
+	}
+	rank, ok := qualifierSortOrder[g.Qualifier]
+	if !ok {
+		rank = len(qualifierSortOrder)
+	}
+	return precision*(len(qualifierSortOrder)+1) + rank
+}
+
+// Compare defines a total ordering on dates, returning -1, 0, or 1. Dates order
+// by SortDate, then by SortRank, then by their formatted text, so dates of mixed
+// precision and approximate dates sort deterministically. Dates that cannot be
+// placed on a timeline sort after all others.
+func (g *GenDate) Compare(other *GenDate) int {
+	a, b := g.SortDate(), other.SortDate()
+	switch {
+	case a.IsZero() && !b.IsZero():
+		return 1
+	case !a.IsZero() && b.IsZero():
+		return -1
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	rankA, rankB := g.SortRank(), other.SortRank()
+	switch {
+	case rankA < rankB:
+		return -1
+	case rankA > rankB:
+		return 1
+	}
+	return strings.Compare(g.Format(), other.Format())
+}
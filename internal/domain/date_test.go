@@ -1,7 +1,9 @@
 package domain
 
 import (
+	"sort"
 	"testing"
+	"time"
 )
 
 func TestParseGenDate(t *testing.T) {
@@ -84,6 +86,51 @@ func TestParseGenDate(t *testing.T) {
 			wantYear:  intPtr(1850),
 			wantYear2: intPtr(1860),
 		},
+		{
+			name:      "between spelled out",
+			input:     "BETWEEN 1840 AND 1850",
+			wantQual:  DateBet,
+			wantYear:  intPtr(1840),
+			wantYear2: intPtr(1850),
+		},
+		{
+			name:     "open-ended from",
+			input:    "FROM 1850",
+			wantQual: DateFrom,
+			wantYear: intPtr(1850),
+		},
+		{
+			name:     "to date",
+			input:    "TO 1860",
+			wantQual: DateTo,
+			wantYear: intPtr(1860),
+		},
+		{
+			name:     "abbreviation with period",
+			input:    "ABT. 1850",
+			wantQual: DateAbout,
+			wantYear: intPtr(1850),
+		},
+		{
+			name:      "before with period and full date",
+			input:     "Bef. 3 MAR 1850",
+			wantQual:  DateBef,
+			wantYear:  intPtr(1850),
+			wantMonth: intPtr(3),
+			wantDay:   intPtr(3),
+		},
+		{
+			name:     "circa abbreviation",
+			input:    "c. 1850",
+			wantQual: DateAbout,
+			wantYear: intPtr(1850),
+		},
+		{
+			name:     "estimated spelled out",
+			input:    "ESTIMATED 1850",
+			wantQual: DateEst,
+			wantYear: intPtr(1850),
+		},
 		{
 			name:     "lowercase input",
 			input:    "abt 1850",
@@ -346,6 +393,21 @@ func TestGenDate_Format(t *testing.T) {
 			date: GenDate{Qualifier: DateFrom, Year: intPtr(1850), Year2: intPtr(1860)},
 			want: "FROM 1850 TO 1860",
 		},
+		{
+			name: "open-ended between",
+			date: GenDate{Qualifier: DateBet, Year: intPtr(1850)},
+			want: "BET 1850",
+		},
+		{
+			name: "open-ended from",
+			date: GenDate{Qualifier: DateFrom, Year: intPtr(1850)},
+			want: "FROM 1850",
+		},
+		{
+			name: "to date",
+			date: GenDate{Qualifier: DateTo, Year: intPtr(1860)},
+			want: "TO 1860",
+		},
 		{
 			name: "interpreted date with phrase",
 			date: GenDate{Qualifier: DateInt, Year: intPtr(1850), InterpretedFrom: "about eighteen fifty"},
@@ -374,12 +436,138 @@ func TestGenDate_Format(t *testing.T) {
 }
 
 func TestGenDate_SortDate(t *testing.T) {
-	date := GenDate{Year: intPtr(1850), Month: intPtr(1), Day: intPtr(1)}
-	sortDate := date.SortDate()
-	expected := date.ToTime()
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"1 JAN 1850", time.Date(1850, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"ABT 1850", time.Date(1850, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"BEF MAR 1850", time.Date(1850, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"AFT 1850", time.Date(1850, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"BET 1 JAN 1850 AND 1 JAN 1852", time.Date(1851, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"BET 1850", time.Date(1850, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"FROM 1850 TO 1860", time.Date(1850, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"TO 1860", time.Date(1860, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			date := ParseGenDate(tt.input)
+			if got := date.SortDate(); !got.Equal(tt.want) {
+				t.Errorf("SortDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenDate_SortDate_WideRange(t *testing.T) {
+	// Wider than a time.Duration can hold
+	date := ParseGenDate("BET 1400 AND 1900")
+	want := time.Date(1650, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := date.SortDate(); got.Sub(want).Abs() > 48*time.Hour {
+		t.Errorf("SortDate() = %v, want about %v", got, want)
+	}
+}
+
+func TestGenDate_Compare_MixedPrecision(t *testing.T) {
+	// Expected ascending order; shuffled inputs must always sort the same way
+	want := []string{
+		"1849",
+		"1850",
+		"ABT 1850",
+		"BEF 1850",
+		"JAN 1850",
+		"1 JAN 1850",
+		"ABT 1 JAN 1850",
+		"15 MAR 1850",
+		"BET 1850 AND 1851",
+		"1851",
+		"",
+	}
+	for _, order := range [][]int{{10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, {5, 1, 10, 3, 7, 0, 9, 2, 8, 4, 6}} {
+		dates := make([]GenDate, len(order))
+		for i, idx := range order {
+			dates[i] = ParseGenDate(want[idx])
+		}
+		sort.Slice(dates, func(i, j int) bool { return dates[i].Compare(&dates[j]) < 0 })
+		for i, d := range dates {
+			if d.Raw != want[i] {
+				t.Errorf("position %d = %q, want %q", i, d.Raw, want[i])
+			}
+		}
+	}
+}
+
+func TestGenDate_Compare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1850", "1850", 0},
+		{"1850", "1 JAN 1850", -1},
+		{"1 JAN 1850", "JAN 1850", 1},
+		{"ABT 1850", "1850", 1},
+		{"ABT 1850", "EST 1850", -1},
+		{"1851", "31 DEC 1850", 1},
+		{"", "1850", 1},
+		{"1850", "", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			a, b := ParseGenDate(tt.a), ParseGenDate(tt.b)
+			if got := a.Compare(&b); got != tt.want {
+				t.Errorf("Compare() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenDate_IsUncertain(t *testing.T) {
+	tests := map[string]bool{
+		"1 JAN 1850":        false,
+		"1850":              false,
+		"FROM 1850 TO 1860": false,
+		"TO 1860":           false,
+		"ABT 1850":          true,
+		"CAL 1850":          true,
+		"EST 1850":          true,
+		"BEF 1850":          true,
+		"AFT 1850":          true,
+		"BET 1840 AND 1850": true,
+		"INT 1850 (c1850)":  true,
+	}
+
+	for input, want := range tests {
+		date := ParseGenDate(input)
+		if got := date.IsUncertain(); got != want {
+			t.Errorf("ParseGenDate(%q).IsUncertain() = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestGenDate_FormatRoundTrip(t *testing.T) {
+	// Parsing then formatting (ignoring Raw) yields the canonical GEDCOM form
+	tests := map[string]string{
+		"BET 1840 AND 1850":               "BET 1840 AND 1850",
+		"between jan 1840 and 3 mar 1850": "BET JAN 1840 AND 3 MAR 1850",
+		"ABT. 1850":                       "ABT 1850",
+		"circa 1850":                      "ABT 1850",
+		"Bef. 1850":                       "BEF 1850",
+		"AFTER 1850":                      "AFT 1850",
+		"FROM 1850":                       "FROM 1850",
+		"TO 1860":                         "TO 1860",
+		"BET @#DJULIAN@ 1700 AND 1710":    "BET @#DJULIAN@ 1700 AND @#DJULIAN@ 1710",
+	}
 
-	if !sortDate.Equal(expected) {
-		t.Errorf("SortDate() = %v, want %v", sortDate, expected)
+	for input, want := range tests {
+		date := ParseGenDate(input)
+		if date.String() != input {
+			t.Errorf("ParseGenDate(%q).String() = %q, want the original input", input, date.String())
+		}
+		if got := date.Format(); got != want {
+			t.Errorf("ParseGenDate(%q).Format() = %q, want %q", input, got, want)
+		}
 	}
 }
 
@@ -429,6 +617,11 @@ func TestDateQualifier_IsValid(t *testing.T) {
 			qualifier: DateFrom,
 			want:      true,
 		},
+		{
+			name:      "to is valid",
+			qualifier: DateTo,
+			want:      true,
+		},
 		{
 			name:      "interpreted is valid",
 			qualifier: DateInt,
@@ -471,3 +664,137 @@ func ptrStr(p *int) string {
 	}
 	return string(rune(*p + '0'))
 }
+
+func TestParseGenDate_DualYear(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantYear  *int
+		wantDual  *int
+		wantMonth *int
+		wantDay   *int
+		wantDual2 *int
+	}{
+		{
+			name:      "two-digit new style year",
+			input:     "24 MAR 1719/20",
+			wantYear:  intPtr(1719),
+			wantDual:  intPtr(1720),
+			wantMonth: intPtr(3),
+			wantDay:   intPtr(24),
+		},
+		{
+			name:      "full month name",
+			input:     "24 March 1719/20",
+			wantYear:  intPtr(1719),
+			wantDual:  intPtr(1720),
+			wantMonth: intPtr(3),
+			wantDay:   intPtr(24),
+		},
+		{
+			name:      "four-digit new style year",
+			input:     "FEB 1750/1751",
+			wantYear:  intPtr(1750),
+			wantDual:  intPtr(1751),
+			wantMonth: intPtr(2),
+		},
+		{
+			name:     "century rollover",
+			input:    "1699/00",
+			wantYear: intPtr(1699),
+			wantDual: intPtr(1700),
+		},
+		{
+			name:      "range end",
+			input:     "BET 1718 AND 1719/20",
+			wantYear:  intPtr(1718),
+			wantDual2: intPtr(1720),
+		},
+		{
+			name:     "plain year has no dual year",
+			input:    "1720",
+			wantYear: intPtr(1720),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseGenDate(tt.input)
+			if !intPtrEqual(got.Year, tt.wantYear) {
+				t.Errorf("Year = %v, want %v", ptrStr(got.Year), ptrStr(tt.wantYear))
+			}
+			if !intPtrEqual(got.DualYear, tt.wantDual) {
+				t.Errorf("DualYear = %v, want %v", ptrStr(got.DualYear), ptrStr(tt.wantDual))
+			}
+			if !intPtrEqual(got.Month, tt.wantMonth) {
+				t.Errorf("Month = %v, want %v", ptrStr(got.Month), ptrStr(tt.wantMonth))
+			}
+			if !intPtrEqual(got.Day, tt.wantDay) {
+				t.Errorf("Day = %v, want %v", ptrStr(got.Day), ptrStr(tt.wantDay))
+			}
+			if !intPtrEqual(got.DualYear2, tt.wantDual2) {
+				t.Errorf("DualYear2 = %v, want %v", ptrStr(got.DualYear2), ptrStr(tt.wantDual2))
+			}
+		})
+	}
+}
+
+func TestGenDate_DualYear_Format(t *testing.T) {
+	tests := map[string]string{
+		"24 March 1719/20":          "24 MAR 1719/20",
+		"FEB 1750/1751":             "FEB 1750/51",
+		"ABT 1699/00":               "ABT 1699/00",
+		"BET 1718/19 AND 1719/20":   "BET 1718/19 AND 1719/20",
+		"@#DJULIAN@ 11 FEB 1731/32": "@#DJULIAN@ 11 FEB 1731/32",
+	}
+
+	for input, want := range tests {
+		date := ParseGenDate(input)
+		if got := date.Format(); got != want {
+			t.Errorf("ParseGenDate(%q).Format() = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestGenDate_DualYear_SortsByNewStyleYear(t *testing.T) {
+	dual := ParseGenDate("24 MAR 1719/20")
+	if got, want := dual.SortDate(), time.Date(1720, 3, 24, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("SortDate() = %v, want %v", got, want)
+	}
+
+	// 24 March 1719/20 came after 1 June 1719 but before 1 June 1720
+	before := ParseGenDate("1 JUN 1719")
+	after := ParseGenDate("1 JUN 1720")
+	if !dual.After(&before) || !dual.Before(&after) {
+		t.Error("dual-dated year should order by its New Style year")
+	}
+}
+
+func TestGenDate_DualYear_ToGregorian(t *testing.T) {
+	// Julian 24 March 1719/20 is Gregorian 4 April 1720
+	date := ParseGenDate("@#DJULIAN@ 24 MAR 1719/20")
+	greg, err := date.ToGregorian()
+	if err != nil {
+		t.Fatalf("ToGregorian() error = %v", err)
+	}
+	if greg.Format() != "4 APR 1720" {
+		t.Errorf("ToGregorian() = %q, want %q", greg.Format(), "4 APR 1720")
+	}
+}
+
+func TestGenDate_DualYear_Validate(t *testing.T) {
+	tests := map[string]bool{
+		"24 MAR 1719/20":         false,
+		"24 MAR 1719/21":         true,
+		"24 MAR 1719/1718":       true,
+		"BET 1718 AND 1719/20":   false,
+		"BET 1718 AND 1719/1730": true,
+	}
+
+	for input, wantErr := range tests {
+		date := ParseGenDate(input)
+		if err := date.Validate(); (err != nil) != wantErr {
+			t.Errorf("ParseGenDate(%q).Validate() error = %v, wantErr %v", input, err, wantErr)
+		}
+	}
+}
@@ -4,16 +4,19 @@ package domain
 // Gender represents the gender of a person.
 type Gender string
 
+// An empty Gender means none was recorded; GenderUnknown means the record
+// says it could not be determined (GEDCOM SEX U).
 const (
-	GenderMale    Gender = "male"
-	GenderFemale  Gender = "female"
-	GenderUnknown Gender = "unknown"
+	GenderMale      Gender = "male"
+	GenderFemale    Gender = "female"
+	GenderNonbinary Gender = "nonbinary" // GEDCOM 7 SEX X
+	GenderUnknown   Gender = "unknown"
 )
 
 // IsValid checks if the gender value is valid.
 func (g Gender) IsValid() bool {
 	switch g {
-	case GenderMale, GenderFemale, GenderUnknown, "":
+	case GenderMale, GenderFemale, GenderNonbinary, GenderUnknown, "":
 		return true
 	default:
 		return false
@@ -175,12 +178,13 @@ const (
 	NameTypeImmigrant    NameType = "immigrant"    // Name after immigration (anglicized, etc.)
 	NameTypeReligious    NameType = "religious"    // Religious name (confirmation, ordination)
 	NameTypeProfessional NameType = "professional" // Professional/stage name
+	NameTypePatronymic   NameType = "patronymic"   // Surname derived from the father's given name (Jónsdóttir), not a family surname
 )
 
 // IsValid checks if the name type value is valid.
 func (n NameType) IsValid() bool {
 	switch n {
-	case NameTypeBirth, NameTypeMarried, NameTypeAKA, NameTypeImmigrant, NameTypeReligious, NameTypeProfessional, "":
+	case NameTypeBirth, NameTypeMarried, NameTypeAKA, NameTypeImmigrant, NameTypeReligious, NameTypeProfessional, NameTypePatronymic, "":
 		return true
 	default:
 		return false
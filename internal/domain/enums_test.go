@@ -18,6 +18,11 @@ func TestGender_IsValid(t *testing.T) {
 			gender: GenderFemale,
 			want:   true,
 		},
+		{
+			name:   "nonbinary is valid",
+			gender: GenderNonbinary,
+			want:   true,
+		},
 		{
 			name:   "unknown is valid",
 			gender: GenderUnknown,
@@ -534,6 +539,11 @@ func TestNameType_IsValid(t *testing.T) {
 			nameType: NameTypeProfessional,
 			want:     true,
 		},
+		{
+			name:     "patronymic is valid",
+			nameType: NameTypePatronymic,
+			want:     true,
+		},
 		{
 			name:     "empty string is valid",
 			nameType: "",
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"encoding/json"
+	"reflect"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,6 +19,7 @@ type Event interface {
 type BaseEvent struct {
 	ID        uuid.UUID `json:"id"`
 	Timestamp time.Time `json:"timestamp"`
+	Actor     string    `json:"actor,omitempty"` // Who made the change; empty if unattributed
 }
 
 // OccurredAt returns when the event occurred.
@@ -33,6 +35,23 @@ func NewBaseEvent() BaseEvent {
 	}
 }
 
+// WithActor returns a copy of event attributed to actor. Events that do not
+// embed BaseEvent are returned unchanged.
+func WithActor(event Event, actor string) Event {
+	v := reflect.ValueOf(event)
+	if v.Kind() != reflect.Struct {
+		return event
+	}
+	stamped := reflect.New(v.Type()).Elem()
+	stamped.Set(v)
+	base := stamped.FieldByName("BaseEvent")
+	if !base.IsValid() || base.Type() != reflect.TypeOf(BaseEvent{}) {
+		return event
+	}
+	base.FieldByName("Actor").SetString(actor)
+	return stamped.Interface().(Event)
+}
+
 // PersonCreated event is emitted when a new person is created.
 type PersonCreated struct {
 	BaseEvent
@@ -121,13 +140,14 @@ func NewPersonDeleted(personID uuid.UUID, reason string) PersonDeleted {
 // FamilyCreated event is emitted when a new family is created.
 type FamilyCreated struct {
 	BaseEvent
-	FamilyID         uuid.UUID    `json:"family_id"`
-	Partner1ID       *uuid.UUID   `json:"partner1_id,omitempty"`
-	Partner2ID       *uuid.UUID   `json:"partner2_id,omitempty"`
-	RelationshipType RelationType `json:"relationship_type,omitempty"`
-	MarriageDate     *GenDate     `json:"marriage_date,omitempty"`
-	MarriagePlace    string       `json:"marriage_place,omitempty"`
-	GedcomXref       string       `json:"gedcom_xref,omitempty"`
+	FamilyID         uuid.UUID      `json:"family_id"`
+	Partner1ID       *uuid.UUID     `json:"partner1_id,omitempty"`
+	Partner2ID       *uuid.UUID     `json:"partner2_id,omitempty"`
+	RelationshipType RelationType   `json:"relationship_type,omitempty"`
+	MarriageDate     *GenDate       `json:"marriage_date,omitempty"`
+	MarriagePlace    string         `json:"marriage_place,omitempty"`
+	ResearchStatus   ResearchStatus `json:"research_status,omitempty"`
+	GedcomXref       string         `json:"gedcom_xref,omitempty"`
 }
 
 func (e FamilyCreated) EventType() string      { return "FamilyCreated" }
@@ -143,6 +163,7 @@ func NewFamilyCreated(f *Family) FamilyCreated {
 		RelationshipType: f.RelationshipType,
 		MarriageDate:     f.MarriageDate,
 		MarriagePlace:    f.MarriagePlace,
+		ResearchStatus:   f.ResearchStatus,
 		GedcomXref:       f.GedcomXref,
 	}
 }
@@ -449,7 +470,13 @@ type MediaCreated struct {
 	FileSize      int64     `json:"file_size"`
 	FileData      []byte    `json:"file_data"`
 	ThumbnailData []byte    `json:"thumbnail_data,omitempty"`
+	ContentHash   string    `json:"content_hash,omitempty"` // When FileData is empty, the blob is shared with existing media of this hash
+	StorageKey    string    `json:"storage_key,omitempty"`  // When set, FileData is held in the external blob store under this key
 	GedcomXref    string    `json:"gedcom_xref,omitempty"`
+	// EXIF metadata extracted from uploaded photos
+	CapturedAt   *time.Time `json:"captured_at,omitempty"`
+	GPSLatitude  *float64   `json:"gps_latitude,omitempty"`
+	GPSLongitude *float64   `json:"gps_longitude,omitempty"`
 	// GEDCOM 7.0 enhanced fields
 	Files        []MediaFile `json:"files,omitempty"`        // Multiple file references
 	Format       string      `json:"format,omitempty"`       // Primary format/MIME type
@@ -474,7 +501,12 @@ func NewMediaCreated(m *Media) MediaCreated {
 		FileSize:      m.FileSize,
 		FileData:      m.FileData,
 		ThumbnailData: m.ThumbnailData,
+		ContentHash:   m.ContentHash,
+		StorageKey:    m.StorageKey,
 		GedcomXref:    m.GedcomXref,
+		CapturedAt:    m.CapturedAt,
+		GPSLatitude:   m.GPSLatitude,
+		GPSLongitude:  m.GPSLongitude,
 		Files:         m.Files,
 		Format:        m.Format,
 		Translations:  m.Translations,
@@ -599,18 +631,19 @@ func NewRepositoryDeleted(repositoryID uuid.UUID, reason string) RepositoryDelet
 // LifeEventCreated event is emitted when a new life event is created.
 type LifeEventCreated struct {
 	BaseEvent
-	EventID     uuid.UUID  `json:"event_id"`
-	PersonID    *uuid.UUID `json:"person_id,omitempty"` // nil for family events
-	FamilyID    *uuid.UUID `json:"family_id,omitempty"` // nil for person events
-	FactType    FactType   `json:"fact_type"`
-	Date        *GenDate   `json:"date,omitempty"`
-	Place       string     `json:"place,omitempty"`
-	Address     *Address   `json:"address,omitempty"` // Structured address (RESI, etc.)
-	Description string     `json:"description,omitempty"`
-	Cause       string     `json:"cause,omitempty"`      // For death/burial events
-	Age         string     `json:"age,omitempty"`        // Age at event
-	IsNegated   bool       `json:"is_negated,omitempty"` // Negative assertion (GEDCOM 7.0 NO tag)
-	GedcomXref  string     `json:"gedcom_xref,omitempty"`
+	EventID        uuid.UUID      `json:"event_id"`
+	PersonID       *uuid.UUID     `json:"person_id,omitempty"` // nil for family events
+	FamilyID       *uuid.UUID     `json:"family_id,omitempty"` // nil for person events
+	FactType       FactType       `json:"fact_type"`
+	Date           *GenDate       `json:"date,omitempty"`
+	Place          string         `json:"place,omitempty"`
+	Address        *Address       `json:"address,omitempty"` // Structured address (RESI, etc.)
+	Description    string         `json:"description,omitempty"`
+	Cause          string         `json:"cause,omitempty"` // For death/burial events
+	Age            string         `json:"age,omitempty"`   // Age at event
+	ResearchStatus ResearchStatus `json:"research_status,omitempty"`
+	IsNegated      bool           `json:"is_negated,omitempty"` // Negative assertion (GEDCOM 7.0 NO tag)
+	GedcomXref     string         `json:"gedcom_xref,omitempty"`
 }
 
 func (e LifeEventCreated) EventType() string      { return "LifeEventCreated" }
@@ -619,19 +652,20 @@ func (e LifeEventCreated) AggregateID() uuid.UUID { return e.EventID }
 // NewLifeEventCreatedFromModel creates a LifeEventCreated event from a LifeEvent model.
 func NewLifeEventCreatedFromModel(le *LifeEvent) LifeEventCreated {
 	return LifeEventCreated{
-		BaseEvent:   NewBaseEvent(),
-		EventID:     le.ID,
-		PersonID:    le.PersonID,
-		FamilyID:    le.FamilyID,
-		FactType:    le.FactType,
-		Date:        le.Date,
-		Place:       le.Place,
-		Address:     le.Address,
-		Description: le.Description,
-		Cause:       le.Cause,
-		Age:         le.Age,
-		IsNegated:   le.IsNegated,
-		GedcomXref:  le.GedcomXref,
+		BaseEvent:      NewBaseEvent(),
+		EventID:        le.ID,
+		PersonID:       le.PersonID,
+		FamilyID:       le.FamilyID,
+		FactType:       le.FactType,
+		Date:           le.Date,
+		Place:          le.Place,
+		Address:        le.Address,
+		Description:    le.Description,
+		Cause:          le.Cause,
+		Age:            le.Age,
+		ResearchStatus: le.ResearchStatus,
+		IsNegated:      le.IsNegated,
+		GedcomXref:     le.GedcomXref,
 	}
 }
 
@@ -676,13 +710,14 @@ func NewLifeEventDeleted(eventID uuid.UUID, reason string) LifeEventDeleted {
 // AttributeCreated event is emitted when a new person attribute is created.
 type AttributeCreated struct {
 	BaseEvent
-	AttributeID uuid.UUID `json:"attribute_id"`
-	PersonID    uuid.UUID `json:"person_id"`
-	FactType    FactType  `json:"fact_type"`
-	Value       string    `json:"value"`
-	Date        *GenDate  `json:"date,omitempty"`
-	Place       string    `json:"place,omitempty"`
-	GedcomXref  string    `json:"gedcom_xref,omitempty"`
+	AttributeID    uuid.UUID      `json:"attribute_id"`
+	PersonID       uuid.UUID      `json:"person_id"`
+	FactType       FactType       `json:"fact_type"`
+	Value          string         `json:"value"`
+	Date           *GenDate       `json:"date,omitempty"`
+	Place          string         `json:"place,omitempty"`
+	ResearchStatus ResearchStatus `json:"research_status,omitempty"`
+	GedcomXref     string         `json:"gedcom_xref,omitempty"`
 }
 
 func (e AttributeCreated) EventType() string      { return "AttributeCreated" }
@@ -691,14 +726,15 @@ func (e AttributeCreated) AggregateID() uuid.UUID { return e.AttributeID }
 // NewAttributeCreatedFromModel creates an AttributeCreated event from an Attribute model.
 func NewAttributeCreatedFromModel(a *Attribute) AttributeCreated {
 	return AttributeCreated{
-		BaseEvent:   NewBaseEvent(),
-		AttributeID: a.ID,
-		PersonID:    a.PersonID,
-		FactType:    a.FactType,
-		Value:       a.Value,
-		Date:        a.Date,
-		Place:       a.Place,
-		GedcomXref:  a.GedcomXref,
+		BaseEvent:      NewBaseEvent(),
+		AttributeID:    a.ID,
+		PersonID:       a.PersonID,
+		FactType:       a.FactType,
+		Value:          a.Value,
+		Date:           a.Date,
+		Place:          a.Place,
+		ResearchStatus: a.ResearchStatus,
+		GedcomXref:     a.GedcomXref,
 	}
 }
 
@@ -751,6 +787,7 @@ type NameAdded struct {
 	NameSuffix    string    `json:"name_suffix,omitempty"`
 	SurnamePrefix string    `json:"surname_prefix,omitempty"`
 	Nickname      string    `json:"nickname,omitempty"`
+	Romanized     string    `json:"romanized,omitempty"`
 	NameType      NameType  `json:"name_type,omitempty"`
 	IsPrimary     bool      `json:"is_primary"`
 }
@@ -770,6 +807,7 @@ func NewNameAdded(pn *PersonName) NameAdded {
 		NameSuffix:    pn.NameSuffix,
 		SurnamePrefix: pn.SurnamePrefix,
 		Nickname:      pn.Nickname,
+		Romanized:     pn.Romanized,
 		NameType:      pn.NameType,
 		IsPrimary:     pn.IsPrimary,
 	}
@@ -786,6 +824,7 @@ type NameUpdated struct {
 	NameSuffix    string    `json:"name_suffix,omitempty"`
 	SurnamePrefix string    `json:"surname_prefix,omitempty"`
 	Nickname      string    `json:"nickname,omitempty"`
+	Romanized     string    `json:"romanized,omitempty"`
 	NameType      NameType  `json:"name_type,omitempty"`
 	IsPrimary     bool      `json:"is_primary"`
 }
@@ -805,6 +844,7 @@ func NewNameUpdated(pn *PersonName) NameUpdated {
 		NameSuffix:    pn.NameSuffix,
 		SurnamePrefix: pn.SurnamePrefix,
 		Nickname:      pn.Nickname,
+		Romanized:     pn.Romanized,
 		NameType:      pn.NameType,
 		IsPrimary:     pn.IsPrimary,
 	}
@@ -829,6 +869,28 @@ func NewNameRemoved(personID, nameID uuid.UUID) NameRemoved {
 	}
 }
 
+// PersonExternalIDAdded event is emitted when a person is linked to their
+// profile in an external tree such as FamilySearch or WikiTree.
+type PersonExternalIDAdded struct {
+	BaseEvent
+	PersonID uuid.UUID `json:"person_id"`
+	Value    string    `json:"value"`
+	Type     string    `json:"type,omitempty"`
+}
+
+func (e PersonExternalIDAdded) EventType() string      { return "PersonExternalIDAdded" }
+func (e PersonExternalIDAdded) AggregateID() uuid.UUID { return e.PersonID }
+
+// NewPersonExternalIDAdded creates a PersonExternalIDAdded event.
+func NewPersonExternalIDAdded(personID uuid.UUID, ext ExternalIdentifier) PersonExternalIDAdded {
+	return PersonExternalIDAdded{
+		BaseEvent: NewBaseEvent(),
+		PersonID:  personID,
+		Value:     ext.Value,
+		Type:      ext.Type,
+	}
+}
+
 // SnapshotCreated event is emitted when a new snapshot is created.
 type SnapshotCreated struct {
 	BaseEvent
@@ -899,6 +961,7 @@ type NoteCreated struct {
 	Language     string            `json:"language,omitempty"`     // GEDCOM 7.0 SNOTE BCP 47 language tag
 	Translations []NoteTranslation `json:"translations,omitempty"` // GEDCOM 7.0 SNOTE alternate-language renderings
 	GedcomXref   string            `json:"gedcom_xref,omitempty"`
+	Links        []NoteLink        `json:"links,omitempty"` // Entities the note is attached to
 }
 
 func (e NoteCreated) EventType() string      { return "NoteCreated" }
@@ -914,6 +977,7 @@ func NewNoteCreated(n *Note) NoteCreated {
 		Language:     n.Language,
 		Translations: n.Translations,
 		GedcomXref:   n.GedcomXref,
+		Links:        n.Links,
 	}
 }
 
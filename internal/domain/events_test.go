@@ -1230,6 +1230,33 @@ func TestNameRemoved_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestPersonExternalIDAdded_RoundTrip(t *testing.T) {
+	personID := uuid.New()
+
+	event := NewPersonExternalIDAdded(personID, ExternalIdentifier{Value: "KWCJ-QN7", Type: FamilySearchArkType})
+
+	if event.EventType() != "PersonExternalIDAdded" {
+		t.Errorf("EventType() = %v, want PersonExternalIDAdded", event.EventType())
+	}
+	if event.AggregateID() != personID {
+		t.Errorf("AggregateID() = %v, want %v", event.AggregateID(), personID)
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+
+	var decoded PersonExternalIDAdded
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+
+	if decoded.PersonID != personID || decoded.Value != "KWCJ-QN7" || decoded.Type != FamilySearchArkType {
+		t.Errorf("decoded = %+v, want person %v with KWCJ-QN7", decoded, personID)
+	}
+}
+
 // Tests for AggregateID methods that were previously uncovered
 func TestEventAggregateIDs(t *testing.T) {
 	tests := []struct {
@@ -1458,3 +1485,33 @@ func TestNoteDeleted_RoundTrip(t *testing.T) {
 		t.Errorf("Reason = %v, want No longer needed", decoded.Reason)
 	}
 }
+
+func TestWithActor(t *testing.T) {
+	event := NewPersonDeleted(uuid.New(), "duplicate")
+
+	stamped, ok := WithActor(event, "cousin").(PersonDeleted)
+	if !ok {
+		t.Fatalf("WithActor returned %T, want PersonDeleted", stamped)
+	}
+	if stamped.Actor != "cousin" {
+		t.Errorf("Actor = %q, want cousin", stamped.Actor)
+	}
+	if event.Actor != "" {
+		t.Errorf("original Actor = %q, want unchanged", event.Actor)
+	}
+	if stamped.ID != event.ID || stamped.Reason != event.Reason {
+		t.Error("WithActor changed fields other than Actor")
+	}
+
+	data, err := json.Marshal(stamped)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var decoded PersonDeleted
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if decoded.Actor != "cousin" {
+		t.Errorf("round-tripped Actor = %q, want cousin", decoded.Actor)
+	}
+}
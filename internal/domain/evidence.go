@@ -30,6 +30,11 @@ func (e EvidenceAnalysisValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e EvidenceAnalysisValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewEvidenceAnalysis creates a new EvidenceAnalysis with the given required fields.
 func NewEvidenceAnalysis(factType FactType, subjectID uuid.UUID, conclusion string) *EvidenceAnalysis {
 	return &EvidenceAnalysis{
@@ -88,6 +93,11 @@ func (e EvidenceConflictValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e EvidenceConflictValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewEvidenceConflict creates a new EvidenceConflict with the given required fields.
 func NewEvidenceConflict(factType FactType, subjectID uuid.UUID, analysisIDs []uuid.UUID, description string) *EvidenceConflict {
 	return &EvidenceConflict{
@@ -155,6 +165,11 @@ func (e ResearchLogValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e ResearchLogValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewResearchLog creates a new ResearchLog with the given required fields.
 func NewResearchLog(subjectID uuid.UUID, subjectType, repository, searchDescription string, outcome ResearchOutcome, searchDate time.Time) *ResearchLog {
 	return &ResearchLog{
@@ -220,6 +235,11 @@ func (e ProofSummaryValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e ProofSummaryValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewProofSummary creates a new ProofSummary with the given required fields.
 func NewProofSummary(factType FactType, subjectID uuid.UUID, conclusion, argument string) *ProofSummary {
 	return &ProofSummary{
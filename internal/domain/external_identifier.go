@@ -2,10 +2,33 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
 	"strings"
 )
 
+// FamilySearchArkType is the EXID type URI for a FamilySearch Family Tree
+// person ID. GEDCOM 5.5.1 files carry the same identifier in the _FSFTID
+// vendor tag.
+const FamilySearchArkType = "http://www.familysearch.org/ark"
+
+// ExternalIdentifierValidationError represents a validation error for an
+// ExternalIdentifier.
+type ExternalIdentifierValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ExternalIdentifierValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// InvalidField returns the offending field and why it is invalid.
+func (e ExternalIdentifierValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // ExternalIdentifier links a record to an external system using a typed URI
 // identifier. It is the domain representation of the GEDCOM 7.0 EXID structure:
 //
@@ -23,6 +46,26 @@ type ExternalIdentifier struct {
 	Type string `json:"type,omitempty"`
 }
 
+// Validate checks that the identifier has a value and that its type, when
+// given, is an absolute URI as GEDCOM 7.0 requires of EXID.TYPE.
+func (e ExternalIdentifier) Validate() error {
+	var errs []error
+
+	if strings.TrimSpace(e.Value) == "" {
+		errs = append(errs, ExternalIdentifierValidationError{Field: "value", Message: "cannot be empty"})
+	}
+	if len(e.Value) > 255 {
+		errs = append(errs, ExternalIdentifierValidationError{Field: "value", Message: "cannot exceed 255 characters"})
+	}
+	if e.Type != "" {
+		if u, err := url.Parse(e.Type); err != nil || !u.IsAbs() {
+			errs = append(errs, ExternalIdentifierValidationError{Field: "type", Message: "must be an absolute URI"})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // knownExternalIDLinks maps well-known EXID type URIs to a human-readable label
 // and a URL template. The "%s" placeholder is replaced with the identifier value.
 // Templates are intentionally conservative: only systems with a stable, public
@@ -1,6 +1,9 @@
 package domain
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestExternalIdentifierLabel(t *testing.T) {
 	tests := []struct {
@@ -54,3 +57,36 @@ func TestExternalIdentifierURL(t *testing.T) {
 		})
 	}
 }
+
+func TestExternalIdentifierValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		typ     string
+		wantErr string
+	}{
+		{"familysearch", "KWCJ-QN7", FamilySearchArkType, ""},
+		{"empty type", "12345", "", ""},
+		{"empty value", "  ", FamilySearchArkType, "value"},
+		{"value too long", strings.Repeat("x", 256), "", "value"},
+		{"relative type", "12345", "wikitree", "type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ExternalIdentifier{Value: tt.value, Type: tt.typ}.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			fields := FieldErrors(err)
+			if len(fields) != 1 {
+				t.Fatalf("Validate() = %v, want one field error", err)
+			}
+			if field, _ := fields[0].InvalidField(); field != tt.wantErr {
+				t.Errorf("field = %q, want %q", field, tt.wantErr)
+			}
+		})
+	}
+}
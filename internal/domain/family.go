@@ -9,14 +9,15 @@ import (
 
 // Family represents a family unit linking partners and their children.
 type Family struct {
-	ID               uuid.UUID    `json:"id"`
-	Partner1ID       *uuid.UUID   `json:"partner1_id,omitempty"`
-	Partner2ID       *uuid.UUID   `json:"partner2_id,omitempty"`
-	RelationshipType RelationType `json:"relationship_type,omitempty"`
-	MarriageDate     *GenDate     `json:"marriage_date,omitempty"`
-	MarriagePlace    string       `json:"marriage_place,omitempty"`
-	GedcomXref       string       `json:"gedcom_xref,omitempty"` // Original GEDCOM @XREF@ for round-trip
-	Version          int64        `json:"version"`               // Optimistic locking version
+	ID               uuid.UUID      `json:"id"`
+	Partner1ID       *uuid.UUID     `json:"partner1_id,omitempty"`
+	Partner2ID       *uuid.UUID     `json:"partner2_id,omitempty"`
+	RelationshipType RelationType   `json:"relationship_type,omitempty"`
+	MarriageDate     *GenDate       `json:"marriage_date,omitempty"`
+	MarriagePlace    string         `json:"marriage_place,omitempty"`
+	ResearchStatus   ResearchStatus `json:"research_status,omitempty"` // Confidence level (GPS-compliant)
+	GedcomXref       string         `json:"gedcom_xref,omitempty"`     // Original GEDCOM @XREF@ for round-trip
+	Version          int64          `json:"version"`                   // Optimistic locking version
 }
 
 // FamilyChild represents the junction entity linking children to families.
@@ -37,6 +38,11 @@ func (e FamilyValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e FamilyValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewFamily creates a new Family with generated ID.
 func NewFamily() *Family {
 	return &Family{
@@ -81,6 +87,11 @@ func (f *Family) Validate() error {
 		}
 	}
 
+	// Research status validation
+	if !f.ResearchStatus.IsValid() {
+		errs = append(errs, FamilyValidationError{Field: "research_status", Message: fmt.Sprintf("invalid value: %s", f.ResearchStatus)})
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
@@ -63,6 +63,11 @@ func (e LDSOrdinanceValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e LDSOrdinanceValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // LDSOrdinance represents an LDS temple ordinance record.
 // These are important for users with LDS heritage or data from FamilySearch.
 //
@@ -36,6 +36,11 @@ func (e LifeEventValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e LifeEventValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewLifeEvent creates a new LifeEvent for a person.
 func NewLifeEvent(personID uuid.UUID, factType FactType) *LifeEvent {
 	return &LifeEvent{
@@ -161,14 +166,15 @@ func (e *LifeEvent) SetDate(dateStr string) {
 // Attribute represents a biographical attribute of a person.
 // Examples include occupation, residence, education, religion, title.
 type Attribute struct {
-	ID         uuid.UUID `json:"id"`
-	PersonID   uuid.UUID `json:"person_id"` // required, attributes are person-only
-	FactType   FactType  `json:"fact_type"`
-	Value      string    `json:"value"`                 // the attribute value (e.g., "Blacksmith")
-	Date       *GenDate  `json:"date,omitempty"`        // period of applicability
-	Place      string    `json:"place,omitempty"`       // where applicable
-	GedcomXref string    `json:"gedcom_xref,omitempty"` // for round-trip preservation
-	Version    int64     `json:"version"`               // optimistic locking
+	ID             uuid.UUID      `json:"id"`
+	PersonID       uuid.UUID      `json:"person_id"` // required, attributes are person-only
+	FactType       FactType       `json:"fact_type"`
+	Value          string         `json:"value"`                     // the attribute value (e.g., "Blacksmith")
+	Date           *GenDate       `json:"date,omitempty"`            // period of applicability
+	Place          string         `json:"place,omitempty"`           // where applicable
+	ResearchStatus ResearchStatus `json:"research_status,omitempty"` // Confidence level (GPS-compliant)
+	GedcomXref     string         `json:"gedcom_xref,omitempty"`     // for round-trip preservation
+	Version        int64          `json:"version"`                   // optimistic locking
 }
 
 // AttributeValidationError represents a validation error for an Attribute.
@@ -181,6 +187,11 @@ func (e AttributeValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e AttributeValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewAttribute creates a new Attribute for a person.
 func NewAttribute(personID uuid.UUID, factType FactType, value string) *Attribute {
 	return &Attribute{
@@ -251,6 +262,14 @@ func (a *Attribute) Validate() error {
 		})
 	}
 
+	// Validate research status
+	if !a.ResearchStatus.IsValid() {
+		errs = append(errs, AttributeValidationError{
+			Field:   "research_status",
+			Message: fmt.Sprintf("invalid value: %s", a.ResearchStatus),
+		})
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
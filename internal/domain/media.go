@@ -1,15 +1,19 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-// MaxMediaFileSize is the maximum allowed file size (10MB).
-const MaxMediaFileSize = 10 * 1024 * 1024
+// DefaultMaxMediaFileSize is the upload size limit used when none is
+// configured (10MB).
+const DefaultMaxMediaFileSize = 10 * 1024 * 1024
 
 // ValidEntityTypes for media attachment.
 var ValidEntityTypes = []string{"person", "family", "source"}
@@ -43,6 +47,8 @@ type Media struct {
 	Filename      string    `json:"filename,omitempty"`
 	FileSize      int64     `json:"file_size,omitempty"`
 	FileData      []byte    `json:"file_data,omitempty"`
+	ContentHash   string    `json:"content_hash,omitempty"` // Hex SHA-256 of FileData, for deduplication
+	StorageKey    string    `json:"storage_key,omitempty"`  // Key in the external blob store; FileData is empty when set
 	ThumbnailData []byte    `json:"thumbnail_data,omitempty"`
 	CropLeft      *int      `json:"crop_left,omitempty"`
 	CropTop       *int      `json:"crop_top,omitempty"`
@@ -54,6 +60,10 @@ type Media struct {
 	Files        []MediaFile `json:"files,omitempty"`        // Multiple file references (GEDCOM 7.0)
 	Format       string      `json:"format,omitempty"`       // Primary format/MIME type (FORM)
 	Translations []string    `json:"translations,omitempty"` // Translated titles (GEDCOM 7.0)
+	// EXIF metadata extracted from uploaded photos
+	CapturedAt   *time.Time `json:"captured_at,omitempty"`
+	GPSLatitude  *float64   `json:"gps_latitude,omitempty"`
+	GPSLongitude *float64   `json:"gps_longitude,omitempty"`
 }
 
 // MediaValidationError represents a validation error for a Media.
@@ -66,6 +76,11 @@ func (e MediaValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e MediaValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewMedia creates a new Media with the given required fields.
 func NewMedia(title string, entityType string, entityID uuid.UUID) *Media {
 	return &Media{
@@ -113,17 +128,44 @@ func (m *Media) Validate() error {
 		}
 	}
 
-	// FileSize max 10MB
-	if m.FileSize > MaxMediaFileSize {
-		errs = append(errs, MediaValidationError{Field: "file_size", Message: fmt.Sprintf("cannot exceed %d bytes (10MB)", MaxMediaFileSize)})
-	}
-
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
 	return nil
 }
 
+// ValidateFileSize checks the media's file size against a configured upload
+// limit in bytes.
+func (m *Media) ValidateFileSize(limit int64) error {
+	if m.FileSize > limit {
+		return MediaValidationError{Field: "file_size", Message: fmt.Sprintf("cannot exceed %d bytes (%s)", limit, FormatMediaFileSize(limit))}
+	}
+	return nil
+}
+
+// FormatMediaFileSize formats a byte count for size limit messages, e.g.
+// "10MB" or "512KB".
+func FormatMediaFileSize(n int64) string {
+	const kb, mb = 1024, 1024 * 1024
+	switch {
+	case n >= mb && n%mb == 0:
+		return fmt.Sprintf("%dMB", n/mb)
+	case n >= mb:
+		return fmt.Sprintf("%.1fMB", float64(n)/mb)
+	case n >= kb:
+		return fmt.Sprintf("%dKB", n/kb)
+	default:
+		return fmt.Sprintf("%d bytes", n)
+	}
+}
+
+// MediaContentHash returns the hex-encoded SHA-256 digest used to detect
+// identical uploads.
+func MediaContentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
 // isValidEntityType checks if the entity type is valid.
 func isValidEntityType(entityType string) bool {
 	for _, valid := range ValidEntityTypes {
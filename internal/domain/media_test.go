@@ -129,18 +129,6 @@ func TestMedia_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "mime_type",
 		},
-		{
-			name: "file size too large",
-			media: &Media{
-				ID:         uuid.New(),
-				Title:      "Test",
-				EntityType: "person",
-				EntityID:   entityID,
-				FileSize:   MaxMediaFileSize + 1,
-			},
-			wantErr: true,
-			errMsg:  "file_size",
-		},
 		{
 			name: "valid with all fields",
 			media: func() *Media {
@@ -190,6 +178,44 @@ func TestMedia_Validate(t *testing.T) {
 	}
 }
 
+func TestMedia_ValidateFileSize(t *testing.T) {
+	m := NewMedia("Deed", "person", uuid.New())
+	m.FileSize = 18 * 1024 * 1024
+
+	err := m.ValidateFileSize(DefaultMaxMediaFileSize)
+	if err == nil || !strings.Contains(err.Error(), "(10MB)") {
+		t.Errorf("ValidateFileSize(default) error = %v, want 10MB limit", err)
+	}
+	if err := m.ValidateFileSize(25 * 1024 * 1024); err != nil {
+		t.Errorf("ValidateFileSize(25MB) error = %v", err)
+	}
+}
+
+func TestFormatMediaFileSize(t *testing.T) {
+	tests := map[int64]string{
+		10 * 1024 * 1024: "10MB",
+		5 * 512 * 1024:   "2.5MB",
+		512 * 1024:       "512KB",
+		100:              "100 bytes",
+	}
+	for n, want := range tests {
+		if got := FormatMediaFileSize(n); got != want {
+			t.Errorf("FormatMediaFileSize(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestMediaContentHash(t *testing.T) {
+	// SHA-256 of "abc"
+	want := "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	if got := MediaContentHash([]byte("abc")); got != want {
+		t.Errorf("MediaContentHash() = %s, want %s", got, want)
+	}
+	if MediaContentHash([]byte("abc")) == MediaContentHash([]byte("abd")) {
+		t.Error("different content should hash differently")
+	}
+}
+
 func TestMediaValidationError_Error(t *testing.T) {
 	err := MediaValidationError{Field: "title", Message: "cannot be empty"}
 	want := "title: cannot be empty"
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"strings"
+)
+
+// ParsedName holds the parts of a personal name split from a single string.
+// Surname prefixes follow the GEDCOM SPFX convention: "van der Berg" is
+// Surname "Berg" with SurnamePrefix "van der".
+type ParsedName struct {
+	GivenName     string `json:"given_name"`
+	Surname       string `json:"surname,omitempty"`
+	NamePrefix    string `json:"name_prefix,omitempty"`
+	NameSuffix    string `json:"name_suffix,omitempty"`
+	SurnamePrefix string `json:"surname_prefix,omitempty"`
+}
+
+// namePrefixes are titles recognized before a given name.
+var namePrefixes = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "miss": true, "mx": true,
+	"dr": true, "prof": true, "rev": true, "fr": true,
+	"sir": true, "dame": true, "lady": true, "lord": true, "hon": true,
+	"capt": true, "col": true, "gen": true, "lt": true, "maj": true, "sgt": true, "cpl": true, "pvt": true,
+}
+
+// nameSuffixes are generational and honorific suffixes recognized after a surname.
+var nameSuffixes = map[string]bool{
+	"jr": true, "sr": true, "ii": true, "iii": true, "iv": true, "v": true, "vi": true,
+	"esq": true, "md": true, "phd": true, "dds": true,
+}
+
+// surnameParticles are lower-order words that belong in front of a surname.
+var surnameParticles = map[string]bool{
+	"van": true, "von": true, "der": true, "den": true, "de": true, "del": true, "della": true,
+	"di": true, "da": true, "dos": true, "das": true, "du": true, "la": true, "le": true,
+	"ter": true, "ten": true, "zu": true, "af": true, "av": true, "bin": true, "ibn": true,
+}
+
+// ParseFullName splits a full name such as "Dr. John A. Smith Jr.",
+// "Jan van der Berg" or the inverted "Smith, John" into its parts.
+// Single words are treated as a given name.
+func ParseFullName(fullName string) ParsedName {
+	var parsed ParsedName
+
+	// Trailing comma-separated suffixes ("John Smith, Jr.") are not an
+	// inverted name, so split them off before looking for a comma.
+	segments := strings.Split(fullName, ",")
+	var suffixes []string
+	for len(segments) > 1 {
+		last := strings.Fields(segments[len(segments)-1])
+		if len(last) == 0 || !allMatch(last, nameSuffixes) {
+			break
+		}
+		suffixes = append(last, suffixes...)
+		segments = segments[:len(segments)-1]
+	}
+
+	var givenWords, surnameWords []string
+	if len(segments) > 1 {
+		// Inverted form: "Surname, Given Names"
+		surnameWords = strings.Fields(segments[0])
+		givenWords = strings.Fields(strings.Join(segments[1:], " "))
+		givenWords, suffixes = splitTrailing(givenWords, nameSuffixes, suffixes)
+		surnameWords, suffixes = splitTrailing(surnameWords, nameSuffixes, suffixes)
+	} else {
+		words := strings.Fields(segments[0])
+		words, suffixes = splitTrailing(words, nameSuffixes, suffixes)
+		if len(words) > 0 {
+			// Everything from the last word back through any particles is the surname
+			start := len(words) - 1
+			for start > 1 && surnameParticles[strings.ToLower(words[start-1])] {
+				start--
+			}
+			if len(words) > 1 {
+				givenWords, surnameWords = words[:start], words[start:]
+			} else {
+				givenWords = words
+			}
+		}
+	}
+
+	// Titles lead the given names
+	i := 0
+	for i < len(givenWords)-1 && namePrefixes[normalizeNameWord(givenWords[i])] {
+		i++
+	}
+	parsed.NamePrefix = strings.Join(givenWords[:i], " ")
+	parsed.GivenName = strings.Join(givenWords[i:], " ")
+
+	// Particles lead the surname
+	j := 0
+	for j < len(surnameWords)-1 && surnameParticles[strings.ToLower(surnameWords[j])] {
+		j++
+	}
+	parsed.SurnamePrefix = strings.Join(surnameWords[:j], " ")
+	parsed.Surname = strings.Join(surnameWords[j:], " ")
+
+	parsed.NameSuffix = strings.Join(suffixes, " ")
+	return parsed
+}
+
+// splitTrailing moves trailing words found in set from words to the front of
+// rest, keeping at least one word.
+func splitTrailing(words []string, set map[string]bool, rest []string) (remaining, moved []string) {
+	end := len(words)
+	for end > 1 && set[normalizeNameWord(words[end-1])] {
+		end--
+	}
+	return words[:end], append(append([]string{}, words[end:]...), rest...)
+}
+
+// allMatch reports whether every word is in set.
+func allMatch(words []string, set map[string]bool) bool {
+	for _, w := range words {
+		if !set[normalizeNameWord(w)] {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeNameWord lower-cases a word and drops trailing periods for lookup.
+func normalizeNameWord(word string) string {
+	return strings.ToLower(strings.TrimRight(word, "."))
+}
+
+// SurnameSortKey returns a surname without its leading particles, so that
+// "van Gogh" files under "Gogh". Particle-only surnames are returned as is.
+func SurnameSortKey(surname string) string {
+	words := strings.Fields(surname)
+	i := 0
+	for i < len(words)-1 && surnameParticles[strings.ToLower(words[i])] {
+		i++
+	}
+	return strings.Join(words[i:], " ")
+}
@@ -0,0 +1,53 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/cacack/my-family/internal/domain"
+)
+
+func TestParseFullName(t *testing.T) {
+	tests := []struct {
+		input string
+		want  domain.ParsedName
+	}{
+		{"", domain.ParsedName{}},
+		{"Mary", domain.ParsedName{GivenName: "Mary"}},
+		{"John Smith", domain.ParsedName{GivenName: "John", Surname: "Smith"}},
+		{"  Mary   Elizabeth O'Brien ", domain.ParsedName{GivenName: "Mary Elizabeth", Surname: "O'Brien"}},
+		{"John A. Smith Jr.", domain.ParsedName{GivenName: "John A.", Surname: "Smith", NameSuffix: "Jr."}},
+		{"John Smith, Jr.", domain.ParsedName{GivenName: "John", Surname: "Smith", NameSuffix: "Jr."}},
+		{"Dr. John Smith III", domain.ParsedName{NamePrefix: "Dr.", GivenName: "John", Surname: "Smith", NameSuffix: "III"}},
+		{"Jan van der Berg", domain.ParsedName{GivenName: "Jan", SurnamePrefix: "van der", Surname: "Berg"}},
+		{"Ludwig van Beethoven", domain.ParsedName{GivenName: "Ludwig", SurnamePrefix: "van", Surname: "Beethoven"}},
+		{"Smith, John", domain.ParsedName{GivenName: "John", Surname: "Smith"}},
+		{"Smith, John A., Jr.", domain.ParsedName{GivenName: "John A.", Surname: "Smith", NameSuffix: "Jr."}},
+		{"van der Berg, Jan", domain.ParsedName{GivenName: "Jan", SurnamePrefix: "van der", Surname: "Berg"}},
+		{"Smith Sr., Rev. Thomas", domain.ParsedName{NamePrefix: "Rev.", GivenName: "Thomas", Surname: "Smith", NameSuffix: "Sr."}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := domain.ParseFullName(tt.input); got != tt.want {
+				t.Errorf("ParseFullName(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSurnameSortKey(t *testing.T) {
+	tests := map[string]string{
+		"":             "",
+		"Smith":        "Smith",
+		"van Gogh":     "Gogh",
+		"de la Cruz":   "Cruz",
+		"van der Berg": "Berg",
+		"Van":          "Van",
+		"O'Brien":      "O'Brien",
+	}
+	for surname, want := range tests {
+		if got := domain.SurnameSortKey(surname); got != want {
+			t.Errorf("SurnameSortKey(%q) = %q, want %q", surname, got, want)
+		}
+	}
+}
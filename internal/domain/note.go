@@ -17,6 +17,11 @@ func (e NoteValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e NoteValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NoteTranslation is an alternate-language rendering of a shared note (SNOTE).
 // It mirrors the GEDCOM 7.0 TRAN substructure, which carries its own MIME media
 // type and BCP 47 language tag independent of the primary text.
@@ -26,6 +31,13 @@ type NoteTranslation struct {
 	Language string `json:"language,omitempty"` // BCP 47 language tag, e.g. "es"
 }
 
+// NoteLink attaches a note to the person, family, or source it annotates.
+// A shared note referenced by several records carries one link per record.
+type NoteLink struct {
+	EntityType string    `json:"entity_type"` // "person", "family", or "source"
+	EntityID   uuid.UUID `json:"entity_id"`
+}
+
 // Note represents a GEDCOM NOTE record that can be shared across multiple entities.
 // GEDCOM supports two note styles:
 // - Inline notes: embedded directly in an entity
@@ -42,6 +54,7 @@ type Note struct {
 	Language     string            `json:"language,omitempty"`     // BCP 47 language tag (SNOTE), e.g. "en"
 	Translations []NoteTranslation `json:"translations,omitempty"` // Alternate-language renderings (SNOTE TRAN)
 	GedcomXref   string            `json:"gedcom_xref,omitempty"`  // GEDCOM cross-reference ID (e.g., "@N1@")
+	Links        []NoteLink        `json:"links,omitempty"`        // Entities this note is attached to
 	Version      int64             `json:"version"`
 }
 
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"strings"
+)
+
+// DerivePatronymic builds an Icelandic-style patronymic from the father's
+// given name and the child's gender: Jón gives Jónsson or Jónsdóttir. Common
+// genitive endings are handled (Ólafur → Ólafsson, Bjarni → Bjarnason,
+// Magnús → Magnússon); irregular forms such as Sigurðarson need editing by
+// hand. Returns "" when the father's name or the child's gender is unknown.
+func DerivePatronymic(fatherGivenName string, childGender Gender) string {
+	words := strings.Fields(fatherGivenName)
+	if len(words) == 0 {
+		return ""
+	}
+
+	var suffix string
+	switch childGender {
+	case GenderMale:
+		suffix = "son"
+	case GenderFemale:
+		suffix = "dóttir"
+	default:
+		return ""
+	}
+
+	return patronymicStem(words[0]) + suffix
+}
+
+// patronymicStem returns the genitive form of a given name used as the stem
+// of a patronymic.
+func patronymicStem(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ur") && len([]rune(name)) > 3:
+		return strings.TrimSuffix(name, "ur") + "s"
+	case strings.HasSuffix(name, "i") && len([]rune(name)) > 2:
+		return strings.TrimSuffix(name, "i") + "a"
+	case strings.HasSuffix(name, "s"):
+		return name
+	default:
+		return name + "s"
+	}
+}
@@ -0,0 +1,30 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/cacack/my-family/internal/domain"
+)
+
+func TestDerivePatronymic(t *testing.T) {
+	tests := []struct {
+		father string
+		gender domain.Gender
+		want   string
+	}{
+		{"Jón", domain.GenderMale, "Jónsson"},
+		{"Jón", domain.GenderFemale, "Jónsdóttir"},
+		{"Ólafur", domain.GenderMale, "Ólafsson"},
+		{"Bjarni", domain.GenderFemale, "Bjarnadóttir"},
+		{"Magnús", domain.GenderMale, "Magnússon"},
+		{"Einar Þór", domain.GenderMale, "Einarsson"},
+		{"Jón", domain.GenderUnknown, ""},
+		{"", domain.GenderMale, ""},
+	}
+
+	for _, tt := range tests {
+		if got := domain.DerivePatronymic(tt.father, tt.gender); got != tt.want {
+			t.Errorf("DerivePatronymic(%q, %q) = %q, want %q", tt.father, tt.gender, got, tt.want)
+		}
+	}
+}
@@ -38,6 +38,11 @@ func (e PersonValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e PersonValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewPerson creates a new Person with the given required fields.
 func NewPerson(givenName, surname string) *Person {
 	return &Person{
@@ -135,6 +140,7 @@ type PersonName struct {
 	NameSuffix    string    `json:"name_suffix,omitempty"`    // Jr., III, PhD (NSFX)
 	SurnamePrefix string    `json:"surname_prefix,omitempty"` // von, de, van (SPFX)
 	Nickname      string    `json:"nickname,omitempty"`       // Informal name (NICK)
+	Romanized     string    `json:"romanized,omitempty"`      // Latin-script form of a non-Latin name (Иванов → Ivanov)
 	NameType      NameType  `json:"name_type,omitempty"`      // birth, married, aka, immigrant, religious, professional
 	IsPrimary     bool      `json:"is_primary"`               // Whether this is the display name
 }
@@ -149,6 +155,11 @@ func (e PersonNameValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e PersonNameValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewPersonName creates a new PersonName with the given required fields.
 func NewPersonName(personID uuid.UUID, givenName, surname string) *PersonName {
 	return &PersonName{
@@ -176,6 +187,10 @@ func (pn *PersonName) Validate() error {
 		errs = append(errs, PersonNameValidationError{Field: "surname", Message: "cannot exceed 100 characters"})
 	}
 
+	if len(pn.Romanized) > 200 {
+		errs = append(errs, PersonNameValidationError{Field: "romanized", Message: "cannot exceed 200 characters"})
+	}
+
 	// NameType validation
 	if !pn.NameType.IsValid() {
 		errs = append(errs, PersonNameValidationError{Field: "name_type", Message: fmt.Sprintf("invalid value: %s", pn.NameType)})
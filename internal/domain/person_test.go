@@ -230,6 +230,17 @@ func TestPersonName_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "romanized too long",
+			pn: &PersonName{
+				ID:        uuid.New(),
+				PersonID:  personID,
+				GivenName: "Иван",
+				Surname:   "Иванов",
+				Romanized: string(make([]byte, 201)),
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid name type",
 			pn: &PersonName{
@@ -1,5 +1,7 @@
 package domain
 
+import "strings"
+
 // Place represents a location associated with an event (birth, death, marriage).
 // It stores the place name as provided, supporting hierarchical location strings.
 // Coordinates are optional and store latitude/longitude in GEDCOM format (e.g., "N42.3601", "W71.0589").
@@ -41,3 +43,53 @@ func (p Place) IsEmpty() bool {
 func (p Place) HasCoordinates() bool {
 	return p.Latitude != nil && p.Longitude != nil && *p.Latitude != "" && *p.Longitude != ""
 }
+
+// usStateNames maps USPS state abbreviations to lowercase state names.
+var usStateNames = map[string]string{
+	"al": "alabama", "ak": "alaska", "az": "arizona", "ar": "arkansas", "ca": "california",
+	"co": "colorado", "ct": "connecticut", "de": "delaware", "dc": "district of columbia",
+	"fl": "florida", "ga": "georgia", "hi": "hawaii", "id": "idaho", "il": "illinois",
+	"in": "indiana", "ia": "iowa", "ks": "kansas", "ky": "kentucky", "la": "louisiana",
+	"me": "maine", "md": "maryland", "ma": "massachusetts", "mi": "michigan", "mn": "minnesota",
+	"ms": "mississippi", "mo": "missouri", "mt": "montana", "ne": "nebraska", "nv": "nevada",
+	"nh": "new hampshire", "nj": "new jersey", "nm": "new mexico", "ny": "new york",
+	"nc": "north carolina", "nd": "north dakota", "oh": "ohio", "ok": "oklahoma", "or": "oregon",
+	"pa": "pennsylvania", "ri": "rhode island", "sc": "south carolina", "sd": "south dakota",
+	"tn": "tennessee", "tx": "texas", "ut": "utah", "vt": "vermont", "va": "virginia",
+	"wa": "washington", "wv": "west virginia", "wi": "wisconsin", "wy": "wyoming",
+}
+
+// usCountryNames are trailing components dropped when comparing US places.
+var usCountryNames = map[string]bool{
+	"usa": true, "us": true, "united states": true, "united states of america": true,
+}
+
+// PlaceVariantKey returns a comparison key for a place name, so that spelling
+// variants of the same place share a key. Components are lowercased with
+// periods and extra whitespace removed, US state abbreviations are expanded,
+// and a trailing United States country component is dropped, so
+// "Springfield, IL" and "Springfield, Illinois, USA" both become
+// "springfield, illinois". Returns "" for an empty place.
+func PlaceVariantKey(name string) string {
+	parts := strings.Split(name, ",")
+	components := make([]string, 0, len(parts))
+	for _, part := range parts {
+		c := strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(part, ".", ""))), " ")
+		if c == "" {
+			continue
+		}
+		components = append(components, c)
+	}
+
+	if n := len(components); n > 1 && usCountryNames[components[n-1]] {
+		components = components[:n-1]
+	}
+	// The first component is the locality, never a state abbreviation
+	for i := 1; i < len(components); i++ {
+		if state, ok := usStateNames[components[i]]; ok {
+			components[i] = state
+		}
+	}
+
+	return strings.Join(components, ", ")
+}
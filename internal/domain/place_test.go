@@ -214,6 +214,31 @@ func TestPlace_HasCoordinates(t *testing.T) {
 	}
 }
 
+func TestPlaceVariantKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		place string
+		want  string
+	}{
+		{"empty", "", ""},
+		{"state abbreviation", "Springfield, IL", "springfield, illinois"},
+		{"state name with country", "Springfield, Illinois, USA", "springfield, illinois"},
+		{"periods and spacing", "Springfield,  Ill.,  U.S.A.", "springfield, ill"},
+		{"county", "Springfield, Sangamon County, IL, United States", "springfield, sangamon county, illinois"},
+		{"locality not expanded", "Al, Norway", "al, norway"},
+		{"country only", "USA", "usa"},
+		{"empty components", "Boston, , MA", "boston, massachusetts"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlaceVariantKey(tt.place); got != tt.want {
+				t.Errorf("PlaceVariantKey(%q) = %q, want %q", tt.place, got, tt.want)
+			}
+		})
+	}
+}
+
 // strPtr is a helper to create string pointers for tests
 func strPtr(s string) *string {
 	return &s
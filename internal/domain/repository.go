@@ -35,6 +35,11 @@ func (e RepositoryValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e RepositoryValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewRepository creates a new Repository with the given name.
 func NewRepository(name string) *Repository {
 	return &Repository{
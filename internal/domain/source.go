@@ -35,6 +35,11 @@ func (e SourceValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e SourceValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewSource creates a new Source with the given required fields.
 func NewSource(title string, sourceType SourceType) *Source {
 	return &Source{
@@ -101,6 +106,11 @@ func (e CitationValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e CitationValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // NewCitation creates a new Citation with the given required fields.
 func NewCitation(sourceID uuid.UUID, factType FactType, factOwnerID uuid.UUID) *Citation {
 	return &Citation{
@@ -17,6 +17,11 @@ func (e SubmitterValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// InvalidField returns the offending field and why it is invalid.
+func (e SubmitterValidationError) InvalidField() (field, message string) {
+	return e.Field, e.Message
+}
+
 // Submitter represents a GEDCOM SUBM (Submitter) record.
 // Submitters track who created or submitted genealogical data, useful for
 // tracking data provenance, contacting original researchers, and crediting contributors.
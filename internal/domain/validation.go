@@ -0,0 +1,34 @@
+package domain
+
+import "errors"
+
+// FieldError is a validation error concerning a single field. Every
+// *ValidationError type in this package implements it.
+type FieldError interface {
+	error
+	InvalidField() (field, message string)
+}
+
+// FieldErrors returns every FieldError in err's tree, in order. Validate
+// methods report all failures via errors.Join, and callers may wrap the result,
+// so the whole tree is walked rather than stopping at the first match.
+func FieldErrors(err error) []FieldError {
+	var fields []FieldError
+	var walk func(error)
+	walk = func(err error) {
+		switch e := err.(type) {
+		case nil:
+			return
+		case FieldError:
+			fields = append(fields, e)
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				walk(inner)
+			}
+		default:
+			walk(errors.Unwrap(err))
+		}
+	}
+	walk(err)
+	return fields
+}
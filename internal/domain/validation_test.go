@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestFieldErrors(t *testing.T) {
+	p := NewPerson("", "Doe")
+	p.Gender = Gender("robot")
+	err := fmt.Errorf("invalid input: %w", p.Validate())
+
+	fields := FieldErrors(err)
+	if len(fields) != 2 {
+		t.Fatalf("FieldErrors returned %d errors, want 2: %v", len(fields), fields)
+	}
+
+	want := [][2]string{{"given_name", "cannot be empty"}, {"gender", "invalid value: robot"}}
+	for i, fe := range fields {
+		field, message := fe.InvalidField()
+		if field != want[i][0] || message != want[i][1] {
+			t.Errorf("fields[%d] = %s: %s, want %s: %s", i, field, message, want[i][0], want[i][1])
+		}
+	}
+}
+
+func TestFieldErrors_NoFieldErrors(t *testing.T) {
+	if fields := FieldErrors(nil); fields != nil {
+		t.Errorf("FieldErrors(nil) = %v, want nil", fields)
+	}
+	if fields := FieldErrors(errors.New("boom")); fields != nil {
+		t.Errorf("FieldErrors(plain error) = %v, want nil", fields)
+	}
+}
@@ -0,0 +1,133 @@
+package exporter
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A4 page geometry in PDF points.
+const (
+	pdfPageWidth  = 595.0
+	pdfPageHeight = 842.0
+	pdfMargin     = 56.0
+)
+
+// textPDF lays out lines of text top to bottom on A4 pages, starting a new
+// page when one fills up. It uses the standard Helvetica fonts, which every
+// PDF reader provides, so no fonts are embedded; text outside Latin-1 is
+// replaced with "?".
+type textPDF struct {
+	pages []*bytes.Buffer
+	y     float64
+}
+
+// newTextPDF creates a document with one empty page.
+func newTextPDF() *textPDF {
+	p := &textPDF{}
+	p.newPage()
+	return p
+}
+
+func (p *textPDF) newPage() {
+	p.pages = append(p.pages, &bytes.Buffer{})
+	p.y = pdfPageHeight - pdfMargin
+}
+
+// line writes one line of text at the given font size, indented by indent
+// points from the left margin.
+func (p *textPDF) line(text string, size, indent float64, bold bool) {
+	p.advance(size)
+	p.show(text, size, indent, bold)
+}
+
+// row writes a label and its value on one line, with the value starting
+// valueIndent points from the left margin.
+func (p *textPDF) row(label, value string, size, indent, valueIndent float64) {
+	p.advance(size)
+	p.show(label, size, indent, false)
+	p.show(value, size, valueIndent, false)
+}
+
+// advance moves down one line of the given font size, starting a new page
+// when the current one is full.
+func (p *textPDF) advance(size float64) {
+	lead := size * 1.4
+	if p.y-lead < pdfMargin {
+		p.newPage()
+	}
+	p.y -= lead
+}
+
+// show draws text on the current line.
+func (p *textPDF) show(text string, size, indent float64, bold bool) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(p.pages[len(p.pages)-1], "BT /%s %.1f Tf %.1f %.1f Td (%s) Tj ET\n",
+		font, size, pdfMargin+indent, p.y, pdfEscape(text))
+}
+
+// gap leaves vertical space before the next line.
+func (p *textPDF) gap(height float64) {
+	p.y -= height
+}
+
+// WriteTo writes the document as a PDF file.
+func (p *textPDF) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// Objects 1-4 are the catalog, page tree, and fonts; each page then
+	// takes two objects, the page and its content stream.
+	kids := make([]string, len(p.pages))
+	for i := range p.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	buf.WriteString("%PDF-1.4\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(p.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, content := range p.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] "+
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.WriteTo(w)
+}
+
+// pdfEscape encodes text as the body of a PDF literal string in
+// WinAnsiEncoding.
+func pdfEscape(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			sb.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&sb, "\\%03o", r)
+		default:
+			sb.WriteByte('?')
+		}
+	}
+	return sb.String()
+}
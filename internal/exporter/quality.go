@@ -0,0 +1,122 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/cacack/my-family/internal/query"
+)
+
+// WriteQualityReportCSV writes a data quality report as CSV rows of section,
+// metric, and value. Coverage values are percentages.
+func WriteQualityReportCSV(w io.Writer, report *query.ValidationReport) error {
+	cw := csv.NewWriter(w)
+	rows := [][]string{
+		{"section", "metric", "value"},
+		{"totals", "individuals", strconv.Itoa(report.TotalIndividuals)},
+		{"totals", "families", strconv.Itoa(report.TotalFamilies)},
+		{"totals", "sources", strconv.Itoa(report.TotalSources)},
+		{"coverage", "birth_date", formatPercent(report.BirthDateCoverage)},
+		{"coverage", "death_date", formatPercent(report.DeathDateCoverage)},
+		{"coverage", "source", formatPercent(report.SourceCoverage)},
+		{"issues", "errors", strconv.Itoa(report.ErrorCount)},
+		{"issues", "warnings", strconv.Itoa(report.WarningCount)},
+		{"issues", "info", strconv.Itoa(report.InfoCount)},
+	}
+	for _, issue := range report.TopIssues {
+		rows = append(rows, []string{"top_issues", issue.Code, strconv.Itoa(issue.Count)})
+	}
+	for _, kind := range researchStatusKinds(report) {
+		counts := kind.counts
+		rows = append(rows,
+			[]string{"research_status", kind.name + "_certain", strconv.Itoa(counts.Certain)},
+			[]string{"research_status", kind.name + "_probable", strconv.Itoa(counts.Probable)},
+			[]string{"research_status", kind.name + "_possible", strconv.Itoa(counts.Possible)},
+			[]string{"research_status", kind.name + "_unknown", strconv.Itoa(counts.Unknown)},
+		)
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf("write quality report: %w", err)
+	}
+	return nil
+}
+
+// WriteQualityReportPDF writes a data quality report as a printable PDF,
+// dated generated.
+func WriteQualityReportPDF(w io.Writer, report *query.ValidationReport, generated time.Time) error {
+	pdf := newTextPDF()
+	pdf.line("Data Quality Report", 20, 0, true)
+	pdf.line("Generated "+generated.Format("2 January 2006"), 10, 0, false)
+
+	section := func(title string, rows [][2]string) {
+		pdf.gap(12)
+		pdf.line(title, 13, 0, true)
+		for _, row := range rows {
+			pdf.row(row[0], row[1], 11, 12, 260)
+		}
+	}
+
+	section("Totals", [][2]string{
+		{"Individuals", strconv.Itoa(report.TotalIndividuals)},
+		{"Families", strconv.Itoa(report.TotalFamilies)},
+		{"Sources", strconv.Itoa(report.TotalSources)},
+	})
+	section("Coverage", [][2]string{
+		{"Individuals with a birth date", formatPercent(report.BirthDateCoverage) + "%"},
+		{"Individuals with a death date", formatPercent(report.DeathDateCoverage) + "%"},
+		{"Individuals with a source", formatPercent(report.SourceCoverage) + "%"},
+	})
+	section("Issues", [][2]string{
+		{"Errors", strconv.Itoa(report.ErrorCount)},
+		{"Warnings", strconv.Itoa(report.WarningCount)},
+		{"Info", strconv.Itoa(report.InfoCount)},
+	})
+	if len(report.TopIssues) > 0 {
+		rows := make([][2]string, len(report.TopIssues))
+		for i, issue := range report.TopIssues {
+			rows[i] = [2]string{issue.Code, strconv.Itoa(issue.Count)}
+		}
+		section("Most frequent issues", rows)
+	}
+	kinds := researchStatusKinds(report)
+	rows := make([][2]string, len(kinds))
+	for i, kind := range kinds {
+		c := kind.counts
+		rows[i] = [2]string{
+			kind.label,
+			fmt.Sprintf("%d certain, %d probable, %d possible, %d unknown", c.Certain, c.Probable, c.Possible, c.Unknown),
+		}
+	}
+	section("Research status", rows)
+
+	if _, err := pdf.WriteTo(w); err != nil {
+		return fmt.Errorf("write quality report: %w", err)
+	}
+	return nil
+}
+
+// researchStatusKind is one kind of record in the research status breakdown.
+type researchStatusKind struct {
+	name   string // CSV metric prefix
+	label  string // PDF row label
+	counts query.ResearchStatusCounts
+}
+
+// researchStatusKinds lists the report's research status counts in display order.
+func researchStatusKinds(report *query.ValidationReport) []researchStatusKind {
+	rs := report.ResearchStatus
+	return []researchStatusKind{
+		{"persons", "Individuals", rs.Persons},
+		{"families", "Families", rs.Families},
+		{"events", "Events", rs.Events},
+		{"attributes", "Attributes", rs.Attributes},
+	}
+}
+
+// formatPercent formats a 0-1 fraction as a percentage with one decimal.
+func formatPercent(fraction float64) string {
+	return strconv.FormatFloat(fraction*100, 'f', 1, 64)
+}
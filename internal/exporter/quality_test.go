@@ -0,0 +1,102 @@
+package exporter_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cacack/my-family/internal/exporter"
+	"github.com/cacack/my-family/internal/query"
+)
+
+func sampleQualityReport() *query.ValidationReport {
+	return &query.ValidationReport{
+		TotalIndividuals:  12,
+		TotalFamilies:     4,
+		TotalSources:      3,
+		BirthDateCoverage: 0.75,
+		DeathDateCoverage: 0.5,
+		SourceCoverage:    1.0 / 3,
+		ErrorCount:        1,
+		WarningCount:      5,
+		InfoCount:         2,
+		TopIssues: []query.ValidationReportIssue{
+			{Code: "MISSING_BIRTH_DATE", Count: 3},
+			{Code: "GENDER_ROLE_MISMATCH", Count: 1},
+		},
+		ResearchStatus: query.ResearchStatusSummary{
+			Persons:  query.ResearchStatusCounts{Certain: 2, Probable: 3, Unknown: 7},
+			Families: query.ResearchStatusCounts{Possible: 1, Unknown: 3},
+		},
+	}
+}
+
+func TestWriteQualityReportCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, exporter.WriteQualityReportCSV(&buf, sampleQualityReport()))
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"section", "metric", "value"},
+		{"totals", "individuals", "12"},
+		{"totals", "families", "4"},
+		{"totals", "sources", "3"},
+		{"coverage", "birth_date", "75.0"},
+		{"coverage", "death_date", "50.0"},
+		{"coverage", "source", "33.3"},
+		{"issues", "errors", "1"},
+		{"issues", "warnings", "5"},
+		{"issues", "info", "2"},
+		{"top_issues", "MISSING_BIRTH_DATE", "3"},
+		{"top_issues", "GENDER_ROLE_MISMATCH", "1"},
+		{"research_status", "persons_certain", "2"},
+		{"research_status", "persons_probable", "3"},
+		{"research_status", "persons_possible", "0"},
+		{"research_status", "persons_unknown", "7"},
+		{"research_status", "families_certain", "0"},
+		{"research_status", "families_probable", "0"},
+		{"research_status", "families_possible", "1"},
+		{"research_status", "families_unknown", "3"},
+		{"research_status", "events_certain", "0"},
+		{"research_status", "events_probable", "0"},
+		{"research_status", "events_possible", "0"},
+		{"research_status", "events_unknown", "0"},
+		{"research_status", "attributes_certain", "0"},
+		{"research_status", "attributes_probable", "0"},
+		{"research_status", "attributes_possible", "0"},
+		{"research_status", "attributes_unknown", "0"},
+	}, rows)
+}
+
+func TestWriteQualityReportPDF(t *testing.T) {
+	var buf bytes.Buffer
+	generated := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, exporter.WriteQualityReportPDF(&buf, sampleQualityReport(), generated))
+
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+	assert.Contains(t, out, "(Data Quality Report) Tj")
+	assert.Contains(t, out, "(Generated 5 March 2024) Tj")
+	assert.Contains(t, out, "(MISSING_BIRTH_DATE) Tj")
+	assert.Contains(t, out, "(75.0%) Tj")
+	assert.Contains(t, out, "(0 certain, 0 probable, 1 possible, 3 unknown) Tj")
+}
+
+func TestWriteQualityReportPDF_ManyIssuesSpanPages(t *testing.T) {
+	report := sampleQualityReport()
+	report.TopIssues = nil
+	for i := 0; i < 40; i++ {
+		report.TopIssues = append(report.TopIssues, query.ValidationReportIssue{Code: "ISSUE", Count: i})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, exporter.WriteQualityReportPDF(&buf, report, time.Now()))
+	assert.Contains(t, buf.String(), "/Count 2")
+}
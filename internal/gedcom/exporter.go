@@ -77,6 +77,28 @@ type ExportOptions struct {
 	// uses 7.0-only structures. When set, the chosen version is emitted as-is
 	// (the auto-upgrade rule is not applied, so the caller's choice wins).
 	TargetVersion gedcom.Version
+
+	// PersonIDs and FamilyIDs, when non-nil, limit the export to the listed
+	// persons and families (e.g. a single branch of the tree). Links to
+	// records outside the set are dropped. Sources, repositories, and
+	// submitters are still exported in full; standalone notes are omitted
+	// because they cannot be attributed to the subset.
+	PersonIDs map[uuid.UUID]bool
+	FamilyIDs map[uuid.UUID]bool
+
+	// ExcludeSources omits source and repository records along with every
+	// citation of them, for destination programs that reject rich source
+	// structures.
+	ExcludeSources bool
+
+	// ExcludeNotes omits note records, note references, and inline notes.
+	ExcludeNotes bool
+}
+
+// isSubset reports whether the options limit the export to a subset of
+// persons and families.
+func (opts ExportOptions) isSubset() bool {
+	return opts.PersonIDs != nil || opts.FamilyIDs != nil
 }
 
 // Exporter handles GEDCOM file generation from repository data.
@@ -155,6 +177,19 @@ func (exp *Exporter) ExportWithOptions(ctx context.Context, w io.Writer, opts Ex
 		return nil, fmt.Errorf("failed to list repositories: %w", err)
 	}
 
+	if opts.isSubset() {
+		persons = filterByID(persons, opts.PersonIDs, func(p repository.PersonReadModel) uuid.UUID { return p.ID })
+		families = filterByID(families, opts.FamilyIDs, func(f repository.FamilyReadModel) uuid.UUID { return f.ID })
+		notes = nil
+	}
+	if opts.ExcludeSources {
+		sources = nil
+		repositories = nil
+	}
+	if opts.ExcludeNotes {
+		notes = nil
+	}
+
 	// Calculate total items for progress tracking
 	// Weight persons more heavily since they have the most processing
 	totalItems := len(sources) + len(persons)*2 + len(families) + len(notes) + len(submitters) + len(repositories) + 1 // +1 for encoding
@@ -217,6 +252,25 @@ func (exp *Exporter) ExportWithOptions(ctx context.Context, w io.Writer, opts Ex
 		}
 	}
 
+	// Sort notes by ID for stable output and assign xrefs. noteRefs collects
+	// the xrefs of the notes linked to each person, family, and source.
+	sort.Slice(notes, func(i, j int) bool {
+		return notes[i].ID.String() < notes[j].ID.String()
+	})
+	noteXrefs := make(map[uuid.UUID]string)
+	noteRefs := make(map[uuid.UUID][]string)
+	for i, n := range notes {
+		// Use GedcomXref if available (for round-trip), otherwise generate
+		if n.GedcomXref != "" {
+			noteXrefs[n.ID] = n.GedcomXref
+		} else {
+			noteXrefs[n.ID] = fmt.Sprintf("@N%d@", i+1)
+		}
+		for _, l := range n.Links {
+			noteRefs[l.EntityID] = append(noteRefs[l.EntityID], noteXrefs[n.ID])
+		}
+	}
+
 	// Build GEDCOM document
 	doc := &gedcom.Document{
 		Header: &gedcom.Header{
@@ -231,6 +285,10 @@ func (exp *Exporter) ExportWithOptions(ctx context.Context, w io.Writer, opts Ex
 	for i, s := range sources {
 		xref := sourceXrefs[s.ID]
 		src := toGedcomSource(s, repoIDToXref, repoNameToXref, exp.readStore, ctx)
+		src.NoteXRefs = noteRefs[s.ID]
+		if opts.ExcludeNotes {
+			src.InlineNotes = nil
+		}
 		doc.Records = append(doc.Records, &gedcom.Record{
 			XRef:   xref,
 			Type:   gedcom.RecordTypeSource,
@@ -252,10 +310,12 @@ func (exp *Exporter) ExportWithOptions(ctx context.Context, w io.Writer, opts Ex
 	for i, p := range persons {
 		xref := personXrefs[p.ID]
 		// Fetch citations for this person's events
-		birthCitations, _ := exp.readStore.GetCitationsForFact(ctx, domain.FactPersonBirth, p.ID)
-		deathCitations, _ := exp.readStore.GetCitationsForFact(ctx, domain.FactPersonDeath, p.ID)
-		allCitations := append(birthCitations, deathCitations...)
-		result.CitationsExported += len(allCitations)
+		var birthCitations, deathCitations []repository.CitationReadModel
+		if !opts.ExcludeSources {
+			birthCitations, _ = exp.readStore.GetCitationsForFact(ctx, domain.FactPersonBirth, p.ID)
+			deathCitations, _ = exp.readStore.GetCitationsForFact(ctx, domain.FactPersonDeath, p.ID)
+		}
+		result.CitationsExported += len(birthCitations) + len(deathCitations)
 
 		// Fetch events and attributes for this person
 		events, _ := exp.readStore.ListEventsForPerson(ctx, p.ID)
@@ -279,6 +339,13 @@ func (exp *Exporter) ExportWithOptions(ctx context.Context, w io.Writer, opts Ex
 		result.LDSOrdinancesExported += len(ldsOrdinances)
 
 		indi := toGedcomIndividual(p, sourceXrefs, personXrefs, birthCitations, deathCitations, events, attributes, associations, ldsOrdinances, exp.readStore, ctx)
+		indi.NoteXRefs = noteRefs[p.ID]
+		if opts.ExcludeNotes {
+			indi.InlineNotes = nil
+			for _, a := range indi.Associations {
+				a.Notes = nil
+			}
+		}
 		doc.Records = append(doc.Records, &gedcom.Record{
 			XRef:   xref,
 			Type:   gedcom.RecordTypeIndividual,
@@ -300,7 +367,10 @@ func (exp *Exporter) ExportWithOptions(ctx context.Context, w io.Writer, opts Ex
 	for i, f := range families {
 		xref := familyXrefs[f.ID]
 		children, _ := exp.readStore.GetFamilyChildren(ctx, f.ID)
-		marriageCitations, _ := exp.readStore.GetCitationsForFact(ctx, domain.FactFamilyMarriage, f.ID)
+		var marriageCitations []repository.CitationReadModel
+		if !opts.ExcludeSources {
+			marriageCitations, _ = exp.readStore.GetCitationsForFact(ctx, domain.FactFamilyMarriage, f.ID)
+		}
 		result.CitationsExported += len(marriageCitations)
 
 		// Fetch events for this family
@@ -312,6 +382,7 @@ func (exp *Exporter) ExportWithOptions(ctx context.Context, w io.Writer, opts Ex
 		result.LDSOrdinancesExported += len(familyLDSOrdinances)
 
 		fam := toGedcomFamily(f, personXrefs, sourceXrefs, children, marriageCitations, familyEvents, familyLDSOrdinances, exp.readStore, ctx)
+		fam.NoteXRefs = noteRefs[f.ID]
 		doc.Records = append(doc.Records, &gedcom.Record{
 			XRef:   xref,
 			Type:   gedcom.RecordTypeFamily,
@@ -329,19 +400,8 @@ func (exp *Exporter) ExportWithOptions(ctx context.Context, w io.Writer, opts Ex
 		}
 	}
 
-	// Sort notes by ID for stable output
-	sort.Slice(notes, func(i, j int) bool {
-		return notes[i].ID.String() < notes[j].ID.String()
-	})
-
 	for i, n := range notes {
-		// Use GedcomXref if available (for round-trip), otherwise generate
-		var xref string
-		if n.GedcomXref != "" {
-			xref = n.GedcomXref
-		} else {
-			xref = fmt.Sprintf("@N%d@", i+1)
-		}
+		xref := noteXrefs[n.ID]
 
 		// Notes carrying GEDCOM 7.0 metadata (MIME, language, or translations)
 		// round-trip as SNOTE records; plain notes stay as NOTE records for
@@ -396,6 +456,9 @@ func (exp *Exporter) ExportWithOptions(ctx context.Context, w io.Writer, opts Ex
 	for i, r := range repositories {
 		xref := repositoryXrefs[r.ID]
 		repo := toGedcomRepository(r, exp.readStore, ctx)
+		if opts.ExcludeNotes {
+			repo.InlineNotes = nil
+		}
 		doc.Records = append(doc.Records, &gedcom.Record{
 			XRef:   xref,
 			Type:   gedcom.RecordTypeRepository,
@@ -429,6 +492,15 @@ func (exp *Exporter) ExportWithOptions(ctx context.Context, w io.Writer, opts Ex
 	result.Version = targetVersion
 	result.SourceVersion = targetVersion
 
+	// SEX X is new in 7.0; older versions only know M, F, and U
+	if targetVersion != gedcom.Version70 {
+		for _, indi := range doc.Individuals() {
+			if indi.Sex == "X" {
+				indi.Sex = "U"
+			}
+		}
+	}
+
 	// Report encoding phase
 	if err := reportProgress("encoding", 0, 1, 99.0); err != nil {
 		return result, err
@@ -475,6 +547,17 @@ func (exp *Exporter) ExportWithOptions(ctx context.Context, w io.Writer, opts Ex
 	return result, nil
 }
 
+// filterByID returns the items whose ID is in ids.
+func filterByID[T any](items []T, ids map[uuid.UUID]bool, id func(T) uuid.UUID) []T {
+	kept := items[:0]
+	for _, item := range items {
+		if ids[id(item)] {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
 // encodeDowngraded emits doc to w at targetVersion (an older version than the
 // document's 7.0 content requires) and returns the conversion report describing
 // what was transformed or dropped. Because the gedcom-go converter works on
@@ -641,13 +724,15 @@ func toGedcomIndividual(p repository.PersonReadModel, sourceXrefs map[uuid.UUID]
 	}
 
 	// Sex
-	if p.Gender != "" {
-		switch p.Gender {
-		case domain.GenderMale:
-			indi.Sex = "M"
-		case domain.GenderFemale:
-			indi.Sex = "F"
-		}
+	switch p.Gender {
+	case domain.GenderMale:
+		indi.Sex = "M"
+	case domain.GenderFemale:
+		indi.Sex = "F"
+	case domain.GenderNonbinary:
+		indi.Sex = "X"
+	case domain.GenderUnknown:
+		indi.Sex = "U"
 	}
 
 	// Birth event
@@ -813,6 +898,14 @@ func toGedcomPersonalName(nm repository.PersonNameReadModel) *gedcom.PersonalNam
 		pn.Nickname = nm.Nickname
 	}
 
+	// Romanized form as a TRAN in Latin script; the source language is unknown
+	if nm.Romanized != "" {
+		pn.Transliterations = []*gedcom.Transliteration{{
+			Value:    nm.Romanized,
+			Language: "und-Latn",
+		}}
+	}
+
 	return pn
 }
 
@@ -1085,6 +1178,8 @@ func mapNameTypeToGedcom(nameType domain.NameType) string {
 		return "religious"
 	case domain.NameTypeProfessional:
 		return "professional"
+	case domain.NameTypePatronymic:
+		return "patronymic"
 	default:
 		return string(nameType)
 	}
@@ -194,6 +194,50 @@ func TestExport(t *testing.T) {
 	}
 }
 
+func TestExport_Subset(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	john, jane, junior := setupExportTestData(t, readStore)
+	ctx := context.Background()
+
+	readStore.SaveNote(ctx, &repository.NoteReadModel{ID: uuid.New(), Text: "Unrelated research note"})
+
+	families, _, err := readStore.ListFamilies(ctx, repository.ListOptions{Limit: 10})
+	if err != nil || len(families) != 1 {
+		t.Fatalf("expected one family, got %d (err %v)", len(families), err)
+	}
+
+	exporter := gedcom.NewExporter(readStore)
+	buf := &bytes.Buffer{}
+	result, err := exporter.ExportWithOptions(ctx, buf, gedcom.ExportOptions{
+		PersonIDs: map[uuid.UUID]bool{john: true, junior: true},
+		FamilyIDs: map[uuid.UUID]bool{families[0].ID: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.PersonsExported != 2 {
+		t.Errorf("PersonsExported = %d, want 2", result.PersonsExported)
+	}
+	if result.FamiliesExported != 1 {
+		t.Errorf("FamiliesExported = %d, want 1", result.FamiliesExported)
+	}
+	if result.NotesExported != 0 {
+		t.Errorf("NotesExported = %d, want 0 for a subset export", result.NotesExported)
+	}
+
+	output := buf.String()
+	if strings.Contains(output, "Jane") {
+		t.Errorf("subset export should not include %s", jane)
+	}
+	if !strings.Contains(output, "1 HUSB @I") || !strings.Contains(output, "1 CHIL @I") {
+		t.Error("family should keep links to exported members")
+	}
+	if strings.Contains(output, "1 WIFE") {
+		t.Error("family should drop the link to the excluded partner")
+	}
+}
+
 func TestExport_EmptyDatabase(t *testing.T) {
 	readStore := memory.NewReadModelStore()
 	exporter := gedcom.NewExporter(readStore)
@@ -223,6 +267,43 @@ func TestExport_EmptyDatabase(t *testing.T) {
 	}
 }
 
+func TestExport_Sex(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	ctx := context.Background()
+	for _, g := range []domain.Gender{domain.GenderNonbinary, domain.GenderUnknown, ""} {
+		if err := readStore.SavePerson(ctx, &repository.PersonReadModel{
+			ID: uuid.New(), GivenName: "Pat", Surname: "Lee", FullName: "Pat Lee", Gender: g,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		target gcgedcom.Version
+		want   string
+	}{
+		{gcgedcom.Version70, "1 SEX X\n"},
+		{gcgedcom.Version551, "1 SEX U\n"},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		if _, err := gedcom.NewExporter(readStore).ExportWithOptions(ctx, buf, gedcom.ExportOptions{TargetVersion: tt.target}); err != nil {
+			t.Fatal(err)
+		}
+		output := buf.String()
+		if !strings.Contains(output, tt.want) {
+			t.Errorf("%s export should contain %q", tt.target, tt.want)
+		}
+		// Unknown is exported as U; an unrecorded sex is omitted
+		if n := strings.Count(output, "1 SEX "); n != 2 {
+			t.Errorf("%s export has %d SEX lines, want 2", tt.target, n)
+		}
+		if tt.target != gcgedcom.Version70 && strings.Contains(output, "1 SEX X") {
+			t.Errorf("%s export should not contain SEX X", tt.target)
+		}
+	}
+}
+
 func TestExport_ApproximateDates(t *testing.T) {
 	readStore := memory.NewReadModelStore()
 	ctx := context.Background()
@@ -275,6 +356,61 @@ func TestExport_DateRanges(t *testing.T) {
 	}
 }
 
+func TestExport_DualDates(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	ctx := context.Background()
+
+	person := &repository.PersonReadModel{
+		ID:           uuid.New(),
+		GivenName:    "Test",
+		Surname:      "Person",
+		FullName:     "Test Person",
+		BirthDateRaw: "24 MAR 1719/20",
+	}
+	readStore.SavePerson(ctx, person)
+
+	exporter := gedcom.NewExporter(readStore)
+	buf := &bytes.Buffer{}
+	_, err := exporter.Export(ctx, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "2 DATE 24 MAR 1719/20\n") {
+		t.Error("Output should preserve dual-dated years")
+	}
+}
+
+func TestExport_HistoricalCalendars(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	ctx := context.Background()
+
+	person := &repository.PersonReadModel{
+		ID:           uuid.New(),
+		GivenName:    "Jean",
+		Surname:      "Dupont",
+		FullName:     "Jean Dupont",
+		BirthDateRaw: "@#DFRENCH R@ 12 THER 2",
+		DeathDateRaw: "@#DJULIAN@ 14 FEB 1689",
+	}
+	readStore.SavePerson(ctx, person)
+
+	exporter := gedcom.NewExporter(readStore)
+	buf := &bytes.Buffer{}
+	_, err := exporter.Export(ctx, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"2 DATE @#DFRENCH R@ 12 THER 2\n", "2 DATE @#DJULIAN@ 14 FEB 1689\n"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Output should preserve the calendar escape in %q", strings.TrimSpace(want))
+		}
+	}
+}
+
 func TestExport_Notes(t *testing.T) {
 	readStore := memory.NewReadModelStore()
 	ctx := context.Background()
@@ -304,6 +440,49 @@ func TestExport_Notes(t *testing.T) {
 	}
 }
 
+func TestExport_LinkedNotes(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	ctx := context.Background()
+
+	personID := uuid.New()
+	readStore.SavePerson(ctx, &repository.PersonReadModel{
+		ID:        personID,
+		GivenName: "Test",
+		Surname:   "Person",
+		FullName:  "Test Person",
+	})
+	familyID := uuid.New()
+	readStore.SaveFamily(ctx, &repository.FamilyReadModel{
+		ID:                familyID,
+		Partner1ID:        &personID,
+		Partner1GivenName: "Test",
+		Partner1Surname:   "Person",
+	})
+	readStore.SaveNote(ctx, &repository.NoteReadModel{
+		ID:         uuid.New(),
+		Text:       "Shared research note",
+		GedcomXref: "@N7@",
+		Links: []domain.NoteLink{
+			{EntityType: "person", EntityID: personID},
+			{EntityType: "family", EntityID: familyID},
+		},
+	})
+
+	exporter := gedcom.NewExporter(readStore)
+	buf := &bytes.Buffer{}
+	if _, err := exporter.Export(ctx, buf); err != nil {
+		t.Fatal(err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "0 @N7@ NOTE Shared research note") {
+		t.Error("Output should contain the shared NOTE record")
+	}
+	if got := strings.Count(output, "1 NOTE @N7@\n"); got != 2 {
+		t.Errorf("NOTE @N7@ references = %d, want 2 (person and family)", got)
+	}
+}
+
 func TestExport_SingleParentFamily(t *testing.T) {
 	readStore := memory.NewReadModelStore()
 	ctx := context.Background()
@@ -1666,6 +1845,49 @@ func TestExport_MultipleNames(t *testing.T) {
 	}
 }
 
+func TestExport_RomanizedName(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	ctx := context.Background()
+
+	personID := uuid.New()
+	if err := readStore.SavePerson(ctx, &repository.PersonReadModel{
+		ID:        personID,
+		GivenName: "Иван",
+		Surname:   "Иванов",
+		FullName:  "Иван Иванов",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := readStore.SavePersonName(ctx, &repository.PersonNameReadModel{
+		ID:        uuid.New(),
+		PersonID:  personID,
+		GivenName: "Иван",
+		Surname:   "Иванов",
+		FullName:  "Иван Иванов",
+		Romanized: "Ivan Ivanov",
+		IsPrimary: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := &bytes.Buffer{}
+	result, err := gedcom.NewExporter(readStore).Export(ctx, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "2 TRAN Ivan Ivanov") {
+		t.Errorf("Output should contain '2 TRAN Ivan Ivanov', got:\n%s", output)
+	}
+	if !strings.Contains(output, "3 LANG und-Latn") {
+		t.Error("Output should contain '3 LANG und-Latn'")
+	}
+	if result.Version != gcgedcom.Version70 {
+		t.Errorf("Version = %q, want %q", result.Version, gcgedcom.Version70)
+	}
+}
+
 func TestExport_PrimaryNameFirst(t *testing.T) {
 	readStore := memory.NewReadModelStore()
 	ctx := context.Background()
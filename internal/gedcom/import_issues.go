@@ -0,0 +1,135 @@
+package gedcom
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cacack/gedcom-go/v2/decoder"
+	"github.com/cacack/gedcom-go/v2/validator"
+)
+
+// ImportIssueSeverity separates problems the importer worked around from
+// problems in the data that need fixing.
+type ImportIssueSeverity string
+
+const (
+	// ImportIssueWarning is recoverable: the data was imported as-is or with a
+	// substitute, e.g. an unknown tag or a person without a name.
+	ImportIssueWarning ImportIssueSeverity = "warning"
+	// ImportIssueError is a data problem, e.g. an unparseable date or a
+	// reference to a record that does not exist.
+	ImportIssueError ImportIssueSeverity = "error"
+)
+
+// Import issue codes raised by the importer itself. Issues reported by the
+// gedcom-go decoder and validator keep their own codes (e.g. UNKNOWN_TAG,
+// MISSING_REQUIRED), which BROKEN_XREF and INVALID_VALUE are shared with.
+const (
+	IssueBrokenXRef      = "BROKEN_XREF"
+	IssueInvalidDate     = "INVALID_DATE"
+	IssueInvalidValue    = "INVALID_VALUE"
+	IssueMissingName     = "MISSING_NAME"
+	IssueMissingRequired = "MISSING_REQUIRED"
+	IssueMediaNotFound   = "MEDIA_FILE_NOT_FOUND"
+	IssueMediaTooLarge   = "MEDIA_FILE_TOO_LARGE"
+	IssueNotImported     = "RECORD_NOT_IMPORTED"
+)
+
+// ImportIssue is a machine-readable problem found while importing a GEDCOM
+// file, the structured counterpart of an import warning.
+type ImportIssue struct {
+	Severity ImportIssueSeverity
+	Code     string
+	Line     int    // 1-based GEDCOM line, 0 when unknown
+	Context  string // Record XREF or raw GEDCOM content the issue concerns
+	Message  string
+}
+
+// ImportIssueGroup collects the issues sharing a code.
+type ImportIssueGroup struct {
+	Code     string
+	Severity ImportIssueSeverity // Most severe of the group's issues
+	Issues   []ImportIssue
+}
+
+// GroupImportIssues groups issues by code, errors before warnings and larger
+// groups first. Issues keep their order within a group.
+func GroupImportIssues(issues []ImportIssue) []ImportIssueGroup {
+	index := make(map[string]int)
+	var groups []ImportIssueGroup
+	for _, issue := range issues {
+		i, ok := index[issue.Code]
+		if !ok {
+			i = len(groups)
+			index[issue.Code] = i
+			groups = append(groups, ImportIssueGroup{Code: issue.Code, Severity: issue.Severity})
+		}
+		if issue.Severity == ImportIssueError {
+			groups[i].Severity = ImportIssueError
+		}
+		groups[i].Issues = append(groups[i].Issues, issue)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Severity != groups[j].Severity {
+			return groups[i].Severity == ImportIssueError
+		}
+		return len(groups[i].Issues) > len(groups[j].Issues)
+	})
+	return groups
+}
+
+// addIssue records an importer problem as a flat warning and as an
+// ImportIssue located at the record with the given XREF.
+func (r *ImportResult) addIssue(severity ImportIssueSeverity, code, xref, format string, args ...any) {
+	r.addIssueAt(severity, code, xref, r.recordLines[xref], format, args...)
+}
+
+// addIssueAt is addIssue for a problem on a known line within the record.
+func (r *ImportResult) addIssueAt(severity ImportIssueSeverity, code, xref string, line int, format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	r.Warnings = append(r.Warnings, msg)
+	r.Issues = append(r.Issues, ImportIssue{
+		Severity: severity,
+		Code:     code,
+		Line:     line,
+		Context:  xref,
+		Message:  msg,
+	})
+}
+
+// diagnosticIssue converts a decoder diagnostic to an import issue.
+func diagnosticIssue(d decoder.Diagnostic) ImportIssue {
+	severity := ImportIssueWarning
+	if d.Severity == decoder.SeverityError {
+		severity = ImportIssueError
+	}
+	return ImportIssue{
+		Severity: severity,
+		Code:     d.Code,
+		Line:     d.Line,
+		Context:  d.Context,
+		Message:  d.String(),
+	}
+}
+
+// validationIssue converts a gedcom-go validation error, such as an empty
+// family, to an import issue.
+func validationIssue(err error) ImportIssue {
+	issue := ImportIssue{Severity: ImportIssueWarning, Code: IssueInvalidValue, Message: err.Error()}
+	var verr *validator.ValidationError
+	if errors.As(err, &verr) {
+		issue.Code = verr.Code
+		issue.Line = verr.Line
+		issue.Context = verr.XRef
+	}
+	return issue
+}
+
+// isBrokenXRef reports whether a gedcom-go validation error is a broken
+// reference, which reportDanglingXRefs reports in more detail.
+func isBrokenXRef(err error) bool {
+	var verr *validator.ValidationError
+	return errors.As(err, &verr) && verr.Code == IssueBrokenXRef
+}
@@ -0,0 +1,178 @@
+package gedcom_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cacack/my-family/internal/gedcom"
+)
+
+func TestImportIssues(t *testing.T) {
+	gedcomData := `0 HEAD
+1 GEDC
+2 VERS 5.5
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME Test /Person/
+1 SEX Q
+1 BIRT
+2 DATE 31 FEB 1900
+0 @F1@ FAM
+1 HUSB @I1@
+1 CHIL @I99@
+0 TRLR
+`
+	result, _, _, _, _, _, _, _, _, _, _, _, _, err := gedcom.NewImporter().Import(context.Background(), strings.NewReader(gedcomData))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	find := func(code, context string) *gedcom.ImportIssue {
+		for i, issue := range result.Issues {
+			if issue.Code == code && issue.Context == context {
+				return &result.Issues[i]
+			}
+		}
+		return nil
+	}
+
+	tests := []struct {
+		code     string
+		context  string
+		severity gedcom.ImportIssueSeverity
+		line     int
+	}{
+		{gedcom.IssueInvalidValue, "@I1@", gedcom.ImportIssueWarning, 5},
+		{gedcom.IssueInvalidDate, "@I1@", gedcom.ImportIssueError, 5},
+		{gedcom.IssueBrokenXRef, "@F1@", gedcom.ImportIssueError, 12},
+	}
+	for _, tt := range tests {
+		issue := find(tt.code, tt.context)
+		if issue == nil {
+			t.Errorf("no %s issue for %s in %+v", tt.code, tt.context, result.Issues)
+			continue
+		}
+		if issue.Severity != tt.severity {
+			t.Errorf("%s severity = %s, want %s", tt.code, issue.Severity, tt.severity)
+		}
+		if issue.Line != tt.line {
+			t.Errorf("%s line = %d, want %d", tt.code, issue.Line, tt.line)
+		}
+		if issue.Message == "" {
+			t.Errorf("%s has no message", tt.code)
+		}
+	}
+
+	// Every flat warning has a structured counterpart
+	if len(result.Issues) < len(result.Warnings) {
+		t.Errorf("len(Issues) = %d, want at least len(Warnings) = %d", len(result.Issues), len(result.Warnings))
+	}
+}
+
+func TestGroupImportIssues(t *testing.T) {
+	issues := []gedcom.ImportIssue{
+		{Severity: gedcom.ImportIssueWarning, Code: "UNKNOWN_TAG", Line: 3},
+		{Severity: gedcom.ImportIssueWarning, Code: "UNKNOWN_TAG", Line: 7},
+		{Severity: gedcom.ImportIssueWarning, Code: "MISSING_NAME", Line: 9},
+		{Severity: gedcom.ImportIssueError, Code: "BROKEN_XREF", Line: 12},
+		{Severity: gedcom.ImportIssueWarning, Code: "UNKNOWN_TAG", Line: 15},
+	}
+
+	groups := gedcom.GroupImportIssues(issues)
+
+	want := []struct {
+		code  string
+		count int
+	}{
+		{"BROKEN_XREF", 1},
+		{"UNKNOWN_TAG", 3},
+		{"MISSING_NAME", 1},
+	}
+	if len(groups) != len(want) {
+		t.Fatalf("len(groups) = %d, want %d", len(groups), len(want))
+	}
+	for i, w := range want {
+		if groups[i].Code != w.code || len(groups[i].Issues) != w.count {
+			t.Errorf("groups[%d] = %s x%d, want %s x%d", i, groups[i].Code, len(groups[i].Issues), w.code, w.count)
+		}
+	}
+	if groups[0].Severity != gedcom.ImportIssueError {
+		t.Errorf("groups[0].Severity = %s, want error", groups[0].Severity)
+	}
+	if lines := []int{groups[1].Issues[0].Line, groups[1].Issues[2].Line}; lines[0] != 3 || lines[1] != 15 {
+		t.Errorf("UNKNOWN_TAG issues out of order: %v", groups[1].Issues)
+	}
+}
+
+func TestImportDanglingXRefs(t *testing.T) {
+	gedcomData := `0 HEAD
+1 GEDC
+2 VERS 5.5
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME John /Doe/
+1 FAMC @F45@
+1 FAMS @F1@
+1 BIRT
+2 SOUR @S9@
+0 @I2@ INDI
+1 NAME Jane /Doe/
+1 FAMC @F1@
+1 ASSO @I88@
+2 RELA Godfather
+0 @S1@ SOUR
+1 TITL Parish register
+0 @F1@ FAM
+1 HUSB @I1@
+1 WIFE @S1@
+1 CHIL @I2@
+1 CHIL @I123@
+0 TRLR
+`
+	result, _, families, _, _, _, _, _, _, _, associations, _, _, err := gedcom.NewImporter().Import(context.Background(), strings.NewReader(gedcomData))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	want := []struct {
+		context string
+		line    int
+		message string
+	}{
+		{"@I1@", 7, "Individual @I1@: parents' family @F45@ not found"},
+		{"@I1@", 10, "Individual @I1@: source @S9@ not found"},
+		{"@I2@", 14, "Individual @I2@: associated individual @I88@ not found"},
+		{"@F1@", 20, "Family @F1@: wife @S1@ is a SOUR record"},
+		{"@F1@", 22, "Family @F1@: child @I123@ not found"},
+	}
+	var broken []gedcom.ImportIssue
+	for _, issue := range result.Issues {
+		if issue.Code == gedcom.IssueBrokenXRef {
+			broken = append(broken, issue)
+		}
+	}
+	if len(broken) != len(want) {
+		t.Fatalf("got %d BROKEN_XREF issues, want %d: %+v", len(broken), len(want), broken)
+	}
+	for i, w := range want {
+		got := broken[i]
+		if got.Context != w.context || got.Line != w.line || got.Message != w.message || got.Severity != gedcom.ImportIssueError {
+			t.Errorf("issue %d = %+v, want %s at line %d: %q", i, got, w.context, w.line, w.message)
+		}
+	}
+
+	// Dangling pointers are left unlinked rather than pointing nowhere
+	if len(families) != 1 {
+		t.Fatalf("len(families) = %d, want 1", len(families))
+	}
+	if families[0].Partner2ID != nil {
+		t.Error("wife pointing at a source should not be linked")
+	}
+	if len(families[0].ChildIDs) != 1 {
+		t.Errorf("len(ChildIDs) = %d, want 1", len(families[0].ChildIDs))
+	}
+	if len(associations) != 0 {
+		t.Errorf("len(associations) = %d, want 0", len(associations))
+	}
+}
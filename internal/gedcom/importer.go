@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"strings"
 
 	"github.com/cacack/gedcom-go/v2/decoder"
@@ -33,6 +34,10 @@ type ImportResult struct {
 	Warnings              []string
 	Errors                []string
 
+	// Issues holds every warning, along with the decoder's parse errors, in
+	// machine-readable form; see GroupImportIssues.
+	Issues []ImportIssue
+
 	// Vendor is the detected vendor that created this GEDCOM file (e.g., "ancestry", "familysearch").
 	// Empty string if vendor could not be determined.
 	Vendor string
@@ -49,6 +54,9 @@ type ImportResult struct {
 	RepositoryXrefToID map[string]uuid.UUID
 	NoteXrefToID       map[string]uuid.UUID
 	SubmitterXrefToID  map[string]uuid.UUID
+
+	// recordLines maps record XREFs to the line where the record starts
+	recordLines map[string]int
 }
 
 // PersonNameData contains parsed name data for a person.
@@ -59,6 +67,7 @@ type PersonNameData struct {
 	NameSuffix    string          // Jr., III, PhD (NSFX)
 	SurnamePrefix string          // von, de, van (SPFX)
 	Nickname      string          // Informal name (NICK)
+	Romanized     string          // Latin-script form (TRAN)
 	NameType      domain.NameType // birth, married, aka (TYPE)
 	IsPrimary     bool            // First name is primary
 }
@@ -202,6 +211,16 @@ type MediaData struct {
 	Files        []domain.MediaFile // Multiple file references (GEDCOM 7.0)
 	Format       string             // Primary format/MIME type (FORM)
 	Translations []string           // Translated titles (GEDCOM 7.0)
+	// Persons, families, and sources that reference this media object
+	Links []MediaLinkData
+}
+
+// MediaLinkData records a person, family, or source that references a media
+// object, either through an OBJE pointer or an inline OBJE structure.
+type MediaLinkData struct {
+	EntityType string // "person", "family", "source"
+	EntityID   uuid.UUID
+	Title      string // TITL given on the reference, overriding the file title
 }
 
 // EventData contains parsed life event data ready for creation.
@@ -245,6 +264,7 @@ type NoteData struct {
 	MIME         string                   // GEDCOM 7.0 SNOTE media type (e.g., "text/html")
 	Language     string                   // GEDCOM 7.0 SNOTE BCP 47 language tag (e.g., "en")
 	Translations []domain.NoteTranslation // GEDCOM 7.0 SNOTE alternate-language renderings
+	Links        []domain.NoteLink        // Persons, families, and sources that reference the note
 }
 
 // SubmitterData contains parsed submitter data ready for creation.
@@ -357,6 +377,13 @@ func (imp *Importer) ImportWithOptions(ctx context.Context, reader io.Reader, im
 
 	doc := decodeResult.Document
 
+	result.recordLines = make(map[string]int, len(doc.XRefMap))
+	for xref, record := range doc.XRefMap {
+		if record != nil {
+			result.recordLines[xref] = record.LineNumber
+		}
+	}
+
 	// Map decoder diagnostics to import warnings/errors
 	for _, d := range decodeResult.Diagnostics {
 		switch d.Severity {
@@ -365,15 +392,21 @@ func (imp *Importer) ImportWithOptions(ctx context.Context, reader io.Reader, im
 		default:
 			result.Warnings = append(result.Warnings, d.String())
 		}
+		result.Issues = append(result.Issues, diagnosticIssue(d))
 	}
 
 	// Run gedcom-go validation to catch structural issues
 	v := validator.New()
 	validationErrors := v.Validate(doc)
 	for _, verr := range validationErrors {
+		if isBrokenXRef(verr) {
+			continue
+		}
 		// Add validation errors as warnings (don't fail import)
 		result.Warnings = append(result.Warnings, verr.Error())
+		result.Issues = append(result.Issues, validationIssue(verr))
 	}
+	reportDanglingXRefs(doc, result)
 
 	// Extract vendor information from the document
 	result.Vendor = string(doc.Vendor)
@@ -460,6 +493,43 @@ func (imp *Importer) ImportWithOptions(ctx context.Context, reader io.Reader, im
 		result.NoteXrefToID[snote.XRef] = noteData.ID
 	}
 
+	// Link shared notes to the persons, families, and sources that reference
+	// them. A note referenced by several records is linked to each. Families
+	// have no notes field of their own, so their inline notes become notes too.
+	noteIndex := make(map[string]int, len(notes))
+	for i, n := range notes {
+		noteIndex[n.GedcomXref] = i
+	}
+	linkNotes := func(xrefs []string, entityType string, entityID uuid.UUID) {
+		for _, xref := range xrefs {
+			i, ok := noteIndex[xref]
+			if !ok {
+				continue // reported by reportDanglingXRefs
+			}
+			notes[i].Links = appendNoteLink(notes[i].Links, entityType, entityID)
+		}
+	}
+	for _, indi := range doc.Individuals() {
+		linkNotes(indi.NoteXRefs, "person", result.PersonXrefToID[indi.XRef])
+	}
+	for _, fam := range doc.Families() {
+		familyID := result.FamilyXrefToID[fam.XRef]
+		linkNotes(fam.NoteXRefs, "family", familyID)
+		for _, text := range fam.InlineNotes {
+			if text == "" {
+				continue
+			}
+			notes = append(notes, NoteData{
+				ID:    uuid.New(),
+				Text:  text,
+				Links: []domain.NoteLink{{EntityType: "family", EntityID: familyID}},
+			})
+		}
+	}
+	for _, src := range doc.Sources() {
+		linkNotes(src.NoteXRefs, "source", result.SourceXrefToID[src.XRef])
+	}
+
 	// Sixth pass: parse SUBM (submitter) records
 	// These track who created or submitted the genealogical data
 	var submitters []SubmitterData
@@ -501,6 +571,43 @@ func (imp *Importer) ImportWithOptions(ctx context.Context, reader io.Reader, im
 		result.MediaXrefToID[media.XRef] = mediaData.ID
 	}
 
+	// Link media objects to the persons, families, and sources that reference
+	// them. Inline OBJE structures (GEDCOM 5.5) carry their FILE directly and
+	// become media objects of their own.
+	mediaIndex := make(map[string]int, len(mediaObjects))
+	for i, m := range mediaObjects {
+		mediaIndex[m.GedcomXref] = i
+	}
+	linkMedia := func(links []*gedcom.MediaLink, tags []*gedcom.Tag, entityType string, entityID uuid.UUID) {
+		for _, link := range links {
+			if link == nil || link.MediaXRef == "" {
+				continue
+			}
+			i, ok := mediaIndex[link.MediaXRef]
+			if !ok {
+				continue // reported by reportDanglingXRefs
+			}
+			mediaObjects[i].Links = append(mediaObjects[i].Links, MediaLinkData{
+				EntityType: entityType,
+				EntityID:   entityID,
+				Title:      link.Title,
+			})
+		}
+		for _, m := range parseInlineMedia(tags) {
+			m.Links = []MediaLinkData{{EntityType: entityType, EntityID: entityID}}
+			mediaObjects = append(mediaObjects, m)
+		}
+	}
+	for _, indi := range doc.Individuals() {
+		linkMedia(indi.Media, indi.Tags, "person", result.PersonXrefToID[indi.XRef])
+	}
+	for _, fam := range doc.Families() {
+		linkMedia(fam.Media, fam.Tags, "family", result.FamilyXrefToID[fam.XRef])
+	}
+	for _, src := range doc.Sources() {
+		linkMedia(src.Media, src.Tags, "source", result.SourceXrefToID[src.XRef])
+	}
+
 	result.PersonsImported = len(persons)
 	result.FamiliesImported = len(families)
 	result.SourcesImported = len(sources)
@@ -517,6 +624,33 @@ func (imp *Importer) ImportWithOptions(ctx context.Context, reader io.Reader, im
 	return result, persons, families, sources, citations, repositories, events, attributes, notes, submitters, associations, ldsOrdinances, mediaObjects, nil
 }
 
+// romanizedName returns the Latin-script transliteration of a name (a TRAN
+// whose LANG has the Latn script subtag, e.g. "ru-Latn"), falling back to the
+// first transliteration. Surname slashes are dropped.
+func romanizedName(trans []*gedcom.Transliteration) string {
+	var chosen *gedcom.Transliteration
+	for _, t := range trans {
+		if t == nil {
+			continue
+		}
+		if strings.Contains(strings.ToLower(t.Language), "-latn") {
+			chosen = t
+			break
+		}
+		if chosen == nil {
+			chosen = t
+		}
+	}
+	if chosen == nil {
+		return ""
+	}
+	value := chosen.Value
+	if strings.TrimSpace(value) == "" {
+		value = chosen.Given + " " + chosen.Surname
+	}
+	return strings.Join(strings.Fields(strings.ReplaceAll(value, "/", " ")), " ")
+}
+
 // parseIndividual converts a GEDCOM individual record to PersonData.
 // The doc parameter provides access to other records for PEDI lookup.
 // TODO: doc parameter reserved for future cross-record lookups
@@ -536,6 +670,7 @@ func parseIndividual(indi *gedcom.Individual, _ *gedcom.Document, result *Import
 				NameSuffix:    strings.TrimSpace(name.Suffix),
 				SurnamePrefix: strings.TrimSpace(name.SurnamePrefix),
 				Nickname:      strings.TrimSpace(name.Nickname),
+				Romanized:     romanizedName(name.Transliterations),
 				NameType:      mapNameType(name.Type),
 				IsPrimary:     i == 0, // First name is primary
 			}
@@ -544,8 +679,8 @@ func parseIndividual(indi *gedcom.Individual, _ *gedcom.Document, result *Import
 			if nameData.GivenName == "" {
 				nameData.GivenName = "Unknown"
 				if i == 0 {
-					result.Warnings = append(result.Warnings,
-						fmt.Sprintf("Individual %s: missing given name, using 'Unknown'", indi.XRef))
+					result.addIssue(ImportIssueWarning, IssueMissingName, indi.XRef,
+						"Individual %s: missing given name, using 'Unknown'", indi.XRef)
 				}
 			}
 
@@ -566,18 +701,26 @@ func parseIndividual(indi *gedcom.Individual, _ *gedcom.Document, result *Import
 	} else {
 		person.GivenName = "Unknown"
 		// Leave surname empty - no name record at all
-		result.Warnings = append(result.Warnings,
-			fmt.Sprintf("Individual %s: no name record, using 'Unknown'", indi.XRef))
+		result.addIssue(ImportIssueWarning, IssueMissingName, indi.XRef,
+			"Individual %s: no name record, using 'Unknown'", indi.XRef)
 	}
 
-	// Parse sex
-	switch strings.ToUpper(indi.Sex) {
+	// Parse sex. A missing SEX stays unrecorded rather than "unknown", which
+	// GEDCOM reserves for a sex that could not be determined (U).
+	switch strings.ToUpper(strings.TrimSpace(indi.Sex)) {
+	case "":
 	case "M":
 		person.Gender = domain.GenderMale
 	case "F":
 		person.Gender = domain.GenderFemale
+	case "X":
+		person.Gender = domain.GenderNonbinary
+	case "U":
+		person.Gender = domain.GenderUnknown
 	default:
 		person.Gender = domain.GenderUnknown
+		result.addIssue(ImportIssueWarning, IssueInvalidValue, indi.XRef,
+			"Individual %s: unrecognized SEX %q, recorded as unknown", indi.XRef, indi.Sex)
 	}
 
 	// Parse events for birth and death with date validation
@@ -604,8 +747,8 @@ func parseIndividual(indi *gedcom.Individual, _ *gedcom.Document, result *Import
 			// Validate the date if parsed
 			if event.ParsedDate != nil {
 				if err := event.ParsedDate.Validate(); err != nil {
-					result.Warnings = append(result.Warnings,
-						fmt.Sprintf("Individual %s: invalid birth date '%s': %v", indi.XRef, event.Date, err))
+					result.addIssue(ImportIssueError, IssueInvalidDate, indi.XRef,
+						"Individual %s: invalid birth date '%s': %v", indi.XRef, event.Date, err)
 				}
 			}
 		case gedcom.EventDeath:
@@ -625,25 +768,16 @@ func parseIndividual(indi *gedcom.Individual, _ *gedcom.Document, result *Import
 			// Validate the date if parsed
 			if event.ParsedDate != nil {
 				if err := event.ParsedDate.Validate(); err != nil {
-					result.Warnings = append(result.Warnings,
-						fmt.Sprintf("Individual %s: invalid death date '%s': %v", indi.XRef, event.Date, err))
+					result.addIssue(ImportIssueError, IssueInvalidDate, indi.XRef,
+						"Individual %s: invalid death date '%s': %v", indi.XRef, event.Date, err)
 				}
 			}
 		}
 	}
 
-	// Collect notes - in cacack/gedcom-go, Notes contains XRefs to note records
-	// For inline notes, we need to check for notes stored differently
-	// The library stores inline notes as part of the individual's Tags
-	var notes []string
-	for _, tag := range indi.Tags {
-		if tag.Tag == "NOTE" && tag.Value != "" {
-			notes = append(notes, tag.Value)
-		}
-	}
-	if len(notes) > 0 {
-		person.Notes = strings.Join(notes, "\n\n")
-	}
+	// Inline notes (with CONT/CONC continuations) become the person's notes.
+	// References to shared NOTE records are linked after notes are parsed.
+	person.Notes = joinInlineNotes(indi.InlineNotes)
 
 	// Extract FamilySearch Family Tree ID (vendor extension)
 	person.FamilySearchID = indi.FamilySearchID
@@ -651,6 +785,15 @@ func parseIndividual(indi *gedcom.Individual, _ *gedcom.Document, result *Import
 	// Extract GEDCOM 7.0 external identifiers (EXID)
 	person.ExternalIDs = toDomainExternalIDs(indi.ExternalIDs)
 
+	// A _FSFTID is the 5.5.1 spelling of a FamilySearch ARK EXID; keep it as
+	// one so it is linkable alongside any EXIDs.
+	if person.FamilySearchID != "" && !hasExternalID(person.ExternalIDs, person.FamilySearchID, domain.FamilySearchArkType) {
+		person.ExternalIDs = append(person.ExternalIDs, domain.ExternalIdentifier{
+			Value: person.FamilySearchID,
+			Type:  domain.FamilySearchArkType,
+		})
+	}
+
 	return person
 }
 
@@ -673,6 +816,23 @@ func toDomainExternalIDs(exids []*gedcom.ExternalID) []domain.ExternalIdentifier
 	return result
 }
 
+// hasExternalID reports whether ids already holds value for the external
+// system identified by typ. Types are compared loosely so http/https and
+// trailing-slash variants of the same URI match.
+func hasExternalID(ids []domain.ExternalIdentifier, value, typ string) bool {
+	norm := func(s string) string {
+		s = strings.ToLower(strings.TrimSuffix(s, "/"))
+		s = strings.TrimPrefix(s, "https://")
+		return strings.TrimPrefix(s, "http://")
+	}
+	for _, id := range ids {
+		if id.Value == value && norm(id.Type) == norm(typ) {
+			return true
+		}
+	}
+	return false
+}
+
 // parseFamily converts a GEDCOM family record to FamilyData.
 // The doc parameter provides access to individual records for PEDI lookup.
 func parseFamily(fam *gedcom.Family, doc *gedcom.Document, result *ImportResult) FamilyData {
@@ -685,8 +845,8 @@ func parseFamily(fam *gedcom.Family, doc *gedcom.Document, result *ImportResult)
 	// Link husband/wife (partner1/partner2) using gedcom-go's nil-safe
 	// relationship traversal, which resolves the XRef against the document
 	// and returns nil for missing/broken references.
-	family.Partner1ID = resolvePartner(fam.Husband, fam.HusbandIndividual(doc), fam.XRef, "husband", result)
-	family.Partner2ID = resolvePartner(fam.Wife, fam.WifeIndividual(doc), fam.XRef, "wife", result)
+	family.Partner1ID = resolvePartner(fam.HusbandIndividual(doc), result)
+	family.Partner2ID = resolvePartner(fam.WifeIndividual(doc), result)
 
 	// Parse events for marriage with date validation
 	for _, event := range fam.Events {
@@ -713,8 +873,8 @@ func parseFamily(fam *gedcom.Family, doc *gedcom.Document, result *ImportResult)
 			// Validate the date if parsed
 			if event.ParsedDate != nil {
 				if err := event.ParsedDate.Validate(); err != nil {
-					result.Warnings = append(result.Warnings,
-						fmt.Sprintf("Family %s: invalid marriage date '%s': %v", fam.XRef, event.Date, err))
+					result.addIssue(ImportIssueError, IssueInvalidDate, fam.XRef,
+						"Family %s: invalid marriage date '%s': %v", fam.XRef, event.Date, err)
 				}
 			}
 		case gedcom.EventDivorce:
@@ -724,7 +884,7 @@ func parseFamily(fam *gedcom.Family, doc *gedcom.Document, result *ImportResult)
 
 	// Link children using gedcom-go's nil-safe relationship traversal.
 	// ChildrenIndividuals resolves each child XRef against the document and
-	// silently drops broken references; we detect and warn about those below.
+	// drops broken references, which reportDanglingXRefs has reported.
 	resolvedChildren := fam.ChildrenIndividuals(doc)
 	for _, child := range resolvedChildren {
 		id := result.PersonXrefToID[child.XRef]
@@ -733,19 +893,6 @@ func parseFamily(fam *gedcom.Family, doc *gedcom.Document, result *ImportResult)
 		// Look up the child's PEDI for this family
 		family.ChildRelTypes = append(family.ChildRelTypes, childPedigreeType(child, fam.XRef))
 	}
-	// Warn about child references that could not be resolved.
-	if len(resolvedChildren) < len(fam.Children) {
-		resolved := make(map[string]bool, len(resolvedChildren))
-		for _, child := range resolvedChildren {
-			resolved[child.XRef] = true
-		}
-		for _, childXRef := range fam.Children {
-			if !resolved[childXRef] {
-				result.Warnings = append(result.Warnings,
-					fmt.Sprintf("Family %s: child %s not found", fam.XRef, childXRef))
-			}
-		}
-	}
 
 	// Extract GEDCOM 7.0 external identifiers (EXID)
 	family.ExternalIDs = toDomainExternalIDs(fam.ExternalIDs)
@@ -753,17 +900,11 @@ func parseFamily(fam *gedcom.Family, doc *gedcom.Document, result *ImportResult)
 	return family
 }
 
-// resolvePartner maps a resolved partner individual to its internal UUID.
-// xref is the raw GEDCOM reference (empty when no partner is declared) and
-// indi is the result of gedcom-go's nil-safe traversal for that partner.
-// A declared-but-unresolvable reference produces a warning and a nil result.
-func resolvePartner(xref string, indi *gedcom.Individual, familyXRef, role string, result *ImportResult) *uuid.UUID {
-	if xref == "" {
-		return nil
-	}
+// resolvePartner maps a partner individual, as resolved by gedcom-go's
+// nil-safe traversal, to its internal UUID. It returns nil when no partner is
+// declared or the reference is dangling (see reportDanglingXRefs).
+func resolvePartner(indi *gedcom.Individual, result *ImportResult) *uuid.UUID {
 	if indi == nil {
-		result.Warnings = append(result.Warnings,
-			fmt.Sprintf("Family %s: %s %s not found", familyXRef, role, xref))
 		return nil
 	}
 	id := result.PersonXrefToID[indi.XRef]
@@ -828,16 +969,8 @@ func parseSource(src *gedcom.Source, result *ImportResult) SourceData {
 		}
 	}
 
-	// Collect notes
-	var notes []string
-	for _, tag := range src.Tags {
-		if tag.Tag == "NOTE" && tag.Value != "" {
-			notes = append(notes, tag.Value)
-		}
-	}
-	if len(notes) > 0 {
-		source.Notes = strings.Join(notes, "\n\n")
-	}
+	// Collect inline notes; shared NOTE references are linked separately
+	source.Notes = joinInlineNotes(src.InlineNotes)
 
 	// Default source type to "other" if not specified
 	source.SourceType = string(domain.SourceOther)
@@ -881,16 +1014,8 @@ func parseRepository(repo *gedcom.Repository, _ *ImportResult) RepositoryData {
 		repository.Website = addr.Website
 	}
 
-	// Collect notes
-	var notes []string
-	for _, tag := range repo.Tags {
-		if tag.Tag == "NOTE" && tag.Value != "" {
-			notes = append(notes, tag.Value)
-		}
-	}
-	if len(notes) > 0 {
-		repository.Notes = strings.Join(notes, "\n\n")
-	}
+	// Collect inline notes
+	repository.Notes = joinInlineNotes(repo.InlineNotes)
 
 	// Extract GEDCOM 7.0 external identifiers (EXID)
 	repository.ExternalIDs = toDomainExternalIDs(repo.ExternalIDs)
@@ -898,6 +1023,27 @@ func parseRepository(repo *gedcom.Repository, _ *ImportResult) RepositoryData {
 	return repository
 }
 
+// joinInlineNotes combines a record's inline notes into a single notes field.
+func joinInlineNotes(inline []string) string {
+	var notes []string
+	for _, n := range inline {
+		if n != "" {
+			notes = append(notes, n)
+		}
+	}
+	return strings.Join(notes, "\n\n")
+}
+
+// appendNoteLink adds a link to links unless the entity is already linked.
+func appendNoteLink(links []domain.NoteLink, entityType string, entityID uuid.UUID) []domain.NoteLink {
+	for _, l := range links {
+		if l.EntityType == entityType && l.EntityID == entityID {
+			return links
+		}
+	}
+	return append(links, domain.NoteLink{EntityType: entityType, EntityID: entityID})
+}
+
 // parseNote converts a GEDCOM note record to NoteData.
 // gedcom-go handles CONT/CONC lines automatically - FullText() returns the complete text.
 func parseNote(note *gedcom.Note) NoteData {
@@ -1328,24 +1474,22 @@ func extractAssociationsFromIndividual(indi *gedcom.Individual, personID uuid.UU
 	for _, assoc := range indi.Associations {
 		// Skip if no associate reference
 		if assoc.IndividualXRef == "" {
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Individual %s: association without IndividualXRef, skipping", indi.XRef))
+			result.addIssue(ImportIssueWarning, IssueMissingRequired, indi.XRef,
+				"Individual %s: association without IndividualXRef, skipping", indi.XRef)
 			continue
 		}
 
 		// Look up the associate's UUID
 		associateID, found := result.PersonXrefToID[assoc.IndividualXRef]
 		if !found {
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Individual %s: association references unknown individual %s, skipping", indi.XRef, assoc.IndividualXRef))
-			continue
+			continue // reported by reportDanglingXRefs
 		}
 
 		// Map GEDCOM role to lowercase
 		role := mapAssociationRole(assoc.Role)
 		if role == "" {
-			result.Warnings = append(result.Warnings,
-				fmt.Sprintf("Individual %s: association without role, using 'unknown'", indi.XRef))
+			result.addIssue(ImportIssueWarning, IssueMissingRequired, indi.XRef,
+				"Individual %s: association without role, using 'unknown'", indi.XRef)
 			role = "unknown"
 		}
 
@@ -1492,6 +1636,8 @@ func mapNameType(gedcomType string) domain.NameType {
 		return domain.NameTypeReligious
 	case "professional", "stage":
 		return domain.NameTypeProfessional
+	case "patronymic":
+		return domain.NameTypePatronymic
 	default:
 		// Return empty for unknown types (valid per NameType.IsValid)
 		return ""
@@ -1611,6 +1757,49 @@ func parseMediaObject(media *gedcom.MediaObject, _ *ImportResult) MediaData {
 	return mediaData
 }
 
+// parseInlineMedia converts inline OBJE structures (a level 1 OBJE without a
+// pointer, carrying its own FILE) found in a record's tags to MediaData. FORM,
+// TITL, and MEDI are accepted both beside FILE (GEDCOM 5.5) and under it
+// (GEDCOM 5.5.1).
+func parseInlineMedia(tags []*gedcom.Tag) []MediaData {
+	var result []MediaData
+	for i := 0; i < len(tags); i++ {
+		if tags[i].Level != 1 || tags[i].Tag != "OBJE" || tags[i].Value != "" {
+			continue
+		}
+		var file domain.MediaFile
+		for j := i + 1; j < len(tags) && tags[j].Level > 1; j++ {
+			switch tags[j].Tag {
+			case "FILE":
+				file.Path = tags[j].Value
+			case "FORM":
+				file.Format = tags[j].Value
+			case "TITL":
+				file.Title = tags[j].Value
+			case "MEDI", "TYPE":
+				file.MediaType = tags[j].Value
+			}
+		}
+		if file.Path == "" {
+			continue
+		}
+		m := MediaData{
+			ID:        uuid.New(),
+			Title:     file.Title,
+			FileRef:   file.Path,
+			MimeType:  file.Format,
+			Format:    file.Format,
+			MediaType: mapGedcomMediaType(file.MediaType),
+			Files:     []domain.MediaFile{file},
+		}
+		if m.Title == "" {
+			m.Title = path.Base(strings.ReplaceAll(file.Path, "\\", "/"))
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
 // mapGedcomMediaType converts GEDCOM MEDI values to domain MediaType.
 // GEDCOM 7.0 MEDI types: AUDIO, BOOK, CARD, ELECTRONIC, FICHE, FILM, MAGAZINE,
 // MANUSCRIPT, MAP, NEWSPAPER, PHOTO, TOMBSTONE, VIDEO
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cacack/my-family/internal/domain"
 	"github.com/cacack/my-family/internal/gedcom"
@@ -197,6 +198,43 @@ func TestImportMissingNames(t *testing.T) {
 	}
 }
 
+func TestImportSex(t *testing.T) {
+	gedcomData := `0 HEAD
+1 GEDC
+2 VERS 7.0
+0 @I1@ INDI
+1 NAME Ann /Lee/
+1 SEX F
+0 @I2@ INDI
+1 NAME Sam /Lee/
+1 SEX X
+0 @I3@ INDI
+1 NAME Pat /Lee/
+1 SEX U
+0 @I4@ INDI
+1 NAME Kim /Lee/
+0 TRLR
+`
+	importer := gedcom.NewImporter()
+	ctx := context.Background()
+
+	_, persons, _, _, _, _, _, _, _, _, _, _, _, err := importer.Import(ctx, strings.NewReader(gedcomData))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if len(persons) != 4 {
+		t.Fatalf("len(persons) = %d, want 4", len(persons))
+	}
+
+	// A missing SEX is left unrecorded, not defaulted
+	want := []domain.Gender{domain.GenderFemale, domain.GenderNonbinary, domain.GenderUnknown, ""}
+	for i, p := range persons {
+		if p.Gender != want[i] {
+			t.Errorf("%s Gender = %q, want %q", p.GivenName, p.Gender, want[i])
+		}
+	}
+}
+
 func TestImportSingleParentFamily(t *testing.T) {
 	gedcomData := `0 HEAD
 1 GEDC
@@ -339,6 +377,48 @@ func TestImportHistoricalCalendars(t *testing.T) {
 	}
 }
 
+func TestImportFrenchRepublicanDates(t *testing.T) {
+	gedcomData := `0 HEAD
+1 GEDC
+2 VERS 5.5
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME Jean /Dupont/
+1 BIRT
+2 DATE @#DFRENCH R@ 12 THER 2
+1 DEAT
+2 DATE BET @#DFRENCH R@ VEND 8 AND @#DFRENCH R@ COMP 8
+0 TRLR
+`
+	importer := gedcom.NewImporter()
+	ctx := context.Background()
+
+	_, persons, _, _, _, _, _, _, _, _, _, _, _, err := importer.Import(ctx, strings.NewReader(gedcomData))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if len(persons) != 1 {
+		t.Fatalf("len(persons) = %d, want 1", len(persons))
+	}
+
+	birth := domain.ParseGenDate(persons[0].BirthDate)
+	if birth.Calendar != domain.CalendarFrench {
+		t.Errorf("birth calendar = %q, want %q", birth.Calendar, domain.CalendarFrench)
+	}
+	// 12 Thermidor II is 30 July 1794
+	if got := birth.SortDate(); !got.Equal(time.Date(1794, 7, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("birth sort date = %v, want 1794-07-30", got)
+	}
+
+	death := domain.ParseGenDate(persons[0].DeathDate)
+	if death.Calendar != domain.CalendarFrench || death.Year2 == nil || *death.Year2 != 8 {
+		t.Errorf("death = %+v, want a French Republican range ending in year 8", death)
+	}
+	if err := death.Validate(); err != nil {
+		t.Errorf("death date should be valid: %v", err)
+	}
+}
+
 func TestValidateImportData(t *testing.T) {
 	// Empty data should fail
 	err := gedcom.ValidateImportData(nil, nil)
@@ -602,6 +682,34 @@ func TestImportNameComponents(t *testing.T) {
 	}
 }
 
+func TestImportNameTransliteration(t *testing.T) {
+	gedcomData := `0 HEAD
+1 GEDC
+2 VERS 7.0
+0 @I1@ INDI
+1 NAME Иван /Иванов/
+2 TRAN 伊万 /伊万诺夫/
+3 LANG zh-Hans
+2 TRAN Ivan /Ivanov/
+3 LANG ru-Latn
+1 SEX M
+0 TRLR
+`
+	importer := gedcom.NewImporter()
+	ctx := context.Background()
+
+	_, persons, _, _, _, _, _, _, _, _, _, _, _, err := importer.Import(ctx, strings.NewReader(gedcomData))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if len(persons) != 1 || len(persons[0].Names) != 1 {
+		t.Fatalf("persons = %+v, want one person with one name", persons)
+	}
+	if got := persons[0].Names[0].Romanized; got != "Ivan Ivanov" {
+		t.Errorf("Romanized = %q, want %q", got, "Ivan Ivanov")
+	}
+}
+
 func TestImportPedigreeTypes(t *testing.T) {
 	// Test GEDCOM with PEDI in FAMC links
 	gedcomData := `0 HEAD
@@ -1672,6 +1780,10 @@ func TestImportFamilySearchVendor(t *testing.T) {
 	if john.FamilySearchID != "KWCJ-QN7" {
 		t.Errorf("John.FamilySearchID = %q, want %q", john.FamilySearchID, "KWCJ-QN7")
 	}
+	// _FSFTID is also kept as a FamilySearch external identifier
+	if len(john.ExternalIDs) != 1 || john.ExternalIDs[0].Value != "KWCJ-QN7" || john.ExternalIDs[0].Type != domain.FamilySearchArkType {
+		t.Errorf("John.ExternalIDs = %+v, want the FamilySearch ID", john.ExternalIDs)
+	}
 
 	if jane == nil {
 		t.Fatal("Jane not found")
@@ -1686,6 +1798,9 @@ func TestImportFamilySearchVendor(t *testing.T) {
 	if child.FamilySearchID != "" {
 		t.Errorf("Child.FamilySearchID = %q, want empty string", child.FamilySearchID)
 	}
+	if len(child.ExternalIDs) != 0 {
+		t.Errorf("Child.ExternalIDs = %+v, want none", child.ExternalIDs)
+	}
 }
 
 func TestImportAncestryFamilyCitations(t *testing.T) {
@@ -2268,6 +2383,80 @@ func TestImportMediaObject(t *testing.T) {
 	}
 }
 
+func TestImportMediaLinks(t *testing.T) {
+	// OBJE pointers link a media object to each referencing record; inline
+	// OBJE structures become media objects of their own.
+	gedcomData := `0 HEAD
+1 GEDC
+2 VERS 5.5.1
+1 CHAR UTF-8
+0 @M1@ OBJE
+1 FILE /photos/portrait.jpg
+2 FORM jpg
+0 @I1@ INDI
+1 NAME John /Doe/
+1 OBJE @M1@
+2 TITL John aged 20
+1 OBJE
+2 FILE C:\Photos\grave.jpg
+3 FORM jpg
+4 MEDI PHOTO
+0 @S1@ SOUR
+1 TITL Parish register
+1 OBJE @M1@
+1 OBJE @M9@
+0 TRLR
+`
+	importer := gedcom.NewImporter()
+	ctx := context.Background()
+
+	result, _, _, _, _, _, _, _, _, _, _, _, media, err := importer.Import(ctx, strings.NewReader(gedcomData))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if len(media) != 2 {
+		t.Fatalf("len(media) = %d, want 2", len(media))
+	}
+
+	shared := media[0]
+	want := []gedcom.MediaLinkData{
+		{EntityType: "person", EntityID: result.PersonXrefToID["@I1@"], Title: "John aged 20"},
+		{EntityType: "source", EntityID: result.SourceXrefToID["@S1@"]},
+	}
+	if len(shared.Links) != len(want) {
+		t.Fatalf("Links = %v, want %v", shared.Links, want)
+	}
+	for i, l := range want {
+		if shared.Links[i] != l {
+			t.Errorf("Links[%d] = %v, want %v", i, shared.Links[i], l)
+		}
+	}
+
+	inline := media[1]
+	if inline.FileRef != `C:\Photos\grave.jpg` {
+		t.Errorf("inline FileRef = %q", inline.FileRef)
+	}
+	if inline.Title != "grave.jpg" {
+		t.Errorf("inline Title = %q, want grave.jpg", inline.Title)
+	}
+	if inline.MediaType != domain.MediaPhoto {
+		t.Errorf("inline MediaType = %s, want %s", inline.MediaType, domain.MediaPhoto)
+	}
+	if len(inline.Links) != 1 || inline.Links[0].EntityID != result.PersonXrefToID["@I1@"] {
+		t.Errorf("inline Links = %v, want John", inline.Links)
+	}
+
+	found := false
+	for _, w := range result.Warnings {
+		if strings.Contains(w, "Source @S1@: media object @M9@ not found") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected warning for unresolved media reference, got %v", result.Warnings)
+	}
+}
+
 func TestImportMediaObjectWithMultipleFiles(t *testing.T) {
 	// Test GEDCOM with OBJE that has multiple FILE records (GEDCOM 7.0 style)
 	// Note: MEDI is a child of FORM, not a sibling like TYPE
@@ -2913,6 +3102,95 @@ func TestImportExternalIDsAndSchema(t *testing.T) {
 	}
 }
 
+func TestImportNoteLinks(t *testing.T) {
+	// A shared note referenced from a person, family, and source is linked to
+	// each; the family's inline note becomes its own note.
+	gedcomData := `0 HEAD
+1 GEDC
+2 VERS 5.5.1
+0 @N1@ NOTE Shared research note
+1 CONT spanning two lines
+0 @I1@ INDI
+1 NAME John /Doe/
+1 NOTE Inline person note
+2 CONT with a continuation
+1 NOTE @N1@
+0 @I2@ INDI
+1 NAME Jane /Doe/
+1 NOTE @N1@
+0 @F1@ FAM
+1 HUSB @I1@
+1 WIFE @I2@
+1 NOTE @N1@
+1 NOTE Inline family note
+0 @S1@ SOUR
+1 TITL Parish register
+1 NOTE @N1@
+1 NOTE @N9@
+0 TRLR
+`
+
+	importer := gedcom.NewImporter()
+	ctx := context.Background()
+
+	result, persons, families, sources, _, _, _, _, notes, _, _, _, _, err := importer.Import(ctx, strings.NewReader(gedcomData))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	if len(notes) != 2 {
+		t.Fatalf("len(notes) = %d, want 2", len(notes))
+	}
+	if result.NotesImported != 2 {
+		t.Errorf("NotesImported = %d, want 2", result.NotesImported)
+	}
+
+	shared := notes[0]
+	if shared.Text != "Shared research note\nspanning two lines" {
+		t.Errorf("shared Text = %q", shared.Text)
+	}
+	want := []domain.NoteLink{
+		{EntityType: "person", EntityID: result.PersonXrefToID["@I1@"]},
+		{EntityType: "person", EntityID: result.PersonXrefToID["@I2@"]},
+		{EntityType: "family", EntityID: result.FamilyXrefToID["@F1@"]},
+		{EntityType: "source", EntityID: result.SourceXrefToID["@S1@"]},
+	}
+	if len(shared.Links) != len(want) {
+		t.Fatalf("shared Links = %v, want %v", shared.Links, want)
+	}
+	for i, l := range want {
+		if shared.Links[i] != l {
+			t.Errorf("shared Links[%d] = %v, want %v", i, shared.Links[i], l)
+		}
+	}
+
+	familyNote := notes[1]
+	if familyNote.Text != "Inline family note" {
+		t.Errorf("family note Text = %q", familyNote.Text)
+	}
+	if len(familyNote.Links) != 1 || familyNote.Links[0].EntityID != families[0].ID {
+		t.Errorf("family note Links = %v, want family %s", familyNote.Links, families[0].ID)
+	}
+
+	// Inline notes keep their continuation lines and no longer carry xrefs.
+	if persons[0].Notes != "Inline person note\nwith a continuation" {
+		t.Errorf("person Notes = %q", persons[0].Notes)
+	}
+	if sources[0].Notes != "" {
+		t.Errorf("source Notes = %q, want empty", sources[0].Notes)
+	}
+
+	found := false
+	for _, w := range result.Warnings {
+		if strings.Contains(w, "Source @S1@: note @N9@ not found") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected warning for unresolved note reference, got %v", result.Warnings)
+	}
+}
+
 func TestImportSharedNote(t *testing.T) {
 	// GEDCOM 7.0 shared note (SNOTE) with MIME, language, and a translation.
 	gedcomData := `0 HEAD
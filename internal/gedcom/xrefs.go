@@ -0,0 +1,82 @@
+package gedcom
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/cacack/gedcom-go/v2/gedcom"
+)
+
+// pointerTargets names what each pointer tag refers to and the record types it
+// may point at. Pointers under other tags (e.g. vendor extensions) are only
+// checked for existence.
+var pointerTargets = map[string]struct {
+	role  string
+	types []gedcom.RecordType
+}{
+	"HUSB":  {"husband", []gedcom.RecordType{gedcom.RecordTypeIndividual}},
+	"WIFE":  {"wife", []gedcom.RecordType{gedcom.RecordTypeIndividual}},
+	"CHIL":  {"child", []gedcom.RecordType{gedcom.RecordTypeIndividual}},
+	"ASSO":  {"associated individual", []gedcom.RecordType{gedcom.RecordTypeIndividual}},
+	"ALIA":  {"alias", []gedcom.RecordType{gedcom.RecordTypeIndividual}},
+	"FAMC":  {"parents' family", []gedcom.RecordType{gedcom.RecordTypeFamily}},
+	"FAMS":  {"spouse family", []gedcom.RecordType{gedcom.RecordTypeFamily}},
+	"SOUR":  {"source", []gedcom.RecordType{gedcom.RecordTypeSource}},
+	"REPO":  {"repository", []gedcom.RecordType{gedcom.RecordTypeRepository}},
+	"OBJE":  {"media object", []gedcom.RecordType{gedcom.RecordTypeMedia}},
+	"NOTE":  {"note", []gedcom.RecordType{gedcom.RecordTypeNote, gedcom.RecordTypeSharedNote}},
+	"SNOTE": {"note", []gedcom.RecordType{gedcom.RecordTypeNote, gedcom.RecordTypeSharedNote}},
+	"SUBM":  {"submitter", []gedcom.RecordType{gedcom.RecordTypeSubmitter}},
+}
+
+// recordKinds names record types in import messages.
+var recordKinds = map[gedcom.RecordType]string{
+	gedcom.RecordTypeIndividual: "Individual",
+	gedcom.RecordTypeFamily:     "Family",
+	gedcom.RecordTypeSource:     "Source",
+	gedcom.RecordTypeRepository: "Repository",
+	gedcom.RecordTypeNote:       "Note",
+	gedcom.RecordTypeSharedNote: "Note",
+	gedcom.RecordTypeMedia:      "Media",
+	gedcom.RecordTypeSubmitter:  "Submitter",
+}
+
+// reportDanglingXRefs reports every pointer in doc that does not resolve to a
+// record of the kind its tag expects, e.g. a CHIL naming an individual missing
+// from the file or a FAMC naming a source. Such pointers are never linked, so
+// this is the only trace of them left after import.
+func reportDanglingXRefs(doc *gedcom.Document, result *ImportResult) {
+	for _, record := range doc.Records {
+		kind, ok := recordKinds[record.Type]
+		if !ok {
+			kind = string(record.Type)
+		}
+		for _, tag := range record.Tags {
+			if !isPointer(tag.Value) {
+				continue
+			}
+			target, known := pointerTargets[tag.Tag]
+			role := target.role
+			if !known {
+				role = tag.Tag
+			}
+
+			found := doc.XRefMap[tag.Value]
+			switch {
+			case found == nil:
+				result.addIssueAt(ImportIssueError, IssueBrokenXRef, record.XRef, tag.LineNumber,
+					"%s %s: %s %s not found", kind, record.XRef, role, tag.Value)
+			case known && !slices.Contains(target.types, found.Type):
+				result.addIssueAt(ImportIssueError, IssueBrokenXRef, record.XRef, tag.LineNumber,
+					"%s %s: %s %s is a %s record", kind, record.XRef, role, tag.Value, found.Type)
+			}
+		}
+	}
+}
+
+// isPointer reports whether a tag value is a cross-reference pointer. The
+// GEDCOM 7 null pointer @VOID@ and @#...@ escapes are not.
+func isPointer(value string) bool {
+	return len(value) > 2 && value[0] == '@' && value[len(value)-1] == '@' &&
+		value != "@VOID@" && !strings.HasPrefix(value, "@#") && !strings.Contains(value, " ")
+}
@@ -0,0 +1,544 @@
+// Package graphql executes GraphQL queries against a schema of Go resolvers.
+// It implements the query subset of the specification: parsing, validation,
+// variables, fragments, @skip and @include, and null propagation. Mutations,
+// subscriptions, interfaces, input objects, and introspection are not
+// supported.
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Request is a GraphQL request as posted by clients.
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Response is the result of executing a request. Data is omitted when the
+// request failed before execution began, e.g. because it did not parse.
+type Response struct {
+	Errors []*Error
+	Data   any
+
+	executed bool
+}
+
+// MarshalJSON encodes the response with errors first, as the specification
+// recommends, and data only if execution started.
+func (r *Response) MarshalJSON() ([]byte, error) {
+	if !r.executed {
+		return json.Marshal(struct {
+			Errors []*Error `json:"errors"`
+		}{r.Errors})
+	}
+	return json.Marshal(struct {
+		Errors []*Error `json:"errors,omitempty"`
+		Data   any      `json:"data"`
+	}{r.Errors, r.Data})
+}
+
+// Error is a GraphQL error with the query locations and, for field errors,
+// the response path it relates to.
+type Error struct {
+	Message   string     `json:"message"`
+	Locations []Location `json:"locations,omitempty"`
+	Path      []any      `json:"path,omitempty"`
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// Location is a 1-based line and column in the query text.
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// Execute parses, validates, and runs a query request. Field errors are
+// reported in the response alongside whatever data could be resolved.
+func (s *Schema) Execute(ctx context.Context, req Request) *Response {
+	doc, perr := parse(req.Query)
+	if perr != nil {
+		return &Response{Errors: []*Error{perr}}
+	}
+	if errs := s.validate(doc); len(errs) > 0 {
+		return &Response{Errors: errs}
+	}
+
+	op, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return &Response{Errors: []*Error{err}}
+	}
+	vars, errs := s.coerceVariables(op, req.Variables)
+	if len(errs) > 0 {
+		return &Response{Errors: errs}
+	}
+
+	e := &executor{ctx: ctx, doc: doc, vars: vars}
+	data, ok := e.executeSelectionSet(s.Query, nil, op.selections, nil)
+	resp := &Response{Errors: e.errs, executed: true}
+	if ok {
+		resp.Data = data
+	}
+	return resp
+}
+
+func selectOperation(doc *document, name string) (*operation, *Error) {
+	if name == "" {
+		if len(doc.operations) != 1 {
+			return nil, &Error{Message: "Must provide operation name if query contains multiple operations."}
+		}
+		return doc.operations[0], nil
+	}
+	for _, op := range doc.operations {
+		if op.name == name {
+			return op, nil
+		}
+	}
+	return nil, &Error{Message: fmt.Sprintf("Unknown operation named %q.", name)}
+}
+
+// coerceVariables checks the request's variable values against the
+// operation's definitions, applying defaults. Variables that were neither
+// given nor defaulted are left out of the result.
+func (s *Schema) coerceVariables(op *operation, given map[string]any) (map[string]any, []*Error) {
+	vars := map[string]any{}
+	var errs []*Error
+	for _, def := range op.vars {
+		t, err := s.inputType(def.typ)
+		if err != nil {
+			return nil, []*Error{{Message: err.Error(), Locations: []Location{def.loc}}}
+		}
+		raw, ok := given[def.name]
+		switch {
+		case !ok && def.def != nil:
+			vars[def.name], _ = coerceLiteral(t, def.def, nil)
+		case !ok:
+			if _, required := t.(*NonNull); required {
+				errs = append(errs, &Error{
+					Message:   fmt.Sprintf("Variable \"$%s\" of required type %q was not provided.", def.name, t),
+					Locations: []Location{def.loc},
+				})
+			}
+		default:
+			v, err := coerceInput(t, raw)
+			if err != nil {
+				errs = append(errs, &Error{
+					Message:   fmt.Sprintf("Variable \"$%s\" got invalid value: %s.", def.name, err),
+					Locations: []Location{def.loc},
+				})
+				continue
+			}
+			vars[def.name] = v
+		}
+	}
+	return vars, errs
+}
+
+// coerceInput coerces a JSON-decoded value to an input type.
+func coerceInput(t Type, v any) (any, error) {
+	if nn, ok := t.(*NonNull); ok {
+		if v == nil {
+			return nil, fmt.Errorf("expected non-null %s", t)
+		}
+		return coerceInput(nn.OfType, v)
+	}
+	if v == nil {
+		return nil, nil
+	}
+	switch t := t.(type) {
+	case *List:
+		items, ok := v.([]any)
+		if !ok {
+			item, err := coerceInput(t.OfType, v)
+			if err != nil {
+				return nil, err
+			}
+			return []any{item}, nil
+		}
+		out := make([]any, len(items))
+		for i, item := range items {
+			c, err := coerceInput(t.OfType, item)
+			if err != nil {
+				return nil, fmt.Errorf("at index %d: %w", i, err)
+			}
+			out[i] = c
+		}
+		return out, nil
+	case *Scalar:
+		return t.ParseValue(v)
+	}
+	return nil, fmt.Errorf("%s is not an input type", t)
+}
+
+// errMissingVariable marks a reference to a variable that has no value.
+var errMissingVariable = errors.New("missing variable")
+
+// coerceLiteral coerces a literal from the query text to an input type.
+// Variables are looked up in vars; a nil vars map means variables are not
+// known yet, during validation, and they are accepted as they are.
+func coerceLiteral(t Type, val value, vars map[string]any) (any, error) {
+	if ref, ok := val.(variableValue); ok {
+		if vars == nil {
+			return nil, nil
+		}
+		v, ok := vars[ref.name]
+		if !ok {
+			return nil, errMissingVariable
+		}
+		return coerceInput(t, v)
+	}
+
+	if nn, ok := t.(*NonNull); ok {
+		if _, isNull := val.(nullValue); isNull {
+			return nil, fmt.Errorf("expected non-null %s, got null", t)
+		}
+		v, err := coerceLiteral(nn.OfType, val, vars)
+		if errors.Is(err, errMissingVariable) {
+			return nil, fmt.Errorf("expected non-null %s", t)
+		}
+		return v, err
+	}
+	if _, isNull := val.(nullValue); isNull {
+		return nil, nil
+	}
+
+	switch t := t.(type) {
+	case *List:
+		list, ok := val.(listValue)
+		if !ok {
+			item, err := coerceLiteral(t.OfType, val, vars)
+			if err != nil {
+				return nil, err
+			}
+			return []any{item}, nil
+		}
+		out := make([]any, len(list.items))
+		for i, item := range list.items {
+			c, err := coerceLiteral(t.OfType, item, vars)
+			if errors.Is(err, errMissingVariable) {
+				c, err = nil, nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("at index %d: %w", i, err)
+			}
+			out[i] = c
+		}
+		return out, nil
+	case *Scalar:
+		var raw any
+		switch val := val.(type) {
+		case intValue:
+			n, err := strconv.ParseInt(val.raw, 10, 64)
+			if err != nil || n < math.MinInt32 || n > math.MaxInt32 {
+				if t == Float {
+					f, _ := strconv.ParseFloat(val.raw, 64)
+					return f, nil
+				}
+				return nil, fmt.Errorf("integer %s is out of the 32-bit range", val.raw)
+			}
+			raw = int(n)
+		case floatValue:
+			f, err := strconv.ParseFloat(val.raw, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid float %s", val.raw)
+			}
+			if t != Float {
+				return nil, fmt.Errorf("expected %s, got %s", t, val.raw)
+			}
+			raw = f
+		case stringValue:
+			raw = val.value
+		case booleanValue:
+			raw = val.value
+		case enumValue:
+			return nil, fmt.Errorf("expected %s, got %s", t, val.name)
+		default:
+			return nil, fmt.Errorf("expected %s, got an object or list", t)
+		}
+		return t.ParseValue(raw)
+	}
+	return nil, fmt.Errorf("%s is not an input type", t)
+}
+
+// executor runs one operation, accumulating field errors.
+type executor struct {
+	ctx  context.Context
+	doc  *document
+	vars map[string]any
+	errs []*Error
+}
+
+func (e *executor) fieldError(msg string, f *field, path []any) {
+	e.errs = append(e.errs, &Error{Message: msg, Locations: []Location{f.loc}, Path: path})
+}
+
+// fieldGroup is the fields of a selection set that share a response key.
+type fieldGroup struct {
+	key    string
+	fields []*field
+}
+
+// collectFields groups the fields of a selection set that apply to obj by
+// response key, in query order, honoring @skip and @include.
+func (e *executor) collectFields(obj *Object, sels []selection, groups []*fieldGroup, visited map[string]bool) []*fieldGroup {
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *field:
+			if !e.included(sel.directives) {
+				continue
+			}
+			key := sel.responseKey()
+			found := false
+			for _, g := range groups {
+				if g.key == key {
+					g.fields = append(g.fields, sel)
+					found = true
+					break
+				}
+			}
+			if !found {
+				groups = append(groups, &fieldGroup{key: key, fields: []*field{sel}})
+			}
+		case *inlineFragment:
+			if !e.included(sel.directives) || (sel.typeCond != "" && sel.typeCond != obj.Name) {
+				continue
+			}
+			groups = e.collectFields(obj, sel.selections, groups, visited)
+		case *fragmentSpread:
+			if visited[sel.name] || !e.included(sel.directives) {
+				continue
+			}
+			visited[sel.name] = true
+			frag := e.doc.fragments[sel.name]
+			if frag.typeCond != obj.Name || !e.included(frag.directives) {
+				continue
+			}
+			groups = e.collectFields(obj, frag.selections, groups, visited)
+		}
+	}
+	return groups
+}
+
+func (e *executor) included(dirs []*directive) bool {
+	for _, d := range dirs {
+		if len(d.args) == 0 {
+			continue
+		}
+		cond, err := coerceLiteral(ifArgument[0].Type, d.args[0].value, e.vars)
+		if err != nil {
+			continue
+		}
+		if d.name == "skip" && cond == true {
+			return false
+		}
+		if d.name == "include" && cond == false {
+			return false
+		}
+	}
+	return true
+}
+
+// executeSelectionSet resolves a selection set on source. It returns false
+// if a non-null field resolved to null, in which case the whole object is
+// null.
+func (e *executor) executeSelectionSet(obj *Object, source any, sels []selection, path []any) (*orderedMap, bool) {
+	groups := e.collectFields(obj, sels, nil, map[string]bool{})
+	out := &orderedMap{}
+	for _, g := range groups {
+		f := g.fields[0]
+		fieldPath := append(slicesClone(path), g.key)
+		if f.name == "__typename" {
+			out.set(g.key, obj.Name)
+			continue
+		}
+
+		def := obj.fields[f.name]
+		v, ok := e.executeField(def, source, g.fields, fieldPath)
+		if !ok {
+			if _, required := def.Type.(*NonNull); required {
+				return nil, false
+			}
+			v = nil
+		}
+		out.set(g.key, v)
+	}
+	return out, true
+}
+
+// executeField resolves one field and completes its value. It returns false
+// if the value is null because of an error that has already been reported.
+func (e *executor) executeField(def *Field, source any, fields []*field, path []any) (any, bool) {
+	f := fields[0]
+	args, msg := e.coerceArguments(def, f)
+	if msg != "" {
+		e.fieldError(msg, f, path)
+		return nil, false
+	}
+
+	resolved, err := e.resolve(def, source, args)
+	if err != nil {
+		e.fieldError(err.Error(), f, path)
+		return nil, false
+	}
+
+	var sels []selection
+	for _, same := range fields {
+		sels = append(sels, same.selections...)
+	}
+	return e.completeValue(def.Type, resolved, f, sels, path)
+}
+
+// resolve calls the field's resolver, turning a panic into a field error so
+// that one faulty resolver cannot bring down the request. The panic itself is
+// logged rather than returned, since it may reveal server internals.
+func (e *executor) resolve(def *Field, source any, args map[string]any) (v any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.ErrorContext(e.ctx, "graphql: resolver panicked", "field", def.Name, "panic", r)
+			err = fmt.Errorf("internal error resolving %s", def.Name)
+		}
+	}()
+	return def.Resolve(e.ctx, source, args)
+}
+
+// coerceArguments returns the field's argument values, or a message
+// describing why they are invalid.
+func (e *executor) coerceArguments(def *Field, f *field) (map[string]any, string) {
+	args := map[string]any{}
+	for _, argDef := range def.Args {
+		var lit value
+		for _, a := range f.args {
+			if a.name == argDef.Name {
+				lit = a.value
+			}
+		}
+		if lit == nil {
+			if argDef.Default != nil {
+				args[argDef.Name] = argDef.Default
+			}
+			continue
+		}
+		v, err := coerceLiteral(argDef.Type, lit, e.vars)
+		if errors.Is(err, errMissingVariable) {
+			if argDef.Default != nil {
+				args[argDef.Name] = argDef.Default
+			} else if _, required := argDef.Type.(*NonNull); required {
+				return nil, fmt.Sprintf("Argument %q of required type %q was not provided.", argDef.Name, argDef.Type)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Sprintf("Argument %q has an invalid value: %s.", argDef.Name, err)
+		}
+		args[argDef.Name] = v
+	}
+	return args, ""
+}
+
+// completeValue shapes a resolved value to the field's type: it serializes
+// scalars, resolves sub-selections of objects, and enforces non-null. It
+// returns false if the value is null because of a reported error.
+func (e *executor) completeValue(t Type, v any, f *field, sels []selection, path []any) (any, bool) {
+	if nn, ok := t.(*NonNull); ok {
+		out, ok := e.completeValue(nn.OfType, v, f, sels, path)
+		if !ok {
+			return nil, false
+		}
+		if out == nil {
+			e.fieldError(fmt.Sprintf("Cannot return null for non-nullable field %q.", f.name), f, path)
+			return nil, false
+		}
+		return out, true
+	}
+
+	rv := reflect.ValueOf(v)
+	if v == nil || (rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Map || rv.Kind() == reflect.Interface) && rv.IsNil() {
+		return nil, true
+	}
+
+	switch t := t.(type) {
+	case *List:
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			e.fieldError(fmt.Sprintf("Expected a list for field %q, got %T.", f.name, v), f, path)
+			return nil, false
+		}
+		out := make([]any, rv.Len())
+		for i := range out {
+			item, ok := e.completeValue(t.OfType, rv.Index(i).Interface(), f, sels, append(slicesClone(path), i))
+			if !ok {
+				if _, required := t.OfType.(*NonNull); required {
+					return nil, false
+				}
+			}
+			out[i] = item
+		}
+		return out, true
+	case *Object:
+		return e.executeSelectionSet(t, v, sels, path)
+	case *Scalar:
+		if _, isStringer := v.(fmt.Stringer); rv.Kind() == reflect.Pointer && !isStringer {
+			v = rv.Elem().Interface()
+		}
+		out, err := t.Serialize(v)
+		if err != nil {
+			e.fieldError(err.Error(), f, path)
+			return nil, false
+		}
+		return out, true
+	}
+	return nil, true
+}
+
+func slicesClone(path []any) []any {
+	return append(make([]any, 0, len(path)+1), path...)
+}
+
+// orderedMap is a JSON object that keeps its keys in query order, as GraphQL
+// responses must.
+type orderedMap struct {
+	keys   []string
+	values map[string]any
+}
+
+func (m *orderedMap) set(key string, v any) {
+	if m.values == nil {
+		m.values = map[string]any{}
+	}
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = v
+}
+
+func (m *orderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,259 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cacack/my-family/internal/graphql"
+)
+
+type character struct {
+	ID       string
+	Name     string
+	Nickname *string
+	Friends  []string
+}
+
+var characters = map[string]*character{
+	"1": {ID: "1", Name: "Ada", Friends: []string{"2", "3"}},
+	"2": {ID: "2", Name: "Grace", Nickname: ptr("Amazing Grace"), Friends: []string{"1"}},
+	"3": {ID: "3", Name: "Linus"},
+}
+
+func ptr(s string) *string { return &s }
+
+func testSchema(t *testing.T) *graphql.Schema {
+	t.Helper()
+	char := &graphql.Object{Name: "Character", Description: "A test character."}
+	char.Fields = []*graphql.Field{
+		{Name: "id", Type: graphql.NonNullOf(graphql.ID), Resolve: func(_ context.Context, src any, _ map[string]any) (any, error) {
+			return src.(*character).ID, nil
+		}},
+		{Name: "name", Type: graphql.NonNullOf(graphql.String), Resolve: func(_ context.Context, src any, _ map[string]any) (any, error) {
+			return src.(*character).Name, nil
+		}},
+		{Name: "nickname", Type: graphql.String, Resolve: func(_ context.Context, src any, _ map[string]any) (any, error) {
+			return src.(*character).Nickname, nil
+		}},
+		{Name: "friends", Type: graphql.NonNullOf(graphql.ListOf(graphql.NonNullOf(char))), Resolve: func(_ context.Context, src any, _ map[string]any) (any, error) {
+			var out []*character
+			for _, id := range src.(*character).Friends {
+				out = append(out, characters[id])
+			}
+			return out, nil
+		}},
+		{Name: "missing", Type: graphql.NonNullOf(graphql.String), Resolve: func(context.Context, any, map[string]any) (any, error) {
+			return nil, nil
+		}},
+		{Name: "failing", Type: graphql.String, Resolve: func(context.Context, any, map[string]any) (any, error) {
+			return nil, errors.New("lookup failed")
+		}},
+		{Name: "broken", Type: graphql.String, Resolve: func(context.Context, any, map[string]any) (any, error) {
+			var friends []string
+			return friends[5], nil
+		}},
+	}
+	query := &graphql.Object{Name: "Query", Fields: []*graphql.Field{
+		{
+			Name: "character",
+			Type: char,
+			Args: []*graphql.Argument{{Name: "id", Type: graphql.NonNullOf(graphql.ID)}},
+			Resolve: func(_ context.Context, _ any, args map[string]any) (any, error) {
+				if c, ok := characters[args["id"].(string)]; ok {
+					return c, nil
+				}
+				return nil, nil
+			},
+		},
+		{
+			Name: "count",
+			Type: graphql.NonNullOf(graphql.Int),
+			Args: []*graphql.Argument{{Name: "plus", Type: graphql.Int, Default: 0}},
+			Resolve: func(_ context.Context, _ any, args map[string]any) (any, error) {
+				return len(characters) + args["plus"].(int), nil
+			},
+		},
+	}}
+	schema, err := graphql.NewSchema(query)
+	if err != nil {
+		t.Fatalf("NewSchema failed: %v", err)
+	}
+	return schema
+}
+
+// run executes query and returns the JSON-encoded response.
+func run(t *testing.T, schema *graphql.Schema, req graphql.Request) string {
+	t.Helper()
+	out, err := json.Marshal(schema.Execute(context.Background(), req))
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+	return string(out)
+}
+
+func TestExecute_NestedSelection(t *testing.T) {
+	schema := testSchema(t)
+	got := run(t, schema, graphql.Request{Query: `
+		query Friends {
+			ada: character(id: "1") {
+				__typename
+				name
+				friends { ...Basic }
+			}
+			count
+		}
+		fragment Basic on Character { id name nickname }
+	`})
+
+	want := `{"data":{"ada":{"__typename":"Character","name":"Ada","friends":[` +
+		`{"id":"2","name":"Grace","nickname":"Amazing Grace"},` +
+		`{"id":"3","name":"Linus","nickname":null}]},"count":3}}`
+	if got != want {
+		t.Errorf("response =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExecute_VariablesAndDirectives(t *testing.T) {
+	schema := testSchema(t)
+	got := run(t, schema, graphql.Request{
+		Query: `query($id: ID!, $plus: Int = 2, $brief: Boolean!) {
+			character(id: $id) { name friends @skip(if: $brief) { name } }
+			count(plus: $plus)
+		}`,
+		Variables: map[string]any{"id": "2", "brief": true},
+	})
+
+	want := `{"data":{"character":{"name":"Grace"},"count":5}}`
+	if got != want {
+		t.Errorf("response = %s, want %s", got, want)
+	}
+}
+
+func TestExecute_NullPropagation(t *testing.T) {
+	schema := testSchema(t)
+	got := run(t, schema, graphql.Request{Query: `{ character(id: "1") { name missing } count }`})
+
+	want := `{"errors":[{"message":"Cannot return null for non-nullable field \"missing\".",` +
+		`"locations":[{"line":1,"column":29}],"path":["character","missing"]}],` +
+		`"data":{"character":null,"count":3}}`
+	if got != want {
+		t.Errorf("response =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExecute_ResolverError(t *testing.T) {
+	schema := testSchema(t)
+	got := run(t, schema, graphql.Request{Query: `{ character(id: "3") { name failing } }`})
+
+	want := `{"errors":[{"message":"lookup failed","locations":[{"line":1,"column":29}],` +
+		`"path":["character","failing"]}],"data":{"character":{"name":"Linus","failing":null}}}`
+	if got != want {
+		t.Errorf("response =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExecute_ResolverPanic(t *testing.T) {
+	schema := testSchema(t)
+	got := run(t, schema, graphql.Request{Query: `{ character(id: "3") { name broken } }`})
+
+	want := `{"errors":[{"message":"internal error resolving broken","locations":[{"line":1,"column":29}],` +
+		`"path":["character","broken"]}],"data":{"character":{"name":"Linus","broken":null}}}`
+	if got != want {
+		t.Errorf("response =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExecute_RequestErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   graphql.Request
+		error string
+	}{
+		{"syntax", graphql.Request{Query: `{ character(id: "1") { name }`}, "Syntax Error: expected name, found end of query"},
+		{"unknown field", graphql.Request{Query: `{ character(id: "1") { age } }`}, `Cannot query field "age" on type "Character".`},
+		{"missing argument", graphql.Request{Query: `{ character { name } }`}, `Field "character" argument "id" of type "ID!" is required, but it was not provided.`},
+		{"wrong argument type", graphql.Request{Query: `{ count(plus: "x") }`}, `Argument "plus" has an invalid value: expected a 32-bit integer, got "x".`},
+		{"missing subselection", graphql.Request{Query: `{ character(id: "1") }`}, `Field "character" of type "Character" must have a selection of subfields.`},
+		{"scalar subselection", graphql.Request{Query: `{ count { x } }`}, `Field "count" must not have a selection since type "Int!" has no subfields.`},
+		{"mutation", graphql.Request{Query: `mutation { count }`}, "Only query operations are supported, not mutation."},
+		{"fragment cycle", graphql.Request{Query: `{ character(id: "1") { ...A } } fragment A on Character { friends { ...A } }`}, `Cannot spread fragment "A" within itself.`},
+		{"undefined variable", graphql.Request{Query: `{ character(id: $id) { name } }`}, `Variable "$id" is not defined by operation "".`},
+		{"missing variable", graphql.Request{Query: `query($id: ID!) { character(id: $id) { name } }`}, `Variable "$id" of required type "ID!" was not provided.`},
+		{"conflicting aliases", graphql.Request{Query: `{ character(id: "1") { x: name x: id } }`}, `Fields "x" conflict because "name" and "id" are different fields. Use different aliases on the fields to fetch both if this was intentional.`},
+	}
+
+	schema := testSchema(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := schema.Execute(context.Background(), tt.req)
+			if len(resp.Errors) == 0 || resp.Errors[0].Message != tt.error {
+				t.Fatalf("errors = %v, want %q", resp.Errors, tt.error)
+			}
+			out, _ := json.Marshal(resp)
+			if strings.Contains(string(out), `"data"`) {
+				t.Errorf("response %s should not contain data", out)
+			}
+		})
+	}
+}
+
+func TestExecute_MaxDepth(t *testing.T) {
+	schema := testSchema(t)
+	schema.MaxDepth = 3
+
+	resp := schema.Execute(context.Background(), graphql.Request{Query: `{ character(id: "1") { friends { friends { name } } } }`})
+	if len(resp.Errors) != 1 || resp.Errors[0].Message != "Query is nested 4 levels deep, more than the maximum of 3." {
+		t.Errorf("errors = %v, want depth error", resp.Errors)
+	}
+
+	resp = schema.Execute(context.Background(), graphql.Request{Query: `{ character(id: "1") { friends { name } } }`})
+	if len(resp.Errors) != 0 {
+		t.Errorf("errors = %v, want none", resp.Errors)
+	}
+}
+
+func TestExecute_MaxFields(t *testing.T) {
+	schema := testSchema(t)
+	schema.MaxFields = 5
+
+	tests := []struct {
+		name  string
+		query string
+		ok    bool
+	}{
+		{"within limit", `{ character(id: "1") { name friends { name id } } }`, true},
+		{"aliases", `{ a: count b: count c: count d: count e: count f: count }`, false},
+		{"fragment spreads", `{ character(id: "1") { ...F friends { ...F } } } fragment F on Character { id name }`, false},
+		{"inline fragments", `{ character(id: "1") { ... on Character { id name nickname friends { id name } } } }`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := schema.Execute(context.Background(), graphql.Request{Query: tt.query})
+			if tt.ok {
+				if len(resp.Errors) != 0 {
+					t.Errorf("errors = %v, want none", resp.Errors)
+				}
+				return
+			}
+			if len(resp.Errors) != 1 || resp.Errors[0].Message != "Query selects more than the maximum of 5 fields." {
+				t.Errorf("errors = %v, want field limit error", resp.Errors)
+			}
+		})
+	}
+}
+
+func TestSchema_String(t *testing.T) {
+	sdl := testSchema(t).String()
+	for _, want := range []string{
+		"type Query {\n  character(id: ID!): Character\n  count(plus: Int = 0): Int!\n}\n",
+		"\"A test character.\"\ntype Character {\n",
+		"  friends: [Character!]!\n",
+	} {
+		if !strings.Contains(sdl, want) {
+			t.Errorf("schema SDL missing %q:\n%s", want, sdl)
+		}
+	}
+}
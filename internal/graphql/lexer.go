@@ -0,0 +1,290 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunct
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of query"
+	case tokenPunct:
+		return "punctuator"
+	case tokenName:
+		return "name"
+	case tokenInt:
+		return "integer"
+	case tokenFloat:
+		return "float"
+	default:
+		return "string"
+	}
+}
+
+// token is a lexical token. For strings, value holds the decoded text.
+type token struct {
+	kind  tokenKind
+	value string
+	loc   Location
+}
+
+// lexer splits a GraphQL document into tokens, skipping whitespace, commas,
+// and comments.
+type lexer struct {
+	src  string
+	pos  int
+	line int
+	col  int
+}
+
+func newLexer(src string) *lexer {
+	src = strings.TrimPrefix(src, "\uFEFF")
+	return &lexer{src: src, line: 1, col: 1}
+}
+
+func (l *lexer) errorf(loc Location, format string, args ...any) *Error {
+	return &Error{Message: "Syntax Error: " + fmt.Sprintf(format, args...), Locations: []Location{loc}}
+}
+
+// advance moves past n bytes on the current line.
+func (l *lexer) advance(n int) {
+	l.pos += n
+	l.col += n
+}
+
+func (l *lexer) skipIgnored() {
+	for l.pos < len(l.src) {
+		switch c := l.src[l.pos]; c {
+		case ' ', '\t', ',':
+			l.advance(1)
+		case '\n':
+			l.pos++
+			l.line++
+			l.col = 1
+		case '\r':
+			l.pos++
+			if l.pos < len(l.src) && l.src[l.pos] == '\n' {
+				l.pos++
+			}
+			l.line++
+			l.col = 1
+		case '#':
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' && l.src[l.pos] != '\r' {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) next() (token, *Error) {
+	l.skipIgnored()
+	loc := Location{Line: l.line, Column: l.col}
+	if l.pos >= len(l.src) {
+		return token{kind: tokenEOF, loc: loc}, nil
+	}
+
+	c := l.src[l.pos]
+	switch {
+	case strings.IndexByte("!$&()[]{}:=@|", c) >= 0:
+		l.advance(1)
+		return token{kind: tokenPunct, value: string(c), loc: loc}, nil
+	case c == '.':
+		if strings.HasPrefix(l.src[l.pos:], "...") {
+			l.advance(3)
+			return token{kind: tokenPunct, value: "...", loc: loc}, nil
+		}
+		return token{}, l.errorf(loc, "unexpected \".\"")
+	case c == '_' || isLetter(c):
+		start := l.pos
+		for l.pos < len(l.src) && (l.src[l.pos] == '_' || isLetter(l.src[l.pos]) || isDigit(l.src[l.pos])) {
+			l.pos++
+		}
+		l.col += l.pos - start
+		return token{kind: tokenName, value: l.src[start:l.pos], loc: loc}, nil
+	case c == '-' || isDigit(c):
+		return l.number(loc)
+	case c == '"':
+		if strings.HasPrefix(l.src[l.pos:], `"""`) {
+			return l.blockString(loc)
+		}
+		return l.string(loc)
+	default:
+		r, _ := utf8.DecodeRuneInString(l.src[l.pos:])
+		return token{}, l.errorf(loc, "unexpected character %q", r)
+	}
+}
+
+func (l *lexer) number(loc Location) (token, *Error) {
+	start := l.pos
+	kind := tokenInt
+	if l.src[l.pos] == '-' {
+		l.pos++
+	}
+	digits := func() bool {
+		begin := l.pos
+		for l.pos < len(l.src) && isDigit(l.src[l.pos]) {
+			l.pos++
+		}
+		return l.pos > begin
+	}
+	if !digits() {
+		return token{}, l.errorf(loc, "invalid number")
+	}
+	if l.pos < len(l.src) && l.src[l.pos] == '.' {
+		kind = tokenFloat
+		l.pos++
+		if !digits() {
+			return token{}, l.errorf(loc, "invalid number")
+		}
+	}
+	if l.pos < len(l.src) && (l.src[l.pos] == 'e' || l.src[l.pos] == 'E') {
+		kind = tokenFloat
+		l.pos++
+		if l.pos < len(l.src) && (l.src[l.pos] == '+' || l.src[l.pos] == '-') {
+			l.pos++
+		}
+		if !digits() {
+			return token{}, l.errorf(loc, "invalid number")
+		}
+	}
+	if l.pos < len(l.src) && (l.src[l.pos] == '_' || l.src[l.pos] == '.' || isLetter(l.src[l.pos])) {
+		return token{}, l.errorf(loc, "invalid number")
+	}
+	l.col += l.pos - start
+	return token{kind: kind, value: l.src[start:l.pos], loc: loc}, nil
+}
+
+func (l *lexer) string(loc Location) (token, *Error) {
+	l.advance(1)
+	var sb strings.Builder
+	for {
+		if l.pos >= len(l.src) || l.src[l.pos] == '\n' || l.src[l.pos] == '\r' {
+			return token{}, l.errorf(loc, "unterminated string")
+		}
+		c := l.src[l.pos]
+		switch c {
+		case '"':
+			l.advance(1)
+			return token{kind: tokenString, value: sb.String(), loc: loc}, nil
+		case '\\':
+			if l.pos+1 >= len(l.src) {
+				return token{}, l.errorf(loc, "unterminated string")
+			}
+			esc := l.src[l.pos+1]
+			switch esc {
+			case '"', '\\', '/':
+				sb.WriteByte(esc)
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'u':
+				if l.pos+6 > len(l.src) {
+					return token{}, l.errorf(loc, "invalid unicode escape")
+				}
+				code, err := strconv.ParseUint(l.src[l.pos+2:l.pos+6], 16, 32)
+				if err != nil {
+					return token{}, l.errorf(loc, "invalid unicode escape")
+				}
+				sb.WriteRune(rune(code))
+				l.advance(4)
+			default:
+				return token{}, l.errorf(loc, "invalid escape sequence \\%c", esc)
+			}
+			l.advance(2)
+		default:
+			_, size := utf8.DecodeRuneInString(l.src[l.pos:])
+			sb.WriteString(l.src[l.pos : l.pos+size])
+			l.advance(size)
+		}
+	}
+}
+
+// blockString reads a """triple-quoted""" string, removing the common
+// indentation of its lines and leading and trailing blank lines.
+func (l *lexer) blockString(loc Location) (token, *Error) {
+	l.advance(3)
+	start := l.pos
+	for {
+		if l.pos >= len(l.src) {
+			return token{}, l.errorf(loc, "unterminated string")
+		}
+		if strings.HasPrefix(l.src[l.pos:], `\"""`) {
+			l.advance(4)
+			continue
+		}
+		if strings.HasPrefix(l.src[l.pos:], `"""`) {
+			break
+		}
+		if l.src[l.pos] == '\n' {
+			l.pos++
+			l.line++
+			l.col = 1
+			continue
+		}
+		l.advance(1)
+	}
+	raw := strings.ReplaceAll(l.src[start:l.pos], `\"""`, `"""`)
+	l.advance(3)
+	return token{kind: tokenString, value: blockStringValue(raw), loc: loc}, nil
+}
+
+func blockStringValue(raw string) string {
+	lines := strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n")
+	indent := -1
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" {
+			continue
+		}
+		if n := len(line) - len(trimmed); indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	if indent > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) >= indent {
+				lines[i] = lines[i][indent:]
+			} else {
+				lines[i] = ""
+			}
+		}
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,560 @@
+package graphql
+
+import "strings"
+
+// document is a parsed GraphQL request document.
+type document struct {
+	operations []*operation
+	fragments  map[string]*fragment
+}
+
+type operation struct {
+	kind       string // query, mutation, or subscription
+	name       string
+	vars       []*varDef
+	directives []*directive
+	selections []selection
+	loc        Location
+}
+
+type varDef struct {
+	name string
+	typ  *typeRef
+	def  value // nil when the variable has no default
+	loc  Location
+}
+
+// typeRef is a type as written in a variable definition, e.g. [ID!]!.
+type typeRef struct {
+	name    string   // named type; empty for lists
+	elem    *typeRef // list element type
+	nonNull bool
+}
+
+func (t *typeRef) String() string {
+	s := t.name
+	if t.elem != nil {
+		s = "[" + t.elem.String() + "]"
+	}
+	if t.nonNull {
+		s += "!"
+	}
+	return s
+}
+
+type selection interface{ location() Location }
+
+type field struct {
+	alias      string
+	name       string
+	args       []*argument
+	directives []*directive
+	selections []selection
+	loc        Location
+}
+
+func (f *field) responseKey() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+type fragmentSpread struct {
+	name       string
+	directives []*directive
+	loc        Location
+}
+
+type inlineFragment struct {
+	typeCond   string
+	directives []*directive
+	selections []selection
+	loc        Location
+}
+
+type fragment struct {
+	name       string
+	typeCond   string
+	directives []*directive
+	selections []selection
+	loc        Location
+}
+
+func (f *field) location() Location          { return f.loc }
+func (f *fragmentSpread) location() Location { return f.loc }
+func (f *inlineFragment) location() Location { return f.loc }
+
+type argument struct {
+	name  string
+	value value
+	loc   Location
+}
+
+type directive struct {
+	name string
+	args []*argument
+	loc  Location
+}
+
+// value is an input value literal.
+type value interface{}
+
+type (
+	variableValue struct{ name string }
+	intValue      struct{ raw string }
+	floatValue    struct{ raw string }
+	stringValue   struct{ value string }
+	booleanValue  struct{ value bool }
+	nullValue     struct{}
+	enumValue     struct{ name string }
+	listValue     struct{ items []value }
+	objectValue   struct{ fields []*argument }
+)
+
+// parser is a recursive-descent parser over the lexer's tokens.
+type parser struct {
+	lex *lexer
+	tok token
+}
+
+// parse parses a request document.
+func parse(src string) (*document, *Error) {
+	p := &parser{lex: newLexer(src)}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	doc := &document{fragments: map[string]*fragment{}}
+	if p.tok.kind == tokenEOF {
+		return nil, p.unexpected()
+	}
+	for p.tok.kind != tokenEOF {
+		switch {
+		case p.peek(tokenPunct, "{"):
+			sels, err := p.selectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, &operation{kind: "query", selections: sels, loc: sels[0].location()})
+		case p.peekName("query"), p.peekName("mutation"), p.peekName("subscription"):
+			op, err := p.operation()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, op)
+		case p.peekName("fragment"):
+			frag, err := p.fragment()
+			if err != nil {
+				return nil, err
+			}
+			if _, dup := doc.fragments[frag.name]; dup {
+				return nil, &Error{Message: `There can be only one fragment named "` + frag.name + `".`, Locations: []Location{frag.loc}}
+			}
+			doc.fragments[frag.name] = frag
+		default:
+			return nil, p.unexpected()
+		}
+	}
+	return doc, nil
+}
+
+func (p *parser) advance() *Error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *parser) peek(kind tokenKind, v string) bool {
+	return p.tok.kind == kind && p.tok.value == v
+}
+
+func (p *parser) peekName(v string) bool {
+	return p.peek(tokenName, v)
+}
+
+func (p *parser) unexpected() *Error {
+	desc := p.tok.kind.String()
+	if p.tok.kind != tokenEOF {
+		desc = `"` + p.tok.value + `"`
+	}
+	return p.lex.errorf(p.tok.loc, "unexpected %s", desc)
+}
+
+// expect consumes the punctuator v or fails.
+func (p *parser) expect(v string) *Error {
+	if !p.peek(tokenPunct, v) {
+		return p.lex.errorf(p.tok.loc, "expected %q, found %s", v, p.describe())
+	}
+	return p.advance()
+}
+
+// skip consumes the punctuator v if it is next.
+func (p *parser) skip(v string) (bool, *Error) {
+	if !p.peek(tokenPunct, v) {
+		return false, nil
+	}
+	return true, p.advance()
+}
+
+func (p *parser) describe() string {
+	if p.tok.kind == tokenEOF {
+		return p.tok.kind.String()
+	}
+	return `"` + p.tok.value + `"`
+}
+
+func (p *parser) name() (string, *Error) {
+	if p.tok.kind != tokenName {
+		return "", p.lex.errorf(p.tok.loc, "expected name, found %s", p.describe())
+	}
+	v := p.tok.value
+	return v, p.advance()
+}
+
+func (p *parser) operation() (*operation, *Error) {
+	op := &operation{kind: p.tok.value, loc: p.tok.loc}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind == tokenName {
+		op.name = p.tok.value
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peek(tokenPunct, "(") {
+		vars, err := p.variableDefinitions()
+		if err != nil {
+			return nil, err
+		}
+		op.vars = vars
+	}
+	var err *Error
+	if op.directives, err = p.directives(true); err != nil {
+		return nil, err
+	}
+	if op.selections, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (p *parser) variableDefinitions() ([]*varDef, *Error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var defs []*varDef
+	for {
+		def := &varDef{loc: p.tok.loc}
+		if err := p.expect("$"); err != nil {
+			return nil, err
+		}
+		var err *Error
+		if def.name, err = p.name(); err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		if def.typ, err = p.typeRef(); err != nil {
+			return nil, err
+		}
+		if ok, err := p.skip("="); err != nil {
+			return nil, err
+		} else if ok {
+			if def.def, err = p.value(true); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := p.directives(true); err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+		if ok, err := p.skip(")"); err != nil {
+			return nil, err
+		} else if ok {
+			return defs, nil
+		}
+	}
+}
+
+func (p *parser) typeRef() (*typeRef, *Error) {
+	t := &typeRef{}
+	if ok, err := p.skip("["); err != nil {
+		return nil, err
+	} else if ok {
+		if t.elem, err = p.typeRef(); err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+	} else {
+		if t.name, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+	ok, err := p.skip("!")
+	if err != nil {
+		return nil, err
+	}
+	t.nonNull = ok
+	return t, nil
+}
+
+func (p *parser) selectionSet() ([]selection, *Error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var sels []selection
+	for {
+		sel, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+		sels = append(sels, sel)
+		if ok, err := p.skip("}"); err != nil {
+			return nil, err
+		} else if ok {
+			return sels, nil
+		}
+	}
+}
+
+func (p *parser) selection() (selection, *Error) {
+	loc := p.tok.loc
+	if ok, err := p.skip("..."); err != nil {
+		return nil, err
+	} else if ok {
+		return p.fragmentSelection(loc)
+	}
+
+	f := &field{loc: loc}
+	var err *Error
+	if f.name, err = p.name(); err != nil {
+		return nil, err
+	}
+	if ok, err := p.skip(":"); err != nil {
+		return nil, err
+	} else if ok {
+		f.alias = f.name
+		if f.name, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peek(tokenPunct, "(") {
+		if f.args, err = p.arguments(false); err != nil {
+			return nil, err
+		}
+	}
+	if f.directives, err = p.directives(false); err != nil {
+		return nil, err
+	}
+	if p.peek(tokenPunct, "{") {
+		if f.selections, err = p.selectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// fragmentSelection parses what follows "..." in a selection set.
+func (p *parser) fragmentSelection(loc Location) (selection, *Error) {
+	if p.tok.kind == tokenName && p.tok.value != "on" {
+		spread := &fragmentSpread{name: p.tok.value, loc: loc}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		var err *Error
+		if spread.directives, err = p.directives(false); err != nil {
+			return nil, err
+		}
+		return spread, nil
+	}
+
+	inline := &inlineFragment{loc: loc}
+	if p.peekName("on") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		var err *Error
+		if inline.typeCond, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+	var err *Error
+	if inline.directives, err = p.directives(false); err != nil {
+		return nil, err
+	}
+	if inline.selections, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+	return inline, nil
+}
+
+func (p *parser) fragment() (*fragment, *Error) {
+	frag := &fragment{loc: p.tok.loc}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	var err *Error
+	if frag.name, err = p.name(); err != nil {
+		return nil, err
+	}
+	if frag.name == "on" {
+		return nil, p.lex.errorf(frag.loc, `fragment cannot be named "on"`)
+	}
+	if !p.peekName("on") {
+		return nil, p.lex.errorf(p.tok.loc, `expected "on", found %s`, p.describe())
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if frag.typeCond, err = p.name(); err != nil {
+		return nil, err
+	}
+	if frag.directives, err = p.directives(false); err != nil {
+		return nil, err
+	}
+	if frag.selections, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+	return frag, nil
+}
+
+func (p *parser) arguments(isConst bool) ([]*argument, *Error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var args []*argument
+	for {
+		arg := &argument{loc: p.tok.loc}
+		var err *Error
+		if arg.name, err = p.name(); err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		if arg.value, err = p.value(isConst); err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if ok, err := p.skip(")"); err != nil {
+			return nil, err
+		} else if ok {
+			return args, nil
+		}
+	}
+}
+
+func (p *parser) directives(isConst bool) ([]*directive, *Error) {
+	var dirs []*directive
+	for p.peek(tokenPunct, "@") {
+		d := &directive{loc: p.tok.loc}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		var err *Error
+		if d.name, err = p.name(); err != nil {
+			return nil, err
+		}
+		if p.peek(tokenPunct, "(") {
+			if d.args, err = p.arguments(isConst); err != nil {
+				return nil, err
+			}
+		}
+		dirs = append(dirs, d)
+	}
+	return dirs, nil
+}
+
+// value parses an input value. Constant values may not contain variables.
+func (p *parser) value(isConst bool) (value, *Error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokenInt:
+		return intValue{raw: tok.value}, p.advance()
+	case tokenFloat:
+		return floatValue{raw: tok.value}, p.advance()
+	case tokenString:
+		return stringValue{value: tok.value}, p.advance()
+	case tokenName:
+		var v value
+		switch tok.value {
+		case "true", "false":
+			v = booleanValue{value: tok.value == "true"}
+		case "null":
+			v = nullValue{}
+		default:
+			v = enumValue{name: tok.value}
+		}
+		return v, p.advance()
+	case tokenPunct:
+		switch tok.value {
+		case "$":
+			if isConst {
+				break
+			}
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			name, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			return variableValue{name: name}, nil
+		case "[":
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			list := listValue{}
+			for {
+				if ok, err := p.skip("]"); err != nil {
+					return nil, err
+				} else if ok {
+					return list, nil
+				}
+				item, err := p.value(isConst)
+				if err != nil {
+					return nil, err
+				}
+				list.items = append(list.items, item)
+			}
+		case "{":
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			obj := objectValue{}
+			for {
+				if ok, err := p.skip("}"); err != nil {
+					return nil, err
+				} else if ok {
+					return obj, nil
+				}
+				f := &argument{loc: p.tok.loc}
+				var err *Error
+				if f.name, err = p.name(); err != nil {
+					return nil, err
+				}
+				if err := p.expect(":"); err != nil {
+					return nil, err
+				}
+				if f.value, err = p.value(isConst); err != nil {
+					return nil, err
+				}
+				obj.fields = append(obj.fields, f)
+			}
+		}
+	}
+	return nil, p.unexpected()
+}
+
+// isIntrospectionName reports whether name is reserved for introspection.
+func isIntrospectionName(name string) bool {
+	return strings.HasPrefix(name, "__")
+}
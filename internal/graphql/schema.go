@@ -0,0 +1,400 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Type is a GraphQL type: a *Scalar, an *Object, or a *List or *NonNull
+// wrapping another type.
+type Type interface {
+	String() string
+}
+
+// ResolveFunc produces the value of a field for the parent value source.
+// Arguments have already been coerced to their declared types: String and ID
+// to string, Int to int, Float to float64, Boolean to bool, and lists to
+// []any. Optional arguments that were not supplied are absent from args.
+type ResolveFunc func(ctx context.Context, source any, args map[string]any) (any, error)
+
+// Scalar is a leaf type. Serialize converts a resolved Go value to its JSON
+// output; ParseValue coerces an input from a variable or literal.
+type Scalar struct {
+	Name        string
+	Description string
+	Serialize   func(v any) (any, error)
+	ParseValue  func(v any) (any, error)
+}
+
+func (s *Scalar) String() string { return s.Name }
+
+// Object is a type with a set of named fields.
+type Object struct {
+	Name        string
+	Description string
+	Fields      []*Field
+
+	fields map[string]*Field
+}
+
+func (o *Object) String() string { return o.Name }
+
+// Field is a field of an Object.
+type Field struct {
+	Name        string
+	Description string
+	Type        Type
+	Args        []*Argument
+	Resolve     ResolveFunc
+}
+
+// Argument is an argument accepted by a field. Default, if non-nil, is used
+// when the argument is omitted.
+type Argument struct {
+	Name        string
+	Description string
+	Type        Type
+	Default     any
+}
+
+// List is a list of values of another type.
+type List struct{ OfType Type }
+
+func (l *List) String() string { return "[" + l.OfType.String() + "]" }
+
+// NonNull marks a type whose values are never null.
+type NonNull struct{ OfType Type }
+
+func (n *NonNull) String() string { return n.OfType.String() + "!" }
+
+// ListOf returns a list of t.
+func ListOf(t Type) *List { return &List{OfType: t} }
+
+// NonNullOf returns the non-null form of t.
+func NonNullOf(t Type) *NonNull { return &NonNull{OfType: t} }
+
+// Built-in scalars.
+var (
+	String = &Scalar{
+		Name:        "String",
+		Description: "UTF-8 character sequence.",
+		Serialize:   serializeString,
+		ParseValue:  parseString,
+	}
+	ID = &Scalar{
+		Name:        "ID",
+		Description: "Unique identifier, serialized as a string.",
+		Serialize:   serializeString,
+		ParseValue:  parseID,
+	}
+	Int = &Scalar{
+		Name:        "Int",
+		Description: "Signed 32-bit integer.",
+		Serialize:   serializeInt,
+		ParseValue:  parseInt,
+	}
+	Float = &Scalar{
+		Name:        "Float",
+		Description: "Double-precision floating-point value.",
+		Serialize:   serializeFloat,
+		ParseValue:  parseFloat,
+	}
+	Boolean = &Scalar{
+		Name:        "Boolean",
+		Description: "true or false.",
+		Serialize:   serializeBoolean,
+		ParseValue:  parseBoolean,
+	}
+)
+
+var builtinScalars = []*Scalar{String, ID, Int, Float, Boolean}
+
+func serializeString(v any) (any, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case fmt.Stringer:
+		return v.String(), nil
+	case bool, int, int32, int64, float64:
+		return fmt.Sprint(v), nil
+	}
+	return nil, fmt.Errorf("cannot represent %T as a string", v)
+}
+
+func parseString(v any) (any, error) {
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	return nil, fmt.Errorf("expected a string, got %s", describeInput(v))
+}
+
+func parseID(v any) (any, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case float64:
+		if v == math.Trunc(v) {
+			return fmt.Sprintf("%.0f", v), nil
+		}
+	case int:
+		return fmt.Sprint(v), nil
+	}
+	return nil, fmt.Errorf("expected an ID, got %s", describeInput(v))
+}
+
+func serializeInt(v any) (any, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := rv.Int(); n >= math.MinInt32 && n <= math.MaxInt32 {
+			return int(n), nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n := rv.Uint(); n <= math.MaxInt32 {
+			return int(n), nil
+		}
+	default:
+		return nil, fmt.Errorf("cannot represent %T as an integer", v)
+	}
+	return nil, fmt.Errorf("integer %v is out of the 32-bit range", v)
+}
+
+func parseInt(v any) (any, error) {
+	switch v := v.(type) {
+	case int:
+		if v >= math.MinInt32 && v <= math.MaxInt32 {
+			return v, nil
+		}
+	case float64:
+		if v == math.Trunc(v) && v >= math.MinInt32 && v <= math.MaxInt32 {
+			return int(v), nil
+		}
+	}
+	return nil, fmt.Errorf("expected a 32-bit integer, got %s", describeInput(v))
+}
+
+func serializeFloat(v any) (any, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	}
+	return nil, fmt.Errorf("cannot represent %T as a float", v)
+}
+
+func parseFloat(v any) (any, error) {
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	}
+	return nil, fmt.Errorf("expected a float, got %s", describeInput(v))
+}
+
+func serializeBoolean(v any) (any, error) {
+	if b, ok := v.(bool); ok {
+		return b, nil
+	}
+	return nil, fmt.Errorf("cannot represent %T as a boolean", v)
+}
+
+func parseBoolean(v any) (any, error) {
+	if b, ok := v.(bool); ok {
+		return b, nil
+	}
+	return nil, fmt.Errorf("expected a boolean, got %s", describeInput(v))
+}
+
+func describeInput(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return fmt.Sprintf("%q", v)
+	case []any:
+		return "a list"
+	case map[string]any:
+		return "an object"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Schema is an executable GraphQL schema. Only query operations are
+// supported.
+type Schema struct {
+	Query *Object
+
+	// MaxDepth limits how deeply a query may nest selections, guarding
+	// against queries that expand into huge responses. Zero means no limit.
+	MaxDepth int
+
+	// MaxFields limits how many fields a query may select in all, counting
+	// a fragment's fields wherever it is spread, so that aliases cannot
+	// repeat an expensive field without bound. Zero means no limit.
+	MaxFields int
+
+	types map[string]Type
+	order []string // named types in discovery order, for printing
+}
+
+// NewSchema checks the types reachable from query and returns a schema for
+// executing requests against them.
+func NewSchema(query *Object) (*Schema, error) {
+	if query == nil {
+		return nil, fmt.Errorf("graphql: schema has no query type")
+	}
+	s := &Schema{Query: query, types: map[string]Type{}}
+	for _, sc := range builtinScalars {
+		s.types[sc.Name] = sc
+	}
+	if err := s.addType(query); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Schema) addType(t Type) error {
+	switch t := t.(type) {
+	case *List:
+		return s.addType(t.OfType)
+	case *NonNull:
+		if _, ok := t.OfType.(*NonNull); ok {
+			return fmt.Errorf("graphql: %s wraps a non-null type in NonNull", t)
+		}
+		return s.addType(t.OfType)
+	case *Scalar:
+		if existing, ok := s.types[t.Name]; ok {
+			if existing != Type(t) {
+				return fmt.Errorf("graphql: two different types are named %s", t.Name)
+			}
+			return nil
+		}
+		if t.Serialize == nil || t.ParseValue == nil {
+			return fmt.Errorf("graphql: scalar %s needs Serialize and ParseValue", t.Name)
+		}
+		s.types[t.Name] = t
+		s.order = append(s.order, t.Name)
+		return nil
+	case *Object:
+		if existing, ok := s.types[t.Name]; ok {
+			if existing != Type(t) {
+				return fmt.Errorf("graphql: two different types are named %s", t.Name)
+			}
+			return nil
+		}
+		if t.Name == "" || len(t.Fields) == 0 {
+			return fmt.Errorf("graphql: object %q must have a name and at least one field", t.Name)
+		}
+		s.types[t.Name] = t
+		s.order = append(s.order, t.Name)
+		t.fields = make(map[string]*Field, len(t.Fields))
+		for _, f := range t.Fields {
+			if err := s.addField(t, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("graphql: unsupported type %T", t)
+	}
+}
+
+func (s *Schema) addField(o *Object, f *Field) error {
+	if f.Name == "" || isIntrospectionName(f.Name) {
+		return fmt.Errorf("graphql: %s has a field with invalid name %q", o.Name, f.Name)
+	}
+	if _, dup := o.fields[f.Name]; dup {
+		return fmt.Errorf("graphql: %s has two fields named %s", o.Name, f.Name)
+	}
+	if f.Type == nil || f.Resolve == nil {
+		return fmt.Errorf("graphql: %s.%s needs a type and a resolver", o.Name, f.Name)
+	}
+	o.fields[f.Name] = f
+	for _, arg := range f.Args {
+		if !isInputType(arg.Type) {
+			return fmt.Errorf("graphql: argument %s of %s.%s must be a scalar or list of scalars", arg.Name, o.Name, f.Name)
+		}
+		if err := s.addType(arg.Type); err != nil {
+			return err
+		}
+	}
+	return s.addType(f.Type)
+}
+
+// isInputType reports whether t may be used for arguments and variables.
+func isInputType(t Type) bool {
+	switch t := t.(type) {
+	case *Scalar:
+		return true
+	case *List:
+		return isInputType(t.OfType)
+	case *NonNull:
+		return isInputType(t.OfType)
+	}
+	return false
+}
+
+// namedType strips any List and NonNull wrappers from t.
+func namedType(t Type) Type {
+	for {
+		switch w := t.(type) {
+		case *List:
+			t = w.OfType
+		case *NonNull:
+			t = w.OfType
+		default:
+			return t
+		}
+	}
+}
+
+// String renders the schema in the GraphQL schema definition language.
+func (s *Schema) String() string {
+	var sb strings.Builder
+	for i, name := range s.order {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		switch t := s.types[name].(type) {
+		case *Scalar:
+			writeDescription(&sb, "", t.Description)
+			fmt.Fprintf(&sb, "scalar %s\n", t.Name)
+		case *Object:
+			writeDescription(&sb, "", t.Description)
+			fmt.Fprintf(&sb, "type %s {\n", t.Name)
+			for _, f := range t.Fields {
+				writeDescription(&sb, "  ", f.Description)
+				sb.WriteString("  " + f.Name)
+				if len(f.Args) > 0 {
+					args := make([]string, len(f.Args))
+					for j, a := range f.Args {
+						args[j] = a.Name + ": " + a.Type.String()
+						if a.Default != nil {
+							def, _ := json.Marshal(a.Default)
+							args[j] += " = " + string(def)
+						}
+					}
+					sb.WriteString("(" + strings.Join(args, ", ") + ")")
+				}
+				sb.WriteString(": " + f.Type.String() + "\n")
+			}
+			sb.WriteString("}\n")
+		}
+	}
+	return sb.String()
+}
+
+func writeDescription(sb *strings.Builder, indent, desc string) {
+	if desc == "" {
+		return
+	}
+	fmt.Fprintf(sb, "%s%q\n", indent, desc)
+}
@@ -0,0 +1,455 @@
+package graphql
+
+import (
+	"fmt"
+	"slices"
+)
+
+// validator checks a document against the schema before execution, so that
+// malformed queries fail as a whole instead of partway through.
+type validator struct {
+	schema *Schema
+	doc    *document
+	errs   []*Error
+}
+
+func (v *validator) errorf(loc Location, format string, args ...any) {
+	v.errs = append(v.errs, &Error{Message: fmt.Sprintf(format, args...), Locations: []Location{loc}})
+}
+
+// validate returns the errors that make doc invalid against the schema.
+func (s *Schema) validate(doc *document) []*Error {
+	v := &validator{schema: s, doc: doc}
+
+	names := map[string]bool{}
+	for _, op := range doc.operations {
+		if op.kind != "query" {
+			v.errorf(op.loc, "Only query operations are supported, not %s.", op.kind)
+		}
+		if op.name == "" && len(doc.operations) > 1 {
+			v.errorf(op.loc, "This anonymous operation must be the only defined operation.")
+		}
+		if op.name != "" {
+			if names[op.name] {
+				v.errorf(op.loc, "There can be only one operation named %q.", op.name)
+			}
+			names[op.name] = true
+		}
+	}
+
+	if !v.checkFragmentCycles() {
+		return v.errs
+	}
+
+	for _, name := range sortedKeys(doc.fragments) {
+		frag := doc.fragments[name]
+		obj, ok := s.types[frag.typeCond].(*Object)
+		if !ok {
+			v.errorf(frag.loc, "Fragment %q cannot condition on non-object type %q.", frag.name, frag.typeCond)
+			continue
+		}
+		v.checkDirectives(frag.directives)
+		v.checkSelections(obj, frag.selections)
+	}
+
+	for _, op := range doc.operations {
+		if op.kind != "query" {
+			continue
+		}
+		v.checkDirectives(op.directives)
+		v.checkVariables(op)
+		v.checkSelections(s.Query, op.selections)
+		if s.MaxDepth > 0 {
+			if depth := v.depth(op.selections); depth > s.MaxDepth {
+				v.errorf(op.loc, "Query is nested %d levels deep, more than the maximum of %d.", depth, s.MaxDepth)
+			}
+		}
+		if s.MaxFields > 0 {
+			if v.fieldCount(op.selections, s.MaxFields, map[string]int{}) > s.MaxFields {
+				v.errorf(op.loc, "Query selects more than the maximum of %d fields.", s.MaxFields)
+			}
+		}
+	}
+	return v.errs
+}
+
+// checkFragmentCycles reports fragments that spread themselves, directly or
+// through other fragments. It returns false if there are any, since later
+// checks expand fragments recursively.
+func (v *validator) checkFragmentCycles() bool {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := map[string]int{}
+	ok := true
+	var visit func(name string, sels []selection)
+	visit = func(name string, sels []selection) {
+		state[name] = visiting
+		for _, spread := range spreads(sels) {
+			frag, exists := v.doc.fragments[spread.name]
+			if !exists {
+				continue
+			}
+			switch state[spread.name] {
+			case visiting:
+				v.errorf(spread.loc, "Cannot spread fragment %q within itself.", spread.name)
+				ok = false
+			case 0:
+				visit(spread.name, frag.selections)
+			}
+		}
+		state[name] = done
+	}
+	for _, name := range sortedKeys(v.doc.fragments) {
+		if state[name] == 0 {
+			visit(name, v.doc.fragments[name].selections)
+		}
+	}
+	return ok
+}
+
+// spreads returns the fragment spreads anywhere within sels, not following
+// the spreads themselves.
+func spreads(sels []selection) []*fragmentSpread {
+	var out []*fragmentSpread
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *field:
+			out = append(out, spreads(sel.selections)...)
+		case *inlineFragment:
+			out = append(out, spreads(sel.selections)...)
+		case *fragmentSpread:
+			out = append(out, sel)
+		}
+	}
+	return out
+}
+
+func (v *validator) checkVariables(op *operation) {
+	defined := map[string]bool{}
+	for _, def := range op.vars {
+		if defined[def.name] {
+			v.errorf(def.loc, "There can be only one variable named \"$%s\".", def.name)
+		}
+		defined[def.name] = true
+		t, err := v.schema.inputType(def.typ)
+		if err != nil {
+			v.errorf(def.loc, "Variable \"$%s\" %s.", def.name, err)
+			continue
+		}
+		if def.def != nil {
+			if _, err := coerceLiteral(t, def.def, nil); err != nil {
+				v.errorf(def.loc, "Variable \"$%s\" has an invalid default value: %s.", def.name, err)
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	var walk func(sels []selection)
+	walkArgs := func(args []*argument) {
+		for _, arg := range args {
+			for _, name := range variablesIn(arg.value) {
+				if !defined[name] {
+					v.errorf(arg.loc, "Variable \"$%s\" is not defined by operation %q.", name, op.name)
+				}
+			}
+		}
+	}
+	walkDirectives := func(dirs []*directive) {
+		for _, d := range dirs {
+			walkArgs(d.args)
+		}
+	}
+	walk = func(sels []selection) {
+		for _, sel := range sels {
+			switch sel := sel.(type) {
+			case *field:
+				walkArgs(sel.args)
+				walkDirectives(sel.directives)
+				walk(sel.selections)
+			case *inlineFragment:
+				walkDirectives(sel.directives)
+				walk(sel.selections)
+			case *fragmentSpread:
+				walkDirectives(sel.directives)
+				if frag, ok := v.doc.fragments[sel.name]; ok && !seen[sel.name] {
+					seen[sel.name] = true
+					walkDirectives(frag.directives)
+					walk(frag.selections)
+				}
+			}
+		}
+	}
+	walk(op.selections)
+}
+
+// variablesIn returns the names of the variables used in a value.
+func variablesIn(val value) []string {
+	switch val := val.(type) {
+	case variableValue:
+		return []string{val.name}
+	case listValue:
+		var names []string
+		for _, item := range val.items {
+			names = append(names, variablesIn(item)...)
+		}
+		return names
+	case objectValue:
+		var names []string
+		for _, f := range val.fields {
+			names = append(names, variablesIn(f.value)...)
+		}
+		return names
+	}
+	return nil
+}
+
+func (v *validator) checkSelections(obj *Object, sels []selection) {
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *field:
+			v.checkDirectives(sel.directives)
+			v.checkField(obj, sel)
+		case *fragmentSpread:
+			v.checkDirectives(sel.directives)
+			frag, ok := v.doc.fragments[sel.name]
+			if !ok {
+				v.errorf(sel.loc, "Unknown fragment %q.", sel.name)
+				continue
+			}
+			if frag.typeCond != obj.Name {
+				v.errorf(sel.loc, "Fragment %q cannot be spread here as objects of type %q can never be of type %q.", sel.name, obj.Name, frag.typeCond)
+			}
+		case *inlineFragment:
+			v.checkDirectives(sel.directives)
+			if sel.typeCond != "" && sel.typeCond != obj.Name {
+				if _, ok := v.schema.types[sel.typeCond].(*Object); !ok {
+					v.errorf(sel.loc, "Unknown type %q.", sel.typeCond)
+				} else {
+					v.errorf(sel.loc, "Fragment cannot be spread here as objects of type %q can never be of type %q.", obj.Name, sel.typeCond)
+				}
+				continue
+			}
+			v.checkSelections(obj, sel.selections)
+		}
+	}
+	v.checkMergeable(obj, sels)
+}
+
+func (v *validator) checkField(obj *Object, f *field) {
+	if f.name == "__typename" {
+		if len(f.args) > 0 {
+			v.errorf(f.loc, "Unknown argument %q on field \"__typename\".", f.args[0].name)
+		}
+		if len(f.selections) > 0 {
+			v.errorf(f.loc, "Field \"__typename\" must not have a selection since type \"String\" has no subfields.")
+		}
+		return
+	}
+	def, ok := obj.fields[f.name]
+	if !ok {
+		v.errorf(f.loc, "Cannot query field %q on type %q.", f.name, obj.Name)
+		return
+	}
+
+	v.checkArguments(fmt.Sprintf("Field %q", f.name), def.Args, f.args, f.loc)
+
+	switch t := namedType(def.Type).(type) {
+	case *Object:
+		if len(f.selections) == 0 {
+			v.errorf(f.loc, "Field %q of type %q must have a selection of subfields.", f.name, def.Type)
+			return
+		}
+		v.checkSelections(t, f.selections)
+	default:
+		if len(f.selections) > 0 {
+			v.errorf(f.loc, "Field %q must not have a selection since type %q has no subfields.", f.name, def.Type)
+		}
+	}
+}
+
+// checkArguments checks supplied arguments against their definitions:
+// every argument must be known and given once, literals must have the right
+// type, and required arguments must be present.
+func (v *validator) checkArguments(owner string, defs []*Argument, args []*argument, loc Location) {
+	given := map[string]bool{}
+	for _, arg := range args {
+		if given[arg.name] {
+			v.errorf(arg.loc, "There can be only one argument named %q.", arg.name)
+			continue
+		}
+		given[arg.name] = true
+		i := slices.IndexFunc(defs, func(d *Argument) bool { return d.Name == arg.name })
+		if i < 0 {
+			v.errorf(arg.loc, "Unknown argument %q on %s.", arg.name, lowerFirst(owner))
+			continue
+		}
+		if _, err := coerceLiteral(defs[i].Type, arg.value, nil); err != nil {
+			v.errorf(arg.loc, "Argument %q has an invalid value: %s.", arg.name, err)
+		}
+	}
+	for _, def := range defs {
+		if _, required := def.Type.(*NonNull); required && def.Default == nil && !given[def.Name] {
+			v.errorf(loc, "%s argument %q of type %q is required, but it was not provided.", owner, def.Name, def.Type)
+		}
+	}
+}
+
+var ifArgument = []*Argument{{Name: "if", Type: NonNullOf(Boolean)}}
+
+func (v *validator) checkDirectives(dirs []*directive) {
+	for _, d := range dirs {
+		if d.name != "skip" && d.name != "include" {
+			v.errorf(d.loc, "Unknown directive \"@%s\".", d.name)
+			continue
+		}
+		v.checkArguments(fmt.Sprintf("Directive \"@%s\"", d.name), ifArgument, d.args, d.loc)
+	}
+}
+
+// checkMergeable reports fields in a selection set that share a response key
+// but select different fields or arguments, since their results cannot be
+// merged into one entry.
+func (v *validator) checkMergeable(obj *Object, sels []selection) {
+	byKey := map[string]*field{}
+	for _, f := range v.flatten(obj, sels, map[string]bool{}) {
+		key := f.responseKey()
+		prev, ok := byKey[key]
+		if !ok {
+			byKey[key] = f
+			continue
+		}
+		if prev.name != f.name {
+			v.errorf(f.loc, "Fields %q conflict because %q and %q are different fields. Use different aliases on the fields to fetch both if this was intentional.", key, prev.name, f.name)
+		} else if !sameArguments(prev.args, f.args) {
+			v.errorf(f.loc, "Fields %q conflict because they have differing arguments. Use different aliases on the fields to fetch both if this was intentional.", key)
+		}
+	}
+}
+
+// flatten returns the fields of a selection set, expanding fragments that
+// apply to obj.
+func (v *validator) flatten(obj *Object, sels []selection, visited map[string]bool) []*field {
+	var out []*field
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *field:
+			out = append(out, sel)
+		case *inlineFragment:
+			if sel.typeCond == "" || sel.typeCond == obj.Name {
+				out = append(out, v.flatten(obj, sel.selections, visited)...)
+			}
+		case *fragmentSpread:
+			frag, ok := v.doc.fragments[sel.name]
+			if ok && !visited[sel.name] && frag.typeCond == obj.Name {
+				visited[sel.name] = true
+				out = append(out, v.flatten(obj, frag.selections, visited)...)
+			}
+		}
+	}
+	return out
+}
+
+func sameArguments(a, b []*argument) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, x := range a {
+		i := slices.IndexFunc(b, func(y *argument) bool { return y.name == x.name })
+		if i < 0 || fmt.Sprintf("%#v", x.value) != fmt.Sprintf("%#v", b[i].value) {
+			return false
+		}
+	}
+	return true
+}
+
+// depth returns how many levels of fields sels nests, expanding fragments.
+func (v *validator) depth(sels []selection) int {
+	deepest := 0
+	for _, sel := range sels {
+		var d int
+		switch sel := sel.(type) {
+		case *field:
+			d = 1 + v.depth(sel.selections)
+		case *inlineFragment:
+			d = v.depth(sel.selections)
+		case *fragmentSpread:
+			if frag, ok := v.doc.fragments[sel.name]; ok {
+				d = v.depth(frag.selections)
+			}
+		}
+		deepest = max(deepest, d)
+	}
+	return deepest
+}
+
+// fieldCount returns how many fields sels select, expanding fragment spreads.
+// Counting stops once it passes limit, and each fragment is counted once and
+// remembered in counts, so fragments spread many times cannot make the count
+// itself expensive.
+func (v *validator) fieldCount(sels []selection, limit int, counts map[string]int) int {
+	n := 0
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *field:
+			n += 1 + v.fieldCount(sel.selections, limit, counts)
+		case *inlineFragment:
+			n += v.fieldCount(sel.selections, limit, counts)
+		case *fragmentSpread:
+			c, ok := counts[sel.name]
+			if !ok {
+				if frag, exists := v.doc.fragments[sel.name]; exists {
+					c = v.fieldCount(frag.selections, limit, counts)
+				}
+				counts[sel.name] = c
+			}
+			n += c
+		}
+		if n > limit {
+			return limit + 1
+		}
+	}
+	return n
+}
+
+// inputType resolves a variable's declared type against the schema.
+func (s *Schema) inputType(ref *typeRef) (Type, error) {
+	var t Type
+	if ref.elem != nil {
+		elem, err := s.inputType(ref.elem)
+		if err != nil {
+			return nil, err
+		}
+		t = ListOf(elem)
+	} else {
+		named, ok := s.types[ref.name]
+		if !ok {
+			return nil, fmt.Errorf("has unknown type %q", ref.name)
+		}
+		if !isInputType(named) {
+			return nil, fmt.Errorf("cannot be non-input type %q", ref.name)
+		}
+		t = named
+	}
+	if ref.nonNull {
+		t = NonNullOf(t)
+	}
+	return t, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return string(s[0]|0x20) + s[1:]
+}
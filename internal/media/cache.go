@@ -0,0 +1,75 @@
+package media
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// DefaultThumbnailCacheEntries bounds the number of thumbnails a cache holds.
+const DefaultThumbnailCacheEntries = 1000
+
+// thumbnailKey identifies a thumbnail of one media item at one size.
+type thumbnailKey struct {
+	id   uuid.UUID
+	size int
+}
+
+// ThumbnailCache holds thumbnails generated on demand, keyed by media ID and
+// size. When full, the oldest entry is evicted. It is safe for concurrent use.
+type ThumbnailCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[thumbnailKey][]byte
+	order      []thumbnailKey // insertion order, oldest first
+}
+
+// NewThumbnailCache creates a cache holding at most maxEntries thumbnails.
+// A non-positive maxEntries uses DefaultThumbnailCacheEntries.
+func NewThumbnailCache(maxEntries int) *ThumbnailCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultThumbnailCacheEntries
+	}
+	return &ThumbnailCache{
+		maxEntries: maxEntries,
+		entries:    make(map[thumbnailKey][]byte),
+	}
+}
+
+// Get returns the cached thumbnail for a media item at a size.
+func (c *ThumbnailCache) Get(id uuid.UUID, size int) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.entries[thumbnailKey{id, size}]
+	return data, ok
+}
+
+// Put stores a thumbnail for a media item at a size.
+func (c *ThumbnailCache) Put(id uuid.UUID, size int, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := thumbnailKey{id, size}
+	if _, exists := c.entries[key]; !exists {
+		if len(c.order) >= c.maxEntries {
+			delete(c.entries, c.order[0])
+			c.order = c.order[1:]
+		}
+		c.order = append(c.order, key)
+	}
+	c.entries[key] = data
+}
+
+// Remove drops every cached size of a media item.
+func (c *ThumbnailCache) Remove(id uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	kept := c.order[:0]
+	for _, key := range c.order {
+		if key.id == id {
+			delete(c.entries, key)
+			continue
+		}
+		kept = append(kept, key)
+	}
+	c.order = kept
+}
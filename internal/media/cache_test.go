@@ -0,0 +1,66 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestThumbnailCache_GetPut(t *testing.T) {
+	cache := NewThumbnailCache(10)
+	id := uuid.New()
+
+	if _, ok := cache.Get(id, 150); ok {
+		t.Fatal("empty cache should miss")
+	}
+
+	cache.Put(id, 150, []byte("small"))
+	cache.Put(id, 400, []byte("large"))
+
+	if got, ok := cache.Get(id, 150); !ok || string(got) != "small" {
+		t.Errorf("Get(150) = %q, %v; want small", got, ok)
+	}
+	if got, ok := cache.Get(id, 400); !ok || string(got) != "large" {
+		t.Errorf("Get(400) = %q, %v; want large", got, ok)
+	}
+}
+
+func TestThumbnailCache_EvictsOldest(t *testing.T) {
+	cache := NewThumbnailCache(2)
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+
+	cache.Put(first, 150, []byte("1"))
+	cache.Put(second, 150, []byte("2"))
+	cache.Put(first, 150, []byte("1b")) // replacing does not reorder or grow
+	cache.Put(third, 150, []byte("3"))
+
+	if _, ok := cache.Get(first, 150); ok {
+		t.Error("oldest entry should have been evicted")
+	}
+	if _, ok := cache.Get(second, 150); !ok {
+		t.Error("second entry should remain")
+	}
+	if _, ok := cache.Get(third, 150); !ok {
+		t.Error("newest entry should remain")
+	}
+}
+
+func TestThumbnailCache_Remove(t *testing.T) {
+	cache := NewThumbnailCache(0)
+	id, other := uuid.New(), uuid.New()
+
+	cache.Put(id, 150, []byte("a"))
+	cache.Put(id, 400, []byte("b"))
+	cache.Put(other, 150, []byte("c"))
+	cache.Remove(id)
+
+	if _, ok := cache.Get(id, 150); ok {
+		t.Error("removed media should miss at 150")
+	}
+	if _, ok := cache.Get(id, 400); ok {
+		t.Error("removed media should miss at 400")
+	}
+	if _, ok := cache.Get(other, 150); !ok {
+		t.Error("other media should remain")
+	}
+}
@@ -0,0 +1,208 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"time"
+)
+
+// ExifMetadata holds the genealogically useful EXIF fields of a photo.
+type ExifMetadata struct {
+	CapturedAt *time.Time // When the photo was taken (DateTimeOriginal, else DateTime)
+	Latitude   *float64   // GPS latitude in decimal degrees, negative south
+	Longitude  *float64   // GPS longitude in decimal degrees, negative west
+}
+
+// IsEmpty reports whether no metadata was found.
+func (m ExifMetadata) IsEmpty() bool {
+	return m.CapturedAt == nil && m.Latitude == nil && m.Longitude == nil
+}
+
+// EXIF tag IDs used by ExtractExif.
+const (
+	tagDateTime         = 0x0132
+	tagExifIFD          = 0x8769
+	tagGPSIFD           = 0x8825
+	tagDateTimeOriginal = 0x9003
+	tagGPSLatitudeRef   = 0x0001
+	tagGPSLatitude      = 0x0002
+	tagGPSLongitudeRef  = 0x0003
+	tagGPSLongitude     = 0x0004
+)
+
+// EXIF field types used by ExtractExif.
+const (
+	exifASCII    = 2
+	exifLong     = 4
+	exifRational = 5
+)
+
+// exifTimeLayout is the EXIF date/time format. It carries no time zone, so
+// parsed times are reported in UTC.
+const exifTimeLayout = "2006:01:02 15:04:05"
+
+// ExtractExif reads capture date and GPS position from the EXIF block of a
+// JPEG. Missing, malformed, or unsupported data yields empty metadata rather
+// than an error, since EXIF is optional and often partially corrupt.
+func ExtractExif(data []byte) ExifMetadata {
+	tiff := findExifTIFF(data)
+	if tiff == nil {
+		return ExifMetadata{}
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return ExifMetadata{}
+	}
+	r := exifReader{data: tiff, order: order}
+
+	var meta ExifMetadata
+	ifd0 := r.readIFD(r.u32(4))
+
+	dateTime := r.ascii(ifd0[tagDateTime])
+	if off, ok := r.long(ifd0[tagExifIFD]); ok {
+		if original := r.ascii(r.readIFD(off)[tagDateTimeOriginal]); original != "" {
+			dateTime = original
+		}
+	}
+	if t, err := time.Parse(exifTimeLayout, dateTime); err == nil {
+		meta.CapturedAt = &t
+	}
+
+	if off, ok := r.long(ifd0[tagGPSIFD]); ok {
+		gps := r.readIFD(off)
+		meta.Latitude = r.coordinate(gps[tagGPSLatitude], r.ascii(gps[tagGPSLatitudeRef]), "S")
+		meta.Longitude = r.coordinate(gps[tagGPSLongitude], r.ascii(gps[tagGPSLongitudeRef]), "W")
+	}
+	return meta
+}
+
+// findExifTIFF walks the JPEG markers up to the image data and returns the
+// TIFF structure inside the APP1 Exif segment, or nil if there is none.
+func findExifTIFF(data []byte) []byte {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil
+	}
+	for pos := 2; pos+4 <= len(data); {
+		if data[pos] != 0xFF {
+			return nil
+		}
+		marker := data[pos+1]
+		if marker == 0xDA || marker == 0xD9 { // start of scan / end of image
+			return nil
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil
+		}
+		segment := data[pos+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) && len(segment) >= 14 {
+			return segment[6:]
+		}
+		pos = end
+	}
+	return nil
+}
+
+// exifEntry is a raw IFD entry; value holds the 4-byte value/offset field.
+type exifEntry struct {
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+// exifReader decodes IFD entries from a TIFF structure, bounds-checking every
+// access so truncated data reads as missing.
+type exifReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+func (r exifReader) u32(off uint32) uint32 {
+	if uint64(off)+4 > uint64(len(r.data)) {
+		return 0
+	}
+	return r.order.Uint32(r.data[off:])
+}
+
+// readIFD returns the entries of the IFD at off, keyed by tag.
+func (r exifReader) readIFD(off uint32) map[uint16]exifEntry {
+	entries := make(map[uint16]exifEntry)
+	if off == 0 || uint64(off)+2 > uint64(len(r.data)) {
+		return entries
+	}
+	n := int(r.order.Uint16(r.data[off:]))
+	for i := 0; i < n; i++ {
+		start := uint64(off) + 2 + uint64(i)*12
+		if start+12 > uint64(len(r.data)) {
+			break
+		}
+		e := r.data[start : start+12]
+		entries[r.order.Uint16(e)] = exifEntry{
+			typ:   r.order.Uint16(e[2:]),
+			count: r.order.Uint32(e[4:]),
+			value: e[8:12],
+		}
+	}
+	return entries
+}
+
+// payload returns the bytes of an entry's value, which are stored inline when
+// they fit in four bytes and at an offset otherwise.
+func (r exifReader) payload(e exifEntry, size uint32) []byte {
+	n := uint64(e.count) * uint64(size)
+	if n <= 4 {
+		return e.value[:n]
+	}
+	off := uint64(r.order.Uint32(e.value))
+	if off+n > uint64(len(r.data)) {
+		return nil
+	}
+	return r.data[off : off+n]
+}
+
+func (r exifReader) long(e exifEntry) (uint32, bool) {
+	if e.typ != exifLong || e.count != 1 {
+		return 0, false
+	}
+	return r.order.Uint32(e.value), true
+}
+
+func (r exifReader) ascii(e exifEntry) string {
+	if e.typ != exifASCII {
+		return ""
+	}
+	return strings.TrimRight(string(r.payload(e, 1)), "\x00 ")
+}
+
+// coordinate converts a degrees/minutes/seconds rational triple to decimal
+// degrees, negated when ref matches the negative hemisphere.
+func (r exifReader) coordinate(e exifEntry, ref, negative string) *float64 {
+	if e.typ != exifRational || e.count != 3 {
+		return nil
+	}
+	raw := r.payload(e, 8)
+	if raw == nil {
+		return nil
+	}
+	var parts [3]float64
+	for i := range parts {
+		num := r.order.Uint32(raw[i*8:])
+		den := r.order.Uint32(raw[i*8+4:])
+		if den == 0 {
+			return nil
+		}
+		parts[i] = float64(num) / float64(den)
+	}
+	deg := parts[0] + parts[1]/60 + parts[2]/3600
+	if strings.EqualFold(ref, negative) {
+		deg = -deg
+	}
+	return &deg
+}
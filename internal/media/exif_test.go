@@ -0,0 +1,185 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+)
+
+// testExifTag is an IFD entry for buildTestExif; data is the raw value bytes.
+type testExifTag struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	data  []byte
+}
+
+func asciiTag(tag uint16, s string) testExifTag {
+	b := append([]byte(s), 0)
+	return testExifTag{tag: tag, typ: exifASCII, count: uint32(len(b)), data: b}
+}
+
+func rationalTag(order binary.ByteOrder, tag uint16, vals ...[2]uint32) testExifTag {
+	b := make([]byte, 8*len(vals))
+	for i, v := range vals {
+		order.PutUint32(b[i*8:], v[0])
+		order.PutUint32(b[i*8+4:], v[1])
+	}
+	return testExifTag{tag: tag, typ: exifRational, count: uint32(len(vals)), data: b}
+}
+
+// buildTestExif lays out IFD0, an Exif sub-IFD, and a GPS sub-IFD in a TIFF
+// structure and wraps it in a JPEG APP1 segment inserted after the SOI marker.
+// Empty sub-IFDs are omitted along with their pointer tag.
+func buildTestExif(jpg []byte, order binary.ByteOrder, ifd0, exifIFD, gpsIFD []testExifTag) []byte {
+	ifdSize := func(tags []testExifTag) uint32 { return uint32(2 + 12*len(tags) + 4) }
+	dataSize := func(tags []testExifTag) uint32 {
+		var n uint32
+		for _, t := range tags {
+			if len(t.data) > 4 {
+				n += uint32(len(t.data))
+			}
+		}
+		return n
+	}
+	pointer := func(tag uint16) testExifTag {
+		return testExifTag{tag: tag, typ: exifLong, count: 1, data: make([]byte, 4)}
+	}
+	if len(exifIFD) > 0 {
+		ifd0 = append(ifd0, pointer(tagExifIFD))
+	}
+	if len(gpsIFD) > 0 {
+		ifd0 = append(ifd0, pointer(tagGPSIFD))
+	}
+
+	// Each IFD is followed immediately by its out-of-line values
+	ifd0Off := uint32(8)
+	exifOff := ifd0Off + ifdSize(ifd0) + dataSize(ifd0)
+	gpsOff := exifOff + ifdSize(exifIFD) + dataSize(exifIFD)
+	for i := range ifd0 {
+		switch ifd0[i].tag {
+		case tagExifIFD:
+			order.PutUint32(ifd0[i].data, exifOff)
+		case tagGPSIFD:
+			order.PutUint32(ifd0[i].data, gpsOff)
+		}
+	}
+
+	var tiff bytes.Buffer
+	if order == binary.LittleEndian {
+		tiff.WriteString("II")
+	} else {
+		tiff.WriteString("MM")
+	}
+	_ = binary.Write(&tiff, order, uint16(42))
+	_ = binary.Write(&tiff, order, ifd0Off)
+
+	writeIFD := func(off uint32, tags []testExifTag) {
+		if len(tags) == 0 {
+			return
+		}
+		dataOff := off + ifdSize(tags)
+		var values bytes.Buffer
+		_ = binary.Write(&tiff, order, uint16(len(tags)))
+		for _, t := range tags {
+			_ = binary.Write(&tiff, order, t.tag)
+			_ = binary.Write(&tiff, order, t.typ)
+			_ = binary.Write(&tiff, order, t.count)
+			if len(t.data) > 4 {
+				_ = binary.Write(&tiff, order, dataOff+uint32(values.Len()))
+				values.Write(t.data)
+			} else {
+				inline := make([]byte, 4)
+				copy(inline, t.data)
+				tiff.Write(inline)
+			}
+		}
+		_ = binary.Write(&tiff, order, uint32(0)) // no next IFD
+		tiff.Write(values.Bytes())
+	}
+	writeIFD(ifd0Off, ifd0)
+	writeIFD(exifOff, exifIFD)
+	writeIFD(gpsOff, gpsIFD)
+
+	segment := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	var out bytes.Buffer
+	out.Write(jpg[:2])
+	out.Write([]byte{0xFF, 0xE1})
+	_ = binary.Write(&out, binary.BigEndian, uint16(len(segment)+2))
+	out.Write(segment)
+	out.Write(jpg[2:])
+	return out.Bytes()
+}
+
+func TestExtractExif(t *testing.T) {
+	jpg := encodeTestImageJPEG(createTestImage(20, 20))
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		t.Run(order.String(), func(t *testing.T) {
+			data := buildTestExif(jpg, order,
+				[]testExifTag{asciiTag(tagDateTime, "2001:01:01 00:00:00")},
+				[]testExifTag{asciiTag(tagDateTimeOriginal, "1962:03:12 14:30:05")},
+				[]testExifTag{
+					asciiTag(tagGPSLatitudeRef, "N"),
+					rationalTag(order, tagGPSLatitude, [2]uint32{40, 1}, [2]uint32{26, 1}, [2]uint32{4620, 100}),
+					asciiTag(tagGPSLongitudeRef, "W"),
+					rationalTag(order, tagGPSLongitude, [2]uint32{79, 1}, [2]uint32{58, 1}, [2]uint32{0, 1}),
+				})
+
+			meta := ExtractExif(data)
+			want := time.Date(1962, 3, 12, 14, 30, 5, 0, time.UTC)
+			if meta.CapturedAt == nil || !meta.CapturedAt.Equal(want) {
+				t.Errorf("CapturedAt = %v, want %v (DateTimeOriginal preferred)", meta.CapturedAt, want)
+			}
+			if meta.Latitude == nil || math.Abs(*meta.Latitude-40.4462) > 1e-4 {
+				t.Errorf("Latitude = %v, want ~40.4462", meta.Latitude)
+			}
+			if meta.Longitude == nil || math.Abs(*meta.Longitude+79.9667) > 1e-4 {
+				t.Errorf("Longitude = %v, want ~-79.9667", meta.Longitude)
+			}
+		})
+	}
+}
+
+func TestExtractExif_DateTimeFallback(t *testing.T) {
+	jpg := encodeTestImageJPEG(createTestImage(20, 20))
+	data := buildTestExif(jpg, binary.LittleEndian,
+		[]testExifTag{asciiTag(tagDateTime, "1975:07:04 09:00:00")}, nil, nil)
+
+	meta := ExtractExif(data)
+	if meta.CapturedAt == nil || meta.CapturedAt.Year() != 1975 {
+		t.Errorf("CapturedAt = %v, want 1975 from IFD0 DateTime", meta.CapturedAt)
+	}
+	if meta.Latitude != nil || meta.Longitude != nil {
+		t.Errorf("expected no GPS, got %v, %v", meta.Latitude, meta.Longitude)
+	}
+}
+
+func TestExtractExif_NoMetadata(t *testing.T) {
+	jpg := encodeTestImageJPEG(createTestImage(20, 20))
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"plain JPEG", jpg},
+		{"PNG", encodeTestImagePNG(createTestImage(20, 20))},
+		{"empty", nil},
+		{"truncated", buildTestExif(jpg, binary.LittleEndian,
+			[]testExifTag{asciiTag(tagDateTime, "1975:07:04 09:00:00")}, nil, nil)[:30]},
+		{"unparseable date", buildTestExif(jpg, binary.LittleEndian,
+			[]testExifTag{asciiTag(tagDateTime, "0000:00:00 00:00:00")}, nil, nil)},
+		{"zero denominator", buildTestExif(jpg, binary.LittleEndian, nil, nil,
+			[]testExifTag{rationalTag(binary.LittleEndian, tagGPSLatitude, [2]uint32{40, 0}, [2]uint32{0, 1}, [2]uint32{0, 1})})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if meta := ExtractExif(tt.data); !meta.IsEmpty() {
+				t.Errorf("ExtractExif() = %+v, want empty", meta)
+			}
+		})
+	}
+}
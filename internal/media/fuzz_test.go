@@ -2,6 +2,7 @@ package media
 
 import (
 	"bytes"
+	"encoding/binary"
 	"image"
 	"image/color"
 	"image/gif"
@@ -59,3 +60,19 @@ func FuzzGenerateThumbnail(f *testing.F) {
 		_, _ = GenerateThumbnail(data, opts)
 	})
 }
+
+func FuzzExtractExif(f *testing.F) {
+	jpg := makeJPEG(10, 10)
+	f.Add(jpg)
+	f.Add(buildTestExif(jpg, binary.LittleEndian,
+		[]testExifTag{asciiTag(tagDateTime, "1962:03:12 14:30:05")},
+		[]testExifTag{asciiTag(tagDateTimeOriginal, "1962:03:12 14:30:05")},
+		[]testExifTag{rationalTag(binary.LittleEndian, tagGPSLatitude, [2]uint32{40, 1}, [2]uint32{26, 1}, [2]uint32{0, 1})}))
+	f.Add([]byte{})
+	f.Add([]byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00, 0x10, 'E', 'x', 'i', 'f', 0, 0, 'I', 'I'})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// ExtractExif must not panic on any input.
+		_ = ExtractExif(data)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"fmt"
 	"image"
+	"image/color"
 	"image/gif"
 	"image/jpeg"
 	"image/png"
@@ -43,6 +44,17 @@ func DefaultThumbnailOptions() ThumbnailOptions {
 	}
 }
 
+// ThumbnailOptionsForSize returns the default options with both dimensions
+// capped at size. A non-positive size keeps the default maximum.
+func ThumbnailOptionsForSize(size int) ThumbnailOptions {
+	opts := DefaultThumbnailOptions()
+	if size > 0 {
+		opts.MaxWidth = size
+		opts.MaxHeight = size
+	}
+	return opts
+}
+
 // GenerateThumbnail creates a thumbnail from image data.
 // Returns nil if the input is not a supported image format.
 func GenerateThumbnail(data []byte, opts ThumbnailOptions) ([]byte, error) {
@@ -96,6 +108,57 @@ func IsImageMimeType(mimeType string) bool {
 	}
 }
 
+// IsAudioMimeType checks if the MIME type represents an audio recording.
+func IsAudioMimeType(mimeType string) bool {
+	return strings.HasPrefix(strings.ToLower(mimeType), "audio/")
+}
+
+// IsVideoMimeType checks if the MIME type represents a video recording.
+func IsVideoMimeType(mimeType string) bool {
+	return strings.HasPrefix(strings.ToLower(mimeType), "video/")
+}
+
+// GenerateThumbnailForMimeType creates a thumbnail appropriate to the file type.
+// Images are scaled down; videos get a generic play icon, since extracting a
+// poster frame would require a video decoder. Returns nil for any other type.
+func GenerateThumbnailForMimeType(data []byte, mimeType string, opts ThumbnailOptions) ([]byte, error) {
+	switch {
+	case IsImageMimeType(mimeType):
+		return GenerateThumbnail(data, opts)
+	case IsVideoMimeType(mimeType):
+		return encodeImage(videoIcon(opts.MaxWidth, opts.MaxHeight), opts)
+	default:
+		return nil, nil
+	}
+}
+
+// videoIcon draws a light play triangle centered on a dark 16:9 background
+// that fits within maxWidth x maxHeight.
+func videoIcon(maxWidth, maxHeight int) *image.RGBA {
+	w, h := maxWidth, maxWidth*9/16
+	if h > maxHeight {
+		w, h = maxHeight*16/9, maxHeight
+	}
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(img, img.Bounds(), &image.Uniform{color.RGBA{48, 48, 48, 255}}, image.Point{}, draw.Src)
+
+	// Triangle pointing right, one third of the height tall
+	size := h / 3
+	left, top := (w-size)/2, (h-size)/2
+	fg := color.RGBA{230, 230, 230, 255}
+	for y := 0; y < size; y++ {
+		// Width grows to the vertical midpoint, then shrinks
+		span := y
+		if y > size/2 {
+			span = size - y
+		}
+		for x := 0; x < span*2 && x < size; x++ {
+			img.Set(left+x, top+y, fg)
+		}
+	}
+	return img
+}
+
 // fitImage resizes an image to fit within maxWidth x maxHeight while preserving aspect ratio.
 // Uses CatmullRom interpolation for high-quality results.
 func fitImage(img image.Image, maxWidth, maxHeight int) *image.RGBA {
@@ -291,6 +291,46 @@ func TestIsImageMimeType(t *testing.T) {
 	}
 }
 
+func TestGenerateThumbnailForMimeType(t *testing.T) {
+	opts := DefaultThumbnailOptions()
+
+	// Images are scaled like GenerateThumbnail
+	thumb, err := GenerateThumbnailForMimeType(encodeTestImageJPEG(createTestImage(1000, 800)), "image/jpeg", opts)
+	if err != nil || len(thumb) == 0 {
+		t.Fatalf("image thumbnail = %d bytes, err %v; want data", len(thumb), err)
+	}
+
+	// Videos get a play icon regardless of content
+	thumb, err = GenerateThumbnailForMimeType([]byte("not decodable"), "video/mp4", opts)
+	if err != nil {
+		t.Fatalf("video thumbnail error = %v", err)
+	}
+	img, err := jpeg.Decode(bytes.NewReader(thumb))
+	if err != nil {
+		t.Fatalf("video thumbnail is not a JPEG: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() > opts.MaxWidth || b.Dy() > opts.MaxHeight {
+		t.Errorf("video thumbnail %dx%d exceeds %dx%d", b.Dx(), b.Dy(), opts.MaxWidth, opts.MaxHeight)
+	}
+
+	// Audio and documents have none
+	for _, mimeType := range []string{"audio/mpeg", "application/pdf"} {
+		thumb, err = GenerateThumbnailForMimeType([]byte("data"), mimeType, opts)
+		if err != nil || thumb != nil {
+			t.Errorf("%s thumbnail = %d bytes, err %v; want nil", mimeType, len(thumb), err)
+		}
+	}
+}
+
+func TestIsAudioVideoMimeType(t *testing.T) {
+	if !IsAudioMimeType("audio/mpeg") || !IsAudioMimeType("Audio/Wave") || IsAudioMimeType("video/mp4") {
+		t.Error("IsAudioMimeType misclassified a type")
+	}
+	if !IsVideoMimeType("video/mp4") || !IsVideoMimeType("VIDEO/WEBM") || IsVideoMimeType("audio/mpeg") {
+		t.Error("IsVideoMimeType misclassified a type")
+	}
+}
+
 func TestGenerateThumbnail_AspectRatioPreserved(t *testing.T) {
 	// Create a wide image (2:1 aspect ratio)
 	img := createTestImage(600, 300)
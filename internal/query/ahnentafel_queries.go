@@ -15,16 +15,17 @@ import (
 // - Father of person N = 2N
 // - Mother of person N = 2N + 1
 type AhnentafelEntry struct {
-	Number     int             `json:"number"`
-	Generation int             `json:"generation"`
-	ID         uuid.UUID       `json:"id"`
-	GivenName  string          `json:"given_name"`
-	Surname    string          `json:"surname"`
-	Gender     string          `json:"gender,omitempty"`
-	BirthDate  *domain.GenDate `json:"birth_date,omitempty"`
-	BirthPlace *string         `json:"birth_place,omitempty"`
-	DeathDate  *domain.GenDate `json:"death_date,omitempty"`
-	DeathPlace *string         `json:"death_place,omitempty"`
+	Number     int               `json:"number"`
+	Generation int               `json:"generation"`
+	ID         uuid.UUID         `json:"id"`
+	GivenName  string            `json:"given_name"`
+	Surname    string            `json:"surname"`
+	Gender     string            `json:"gender,omitempty"`
+	BirthDate  *domain.GenDate   `json:"birth_date,omitempty"`
+	BirthPlace *string           `json:"birth_place,omitempty"`
+	DeathDate  *domain.GenDate   `json:"death_date,omitempty"`
+	DeathPlace *string           `json:"death_place,omitempty"`
+	Sources    *FactSourceCounts `json:"sources,omitempty"` // Set when sources are requested
 }
 
 // AhnentafelResult contains the complete Ahnentafel report for a person.
@@ -47,7 +48,8 @@ func NewAhnentafelService(pedigreeService *PedigreeService) *AhnentafelService {
 // GetAhnentafelInput contains options for retrieving an Ahnentafel report.
 type GetAhnentafelInput struct {
 	PersonID       uuid.UUID
-	MaxGenerations int // Maximum generations to include (default 5)
+	MaxGenerations int  // Maximum generations to include (default 5)
+	IncludeSources bool // Attach birth and death citation counts to each entry
 }
 
 // GetAhnentafel returns the Ahnentafel (numbered ancestor list) for a person.
@@ -105,6 +107,7 @@ func (s *AhnentafelService) traverseTree(node *PedigreeNode, ahnentafelNum int,
 		BirthPlace: node.BirthPlace,
 		DeathDate:  node.DeathDate,
 		DeathPlace: node.DeathPlace,
+		Sources:    node.Sources,
 	}
 	*entries = append(*entries, entry)
 
@@ -112,3 +115,39 @@ func (s *AhnentafelService) traverseTree(node *PedigreeNode, ahnentafelNum int,
 	s.traverseTree(node.Father, 2*ahnentafelNum, entries)
 	s.traverseTree(node.Mother, 2*ahnentafelNum+1, entries)
 }
+
+// GetEndOfLines returns the "end-of-line" ancestors in a person's pedigree:
+// those with no recorded father or mother, which mark the research frontier.
+// The subject is included when they have no recorded parents. Each entry
+// keeps its Ahnentafel number, so the branch it ends can be identified.
+func (s *AhnentafelService) GetEndOfLines(ctx context.Context, input GetAhnentafelInput) (*AhnentafelResult, error) {
+	result, err := s.GetAhnentafel(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the recorded parents rather than the tree shape: ancestors at the
+	// generation limit, or reached twice through pedigree collapse, have no
+	// parent nodes in the tree but may still have known parents.
+	entries := make([]AhnentafelEntry, 0)
+	maxGen := 0
+	for _, entry := range result.Entries {
+		edge, err := s.pedigreeService.readStore.GetPedigreeEdge(ctx, entry.ID)
+		if err != nil {
+			return nil, err
+		}
+		if edge != nil && (edge.FatherID != nil || edge.MotherID != nil) {
+			continue
+		}
+		entries = append(entries, entry)
+		if entry.Generation > maxGen {
+			maxGen = entry.Generation
+		}
+	}
+
+	return &AhnentafelResult{
+		Entries:       entries,
+		TotalEntries:  len(entries),
+		MaxGeneration: maxGen,
+	}, nil
+}
@@ -660,3 +660,82 @@ func TestNewAhnentafelService(t *testing.T) {
 		t.Error("NewAhnentafelService should return non-nil service")
 	}
 }
+
+func TestGetEndOfLines(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	pedigreeSvc := query.NewPedigreeService(readStore)
+	svc := query.NewAhnentafelService(pedigreeSvc)
+
+	subject, _, _, paternalGF, paternalGM, maternalGF, maternalGM := setupAhnentafelTestData(t, readStore)
+
+	ctx := context.Background()
+	result, err := svc.GetEndOfLines(ctx, query.GetAhnentafelInput{
+		PersonID:       subject,
+		MaxGenerations: 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []uuid.UUID{paternalGF, paternalGM, maternalGF, maternalGM}
+	if result.TotalEntries != len(want) {
+		t.Fatalf("TotalEntries = %d, want %d", result.TotalEntries, len(want))
+	}
+	for i, id := range want {
+		if result.Entries[i].ID != id {
+			t.Errorf("Entries[%d].ID = %v, want %v", i, result.Entries[i].ID, id)
+		}
+		if result.Entries[i].Number != i+4 {
+			t.Errorf("Entries[%d].Number = %d, want %d", i, result.Entries[i].Number, i+4)
+		}
+	}
+	if result.MaxGeneration != 2 {
+		t.Errorf("MaxGeneration = %d, want 2", result.MaxGeneration)
+	}
+}
+
+func TestGetEndOfLines_GenerationLimit(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	pedigreeSvc := query.NewPedigreeService(readStore)
+	svc := query.NewAhnentafelService(pedigreeSvc)
+
+	subject, _, _, _, _, _, _ := setupAhnentafelTestData(t, readStore)
+
+	// Parents cut off by the generation limit still have known parents,
+	// so they are not end-of-line ancestors.
+	result, err := svc.GetEndOfLines(context.Background(), query.GetAhnentafelInput{
+		PersonID:       subject,
+		MaxGenerations: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TotalEntries != 0 {
+		t.Errorf("TotalEntries = %d, want 0", result.TotalEntries)
+	}
+}
+
+func TestGetEndOfLines_NoParents(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	pedigreeSvc := query.NewPedigreeService(readStore)
+	svc := query.NewAhnentafelService(pedigreeSvc)
+
+	ctx := context.Background()
+	loner := uuid.New()
+	if err := readStore.SavePerson(ctx, &repository.PersonReadModel{ID: loner, GivenName: "Solo", Surname: "Person", FullName: "Solo Person"}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := svc.GetEndOfLines(ctx, query.GetAhnentafelInput{PersonID: loner})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.TotalEntries != 1 || result.Entries[0].Number != 1 {
+		t.Errorf("Entries = %+v, want the subject alone", result.Entries)
+	}
+
+	_, err = svc.GetEndOfLines(ctx, query.GetAhnentafelInput{PersonID: uuid.New()})
+	if err != query.ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
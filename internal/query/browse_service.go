@@ -2,15 +2,19 @@ package query
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/cacack/my-family/internal/domain"
 	"github.com/cacack/my-family/internal/repository"
 )
 
-// BrowseService provides queries for browsing surnames and places.
+// BrowseService provides queries for browsing surnames, places, and other indexes.
 type BrowseService struct {
 	readStore repository.ReadModelStore
 }
@@ -57,10 +61,18 @@ type PlaceEntry struct {
 // GetSurnameIndexInput contains the input for GetSurnameIndex.
 type GetSurnameIndexInput struct {
 	Letter string // Optional: filter by starting letter
+
+	// IgnoreSurnamePrefix files and sorts surnames by their main part, so
+	// "van Gogh" is listed under G rather than V
+	IgnoreSurnamePrefix bool
 }
 
 // GetSurnameIndex returns the surname index with optional letter filtering.
 func (s *BrowseService) GetSurnameIndex(ctx context.Context, input GetSurnameIndexInput) (*SurnameIndexResult, error) {
+	if input.IgnoreSurnamePrefix {
+		return s.getSurnameIndexIgnoringPrefix(ctx, input.Letter)
+	}
+
 	if input.Letter != "" {
 		// Get surnames for specific letter
 		entries, err := s.readStore.GetSurnamesByLetter(ctx, input.Letter)
@@ -111,6 +123,66 @@ func (s *BrowseService) GetSurnameIndex(ctx context.Context, input GetSurnameInd
 	}, nil
 }
 
+// getSurnameIndexIgnoringPrefix builds the surname index from the full index,
+// grouping and ordering surnames by domain.SurnameSortKey.
+func (s *BrowseService) getSurnameIndexIgnoringPrefix(ctx context.Context, letter string) (*SurnameIndexResult, error) {
+	entries, _, err := s.readStore.GetSurnameIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type keyedEntry struct {
+		entry SurnameEntry
+		key   string
+	}
+	keyed := make([]keyedEntry, 0, len(entries))
+	letterSurnames := make(map[string]int)
+	for _, e := range entries {
+		key := domain.SurnameSortKey(e.Surname)
+		first := surnameLetter(key)
+		if letter != "" && (first == "" || !strings.EqualFold(first, letter)) {
+			continue
+		}
+		if first != "" {
+			letterSurnames[first]++
+		}
+		keyed = append(keyed, keyedEntry{entry: SurnameEntry{Surname: e.Surname, Count: e.Count}, key: key})
+	}
+	sort.SliceStable(keyed, func(i, j int) bool {
+		if keyed[i].key != keyed[j].key {
+			return keyed[i].key < keyed[j].key
+		}
+		return keyed[i].entry.Surname < keyed[j].entry.Surname
+	})
+
+	items := make([]SurnameEntry, len(keyed))
+	for i, k := range keyed {
+		items[i] = k.entry
+	}
+	result := &SurnameIndexResult{
+		Items: items,
+		Total: len(items),
+	}
+	if letter == "" {
+		result.LetterCounts = make([]LetterCount, 0, len(letterSurnames))
+		for l, count := range letterSurnames {
+			result.LetterCounts = append(result.LetterCounts, LetterCount{Letter: l, Count: count})
+		}
+		sort.Slice(result.LetterCounts, func(i, j int) bool {
+			return result.LetterCounts[i].Letter < result.LetterCounts[j].Letter
+		})
+	}
+	return result, nil
+}
+
+// surnameLetter returns the upper-cased first letter a surname is filed under.
+func surnameLetter(surname string) string {
+	for _, r := range surname {
+		return strings.ToUpper(string(r))
+	}
+	return ""
+}
+
 // GetPersonsBySurnameInput contains the input for GetPersonsBySurname.
 type GetPersonsBySurnameInput struct {
 	Surname string
@@ -240,6 +312,81 @@ func (s *BrowseService) GetPersonsByPlace(ctx context.Context, input GetPersonsB
 	}, nil
 }
 
+// PlaceVariantsResult contains clusters of place strings that appear to name
+// the same place.
+type PlaceVariantsResult struct {
+	Clusters []PlaceVariantCluster `json:"clusters"`
+	Total    int                   `json:"total"`
+}
+
+// PlaceVariantCluster is a group of place strings sharing a variant key.
+type PlaceVariantCluster struct {
+	Suggested string         `json:"suggested"` // Most used variant, preferring the longest on ties
+	Variants  []PlaceVariant `json:"variants"`
+	Count     int            `json:"count"` // Total references across all variants
+}
+
+// PlaceVariant is a place string with its reference count.
+type PlaceVariant struct {
+	Place string `json:"place"`
+	Count int    `json:"count"`
+}
+
+// GetPlaceVariants clusters place strings used by persons, families, and life
+// events by domain.PlaceVariantKey. Only clusters with two or more distinct
+// strings are returned, largest first.
+func (s *BrowseService) GetPlaceVariants(ctx context.Context) (*PlaceVariantsResult, error) {
+	usage, err := s.readStore.ListPlaceUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]*PlaceVariantCluster)
+	var keys []string
+	for _, u := range usage {
+		key := domain.PlaceVariantKey(u.Place)
+		if key == "" {
+			continue
+		}
+		cluster, ok := byKey[key]
+		if !ok {
+			cluster = &PlaceVariantCluster{}
+			byKey[key] = cluster
+			keys = append(keys, key)
+		}
+		cluster.Variants = append(cluster.Variants, PlaceVariant{Place: u.Place, Count: u.Count})
+		cluster.Count += u.Count
+	}
+
+	clusters := make([]PlaceVariantCluster, 0)
+	for _, key := range keys {
+		cluster := byKey[key]
+		if len(cluster.Variants) < 2 {
+			continue
+		}
+		sort.Slice(cluster.Variants, func(i, j int) bool {
+			a, b := cluster.Variants[i], cluster.Variants[j]
+			if a.Count != b.Count {
+				return a.Count > b.Count
+			}
+			if len(a.Place) != len(b.Place) {
+				return len(a.Place) > len(b.Place)
+			}
+			return a.Place < b.Place
+		})
+		cluster.Suggested = cluster.Variants[0].Place
+		clusters = append(clusters, *cluster)
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].Count > clusters[j].Count
+	})
+
+	return &PlaceVariantsResult{
+		Clusters: clusters,
+		Total:    len(clusters),
+	}, nil
+}
+
 // CemeteryIndexResult contains the cemetery index response.
 type CemeteryIndexResult struct {
 	Items []CemeteryEntry `json:"items"`
@@ -318,6 +465,234 @@ func (s *BrowseService) GetPersonsByCemetery(ctx context.Context, input GetPerso
 	}, nil
 }
 
+// UnknownBirthDecade is the decade label for persons without a parseable birth date.
+const UnknownBirthDecade = "unknown"
+
+// ErrInvalidDecade is returned when a decade label cannot be parsed.
+var ErrInvalidDecade = errors.New("invalid decade: expected a year ending in 0 (e.g. 1850 or 1850s) or \"unknown\"")
+
+// BirthDecadeIndexResult contains the birth decade index response.
+type BirthDecadeIndexResult struct {
+	Items []BirthDecadeEntry `json:"items"`
+	Total int                `json:"total"`
+}
+
+// BirthDecadeEntry represents a birth decade with person count.
+type BirthDecadeEntry struct {
+	Decade string `json:"decade"` // e.g. "1850s", or "unknown"
+	Count  int    `json:"count"`
+}
+
+// GetBirthDecadeIndex returns person counts per birth decade.
+func (s *BrowseService) GetBirthDecadeIndex(ctx context.Context) (*BirthDecadeIndexResult, error) {
+	entries, err := s.readStore.GetBirthDecadeIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]BirthDecadeEntry, len(entries))
+	for i, e := range entries {
+		label := UnknownBirthDecade
+		if e.Decade != nil {
+			label = strconv.Itoa(*e.Decade) + "s"
+		}
+		items[i] = BirthDecadeEntry{
+			Decade: label,
+			Count:  e.Count,
+		}
+	}
+
+	return &BirthDecadeIndexResult{
+		Items: items,
+		Total: len(items),
+	}, nil
+}
+
+// GetPersonsByBirthDecadeInput contains the input for GetPersonsByBirthDecade.
+type GetPersonsByBirthDecadeInput struct {
+	Decade string // "1850", "1850s", or "unknown"
+	Limit  int
+	Offset int
+}
+
+// GetPersonsByBirthDecade returns persons born in a decade, ordered by birth date.
+func (s *BrowseService) GetPersonsByBirthDecade(ctx context.Context, input GetPersonsByBirthDecadeInput) (*PersonListResult, error) {
+	decade, err := parseBirthDecade(input.Decade)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply defaults
+	limit := input.Limit
+	if limit <= 0 {
+		limit = 20
+	} else if limit > 100 {
+		limit = 100
+	}
+
+	offset := input.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	opts := repository.ListOptions{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	persons, total, err := s.readStore.GetPersonsByBirthDecade(ctx, decade, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Person, len(persons))
+	for i, p := range persons {
+		items[i] = convertReadModelToPerson(p)
+	}
+
+	return &PersonListResult{
+		Items:  items,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// parseBirthDecade parses a decade label, returning nil for the unknown bucket.
+func parseBirthDecade(label string) (*int, error) {
+	label = strings.ToLower(strings.TrimSpace(label))
+	if label == UnknownBirthDecade {
+		return nil, nil
+	}
+	year, err := strconv.Atoi(strings.TrimSuffix(label, "s"))
+	if err != nil || year < 0 || year%10 != 0 {
+		return nil, ErrInvalidDecade
+	}
+	return &year, nil
+}
+
+// SourceRepositoryIndexResult contains the source repository index response.
+type SourceRepositoryIndexResult struct {
+	Items []SourceRepositoryEntry `json:"items"`
+	Total int                     `json:"total"`
+}
+
+// SourceRepositoryEntry represents a source repository name with source count.
+type SourceRepositoryEntry struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// GetSourceRepositoryIndex returns source repository names with source counts.
+func (s *BrowseService) GetSourceRepositoryIndex(ctx context.Context) (*SourceRepositoryIndexResult, error) {
+	entries, err := s.readStore.GetSourceRepositoryIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]SourceRepositoryEntry, len(entries))
+	for i, e := range entries {
+		items[i] = SourceRepositoryEntry{
+			Name:  e.Name,
+			Count: e.Count,
+		}
+	}
+
+	return &SourceRepositoryIndexResult{
+		Items: items,
+		Total: len(items),
+	}, nil
+}
+
+// FactTypeIndexResult contains the fact types in use.
+type FactTypeIndexResult struct {
+	Items []FactTypeEntry `json:"items"`
+	Total int             `json:"total"`
+}
+
+// FactTypeEntry is a fact type present in the data with its usage counts.
+// Known is false for fact types the application does not define, such as
+// vendor-specific types brought in by an import.
+type FactTypeEntry struct {
+	FactType       string `json:"fact_type"`
+	Known          bool   `json:"known"`
+	Count          int    `json:"count"`
+	EventCount     int    `json:"event_count"`
+	AttributeCount int    `json:"attribute_count"`
+	CitationCount  int    `json:"citation_count"`
+}
+
+// GetFactTypeIndex returns the fact types used by life events, attributes,
+// and citations, ordered by fact type.
+func (s *BrowseService) GetFactTypeIndex(ctx context.Context) (*FactTypeIndexResult, error) {
+	usage, err := s.readStore.ListFactTypeUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]FactTypeEntry, len(usage))
+	for i, u := range usage {
+		items[i] = FactTypeEntry{
+			FactType:       string(u.FactType),
+			Known:          u.FactType.IsValid(),
+			Count:          u.EventCount + u.AttributeCount + u.CitationCount,
+			EventCount:     u.EventCount,
+			AttributeCount: u.AttributeCount,
+			CitationCount:  u.CitationCount,
+		}
+	}
+
+	return &FactTypeIndexResult{
+		Items: items,
+		Total: len(items),
+	}, nil
+}
+
+// GetSourcesByRepositoryInput contains the input for GetSourcesByRepository.
+type GetSourcesByRepositoryInput struct {
+	Name   string
+	Limit  int
+	Offset int
+}
+
+// GetSourcesByRepository returns sources held by a repository, matched by name.
+func (s *BrowseService) GetSourcesByRepository(ctx context.Context, input GetSourcesByRepositoryInput) (*SourceListResult, error) {
+	// Apply defaults
+	limit := input.Limit
+	if limit <= 0 {
+		limit = 20
+	} else if limit > 100 {
+		limit = 100
+	}
+
+	offset := input.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	opts := repository.ListOptions{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	readModels, total, err := s.readStore.GetSourcesByRepositoryName(ctx, input.Name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]Source, len(readModels))
+	for i, rm := range readModels {
+		sources[i] = convertReadModelToSource(rm)
+	}
+
+	return &SourceListResult{
+		Sources: sources,
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
 // MapLocationsResult contains the map locations response.
 type MapLocationsResult struct {
 	Items []MapLocation `json:"items"`
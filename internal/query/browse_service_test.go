@@ -2,6 +2,8 @@ package query_test
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -75,6 +77,57 @@ func TestGetSurnameIndex(t *testing.T) {
 	}
 }
 
+func TestGetSurnameIndex_IgnoreSurnamePrefix(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewBrowseService(readStore)
+	ctx := context.Background()
+
+	for _, surname := range []string{"van Gogh", "Garcia", "de la Cruz", "Vance", "van Gogh"} {
+		if _, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Test", Surname: surname}); err != nil {
+			t.Fatalf("CreatePerson failed: %v", err)
+		}
+	}
+
+	result, err := service.GetSurnameIndex(ctx, query.GetSurnameIndexInput{IgnoreSurnamePrefix: true})
+	if err != nil {
+		t.Fatalf("GetSurnameIndex failed: %v", err)
+	}
+	var surnames []string
+	for _, item := range result.Items {
+		surnames = append(surnames, item.Surname)
+	}
+	want := []string{"de la Cruz", "Garcia", "van Gogh", "Vance"}
+	if !slices.Equal(surnames, want) {
+		t.Errorf("surnames = %v, want %v", surnames, want)
+	}
+	if result.Items[2].Count != 2 {
+		t.Errorf("van Gogh count = %d, want 2", result.Items[2].Count)
+	}
+	wantLetters := []query.LetterCount{{Letter: "C", Count: 1}, {Letter: "G", Count: 2}, {Letter: "V", Count: 1}}
+	if !slices.Equal(result.LetterCounts, wantLetters) {
+		t.Errorf("letter counts = %v, want %v", result.LetterCounts, wantLetters)
+	}
+
+	result, err = service.GetSurnameIndex(ctx, query.GetSurnameIndexInput{Letter: "g", IgnoreSurnamePrefix: true})
+	if err != nil {
+		t.Fatalf("GetSurnameIndex failed: %v", err)
+	}
+	if result.Total != 2 || result.Items[0].Surname != "Garcia" || result.Items[1].Surname != "van Gogh" {
+		t.Errorf("letter G items = %v, want Garcia, van Gogh", result.Items)
+	}
+
+	// Without the option, van Gogh stays under V
+	result, err = service.GetSurnameIndex(ctx, query.GetSurnameIndexInput{Letter: "V"})
+	if err != nil {
+		t.Fatalf("GetSurnameIndex failed: %v", err)
+	}
+	if result.Total != 2 {
+		t.Errorf("letter V total = %d, want 2", result.Total)
+	}
+}
+
 func TestGetSurnamesByLetter(t *testing.T) {
 	eventStore := memory.NewEventStore()
 	readStore := memory.NewReadModelStore()
@@ -843,3 +896,215 @@ func TestGetPersonsByCemetery_LimitCapping(t *testing.T) {
 		t.Errorf("Offset = %d, want 0 (defaulted)", result2.Offset)
 	}
 }
+
+func TestGetBirthDecadeIndex(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewBrowseService(readStore)
+	ctx := context.Background()
+
+	testData := []struct {
+		givenName string
+		birthDate string
+	}{
+		{"John", "12 MAR 1852"},
+		{"Jane", "ABT 1859"},
+		{"Bob", "1861"},
+		{"Alice", ""},
+	}
+
+	for _, td := range testData {
+		_, err := handler.CreatePerson(ctx, command.CreatePersonInput{
+			GivenName: td.givenName,
+			Surname:   "Smith",
+			BirthDate: td.birthDate,
+		})
+		if err != nil {
+			t.Fatalf("CreatePerson failed: %v", err)
+		}
+	}
+
+	result, err := service.GetBirthDecadeIndex(ctx)
+	if err != nil {
+		t.Fatalf("GetBirthDecadeIndex failed: %v", err)
+	}
+
+	want := []query.BirthDecadeEntry{
+		{Decade: "1850s", Count: 2},
+		{Decade: "1860s", Count: 1},
+		{Decade: "unknown", Count: 1},
+	}
+	if result.Total != len(want) {
+		t.Fatalf("Total = %d, want %d", result.Total, len(want))
+	}
+	for i, w := range want {
+		if result.Items[i] != w {
+			t.Errorf("Items[%d] = %+v, want %+v", i, result.Items[i], w)
+		}
+	}
+}
+
+func TestGetPersonsByBirthDecade(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewBrowseService(readStore)
+	ctx := context.Background()
+
+	testData := []struct {
+		givenName string
+		birthDate string
+	}{
+		{"Late", "1859"},
+		{"Early", "2 JAN 1850"},
+		{"Outside", "1860"},
+		{"Undated", ""},
+	}
+
+	for _, td := range testData {
+		_, err := handler.CreatePerson(ctx, command.CreatePersonInput{
+			GivenName: td.givenName,
+			Surname:   "Smith",
+			BirthDate: td.birthDate,
+		})
+		if err != nil {
+			t.Fatalf("CreatePerson failed: %v", err)
+		}
+	}
+
+	for _, label := range []string{"1850s", "1850"} {
+		result, err := service.GetPersonsByBirthDecade(ctx, query.GetPersonsByBirthDecadeInput{Decade: label})
+		if err != nil {
+			t.Fatalf("GetPersonsByBirthDecade(%q) failed: %v", label, err)
+		}
+		if result.Total != 2 {
+			t.Fatalf("GetPersonsByBirthDecade(%q) Total = %d, want 2", label, result.Total)
+		}
+		if result.Items[0].GivenName != "Early" || result.Items[1].GivenName != "Late" {
+			t.Errorf("expected persons in birth order, got %s, %s", result.Items[0].GivenName, result.Items[1].GivenName)
+		}
+	}
+
+	result, err := service.GetPersonsByBirthDecade(ctx, query.GetPersonsByBirthDecadeInput{Decade: "unknown"})
+	if err != nil {
+		t.Fatalf("GetPersonsByBirthDecade(unknown) failed: %v", err)
+	}
+	if result.Total != 1 || result.Items[0].GivenName != "Undated" {
+		t.Errorf("unknown decade = %+v, want only Undated", result.Items)
+	}
+
+	for _, label := range []string{"1855", "abc", ""} {
+		_, err := service.GetPersonsByBirthDecade(ctx, query.GetPersonsByBirthDecadeInput{Decade: label})
+		if !errors.Is(err, query.ErrInvalidDecade) {
+			t.Errorf("GetPersonsByBirthDecade(%q) error = %v, want ErrInvalidDecade", label, err)
+		}
+	}
+}
+
+func TestGetSourceRepositoryIndex(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewBrowseService(readStore)
+	ctx := context.Background()
+
+	testData := []struct {
+		title      string
+		repository string
+	}{
+		{"1850 Census", "National Archives"},
+		{"Naturalization Records", "National Archives"},
+		{"Parish Register", "County Record Office"},
+		{"Family Bible", ""},
+	}
+
+	for _, td := range testData {
+		_, err := handler.CreateSource(ctx, command.CreateSourceInput{
+			SourceType:     "other",
+			Title:          td.title,
+			RepositoryName: td.repository,
+		})
+		if err != nil {
+			t.Fatalf("CreateSource failed: %v", err)
+		}
+	}
+
+	result, err := service.GetSourceRepositoryIndex(ctx)
+	if err != nil {
+		t.Fatalf("GetSourceRepositoryIndex failed: %v", err)
+	}
+
+	want := []query.SourceRepositoryEntry{
+		{Name: "County Record Office", Count: 1},
+		{Name: "National Archives", Count: 2},
+	}
+	if result.Total != len(want) {
+		t.Fatalf("Total = %d, want %d", result.Total, len(want))
+	}
+	for i, w := range want {
+		if result.Items[i] != w {
+			t.Errorf("Items[%d] = %+v, want %+v", i, result.Items[i], w)
+		}
+	}
+
+	sources, err := service.GetSourcesByRepository(ctx, query.GetSourcesByRepositoryInput{Name: "national archives"})
+	if err != nil {
+		t.Fatalf("GetSourcesByRepository failed: %v", err)
+	}
+	if sources.Total != 2 {
+		t.Fatalf("Total = %d, want 2", sources.Total)
+	}
+	if sources.Sources[0].Title != "1850 Census" || sources.Sources[1].Title != "Naturalization Records" {
+		t.Errorf("expected sources sorted by title, got %s, %s", sources.Sources[0].Title, sources.Sources[1].Title)
+	}
+}
+
+func TestGetPlaceVariants(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewBrowseService(readStore)
+	ctx := context.Background()
+
+	testData := []struct {
+		givenName  string
+		birthPlace string
+		deathPlace string
+	}{
+		{"John", "Springfield, IL", "Springfield, Illinois, USA"},
+		{"Jane", "Springfield, IL", ""},
+		{"Bob", "Boston, MA", "Chicago, IL"},
+	}
+
+	for _, td := range testData {
+		_, err := handler.CreatePerson(ctx, command.CreatePersonInput{
+			GivenName:  td.givenName,
+			Surname:    "Smith",
+			BirthPlace: td.birthPlace,
+			DeathPlace: td.deathPlace,
+		})
+		if err != nil {
+			t.Fatalf("CreatePerson failed: %v", err)
+		}
+	}
+
+	result, err := service.GetPlaceVariants(ctx)
+	if err != nil {
+		t.Fatalf("GetPlaceVariants failed: %v", err)
+	}
+
+	if result.Total != 1 {
+		t.Fatalf("Total = %d, want 1", result.Total)
+	}
+	cluster := result.Clusters[0]
+	if cluster.Suggested != "Springfield, IL" {
+		t.Errorf("Suggested = %q, want most used variant", cluster.Suggested)
+	}
+	if cluster.Count != 3 {
+		t.Errorf("Count = %d, want 3", cluster.Count)
+	}
+	if len(cluster.Variants) != 2 || cluster.Variants[1].Place != "Springfield, Illinois, USA" {
+		t.Errorf("Variants = %+v", cluster.Variants)
+	}
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -23,6 +24,7 @@ func NewDescendancyService(readStore repository.ReadModelStore) *DescendancyServ
 type SpouseInfo struct {
 	ID           uuid.UUID       `json:"id"`
 	Name         string          `json:"name"`
+	FamilyID     uuid.UUID       `json:"family_id"` // Family shared with the spouse; children of it carry the same FamilyID
 	MarriageDate *domain.GenDate `json:"marriage_date,omitempty"`
 }
 
@@ -36,6 +38,7 @@ type DescendancyNode struct {
 	DeathDate  *domain.GenDate    `json:"death_date,omitempty"`
 	Spouses    []SpouseInfo       `json:"spouses,omitempty"`
 	Children   []*DescendancyNode `json:"children,omitempty"`
+	FamilyID   *uuid.UUID         `json:"family_id,omitempty"` // Parent's family this node descends through; nil for the root
 	Generation int                `json:"generation"`
 }
 
@@ -44,12 +47,42 @@ type DescendancyResult struct {
 	Root             *DescendancyNode `json:"root"`
 	TotalDescendants int              `json:"total_descendants"`
 	MaxGeneration    int              `json:"max_generation"`
+	GenerationCounts []int            `json:"generation_counts"` // Descendants per generation; index 0 is generation 1 (children)
 }
 
 // GetDescendancyInput contains options for retrieving a descendancy.
 type GetDescendancyInput struct {
 	PersonID       uuid.UUID
-	MaxGenerations int // Maximum generations to traverse (default 4)
+	MaxGenerations int // Maximum generations to traverse (default 4); callers enforce any upper limit
+
+	// Optional filters on descendants. A descendant that fails them is left
+	// out along with its subtree, unless one of its own descendants passes,
+	// in which case it is kept to connect that descendant to the root.
+	// Persons whose birth year or living status cannot be judged are kept.
+	BirthYearFrom *int // Leave out descendants born before this year
+	LivingOnly    bool // Leave out descendants estimated to be deceased (see LivingStatistics)
+}
+
+// descendancyFilter decides which descendants a descendancy keeps.
+type descendancyFilter struct {
+	birthYearFrom *int
+	livingOnly    bool
+	asOfYear      int
+}
+
+// keeps reports whether person passes the filter on their own.
+func (f descendancyFilter) keeps(person *repository.PersonReadModel) bool {
+	if f.birthYearFrom != nil {
+		if year := domain.ParseGenDate(person.BirthDateRaw).Year; year != nil && *year < *f.birthYearFrom {
+			return false
+		}
+	}
+	if f.livingOnly {
+		if deceased, known := estimateDeceased(person, f.asOfYear); known && deceased {
+			return false
+		}
+	}
+	return true
 }
 
 // GetDescendancy returns the descendant tree for a person.
@@ -59,9 +92,6 @@ func (s *DescendancyService) GetDescendancy(ctx context.Context, input GetDescen
 	if maxGen <= 0 {
 		maxGen = 4
 	}
-	if maxGen > 10 {
-		maxGen = 10 // Hard limit to prevent excessive recursion
-	}
 
 	// Get the root person
 	person, err := s.readStore.GetPerson(ctx, input.PersonID)
@@ -74,22 +104,30 @@ func (s *DescendancyService) GetDescendancy(ctx context.Context, input GetDescen
 
 	// Build descendancy tree recursively
 	visited := make(map[uuid.UUID]bool)
-	root := s.buildDescendancyNode(ctx, input.PersonID, 0, maxGen, visited)
+	filter := descendancyFilter{
+		birthYearFrom: input.BirthYearFrom,
+		livingOnly:    input.LivingOnly,
+		asOfYear:      time.Now().Year(),
+	}
+	root := s.buildDescendancyNode(ctx, input.PersonID, 0, maxGen, filter, visited)
 
-	// Count total descendants and max generation
+	// Count total descendants, max generation, and per-generation counts
 	totalDescendants := 0
 	maxGenReached := 0
-	countDescendants(root, &totalDescendants, &maxGenReached)
+	generationCounts := []int{}
+	countDescendants(root, &totalDescendants, &maxGenReached, &generationCounts)
 
 	return &DescendancyResult{
 		Root:             root,
 		TotalDescendants: totalDescendants,
 		MaxGeneration:    maxGenReached,
+		GenerationCounts: generationCounts,
 	}, nil
 }
 
 // buildDescendancyNode recursively builds a descendancy node and its descendants.
-func (s *DescendancyService) buildDescendancyNode(ctx context.Context, personID uuid.UUID, generation, maxGen int, visited map[uuid.UUID]bool) *DescendancyNode {
+// It returns nil for a descendant that filter leaves out.
+func (s *DescendancyService) buildDescendancyNode(ctx context.Context, personID uuid.UUID, generation, maxGen int, filter descendancyFilter, visited map[uuid.UUID]bool) *DescendancyNode {
 	// Check if we've already visited this person (cycle detection)
 	if visited[personID] {
 		return nil
@@ -148,13 +186,20 @@ func (s *DescendancyService) buildDescendancyNode(ctx context.Context, personID
 		}
 
 		for _, child := range children {
-			childNode := s.buildDescendancyNode(ctx, child.PersonID, generation+1, maxGen, visited)
+			childNode := s.buildDescendancyNode(ctx, child.PersonID, generation+1, maxGen, filter, visited)
 			if childNode != nil {
+				familyID := family.ID
+				childNode.FamilyID = &familyID
 				node.Children = append(node.Children, childNode)
 			}
 		}
 	}
 
+	// Keep a filtered-out descendant only when it leads to kept descendants
+	if generation > 0 && len(node.Children) == 0 && !filter.keeps(person) {
+		return nil
+	}
+
 	return node
 }
 
@@ -177,8 +222,9 @@ func (s *DescendancyService) getSpouseInfo(family repository.FamilyReadModel, pe
 	}
 
 	info := &SpouseInfo{
-		ID:   *spouseID,
-		Name: spouseName,
+		ID:       *spouseID,
+		Name:     spouseName,
+		FamilyID: family.ID,
 	}
 
 	// Add marriage date if available
@@ -190,8 +236,9 @@ func (s *DescendancyService) getSpouseInfo(family repository.FamilyReadModel, pe
 	return info
 }
 
-// countDescendants counts total descendants and finds max generation in the tree.
-func countDescendants(node *DescendancyNode, total *int, maxGen *int) {
+// countDescendants counts total descendants, finds max generation, and tallies
+// descendants per generation in the tree.
+func countDescendants(node *DescendancyNode, total *int, maxGen *int, perGen *[]int) {
 	if node == nil {
 		return
 	}
@@ -202,6 +249,10 @@ func countDescendants(node *DescendancyNode, total *int, maxGen *int) {
 
 	for _, child := range node.Children {
 		*total++
-		countDescendants(child, total, maxGen)
+		for len(*perGen) < child.Generation {
+			*perGen = append(*perGen, 0)
+		}
+		(*perGen)[child.Generation-1]++
+		countDescendants(child, total, maxGen, perGen)
 	}
 }
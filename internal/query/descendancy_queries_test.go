@@ -2,6 +2,7 @@ package query_test
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/google/uuid"
@@ -228,6 +229,9 @@ func TestGetDescendancy(t *testing.T) {
 	if result.MaxGeneration != 3 {
 		t.Errorf("MaxGeneration = %d, want 3", result.MaxGeneration)
 	}
+	if want := []int{1, 2, 1}; !slices.Equal(result.GenerationCounts, want) {
+		t.Errorf("GenerationCounts = %v, want %v", result.GenerationCounts, want)
+	}
 }
 
 func TestGetDescendancy_NotFound(t *testing.T) {
@@ -277,6 +281,89 @@ func TestGetDescendancy_MaxGenerations(t *testing.T) {
 	}
 }
 
+// descendantIDs returns the IDs of every descendant below node.
+func descendantIDs(node *query.DescendancyNode) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, child := range node.Children {
+		ids = append(ids, child.ID)
+		ids = append(ids, descendantIDs(child)...)
+	}
+	return ids
+}
+
+func TestGetDescendancy_BirthYearFrom(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	svc := query.NewDescendancyService(readStore)
+
+	grandparent, parent1, _, child1, child2, grandchild := setupDescendancyTestData(t, readStore)
+
+	year := 2010
+	result, err := svc.GetDescendancy(context.Background(), query.GetDescendancyInput{
+		PersonID:      grandparent,
+		BirthYearFrom: &year,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Jenny (2002) is left out; John (1970) and Junior (2000) are kept as the
+	// line leading to Baby (2025).
+	ids := descendantIDs(result.Root)
+	for _, want := range []uuid.UUID{parent1, child1, grandchild} {
+		if !slices.Contains(ids, want) {
+			t.Errorf("descendant %s missing", want)
+		}
+	}
+	if slices.Contains(ids, child2) {
+		t.Error("Jenny should be left out when born before the cutoff")
+	}
+	if result.TotalDescendants != 3 {
+		t.Errorf("TotalDescendants = %d, want 3", result.TotalDescendants)
+	}
+}
+
+func TestGetDescendancy_LivingOnly(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	svc := query.NewDescendancyService(readStore)
+	ctx := context.Background()
+
+	grandparent, _, _, child1, child2, grandchild := setupDescendancyTestData(t, readStore)
+
+	// Jenny has a recorded death and Baby a death place only
+	for id, update := range map[uuid.UUID]func(*repository.PersonReadModel){
+		child2:     func(p *repository.PersonReadModel) { p.DeathDateRaw = "3 MAR 2020" },
+		grandchild: func(p *repository.PersonReadModel) { p.DeathPlace = "Boston, MA" },
+	} {
+		p, err := readStore.GetPerson(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		update(p)
+		if err := readStore.SavePerson(ctx, p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := svc.GetDescendancy(ctx, query.GetDescendancyInput{
+		PersonID:   grandparent,
+		LivingOnly: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := descendantIDs(result.Root)
+	if !slices.Contains(ids, child1) {
+		t.Error("Junior should be kept as a living descendant")
+	}
+	if slices.Contains(ids, child2) || slices.Contains(ids, grandchild) {
+		t.Errorf("deceased descendants should be left out, got %v", ids)
+	}
+	if result.TotalDescendants != 2 {
+		t.Errorf("TotalDescendants = %d, want 2", result.TotalDescendants)
+	}
+}
+
 func TestGetDescendancy_NoDescendants(t *testing.T) {
 	readStore := memory.NewReadModelStore()
 	svc := query.NewDescendancyService(readStore)
@@ -317,6 +404,9 @@ func TestGetDescendancy_NoDescendants(t *testing.T) {
 	if result.MaxGeneration != 0 {
 		t.Errorf("MaxGeneration = %d, want 0", result.MaxGeneration)
 	}
+	if result.GenerationCounts == nil || len(result.GenerationCounts) != 0 {
+		t.Errorf("GenerationCounts = %v, want empty", result.GenerationCounts)
+	}
 }
 
 func TestGetDescendancy_DefaultMaxGenerations(t *testing.T) {
@@ -350,10 +440,10 @@ func TestGetDescendancy_MaxGenerationsHardLimit(t *testing.T) {
 
 	ctx := context.Background()
 
-	// Request with max generations > 10 should be capped to 10
+	// A large generation count traverses only as far as the data goes
 	result, err := svc.GetDescendancy(ctx, query.GetDescendancyInput{
 		PersonID:       grandparent,
-		MaxGenerations: 100, // Should be capped to 10
+		MaxGenerations: 100,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -676,4 +766,23 @@ func TestGetDescendancy_MultipleSpouses(t *testing.T) {
 	if !spouseNames["Spouse2 Test"] {
 		t.Error("Spouse2 should be in spouses list")
 	}
+
+	// Children are grouped per spouse by the family they descend through
+	if result.Root.FamilyID != nil {
+		t.Errorf("Root FamilyID = %v, want nil", result.Root.FamilyID)
+	}
+	spouseByFamily := make(map[uuid.UUID]uuid.UUID)
+	for _, s := range result.Root.Spouses {
+		spouseByFamily[s.FamilyID] = s.ID
+	}
+	wantSpouse := map[uuid.UUID]uuid.UUID{child1: spouse1, child2: spouse2}
+	for _, c := range result.Root.Children {
+		if c.FamilyID == nil {
+			t.Errorf("child %s has no FamilyID", c.GivenName)
+			continue
+		}
+		if got := spouseByFamily[*c.FamilyID]; got != wantSpouse[c.ID] {
+			t.Errorf("child %s grouped under spouse %s, want %s", c.GivenName, got, wantSpouse[c.ID])
+		}
+	}
 }
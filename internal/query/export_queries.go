@@ -3,6 +3,9 @@ package query
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/google/uuid"
 
 	"github.com/cacack/my-family/internal/repository"
 )
@@ -107,3 +110,251 @@ func (s *ExportService) GetEstimate(ctx context.Context) (*ExportEstimate, error
 		IsLargeExport:  isLargeExport,
 	}, nil
 }
+
+// SubtreeMode selects the direction a subtree is walked from its root person.
+type SubtreeMode string
+
+// Supported subtree modes.
+const (
+	SubtreeDescendants SubtreeMode = "descendants"
+	SubtreeAncestors   SubtreeMode = "ancestors"
+)
+
+// IsValid reports whether the mode is supported.
+func (m SubtreeMode) IsValid() bool {
+	return m == SubtreeDescendants || m == SubtreeAncestors
+}
+
+// Subtree is a set of persons and the families connecting them, used to
+// limit an export to part of the tree.
+type Subtree struct {
+	PersonIDs map[uuid.UUID]bool
+	FamilyIDs map[uuid.UUID]bool
+}
+
+// GetSubtree collects the persons and families reachable from a root person.
+// Descendant subtrees hold the root's families, their partners, and every
+// descendant; spouses are included so each family is complete, but their own
+// ancestors and other families are not. Ancestor subtrees hold the root's
+// parents' families and every ancestor, without siblings or collateral lines.
+func (s *ExportService) GetSubtree(ctx context.Context, rootID uuid.UUID, mode SubtreeMode) (*Subtree, error) {
+	if !mode.IsValid() {
+		return nil, fmt.Errorf("unknown subtree mode %q", mode)
+	}
+
+	person, err := s.readStore.GetPerson(ctx, rootID)
+	if err != nil {
+		return nil, err
+	}
+	if person == nil {
+		return nil, ErrNotFound
+	}
+
+	subtree := &Subtree{
+		PersonIDs: map[uuid.UUID]bool{rootID: true},
+		FamilyIDs: make(map[uuid.UUID]bool),
+	}
+
+	// Breadth-first walk; visited guards against cycles in bad data.
+	visited := map[uuid.UUID]bool{rootID: true}
+	queue := []uuid.UUID{rootID}
+	for len(queue) > 0 {
+		personID := queue[0]
+		queue = queue[1:]
+
+		var next []uuid.UUID
+		if mode == SubtreeDescendants {
+			next, err = s.addDescendantFamilies(ctx, personID, subtree)
+		} else {
+			next, err = s.addParentFamily(ctx, personID, subtree)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range next {
+			subtree.PersonIDs[id] = true
+			if !visited[id] {
+				visited[id] = true
+				queue = append(queue, id)
+			}
+		}
+	}
+
+	return subtree, nil
+}
+
+// addDescendantFamilies adds the families the person is a partner in, along
+// with their partners, and returns the children to walk next.
+func (s *ExportService) addDescendantFamilies(ctx context.Context, personID uuid.UUID, subtree *Subtree) ([]uuid.UUID, error) {
+	families, err := s.readStore.GetFamiliesForPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	var children []uuid.UUID
+	for _, family := range families {
+		subtree.FamilyIDs[family.ID] = true
+		if family.Partner1ID != nil {
+			subtree.PersonIDs[*family.Partner1ID] = true
+		}
+		if family.Partner2ID != nil {
+			subtree.PersonIDs[*family.Partner2ID] = true
+		}
+		familyChildren, err := s.readStore.GetFamilyChildren(ctx, family.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range familyChildren {
+			children = append(children, child.PersonID)
+		}
+	}
+	return children, nil
+}
+
+// addParentFamily adds the family the person is a child in and returns its
+// partners to walk next.
+func (s *ExportService) addParentFamily(ctx context.Context, personID uuid.UUID, subtree *Subtree) ([]uuid.UUID, error) {
+	family, err := s.readStore.GetChildFamily(ctx, personID)
+	if err != nil || family == nil {
+		return nil, err
+	}
+	subtree.FamilyIDs[family.ID] = true
+	var parents []uuid.UUID
+	if family.Partner1ID != nil {
+		parents = append(parents, *family.Partner1ID)
+	}
+	if family.Partner2ID != nil {
+		parents = append(parents, *family.Partner2ID)
+	}
+	return parents, nil
+}
+
+// GetSelection returns exactly the given persons plus the families that
+// connect at least two of them, as partners, parent and child, or siblings.
+// Families touching only one selected person are left out. It returns
+// ErrNotFound if any person does not exist.
+func (s *ExportService) GetSelection(ctx context.Context, personIDs []uuid.UUID) (*Subtree, error) {
+	selection := &Subtree{
+		PersonIDs: make(map[uuid.UUID]bool, len(personIDs)),
+		FamilyIDs: make(map[uuid.UUID]bool),
+	}
+	for _, id := range personIDs {
+		person, err := s.readStore.GetPerson(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if person == nil {
+			return nil, fmt.Errorf("%w: person %s", ErrNotFound, id)
+		}
+		selection.PersonIDs[id] = true
+	}
+
+	// Any connecting family has a selected partner or a selected child, so
+	// the candidates are the families each selected person belongs to.
+	candidates := make(map[uuid.UUID]repository.FamilyReadModel)
+	for id := range selection.PersonIDs {
+		families, err := s.readStore.GetFamiliesForPerson(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, family := range families {
+			candidates[family.ID] = family
+		}
+		childFamily, err := s.readStore.GetChildFamily(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if childFamily != nil {
+			candidates[childFamily.ID] = *childFamily
+		}
+	}
+
+	for _, family := range candidates {
+		members := 0
+		if family.Partner1ID != nil && selection.PersonIDs[*family.Partner1ID] {
+			members++
+		}
+		if family.Partner2ID != nil && selection.PersonIDs[*family.Partner2ID] {
+			members++
+		}
+		children, err := s.readStore.GetFamilyChildren(ctx, family.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			if selection.PersonIDs[child.PersonID] {
+				members++
+			}
+		}
+		if members >= 2 {
+			selection.FamilyIDs[family.ID] = true
+		}
+	}
+
+	return selection, nil
+}
+
+// SurnameSubtree is the export subset for one surname line.
+type SurnameSubtree struct {
+	Surname string
+	Subtree
+}
+
+// GetSurnameSubtrees returns one subtree per surname in the surname index,
+// in index order. Each holds everyone bearing the surname, the partners who
+// married into their families, and the families connecting at least two of
+// them, so a line's marriages and parent-child links stay intact. A partner
+// therefore also appears in their own surname's subtree. Persons without a
+// surname are left out.
+func (s *ExportService) GetSurnameSubtrees(ctx context.Context) ([]SurnameSubtree, error) {
+	entries, _, err := s.readStore.GetSurnameIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []SurnameSubtree
+	for _, entry := range entries {
+		if entry.Surname == "" {
+			continue
+		}
+		persons, err := repository.ListAll(ctx, 1000, func(ctx context.Context, opts repository.ListOptions) ([]repository.PersonReadModel, int, error) {
+			return s.readStore.GetPersonsBySurname(ctx, entry.Surname, opts)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		seen := make(map[uuid.UUID]bool)
+		var ids []uuid.UUID
+		add := func(id uuid.UUID) {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		for _, p := range persons {
+			add(p.ID)
+			families, err := s.readStore.GetFamiliesForPerson(ctx, p.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, family := range families {
+				if family.Partner1ID != nil {
+					add(*family.Partner1ID)
+				}
+				if family.Partner2ID != nil {
+					add(*family.Partner2ID)
+				}
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		subtree, err := s.GetSelection(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, SurnameSubtree{Surname: entry.Surname, Subtree: *subtree})
+	}
+	return result, nil
+}
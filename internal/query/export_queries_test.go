@@ -2,6 +2,7 @@ package query_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
@@ -198,3 +199,151 @@ func TestExportService_GetEstimate_ByteCalculation(t *testing.T) {
 		t.Errorf("EstimatedBytes = %d, want %d", estimate.EstimatedBytes, expectedBytes)
 	}
 }
+
+func TestExportService_GetSubtree_Descendants(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	grandparent, parent1, parent2, child1, child2, grandchild := setupDescendancyTestData(t, readStore)
+	svc := query.NewExportService(readStore)
+
+	subtree, err := svc.GetSubtree(context.Background(), parent1, query.SubtreeDescendants)
+	if err != nil {
+		t.Fatalf("GetSubtree failed: %v", err)
+	}
+
+	for _, id := range []uuid.UUID{parent1, parent2, child1, child2, grandchild} {
+		if !subtree.PersonIDs[id] {
+			t.Errorf("expected person %s in descendant subtree", id)
+		}
+	}
+	if subtree.PersonIDs[grandparent] {
+		t.Error("descendant subtree should not include the root's parent")
+	}
+	if len(subtree.PersonIDs) != 5 {
+		t.Errorf("PersonIDs = %d, want 5", len(subtree.PersonIDs))
+	}
+	if len(subtree.FamilyIDs) != 2 {
+		t.Errorf("FamilyIDs = %d, want 2", len(subtree.FamilyIDs))
+	}
+}
+
+func TestExportService_GetSubtree_Ancestors(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	grandparent, parent1, parent2, child1, child2, grandchild := setupDescendancyTestData(t, readStore)
+	svc := query.NewExportService(readStore)
+
+	subtree, err := svc.GetSubtree(context.Background(), grandchild, query.SubtreeAncestors)
+	if err != nil {
+		t.Fatalf("GetSubtree failed: %v", err)
+	}
+
+	for _, id := range []uuid.UUID{grandchild, child1, parent1, parent2, grandparent} {
+		if !subtree.PersonIDs[id] {
+			t.Errorf("expected person %s in ancestor subtree", id)
+		}
+	}
+	if subtree.PersonIDs[child2] {
+		t.Error("ancestor subtree should not include siblings")
+	}
+	if len(subtree.FamilyIDs) != 3 {
+		t.Errorf("FamilyIDs = %d, want 3", len(subtree.FamilyIDs))
+	}
+}
+
+func TestExportService_GetSubtree_NotFound(t *testing.T) {
+	svc := query.NewExportService(memory.NewReadModelStore())
+
+	_, err := svc.GetSubtree(context.Background(), uuid.New(), query.SubtreeDescendants)
+	if !errors.Is(err, query.ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestExportService_GetSelection(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	grandparent, parent1, parent2, child1, child2, _ := setupDescendancyTestData(t, readStore)
+	svc := query.NewExportService(readStore)
+	ctx := context.Background()
+
+	// Siblings share their parents' family; the grandparent connects to
+	// neither of them directly.
+	selection, err := svc.GetSelection(ctx, []uuid.UUID{grandparent, child1, child2})
+	if err != nil {
+		t.Fatalf("GetSelection failed: %v", err)
+	}
+	if len(selection.PersonIDs) != 3 {
+		t.Errorf("PersonIDs = %d, want 3", len(selection.PersonIDs))
+	}
+	if selection.PersonIDs[parent1] || selection.PersonIDs[parent2] {
+		t.Error("selection should not include unselected persons")
+	}
+	if len(selection.FamilyIDs) != 1 {
+		t.Errorf("FamilyIDs = %d, want 1 (the siblings' family)", len(selection.FamilyIDs))
+	}
+
+	// A single person has no connecting families.
+	selection, err = svc.GetSelection(ctx, []uuid.UUID{parent1})
+	if err != nil {
+		t.Fatalf("GetSelection failed: %v", err)
+	}
+	if len(selection.FamilyIDs) != 0 {
+		t.Errorf("FamilyIDs = %d, want 0", len(selection.FamilyIDs))
+	}
+
+	// Parent and child connect through the parent's family.
+	selection, err = svc.GetSelection(ctx, []uuid.UUID{grandparent, parent1, parent2})
+	if err != nil {
+		t.Fatalf("GetSelection failed: %v", err)
+	}
+	if len(selection.FamilyIDs) != 2 {
+		t.Errorf("FamilyIDs = %d, want 2", len(selection.FamilyIDs))
+	}
+}
+
+func TestExportService_GetSelection_NotFound(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	grandparent, _, _, _, _, _ := setupDescendancyTestData(t, readStore)
+	svc := query.NewExportService(readStore)
+
+	_, err := svc.GetSelection(context.Background(), []uuid.UUID{grandparent, uuid.New()})
+	if !errors.Is(err, query.ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestExportService_GetSurnameSubtrees(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	_, parent1, parent2, _, _, _ := setupDescendancyTestData(t, readStore)
+	svc := query.NewExportService(readStore)
+
+	subtrees, err := svc.GetSurnameSubtrees(context.Background())
+	if err != nil {
+		t.Fatalf("GetSurnameSubtrees failed: %v", err)
+	}
+	if len(subtrees) != 2 {
+		t.Fatalf("len(subtrees) = %d, want 2", len(subtrees))
+	}
+
+	// Doe: Jane plus the husband she married, joined by their family
+	doe := subtrees[0]
+	if doe.Surname != "Doe" {
+		t.Errorf("subtrees[0].Surname = %q, want Doe", doe.Surname)
+	}
+	if len(doe.PersonIDs) != 2 || !doe.PersonIDs[parent1] || !doe.PersonIDs[parent2] {
+		t.Errorf("Doe PersonIDs = %v, want Jane and John", doe.PersonIDs)
+	}
+	if len(doe.FamilyIDs) != 1 {
+		t.Errorf("Doe FamilyIDs = %d, want 1", len(doe.FamilyIDs))
+	}
+
+	// Smith: all five Smiths, Jane as a married-in spouse, and every family
+	smith := subtrees[1]
+	if smith.Surname != "Smith" {
+		t.Errorf("subtrees[1].Surname = %q, want Smith", smith.Surname)
+	}
+	if len(smith.PersonIDs) != 6 || !smith.PersonIDs[parent2] {
+		t.Errorf("Smith PersonIDs = %d, want 6 including the spouse", len(smith.PersonIDs))
+	}
+	if len(smith.FamilyIDs) != 3 {
+		t.Errorf("Smith FamilyIDs = %d, want 3", len(smith.FamilyIDs))
+	}
+}
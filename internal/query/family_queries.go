@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -31,7 +32,10 @@ type Family struct {
 	RelationshipType  *string         `json:"relationship_type,omitempty"`
 	MarriageDate      *domain.GenDate `json:"marriage_date,omitempty"`
 	MarriagePlace     *string         `json:"marriage_place,omitempty"`
+	ResearchStatus    *string         `json:"research_status,omitempty"`
 	ChildCount        int             `json:"child_count"`
+	CreatedAt         *time.Time      `json:"created_at,omitempty"`
+	UpdatedAt         time.Time       `json:"updated_at"`
 	Version           int64           `json:"version"`
 }
 
@@ -77,6 +81,9 @@ func (s *FamilyService) ListFamilies(ctx context.Context, input ListFamiliesInpu
 	if opts.Limit > 100 {
 		opts.Limit = 100
 	}
+	if opts.Offset < 0 {
+		opts.Offset = 0
+	}
 
 	readModels, total, err := s.readStore.ListFamilies(ctx, opts)
 	if err != nil {
@@ -96,6 +103,25 @@ func (s *FamilyService) ListFamilies(ctx context.Context, input ListFamiliesInpu
 	}, nil
 }
 
+// SearchFamilies searches for families by partner name.
+func (s *FamilyService) SearchFamilies(ctx context.Context, query string, limit int) ([]FamilySummary, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+
+	readModels, err := s.readStore.SearchFamilies(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	families := make([]FamilySummary, len(readModels))
+	for i, rm := range readModels {
+		families[i] = convertToFamilySummary(rm)
+	}
+
+	return families, nil
+}
+
 // GetFamily returns a family by ID with children.
 func (s *FamilyService) GetFamily(ctx context.Context, id uuid.UUID) (*FamilyDetail, error) {
 	rm, err := s.readStore.GetFamily(ctx, id)
@@ -164,9 +190,15 @@ func convertReadModelToFamily(rm repository.FamilyReadModel) Family {
 		Partner1ID: rm.Partner1ID,
 		Partner2ID: rm.Partner2ID,
 		ChildCount: rm.ChildCount,
+		UpdatedAt:  rm.UpdatedAt,
 		Version:    rm.Version,
 	}
 
+	if !rm.CreatedAt.IsZero() {
+		createdAt := rm.CreatedAt
+		f.CreatedAt = &createdAt
+	}
+
 	if rm.Partner1GivenName != "" {
 		v := rm.Partner1GivenName
 		f.Partner1GivenName = &v
@@ -194,6 +226,10 @@ func convertReadModelToFamily(rm repository.FamilyReadModel) Family {
 	if rm.MarriagePlace != "" {
 		f.MarriagePlace = &rm.MarriagePlace
 	}
+	if rm.ResearchStatus != "" {
+		rs := string(rm.ResearchStatus)
+		f.ResearchStatus = &rs
+	}
 
 	return f
 }
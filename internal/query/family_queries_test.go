@@ -161,6 +161,53 @@ func TestGetFamily_NotFound(t *testing.T) {
 	}
 }
 
+func TestSearchFamilies(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewFamilyService(readStore)
+	ctx := context.Background()
+
+	john, _ := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	jane, _ := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Jane", Surname: "Smith"})
+	mary, _ := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Mary", Surname: "Jones"})
+
+	_, _ = handler.CreateFamily(ctx, command.CreateFamilyInput{Partner1ID: &john.ID, Partner2ID: &jane.ID})
+	_, _ = handler.CreateFamily(ctx, command.CreateFamilyInput{Partner1ID: &john.ID, Partner2ID: &mary.ID})
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"doe", 2},
+		{"Smith", 1},
+		{"doe smith", 1}, // one surname from each partner
+		{"jane jones", 0},
+		{"miller", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			families, err := service.SearchFamilies(ctx, tt.query, 0)
+			if err != nil {
+				t.Fatalf("SearchFamilies failed: %v", err)
+			}
+			if len(families) != tt.want {
+				t.Errorf("len = %d, want %d", len(families), tt.want)
+			}
+		})
+	}
+
+	families, _ := service.SearchFamilies(ctx, "smith", 0)
+	if len(families) == 1 {
+		if families[0].Partner1Name == nil || *families[0].Partner1Name != "John Doe" {
+			t.Errorf("Partner1Name = %v, want John Doe", families[0].Partner1Name)
+		}
+		if families[0].Partner2Name == nil || *families[0].Partner2Name != "Jane Smith" {
+			t.Errorf("Partner2Name = %v, want Jane Smith", families[0].Partner2Name)
+		}
+	}
+}
+
 func TestGetFamiliesForPerson(t *testing.T) {
 	eventStore := memory.NewEventStore()
 	readStore := memory.NewReadModelStore()
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,6 +18,7 @@ import (
 type HistoryService struct {
 	eventStore repository.EventStore
 	readStore  repository.ReadModelStore
+	rollback   *RollbackService // reconstructs prior state for old values
 }
 
 // NewHistoryService creates a new history query service.
@@ -24,6 +26,7 @@ func NewHistoryService(eventStore repository.EventStore, readStore repository.Re
 	return &HistoryService{
 		eventStore: eventStore,
 		readStore:  readStore,
+		rollback:   NewRollbackService(eventStore, readStore),
 	}
 }
 
@@ -35,6 +38,7 @@ type ChangeEntry struct {
 	EntityID   uuid.UUID              `json:"entity_id"`
 	EntityName string                 `json:"entity_name"` // e.g., "John Smith"
 	Action     string                 `json:"action"`      // "created", "updated", "deleted"
+	Summary    string                 `json:"summary"`     // e.g., "updated birth_place, notes"
 	Changes    map[string]FieldChange `json:"changes,omitempty"`
 	UserID     *string                `json:"user_id,omitempty"`
 }
@@ -59,10 +63,35 @@ type GetGlobalHistoryInput struct {
 	FromTime   time.Time
 	ToTime     time.Time
 	EventTypes []string
+	Action     string // Filter by action: created, updated, deleted, linked, unlinked
 	Limit      int
 	Offset     int
 }
 
+// historyEventTypes lists the event types that appear in change history.
+var historyEventTypes = []string{
+	"PersonCreated", "PersonUpdated", "PersonDeleted",
+	"FamilyCreated", "FamilyUpdated", "FamilyDeleted",
+	"ChildLinkedToFamily", "ChildUnlinkedFromFamily",
+	"SourceCreated", "SourceUpdated", "SourceDeleted",
+	"CitationCreated", "CitationUpdated", "CitationDeleted",
+}
+
+// eventTypesForAction narrows eventTypes (or all history event types when
+// empty) to those whose action matches.
+func eventTypesForAction(eventTypes []string, action string) []string {
+	if len(eventTypes) == 0 {
+		eventTypes = historyEventTypes
+	}
+	var matched []string
+	for _, et := range eventTypes {
+		if eventTypeToAction(et) == action {
+			matched = append(matched, et)
+		}
+	}
+	return matched
+}
+
 // GetEntityHistory retrieves the change history for a specific entity.
 func (s *HistoryService) GetEntityHistory(ctx context.Context, entityType string, entityID uuid.UUID, limit, offset int) (*ChangeHistoryResult, error) {
 	// Validate inputs
@@ -110,8 +139,21 @@ func (s *HistoryService) GetGlobalHistory(ctx context.Context, input GetGlobalHi
 		input.Offset = 0
 	}
 
+	eventTypes := input.EventTypes
+	if input.Action != "" {
+		eventTypes = eventTypesForAction(eventTypes, input.Action)
+		// An empty list would match every event, so no matching types means no history
+		if len(eventTypes) == 0 {
+			return &ChangeHistoryResult{
+				Entries: []ChangeEntry{},
+				Limit:   input.Limit,
+				Offset:  input.Offset,
+			}, nil
+		}
+	}
+
 	// Read events from event store
-	page, err := s.eventStore.ReadGlobalByTime(ctx, input.FromTime, input.ToTime, input.EventTypes, input.Limit, input.Offset)
+	page, err := s.eventStore.ReadGlobalByTime(ctx, input.FromTime, input.ToTime, eventTypes, input.Limit, input.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("reading global history: %w", err)
 	}
@@ -131,6 +173,177 @@ func (s *HistoryService) GetGlobalHistory(ctx context.Context, input GetGlobalHi
 	}, nil
 }
 
+// recentReadBatch is how many events GetRecentChanges reads from the event log at a time.
+const recentReadBatch = 1000
+
+// GetRecentChanges returns the most recently changed entities across the tree,
+// newest first, with one entry per entity describing its latest change.
+// Field-level changes are left out to keep the feed compact.
+func (s *HistoryService) GetRecentChanges(ctx context.Context, limit int) ([]ChangeEntry, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	tracked := make(map[string]bool, len(historyEventTypes))
+	for _, et := range historyEventTypes {
+		tracked[et] = true
+	}
+
+	// Keep the last event of each entity's stream
+	latest := make(map[uuid.UUID]repository.StoredEvent)
+	var position int64
+	for {
+		events, err := s.eventStore.ReadAll(ctx, position, recentReadBatch)
+		if err != nil {
+			return nil, fmt.Errorf("reading event log: %w", err)
+		}
+		for _, evt := range events {
+			if tracked[evt.EventType] {
+				latest[evt.StreamID] = evt
+			}
+			position = evt.Position
+		}
+		if len(events) < recentReadBatch {
+			break
+		}
+	}
+
+	recent := make([]repository.StoredEvent, 0, len(latest))
+	for _, evt := range latest {
+		recent = append(recent, evt)
+	}
+	sort.Slice(recent, func(i, j int) bool {
+		return recent[i].Position > recent[j].Position
+	})
+	if len(recent) > limit {
+		recent = recent[:limit]
+	}
+
+	entries, err := s.transformStoredEvents(ctx, recent)
+	if err != nil {
+		return nil, fmt.Errorf("transforming events: %w", err)
+	}
+	for i := range entries {
+		entries[i].Changes = nil
+	}
+	return entries, nil
+}
+
+// DeletedEntry is an entity whose latest change deleted it, and which can
+// still be restored.
+type DeletedEntry struct {
+	EntityType string    `json:"entity_type"` // "person", "family", "source", "citation"
+	EntityID   uuid.UUID `json:"entity_id"`
+	EntityName string    `json:"entity_name"`
+	DeletedAt  time.Time `json:"deleted_at"`
+	Reason     string    `json:"reason,omitempty"`
+	UserID     *string   `json:"user_id,omitempty"`
+}
+
+// DeletedEntriesResult contains paginated deleted entities.
+type DeletedEntriesResult struct {
+	Entries    []DeletedEntry `json:"entries"`
+	TotalCount int            `json:"total_count"`
+	Limit      int            `json:"limit"`
+	Offset     int            `json:"offset"`
+}
+
+// ListDeleted returns the entities whose latest change deleted them, most
+// recently deleted first. Entities that were deleted and later recreated are
+// not included.
+func (s *HistoryService) ListDeleted(ctx context.Context, limit, offset int) (*DeletedEntriesResult, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	tracked := make(map[string]bool, len(historyEventTypes))
+	for _, et := range historyEventTypes {
+		tracked[et] = true
+	}
+
+	// Keep the last event and last creation of each entity's stream
+	latest := make(map[uuid.UUID]repository.StoredEvent)
+	creations := make(map[uuid.UUID]repository.StoredEvent)
+	var position int64
+	for {
+		events, err := s.eventStore.ReadAll(ctx, position, recentReadBatch)
+		if err != nil {
+			return nil, fmt.Errorf("reading event log: %w", err)
+		}
+		for _, evt := range events {
+			if tracked[evt.EventType] {
+				latest[evt.StreamID] = evt
+				if eventTypeToAction(evt.EventType) == "created" {
+					creations[evt.StreamID] = evt
+				}
+			}
+			position = evt.Position
+		}
+		if len(events) < recentReadBatch {
+			break
+		}
+	}
+
+	var deletions []repository.StoredEvent
+	for _, evt := range latest {
+		if eventTypeToAction(evt.EventType) == "deleted" {
+			deletions = append(deletions, evt)
+		}
+	}
+	sort.Slice(deletions, func(i, j int) bool {
+		return deletions[i].Position > deletions[j].Position
+	})
+
+	total := len(deletions)
+	end := offset + limit
+	if offset > total {
+		offset = total
+	}
+	if end > total {
+		end = total
+	}
+
+	entries := make([]DeletedEntry, 0, end-offset)
+	for _, evt := range deletions[offset:end] {
+		entityType, _ := s.mapEventTypeToEntityAndAction(evt.EventType)
+		entry := DeletedEntry{
+			EntityType: entityType,
+			EntityID:   evt.StreamID,
+			DeletedAt:  evt.Timestamp,
+		}
+		// The read model no longer has the entity, so names come from its creation
+		creation := creations[evt.StreamID]
+		entry.EntityName = s.getEntityName(ctx, entityType, evt.StreamID, &creation)
+
+		var deleted struct {
+			Reason string `json:"reason"`
+		}
+		if err := json.Unmarshal(evt.Data, &deleted); err == nil {
+			entry.Reason = deleted.Reason
+		}
+		if actor := evt.Actor(); actor != "" {
+			entry.UserID = &actor
+		}
+		entries = append(entries, entry)
+	}
+
+	return &DeletedEntriesResult{
+		Entries:    entries,
+		TotalCount: total,
+		Limit:      limit,
+		Offset:     offset,
+	}, nil
+}
+
 // transformStoredEvents converts raw StoredEvents to user-friendly ChangeEntries.
 func (s *HistoryService) transformStoredEvents(ctx context.Context, events []repository.StoredEvent) ([]ChangeEntry, error) {
 	entries := make([]ChangeEntry, 0, len(events))
@@ -152,6 +365,7 @@ func (s *HistoryService) transformStoredEvents(ctx context.Context, events []rep
 
 		entry.EntityType = entityType
 		entry.Action = action
+		entry.Summary = buildChangeSummary(evt)
 
 		// Extract changes for update events
 		if action == "updated" {
@@ -161,12 +375,9 @@ func (s *HistoryService) transformStoredEvents(ctx context.Context, events []rep
 			}
 		}
 
-		// Extract user ID from metadata if present
-		if len(evt.Metadata) > 0 {
-			var metadata domain.EventMetadata
-			if err := json.Unmarshal(evt.Metadata, &metadata); err == nil && metadata.UserID != "" {
-				entry.UserID = &metadata.UserID
-			}
+		// Attribute the change to its actor, if recorded
+		if actor := evt.Actor(); actor != "" {
+			entry.UserID = &actor
 		}
 
 		// Enrich with entity name from read model
@@ -228,13 +439,13 @@ func (s *HistoryService) extractChanges(ctx context.Context, evt repository.Stor
 	// Extract changes based on event type
 	switch e := domainEvent.(type) {
 	case domain.PersonUpdated:
-		return s.convertChangesMap(e.Changes), nil
+		return s.convertChangesMap(e.Changes, s.priorState(ctx, evt)), nil
 	case domain.FamilyUpdated:
-		return s.convertChangesMap(e.Changes), nil
+		return s.convertChangesMap(e.Changes, s.priorState(ctx, evt)), nil
 	case domain.SourceUpdated:
-		return s.convertChangesMap(e.Changes), nil
+		return s.convertChangesMap(e.Changes, s.priorState(ctx, evt)), nil
 	case domain.CitationUpdated:
-		return s.convertChangesMap(e.Changes), nil
+		return s.convertChangesMap(e.Changes, s.priorState(ctx, evt)), nil
 	case domain.ChildLinkedToFamily:
 		childName := s.getPersonName(ctx, e.PersonID, nil)
 		return map[string]FieldChange{
@@ -250,20 +461,33 @@ func (s *HistoryService) extractChanges(ctx context.Context, evt repository.Stor
 	}
 }
 
-// convertChangesMap converts domain event changes to FieldChange map.
-func (s *HistoryService) convertChangesMap(changes map[string]any) map[string]FieldChange {
+// convertChangesMap converts domain event changes to FieldChange map, taking
+// old values from the entity's state before the change.
+func (s *HistoryService) convertChangesMap(changes map[string]any, prior map[string]any) map[string]FieldChange {
 	result := make(map[string]FieldChange)
 	for field, value := range changes {
-		// Handle different change representations
-		// For now, we assume the value is the new value
-		// A more sophisticated implementation would track old/new pairs
 		result[field] = FieldChange{
+			OldValue: prior[field],
 			NewValue: value,
 		}
 	}
 	return result
 }
 
+// priorState reconstructs an entity's fields as they were just before evt.
+// It returns nil when the state cannot be recovered, leaving old values unset.
+func (s *HistoryService) priorState(ctx context.Context, evt repository.StoredEvent) map[string]any {
+	if evt.Version <= 1 {
+		return nil
+	}
+	entityType, _ := s.mapEventTypeToEntityAndAction(evt.EventType)
+	state, err := s.rollback.GetStateAtVersion(ctx, entityType, evt.StreamID, evt.Version-1)
+	if err != nil {
+		return nil
+	}
+	return state.State
+}
+
 // getEntityName looks up the display name for an entity from the read model.
 func (s *HistoryService) getEntityName(ctx context.Context, entityType string, entityID uuid.UUID, evt *repository.StoredEvent) string {
 	switch entityType {
@@ -16,6 +16,8 @@ import (
 
 // mockEventStore implements repository.EventStore for testing.
 type mockEventStore struct {
+	readAllFunc          func(ctx context.Context, fromPosition int64, limit int) ([]repository.StoredEvent, error)
+	readStreamFunc       func(ctx context.Context, streamID uuid.UUID) ([]repository.StoredEvent, error)
 	readByStreamFunc     func(ctx context.Context, streamID uuid.UUID, limit, offset int) (*repository.HistoryPage, error)
 	readGlobalByTimeFunc func(ctx context.Context, fromTime, toTime time.Time, eventTypes []string, limit, offset int) (*repository.HistoryPage, error)
 }
@@ -25,10 +27,16 @@ func (m *mockEventStore) Append(ctx context.Context, streamID uuid.UUID, streamT
 }
 
 func (m *mockEventStore) ReadStream(ctx context.Context, streamID uuid.UUID) ([]repository.StoredEvent, error) {
+	if m.readStreamFunc != nil {
+		return m.readStreamFunc(ctx, streamID)
+	}
 	return nil, nil
 }
 
 func (m *mockEventStore) ReadAll(ctx context.Context, fromPosition int64, limit int) ([]repository.StoredEvent, error) {
+	if m.readAllFunc != nil {
+		return m.readAllFunc(ctx, fromPosition, limit)
+	}
 	return nil, nil
 }
 
@@ -144,6 +152,9 @@ func (m *mockReadModelStore) ListFamilies(ctx context.Context, opts repository.L
 func (m *mockReadModelStore) GetFamiliesForPerson(ctx context.Context, personID uuid.UUID) ([]repository.FamilyReadModel, error) {
 	return nil, nil
 }
+func (m *mockReadModelStore) SearchFamilies(ctx context.Context, query string, limit int) ([]repository.FamilyReadModel, error) {
+	return nil, nil
+}
 func (m *mockReadModelStore) SaveFamily(ctx context.Context, family *repository.FamilyReadModel) error {
 	return nil
 }
@@ -189,6 +200,9 @@ func (m *mockReadModelStore) DeleteSource(ctx context.Context, id uuid.UUID) err
 func (m *mockReadModelStore) ListCitations(ctx context.Context, opts repository.ListOptions) ([]repository.CitationReadModel, int, error) {
 	return nil, 0, nil
 }
+func (m *mockReadModelStore) ListCitationsForSource(ctx context.Context, sourceID uuid.UUID, filter repository.CitationFilter, opts repository.ListOptions) ([]repository.CitationReadModel, int, error) {
+	return nil, 0, nil
+}
 func (m *mockReadModelStore) GetCitationsForSource(ctx context.Context, sourceID uuid.UUID) ([]repository.CitationReadModel, error) {
 	return nil, nil
 }
@@ -215,6 +229,12 @@ func (m *mockReadModelStore) GetMediaWithData(ctx context.Context, id uuid.UUID)
 func (m *mockReadModelStore) GetMediaThumbnail(ctx context.Context, id uuid.UUID) ([]byte, error) {
 	return nil, nil
 }
+func (m *mockReadModelStore) FindMediaByContentHash(ctx context.Context, hash string) (*repository.MediaReadModel, error) {
+	return nil, nil
+}
+func (m *mockReadModelStore) ListDuplicateMedia(ctx context.Context) ([]repository.MediaDuplicateGroup, error) {
+	return nil, nil
+}
 func (m *mockReadModelStore) ListMediaForEntity(ctx context.Context, entityType string, entityID uuid.UUID, opts repository.ListOptions) ([]repository.MediaReadModel, int, error) {
 	return nil, 0, nil
 }
@@ -284,6 +304,27 @@ func (m *mockReadModelStore) GetCemeteryIndex(ctx context.Context) ([]repository
 func (m *mockReadModelStore) GetPersonsByCemetery(ctx context.Context, place string, opts repository.ListOptions) ([]repository.PersonReadModel, int, error) {
 	return nil, 0, nil
 }
+func (m *mockReadModelStore) GetBirthDecadeIndex(ctx context.Context) ([]repository.BirthDecadeEntry, error) {
+	return nil, nil
+}
+func (m *mockReadModelStore) GetPersonsByBirthDecade(ctx context.Context, decade *int, opts repository.ListOptions) ([]repository.PersonReadModel, int, error) {
+	return nil, 0, nil
+}
+func (m *mockReadModelStore) GetSourceRepositoryIndex(ctx context.Context) ([]repository.SourceRepositoryEntry, error) {
+	return nil, nil
+}
+func (m *mockReadModelStore) GetSourcesByRepositoryName(ctx context.Context, name string, opts repository.ListOptions) ([]repository.SourceReadModel, int, error) {
+	return nil, 0, nil
+}
+func (m *mockReadModelStore) ListFactTypeUsage(ctx context.Context) ([]repository.FactTypeUsage, error) {
+	return nil, nil
+}
+func (m *mockReadModelStore) ListPlaceUsage(ctx context.Context) ([]repository.PlaceUsage, error) {
+	return nil, nil
+}
+func (m *mockReadModelStore) FindPlaceReferences(ctx context.Context, place string) ([]repository.PlaceReference, error) {
+	return nil, nil
+}
 func (m *mockReadModelStore) GetMapLocations(ctx context.Context) ([]repository.MapLocation, error) {
 	return nil, nil
 }
@@ -306,6 +347,9 @@ func (m *mockReadModelStore) GetNote(ctx context.Context, id uuid.UUID) (*reposi
 func (m *mockReadModelStore) ListNotes(ctx context.Context, opts repository.ListOptions) ([]repository.NoteReadModel, int, error) {
 	return nil, 0, nil
 }
+func (m *mockReadModelStore) ListNotesForEntity(ctx context.Context, entityID uuid.UUID) ([]repository.NoteReadModel, error) {
+	return nil, nil
+}
 func (m *mockReadModelStore) SaveNote(ctx context.Context, note *repository.NoteReadModel) error {
 	return nil
 }
@@ -708,6 +752,157 @@ func TestGetGlobalHistory(t *testing.T) {
 	}
 }
 
+func TestGetGlobalHistory_ActionFilter(t *testing.T) {
+	tests := []struct {
+		name          string
+		eventTypes    []string
+		action        string
+		wantQueried   []string
+		wantEventRead bool
+	}{
+		{
+			name:          "deletions across all entity types",
+			action:        "deleted",
+			wantQueried:   []string{"PersonDeleted", "FamilyDeleted", "SourceDeleted", "CitationDeleted"},
+			wantEventRead: true,
+		},
+		{
+			name:          "narrows entity type filter",
+			eventTypes:    []string{"FamilyCreated", "FamilyUpdated", "FamilyDeleted", "ChildLinkedToFamily", "ChildUnlinkedFromFamily"},
+			action:        "linked",
+			wantQueried:   []string{"ChildLinkedToFamily"},
+			wantEventRead: true,
+		},
+		{
+			name:       "no matching event types",
+			eventTypes: []string{"PersonCreated", "PersonUpdated", "PersonDeleted"},
+			action:     "unlinked",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queried []string
+			called := false
+			eventStore := &mockEventStore{
+				readGlobalByTimeFunc: func(ctx context.Context, fromTime, toTime time.Time, eventTypes []string, limit, offset int) (*repository.HistoryPage, error) {
+					called = true
+					queried = eventTypes
+					return &repository.HistoryPage{}, nil
+				},
+			}
+
+			service := NewHistoryService(eventStore, &mockReadModelStore{})
+			result, err := service.GetGlobalHistory(context.Background(), GetGlobalHistoryInput{
+				ToTime:     time.Now(),
+				EventTypes: tt.eventTypes,
+				Action:     tt.action,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEventRead, called)
+			assert.Equal(t, tt.wantQueried, queried)
+			assert.Empty(t, result.Entries)
+		})
+	}
+}
+
+func TestGetRecentChanges(t *testing.T) {
+	personID := uuid.New()
+	sourceID := uuid.New()
+	base := time.Now().Add(-time.Hour).UTC()
+
+	log := []repository.StoredEvent{
+		{ID: uuid.New(), StreamID: personID, EventType: "PersonCreated", Data: json.RawMessage(`{}`), Position: 1, Timestamp: base},
+		{ID: uuid.New(), StreamID: sourceID, EventType: "SourceCreated", Data: json.RawMessage(`{}`), Position: 2, Timestamp: base.Add(time.Minute)},
+		{ID: uuid.New(), StreamID: uuid.New(), EventType: "GedcomImported", Data: json.RawMessage(`{}`), Position: 3, Timestamp: base.Add(2 * time.Minute)},
+		{ID: uuid.New(), StreamID: personID, EventType: "PersonUpdated", Data: json.RawMessage(`{"changes":{"notes":"x"}}`), Position: 4, Timestamp: base.Add(3 * time.Minute)},
+	}
+
+	var reads []int64
+	eventStore := &mockEventStore{
+		readAllFunc: func(ctx context.Context, fromPosition int64, limit int) ([]repository.StoredEvent, error) {
+			reads = append(reads, fromPosition)
+			var out []repository.StoredEvent
+			for _, evt := range log {
+				if evt.Position > fromPosition && len(out) < limit {
+					out = append(out, evt)
+				}
+			}
+			return out, nil
+		},
+	}
+	service := NewHistoryService(eventStore, &mockReadModelStore{})
+
+	entries, err := service.GetRecentChanges(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{0}, reads)
+	require.Len(t, entries, 2)
+	assert.Equal(t, personID, entries[0].EntityID)
+	assert.Equal(t, "updated", entries[0].Action)
+	assert.Nil(t, entries[0].Changes)
+	assert.Equal(t, sourceID, entries[1].EntityID)
+	assert.Equal(t, "created", entries[1].Action)
+
+	entries, err = service.GetRecentChanges(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, personID, entries[0].EntityID)
+}
+
+func TestListDeleted(t *testing.T) {
+	deletedID := uuid.New()
+	recreatedID := uuid.New()
+	liveID := uuid.New()
+	base := time.Now().Add(-time.Hour).UTC()
+
+	created, _ := json.Marshal(domain.NewPersonCreated(&domain.Person{ID: deletedID, GivenName: "John", Surname: "Smith"}))
+	deleted, _ := json.Marshal(domain.NewPersonDeleted(deletedID, "duplicate"))
+	log := []repository.StoredEvent{
+		{ID: uuid.New(), StreamID: deletedID, EventType: "PersonCreated", Data: created, Position: 1, Timestamp: base},
+		{ID: uuid.New(), StreamID: recreatedID, EventType: "SourceCreated", Data: json.RawMessage(`{}`), Position: 2, Timestamp: base},
+		{ID: uuid.New(), StreamID: liveID, EventType: "CitationCreated", Data: json.RawMessage(`{}`), Position: 3, Timestamp: base},
+		{ID: uuid.New(), StreamID: recreatedID, EventType: "SourceDeleted", Data: json.RawMessage(`{}`), Position: 4, Timestamp: base.Add(time.Minute)},
+		{ID: uuid.New(), StreamID: deletedID, EventType: "PersonDeleted", Data: deleted, Position: 5, Timestamp: base.Add(2 * time.Minute)},
+		{ID: uuid.New(), StreamID: recreatedID, EventType: "SourceCreated", Data: json.RawMessage(`{}`), Position: 6, Timestamp: base.Add(3 * time.Minute)},
+	}
+	eventStore := &mockEventStore{
+		readAllFunc: func(ctx context.Context, fromPosition int64, limit int) ([]repository.StoredEvent, error) {
+			var out []repository.StoredEvent
+			for _, evt := range log {
+				if evt.Position > fromPosition && len(out) < limit {
+					out = append(out, evt)
+				}
+			}
+			return out, nil
+		},
+	}
+	readStore := &mockReadModelStore{
+		getPersonFunc: func(ctx context.Context, id uuid.UUID) (*repository.PersonReadModel, error) {
+			return nil, nil
+		},
+	}
+	service := NewHistoryService(eventStore, readStore)
+
+	result, err := service.ListDeleted(context.Background(), 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.TotalCount)
+	assert.Equal(t, 20, result.Limit)
+	require.Len(t, result.Entries, 1)
+
+	entry := result.Entries[0]
+	assert.Equal(t, deletedID, entry.EntityID)
+	assert.Equal(t, "person", entry.EntityType)
+	assert.Equal(t, "John Smith", entry.EntityName)
+	assert.Equal(t, "duplicate", entry.Reason)
+	assert.Equal(t, base.Add(2*time.Minute), entry.DeletedAt)
+
+	page, err := service.ListDeleted(context.Background(), 10, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 1, page.TotalCount)
+	assert.Empty(t, page.Entries)
+}
+
 func TestMapEventTypeToEntityAndAction(t *testing.T) {
 	service := &HistoryService{}
 
@@ -1152,6 +1347,7 @@ func TestTransformStoredEvents(t *testing.T) {
 	// Check first entry (created)
 	assert.Equal(t, "person", entries[0].EntityType)
 	assert.Equal(t, "created", entries[0].Action)
+	assert.Equal(t, "created John Smith", entries[0].Summary)
 	assert.Equal(t, "John Smith", entries[0].EntityName)
 	assert.Equal(t, personID, entries[0].EntityID)
 	assert.Nil(t, entries[0].Changes)
@@ -1161,11 +1357,46 @@ func TestTransformStoredEvents(t *testing.T) {
 	// Check second entry (updated)
 	assert.Equal(t, "person", entries[1].EntityType)
 	assert.Equal(t, "updated", entries[1].Action)
+	assert.Equal(t, "updated given_name", entries[1].Summary)
 	assert.Equal(t, "John Smith", entries[1].EntityName)
 	assert.NotNil(t, entries[1].Changes)
 	assert.Contains(t, entries[1].Changes, "given_name")
 }
 
+func TestTransformStoredEvents_OldValues(t *testing.T) {
+	personID := uuid.New()
+	now := time.Now().UTC()
+
+	createdData, _ := json.Marshal(domain.NewPersonCreated(&domain.Person{
+		ID:         personID,
+		GivenName:  "John",
+		Surname:    "Smith",
+		BirthPlace: "NY",
+	}))
+	updatedData, _ := json.Marshal(domain.NewPersonUpdated(personID, map[string]any{
+		"birth_place": "New York",
+		"notes":       "Census 1900",
+	}))
+
+	events := []repository.StoredEvent{
+		{ID: uuid.New(), StreamID: personID, StreamType: "person", EventType: "PersonCreated", Data: createdData, Version: 1, Position: 1, Timestamp: now},
+		{ID: uuid.New(), StreamID: personID, StreamType: "person", EventType: "PersonUpdated", Data: updatedData, Version: 2, Position: 2, Timestamp: now.Add(time.Hour)},
+	}
+	eventStore := &mockEventStore{
+		readStreamFunc: func(ctx context.Context, streamID uuid.UUID) ([]repository.StoredEvent, error) {
+			return events, nil
+		},
+	}
+	service := NewHistoryService(eventStore, &mockReadModelStore{})
+
+	entries, err := service.transformStoredEvents(context.Background(), events[1:])
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	assert.Equal(t, FieldChange{OldValue: "NY", NewValue: "New York"}, entries[0].Changes["birth_place"])
+	assert.Equal(t, FieldChange{NewValue: "Census 1900"}, entries[0].Changes["notes"])
+}
+
 func TestChildLinkedToFamilyProducesUpdatedAction(t *testing.T) {
 	familyID := uuid.New()
 	childID := uuid.New()
@@ -1224,6 +1455,7 @@ func TestChildLinkedToFamilyProducesUpdatedAction(t *testing.T) {
 	entry := entries[0]
 	assert.Equal(t, "family", entry.EntityType)
 	assert.Equal(t, "updated", entry.Action)
+	assert.Equal(t, "linked child "+childID.String()[:8], entry.Summary)
 	assert.Equal(t, "John Smith & Jane Smith", entry.EntityName)
 	require.Contains(t, entry.Changes, "children")
 	assert.Equal(t, "Child linked: Bobby Smith", entry.Changes["children"].NewValue)
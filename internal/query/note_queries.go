@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,6 +29,7 @@ type Note struct {
 	Language     string                   `json:"language,omitempty"`     // GEDCOM 7.0 SNOTE BCP 47 language tag
 	Translations []domain.NoteTranslation `json:"translations,omitempty"` // GEDCOM 7.0 SNOTE alternate-language renderings
 	GedcomXref   *string                  `json:"gedcom_xref,omitempty"`
+	Links        []domain.NoteLink        `json:"links,omitempty"` // Persons, families, and sources the note is attached to
 	Version      int64                    `json:"version"`
 	UpdatedAt    time.Time                `json:"updated_at"`
 }
@@ -36,7 +38,8 @@ type Note struct {
 type ListNotesInput struct {
 	Limit     int
 	Offset    int
-	SortOrder string // asc, desc (sorted by updated_at)
+	SortOrder string     // asc, desc (sorted by updated_at)
+	EntityID  *uuid.UUID // Only notes attached to this person, family, or source
 }
 
 // NoteListResult contains paginated note results.
@@ -65,9 +68,27 @@ func (s *NoteService) ListNotes(ctx context.Context, input ListNotesInput) (*Not
 		opts.Order = "desc"
 	}
 
-	readModels, total, err := s.readStore.ListNotes(ctx, opts)
-	if err != nil {
-		return nil, err
+	var readModels []repository.NoteReadModel
+	var total int
+	if input.EntityID != nil {
+		// An entity has few notes, so sort and paginate them here
+		all, err := s.readStore.ListNotesForEntity(ctx, *input.EntityID)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Order == "desc" {
+			slices.Reverse(all)
+		}
+		total = len(all)
+		start := min(opts.Offset, total)
+		end := min(start+opts.Limit, total)
+		readModels = all[start:end]
+	} else {
+		var err error
+		readModels, total, err = s.readStore.ListNotes(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	notes := make([]Note, len(readModels))
@@ -105,6 +126,7 @@ func convertReadModelToNote(rm repository.NoteReadModel) Note {
 		MIME:         rm.MIME,
 		Language:     rm.Language,
 		Translations: rm.Translations,
+		Links:        rm.Links,
 		Version:      rm.Version,
 		UpdatedAt:    rm.UpdatedAt,
 	}
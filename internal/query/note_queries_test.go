@@ -7,7 +7,9 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/domain"
 	"github.com/cacack/my-family/internal/query"
+	"github.com/cacack/my-family/internal/repository"
 	"github.com/cacack/my-family/internal/repository/memory"
 )
 
@@ -90,6 +92,47 @@ func TestListNotes(t *testing.T) {
 	}
 }
 
+// TestListNotes_EntityFilter tests listing the notes attached to an entity.
+func TestListNotes_EntityFilter(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	queryService := query.NewNoteService(readStore)
+	ctx := context.Background()
+
+	personID := uuid.New()
+	otherID := uuid.New()
+	for _, n := range []repository.NoteReadModel{
+		{ID: uuid.New(), Text: "Person note", Links: []domain.NoteLink{{EntityType: "person", EntityID: personID}}},
+		{ID: uuid.New(), Text: "Shared note", Links: []domain.NoteLink{{EntityType: "source", EntityID: otherID}, {EntityType: "person", EntityID: personID}}},
+		{ID: uuid.New(), Text: "Other note", Links: []domain.NoteLink{{EntityType: "family", EntityID: otherID}}},
+		{ID: uuid.New(), Text: "Unlinked note"},
+	} {
+		if err := readStore.SaveNote(ctx, &n); err != nil {
+			t.Fatalf("SaveNote failed: %v", err)
+		}
+	}
+
+	result, err := queryService.ListNotes(ctx, query.ListNotesInput{EntityID: &personID})
+	if err != nil {
+		t.Fatalf("ListNotes failed: %v", err)
+	}
+	if result.Total != 2 || len(result.Notes) != 2 {
+		t.Fatalf("Got %d notes (total %d), want 2", len(result.Notes), result.Total)
+	}
+	for _, n := range result.Notes {
+		if n.Text != "Person note" && n.Text != "Shared note" {
+			t.Errorf("unexpected note %q", n.Text)
+		}
+	}
+
+	result, err = queryService.ListNotes(ctx, query.ListNotesInput{EntityID: &personID, Limit: 1, Offset: 1})
+	if err != nil {
+		t.Fatalf("ListNotes failed: %v", err)
+	}
+	if result.Total != 2 || len(result.Notes) != 1 {
+		t.Errorf("Got %d notes (total %d), want 1 of 2", len(result.Notes), result.Total)
+	}
+}
+
 // TestListNotes_LimitEnforcement tests that limits are enforced.
 func TestListNotes_LimitEnforcement(t *testing.T) {
 	eventStore := memory.NewEventStore()
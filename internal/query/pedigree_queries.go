@@ -21,17 +21,25 @@ func NewPedigreeService(readStore repository.ReadModelStore) *PedigreeService {
 
 // PedigreeNode represents a person in the pedigree tree.
 type PedigreeNode struct {
-	ID         uuid.UUID       `json:"id"`
-	GivenName  string          `json:"given_name"`
-	Surname    string          `json:"surname"`
-	Gender     string          `json:"gender,omitempty"`
-	BirthDate  *domain.GenDate `json:"birth_date,omitempty"`
-	BirthPlace *string         `json:"birth_place,omitempty"`
-	DeathDate  *domain.GenDate `json:"death_date,omitempty"`
-	DeathPlace *string         `json:"death_place,omitempty"`
-	Generation int             `json:"generation"`
-	Father     *PedigreeNode   `json:"father,omitempty"`
-	Mother     *PedigreeNode   `json:"mother,omitempty"`
+	ID         uuid.UUID         `json:"id"`
+	GivenName  string            `json:"given_name"`
+	Surname    string            `json:"surname"`
+	Gender     string            `json:"gender,omitempty"`
+	BirthDate  *domain.GenDate   `json:"birth_date,omitempty"`
+	BirthPlace *string           `json:"birth_place,omitempty"`
+	DeathDate  *domain.GenDate   `json:"death_date,omitempty"`
+	DeathPlace *string           `json:"death_place,omitempty"`
+	Generation int               `json:"generation"`
+	Sources    *FactSourceCounts `json:"sources,omitempty"` // Set when sources are requested
+	Father     *PedigreeNode     `json:"father,omitempty"`
+	Mother     *PedigreeNode     `json:"mother,omitempty"`
+}
+
+// FactSourceCounts counts the citations supporting a person's vital facts.
+// A zero count marks an unsourced fact.
+type FactSourceCounts struct {
+	Birth int `json:"birth"`
+	Death int `json:"death"`
 }
 
 // PedigreeResult contains the pedigree tree for a person.
@@ -44,7 +52,8 @@ type PedigreeResult struct {
 // GetPedigreeInput contains options for retrieving a pedigree.
 type GetPedigreeInput struct {
 	PersonID       uuid.UUID
-	MaxGenerations int // Maximum generations to traverse (default 5)
+	MaxGenerations int  // Maximum generations to traverse (default 5); callers enforce any upper limit
+	IncludeSources bool // Attach birth and death citation counts to each node
 }
 
 // GetPedigree returns the ancestor tree for a person.
@@ -54,9 +63,6 @@ func (s *PedigreeService) GetPedigree(ctx context.Context, input GetPedigreeInpu
 	if maxGen <= 0 {
 		maxGen = 5
 	}
-	if maxGen > 10 {
-		maxGen = 10 // Hard limit to prevent excessive recursion
-	}
 
 	// Get the root person
 	person, err := s.readStore.GetPerson(ctx, input.PersonID)
@@ -69,7 +75,7 @@ func (s *PedigreeService) GetPedigree(ctx context.Context, input GetPedigreeInpu
 
 	// Build pedigree tree recursively
 	visited := make(map[uuid.UUID]bool)
-	root := s.buildNode(ctx, input.PersonID, 0, maxGen, visited)
+	root := s.buildNode(ctx, input.PersonID, 0, maxGen, input.IncludeSources, visited)
 
 	// Count total ancestors and max generation
 	totalAncestors := 0
@@ -84,7 +90,7 @@ func (s *PedigreeService) GetPedigree(ctx context.Context, input GetPedigreeInpu
 }
 
 // buildNode recursively builds a pedigree node and its ancestors.
-func (s *PedigreeService) buildNode(ctx context.Context, personID uuid.UUID, generation, maxGen int, visited map[uuid.UUID]bool) *PedigreeNode {
+func (s *PedigreeService) buildNode(ctx context.Context, personID uuid.UUID, generation, maxGen int, includeSources bool, visited map[uuid.UUID]bool) *PedigreeNode {
 	// Check if we've already visited this person (cycle detection)
 	if visited[personID] {
 		return nil
@@ -122,6 +128,9 @@ func (s *PedigreeService) buildNode(ctx context.Context, personID uuid.UUID, gen
 	if person.DeathPlace != "" {
 		node.DeathPlace = &person.DeathPlace
 	}
+	if includeSources {
+		node.Sources = s.factSourceCounts(ctx, personID)
+	}
 
 	// Don't recurse beyond max generations
 	if generation >= maxGen {
@@ -136,17 +145,35 @@ func (s *PedigreeService) buildNode(ctx context.Context, personID uuid.UUID, gen
 
 	// Recursively build father's ancestors
 	if edge.FatherID != nil {
-		node.Father = s.buildNode(ctx, *edge.FatherID, generation+1, maxGen, visited)
+		node.Father = s.buildNode(ctx, *edge.FatherID, generation+1, maxGen, includeSources, visited)
 	}
 
 	// Recursively build mother's ancestors
 	if edge.MotherID != nil {
-		node.Mother = s.buildNode(ctx, *edge.MotherID, generation+1, maxGen, visited)
+		node.Mother = s.buildNode(ctx, *edge.MotherID, generation+1, maxGen, includeSources, visited)
 	}
 
 	return node
 }
 
+// factSourceCounts counts the citations for a person's birth and death.
+func (s *PedigreeService) factSourceCounts(ctx context.Context, personID uuid.UUID) *FactSourceCounts {
+	counts := &FactSourceCounts{}
+	citations, err := s.readStore.GetCitationsForPerson(ctx, personID)
+	if err != nil {
+		return counts
+	}
+	for _, c := range citations {
+		switch c.FactType {
+		case domain.FactPersonBirth:
+			counts.Birth++
+		case domain.FactPersonDeath:
+			counts.Death++
+		}
+	}
+	return counts
+}
+
 // countAncestors counts total ancestors and finds max generation in the tree.
 func countAncestors(node *PedigreeNode, total *int, maxGen *int) {
 	if node == nil {
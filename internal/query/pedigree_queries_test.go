@@ -141,6 +141,57 @@ func TestGetPedigree(t *testing.T) {
 	}
 }
 
+func TestGetPedigree_IncludeSources(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	svc := query.NewPedigreeService(readStore)
+
+	child, father, _, _, _ := setupPedigreeTestData(t, readStore)
+
+	ctx := context.Background()
+	sourceID := uuid.New()
+	for _, c := range []struct {
+		factType domain.FactType
+		owner    uuid.UUID
+	}{
+		{domain.FactPersonBirth, father},
+		{domain.FactPersonBirth, father},
+		{domain.FactPersonDeath, father},
+		{domain.FactPersonOccupation, father},
+	} {
+		if err := readStore.SaveCitation(ctx, &repository.CitationReadModel{
+			ID:          uuid.New(),
+			SourceID:    sourceID,
+			FactType:    c.factType,
+			FactOwnerID: c.owner,
+			Version:     1,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := svc.GetPedigree(ctx, query.GetPedigreeInput{PersonID: child, MaxGenerations: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Root.Sources != nil {
+		t.Error("Sources should be omitted unless requested")
+	}
+
+	result, err = svc.GetPedigree(ctx, query.GetPedigreeInput{PersonID: child, MaxGenerations: 5, IncludeSources: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := result.Root.Father.Sources; got == nil || *got != (query.FactSourceCounts{Birth: 2, Death: 1}) {
+		t.Errorf("Father sources = %+v, want birth 2, death 1", got)
+	}
+	if got := result.Root.Sources; got == nil || *got != (query.FactSourceCounts{}) {
+		t.Errorf("Root sources = %+v, want zero counts for unsourced facts", got)
+	}
+	if result.Root.Father.Father.Sources == nil {
+		t.Error("Grandfather sources should be set")
+	}
+}
+
 func TestGetPedigree_NotFound(t *testing.T) {
 	readStore := memory.NewReadModelStore()
 	svc := query.NewPedigreeService(readStore)
@@ -280,10 +331,10 @@ func TestGetPedigree_MaxGenerationsHardLimit(t *testing.T) {
 
 	ctx := context.Background()
 
-	// Request with max generations > 10 should be capped to 10
+	// A large generation count traverses only as far as the data goes
 	result, err := svc.GetPedigree(ctx, query.GetPedigreeInput{
 		PersonID:       child,
-		MaxGenerations: 100, // Should be capped to 10
+		MaxGenerations: 100,
 	})
 	if err != nil {
 		t.Fatal(err)
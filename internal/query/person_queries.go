@@ -4,6 +4,7 @@ package query
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -40,6 +41,8 @@ type Person struct {
 	BrickWallNote       *string         `json:"brick_wall_note,omitempty"`
 	BrickWallSince      *time.Time      `json:"brick_wall_since,omitempty"`
 	BrickWallResolvedAt *time.Time      `json:"brick_wall_resolved_at,omitempty"`
+	CreatedAt           *time.Time      `json:"created_at,omitempty"`
+	UpdatedAt           time.Time       `json:"updated_at"`
 	Version             int64           `json:"version"`
 }
 
@@ -53,6 +56,7 @@ type PersonName struct {
 	NameSuffix    string    `json:"name_suffix,omitempty"`
 	SurnamePrefix string    `json:"surname_prefix,omitempty"`
 	Nickname      string    `json:"nickname,omitempty"`
+	Romanized     string    `json:"romanized,omitempty"`
 	NameType      string    `json:"name_type"`
 	IsPrimary     bool      `json:"is_primary"`
 }
@@ -89,6 +93,11 @@ type ListPersonsInput struct {
 	Sort           string  // surname, given_name, birth_date, updated_at
 	Order          string  // asc, desc
 	ResearchStatus *string // Filter by research_status: certain, probable, possible, unknown, or "unset" for NULL
+	Surname        string  // Filter by exact surname, case-insensitive
+	Gender         string  // Filter by gender: male, female, nonbinary, unknown
+	Unlinked       bool    // Only persons with no family: neither a partner nor a child in one
+
+	IgnoreSurnamePrefix bool // Sort surnames without leading particles ("van Gogh" under G)
 }
 
 // ListPersons returns a paginated list of persons.
@@ -99,6 +108,11 @@ func (s *PersonService) ListPersons(ctx context.Context, input ListPersonsInput)
 		Sort:           input.Sort,
 		Order:          input.Order,
 		ResearchStatus: input.ResearchStatus,
+		Surname:        strings.TrimSpace(input.Surname),
+		Gender:         input.Gender,
+		Unlinked:       input.Unlinked,
+
+		IgnoreSurnamePrefix: input.IgnoreSurnamePrefix,
 	}
 
 	if opts.Limit <= 0 {
@@ -107,6 +121,9 @@ func (s *PersonService) ListPersons(ctx context.Context, input ListPersonsInput)
 	if opts.Limit > 100 {
 		opts.Limit = 100
 	}
+	if opts.Offset < 0 {
+		opts.Offset = 0
+	}
 	if opts.Sort == "" {
 		opts.Sort = "surname"
 	}
@@ -191,9 +208,25 @@ func (s *PersonService) GetPerson(ctx context.Context, id uuid.UUID) (*PersonDet
 	return detail, nil
 }
 
+// Search algorithms for SearchPersonsInput.Algorithm.
+const (
+	SearchAlgorithmExact     = "exact"
+	SearchAlgorithmFuzzy     = "fuzzy"
+	SearchAlgorithmSoundex   = "soundex"
+	SearchAlgorithmMetaphone = "metaphone"
+)
+
+// ErrInvalidSearchAlgorithm is returned when SearchPersonsInput.Algorithm is not recognized.
+var ErrInvalidSearchAlgorithm = errors.New("invalid search algorithm")
+
 // SearchPersonsInput contains options for searching persons.
 type SearchPersonsInput struct {
-	Query         string
+	// Query is the name to search for. A query containing * is a wildcard
+	// pattern matched against name words ("Smi*", "*son") and ignores Algorithm.
+	Query string
+	// Algorithm selects the name matching algorithm (one of the SearchAlgorithm
+	// constants). When empty it is derived from Fuzzy and Soundex.
+	Algorithm     string
 	Fuzzy         bool
 	Soundex       bool
 	BirthDateFrom *time.Time
@@ -204,13 +237,22 @@ type SearchPersonsInput struct {
 	DeathPlace    string
 	Sort          string
 	Order         string
-	Limit         int
+	Limit         int // Maximum results (default 20); callers enforce any upper limit
 }
 
 // SearchResult represents a search result with relevance score.
 type SearchResult struct {
 	Person
 	Score float64 `json:"score"`
+	// MatchedBy is the algorithm that matched the person's primary name:
+	// SearchAlgorithmExact when it contains the query, otherwise the requested
+	// algorithm. Empty when the search had no text query.
+	MatchedBy string `json:"matched_by,omitempty"`
+	// Patronymic is true when the person's primary name is a patronymic, so
+	// the surname is derived from a parent rather than a family name.
+	Patronymic bool `json:"patronymic,omitempty"`
+	// Matches locates the text that matched in each field, for highlighting.
+	Matches []SearchMatch `json:"matches,omitempty"`
 }
 
 // SearchPersonsResult contains search results.
@@ -225,14 +267,24 @@ func (s *PersonService) SearchPersons(ctx context.Context, input SearchPersonsIn
 	if input.Limit <= 0 {
 		input.Limit = 20
 	}
-	if input.Limit > 100 {
-		input.Limit = 100
+
+	algorithm, err := resolveSearchAlgorithm(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// A wildcard pattern such as "Smi*" is matched literally
+	wildcard := repository.IsWildcardQuery(input.Query)
+	if wildcard {
+		algorithm = SearchAlgorithmExact
 	}
 
 	opts := repository.SearchOptions{
 		Query:         input.Query,
-		Fuzzy:         input.Fuzzy,
-		Soundex:       input.Soundex,
+		Wildcard:      wildcard,
+		Fuzzy:         algorithm == SearchAlgorithmFuzzy,
+		Soundex:       algorithm == SearchAlgorithmSoundex,
+		Metaphone:     algorithm == SearchAlgorithmMetaphone,
 		BirthDateFrom: input.BirthDateFrom,
 		BirthDateTo:   input.BirthDateTo,
 		DeathDateFrom: input.DeathDateFrom,
@@ -249,12 +301,28 @@ func (s *PersonService) SearchPersons(ctx context.Context, input SearchPersonsIn
 		return nil, err
 	}
 
+	criteria := matchCriteria{
+		nameTerms:  queryTerms(input.Query),
+		algorithm:  algorithm,
+		birthPlace: input.BirthPlace,
+		deathPlace: input.DeathPlace,
+		birthDates: input.BirthDateFrom != nil || input.BirthDateTo != nil,
+		deathDates: input.DeathDateFrom != nil || input.DeathDateTo != nil,
+	}
+
 	results := make([]SearchResult, len(readModels))
 	for i, rm := range readModels {
 		results[i] = SearchResult{
-			Person: convertReadModelToPerson(rm),
-			Score:  1.0, // In-memory search doesn't have scoring; SQLite/PostgreSQL would provide this
+			Person:    convertReadModelToPerson(rm),
+			Score:     1.0, // In-memory search doesn't have scoring; SQLite/PostgreSQL would provide this
+			MatchedBy: matchedSearchAlgorithm(rm, input.Query, algorithm),
+		}
+		names, err := s.readStore.GetPersonNames(ctx, rm.ID)
+		if err != nil {
+			return nil, err
 		}
+		results[i].Patronymic = hasPatronymicName(names)
+		results[i].Matches = searchMatches(results[i].Person, names, criteria)
 	}
 
 	return &SearchPersonsResult{
@@ -264,15 +332,183 @@ func (s *PersonService) SearchPersons(ctx context.Context, input SearchPersonsIn
 	}, nil
 }
 
+// ErrNoSearchCriteria is returned when an advanced search has no criteria.
+var ErrNoSearchCriteria = errors.New("at least one search criterion is required")
+
+// ErrInvalidYearRange is returned when a year range's start is after its end.
+var ErrInvalidYearRange = errors.New("invalid year range: from must not be after to")
+
+// AdvancedSearchInput contains structured criteria for searching persons.
+// All provided criteria are ANDed together. Year ranges are inclusive.
+type AdvancedSearchInput struct {
+	GivenName     string
+	Surname       string
+	BirthYearFrom *int
+	BirthYearTo   *int
+	DeathYearFrom *int
+	DeathYearTo   *int
+	BirthPlace    string
+	Gender        string
+	Sort          string
+	Order         string
+	Limit         int
+}
+
+// AdvancedSearchPersons searches for persons matching all of the given criteria.
+func (s *PersonService) AdvancedSearchPersons(ctx context.Context, input AdvancedSearchInput) (*SearchPersonsResult, error) {
+	if input.Limit <= 0 {
+		input.Limit = 20
+	}
+
+	opts := repository.SearchOptions{
+		GivenName:  strings.TrimSpace(input.GivenName),
+		Surname:    strings.TrimSpace(input.Surname),
+		BirthPlace: strings.TrimSpace(input.BirthPlace),
+		Gender:     domain.Gender(input.Gender),
+		Sort:       input.Sort,
+		Order:      input.Order,
+		Limit:      input.Limit,
+	}
+	if opts.Sort == "" {
+		opts.Sort = "name"
+	}
+
+	var err error
+	if opts.BirthDateFrom, opts.BirthDateTo, err = yearRange(input.BirthYearFrom, input.BirthYearTo); err != nil {
+		return nil, err
+	}
+	if opts.DeathDateFrom, opts.DeathDateTo, err = yearRange(input.DeathYearFrom, input.DeathYearTo); err != nil {
+		return nil, err
+	}
+
+	if opts.GivenName == "" && opts.Surname == "" && opts.BirthPlace == "" && opts.Gender == "" &&
+		opts.BirthDateFrom == nil && opts.BirthDateTo == nil && opts.DeathDateFrom == nil && opts.DeathDateTo == nil {
+		return nil, ErrNoSearchCriteria
+	}
+
+	readModels, err := s.readStore.SearchPersons(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria := matchCriteria{
+		givenTerms:   strings.Fields(opts.GivenName),
+		surnameTerms: strings.Fields(opts.Surname),
+		birthPlace:   opts.BirthPlace,
+		birthDates:   opts.BirthDateFrom != nil || opts.BirthDateTo != nil,
+		deathDates:   opts.DeathDateFrom != nil || opts.DeathDateTo != nil,
+	}
+
+	results := make([]SearchResult, len(readModels))
+	for i, rm := range readModels {
+		results[i] = SearchResult{
+			Person: convertReadModelToPerson(rm),
+			Score:  1.0,
+		}
+		names, err := s.readStore.GetPersonNames(ctx, rm.ID)
+		if err != nil {
+			return nil, err
+		}
+		results[i].Patronymic = hasPatronymicName(names)
+		results[i].Matches = searchMatches(results[i].Person, names, criteria)
+	}
+
+	return &SearchPersonsResult{
+		Items: results,
+		Total: len(results),
+	}, nil
+}
+
+// searchMatches locates what matched c in a search result, given the person's
+// loaded names. Alternate names are only considered for name searches.
+func searchMatches(p Person, names []repository.PersonNameReadModel, c matchCriteria) []SearchMatch {
+	var altNames []PersonName
+	if len(c.nameTerms) > 0 {
+		for _, n := range names {
+			altNames = append(altNames, convertPersonNameReadModel(n))
+		}
+	}
+	return findMatches(p, altNames, c)
+}
+
+// hasPatronymicName reports whether a person's primary name is a patronymic.
+func hasPatronymicName(names []repository.PersonNameReadModel) bool {
+	for _, n := range names {
+		if n.IsPrimary && n.NameType == domain.NameTypePatronymic {
+			return true
+		}
+	}
+	return false
+}
+
+// yearRange converts an inclusive year range into the first and last day of
+// those years. Either bound may be nil.
+func yearRange(from, to *int) (*time.Time, *time.Time, error) {
+	if from != nil && to != nil && *from > *to {
+		return nil, nil, ErrInvalidYearRange
+	}
+	var start, end *time.Time
+	if from != nil {
+		t := time.Date(*from, time.January, 1, 0, 0, 0, 0, time.UTC)
+		start = &t
+	}
+	if to != nil {
+		t := time.Date(*to, time.December, 31, 0, 0, 0, 0, time.UTC)
+		end = &t
+	}
+	return start, end, nil
+}
+
+// resolveSearchAlgorithm returns the algorithm to search with, falling back to
+// the legacy Fuzzy and Soundex flags when Algorithm is unset.
+func resolveSearchAlgorithm(input SearchPersonsInput) (string, error) {
+	switch input.Algorithm {
+	case SearchAlgorithmExact, SearchAlgorithmFuzzy, SearchAlgorithmSoundex, SearchAlgorithmMetaphone:
+		return input.Algorithm, nil
+	case "":
+		switch {
+		case input.Soundex:
+			return SearchAlgorithmSoundex, nil
+		case input.Fuzzy:
+			return SearchAlgorithmFuzzy, nil
+		default:
+			return SearchAlgorithmExact, nil
+		}
+	default:
+		return "", ErrInvalidSearchAlgorithm
+	}
+}
+
+// matchedSearchAlgorithm reports which algorithm matched a search result.
+// Results whose primary name contains the query are exact matches; anything
+// else was found by the requested algorithm (possibly via an alternate name).
+func matchedSearchAlgorithm(rm repository.PersonReadModel, query, algorithm string) string {
+	queryLower := strings.ToLower(strings.TrimSpace(query))
+	if queryLower == "" {
+		return ""
+	}
+	if algorithm == SearchAlgorithmExact ||
+		strings.Contains(strings.ToLower(rm.FullName), queryLower) ||
+		strings.Contains(strings.ToLower(rm.GivenName+" "+rm.Surname), queryLower) {
+		return SearchAlgorithmExact
+	}
+	return algorithm
+}
+
 // Helper function to convert read model to query result.
 func convertReadModelToPerson(rm repository.PersonReadModel) Person {
 	p := Person{
 		ID:        rm.ID,
 		GivenName: rm.GivenName,
 		Surname:   rm.Surname,
+		UpdatedAt: rm.UpdatedAt,
 		Version:   rm.Version,
 	}
 
+	if !rm.CreatedAt.IsZero() {
+		createdAt := rm.CreatedAt
+		p.CreatedAt = &createdAt
+	}
 	if rm.Gender != "" {
 		g := string(rm.Gender)
 		p.Gender = &g
@@ -352,6 +588,49 @@ func (s *PersonService) GetPersonNames(ctx context.Context, personID uuid.UUID)
 	return names, nil
 }
 
+// PersonNameListResult is a page of a person's names.
+type PersonNameListResult struct {
+	Items  []PersonName `json:"items"`
+	Total  int          `json:"total"`
+	Limit  int          `json:"limit"`
+	Offset int          `json:"offset"`
+}
+
+// ListPersonNames returns a page of a person's names. Total counts all of
+// the person's names.
+func (s *PersonService) ListPersonNames(ctx context.Context, personID uuid.UUID, limit, offset int) (*PersonNameListResult, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	names, err := s.GetPersonNames(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+
+	total := len(names)
+	end := offset + limit
+	if offset > total {
+		offset = total
+	}
+	if end > total {
+		end = total
+	}
+
+	return &PersonNameListResult{
+		Items:  names[offset:end],
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
 // convertPersonNameReadModel converts a PersonNameReadModel to a PersonName query result.
 func convertPersonNameReadModel(rm repository.PersonNameReadModel) PersonName {
 	return PersonName{
@@ -363,6 +642,7 @@ func convertPersonNameReadModel(rm repository.PersonNameReadModel) PersonName {
 		NameSuffix:    rm.NameSuffix,
 		SurnamePrefix: rm.SurnamePrefix,
 		Nickname:      rm.Nickname,
+		Romanized:     rm.Romanized,
 		NameType:      string(rm.NameType),
 		IsPrimary:     rm.IsPrimary,
 	}
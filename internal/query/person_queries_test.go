@@ -2,11 +2,16 @@ package query_test
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 
+	"github.com/google/uuid"
+
 	"github.com/cacack/my-family/internal/command"
 	"github.com/cacack/my-family/internal/domain"
 	"github.com/cacack/my-family/internal/query"
+	"github.com/cacack/my-family/internal/repository"
 	"github.com/cacack/my-family/internal/repository/memory"
 )
 
@@ -186,6 +191,238 @@ func TestSearchPersons(t *testing.T) {
 	}
 }
 
+func TestSearchPersons_FlagsPatronymic(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewPersonService(readStore)
+	ctx := context.Background()
+
+	created, _ := handler.CreatePerson(ctx, command.CreatePersonInput{
+		GivenName: "Sigríður",
+		Surname:   "Jónsdóttir",
+	})
+	_, err := handler.AddName(ctx, command.AddNameInput{
+		PersonID:  created.ID,
+		GivenName: "Sigríður",
+		Surname:   "Jónsdóttir",
+		NameType:  "patronymic",
+		IsPrimary: true,
+	})
+	if err != nil {
+		t.Fatalf("AddName failed: %v", err)
+	}
+	_, _ = handler.CreatePerson(ctx, command.CreatePersonInput{
+		GivenName: "Sigrid",
+		Surname:   "Jensen",
+	})
+
+	result, err := service.SearchPersons(ctx, query.SearchPersonsInput{Query: "Sigr"})
+	if err != nil {
+		t.Fatalf("SearchPersons failed: %v", err)
+	}
+	if result.Total != 2 {
+		t.Fatalf("Total = %d, want 2", result.Total)
+	}
+	for _, item := range result.Items {
+		if want := item.ID == created.ID; item.Patronymic != want {
+			t.Errorf("%s: Patronymic = %v, want %v", item.GivenName, item.Patronymic, want)
+		}
+	}
+}
+
+// nameCountingStore counts GetPersonNames calls.
+type nameCountingStore struct {
+	*memory.ReadModelStore
+	nameLoads int
+}
+
+func (s *nameCountingStore) GetPersonNames(ctx context.Context, personID uuid.UUID) ([]repository.PersonNameReadModel, error) {
+	s.nameLoads++
+	return s.ReadModelStore.GetPersonNames(ctx, personID)
+}
+
+func TestSearchPersons_LoadsNamesOncePerResult(t *testing.T) {
+	readStore := &nameCountingStore{ReadModelStore: memory.NewReadModelStore()}
+	handler := command.NewHandler(memory.NewEventStore(), readStore)
+	service := query.NewPersonService(readStore)
+	ctx := context.Background()
+
+	for _, given := range []string{"Anna", "Erik", "Olof"} {
+		created, _ := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: given, Surname: "Persson"})
+		if _, err := handler.AddName(ctx, command.AddNameInput{
+			PersonID:  created.ID,
+			GivenName: given,
+			Surname:   "Persson",
+			NameType:  "patronymic",
+			IsPrimary: true,
+		}); err != nil {
+			t.Fatalf("AddName failed: %v", err)
+		}
+	}
+
+	readStore.nameLoads = 0
+	result, err := service.AdvancedSearchPersons(ctx, query.AdvancedSearchInput{Surname: "Persson"})
+	if err != nil {
+		t.Fatalf("AdvancedSearchPersons failed: %v", err)
+	}
+	if result.Total != 3 {
+		t.Fatalf("Total = %d, want 3", result.Total)
+	}
+	for _, item := range result.Items {
+		if !item.Patronymic {
+			t.Errorf("%s: Patronymic = false, want true", item.GivenName)
+		}
+	}
+	if readStore.nameLoads != 3 {
+		t.Errorf("AdvancedSearchPersons: GetPersonNames called %d times for 3 results, want 3", readStore.nameLoads)
+	}
+
+	// Name searches reuse the same names to locate matches
+	readStore.nameLoads = 0
+	result, err = service.SearchPersons(ctx, query.SearchPersonsInput{Query: "Persson"})
+	if err != nil {
+		t.Fatalf("SearchPersons failed: %v", err)
+	}
+	if result.Total != 3 {
+		t.Fatalf("Total = %d, want 3", result.Total)
+	}
+	for _, item := range result.Items {
+		if !item.Patronymic || len(item.Matches) == 0 {
+			t.Errorf("%s: Patronymic = %v, Matches = %v; want flagged with matches", item.GivenName, item.Patronymic, item.Matches)
+		}
+	}
+	if readStore.nameLoads != 3 {
+		t.Errorf("SearchPersons: GetPersonNames called %d times for 3 results, want 3", readStore.nameLoads)
+	}
+}
+
+func TestSearchPersons_Matches(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewPersonService(readStore)
+	ctx := context.Background()
+
+	goldsmith, _ := handler.CreatePerson(ctx, command.CreatePersonInput{
+		GivenName:  "Jón",
+		Surname:    "Goldsmith",
+		BirthPlace: "Boston, Massachusetts",
+	})
+	brown, _ := handler.CreatePerson(ctx, command.CreatePersonInput{
+		GivenName: "Robert",
+		Surname:   "Brown",
+	})
+	if _, err := handler.AddName(ctx, command.AddNameInput{
+		PersonID:  brown.ID,
+		GivenName: "Robert",
+		Surname:   "Smithers",
+		NameType:  "aka",
+	}); err != nil {
+		t.Fatalf("AddName failed: %v", err)
+	}
+
+	matchesFor := func(t *testing.T, input query.SearchPersonsInput, id uuid.UUID) []query.SearchMatch {
+		t.Helper()
+		result, err := service.SearchPersons(ctx, input)
+		if err != nil {
+			t.Fatalf("SearchPersons failed: %v", err)
+		}
+		for _, item := range result.Items {
+			if item.ID == id {
+				return item.Matches
+			}
+		}
+		t.Fatalf("person %s not in results", id)
+		return nil
+	}
+
+	t.Run("substring within a name", func(t *testing.T) {
+		got := matchesFor(t, query.SearchPersonsInput{Query: "smith"}, goldsmith.ID)
+		want := []query.SearchMatch{{Field: "surname", Value: "Goldsmith", Start: 4, End: 9}}
+		if !slices.Equal(got, want) {
+			t.Errorf("Matches = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("offsets count characters", func(t *testing.T) {
+		got := matchesFor(t, query.SearchPersonsInput{Query: "ón gold"}, goldsmith.ID)
+		want := []query.SearchMatch{
+			{Field: "given_name", Value: "Jón", Start: 1, End: 3},
+			{Field: "surname", Value: "Goldsmith", Start: 0, End: 4},
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("Matches = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("alternate name", func(t *testing.T) {
+		got := matchesFor(t, query.SearchPersonsInput{Query: "Smithers"}, brown.ID)
+		want := []query.SearchMatch{{Field: "alternate_name", Value: "Robert Smithers", Start: 7, End: 15}}
+		if !slices.Equal(got, want) {
+			t.Errorf("Matches = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("phonetic match highlights the word", func(t *testing.T) {
+		got := matchesFor(t, query.SearchPersonsInput{Query: "Braun", Algorithm: query.SearchAlgorithmSoundex}, brown.ID)
+		want := []query.SearchMatch{{Field: "surname", Value: "Brown", Start: 0, End: 5}}
+		if !slices.Equal(got, want) {
+			t.Errorf("Matches = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("place filter", func(t *testing.T) {
+		got := matchesFor(t, query.SearchPersonsInput{BirthPlace: "boston"}, goldsmith.ID)
+		want := []query.SearchMatch{{Field: "birth_place", Value: "Boston, Massachusetts", Start: 0, End: 6}}
+		if !slices.Equal(got, want) {
+			t.Errorf("Matches = %+v, want %+v", got, want)
+		}
+	})
+}
+
+func TestSearchPersons_Romanized(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewPersonService(readStore)
+	ctx := context.Background()
+
+	created, _ := handler.CreatePerson(ctx, command.CreatePersonInput{
+		GivenName: "Иван",
+		Surname:   "Иванов",
+	})
+	_, err := handler.AddName(ctx, command.AddNameInput{
+		PersonID:  created.ID,
+		GivenName: "Иван",
+		Surname:   "Иванов",
+		Romanized: "Ivan Ivanov",
+		NameType:  "birth",
+		IsPrimary: true,
+	})
+	if err != nil {
+		t.Fatalf("AddName failed: %v", err)
+	}
+
+	for _, q := range []string{"Ivanov", "Иванов"} {
+		result, err := service.SearchPersons(ctx, query.SearchPersonsInput{Query: q})
+		if err != nil {
+			t.Fatalf("SearchPersons(%q) failed: %v", q, err)
+		}
+		if result.Total != 1 || result.Items[0].ID != created.ID {
+			t.Errorf("SearchPersons(%q) Total = %d, want Иван Иванов", q, result.Total)
+		}
+	}
+
+	names, err := service.GetPersonNames(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetPersonNames failed: %v", err)
+	}
+	if len(names) == 0 || names[0].Romanized != "Ivan Ivanov" {
+		t.Errorf("names = %+v, want primary romanized Ivan Ivanov", names)
+	}
+}
+
 func TestSearchPersons_NoResults(t *testing.T) {
 	eventStore := memory.NewEventStore()
 	readStore := memory.NewReadModelStore()
@@ -210,6 +447,146 @@ func TestSearchPersons_NoResults(t *testing.T) {
 	}
 }
 
+func TestSearchPersons_Algorithm(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewPersonService(readStore)
+	ctx := context.Background()
+
+	for _, surname := range []string{"Reilly", "Riley", "Smith"} {
+		if _, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Pat", Surname: surname}); err != nil {
+			t.Fatalf("CreatePerson failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name          string
+		algorithm     string
+		query         string
+		wantMatchedBy map[string]string // surname -> matched_by
+	}{
+		{
+			name:          "exact",
+			algorithm:     query.SearchAlgorithmExact,
+			query:         "Riley",
+			wantMatchedBy: map[string]string{"Riley": query.SearchAlgorithmExact},
+		},
+		{
+			name:      "metaphone",
+			algorithm: query.SearchAlgorithmMetaphone,
+			query:     "Riley",
+			wantMatchedBy: map[string]string{
+				"Riley":  query.SearchAlgorithmExact,
+				"Reilly": query.SearchAlgorithmMetaphone,
+			},
+		},
+		{
+			name:      "soundex",
+			algorithm: query.SearchAlgorithmSoundex,
+			query:     "Reilly",
+			wantMatchedBy: map[string]string{
+				"Reilly": query.SearchAlgorithmExact,
+				"Riley":  query.SearchAlgorithmSoundex,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.SearchPersons(ctx, query.SearchPersonsInput{
+				Query:     tt.query,
+				Algorithm: tt.algorithm,
+			})
+			if err != nil {
+				t.Fatalf("SearchPersons failed: %v", err)
+			}
+			if result.Total != len(tt.wantMatchedBy) {
+				t.Fatalf("Total = %d, want %d", result.Total, len(tt.wantMatchedBy))
+			}
+			for _, item := range result.Items {
+				if want := tt.wantMatchedBy[item.Surname]; item.MatchedBy != want {
+					t.Errorf("%s MatchedBy = %q, want %q", item.Surname, item.MatchedBy, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchPersons_InvalidAlgorithm(t *testing.T) {
+	service := query.NewPersonService(memory.NewReadModelStore())
+
+	_, err := service.SearchPersons(context.Background(), query.SearchPersonsInput{
+		Query:     "Smith",
+		Algorithm: "nysiis",
+	})
+	if !errors.Is(err, query.ErrInvalidSearchAlgorithm) {
+		t.Errorf("err = %v, want ErrInvalidSearchAlgorithm", err)
+	}
+}
+
+func TestAdvancedSearchPersons(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewPersonService(readStore)
+	ctx := context.Background()
+
+	persons := []command.CreatePersonInput{
+		{GivenName: "John", Surname: "Miller", Gender: "male", BirthDate: "1845", BirthPlace: "Columbus, Ohio"},
+		{GivenName: "John", Surname: "Baker", Gender: "male", BirthDate: "1860", BirthPlace: "Dayton, Ohio"},
+		{GivenName: "John", Surname: "Carter", Gender: "male", BirthDate: "1848", BirthPlace: "Boston, Massachusetts"},
+		{GivenName: "Johanna", Surname: "Miller", Gender: "female", BirthDate: "1842", BirthPlace: "Akron, Ohio"},
+	}
+	for _, p := range persons {
+		if _, err := handler.CreatePerson(ctx, p); err != nil {
+			t.Fatalf("CreatePerson failed: %v", err)
+		}
+	}
+
+	from, to := 1840, 1850
+	result, err := service.AdvancedSearchPersons(ctx, query.AdvancedSearchInput{
+		GivenName:     "John",
+		Gender:        "male",
+		BirthYearFrom: &from,
+		BirthYearTo:   &to,
+		BirthPlace:    "Ohio",
+	})
+	if err != nil {
+		t.Fatalf("AdvancedSearchPersons failed: %v", err)
+	}
+	if result.Total != 1 {
+		t.Fatalf("Total = %d, want 1", result.Total)
+	}
+	if result.Items[0].Surname != "Miller" || result.Items[0].GivenName != "John" {
+		t.Errorf("got %s %s, want John Miller", result.Items[0].GivenName, result.Items[0].Surname)
+	}
+
+	// Surname alone matches both Millers
+	result, err = service.AdvancedSearchPersons(ctx, query.AdvancedSearchInput{Surname: "miller"})
+	if err != nil {
+		t.Fatalf("AdvancedSearchPersons failed: %v", err)
+	}
+	if result.Total != 2 {
+		t.Errorf("Total = %d, want 2", result.Total)
+	}
+}
+
+func TestAdvancedSearchPersons_Validation(t *testing.T) {
+	service := query.NewPersonService(memory.NewReadModelStore())
+	ctx := context.Background()
+
+	if _, err := service.AdvancedSearchPersons(ctx, query.AdvancedSearchInput{}); !errors.Is(err, query.ErrNoSearchCriteria) {
+		t.Errorf("err = %v, want ErrNoSearchCriteria", err)
+	}
+
+	from, to := 1900, 1800
+	_, err := service.AdvancedSearchPersons(ctx, query.AdvancedSearchInput{DeathYearFrom: &from, DeathYearTo: &to})
+	if !errors.Is(err, query.ErrInvalidYearRange) {
+		t.Errorf("err = %v, want ErrInvalidYearRange", err)
+	}
+}
+
 func TestListPersons_LimitConstraints(t *testing.T) {
 	eventStore := memory.NewEventStore()
 	readStore := memory.NewReadModelStore()
@@ -365,9 +742,9 @@ func TestSearchPersons_LimitConstraints(t *testing.T) {
 		expectedLimit int
 	}{
 		{
-			name:          "limit over 100 gets capped",
+			name:          "limit over 100 is left to the caller",
 			limit:         200,
-			expectedLimit: 100,
+			expectedLimit: 200,
 		},
 		{
 			name:          "zero limit defaults to 20",
@@ -696,6 +1073,60 @@ func TestGetPersonNames_MultipleNames(t *testing.T) {
 	}
 }
 
+func TestListPersonNames(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	handler := command.NewHandler(eventStore, readStore)
+	service := query.NewPersonService(readStore)
+	ctx := context.Background()
+
+	person, err := handler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	if err != nil {
+		t.Fatalf("CreatePerson failed: %v", err)
+	}
+	for i := 0; i < 105; i++ {
+		if err := readStore.SavePersonName(ctx, &repository.PersonNameReadModel{
+			ID:        uuid.New(),
+			PersonID:  person.ID,
+			GivenName: "John",
+			Surname:   "Doe",
+			NameType:  domain.NameTypeAKA,
+		}); err != nil {
+			t.Fatalf("SavePersonName failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name          string
+		limit, offset int
+		wantItems     int
+		wantLimit     int
+		wantOffset    int
+	}{
+		{"page", 10, 100, 5, 10, 100},
+		{"default limit", 0, 0, 20, 20, 0},
+		{"negative limit", -1, 0, 20, 20, 0},
+		{"oversized limit", 100000, 0, 100, 100, 0},
+		{"negative offset", 10, -1, 10, 10, 0},
+		{"offset past end", 10, 500, 0, 10, 105},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.ListPersonNames(ctx, person.ID, tt.limit, tt.offset)
+			if err != nil {
+				t.Fatalf("ListPersonNames failed: %v", err)
+			}
+			if result.Total != 105 {
+				t.Errorf("Total = %d, want 105", result.Total)
+			}
+			if len(result.Items) != tt.wantItems || result.Limit != tt.wantLimit || result.Offset != tt.wantOffset {
+				t.Errorf("got %d items, limit %d, offset %d; want %d, %d, %d",
+					len(result.Items), result.Limit, result.Offset, tt.wantItems, tt.wantLimit, tt.wantOffset)
+			}
+		})
+	}
+}
+
 func TestGetPersonNames_NotFound(t *testing.T) {
 	readStore := memory.NewReadModelStore()
 	service := query.NewPersonService(readStore)
@@ -0,0 +1,297 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// projectionReplayBatch is how many events VerifyProjections reads from the event log at a time.
+const projectionReplayBatch = 1000
+
+// Projection discrepancy issues.
+const (
+	ProjectionMissing    = "missing"    // The event log has the entity but the read model does not
+	ProjectionUnexpected = "unexpected" // The read model has an entity the event log deleted
+	ProjectionMismatch   = "mismatch"   // Both have the entity but fields differ
+	ProjectionFailed     = "failed"     // An event for the entity could not be decoded or projected
+)
+
+// ProjectionCheckService compares read models against state rebuilt from the event log.
+type ProjectionCheckService struct {
+	eventStore      repository.EventStore
+	readStore       repository.ReadModelStore
+	newScratchStore func() repository.ReadModelStore
+}
+
+// NewProjectionCheckService creates a new projection check service.
+// newScratchStore returns an empty read model store to rebuild state into;
+// it is called once per check, and the store is discarded afterwards.
+func NewProjectionCheckService(eventStore repository.EventStore, readStore repository.ReadModelStore, newScratchStore func() repository.ReadModelStore) *ProjectionCheckService {
+	return &ProjectionCheckService{
+		eventStore:      eventStore,
+		readStore:       readStore,
+		newScratchStore: newScratchStore,
+	}
+}
+
+// FieldMismatch is a field whose read model value differs from the value
+// rebuilt from the event log.
+type FieldMismatch struct {
+	Field    string `json:"field"`
+	Expected string `json:"expected"` // Rebuilt from the event log
+	Actual   string `json:"actual"`   // Stored in the read model
+}
+
+// ProjectionDiscrepancy describes an entity whose read model has drifted.
+type ProjectionDiscrepancy struct {
+	EntityType string          `json:"entity_type"` // "person", "family", "source", "citation"; any stream type for ProjectionFailed
+	EntityID   uuid.UUID       `json:"entity_id"`
+	Issue      string          `json:"issue"` // ProjectionMissing, ProjectionUnexpected, ProjectionMismatch, or ProjectionFailed
+	Fields     []FieldMismatch `json:"fields,omitempty"`
+	Error      string          `json:"error,omitempty"` // Why the first failing event could not be replayed (ProjectionFailed only)
+}
+
+// ProjectionCheckResult contains the outcome of a projection check.
+type ProjectionCheckResult struct {
+	Checked        int                     `json:"checked"`         // Entities compared
+	EventsReplayed int                     `json:"events_replayed"` // Events applied to rebuild state
+	Discrepancies  []ProjectionDiscrepancy `json:"discrepancies"`
+}
+
+// VerifyProjections rebuilds the read model from the full event log into a
+// scratch store and compares persons, families, sources, and citations
+// against the live read model. A positive sample limits the comparison to
+// that many randomly chosen entities; the whole log is still replayed, since
+// projections read across streams.
+//
+// Only event-sourced fields and versions are compared. Data written to the
+// read model outside events, such as GEDCOM place coordinates and brick wall
+// notes, is ignored. An entity with an event that cannot be replayed is
+// reported as ProjectionFailed rather than compared.
+func (s *ProjectionCheckService) VerifyProjections(ctx context.Context, sample int) (*ProjectionCheckResult, error) {
+	scratch := s.newScratchStore()
+	projector := repository.NewProjector(scratch)
+
+	type stream struct {
+		id         uuid.UUID
+		entityType string
+	}
+	var streams []stream
+	seen := make(map[uuid.UUID]bool)
+	failed := make(map[uuid.UUID]bool)
+	failures := []ProjectionDiscrepancy{}
+	replayed := 0
+
+	var position int64
+	for {
+		events, err := s.eventStore.ReadAll(ctx, position, projectionReplayBatch)
+		if err != nil {
+			return nil, fmt.Errorf("reading event log: %w", err)
+		}
+		for i := range events {
+			evt := &events[i]
+			position = evt.Position
+
+			// Stream types are not consistently cased ("Person" vs "person")
+			entityType := strings.ToLower(evt.StreamType)
+			if entityType == "media" {
+				continue // Media content can be large and no checked projection reads it
+			}
+
+			domainEvent, err := evt.DecodeEvent()
+			if err == nil {
+				err = projector.Project(ctx, domainEvent, evt.Version)
+			}
+			if err != nil {
+				if !failed[evt.StreamID] {
+					failed[evt.StreamID] = true
+					failures = append(failures, ProjectionDiscrepancy{
+						EntityType: entityType,
+						EntityID:   evt.StreamID,
+						Issue:      ProjectionFailed,
+						Error:      fmt.Sprintf("event %d (%s): %v", evt.Version, evt.EventType, err),
+					})
+				}
+				continue
+			}
+			replayed++
+
+			switch entityType {
+			case "person", "family", "source", "citation":
+				if !seen[evt.StreamID] {
+					seen[evt.StreamID] = true
+					streams = append(streams, stream{id: evt.StreamID, entityType: entityType})
+				}
+			}
+		}
+		if len(events) < projectionReplayBatch {
+			break
+		}
+	}
+
+	// Rebuilt state for a stream with a failed event is incomplete, so
+	// comparing it would only repeat the failure as a mismatch
+	streams = slices.DeleteFunc(streams, func(st stream) bool { return failed[st.id] })
+
+	if sample > 0 && sample < len(streams) {
+		rand.Shuffle(len(streams), func(i, j int) {
+			streams[i], streams[j] = streams[j], streams[i]
+		})
+		streams = streams[:sample]
+	}
+
+	result := &ProjectionCheckResult{
+		Checked:        len(streams),
+		EventsReplayed: replayed,
+		Discrepancies:  failures,
+	}
+	for _, st := range streams {
+		discrepancy, err := s.compareEntity(ctx, scratch, st.entityType, st.id)
+		if err != nil {
+			return nil, err
+		}
+		if discrepancy != nil {
+			result.Discrepancies = append(result.Discrepancies, *discrepancy)
+		}
+	}
+
+	return result, nil
+}
+
+// compareEntity compares one entity in the rebuilt and live read models,
+// returning nil when they agree.
+func (s *ProjectionCheckService) compareEntity(ctx context.Context, rebuilt repository.ReadModelStore, entityType string, id uuid.UUID) (*ProjectionDiscrepancy, error) {
+	var (
+		expectedExists, actualExists bool
+		fields                       []FieldMismatch
+	)
+	check := func(field, expected, actual string) {
+		if expected != actual {
+			fields = append(fields, FieldMismatch{Field: field, Expected: expected, Actual: actual})
+		}
+	}
+
+	switch entityType {
+	case "person":
+		expected, err := rebuilt.GetPerson(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := s.readStore.GetPerson(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		expectedExists, actualExists = expected != nil, actual != nil
+		if expectedExists && actualExists {
+			check("given_name", expected.GivenName, actual.GivenName)
+			check("surname", expected.Surname, actual.Surname)
+			check("gender", string(expected.Gender), string(actual.Gender))
+			check("birth_date", expected.BirthDateRaw, actual.BirthDateRaw)
+			check("birth_place", expected.BirthPlace, actual.BirthPlace)
+			check("death_date", expected.DeathDateRaw, actual.DeathDateRaw)
+			check("death_place", expected.DeathPlace, actual.DeathPlace)
+			check("notes", expected.Notes, actual.Notes)
+			check("research_status", string(expected.ResearchStatus), string(actual.ResearchStatus))
+			check("version", strconv.FormatInt(expected.Version, 10), strconv.FormatInt(actual.Version, 10))
+		}
+
+	case "family":
+		expected, err := rebuilt.GetFamily(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := s.readStore.GetFamily(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		expectedExists, actualExists = expected != nil, actual != nil
+		if expectedExists && actualExists {
+			check("partner1_id", optionalID(expected.Partner1ID), optionalID(actual.Partner1ID))
+			check("partner2_id", optionalID(expected.Partner2ID), optionalID(actual.Partner2ID))
+			check("relationship_type", string(expected.RelationshipType), string(actual.RelationshipType))
+			check("marriage_date", expected.MarriageDateRaw, actual.MarriageDateRaw)
+			check("marriage_place", expected.MarriagePlace, actual.MarriagePlace)
+			check("research_status", string(expected.ResearchStatus), string(actual.ResearchStatus))
+			check("child_count", strconv.Itoa(expected.ChildCount), strconv.Itoa(actual.ChildCount))
+			check("version", strconv.FormatInt(expected.Version, 10), strconv.FormatInt(actual.Version, 10))
+		}
+
+	case "source":
+		expected, err := rebuilt.GetSource(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := s.readStore.GetSource(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		expectedExists, actualExists = expected != nil, actual != nil
+		if expectedExists && actualExists {
+			check("source_type", string(expected.SourceType), string(actual.SourceType))
+			check("title", expected.Title, actual.Title)
+			check("author", expected.Author, actual.Author)
+			check("publisher", expected.Publisher, actual.Publisher)
+			check("publish_date", expected.PublishDateRaw, actual.PublishDateRaw)
+			check("url", expected.URL, actual.URL)
+			check("repository_name", expected.RepositoryName, actual.RepositoryName)
+			check("collection_name", expected.CollectionName, actual.CollectionName)
+			check("call_number", expected.CallNumber, actual.CallNumber)
+			check("notes", expected.Notes, actual.Notes)
+			check("version", strconv.FormatInt(expected.Version, 10), strconv.FormatInt(actual.Version, 10))
+		}
+
+	case "citation":
+		expected, err := rebuilt.GetCitation(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := s.readStore.GetCitation(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		expectedExists, actualExists = expected != nil, actual != nil
+		if expectedExists && actualExists {
+			check("source_id", expected.SourceID.String(), actual.SourceID.String())
+			check("fact_type", string(expected.FactType), string(actual.FactType))
+			check("fact_owner_id", expected.FactOwnerID.String(), actual.FactOwnerID.String())
+			check("page", expected.Page, actual.Page)
+			check("volume", expected.Volume, actual.Volume)
+			check("source_quality", string(expected.SourceQuality), string(actual.SourceQuality))
+			check("informant_type", string(expected.InformantType), string(actual.InformantType))
+			check("evidence_type", string(expected.EvidenceType), string(actual.EvidenceType))
+			check("quoted_text", expected.QuotedText, actual.QuotedText)
+			check("analysis", expected.Analysis, actual.Analysis)
+			check("version", strconv.FormatInt(expected.Version, 10), strconv.FormatInt(actual.Version, 10))
+		}
+	}
+
+	discrepancy := &ProjectionDiscrepancy{EntityType: entityType, EntityID: id}
+	switch {
+	case expectedExists && !actualExists:
+		discrepancy.Issue = ProjectionMissing
+	case !expectedExists && actualExists:
+		discrepancy.Issue = ProjectionUnexpected
+	case len(fields) > 0:
+		discrepancy.Issue = ProjectionMismatch
+		discrepancy.Fields = fields
+	default:
+		return nil, nil
+	}
+	return discrepancy, nil
+}
+
+// optionalID formats an optional ID, using an empty string for nil.
+func optionalID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,167 @@
+package query_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/domain"
+	"github.com/cacack/my-family/internal/query"
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/memory"
+)
+
+func newScratchReadStore() repository.ReadModelStore {
+	return memory.NewReadModelStore()
+}
+
+func TestVerifyProjections(t *testing.T) {
+	ctx := context.Background()
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	cmdHandler := command.NewHandler(eventStore, readStore)
+	svc := query.NewProjectionCheckService(eventStore, readStore, newScratchReadStore)
+
+	john, err := cmdHandler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Smith", BirthPlace: "NY"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mary, err := cmdHandler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Mary", Surname: "Jones"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	family, err := cmdHandler.CreateFamily(ctx, command.CreateFamilyInput{Partner1ID: &john.ID, Partner2ID: &mary.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cmdHandler.CreateSource(ctx, command.CreateSourceInput{SourceType: "census", Title: "1900 Census"}); err != nil {
+		t.Fatal(err)
+	}
+	place := "New York"
+	if _, err := cmdHandler.UpdatePerson(ctx, command.UpdatePersonInput{ID: john.ID, BirthPlace: &place, Version: john.Version}); err != nil {
+		t.Fatal(err)
+	}
+	ghost, err := cmdHandler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Ghost", Surname: "Person"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmdHandler.DeletePerson(ctx, command.DeletePersonInput{ID: ghost.ID, Version: ghost.Version}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := svc.VerifyProjections(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Checked != 5 {
+		t.Errorf("Checked = %d, want 5", result.Checked)
+	}
+	if result.EventsReplayed == 0 {
+		t.Error("EventsReplayed = 0, want events replayed")
+	}
+	if len(result.Discrepancies) != 0 {
+		t.Fatalf("Discrepancies = %+v, want none", result.Discrepancies)
+	}
+
+	// Drift the read model: edit a field, drop a family, resurrect a deleted person
+	drifted, _ := readStore.GetPerson(ctx, john.ID)
+	drifted.BirthPlace = "NY"
+	if err := readStore.SavePerson(ctx, drifted); err != nil {
+		t.Fatal(err)
+	}
+	if err := readStore.DeleteFamily(ctx, family.ID); err != nil {
+		t.Fatal(err)
+	}
+	resurrected := *drifted
+	resurrected.ID = ghost.ID
+	if err := readStore.SavePerson(ctx, &resurrected); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = svc.VerifyProjections(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues := make(map[string]query.ProjectionDiscrepancy)
+	for _, d := range result.Discrepancies {
+		issues[d.EntityID.String()] = d
+	}
+	if len(issues) != 3 {
+		t.Fatalf("Discrepancies = %+v, want 3", result.Discrepancies)
+	}
+
+	d := issues[john.ID.String()]
+	if d.Issue != query.ProjectionMismatch || len(d.Fields) != 1 {
+		t.Fatalf("John discrepancy = %+v, want one mismatched field", d)
+	}
+	if f := d.Fields[0]; f.Field != "birth_place" || f.Expected != "New York" || f.Actual != "NY" {
+		t.Errorf("Field = %+v, want birth_place New York -> NY", f)
+	}
+	if d := issues[family.ID.String()]; d.Issue != query.ProjectionMissing || d.EntityType != "family" {
+		t.Errorf("Family discrepancy = %+v, want missing family", d)
+	}
+	if d := issues[ghost.ID.String()]; d.Issue != query.ProjectionUnexpected {
+		t.Errorf("Ghost discrepancy = %+v, want unexpected", d)
+	}
+
+	// A sample limits how many entities are compared
+	result, err = svc.VerifyProjections(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Checked != 2 {
+		t.Errorf("Checked = %d, want 2", result.Checked)
+	}
+}
+
+// unknownEvent is an event the projector does not know how to decode.
+type unknownEvent struct {
+	domain.BaseEvent
+	PersonID uuid.UUID `json:"person_id"`
+}
+
+func (e unknownEvent) EventType() string      { return "PersonTeleported" }
+func (e unknownEvent) AggregateID() uuid.UUID { return e.PersonID }
+
+func TestVerifyProjections_ReplayFailure(t *testing.T) {
+	ctx := context.Background()
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	cmdHandler := command.NewHandler(eventStore, readStore)
+	svc := query.NewProjectionCheckService(eventStore, readStore, newScratchReadStore)
+
+	john, err := cmdHandler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Smith"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cmdHandler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Mary", Surname: "Jones"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := eventStore.Append(ctx, john.ID, "Person", []domain.Event{unknownEvent{PersonID: john.ID}}, john.Version); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := svc.VerifyProjections(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.EventsReplayed != 2 {
+		t.Errorf("EventsReplayed = %d, want 2 (the failed event is not counted)", result.EventsReplayed)
+	}
+	if result.Checked != 1 {
+		t.Errorf("Checked = %d, want 1 (John is reported, not compared)", result.Checked)
+	}
+	if len(result.Discrepancies) != 1 {
+		t.Fatalf("Discrepancies = %+v, want 1", result.Discrepancies)
+	}
+	d := result.Discrepancies[0]
+	if d.EntityID != john.ID || d.EntityType != "person" || d.Issue != query.ProjectionFailed {
+		t.Errorf("Discrepancy = %+v, want failed person John", d)
+	}
+	if !strings.Contains(d.Error, "PersonTeleported") {
+		t.Errorf("Error = %q, want it to name the failing event", d.Error)
+	}
+}
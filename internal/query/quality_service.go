@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -48,11 +49,90 @@ type PersonQuality struct {
 
 // Statistics contains tree-wide statistics.
 type Statistics struct {
-	TotalPersons       int                `json:"total_persons"`
-	TotalFamilies      int                `json:"total_families"`
-	DateRange          DateRange          `json:"date_range"`
-	TopSurnames        []SurnameCount     `json:"top_surnames"`
-	GenderDistribution GenderDistribution `json:"gender_distribution"`
+	TotalPersons       int                  `json:"total_persons"`
+	TotalFamilies      int                  `json:"total_families"`
+	DateRange          DateRange            `json:"date_range"`
+	TopSurnames        []SurnameCount       `json:"top_surnames"`
+	TopGivenNames      []NameCount          `json:"top_given_names"`
+	GenderDistribution GenderDistribution   `json:"gender_distribution"`
+	Living             LivingStatistics     `json:"living"`
+	Lifespan           LifespanStatistics   `json:"lifespan"`
+	BirthsByDecade     []DecadeCount        `json:"births_by_decade"`
+	FamilySize         FamilySizeStatistics `json:"family_size"`
+}
+
+// FamilySizeStatistics summarizes the number of children per family.
+type FamilySizeStatistics struct {
+	FamilyCount     int               `json:"family_count"`
+	AverageChildren *float64          `json:"average_children,omitempty"`
+	MedianChildren  *float64          `json:"median_children,omitempty"`
+	Distribution    []FamilySizeCount `json:"distribution"`
+}
+
+// FamilySizeCount is the number of families with a given number of children.
+type FamilySizeCount struct {
+	Children int `json:"children"`
+	Families int `json:"families"`
+}
+
+// presumedLifespan is the age beyond which a person with no recorded death is
+// presumed deceased.
+const presumedLifespan = 100
+
+// estimateDeceased reports whether person is deceased under the estimate
+// described on LivingStatistics, and whether it could be judged at all.
+func estimateDeceased(person *repository.PersonReadModel, asOfYear int) (deceased, known bool) {
+	if person.DeathDateRaw != "" || person.DeathPlace != "" {
+		return true, true
+	}
+	birthYear := domain.ParseGenDate(person.BirthDateRaw).Year
+	if birthYear == nil {
+		return false, false
+	}
+	return asOfYear-*birthYear > presumedLifespan, true
+}
+
+// LivingStatistics counts living and deceased persons. Few records state that
+// a person is alive, so living status is estimated: anyone with a death date
+// or place is deceased; anyone else born more than PresumedLifespan years
+// before AsOfYear is presumed deceased, and anyone born since is presumed
+// living. Persons with neither a death nor a birth year cannot be judged.
+type LivingStatistics struct {
+	Living           int `json:"living"`
+	Deceased         int `json:"deceased"`
+	PresumedDeceased int `json:"presumed_deceased"` // Of Deceased, those with no death recorded
+	Unknown          int `json:"unknown"`
+	PresumedLifespan int `json:"presumed_lifespan"` // Years
+	AsOfYear         int `json:"as_of_year"`
+}
+
+// DecadeCount is the number of births in a decade.
+type DecadeCount struct {
+	Decade int `json:"decade"` // First year of the decade, e.g. 1850
+	Count  int `json:"count"`
+}
+
+// Plausible bounds for age at death; ages outside them are treated as data errors.
+const (
+	minAgeAtDeath = 0
+	maxAgeAtDeath = 120
+)
+
+// LifespanStatistics summarizes age at death for persons with both a birth and
+// a death date.
+type LifespanStatistics struct {
+	PersonCount int               `json:"person_count"`
+	AverageAge  *float64          `json:"average_age,omitempty"`
+	MedianAge   *float64          `json:"median_age,omitempty"`
+	ByCentury   []CenturyLifespan `json:"by_century"`
+}
+
+// CenturyLifespan summarizes age at death for persons born in one century.
+type CenturyLifespan struct {
+	Century     string  `json:"century"` // e.g. "1800s"
+	PersonCount int     `json:"person_count"`
+	AverageAge  float64 `json:"average_age"`
+	MedianAge   float64 `json:"median_age"`
 }
 
 // DateRange represents the range of birth dates in the tree.
@@ -67,11 +147,18 @@ type SurnameCount struct {
 	Count   int    `json:"count"`
 }
 
+// NameCount represents a given name with its count.
+type NameCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
 // GenderDistribution contains counts by gender.
 type GenderDistribution struct {
-	Male    int `json:"male"`
-	Female  int `json:"female"`
-	Unknown int `json:"unknown"`
+	Male      int `json:"male"`
+	Female    int `json:"female"`
+	Nonbinary int `json:"nonbinary"`
+	Unknown   int `json:"unknown"` // Unknown or not recorded
 }
 
 // DiscoverySuggestion represents an actionable research suggestion.
@@ -414,7 +501,12 @@ func (s *QualityService) GetStatistics(ctx context.Context) (*Statistics, error)
 	// Calculate statistics in a single pass
 	var earliestYear, latestYear *int
 	surnameCounts := make(map[string]int)
+	givenNameCounts := make(map[string]int)
 	genderDist := GenderDistribution{}
+	living := LivingStatistics{PresumedLifespan: presumedLifespan, AsOfYear: time.Now().Year()}
+	var ages []int
+	agesByCentury := make(map[int][]int)
+	birthsByDecade := make(map[int]int)
 
 	for _, person := range persons {
 		// Track birth year range
@@ -427,6 +519,34 @@ func (s *QualityService) GetStatistics(ctx context.Context) (*Statistics, error)
 				if latestYear == nil || *gd.Year > *latestYear {
 					latestYear = gd.Year
 				}
+				if t := gd.ToTime(); !t.IsZero() {
+					birthsByDecade[repository.BirthDecade(t)]++
+				}
+			}
+		}
+
+		// Estimate living status
+		deceased, known := estimateDeceased(&person, living.AsOfYear)
+		switch {
+		case !known:
+			living.Unknown++
+		case !deceased:
+			living.Living++
+		default:
+			living.Deceased++
+			if person.DeathDateRaw == "" && person.DeathPlace == "" {
+				living.PresumedDeceased++
+			}
+		}
+
+		// Collect age at death
+		if person.BirthDateRaw != "" && person.DeathDateRaw != "" {
+			birth := domain.ParseGenDate(person.BirthDateRaw)
+			death := domain.ParseGenDate(person.DeathDateRaw)
+			if age, ok := ageAtDeath(birth, death); ok {
+				ages = append(ages, age)
+				century := birth.ToTime().Year() / 100 * 100
+				agesByCentury[century] = append(agesByCentury[century], age)
 			}
 		}
 
@@ -435,12 +555,19 @@ func (s *QualityService) GetStatistics(ctx context.Context) (*Statistics, error)
 			surnameCounts[person.Surname]++
 		}
 
+		// Count given names by first token, so "William Henry" counts as "William"
+		if fields := strings.Fields(person.GivenName); len(fields) > 0 {
+			givenNameCounts[fields[0]]++
+		}
+
 		// Count genders
 		switch person.Gender {
 		case domain.GenderMale:
 			genderDist.Male++
 		case domain.GenderFemale:
 			genderDist.Female++
+		case domain.GenderNonbinary:
+			genderDist.Nonbinary++
 		default:
 			genderDist.Unknown++
 		}
@@ -469,15 +596,267 @@ func (s *QualityService) GetStatistics(ctx context.Context) (*Statistics, error)
 		topSurnames = topSurnames[:10]
 	}
 
+	// Sort given names by count (then name, for stable ties) and take top 10
+	topGivenNames := make([]NameCount, 0, len(givenNameCounts))
+	for name, count := range givenNameCounts {
+		topGivenNames = append(topGivenNames, NameCount{Name: name, Count: count})
+	}
+	sort.Slice(topGivenNames, func(i, j int) bool {
+		if topGivenNames[i].Count != topGivenNames[j].Count {
+			return topGivenNames[i].Count > topGivenNames[j].Count
+		}
+		return topGivenNames[i].Name < topGivenNames[j].Name
+	})
+	if len(topGivenNames) > 10 {
+		topGivenNames = topGivenNames[:10]
+	}
+
 	return &Statistics{
 		TotalPersons:       totalPersons,
 		TotalFamilies:      totalFamilies,
 		DateRange:          dateRange,
 		TopSurnames:        topSurnames,
+		TopGivenNames:      topGivenNames,
 		GenderDistribution: genderDist,
+		Living:             living,
+		Lifespan:           buildLifespanStatistics(ages, agesByCentury),
+		BirthsByDecade:     buildDecadeHistogram(birthsByDecade),
+		FamilySize:         buildFamilySizeStatistics(families),
+	}, nil
+}
+
+// buildFamilySizeStatistics counts families by number of children, from
+// childless up to the largest family, including sizes no family has so the
+// distribution can be charted directly.
+func buildFamilySizeStatistics(families []repository.FamilyReadModel) FamilySizeStatistics {
+	stats := FamilySizeStatistics{
+		FamilyCount:  len(families),
+		Distribution: make([]FamilySizeCount, 0),
+	}
+	if len(families) == 0 {
+		return stats
+	}
+
+	sizes := make([]int, len(families))
+	for i, f := range families {
+		sizes[i] = f.ChildCount
+	}
+	avg, median := averageAndMedian(sizes)
+	stats.AverageChildren = &avg
+	stats.MedianChildren = &median
+
+	// sizes is sorted, so the last is the largest family
+	stats.Distribution = make([]FamilySizeCount, sizes[len(sizes)-1]+1)
+	for i := range stats.Distribution {
+		stats.Distribution[i].Children = i
+	}
+	for _, n := range sizes {
+		stats.Distribution[n].Families++
+	}
+	return stats
+}
+
+// buildDecadeHistogram returns decade counts from the earliest to the latest
+// decade, including empty decades so the result can be charted directly.
+func buildDecadeHistogram(counts map[int]int) []DecadeCount {
+	histogram := make([]DecadeCount, 0)
+	if len(counts) == 0 {
+		return histogram
+	}
+
+	first, last := 0, 0
+	started := false
+	for decade := range counts {
+		if !started || decade < first {
+			first = decade
+		}
+		if !started || decade > last {
+			last = decade
+		}
+		started = true
+	}
+	for decade := first; decade <= last; decade += 10 {
+		histogram = append(histogram, DecadeCount{Decade: decade, Count: counts[decade]})
+	}
+	return histogram
+}
+
+// ageAtDeath returns the age in completed years between a birth and a death
+// date. Returns false if either date has no year or the age is implausible.
+func ageAtDeath(birth, death domain.GenDate) (int, bool) {
+	return ageAt(birth, death, minAgeAtDeath, maxAgeAtDeath)
+}
+
+// ageAt returns the age in completed years on date, given a birth date. When
+// either date lacks a month, the age is the difference in years. Returns false
+// if either date has no year or the age is outside [minAge, maxAge].
+func ageAt(birth, date domain.GenDate, minAge, maxAge int) (int, bool) {
+	if birth.Year == nil || date.Year == nil {
+		return 0, false
+	}
+	b, d := birth.ToTime(), date.ToTime()
+	if b.IsZero() || d.IsZero() {
+		return 0, false
+	}
+
+	age := d.Year() - b.Year()
+	if birth.Month != nil && date.Month != nil {
+		if d.Month() < b.Month() ||
+			(d.Month() == b.Month() && birth.Day != nil && date.Day != nil && d.Day() < b.Day()) {
+			age--
+		}
+	}
+
+	if age < minAge || age > maxAge {
+		return 0, false
+	}
+	return age, true
+}
+
+// Plausible bounds for age at marriage.
+const (
+	minAgeAtMarriage = 12
+	maxAgeAtMarriage = 100
+)
+
+// MarriageAgeStatistics summarizes age at first marriage by gender.
+type MarriageAgeStatistics struct {
+	Overall AgeSummary `json:"overall"`
+	Male    AgeSummary `json:"male"`
+	Female  AgeSummary `json:"female"`
+	Unknown AgeSummary `json:"unknown"`
+}
+
+// AgeSummary is the average and median of a set of ages. The averages are nil
+// when PersonCount is 0.
+type AgeSummary struct {
+	PersonCount int      `json:"person_count"`
+	AverageAge  *float64 `json:"average_age,omitempty"`
+	MedianAge   *float64 `json:"median_age,omitempty"`
+}
+
+// GetMarriageAgeStatistics returns the age at first marriage by gender. Each
+// partner's earliest dated marriage is compared with their birth date; partners
+// without both dates, or with an implausible age, are skipped.
+func (s *QualityService) GetMarriageAgeStatistics(ctx context.Context) (*MarriageAgeStatistics, error) {
+	persons, err := repository.ListAll(ctx, 1000, s.readStore.ListPersons)
+	if err != nil {
+		return nil, err
+	}
+	families, err := repository.ListAll(ctx, 1000, s.readStore.ListFamilies)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find each partner's first marriage
+	firstMarriage := make(map[uuid.UUID]domain.GenDate)
+	for _, f := range families {
+		if f.MarriageDateRaw == "" {
+			continue
+		}
+		marriage := domain.ParseGenDate(f.MarriageDateRaw)
+		if marriage.ToTime().IsZero() {
+			continue
+		}
+		for _, partnerID := range []*uuid.UUID{f.Partner1ID, f.Partner2ID} {
+			if partnerID == nil {
+				continue
+			}
+			if current, ok := firstMarriage[*partnerID]; !ok || marriage.Before(&current) {
+				firstMarriage[*partnerID] = marriage
+			}
+		}
+	}
+
+	var all, male, female, unknown []int
+	for _, person := range persons {
+		marriage, ok := firstMarriage[person.ID]
+		if !ok || person.BirthDateRaw == "" {
+			continue
+		}
+		age, ok := ageAt(domain.ParseGenDate(person.BirthDateRaw), marriage, minAgeAtMarriage, maxAgeAtMarriage)
+		if !ok {
+			continue
+		}
+		all = append(all, age)
+		switch person.Gender {
+		case domain.GenderMale:
+			male = append(male, age)
+		case domain.GenderFemale:
+			female = append(female, age)
+		default:
+			unknown = append(unknown, age)
+		}
+	}
+
+	return &MarriageAgeStatistics{
+		Overall: summarizeAges(all),
+		Male:    summarizeAges(male),
+		Female:  summarizeAges(female),
+		Unknown: summarizeAges(unknown),
 	}, nil
 }
 
+// summarizeAges returns the count, average, and median of ages.
+func summarizeAges(ages []int) AgeSummary {
+	summary := AgeSummary{PersonCount: len(ages)}
+	if len(ages) > 0 {
+		avg, median := averageAndMedian(ages)
+		summary.AverageAge = &avg
+		summary.MedianAge = &median
+	}
+	return summary
+}
+
+// buildLifespanStatistics computes overall and per-century lifespan summaries.
+func buildLifespanStatistics(ages []int, agesByCentury map[int][]int) LifespanStatistics {
+	stats := LifespanStatistics{
+		PersonCount: len(ages),
+		ByCentury:   make([]CenturyLifespan, 0, len(agesByCentury)),
+	}
+	if len(ages) > 0 {
+		avg, median := averageAndMedian(ages)
+		stats.AverageAge = &avg
+		stats.MedianAge = &median
+	}
+
+	centuries := make([]int, 0, len(agesByCentury))
+	for century := range agesByCentury {
+		centuries = append(centuries, century)
+	}
+	sort.Ints(centuries)
+	for _, century := range centuries {
+		avg, median := averageAndMedian(agesByCentury[century])
+		stats.ByCentury = append(stats.ByCentury, CenturyLifespan{
+			Century:     intToString(century) + "s",
+			PersonCount: len(agesByCentury[century]),
+			AverageAge:  avg,
+			MedianAge:   median,
+		})
+	}
+
+	return stats
+}
+
+// averageAndMedian returns the mean and median of a non-empty slice, rounded to
+// one decimal place. The slice is sorted in place.
+func averageAndMedian(values []int) (float64, float64) {
+	sort.Ints(values)
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	avg := float64(sum) / float64(len(values))
+
+	mid := len(values) / 2
+	median := float64(values[mid])
+	if len(values)%2 == 0 {
+		median = float64(values[mid-1]+values[mid]) / 2
+	}
+
+	return math.Round(avg*10) / 10, math.Round(median*10) / 10
+}
+
 // computePersonScore calculates the quality score for a person (single-person path).
 // Loads conflicts from the store for the specific person.
 // Used by GetPersonQuality where per-call loading is acceptable.
@@ -540,7 +919,7 @@ func computePersonScoreWithConflicts(person repository.PersonReadModel, conflict
 	}
 
 	// Determine if person is likely deceased (birth > 100 years ago)
-	likelyDeceased := birthYear != nil && currentYear-*birthYear > 100
+	likelyDeceased := birthYear != nil && currentYear-*birthYear > presumedLifespan
 
 	// Has death date: +20 points (or +20 if living)
 	if person.DeathDateRaw != "" {
@@ -737,6 +1116,277 @@ func (s *QualityService) generateSuggestions(issues []string) []string {
 	return suggestions
 }
 
+// Missing data kinds reported by GetMissingDataReport.
+const (
+	MissingBirthDate = "birth_date"
+	MissingDeathDate = "death_date"
+	MissingParents   = "parents"
+	MissingSources   = "sources"
+)
+
+// missingDataWeights is the priority each gap adds to a person's score. Missing
+// parents and birth dates weigh most: they block extending the tree and
+// identifying the person in records.
+var missingDataWeights = map[string]int{
+	MissingBirthDate: 3,
+	MissingParents:   3,
+	MissingSources:   2,
+	MissingDeathDate: 2,
+}
+
+// MissingDataItem is a person with gaps in their key facts.
+type MissingDataItem struct {
+	PersonID          uuid.UUID `json:"person_id"`
+	PersonName        string    `json:"person_name"`
+	Missing           []string  `json:"missing"`            // Missing* kinds
+	PriorityScore     int       `json:"priority_score"`     // Sum of the gap weights; higher means research first
+	CompletenessScore float64   `json:"completeness_score"` // As reported by GetPersonQuality
+}
+
+// MissingDataReport lists persons with research gaps, highest priority first.
+type MissingDataReport struct {
+	Items  []MissingDataItem `json:"items"`
+	Total  int               `json:"total"`
+	Limit  int               `json:"limit"`
+	Offset int               `json:"offset"`
+}
+
+// GetMissingDataReport returns the persons missing a birth date, a death date
+// (when likely deceased), parents, or any citation, ordered by a priority score
+// built from those gaps. Persons with no gaps are omitted.
+func (s *QualityService) GetMissingDataReport(ctx context.Context, limit, offset int) (*MissingDataReport, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	persons, err := repository.ListAll(ctx, 1000, s.readStore.ListPersons)
+	if err != nil {
+		return nil, err
+	}
+	conflicts, err := s.readStore.ListUnresolvedConflicts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load unresolved conflicts: %w", err)
+	}
+	citations, err := repository.ListAll(ctx, 1000, s.readStore.ListCitations)
+	if err != nil {
+		return nil, fmt.Errorf("load citations: %w", err)
+	}
+	cited := make(map[uuid.UUID]bool)
+	for _, c := range citations {
+		if strings.HasPrefix(string(c.FactType), "person_") {
+			cited[c.FactOwnerID] = true
+		}
+	}
+	withParents, err := s.buildPersonsWithParents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currentYear := time.Now().Year()
+	items := []MissingDataItem{}
+	for _, person := range persons {
+		var missing []string
+		var birthYear *int
+		if person.BirthDateRaw != "" {
+			birthYear = domain.ParseGenDate(person.BirthDateRaw).Year
+		}
+		if birthYear == nil {
+			missing = append(missing, MissingBirthDate)
+		}
+		if person.DeathDateRaw == "" && birthYear != nil && currentYear-*birthYear > 100 {
+			missing = append(missing, MissingDeathDate)
+		}
+		if !withParents[person.ID] {
+			missing = append(missing, MissingParents)
+		}
+		if !cited[person.ID] {
+			missing = append(missing, MissingSources)
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		priority := 0
+		for _, m := range missing {
+			priority += missingDataWeights[m]
+		}
+		completeness, _ := s.computePersonScoreBulk(person, conflicts)
+		items = append(items, MissingDataItem{
+			PersonID:          person.ID,
+			PersonName:        person.FullName,
+			Missing:           missing,
+			PriorityScore:     priority,
+			CompletenessScore: completeness,
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].PriorityScore != items[j].PriorityScore {
+			return items[i].PriorityScore > items[j].PriorityScore
+		}
+		if items[i].CompletenessScore != items[j].CompletenessScore {
+			return items[i].CompletenessScore < items[j].CompletenessScore
+		}
+		return items[i].PersonName < items[j].PersonName
+	})
+
+	total := len(items)
+	end := offset + limit
+	if offset > total {
+		offset = total
+	}
+	if end > total {
+		end = total
+	}
+
+	return &MissingDataReport{
+		Items:  items[offset:end],
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// SurnameQuality summarizes completeness and gaps for the persons sharing a
+// surname.
+type SurnameQuality struct {
+	Surname             string  `json:"surname"`
+	PersonCount         int     `json:"person_count"`
+	AverageCompleteness float64 `json:"average_completeness"` // 0-100
+	RecordsWithIssues   int     `json:"records_with_issues"`
+	MissingBirthDates   int     `json:"missing_birth_dates"`
+	UnsourcedBirths     int     `json:"unsourced_births"` // Birth recorded without a birth citation
+}
+
+// SurnameQualityReport lists per-surname quality, least complete first.
+type SurnameQualityReport struct {
+	Items  []SurnameQuality `json:"items"`
+	Total  int              `json:"total"`
+	Limit  int              `json:"limit"`
+	Offset int              `json:"offset"`
+}
+
+// GetQualityBySurname returns completeness and issue counts for each surname in
+// the surname index, ordered by lowest average completeness so the branches
+// that need the most work come first. Persons match a surname
+// case-insensitively, as when browsing by surname.
+func (s *QualityService) GetQualityBySurname(ctx context.Context, limit, offset int) (*SurnameQualityReport, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	surnames, _, err := s.readStore.GetSurnameIndex(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load surname index: %w", err)
+	}
+	persons, err := repository.ListAll(ctx, 1000, s.readStore.ListPersons)
+	if err != nil {
+		return nil, err
+	}
+	conflicts, err := s.readStore.ListUnresolvedConflicts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load unresolved conflicts: %w", err)
+	}
+	citations, err := repository.ListAll(ctx, 1000, s.readStore.ListCitations)
+	if err != nil {
+		return nil, fmt.Errorf("load citations: %w", err)
+	}
+	birthCited := make(map[uuid.UUID]bool)
+	for _, c := range citations {
+		if c.FactType == domain.FactPersonBirth {
+			birthCited[c.FactOwnerID] = true
+		}
+	}
+
+	bySurname := make(map[string][]repository.PersonReadModel)
+	for _, p := range persons {
+		key := strings.ToLower(p.Surname)
+		bySurname[key] = append(bySurname[key], p)
+	}
+
+	items := []SurnameQuality{}
+	seen := make(map[string]bool)
+	for _, entry := range surnames {
+		key := strings.ToLower(entry.Surname)
+		if entry.Surname == "" || seen[key] || len(bySurname[key]) == 0 {
+			continue
+		}
+		seen[key] = true
+
+		item := SurnameQuality{Surname: entry.Surname}
+		var totalScore float64
+		for _, person := range bySurname[key] {
+			score, issues := s.computePersonScoreBulk(person, conflicts)
+			totalScore += score
+			item.PersonCount++
+			if len(issues) > 0 {
+				item.RecordsWithIssues++
+			}
+			if person.BirthDateRaw == "" || domain.ParseGenDate(person.BirthDateRaw).Year == nil {
+				item.MissingBirthDates++
+			}
+			if (person.BirthDateRaw != "" || person.BirthPlace != "") && !birthCited[person.ID] {
+				item.UnsourcedBirths++
+			}
+		}
+		item.AverageCompleteness = totalScore / float64(item.PersonCount)
+		items = append(items, item)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].AverageCompleteness != items[j].AverageCompleteness {
+			return items[i].AverageCompleteness < items[j].AverageCompleteness
+		}
+		return items[i].Surname < items[j].Surname
+	})
+
+	total := len(items)
+	end := offset + limit
+	if offset > total {
+		offset = total
+	}
+	if end > total {
+		end = total
+	}
+
+	return &SurnameQualityReport{
+		Items:  items[offset:end],
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// buildPersonsWithParents returns the set of person IDs linked as a child in a
+// family with at least one partner.
+func (s *QualityService) buildPersonsWithParents(ctx context.Context) (map[uuid.UUID]bool, error) {
+	families, err := repository.ListAll(ctx, 1000, s.readStore.ListFamilies)
+	if err != nil {
+		return nil, err
+	}
+
+	withParents := make(map[uuid.UUID]bool)
+	for _, f := range families {
+		if f.Partner1ID == nil && f.Partner2ID == nil {
+			continue
+		}
+		children, err := s.readStore.GetFamilyChildren(ctx, f.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range children {
+			withParents[c.PersonID] = true
+		}
+	}
+	return withParents, nil
+}
+
 // intToString converts an int to a string.
 func intToString(n int) string {
 	return strconv.Itoa(n)
@@ -2,7 +2,9 @@ package query_test
 
 import (
 	"context"
+	"math"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -716,6 +718,231 @@ func TestGetStatistics_EmptyDatabase(t *testing.T) {
 	}
 }
 
+// TestGetStatistics_Lifespan tests age-at-death statistics
+func TestGetStatistics_Lifespan(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	service := query.NewQualityService(readStore)
+	ctx := context.Background()
+
+	persons := []struct {
+		birth string
+		death string
+	}{
+		{"10 MAY 1820", "9 MAY 1880"}, // 59, one day short of 60
+		{"1825", "1895"},              // 70
+		{"ABT 1850", "1900"},          // 50
+		{"1910", "1990"},              // 80
+		{"1700", "1900"},              // implausible, excluded
+		{"1900", ""},                  // no death date, excluded
+	}
+
+	for i, p := range persons {
+		person := createPersonReadModel(uuid.New(), "Person", strconv.Itoa(i))
+		person.BirthDateRaw = p.birth
+		person.DeathDateRaw = p.death
+		_ = readStore.SavePerson(ctx, &person)
+	}
+
+	result, err := service.GetStatistics(ctx)
+	if err != nil {
+		t.Fatalf("GetStatistics failed: %v", err)
+	}
+
+	lifespan := result.Lifespan
+	if lifespan.PersonCount != 4 {
+		t.Fatalf("PersonCount = %d, want 4", lifespan.PersonCount)
+	}
+	if lifespan.AverageAge == nil || *lifespan.AverageAge != 64.8 {
+		t.Errorf("AverageAge = %v, want 64.8", lifespan.AverageAge)
+	}
+	if lifespan.MedianAge == nil || *lifespan.MedianAge != 64.5 {
+		t.Errorf("MedianAge = %v, want 64.5", lifespan.MedianAge)
+	}
+
+	want := []query.CenturyLifespan{
+		{Century: "1800s", PersonCount: 3, AverageAge: 59.7, MedianAge: 59},
+		{Century: "1900s", PersonCount: 1, AverageAge: 80, MedianAge: 80},
+	}
+	if len(lifespan.ByCentury) != len(want) {
+		t.Fatalf("ByCentury = %+v, want %+v", lifespan.ByCentury, want)
+	}
+	for i, w := range want {
+		if lifespan.ByCentury[i] != w {
+			t.Errorf("ByCentury[%d] = %+v, want %+v", i, lifespan.ByCentury[i], w)
+		}
+	}
+}
+
+// TestGetStatistics_Living tests the living/deceased estimate
+func TestGetStatistics_Living(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	service := query.NewQualityService(readStore)
+	ctx := context.Background()
+	thisYear := time.Now().Year()
+
+	persons := []struct {
+		birth, death, deathPlace string
+	}{
+		{strconv.Itoa(thisYear - 40), "", ""},  // living
+		{strconv.Itoa(thisYear - 100), "", ""}, // living, at the presumed lifespan
+		{strconv.Itoa(thisYear - 101), "", ""}, // presumed deceased
+		{"1900", "1980", ""},                   // deceased
+		{"", "", "Boston, MA"},                 // deceased, death place only
+		{"", "", ""},                           // unknown
+	}
+	for i, p := range persons {
+		person := createPersonReadModel(uuid.New(), "Person", strconv.Itoa(i))
+		person.BirthDateRaw = p.birth
+		person.DeathDateRaw = p.death
+		person.DeathPlace = p.deathPlace
+		_ = readStore.SavePerson(ctx, &person)
+	}
+
+	result, err := service.GetStatistics(ctx)
+	if err != nil {
+		t.Fatalf("GetStatistics failed: %v", err)
+	}
+
+	want := query.LivingStatistics{
+		Living:           2,
+		Deceased:         3,
+		PresumedDeceased: 1,
+		Unknown:          1,
+		PresumedLifespan: 100,
+		AsOfYear:         thisYear,
+	}
+	if result.Living != want {
+		t.Errorf("Living = %+v, want %+v", result.Living, want)
+	}
+}
+
+// TestGetStatistics_BirthsByDecade tests the births-per-decade histogram
+func TestGetStatistics_BirthsByDecade(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	service := query.NewQualityService(readStore)
+	ctx := context.Background()
+
+	for i, birth := range []string{"1851", "ABT 1859", "3 MAR 1880", "", "unknown"} {
+		person := createPersonReadModel(uuid.New(), "Person", strconv.Itoa(i))
+		person.BirthDateRaw = birth
+		_ = readStore.SavePerson(ctx, &person)
+	}
+
+	result, err := service.GetStatistics(ctx)
+	if err != nil {
+		t.Fatalf("GetStatistics failed: %v", err)
+	}
+
+	want := []query.DecadeCount{
+		{Decade: 1850, Count: 2},
+		{Decade: 1860, Count: 0},
+		{Decade: 1870, Count: 0},
+		{Decade: 1880, Count: 1},
+	}
+	if len(result.BirthsByDecade) != len(want) {
+		t.Fatalf("BirthsByDecade = %+v, want %+v", result.BirthsByDecade, want)
+	}
+	for i, w := range want {
+		if result.BirthsByDecade[i] != w {
+			t.Errorf("BirthsByDecade[%d] = %+v, want %+v", i, result.BirthsByDecade[i], w)
+		}
+	}
+}
+
+// TestGetStatistics_FamilySize tests the children-per-family distribution
+func TestGetStatistics_FamilySize(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	service := query.NewQualityService(readStore)
+	ctx := context.Background()
+
+	for _, children := range []int{0, 2, 2, 4, 0} {
+		family := &repository.FamilyReadModel{ID: uuid.New(), ChildCount: children}
+		if err := readStore.SaveFamily(ctx, family); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := service.GetStatistics(ctx)
+	if err != nil {
+		t.Fatalf("GetStatistics failed: %v", err)
+	}
+
+	size := result.FamilySize
+	if size.FamilyCount != 5 {
+		t.Errorf("FamilyCount = %d, want 5", size.FamilyCount)
+	}
+	if size.AverageChildren == nil || *size.AverageChildren != 1.6 {
+		t.Errorf("AverageChildren = %v, want 1.6", size.AverageChildren)
+	}
+	if size.MedianChildren == nil || *size.MedianChildren != 2 {
+		t.Errorf("MedianChildren = %v, want 2", size.MedianChildren)
+	}
+	want := []query.FamilySizeCount{
+		{Children: 0, Families: 2},
+		{Children: 1, Families: 0},
+		{Children: 2, Families: 2},
+		{Children: 3, Families: 0},
+		{Children: 4, Families: 1},
+	}
+	if len(size.Distribution) != len(want) {
+		t.Fatalf("Distribution = %+v, want %+v", size.Distribution, want)
+	}
+	for i, w := range want {
+		if size.Distribution[i] != w {
+			t.Errorf("Distribution[%d] = %+v, want %+v", i, size.Distribution[i], w)
+		}
+	}
+}
+
+// TestGetStatistics_TopGivenNames tests given name counts by first token
+func TestGetStatistics_TopGivenNames(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	service := query.NewQualityService(readStore)
+	ctx := context.Background()
+
+	for i, given := range []string{"William", "William Henry", "  William  ", "Mary Ann", "Anne", "Mary", ""} {
+		person := createPersonReadModel(uuid.New(), given, "Smith"+strconv.Itoa(i))
+		_ = readStore.SavePerson(ctx, &person)
+	}
+
+	result, err := service.GetStatistics(ctx)
+	if err != nil {
+		t.Fatalf("GetStatistics failed: %v", err)
+	}
+
+	want := []query.NameCount{
+		{Name: "William", Count: 3},
+		{Name: "Mary", Count: 2},
+		{Name: "Anne", Count: 1},
+	}
+	if len(result.TopGivenNames) != len(want) {
+		t.Fatalf("TopGivenNames = %+v, want %+v", result.TopGivenNames, want)
+	}
+	for i, w := range want {
+		if result.TopGivenNames[i] != w {
+			t.Errorf("TopGivenNames[%d] = %+v, want %+v", i, result.TopGivenNames[i], w)
+		}
+	}
+}
+
+// TestGetStatistics_NoLifespans tests that averages are omitted without data
+func TestGetStatistics_NoLifespans(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	service := query.NewQualityService(readStore)
+	ctx := context.Background()
+
+	result, err := service.GetStatistics(ctx)
+	if err != nil {
+		t.Fatalf("GetStatistics failed: %v", err)
+	}
+	if result.Lifespan.PersonCount != 0 || result.Lifespan.AverageAge != nil || result.Lifespan.MedianAge != nil {
+		t.Errorf("Lifespan = %+v, want empty", result.Lifespan)
+	}
+	if result.BirthsByDecade == nil || len(result.BirthsByDecade) != 0 {
+		t.Errorf("BirthsByDecade = %v, want empty slice", result.BirthsByDecade)
+	}
+}
+
 // TestGetStatistics_MultiplePeople tests statistics with multiple persons
 func TestGetStatistics_MultiplePeople(t *testing.T) {
 	readStore := memory.NewReadModelStore()
@@ -1725,3 +1952,205 @@ func TestGetQualityOverview_WithConflicts(t *testing.T) {
 		t.Errorf("RecordsWithIssues = %d, want 1", overview.RecordsWithIssues)
 	}
 }
+
+// TestGetMarriageAgeStatistics tests age at first marriage by gender
+func TestGetMarriageAgeStatistics(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	service := query.NewQualityService(readStore)
+	ctx := context.Background()
+
+	husband := createPersonReadModel(uuid.New(), "John", "Smith", withGender(domain.GenderMale))
+	husband.BirthDateRaw = "1850"
+	wife := createPersonReadModel(uuid.New(), "Mary", "Jones", withGender(domain.GenderFemale))
+	wife.BirthDateRaw = "15 JUN 1855"
+	secondWife := createPersonReadModel(uuid.New(), "Anne", "Brown", withGender(domain.GenderFemale))
+	secondWife.BirthDateRaw = "1860"
+	undated := createPersonReadModel(uuid.New(), "Bob", "White", withGender(domain.GenderMale))
+	for _, p := range []*repository.PersonReadModel{&husband, &wife, &secondWife, &undated} {
+		_ = readStore.SavePerson(ctx, p)
+	}
+
+	families := []repository.FamilyReadModel{
+		// Second marriage listed first; John's first marriage is still used
+		{ID: uuid.New(), Partner1ID: &husband.ID, Partner2ID: &secondWife.ID, MarriageDateRaw: "1890"},
+		{ID: uuid.New(), Partner1ID: &husband.ID, Partner2ID: &wife.ID, MarriageDateRaw: "1 JUN 1875"},
+		{ID: uuid.New(), Partner1ID: &undated.ID, MarriageDateRaw: "1900"},
+	}
+	for i := range families {
+		families[i].UpdatedAt = time.Now()
+		_ = readStore.SaveFamily(ctx, &families[i])
+	}
+
+	result, err := service.GetMarriageAgeStatistics(ctx)
+	if err != nil {
+		t.Fatalf("GetMarriageAgeStatistics failed: %v", err)
+	}
+
+	// John: 1875-1850 = 25; Mary: 1 JUN 1875 - 15 JUN 1855 = 19; Anne: 1890-1860 = 30
+	if result.Overall.PersonCount != 3 {
+		t.Errorf("Overall.PersonCount = %d, want 3", result.Overall.PersonCount)
+	}
+	if result.Male.PersonCount != 1 || result.Male.AverageAge == nil || *result.Male.AverageAge != 25 {
+		t.Errorf("Male = %+v, want one person aged 25", result.Male)
+	}
+	if result.Female.PersonCount != 2 || result.Female.AverageAge == nil || *result.Female.AverageAge != 24.5 {
+		t.Errorf("Female = %+v, want two persons averaging 24.5", result.Female)
+	}
+	if result.Unknown.PersonCount != 0 || result.Unknown.AverageAge != nil {
+		t.Errorf("Unknown = %+v, want empty", result.Unknown)
+	}
+}
+
+func TestGetMissingDataReport(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	service := query.NewQualityService(readStore)
+	ctx := context.Background()
+
+	currentYear := time.Now().Year()
+
+	// Parent: born long ago, no death date, no parents, no sources
+	parentID := uuid.New()
+	parent := createPersonReadModel(parentID, "Old", "Doe",
+		withBirthDate(strconv.Itoa(currentYear-150), currentYear-150))
+	_ = readStore.SavePerson(ctx, &parent)
+
+	// Child: linked to parent and cited, but no birth date
+	childID := uuid.New()
+	child := createPersonReadModel(childID, "Young", "Doe")
+	_ = readStore.SavePerson(ctx, &child)
+
+	// Complete: living, has parents and a citation; omitted from the report
+	completeID := uuid.New()
+	complete := createPersonReadModel(completeID, "Done", "Doe",
+		withBirthDate(strconv.Itoa(currentYear-30), currentYear-30))
+	_ = readStore.SavePerson(ctx, &complete)
+
+	familyID := uuid.New()
+	_ = readStore.SaveFamily(ctx, &repository.FamilyReadModel{ID: familyID, Partner1ID: &parentID, UpdatedAt: time.Now()})
+	_ = readStore.SaveFamilyChild(ctx, &repository.FamilyChildReadModel{FamilyID: familyID, PersonID: childID})
+	_ = readStore.SaveFamilyChild(ctx, &repository.FamilyChildReadModel{FamilyID: familyID, PersonID: completeID})
+	for _, id := range []uuid.UUID{childID, completeID} {
+		_ = readStore.SaveCitation(ctx, &repository.CitationReadModel{
+			ID:          uuid.New(),
+			SourceID:    uuid.New(),
+			FactType:    domain.FactPersonBirth,
+			FactOwnerID: id,
+		})
+	}
+
+	report, err := service.GetMissingDataReport(ctx, 10, 0)
+	if err != nil {
+		t.Fatalf("GetMissingDataReport failed: %v", err)
+	}
+
+	if report.Total != 2 {
+		t.Fatalf("Total = %d, want 2", report.Total)
+	}
+
+	first := report.Items[0]
+	if first.PersonID != parentID {
+		t.Errorf("First item = %s, want the parent (highest priority)", first.PersonName)
+	}
+	wantMissing := []string{query.MissingDeathDate, query.MissingParents, query.MissingSources}
+	if len(first.Missing) != len(wantMissing) {
+		t.Fatalf("Parent missing = %v, want %v", first.Missing, wantMissing)
+	}
+	for i, m := range wantMissing {
+		if first.Missing[i] != m {
+			t.Errorf("Parent missing[%d] = %q, want %q", i, first.Missing[i], m)
+		}
+	}
+	if first.PriorityScore != 7 {
+		t.Errorf("Parent priority = %d, want 7", first.PriorityScore)
+	}
+
+	second := report.Items[1]
+	if second.PersonID != childID || len(second.Missing) != 1 || second.Missing[0] != query.MissingBirthDate {
+		t.Errorf("Second item = %+v, want child missing only birth_date", second)
+	}
+
+	// Pagination
+	page, err := service.GetMissingDataReport(ctx, 1, 1)
+	if err != nil {
+		t.Fatalf("GetMissingDataReport failed: %v", err)
+	}
+	if page.Total != 2 || len(page.Items) != 1 || page.Items[0].PersonID != childID {
+		t.Errorf("Page = %+v, want only the child with total 2", page)
+	}
+}
+
+func TestGetQualityBySurname(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	service := query.NewQualityService(readStore)
+	ctx := context.Background()
+
+	currentYear := time.Now().Year()
+	born := strconv.Itoa(currentYear - 30)
+
+	// Hendersons: one complete and cited, one dated but uncited, one bare
+	cited := createPersonReadModel(uuid.New(), "Ann", "Henderson",
+		withBirthDate(born, currentYear-30), withBirthPlace("Leeds"))
+	uncited := createPersonReadModel(uuid.New(), "Bob", "henderson",
+		withBirthDate(born, currentYear-30), withBirthPlace("Leeds"))
+	bare := createPersonReadModel(uuid.New(), "Cal", "Henderson")
+	// Smith: complete and cited
+	smith := createPersonReadModel(uuid.New(), "Dee", "Smith",
+		withBirthDate(born, currentYear-30), withBirthPlace("York"))
+	// No surname: left out of the breakdown
+	unnamed := createPersonReadModel(uuid.New(), "Eve", "")
+	for _, p := range []repository.PersonReadModel{cited, uncited, bare, smith, unnamed} {
+		_ = readStore.SavePerson(ctx, &p)
+	}
+	for _, id := range []uuid.UUID{cited.ID, smith.ID} {
+		_ = readStore.SaveCitation(ctx, &repository.CitationReadModel{
+			ID:          uuid.New(),
+			SourceID:    uuid.New(),
+			FactType:    domain.FactPersonBirth,
+			FactOwnerID: id,
+		})
+	}
+
+	report, err := service.GetQualityBySurname(ctx, 10, 0)
+	if err != nil {
+		t.Fatalf("GetQualityBySurname failed: %v", err)
+	}
+
+	if report.Total != 2 {
+		t.Fatalf("Total = %d, want 2 (Henderson, Smith)", report.Total)
+	}
+
+	henderson := report.Items[0]
+	if !strings.EqualFold(henderson.Surname, "Henderson") {
+		t.Fatalf("First surname = %q, want Henderson (least complete)", henderson.Surname)
+	}
+	if henderson.PersonCount != 3 {
+		t.Errorf("Henderson PersonCount = %d, want 3 (matched case-insensitively)", henderson.PersonCount)
+	}
+	// (100 + 100 + 50) / 3
+	if math.Abs(henderson.AverageCompleteness-250.0/3) > 0.01 {
+		t.Errorf("Henderson AverageCompleteness = %.2f, want 83.33", henderson.AverageCompleteness)
+	}
+	if henderson.RecordsWithIssues != 1 {
+		t.Errorf("Henderson RecordsWithIssues = %d, want 1", henderson.RecordsWithIssues)
+	}
+	if henderson.MissingBirthDates != 1 {
+		t.Errorf("Henderson MissingBirthDates = %d, want 1", henderson.MissingBirthDates)
+	}
+	if henderson.UnsourcedBirths != 1 {
+		t.Errorf("Henderson UnsourcedBirths = %d, want 1", henderson.UnsourcedBirths)
+	}
+
+	smithItem := report.Items[1]
+	if smithItem.Surname != "Smith" || smithItem.AverageCompleteness != 100 || smithItem.UnsourcedBirths != 0 {
+		t.Errorf("Smith = %+v, want complete and sourced", smithItem)
+	}
+
+	// Pagination
+	page, err := service.GetQualityBySurname(ctx, 1, 1)
+	if err != nil {
+		t.Fatalf("GetQualityBySurname failed: %v", err)
+	}
+	if page.Total != 2 || len(page.Items) != 1 || page.Items[0].Surname != "Smith" {
+		t.Errorf("Page = %+v, want only Smith with total 2", page)
+	}
+}
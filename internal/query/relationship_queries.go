@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/google/uuid"
@@ -142,6 +143,16 @@ func (s *RelationshipService) GetRelationship(ctx context.Context, personID1, pe
 		infoA := ancestorsA[ca.person.ID]
 		infoB := ancestorsB[ca.person.ID]
 
+		// A direct ancestor is itself the lowest common ancestor for anyone
+		// above them: a parent is not also reported as an uncle through the
+		// grandparents
+		if direct, ok := ancestorsB[personID1]; ok && infoB.generation > direct.generation {
+			continue
+		}
+		if direct, ok := ancestorsA[personID2]; ok && infoA.generation > direct.generation {
+			continue
+		}
+
 		path := RelationshipPath{
 			PathFromA:           infoA.path,
 			PathFromB:           infoB.path,
@@ -495,3 +506,174 @@ func (s *RelationshipService) buildSummary(paths []RelationshipPath) string {
 
 	return strings.Join(names, "; ")
 }
+
+// Kinship report limits on the number of parent-child links between relatives.
+const (
+	defaultKinDegree = 4
+	maxKinDegree     = 10
+)
+
+// Kin is a blood relative found by a kinship walk.
+type Kin struct {
+	Person          Person `json:"person"`
+	Relationship    string `json:"relationship"`     // What the relative is to the subject, e.g. "1st cousin"
+	Degree          int    `json:"degree"`           // Parent-child links between the subject and the relative
+	GenerationsUp   int    `json:"generations_up"`   // Generations from the subject to the common ancestor
+	GenerationsDown int    `json:"generations_down"` // Generations from the common ancestor to the relative
+}
+
+// KinResult lists a person's relatives within a maximum degree.
+type KinResult struct {
+	Person    Person `json:"person"`
+	MaxDegree int    `json:"max_degree"`
+	Kin       []Kin  `json:"kin"`
+	Total     int    `json:"total"`
+}
+
+// kinStep is a position in the kinship walk: a person reached by climbing up
+// generations and then descending down. Once the walk descends it cannot
+// climb again, which keeps it to blood relatives.
+type kinStep struct {
+	personID uuid.UUID
+	up, down int
+}
+
+// GetKin returns everyone related to a person by blood within maxDegree
+// parent-child links (default 4, capped at 10), each labelled with the
+// relationship the calculator would give. The walk is breadth-first, so a
+// relative reachable along several lines is reported by the closest one.
+func (s *RelationshipService) GetKin(ctx context.Context, personID uuid.UUID, maxDegree int) (*KinResult, error) {
+	if maxDegree <= 0 {
+		maxDegree = defaultKinDegree
+	}
+	if maxDegree > maxKinDegree {
+		maxDegree = maxKinDegree
+	}
+
+	subjectRM, err := s.readStore.GetPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	if subjectRM == nil {
+		return nil, ErrNotFound
+	}
+
+	result := &KinResult{
+		Person:    convertReadModelToPerson(*subjectRM),
+		MaxDegree: maxDegree,
+		Kin:       []Kin{},
+	}
+
+	// A person can be reached both while climbing and while descending (e.g.
+	// through pedigree collapse); only the climbing state may go up further.
+	type stateKey struct {
+		personID   uuid.UUID
+		descending bool
+	}
+	seenState := map[stateKey]bool{{personID, false}: true}
+	found := map[uuid.UUID]bool{personID: true}
+	queue := []kinStep{{personID: personID}}
+
+	for len(queue) > 0 {
+		step := queue[0]
+		queue = queue[1:]
+		if step.up+step.down >= maxDegree {
+			continue
+		}
+
+		var next []kinStep
+		if step.down == 0 {
+			parents, err := s.parentIDs(ctx, step.personID)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range parents {
+				next = append(next, kinStep{personID: id, up: step.up + 1})
+			}
+		}
+		children, err := s.childIDs(ctx, step.personID)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range children {
+			next = append(next, kinStep{personID: id, up: step.up, down: step.down + 1})
+		}
+
+		for _, n := range next {
+			key := stateKey{n.personID, n.down > 0}
+			if seenState[key] {
+				continue
+			}
+			seenState[key] = true
+			queue = append(queue, n)
+
+			if found[n.personID] {
+				continue
+			}
+			rm, err := s.readStore.GetPerson(ctx, n.personID)
+			if err != nil {
+				return nil, err
+			}
+			if rm == nil {
+				continue
+			}
+			found[n.personID] = true
+			result.Kin = append(result.Kin, Kin{
+				Person:          convertReadModelToPerson(*rm),
+				Relationship:    s.getRelationshipName(n.up, n.down),
+				Degree:          n.up + n.down,
+				GenerationsUp:   n.up,
+				GenerationsDown: n.down,
+			})
+		}
+	}
+
+	sort.SliceStable(result.Kin, func(i, j int) bool {
+		a, b := result.Kin[i], result.Kin[j]
+		if a.Degree != b.Degree {
+			return a.Degree < b.Degree
+		}
+		return personDisplayName(a.Person) < personDisplayName(b.Person)
+	})
+	result.Total = len(result.Kin)
+
+	return result, nil
+}
+
+// parentIDs returns the partners of the family the person is a child in.
+// Partners are used rather than the pedigree edge so that parents without a
+// recorded gender are still found.
+func (s *RelationshipService) parentIDs(ctx context.Context, personID uuid.UUID) ([]uuid.UUID, error) {
+	family, err := s.readStore.GetChildFamily(ctx, personID)
+	if err != nil || family == nil {
+		return nil, err
+	}
+	var ids []uuid.UUID
+	if family.Partner1ID != nil {
+		ids = append(ids, *family.Partner1ID)
+	}
+	if family.Partner2ID != nil {
+		ids = append(ids, *family.Partner2ID)
+	}
+	return ids, nil
+}
+
+// childIDs returns the IDs of the children in every family the person is a
+// partner in.
+func (s *RelationshipService) childIDs(ctx context.Context, personID uuid.UUID) ([]uuid.UUID, error) {
+	families, err := s.readStore.GetFamiliesForPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	var ids []uuid.UUID
+	for _, family := range families {
+		children, err := s.readStore.GetFamilyChildren(ctx, family.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			ids = append(ids, child.PersonID)
+		}
+	}
+	return ids, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/cacack/my-family/internal/command"
 	"github.com/cacack/my-family/internal/domain"
 	"github.com/cacack/my-family/internal/query"
 	"github.com/cacack/my-family/internal/repository"
@@ -203,6 +204,40 @@ func TestGetRelationship_Grandparent(t *testing.T) {
 	}
 }
 
+func TestGetRelationship_ParentWithKnownGrandparent(t *testing.T) {
+	store := memory.NewReadModelStore()
+	svc := query.NewRelationshipService(store)
+	ctx := context.Background()
+
+	grandfather := createPerson(t, ctx, store, "George", "Doe", domain.GenderMale)
+	father := createPerson(t, ctx, store, "John", "Doe", domain.GenderMale)
+	child := createPerson(t, ctx, store, "Junior", "Doe", domain.GenderMale)
+
+	createParentChild(t, ctx, store, father, &grandfather, nil, "George Doe", "")
+	createParentChild(t, ctx, store, child, &father, nil, "John Doe", "")
+
+	// The grandfather is a common ancestor too, but must not add an
+	// "uncle/aunt" path on top of the direct line
+	for _, tc := range []struct {
+		a, b uuid.UUID
+		want string
+	}{
+		{child, father, "parent"},
+		{father, child, "child"},
+	} {
+		result, err := svc.GetRelationship(ctx, tc.a, tc.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Paths) != 1 {
+			t.Fatalf("Expected 1 path, got %d (%s)", len(result.Paths), result.Summary)
+		}
+		if result.Summary != tc.want {
+			t.Errorf("Summary = %q, want %q", result.Summary, tc.want)
+		}
+	}
+}
+
 func TestGetRelationship_GreatGrandparent(t *testing.T) {
 	store := memory.NewReadModelStore()
 	svc := query.NewRelationshipService(store)
@@ -1216,3 +1251,110 @@ func TestGetRelationship_OrdinalEdgeCases(t *testing.T) {
 		t.Errorf("Expected to find '5th cousin' relationship, got paths: %v", result.Paths)
 	}
 }
+
+func TestGetKin(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	store := memory.NewReadModelStore()
+	cmdHandler := command.NewHandler(eventStore, store)
+	svc := query.NewRelationshipService(store)
+	ctx := context.Background()
+
+	person := func(given string) uuid.UUID {
+		t.Helper()
+		res, err := cmdHandler.CreatePerson(ctx, command.CreatePersonInput{GivenName: given, Surname: "Doe"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.ID
+	}
+	family := func(partner1, partner2 *uuid.UUID, children ...uuid.UUID) {
+		t.Helper()
+		fam, err := cmdHandler.CreateFamily(ctx, command.CreateFamilyInput{Partner1ID: partner1, Partner2ID: partner2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, child := range children {
+			if _, err := cmdHandler.LinkChild(ctx, command.LinkChildInput{FamilyID: fam.ID, ChildID: child}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	grandpa, grandma := person("Grandpa"), person("Grandma")
+	dad, mom, uncle := person("Dad"), person("Mom"), person("Uncle")
+	me, sister, cousin := person("Me"), person("Sister"), person("Cousin")
+	son := person("Son")
+	family(&grandpa, &grandma, dad, uncle)
+	family(&dad, &mom, me, sister)
+	family(&uncle, nil, cousin)
+	family(&me, nil, son)
+
+	result, err := svc.GetKin(ctx, me, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[uuid.UUID]string{
+		son:     "child",
+		dad:     "parent",
+		mom:     "parent",
+		sister:  "sibling",
+		grandpa: "grandparent",
+		grandma: "grandparent",
+		uncle:   "uncle/aunt",
+	}
+	if result.Total != len(want) {
+		t.Errorf("Total = %d, want %d", result.Total, len(want))
+	}
+	for _, k := range result.Kin {
+		if want[k.Person.ID] != k.Relationship {
+			t.Errorf("%s: relationship = %q, want %q", k.Person.GivenName, k.Relationship, want[k.Person.ID])
+		}
+	}
+	for i := 1; i < len(result.Kin); i++ {
+		if result.Kin[i].Degree < result.Kin[i-1].Degree {
+			t.Errorf("Kin not sorted by degree at index %d", i)
+		}
+	}
+
+	// One more degree reaches the first cousin
+	result, err = svc.GetKin(ctx, me, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := result.Kin[len(result.Kin)-1]
+	if last.Person.ID != cousin || last.Relationship != "1st cousin" || last.Degree != 4 {
+		t.Errorf("Last kin = %s %q degree %d, want Cousin \"1st cousin\" degree 4", last.Person.GivenName, last.Relationship, last.Degree)
+	}
+}
+
+func TestGetKin_ExcludesSpouses(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	store := memory.NewReadModelStore()
+	cmdHandler := command.NewHandler(eventStore, store)
+	svc := query.NewRelationshipService(store)
+	ctx := context.Background()
+
+	husband, _ := cmdHandler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "John", Surname: "Doe"})
+	wife, _ := cmdHandler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Jane", Surname: "Doe"})
+	child, _ := cmdHandler.CreatePerson(ctx, command.CreatePersonInput{GivenName: "Jim", Surname: "Doe"})
+	fam, _ := cmdHandler.CreateFamily(ctx, command.CreateFamilyInput{Partner1ID: &husband.ID, Partner2ID: &wife.ID})
+	_, _ = cmdHandler.LinkChild(ctx, command.LinkChildInput{FamilyID: fam.ID, ChildID: child.ID})
+
+	result, err := svc.GetKin(ctx, husband.ID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 1 || result.Kin[0].Person.ID != child.ID {
+		t.Errorf("Kin = %+v, want only the child", result.Kin)
+	}
+}
+
+func TestGetKin_NotFound(t *testing.T) {
+	store := memory.NewReadModelStore()
+	svc := query.NewRelationshipService(store)
+
+	if _, err := svc.GetKin(context.Background(), uuid.New(), 4); err != query.ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
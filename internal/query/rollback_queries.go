@@ -28,6 +28,7 @@ var ErrNoEvents = errors.New("no events found for entity")
 type RollbackService struct {
 	eventStore repository.EventStore
 	readStore  repository.ReadModelStore
+	snapshots  repository.StreamSnapshotStore // nil disables snapshot-based replay
 }
 
 // NewRollbackService creates a new rollback query service.
@@ -38,12 +39,24 @@ func NewRollbackService(eventStore repository.EventStore, readStore repository.R
 	}
 }
 
+// NewRollbackServiceWithSnapshots creates a rollback query service that resumes
+// state reconstruction from the latest stream snapshot instead of replaying the
+// full event stream.
+func NewRollbackServiceWithSnapshots(eventStore repository.EventStore, readStore repository.ReadModelStore, snapshots repository.StreamSnapshotStore) *RollbackService {
+	return &RollbackService{
+		eventStore: eventStore,
+		readStore:  readStore,
+		snapshots:  snapshots,
+	}
+}
+
 // RestorePoint represents a point in time to which an entity can be restored.
 type RestorePoint struct {
 	Version      int64     `json:"version"`
 	Timestamp    time.Time `json:"timestamp"`
 	Action       string    `json:"action"` // "created", "updated", "deleted", "linked", "unlinked"
 	Summary      string    `json:"summary"`
+	UserID       *string   `json:"user_id,omitempty"` // Who made the change, if recorded
 	IsCurrent    bool      `json:"is_current"`
 	IsRestorable bool      `json:"is_restorable"` // false for deleted or current version
 }
@@ -115,8 +128,8 @@ func (s *RollbackService) GetRestorePoints(ctx context.Context, entityType strin
 	allPoints := make([]RestorePoint, 0, len(events))
 	for i := len(events) - 1; i >= 0; i-- {
 		evt := events[i]
-		action := s.eventTypeToAction(evt.EventType)
-		summary := s.buildChangeSummary(evt)
+		action := eventTypeToAction(evt.EventType)
+		summary := buildChangeSummary(evt)
 
 		point := RestorePoint{
 			Version:      evt.Version,
@@ -126,6 +139,9 @@ func (s *RollbackService) GetRestorePoints(ctx context.Context, entityType strin
 			IsCurrent:    evt.Version == currentVersion,
 			IsRestorable: evt.Version != currentVersion && !isDeleted,
 		}
+		if actor := evt.Actor(); actor != "" {
+			point.UserID = &actor
+		}
 		allPoints = append(allPoints, point)
 	}
 
@@ -161,6 +177,17 @@ func (s *RollbackService) GetStateAtVersion(ctx context.Context, entityType stri
 		return nil, ErrInvalidVersion
 	}
 
+	// Resume from the latest snapshot at or below the target version, if any
+	if s.snapshots != nil {
+		snapshot, err := s.snapshots.GetLatestStreamSnapshot(ctx, entityID, version)
+		if err == nil {
+			return s.replayFromSnapshot(ctx, entityType, entityID, version, snapshot)
+		}
+		if !errors.Is(err, repository.ErrStreamSnapshotNotFound) {
+			return nil, fmt.Errorf("reading stream snapshot: %w", err)
+		}
+	}
+
 	// Read all events for this entity
 	events, err := s.eventStore.ReadStream(ctx, entityID)
 	if err != nil {
@@ -209,6 +236,80 @@ func (s *RollbackService) GetStateAtVersion(ctx context.Context, entityType stri
 	}, nil
 }
 
+// replayFromSnapshot reconstructs the entity state at version by applying only
+// the events recorded after the snapshot.
+func (s *RollbackService) replayFromSnapshot(ctx context.Context, entityType string, entityID uuid.UUID, version int64, snapshot *repository.StreamSnapshot) (*EntityState, error) {
+	state := restoreSnapshotState(snapshot.State)
+	isDeleted := snapshot.IsDeleted
+
+	if version > snapshot.Version {
+		// Stream versions are contiguous from 1, so the events after the
+		// snapshot start at offset snapshot.Version.
+		page, err := s.eventStore.ReadByStream(ctx, entityID, int(version-snapshot.Version), int(snapshot.Version))
+		if err != nil {
+			return nil, fmt.Errorf("reading event stream: %w", err)
+		}
+		if int64(page.TotalCount) < version {
+			return nil, ErrInvalidVersion
+		}
+
+		for _, evt := range page.Events {
+			isDeleted, err = s.applyEventToState(evt, state)
+			if err != nil {
+				return nil, fmt.Errorf("applying event: %w", err)
+			}
+		}
+	}
+
+	return &EntityState{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Version:    version,
+		IsDeleted:  isDeleted,
+		State:      state,
+	}, nil
+}
+
+// BuildStreamSnapshot reconstructs the entity state at version and packages it
+// as a stream snapshot ready to be stored.
+func (s *RollbackService) BuildStreamSnapshot(ctx context.Context, entityType string, entityID uuid.UUID, version int64) (*repository.StreamSnapshot, error) {
+	entityState, err := s.GetStateAtVersion(ctx, entityType, entityID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.StreamSnapshot{
+		StreamID:   entityID,
+		StreamType: entityType,
+		Version:    version,
+		IsDeleted:  entityState.IsDeleted,
+		State:      entityState.State,
+		CreatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// restoreSnapshotState converts a persisted snapshot state back into the shape
+// produced by applyEventToState. JSON decoding yields []any for lists, while
+// replay expects children to be tracked as []string.
+func restoreSnapshotState(persisted map[string]any) map[string]any {
+	state := make(map[string]any, len(persisted))
+	for field, value := range persisted {
+		state[field] = value
+	}
+
+	if children, ok := state["children"].([]any); ok {
+		ids := make([]string, 0, len(children))
+		for _, child := range children {
+			if id, ok := child.(string); ok {
+				ids = append(ids, id)
+			}
+		}
+		state["children"] = ids
+	}
+
+	return state
+}
+
 // ComputeRollbackChanges computes the changes needed to rollback from current to target version.
 func (s *RollbackService) ComputeRollbackChanges(ctx context.Context, entityType string, entityID uuid.UUID, targetVersion int64) (*RollbackChanges, error) {
 	if targetVersion < 1 {
@@ -277,6 +378,20 @@ func (s *RollbackService) ComputeRollbackChanges(ctx context.Context, entityType
 	}, nil
 }
 
+// ComputeChangesBetween computes the changes that turn an entity's state at
+// fromVersion into its state at toVersion.
+func (s *RollbackService) ComputeChangesBetween(ctx context.Context, entityType string, entityID uuid.UUID, fromVersion, toVersion int64) (map[string]any, error) {
+	from, err := s.GetStateAtVersion(ctx, entityType, entityID, fromVersion)
+	if err != nil {
+		return nil, fmt.Errorf("getting state at version %d: %w", fromVersion, err)
+	}
+	to, err := s.GetStateAtVersion(ctx, entityType, entityID, toVersion)
+	if err != nil {
+		return nil, fmt.Errorf("getting state at version %d: %w", toVersion, err)
+	}
+	return s.computeStateDiff(from.State, to.State), nil
+}
+
 // applyEventToState applies a single event to the state map and returns whether the entity is deleted.
 func (s *RollbackService) applyEventToState(evt repository.StoredEvent, state map[string]any) (bool, error) {
 	domainEvent, err := evt.DecodeEvent()
@@ -342,6 +457,9 @@ func (s *RollbackService) applyEventToState(evt repository.StoredEvent, state ma
 		if e.MarriagePlace != "" {
 			state["marriage_place"] = e.MarriagePlace
 		}
+		if e.ResearchStatus != "" {
+			state["research_status"] = string(e.ResearchStatus)
+		}
 		return false, nil
 
 	case domain.FamilyUpdated:
@@ -576,7 +694,7 @@ func valuesEqual(a, b any) bool {
 }
 
 // eventTypeToAction maps event types to user-friendly action names.
-func (s *RollbackService) eventTypeToAction(eventType string) string {
+func eventTypeToAction(eventType string) string {
 	switch eventType {
 	case "PersonCreated", "FamilyCreated", "SourceCreated", "CitationCreated":
 		return "created"
@@ -599,7 +717,7 @@ func (s *RollbackService) isDeletedEvent(eventType string) bool {
 }
 
 // buildChangeSummary creates a human-readable summary of what changed in an event.
-func (s *RollbackService) buildChangeSummary(evt repository.StoredEvent) string {
+func buildChangeSummary(evt repository.StoredEvent) string {
 	domainEvent, err := evt.DecodeEvent()
 	if err != nil {
 		return "unknown change"
@@ -609,7 +727,7 @@ func (s *RollbackService) buildChangeSummary(evt repository.StoredEvent) string
 	case domain.PersonCreated:
 		return fmt.Sprintf("created %s %s", e.GivenName, e.Surname)
 	case domain.PersonUpdated:
-		return s.summarizeChanges("updated", e.Changes)
+		return summarizeChanges("updated", e.Changes)
 	case domain.PersonDeleted:
 		if e.Reason != "" {
 			return fmt.Sprintf("deleted: %s", e.Reason)
@@ -619,7 +737,7 @@ func (s *RollbackService) buildChangeSummary(evt repository.StoredEvent) string
 	case domain.FamilyCreated:
 		return "created family"
 	case domain.FamilyUpdated:
-		return s.summarizeChanges("updated", e.Changes)
+		return summarizeChanges("updated", e.Changes)
 	case domain.FamilyDeleted:
 		return "deleted"
 	case domain.ChildLinkedToFamily:
@@ -630,14 +748,14 @@ func (s *RollbackService) buildChangeSummary(evt repository.StoredEvent) string
 	case domain.SourceCreated:
 		return fmt.Sprintf("created source: %s", truncate(e.Title, 40))
 	case domain.SourceUpdated:
-		return s.summarizeChanges("updated", e.Changes)
+		return summarizeChanges("updated", e.Changes)
 	case domain.SourceDeleted:
 		return "deleted"
 
 	case domain.CitationCreated:
 		return fmt.Sprintf("created citation for %s", e.FactType)
 	case domain.CitationUpdated:
-		return s.summarizeChanges("updated", e.Changes)
+		return summarizeChanges("updated", e.Changes)
 	case domain.CitationDeleted:
 		return "deleted"
 
@@ -647,7 +765,7 @@ func (s *RollbackService) buildChangeSummary(evt repository.StoredEvent) string
 }
 
 // summarizeChanges creates a summary of field changes.
-func (s *RollbackService) summarizeChanges(action string, changes map[string]any) string {
+func summarizeChanges(action string, changes map[string]any) string {
 	if len(changes) == 0 {
 		return action
 	}
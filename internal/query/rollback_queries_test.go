@@ -1020,8 +1020,6 @@ func TestApplyEventToState_CitationEvents(t *testing.T) {
 }
 
 func TestEventTypeToAction(t *testing.T) {
-	service := &RollbackService{}
-
 	tests := []struct {
 		eventType  string
 		wantAction string
@@ -1045,7 +1043,7 @@ func TestEventTypeToAction(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.eventType, func(t *testing.T) {
-			action := service.eventTypeToAction(tt.eventType)
+			action := eventTypeToAction(tt.eventType)
 			assert.Equal(t, tt.wantAction, action)
 		})
 	}
@@ -1076,7 +1074,6 @@ func TestIsDeletedEvent(t *testing.T) {
 }
 
 func TestBuildChangeSummary(t *testing.T) {
-	service := &RollbackService{}
 	personID := uuid.New()
 	sourceID := uuid.New()
 	citationID := uuid.New()
@@ -1216,7 +1213,7 @@ func TestBuildChangeSummary(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			summary := service.buildChangeSummary(tt.event)
+			summary := buildChangeSummary(tt.event)
 			assert.Equal(t, tt.wantSummary, summary)
 		})
 	}
@@ -1708,7 +1705,6 @@ func TestApplyEventToState_UnknownEventType(t *testing.T) {
 }
 
 func TestBuildChangeSummary_AdditionalCases(t *testing.T) {
-	service := &RollbackService{}
 	familyID := uuid.New()
 	sourceID := uuid.New()
 	citationID := uuid.New()
@@ -1720,7 +1716,7 @@ func TestBuildChangeSummary_AdditionalCases(t *testing.T) {
 				FamilyID: familyID,
 			}),
 		}
-		summary := service.buildChangeSummary(evt)
+		summary := buildChangeSummary(evt)
 		assert.Equal(t, "deleted", summary)
 	})
 
@@ -1732,7 +1728,7 @@ func TestBuildChangeSummary_AdditionalCases(t *testing.T) {
 				Changes:  map[string]any{"title": "New Title"},
 			}),
 		}
-		summary := service.buildChangeSummary(evt)
+		summary := buildChangeSummary(evt)
 		assert.Equal(t, "updated title", summary)
 	})
 
@@ -1743,7 +1739,7 @@ func TestBuildChangeSummary_AdditionalCases(t *testing.T) {
 				SourceID: sourceID,
 			}),
 		}
-		summary := service.buildChangeSummary(evt)
+		summary := buildChangeSummary(evt)
 		assert.Equal(t, "deleted", summary)
 	})
 
@@ -1755,7 +1751,7 @@ func TestBuildChangeSummary_AdditionalCases(t *testing.T) {
 				Changes:    map[string]any{"page": "200"},
 			}),
 		}
-		summary := service.buildChangeSummary(evt)
+		summary := buildChangeSummary(evt)
 		assert.Equal(t, "updated page", summary)
 	})
 
@@ -1766,7 +1762,7 @@ func TestBuildChangeSummary_AdditionalCases(t *testing.T) {
 				CitationID: citationID,
 			}),
 		}
-		summary := service.buildChangeSummary(evt)
+		summary := buildChangeSummary(evt)
 		assert.Equal(t, "deleted", summary)
 	})
 
@@ -1775,7 +1771,7 @@ func TestBuildChangeSummary_AdditionalCases(t *testing.T) {
 			EventType: "PersonCreated",
 			Data:      []byte(`invalid json`),
 		}
-		summary := service.buildChangeSummary(evt)
+		summary := buildChangeSummary(evt)
 		assert.Equal(t, "unknown change", summary)
 	})
 
@@ -1784,7 +1780,7 @@ func TestBuildChangeSummary_AdditionalCases(t *testing.T) {
 			EventType: "SomeNewEventType",
 			Data:      []byte(`{}`),
 		}
-		summary := service.buildChangeSummary(evt)
+		summary := buildChangeSummary(evt)
 		assert.Equal(t, "unknown change", summary)
 	})
 }
@@ -1803,9 +1799,7 @@ func TestNormalizeValue_GenDate(t *testing.T) {
 }
 
 func TestSummarizeChanges_EmptyChanges(t *testing.T) {
-	service := &RollbackService{}
-
-	result := service.summarizeChanges("updated", map[string]any{})
+	result := summarizeChanges("updated", map[string]any{})
 	assert.Equal(t, "updated", result)
 }
 
@@ -0,0 +1,184 @@
+package query
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// Fields a SearchMatch can refer to.
+const (
+	MatchFieldGivenName     = "given_name"
+	MatchFieldSurname       = "surname"
+	MatchFieldAlternateName = "alternate_name"
+	MatchFieldBirthPlace    = "birth_place"
+	MatchFieldDeathPlace    = "death_place"
+	MatchFieldBirthDate     = "birth_date"
+	MatchFieldDeathDate     = "death_date"
+)
+
+// SearchMatch locates the part of a field that matched a search, so it can be
+// highlighted. Start and End are character (Unicode code point) offsets into
+// Value, with End exclusive. Date range matches span the whole value.
+type SearchMatch struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+// matchCriteria is what a search looked for, in terms of the fields it checks.
+type matchCriteria struct {
+	nameTerms    []string // matched against given name, surname, and alternate names
+	givenTerms   []string // matched against the given name only
+	surnameTerms []string // matched against the surname only
+	algorithm    string   // phonetic algorithm used for name terms, if any
+	birthPlace   string
+	deathPlace   string
+	birthDates   bool // a birth date range was applied
+	deathDates   bool // a death date range was applied
+}
+
+// queryTerms splits a name query into the terms to highlight: its words, or
+// for a wildcard query the literal runs between wildcards.
+func queryTerms(query string) []string {
+	if repository.IsWildcardQuery(query) {
+		var terms []string
+		for _, literal := range repository.WildcardLiterals(query) {
+			terms = append(terms, strings.Fields(literal)...)
+		}
+		return terms
+	}
+	return strings.Fields(query)
+}
+
+// findMatches returns the matches for p under c. The person's recorded names
+// are only consulted when neither the given name nor the surname matched the
+// name terms, so the first matching one explains the result.
+func findMatches(p Person, altNames []PersonName, c matchCriteria) []SearchMatch {
+	var matches []SearchMatch
+	add := func(field, value string, terms []string, phonetic bool) bool {
+		ranges := termRanges(value, terms)
+		if len(ranges) == 0 && phonetic {
+			ranges = phoneticRanges(value, terms, c.algorithm)
+		}
+		for _, r := range ranges {
+			matches = append(matches, SearchMatch{Field: field, Value: value, Start: r[0], End: r[1]})
+		}
+		return len(ranges) > 0
+	}
+
+	phonetic := c.algorithm == SearchAlgorithmSoundex || c.algorithm == SearchAlgorithmMetaphone
+	nameMatched := add(MatchFieldGivenName, p.GivenName, slices.Concat(c.nameTerms, c.givenTerms), phonetic)
+	nameMatched = add(MatchFieldSurname, p.Surname, slices.Concat(c.nameTerms, c.surnameTerms), phonetic) || nameMatched
+	if !nameMatched && len(c.nameTerms) > 0 {
+		for _, n := range altNames {
+			if add(MatchFieldAlternateName, n.FullName, c.nameTerms, phonetic) {
+				break
+			}
+		}
+	}
+
+	if c.birthPlace != "" && p.BirthPlace != nil {
+		add(MatchFieldBirthPlace, *p.BirthPlace, []string{c.birthPlace}, false)
+	}
+	if c.deathPlace != "" && p.DeathPlace != nil {
+		add(MatchFieldDeathPlace, *p.DeathPlace, []string{c.deathPlace}, false)
+	}
+	if c.birthDates && p.BirthDate != nil {
+		value := p.BirthDate.String()
+		matches = append(matches, SearchMatch{Field: MatchFieldBirthDate, Value: value, End: len([]rune(value))})
+	}
+	if c.deathDates && p.DeathDate != nil {
+		value := p.DeathDate.String()
+		matches = append(matches, SearchMatch{Field: MatchFieldDeathDate, Value: value, End: len([]rune(value))})
+	}
+	return matches
+}
+
+// termRanges returns the merged character ranges of value where any of terms
+// occur, ignoring case.
+func termRanges(value string, terms []string) [][2]int {
+	haystack := lowerRunes(value)
+	covered := make([]bool, len(haystack))
+	for _, term := range terms {
+		needle := lowerRunes(strings.TrimSpace(term))
+		if len(needle) == 0 {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(haystack); i++ {
+			if runesEqual(haystack[i:i+len(needle)], needle) {
+				for j := i; j < i+len(needle); j++ {
+					covered[j] = true
+				}
+			}
+		}
+	}
+	return coveredRanges(covered)
+}
+
+// phoneticRanges returns the character ranges of the words of value that
+// sound like any of terms under the given algorithm.
+func phoneticRanges(value string, terms []string, algorithm string) [][2]int {
+	runes := []rune(value)
+	covered := make([]bool, len(runes))
+	for start := 0; start < len(runes); {
+		if unicode.IsSpace(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && !unicode.IsSpace(runes[end]) {
+			end++
+		}
+		word := string(runes[start:end])
+		for _, term := range terms {
+			if (algorithm == SearchAlgorithmSoundex && repository.SoundexMatch(term, word)) ||
+				(algorithm == SearchAlgorithmMetaphone && repository.MetaphoneMatch(term, word)) {
+				for j := start; j < end; j++ {
+					covered[j] = true
+				}
+				break
+			}
+		}
+		start = end
+	}
+	return coveredRanges(covered)
+}
+
+// coveredRanges converts a per-character coverage mask into [start, end) ranges.
+func coveredRanges(covered []bool) [][2]int {
+	var ranges [][2]int
+	for i := 0; i < len(covered); i++ {
+		if !covered[i] {
+			continue
+		}
+		start := i
+		for i < len(covered) && covered[i] {
+			i++
+		}
+		ranges = append(ranges, [2]int{start, i})
+	}
+	return ranges
+}
+
+// lowerRunes lowercases s one rune at a time, so offsets into the result are
+// offsets into s.
+func lowerRunes(s string) []rune {
+	runes := []rune(s)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	return runes
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -3,6 +3,9 @@ package query
 import (
 	"context"
 	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -71,7 +74,7 @@ type SourceDetail struct {
 type ListSourcesInput struct {
 	Limit     int
 	Offset    int
-	SortBy    string // title, source_type, updated_at
+	SortBy    string // title, source_type, updated_at, citation_count
 	SortOrder string // asc, desc
 	Query     string // optional search term
 }
@@ -99,6 +102,9 @@ func (s *SourceService) ListSources(ctx context.Context, input ListSourcesInput)
 	if opts.Limit > 100 {
 		opts.Limit = 100
 	}
+	if opts.Offset < 0 {
+		opts.Offset = 0
+	}
 	if opts.Sort == "" {
 		opts.Sort = "title"
 	}
@@ -166,14 +172,83 @@ func (s *SourceService) GetSource(ctx context.Context, id uuid.UUID) (*SourceDet
 	return detail, nil
 }
 
-// SearchSources searches for sources by title, author, or other fields.
+// CitationFilter restricts a citation listing. Empty fields match any value.
+type CitationFilter struct {
+	FactType      string // e.g. person_birth
+	SourceQuality string // original, derivative, authored
+	EvidenceType  string // direct, indirect, negative
+}
+
+func (f CitationFilter) toRepository() repository.CitationFilter {
+	return repository.CitationFilter{
+		FactType:      domain.FactType(f.FactType),
+		SourceQuality: domain.SourceQuality(f.SourceQuality),
+		EvidenceType:  domain.EvidenceType(f.EvidenceType),
+	}
+}
+
+// ListSourceCitationsInput contains options for paging through a source's
+// citations.
+type ListSourceCitationsInput struct {
+	CitationFilter
+	Limit  int
+	Offset int
+}
+
+// CitationListResult contains paginated citation results.
+type CitationListResult struct {
+	Citations []Citation `json:"citations"`
+	Total     int        `json:"total"`
+	Limit     int        `json:"limit"`
+	Offset    int        `json:"offset"`
+}
+
+// ListSourceCitations returns a page of the citations of a source, oldest
+// first.
+func (s *SourceService) ListSourceCitations(ctx context.Context, sourceID uuid.UUID, input ListSourceCitationsInput) (*CitationListResult, error) {
+	rm, err := s.readStore.GetSource(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	if rm == nil {
+		return nil, ErrNotFound
+	}
+
+	opts := repository.ListOptions{
+		Limit:  input.Limit,
+		Offset: input.Offset,
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 20
+	}
+	if opts.Limit > 100 {
+		opts.Limit = 100
+	}
+
+	readModels, total, err := s.readStore.ListCitationsForSource(ctx, sourceID, input.toRepository(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	citations := make([]Citation, len(readModels))
+	for i, citationRM := range readModels {
+		citations[i] = convertReadModelToCitation(citationRM)
+	}
+
+	return &CitationListResult{
+		Citations: citations,
+		Total:     total,
+		Limit:     opts.Limit,
+		Offset:    opts.Offset,
+	}, nil
+}
+
+// SearchSources searches for sources by title, author, or other fields,
+// returning at most limit results (default 20); callers enforce any upper limit.
 func (s *SourceService) SearchSources(ctx context.Context, query string, limit int) ([]Source, error) {
 	if limit <= 0 {
 		limit = 20
 	}
-	if limit > 100 {
-		limit = 100
-	}
 
 	readModels, err := s.readStore.SearchSources(ctx, query, limit)
 	if err != nil {
@@ -190,14 +265,22 @@ func (s *SourceService) SearchSources(ctx context.Context, query string, limit i
 
 // GetCitationsForPerson returns all citations for a person.
 func (s *SourceService) GetCitationsForPerson(ctx context.Context, personID uuid.UUID) ([]Citation, error) {
+	return s.ListCitationsForPerson(ctx, personID, CitationFilter{})
+}
+
+// ListCitationsForPerson returns the citations for a person that match filter.
+func (s *SourceService) ListCitationsForPerson(ctx context.Context, personID uuid.UUID, filter CitationFilter) ([]Citation, error) {
 	readModels, err := s.readStore.GetCitationsForPerson(ctx, personID)
 	if err != nil {
 		return nil, err
 	}
 
-	citations := make([]Citation, len(readModels))
-	for i, rm := range readModels {
-		citations[i] = convertReadModelToCitation(rm)
+	match := filter.toRepository()
+	citations := make([]Citation, 0, len(readModels))
+	for _, rm := range readModels {
+		if match.Matches(rm) {
+			citations = append(citations, convertReadModelToCitation(rm))
+		}
 	}
 
 	return citations, nil
@@ -322,3 +405,261 @@ func convertReadModelToCitation(rm repository.CitationReadModel) Citation {
 
 	return c
 }
+
+// FactCoverage describes the citation coverage of one of a person's facts.
+type FactCoverage struct {
+	FactType      string     `json:"fact_type"`
+	EventID       *uuid.UUID `json:"event_id,omitempty"` // Set for life events
+	Date          *string    `json:"date,omitempty"`
+	Place         *string    `json:"place,omitempty"`
+	Value         *string    `json:"value,omitempty"` // e.g. the name for person_name
+	CitationCount int        `json:"citation_count"`
+	BestQuality   *string    `json:"best_quality,omitempty"` // Highest source quality among the citations
+}
+
+// SourceReport lists each of a person's facts with its citation coverage.
+type SourceReport struct {
+	PersonID     uuid.UUID      `json:"person_id"`
+	PersonName   string         `json:"person_name"`
+	Facts        []FactCoverage `json:"facts"`
+	CitedCount   int            `json:"cited_count"`
+	UncitedCount int            `json:"uncited_count"`
+}
+
+// Owner types a source's citations can support.
+const (
+	CitationOwnerPerson = "person"
+	CitationOwnerFamily = "family"
+)
+
+// SourceUsage groups a source's citations by the person or family whose facts
+// they support.
+type SourceUsage struct {
+	SourceID      uuid.UUID       `json:"source_id"`
+	SourceTitle   string          `json:"source_title"`
+	CitationCount int             `json:"citation_count"`
+	Owners        []CitationOwner `json:"owners"`
+}
+
+// CitationOwner is a person or family with the facts a source is cited for.
+type CitationOwner struct {
+	OwnerType string      `json:"owner_type"` // person or family
+	OwnerID   uuid.UUID   `json:"owner_id"`
+	OwnerName *string     `json:"owner_name,omitempty"` // unset if the owner no longer exists
+	Facts     []CitedFact `json:"facts"`
+}
+
+// CitedFact is one fact type of an owner supported by the source.
+type CitedFact struct {
+	FactType      string      `json:"fact_type"`
+	CitationIDs   []uuid.UUID `json:"citation_ids"`
+	CitationCount int         `json:"citation_count"`
+	BestQuality   *string     `json:"best_quality,omitempty"`
+}
+
+// sourceQualityRank orders source qualities from strongest to weakest evidence.
+// Citations without an assessed quality rank below all assessed ones.
+var sourceQualityRank = map[domain.SourceQuality]int{
+	domain.SourceOriginal:   3,
+	domain.SourceDerivative: 2,
+	domain.SourceAuthored:   1,
+}
+
+// GetPersonSourceReport returns the person's name, birth, death and life
+// events, each with the number of citations supporting it and the quality of
+// the best one. Citations attach to a fact by its fact type, so several events
+// of the same type share their citations.
+func (s *SourceService) GetPersonSourceReport(ctx context.Context, personID uuid.UUID) (*SourceReport, error) {
+	person, err := s.readStore.GetPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	if person == nil {
+		return nil, ErrNotFound
+	}
+
+	citations, err := s.readStore.GetCitationsForPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	byFact := make(map[domain.FactType][]repository.CitationReadModel)
+	for _, c := range citations {
+		byFact[c.FactType] = append(byFact[c.FactType], c)
+	}
+
+	events, err := s.readStore.ListEventsForPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i].DateSort, events[j].DateSort
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+
+	report := &SourceReport{
+		PersonID:   person.ID,
+		PersonName: person.FullName,
+	}
+
+	add := func(fc FactCoverage, factType domain.FactType) {
+		fc.FactType = string(factType)
+		fc.CitationCount, fc.BestQuality = citationCoverage(byFact[factType])
+		if fc.CitationCount > 0 {
+			report.CitedCount++
+		} else {
+			report.UncitedCount++
+		}
+		report.Facts = append(report.Facts, fc)
+	}
+
+	add(FactCoverage{Value: optionalString(person.FullName)}, domain.FactPersonName)
+	if person.BirthDateRaw != "" || person.BirthPlace != "" || len(byFact[domain.FactPersonBirth]) > 0 {
+		add(FactCoverage{
+			Date:  optionalString(person.BirthDateRaw),
+			Place: optionalString(person.BirthPlace),
+		}, domain.FactPersonBirth)
+	}
+	if person.DeathDateRaw != "" || person.DeathPlace != "" || len(byFact[domain.FactPersonDeath]) > 0 {
+		add(FactCoverage{
+			Date:  optionalString(person.DeathDateRaw),
+			Place: optionalString(person.DeathPlace),
+		}, domain.FactPersonDeath)
+	}
+	for _, e := range events {
+		eventID := e.ID
+		add(FactCoverage{
+			EventID: &eventID,
+			Date:    optionalString(e.DateRaw),
+			Place:   optionalString(e.Place),
+			Value:   optionalString(e.Description),
+		}, e.FactType)
+	}
+
+	return report, nil
+}
+
+// GetSourceUsage returns the persons and families a source is cited for,
+// each with the facts it supports, ordered by owner name.
+func (s *SourceService) GetSourceUsage(ctx context.Context, sourceID uuid.UUID) (*SourceUsage, error) {
+	source, err := s.readStore.GetSource(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, ErrNotFound
+	}
+
+	citations, err := s.readStore.GetCitationsForSource(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(citations, func(i, j int) bool {
+		return citations[i].CreatedAt.Before(citations[j].CreatedAt)
+	})
+
+	type ownerKey struct {
+		ownerType string
+		id        uuid.UUID
+	}
+	byOwner := make(map[ownerKey]map[domain.FactType][]repository.CitationReadModel)
+	var keys []ownerKey
+	for _, c := range citations {
+		key := ownerKey{CitationOwnerPerson, c.FactOwnerID}
+		if strings.HasPrefix(string(c.FactType), "family_") {
+			key.ownerType = CitationOwnerFamily
+		}
+		if byOwner[key] == nil {
+			byOwner[key] = make(map[domain.FactType][]repository.CitationReadModel)
+			keys = append(keys, key)
+		}
+		byOwner[key][c.FactType] = append(byOwner[key][c.FactType], c)
+	}
+
+	usage := &SourceUsage{
+		SourceID:      source.ID,
+		SourceTitle:   source.Title,
+		CitationCount: len(citations),
+		Owners:        make([]CitationOwner, 0, len(keys)),
+	}
+	for _, key := range keys {
+		name, err := s.citationOwnerName(ctx, key.ownerType, key.id)
+		if err != nil {
+			return nil, err
+		}
+		owner := CitationOwner{OwnerType: key.ownerType, OwnerID: key.id, OwnerName: optionalString(name)}
+
+		factTypes := make([]domain.FactType, 0, len(byOwner[key]))
+		for factType := range byOwner[key] {
+			factTypes = append(factTypes, factType)
+		}
+		slices.Sort(factTypes)
+		for _, factType := range factTypes {
+			fact := CitedFact{FactType: string(factType)}
+			for _, c := range byOwner[key][factType] {
+				fact.CitationIDs = append(fact.CitationIDs, c.ID)
+			}
+			fact.CitationCount, fact.BestQuality = citationCoverage(byOwner[key][factType])
+			owner.Facts = append(owner.Facts, fact)
+		}
+		usage.Owners = append(usage.Owners, owner)
+	}
+
+	sort.SliceStable(usage.Owners, func(i, j int) bool {
+		a, b := usage.Owners[i].OwnerName, usage.Owners[j].OwnerName
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return *a < *b
+	})
+	return usage, nil
+}
+
+// citationOwnerName returns the display name of a person, or the partner
+// names of a family, or "" if the owner does not exist.
+func (s *SourceService) citationOwnerName(ctx context.Context, ownerType string, id uuid.UUID) (string, error) {
+	if ownerType == CitationOwnerPerson {
+		person, err := s.readStore.GetPerson(ctx, id)
+		if err != nil || person == nil {
+			return "", err
+		}
+		return person.FullName, nil
+	}
+
+	family, err := s.readStore.GetFamily(ctx, id)
+	if err != nil || family == nil {
+		return "", err
+	}
+	var names []string
+	for _, name := range []string{
+		fullName(family.Partner1GivenName, family.Partner1Surname),
+		fullName(family.Partner2GivenName, family.Partner2Surname),
+	} {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, " & "), nil
+}
+
+// citationCoverage returns the number of citations and the best source
+// quality among them, if any citation has an assessed quality.
+func citationCoverage(citations []repository.CitationReadModel) (int, *string) {
+	var best domain.SourceQuality
+	for _, c := range citations {
+		if sourceQualityRank[c.SourceQuality] > sourceQualityRank[best] {
+			best = c.SourceQuality
+		}
+	}
+	return len(citations), optionalString(string(best))
+}
+
+// optionalString returns a pointer to s, or nil when s is empty.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
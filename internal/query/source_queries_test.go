@@ -2,11 +2,13 @@ package query_test
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/google/uuid"
 
 	"github.com/cacack/my-family/internal/command"
+	"github.com/cacack/my-family/internal/domain"
 	"github.com/cacack/my-family/internal/query"
 	"github.com/cacack/my-family/internal/repository"
 	"github.com/cacack/my-family/internal/repository/memory"
@@ -462,6 +464,50 @@ func TestListSources_Sorting(t *testing.T) {
 	}
 }
 
+// TestListSources_SortByCitationCount tests ordering sources by how often
+// they are cited.
+func TestListSources_SortByCitationCount(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	queryService := query.NewSourceService(readStore)
+	ctx := context.Background()
+
+	for title, count := range map[string]int{"Census": 5, "Bible": 0, "Parish Register": 12} {
+		err := readStore.SaveSource(ctx, &repository.SourceReadModel{
+			ID:            uuid.New(),
+			SourceType:    domain.SourceBook,
+			Title:         title,
+			CitationCount: count,
+		})
+		if err != nil {
+			t.Fatalf("SaveSource failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		order string
+		want  []string
+	}{
+		{"desc", []string{"Parish Register", "Census", "Bible"}},
+		{"asc", []string{"Bible", "Census", "Parish Register"}},
+	}
+	for _, tt := range tests {
+		result, err := queryService.ListSources(ctx, query.ListSourcesInput{
+			SortBy:    "citation_count",
+			SortOrder: tt.order,
+		})
+		if err != nil {
+			t.Fatalf("ListSources failed: %v", err)
+		}
+		var got []string
+		for _, src := range result.Sources {
+			got = append(got, src.Title)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("order %s: titles = %v, want %v", tt.order, got, tt.want)
+		}
+	}
+}
+
 // TestGetCitation tests getting a single citation by ID.
 func TestGetCitation(t *testing.T) {
 	eventStore := memory.NewEventStore()
@@ -599,3 +645,87 @@ func TestGetSource_ExternalIDs(t *testing.T) {
 		t.Errorf("ExternalIDs[0] = %+v, want S123/familysearch ark", detail.ExternalIDs[0])
 	}
 }
+
+// TestGetPersonSourceReport tests per-fact citation coverage for a person.
+func TestGetPersonSourceReport(t *testing.T) {
+	eventStore := memory.NewEventStore()
+	readStore := memory.NewReadModelStore()
+	cmdHandler := command.NewHandler(eventStore, readStore)
+	queryService := query.NewSourceService(readStore)
+	ctx := context.Background()
+
+	source, _ := cmdHandler.CreateSource(ctx, command.CreateSourceInput{
+		SourceType: "vital_record",
+		Title:      "Birth Register",
+	})
+	person, _ := cmdHandler.CreatePerson(ctx, command.CreatePersonInput{
+		GivenName:  "John",
+		Surname:    "Doe",
+		BirthDate:  "1 JAN 1850",
+		BirthPlace: "Springfield",
+	})
+
+	// Two birth citations of different quality; the best should be reported.
+	for _, quality := range []string{"derivative", "original"} {
+		if _, err := cmdHandler.CreateCitation(ctx, command.CreateCitationInput{
+			SourceID:      source.ID,
+			FactType:      "person_birth",
+			FactOwnerID:   person.ID,
+			SourceQuality: quality,
+		}); err != nil {
+			t.Fatalf("CreateCitation failed: %v", err)
+		}
+	}
+
+	burialID := uuid.New()
+	if err := readStore.SaveEvent(ctx, &repository.EventReadModel{
+		ID:        burialID,
+		OwnerType: "person",
+		OwnerID:   person.ID,
+		FactType:  "person_burial",
+		DateRaw:   "1920",
+	}); err != nil {
+		t.Fatalf("SaveEvent failed: %v", err)
+	}
+
+	report, err := queryService.GetPersonSourceReport(ctx, person.ID)
+	if err != nil {
+		t.Fatalf("GetPersonSourceReport failed: %v", err)
+	}
+
+	// Name, birth and burial; death is omitted since nothing is recorded.
+	if len(report.Facts) != 3 {
+		t.Fatalf("Got %d facts, want 3: %+v", len(report.Facts), report.Facts)
+	}
+	if report.CitedCount != 1 || report.UncitedCount != 2 {
+		t.Errorf("CitedCount/UncitedCount = %d/%d, want 1/2", report.CitedCount, report.UncitedCount)
+	}
+
+	name, birth, burial := report.Facts[0], report.Facts[1], report.Facts[2]
+	if name.FactType != "person_name" || name.Value == nil || *name.Value != "John Doe" {
+		t.Errorf("Facts[0] = %+v, want person_name John Doe", name)
+	}
+	if birth.FactType != "person_birth" || birth.CitationCount != 2 {
+		t.Errorf("Facts[1] = %+v, want person_birth with 2 citations", birth)
+	}
+	if birth.BestQuality == nil || *birth.BestQuality != "original" {
+		t.Errorf("birth BestQuality = %v, want original", birth.BestQuality)
+	}
+	if burial.FactType != "person_burial" || burial.EventID == nil || *burial.EventID != burialID {
+		t.Errorf("Facts[2] = %+v, want burial event %s", burial, burialID)
+	}
+	if burial.CitationCount != 0 || burial.BestQuality != nil {
+		t.Errorf("burial coverage = %d/%v, want uncited", burial.CitationCount, burial.BestQuality)
+	}
+}
+
+// TestGetPersonSourceReport_NotFound tests the report for a missing person.
+func TestGetPersonSourceReport_NotFound(t *testing.T) {
+	readStore := memory.NewReadModelStore()
+	queryService := query.NewSourceService(readStore)
+
+	_, err := queryService.GetPersonSourceReport(context.Background(), uuid.New())
+	if err != query.ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
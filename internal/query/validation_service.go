@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -37,6 +38,38 @@ type ValidationReport struct {
 	WarningCount      int                     `json:"warning_count"`
 	InfoCount         int                     `json:"info_count"`
 	TopIssues         []ValidationReportIssue `json:"top_issues"`
+	ResearchStatus    ResearchStatusSummary   `json:"research_status"`
+}
+
+// ResearchStatusSummary breaks down research status by kind of record.
+type ResearchStatusSummary struct {
+	Persons    ResearchStatusCounts `json:"persons"`
+	Families   ResearchStatusCounts `json:"families"`
+	Events     ResearchStatusCounts `json:"events"`
+	Attributes ResearchStatusCounts `json:"attributes"`
+}
+
+// ResearchStatusCounts counts records by research status. Records never
+// assessed count as unknown.
+type ResearchStatusCounts struct {
+	Certain  int `json:"certain"`
+	Probable int `json:"probable"`
+	Possible int `json:"possible"`
+	Unknown  int `json:"unknown"`
+}
+
+// add counts one record with the given research status.
+func (c *ResearchStatusCounts) add(rs domain.ResearchStatus) {
+	switch rs {
+	case domain.ResearchStatusCertain:
+		c.Certain++
+	case domain.ResearchStatusProbable:
+		c.Probable++
+	case domain.ResearchStatusPossible:
+		c.Possible++
+	default:
+		c.Unknown++
+	}
 }
 
 // ValidationReportIssue represents an issue code with its count.
@@ -79,6 +112,9 @@ func (s *ValidationService) GetQualityReport(ctx context.Context) (*ValidationRe
 
 	// Generate quality report
 	qr := v.QualityReport(doc)
+	extraIssues := append(genderRoleIssues(doc), childFamilyIssues(doc)...)
+	qr.Warnings = append(qr.Warnings, extraIssues...)
+	qr.WarningCount += len(extraIssues)
 
 	// Count issues by code for top issues
 	issueCounts := make(map[string]int)
@@ -99,6 +135,11 @@ func (s *ValidationService) GetQualityReport(ctx context.Context) (*ValidationRe
 		topIssues = topIssues[:10]
 	}
 
+	researchStatus, err := s.researchStatusSummary(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ValidationReport{
 		TotalIndividuals:  qr.TotalIndividuals,
 		TotalFamilies:     qr.TotalFamilies,
@@ -110,9 +151,50 @@ func (s *ValidationService) GetQualityReport(ctx context.Context) (*ValidationRe
 		WarningCount:      qr.WarningCount,
 		InfoCount:         qr.InfoCount,
 		TopIssues:         topIssues,
+		ResearchStatus:    *researchStatus,
 	}, nil
 }
 
+// researchStatusSummary counts persons, families, events, and attributes by
+// research status.
+func (s *ValidationService) researchStatusSummary(ctx context.Context) (*ResearchStatusSummary, error) {
+	var summary ResearchStatusSummary
+
+	persons, err := repository.ListAll(ctx, 1000, s.readStore.ListPersons)
+	if err != nil {
+		return nil, fmt.Errorf("load persons: %w", err)
+	}
+	for _, p := range persons {
+		summary.Persons.add(p.ResearchStatus)
+	}
+
+	families, err := repository.ListAll(ctx, 1000, s.readStore.ListFamilies)
+	if err != nil {
+		return nil, fmt.Errorf("load families: %w", err)
+	}
+	for _, f := range families {
+		summary.Families.add(f.ResearchStatus)
+	}
+
+	events, err := repository.ListAll(ctx, 1000, s.readStore.ListEvents)
+	if err != nil {
+		return nil, fmt.Errorf("load events: %w", err)
+	}
+	for _, e := range events {
+		summary.Events.add(e.ResearchStatus)
+	}
+
+	attributes, err := repository.ListAll(ctx, 1000, s.readStore.ListAttributes)
+	if err != nil {
+		return nil, fmt.Errorf("load attributes: %w", err)
+	}
+	for _, a := range attributes {
+		summary.Attributes.add(a.ResearchStatus)
+	}
+
+	return &summary, nil
+}
+
 // FindDuplicates returns potential duplicate persons with pagination.
 func (s *ValidationService) FindDuplicates(ctx context.Context, limit, offset int) ([]DuplicateResult, int, error) {
 	// Build gedcom document from read model
@@ -190,7 +272,7 @@ func (s *ValidationService) GetValidationIssues(ctx context.Context, severityFil
 
 	// Get all validation issues (unfiltered) so we can compute global counts
 	// even when a severity filter is applied.
-	allIssues := v.ValidateAll(doc)
+	allIssues := append(append(v.ValidateAll(doc), genderRoleIssues(doc)...), childFamilyIssues(doc)...)
 
 	page := &ValidationIssuesPage{
 		Issues: []ValidationIssueResult{},
@@ -265,6 +347,96 @@ func (s *ValidationService) GetValidationIssues(ctx context.Context, severityFil
 	return page, nil
 }
 
+// CodeGenderRoleMismatch flags a family partner whose recorded gender
+// contradicts their husband or wife role.
+const CodeGenderRoleMismatch = "gender_role_mismatch"
+
+// genderRoleIssues checks each family's husband and wife against their
+// recorded gender, which catches partners swapped by a messy import. Couples
+// of the same recorded gender are not flagged, since a marriage or
+// partnership does not imply partners of opposite sex.
+func genderRoleIssues(doc *gedcom.Document) []validator.Issue {
+	sexOf := func(xref string) string {
+		if xref == "" {
+			return ""
+		}
+		if rec, ok := doc.XRefMap[xref]; ok {
+			if ind, ok := rec.Entity.(*gedcom.Individual); ok {
+				return ind.Sex
+			}
+		}
+		return ""
+	}
+
+	var issues []validator.Issue
+	for _, rec := range doc.Records {
+		fam, ok := rec.Entity.(*gedcom.Family)
+		if !ok {
+			continue
+		}
+		husband, wife := sexOf(fam.Husband), sexOf(fam.Wife)
+		issue := validator.Issue{
+			Severity:   validator.SeverityWarning,
+			Code:       CodeGenderRoleMismatch,
+			RecordXRef: rec.XRef,
+		}
+		switch {
+		case husband == "F" && wife == "M":
+			issue.Message = "Partners appear swapped: the husband is recorded as female and the wife as male"
+			issue.RelatedXRef = fam.Husband
+		case husband == "F" && wife != "F":
+			issue.Message = "The husband is recorded as female"
+			issue.RelatedXRef = fam.Husband
+		case wife == "M" && husband != "M":
+			issue.Message = "The wife is recorded as male"
+			issue.RelatedXRef = fam.Wife
+		default:
+			continue
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// CodeChildMultipleFamilies flags a person linked as a birth child of more
+// than one family.
+const CodeChildMultipleFamilies = "child_multiple_families"
+
+// childFamilyIssues flags persons linked as a birth child of several
+// families, which is usually an accidental double link that corrupts the
+// pedigree. Adoptive and foster links are expected alongside a birth family
+// and are not counted.
+func childFamilyIssues(doc *gedcom.Document) []validator.Issue {
+	var issues []validator.Issue
+	for _, rec := range doc.Records {
+		ind, ok := rec.Entity.(*gedcom.Individual)
+		if !ok {
+			continue
+		}
+		var families []string
+		for _, link := range ind.ChildInFamilies {
+			if link.Pedigree == "" || strings.EqualFold(link.Pedigree, "birth") {
+				families = append(families, link.FamilyXRef)
+			}
+		}
+		if len(families) < 2 {
+			continue
+		}
+		ids := make([]string, len(families))
+		for i, xref := range families {
+			ids[i] = strings.Trim(xref, "@")
+		}
+		issues = append(issues, validator.Issue{
+			Severity:    validator.SeverityWarning,
+			Code:        CodeChildMultipleFamilies,
+			Message:     fmt.Sprintf("Linked as a birth child of %d families: %s", len(families), strings.Join(ids, ", ")),
+			RecordXRef:  rec.XRef,
+			RelatedXRef: families[1],
+		})
+	}
+	return issues
+}
+
 // buildGedcomDocument reconstructs a gedcom.Document from read model data.
 // Returns the document and a map of XRef -> UUID for reverse lookup.
 func (s *ValidationService) buildGedcomDocument(ctx context.Context) (*gedcom.Document, map[string]uuid.UUID, error) {
@@ -296,11 +468,13 @@ func (s *ValidationService) buildGedcomDocument(ctx context.Context) (*gedcom.Do
 	}
 
 	// Add persons as individuals
+	individuals := make(map[uuid.UUID]*gedcom.Individual, len(persons))
 	for _, person := range persons {
 		xref := personXRef(person.ID)
 		xrefMap[xref] = person.ID
 
 		individual := s.personToIndividual(person)
+		individuals[person.ID] = individual
 		record := &gedcom.Record{
 			XRef:   xref,
 			Type:   gedcom.RecordTypeIndividual,
@@ -323,6 +497,14 @@ func (s *ValidationService) buildGedcomDocument(ctx context.Context) (*gedcom.Do
 		}
 
 		gedFamily := s.familyToGedcomFamily(family, children)
+		for _, child := range children {
+			if ind, ok := individuals[child.PersonID]; ok {
+				ind.ChildInFamilies = append(ind.ChildInFamilies, gedcom.FamilyLink{
+					FamilyXRef: xref,
+					Pedigree:   childPedigree(child.RelationshipType),
+				})
+			}
+		}
 		record := &gedcom.Record{
 			XRef:   xref,
 			Type:   gedcom.RecordTypeFamily,
@@ -404,6 +586,9 @@ func (s *ValidationService) personToIndividual(person repository.PersonReadModel
 			if gd.Day != nil {
 				birthEvent.ParsedDate.Day = *gd.Day
 			}
+			if gd.DualYear != nil {
+				birthEvent.ParsedDate.DualYear = *gd.DualYear
+			}
 		}
 		individual.Events = append(individual.Events, birthEvent)
 	}
@@ -428,6 +613,9 @@ func (s *ValidationService) personToIndividual(person repository.PersonReadModel
 			if gd.Day != nil {
 				deathEvent.ParsedDate.Day = *gd.Day
 			}
+			if gd.DualYear != nil {
+				deathEvent.ParsedDate.DualYear = *gd.DualYear
+			}
 		}
 		individual.Events = append(individual.Events, deathEvent)
 	}
@@ -476,6 +664,9 @@ func (s *ValidationService) familyToGedcomFamily(family repository.FamilyReadMod
 			if gd.Day != nil {
 				marriageEvent.ParsedDate.Day = *gd.Day
 			}
+			if gd.DualYear != nil {
+				marriageEvent.ParsedDate.DualYear = *gd.DualYear
+			}
 		}
 		gedFamily.Events = append(gedFamily.Events, marriageEvent)
 	}
@@ -483,6 +674,18 @@ func (s *ValidationService) familyToGedcomFamily(family repository.FamilyReadMod
 	return gedFamily
 }
 
+// childPedigree maps a child relationship type to its GEDCOM PEDI value.
+func childPedigree(rel domain.ChildRelationType) string {
+	switch rel {
+	case domain.ChildAdopted:
+		return "adopted"
+	case domain.ChildFoster:
+		return "foster"
+	default:
+		return "birth"
+	}
+}
+
 // sourceToGedcomSource converts a SourceReadModel to a gedcom.Source.
 func (s *ValidationService) sourceToGedcomSource(source repository.SourceReadModel) *gedcom.Source {
 	return &gedcom.Source{
@@ -2,6 +2,7 @@ package query_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -155,6 +156,49 @@ func TestValidationService_GetQualityReport_WithData(t *testing.T) {
 	}
 }
 
+func TestValidationService_GetQualityReport_ResearchStatus(t *testing.T) {
+	service, store := setupValidationService()
+	ctx := context.Background()
+
+	p1 := addPerson(store, "John", "Doe", "1950")
+	p2 := addPerson(store, "Jane", "Doe", "1952")
+	person, _ := store.GetPerson(ctx, p1)
+	person.ResearchStatus = domain.ResearchStatusCertain
+	_ = store.SavePerson(ctx, person)
+
+	familyID := addFamily(store, &p1, &p2, "ABT 1975")
+	family, _ := store.GetFamily(ctx, familyID)
+	family.ResearchStatus = domain.ResearchStatusPossible
+	_ = store.SaveFamily(ctx, family)
+
+	_ = store.SaveEvent(ctx, &repository.EventReadModel{
+		ID: uuid.New(), OwnerType: "family", OwnerID: familyID, FactType: domain.FactFamilyMarriage,
+		ResearchStatus: domain.ResearchStatusProbable,
+	})
+	_ = store.SaveAttribute(ctx, &repository.AttributeReadModel{
+		ID: uuid.New(), PersonID: p1, FactType: domain.FactPersonOccupation, Value: "Farmer",
+	})
+
+	report, err := service.GetQualityReport(ctx)
+	if err != nil {
+		t.Fatalf("GetQualityReport returned error: %v", err)
+	}
+
+	rs := report.ResearchStatus
+	if rs.Persons != (query.ResearchStatusCounts{Certain: 1, Unknown: 1}) {
+		t.Errorf("Persons = %+v, want 1 certain and 1 unknown", rs.Persons)
+	}
+	if rs.Families != (query.ResearchStatusCounts{Possible: 1}) {
+		t.Errorf("Families = %+v, want 1 possible", rs.Families)
+	}
+	if rs.Events != (query.ResearchStatusCounts{Probable: 1}) {
+		t.Errorf("Events = %+v, want 1 probable", rs.Events)
+	}
+	if rs.Attributes != (query.ResearchStatusCounts{Unknown: 1}) {
+		t.Errorf("Attributes = %+v, want 1 unknown", rs.Attributes)
+	}
+}
+
 func TestValidationService_GetQualityReport_TopIssues(t *testing.T) {
 	service, store := setupValidationService()
 	ctx := context.Background()
@@ -762,3 +806,120 @@ func TestValidationService_CoverageCalculations(t *testing.T) {
 		t.Errorf("SourceCoverage = %.2f, want 0", report.SourceCoverage)
 	}
 }
+
+func TestValidationService_GetValidationIssues_GenderRoleMismatch(t *testing.T) {
+	service, store := setupValidationService()
+	ctx := context.Background()
+
+	man := addPersonWithGender(store, "John", "Doe", domain.GenderMale, "1850")
+	woman := addPersonWithGender(store, "Jane", "Doe", domain.GenderFemale, "1852")
+	swapped := addFamily(store, &woman, &man, "1875")
+
+	lone := addPersonWithGender(store, "Mary", "Roe", domain.GenderFemale, "1860")
+	loneFamily := addFamily(store, &lone, nil, "")
+
+	// Correctly assigned and same-gender couples are not flagged
+	addFamily(store, &man, &woman, "1876")
+	other := addPersonWithGender(store, "Ann", "Poe", domain.GenderFemale, "1861")
+	addFamily(store, &lone, &other, "")
+
+	page, err := service.GetValidationIssues(ctx, "", 0, 0)
+	if err != nil {
+		t.Fatalf("GetValidationIssues returned error: %v", err)
+	}
+
+	found := make(map[uuid.UUID]uuid.UUID)
+	for _, issue := range page.Issues {
+		if issue.Code != query.CodeGenderRoleMismatch {
+			continue
+		}
+		if issue.Severity != "warning" {
+			t.Errorf("Severity = %q, want warning", issue.Severity)
+		}
+		if issue.RecordID == nil || issue.RelatedRecordID == nil {
+			t.Fatalf("issue should map family and person IDs: %+v", issue)
+		}
+		found[*issue.RecordID] = *issue.RelatedRecordID
+	}
+
+	if len(found) != 2 {
+		t.Fatalf("got %d gender_role_mismatch issues, want 2: %v", len(found), found)
+	}
+	if found[swapped] != woman {
+		t.Errorf("swapped family related record = %v, want %v", found[swapped], woman)
+	}
+	if found[loneFamily] != lone {
+		t.Errorf("single-partner family related record = %v, want %v", found[loneFamily], lone)
+	}
+
+	report, err := service.GetQualityReport(ctx)
+	if err != nil {
+		t.Fatalf("GetQualityReport returned error: %v", err)
+	}
+	var counted bool
+	for _, ti := range report.TopIssues {
+		if ti.Code == query.CodeGenderRoleMismatch && ti.Count == 2 {
+			counted = true
+		}
+	}
+	if !counted {
+		t.Errorf("quality report top issues should count 2 gender_role_mismatch, got %+v", report.TopIssues)
+	}
+}
+
+func TestValidationService_GetValidationIssues_ChildMultipleFamilies(t *testing.T) {
+	service, store := setupValidationService()
+	ctx := context.Background()
+
+	father := addPersonWithGender(store, "John", "Doe", domain.GenderMale, "1850")
+	mother := addPersonWithGender(store, "Jane", "Doe", domain.GenderFemale, "1852")
+	other := addPersonWithGender(store, "Jim", "Roe", domain.GenderMale, "1849")
+	family1 := addFamily(store, &father, &mother, "1875")
+	family2 := addFamily(store, &other, &mother, "1880")
+	family3 := addFamily(store, &other, nil, "")
+
+	linkChild := func(familyID, personID uuid.UUID, rel domain.ChildRelationType) {
+		_ = store.SaveFamilyChild(ctx, &repository.FamilyChildReadModel{
+			FamilyID:         familyID,
+			PersonID:         personID,
+			RelationshipType: rel,
+		})
+	}
+
+	// Double-linked as a birth child
+	doubled := addPerson(store, "Tom", "Doe", "1876")
+	linkChild(family1, doubled, domain.ChildBiological)
+	linkChild(family2, doubled, domain.ChildBiological)
+
+	// Birth family plus an adoptive family is expected
+	adopted := addPerson(store, "Sue", "Doe", "1878")
+	linkChild(family1, adopted, domain.ChildBiological)
+	linkChild(family3, adopted, domain.ChildAdopted)
+
+	page, err := service.GetValidationIssues(ctx, "", 0, 0)
+	if err != nil {
+		t.Fatalf("GetValidationIssues returned error: %v", err)
+	}
+
+	var issues []query.ValidationIssueResult
+	for _, issue := range page.Issues {
+		if issue.Code == query.CodeChildMultipleFamilies {
+			issues = append(issues, issue)
+		}
+	}
+	if len(issues) != 1 {
+		t.Fatalf("got %d child_multiple_families issues, want 1: %+v", len(issues), issues)
+	}
+	issue := issues[0]
+	if issue.RecordID == nil || *issue.RecordID != doubled {
+		t.Errorf("RecordID = %v, want %v", issue.RecordID, doubled)
+	}
+	if issue.RelatedRecordID == nil || (*issue.RelatedRecordID != family1 && *issue.RelatedRecordID != family2) {
+		t.Errorf("RelatedRecordID = %v, want one of the families", issue.RelatedRecordID)
+	}
+	for _, id := range []uuid.UUID{family1, family2} {
+		if !strings.Contains(issue.Message, id.String()) {
+			t.Errorf("Message %q should list family %v", issue.Message, id)
+		}
+	}
+}
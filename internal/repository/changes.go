@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/domain"
+)
+
+// Change notification actions.
+const (
+	ChangeCreated = "created"
+	ChangeUpdated = "updated"
+	ChangeDeleted = "deleted"
+)
+
+// ChangeNotification is a lightweight notice that an entity's event stream
+// gained a new event. Subscribers re-read the entity if they care about it.
+type ChangeNotification struct {
+	EntityType string    `json:"entity_type"` // Snake-case stream type, e.g. "person" or "lds_ordinance"
+	EntityID   uuid.UUID `json:"entity_id"`
+	Version    int64     `json:"version"` // Stream version after the event
+	Action     string    `json:"action"`  // ChangeCreated, ChangeUpdated, or ChangeDeleted
+}
+
+// ChangeBroker fans change notifications out to subscribers. Delivery is best
+// effort: a subscriber whose buffer is full misses notifications rather than
+// blocking writers.
+type ChangeBroker struct {
+	mu          sync.Mutex
+	subscribers map[chan ChangeNotification]struct{}
+}
+
+// NewChangeBroker creates a broker with no subscribers.
+func NewChangeBroker() *ChangeBroker {
+	return &ChangeBroker{subscribers: make(map[chan ChangeNotification]struct{})}
+}
+
+// Subscribe registers a subscriber with the given buffer size. The returned
+// function unsubscribes and closes the channel; it is safe to call more than once.
+func (b *ChangeBroker) Subscribe(buffer int) (<-chan ChangeNotification, func()) {
+	ch := make(chan ChangeNotification, buffer)
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, ch)
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Publish delivers a notification to every subscriber without blocking.
+func (b *ChangeBroker) Publish(n ChangeNotification) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- n:
+		default:
+		}
+	}
+}
+
+// NotifyingEventStore wraps an EventStore and publishes a ChangeNotification
+// for every event it appends successfully.
+type NotifyingEventStore struct {
+	EventStore
+	broker *ChangeBroker
+}
+
+// NewNotifyingEventStore wraps store so that appends are published to broker.
+func NewNotifyingEventStore(store EventStore, broker *ChangeBroker) *NotifyingEventStore {
+	return &NotifyingEventStore{EventStore: store, broker: broker}
+}
+
+// Append appends events to the wrapped store and publishes one notification
+// per event once the append has succeeded.
+func (s *NotifyingEventStore) Append(ctx context.Context, streamID uuid.UUID, streamType string, events []domain.Event, expectedVersion int64) error {
+	if err := s.EventStore.Append(ctx, streamID, streamType, events, expectedVersion); err != nil {
+		return err
+	}
+
+	entityType := changeEntityType(streamType)
+	version := max(expectedVersion, 0)
+	for _, event := range events {
+		version++
+		s.broker.Publish(ChangeNotification{
+			EntityType: entityType,
+			EntityID:   streamID,
+			Version:    version,
+			Action:     changeAction(event, version),
+		})
+	}
+	return nil
+}
+
+// changeEntityType normalizes a stream type to snake case. Commands name
+// streams inconsistently ("Person" and "person", "LDSOrdinance"), but
+// subscribers should see one spelling per entity type.
+func changeEntityType(streamType string) string {
+	runes := []rune(streamType)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// changeAction classifies an event: the first event of a stream creates the
+// entity, *Deleted events delete it, and everything else updates it.
+func changeAction(event domain.Event, version int64) string {
+	switch {
+	case strings.HasSuffix(event.EventType(), "Deleted"):
+		return ChangeDeleted
+	case version == 1:
+		return ChangeCreated
+	default:
+		return ChangeUpdated
+	}
+}
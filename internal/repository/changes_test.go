@@ -0,0 +1,107 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/domain"
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/memory"
+)
+
+func TestNotifyingEventStore_Append(t *testing.T) {
+	broker := repository.NewChangeBroker()
+	store := repository.NewNotifyingEventStore(memory.NewEventStore(), broker)
+	ctx := context.Background()
+
+	changes, unsubscribe := broker.Subscribe(10)
+	defer unsubscribe()
+
+	person := domain.NewPerson("John", "Doe")
+	if err := store.Append(ctx, person.ID, "person", []domain.Event{
+		domain.NewPersonCreated(person),
+		domain.NewPersonUpdated(person.ID, map[string]any{"given_name": "Jon"}),
+	}, -1); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+	if err := store.Append(ctx, person.ID, "person", []domain.Event{
+		domain.NewPersonDeleted(person.ID, "duplicate"),
+	}, 2); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+
+	// A conflicting append publishes nothing
+	if err := store.Append(ctx, person.ID, "person", []domain.Event{
+		domain.NewPersonUpdated(person.ID, nil),
+	}, 1); err == nil {
+		t.Fatal("expected concurrency conflict")
+	}
+
+	want := []repository.ChangeNotification{
+		{EntityType: "person", EntityID: person.ID, Version: 1, Action: repository.ChangeCreated},
+		{EntityType: "person", EntityID: person.ID, Version: 2, Action: repository.ChangeUpdated},
+		{EntityType: "person", EntityID: person.ID, Version: 3, Action: repository.ChangeDeleted},
+	}
+	for i, w := range want {
+		select {
+		case got := <-changes:
+			if got != w {
+				t.Errorf("notification %d = %+v, want %+v", i, got, w)
+			}
+		default:
+			t.Fatalf("notification %d missing", i)
+		}
+	}
+	select {
+	case got := <-changes:
+		t.Errorf("unexpected notification %+v", got)
+	default:
+	}
+}
+
+func TestNotifyingEventStore_NormalizesEntityType(t *testing.T) {
+	broker := repository.NewChangeBroker()
+	store := repository.NewNotifyingEventStore(memory.NewEventStore(), broker)
+	ctx := context.Background()
+
+	changes, unsubscribe := broker.Subscribe(10)
+	defer unsubscribe()
+
+	tests := map[string]string{
+		"Person":           "person",
+		"family":           "family",
+		"LDSOrdinance":     "lds_ordinance",
+		"EvidenceAnalysis": "evidence_analysis",
+	}
+	for streamType, want := range tests {
+		person := domain.NewPerson("John", "Doe")
+		if err := store.Append(ctx, person.ID, streamType, []domain.Event{domain.NewPersonCreated(person)}, -1); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+		if got := <-changes; got.EntityType != want {
+			t.Errorf("stream type %q: EntityType = %q, want %q", streamType, got.EntityType, want)
+		}
+	}
+}
+
+func TestChangeBroker_SlowSubscriberDoesNotBlock(t *testing.T) {
+	broker := repository.NewChangeBroker()
+	changes, unsubscribe := broker.Subscribe(1)
+
+	id := uuid.New()
+	broker.Publish(repository.ChangeNotification{EntityID: id, Version: 1})
+	broker.Publish(repository.ChangeNotification{EntityID: id, Version: 2}) // dropped
+
+	if got := <-changes; got.Version != 1 {
+		t.Errorf("Version = %d, want 1", got.Version)
+	}
+
+	unsubscribe()
+	unsubscribe()
+	if _, ok := <-changes; ok {
+		t.Error("channel should be closed after unsubscribe")
+	}
+	broker.Publish(repository.ChangeNotification{EntityID: id, Version: 3})
+}
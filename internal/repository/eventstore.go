@@ -69,6 +69,22 @@ type StoredEvent struct {
 	Timestamp  time.Time       `json:"timestamp"`
 }
 
+// Actor returns who made the change: the user recorded in the event metadata,
+// or else the actor stamped on the event itself. It is empty for unattributed events.
+func (e *StoredEvent) Actor() string {
+	if len(e.Metadata) > 0 {
+		var metadata domain.EventMetadata
+		if err := json.Unmarshal(e.Metadata, &metadata); err == nil && metadata.UserID != "" {
+			return metadata.UserID
+		}
+	}
+	var base domain.BaseEvent
+	if err := json.Unmarshal(e.Data, &base); err != nil {
+		return ""
+	}
+	return base.Actor
+}
+
 // HistoryPage represents a paginated result set of events for history queries.
 type HistoryPage struct {
 	Events     []StoredEvent `json:"events"`
@@ -205,6 +221,12 @@ func (e *StoredEvent) DecodeEvent() (domain.Event, error) {
 			return nil, err
 		}
 		return event, nil
+	case "PersonExternalIDAdded":
+		var event domain.PersonExternalIDAdded
+		if err := json.Unmarshal(e.Data, &event); err != nil {
+			return nil, err
+		}
+		return event, nil
 	case "SnapshotCreated":
 		var event domain.SnapshotCreated
 		if err := json.Unmarshal(e.Data, &event); err != nil {
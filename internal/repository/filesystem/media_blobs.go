@@ -0,0 +1,99 @@
+// Package filesystem provides repository implementations backed by the local filesystem.
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// MediaBlobStore stores media blobs as files under a root directory, sharded
+// into subdirectories by the first two characters of the key.
+type MediaBlobStore struct {
+	root string
+}
+
+// NewMediaBlobStore creates a blob store rooted at dir, creating it if needed.
+func NewMediaBlobStore(dir string) (*MediaBlobStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create media storage directory: %w", err)
+	}
+	return &MediaBlobStore{root: dir}, nil
+}
+
+// PutBlob writes data under key. The file is written to a temporary name and
+// renamed into place so readers never observe a partial blob.
+func (s *MediaBlobStore) PutBlob(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("create media blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-"+key+"-*")
+	if err != nil {
+		return fmt.Errorf("create media blob: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write media blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write media blob: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("store media blob: %w", err)
+	}
+	return nil
+}
+
+// GetBlob reads the blob stored under key.
+func (s *MediaBlobStore) GetBlob(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	// #nosec G304 -- path is built from a validated key under the store root
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, repository.ErrMediaBlobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read media blob: %w", err)
+	}
+	return data, nil
+}
+
+// DeleteBlob removes the blob stored under key.
+func (s *MediaBlobStore) DeleteBlob(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete media blob: %w", err)
+	}
+	return nil
+}
+
+// path maps a key to its file, rejecting keys that could escape the root.
+func (s *MediaBlobStore) path(key string) (string, error) {
+	if len(key) < 3 {
+		return "", fmt.Errorf("invalid media blob key %q", key)
+	}
+	for _, r := range key {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return "", fmt.Errorf("invalid media blob key %q", key)
+		}
+	}
+	return filepath.Join(s.root, key[:2], key), nil
+}
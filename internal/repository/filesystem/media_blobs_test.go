@@ -0,0 +1,64 @@
+package filesystem_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/filesystem"
+)
+
+func TestMediaBlobStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := filesystem.NewMediaBlobStore(filepath.Join(dir, "media"))
+	if err != nil {
+		t.Fatalf("NewMediaBlobStore() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if err := store.PutBlob(ctx, "abc123", []byte("scan")); err != nil {
+		t.Fatalf("PutBlob() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "media", "ab", "abc123")); err != nil {
+		t.Errorf("blob not stored in shard directory: %v", err)
+	}
+
+	// Replacing an existing blob
+	if err := store.PutBlob(ctx, "abc123", []byte("rescan")); err != nil {
+		t.Fatalf("PutBlob() replace error = %v", err)
+	}
+	data, err := store.GetBlob(ctx, "abc123")
+	if err != nil || string(data) != "rescan" {
+		t.Errorf("GetBlob() = %q, %v; want rescan", data, err)
+	}
+
+	if err := store.DeleteBlob(ctx, "abc123"); err != nil {
+		t.Fatalf("DeleteBlob() error = %v", err)
+	}
+	if _, err := store.GetBlob(ctx, "abc123"); !errors.Is(err, repository.ErrMediaBlobNotFound) {
+		t.Errorf("GetBlob() after delete error = %v, want ErrMediaBlobNotFound", err)
+	}
+	if err := store.DeleteBlob(ctx, "abc123"); err != nil {
+		t.Errorf("DeleteBlob() of missing blob error = %v", err)
+	}
+}
+
+func TestMediaBlobStore_InvalidKey(t *testing.T) {
+	store, err := filesystem.NewMediaBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewMediaBlobStore() error = %v", err)
+	}
+	ctx := context.Background()
+
+	for _, key := range []string{"", "ab", "../etc/passwd", "abc/def", "abc.def"} {
+		if err := store.PutBlob(ctx, key, []byte("x")); err == nil {
+			t.Errorf("PutBlob(%q) expected error", key)
+		}
+		if _, err := store.GetBlob(ctx, key); err == nil {
+			t.Errorf("GetBlob(%q) expected error", key)
+		}
+	}
+}
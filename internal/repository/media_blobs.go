@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"errors"
+)
+
+// Common errors for media blob store operations.
+var (
+	ErrMediaBlobNotFound = errors.New("media blob not found")
+)
+
+// MediaBlobStore holds media file content outside the read model, which then
+// keeps only metadata and a storage key. Keys are content hashes, so identical
+// uploads share a single blob.
+type MediaBlobStore interface {
+	// PutBlob stores data under key, replacing any existing blob.
+	PutBlob(ctx context.Context, key string, data []byte) error
+
+	// GetBlob returns the data stored under key.
+	// Returns ErrMediaBlobNotFound if no such blob exists.
+	GetBlob(ctx context.Context, key string) ([]byte, error)
+
+	// DeleteBlob removes the blob stored under key. Deleting a missing blob
+	// is not an error.
+	DeleteBlob(ctx context.Context, key string) error
+}
+
+// NewTreeMediaBlobStore returns a view of store whose keys are prefixed with
+// treeID, giving an additional family tree its own blob namespace. Each tree
+// only knows about its own media, so trees sharing unprefixed keys would
+// delete content that another tree still refers to.
+func NewTreeMediaBlobStore(store MediaBlobStore, treeID string) MediaBlobStore {
+	return &treeMediaBlobStore{store: store, prefix: treeID + "_"}
+}
+
+type treeMediaBlobStore struct {
+	store  MediaBlobStore
+	prefix string
+}
+
+func (s *treeMediaBlobStore) PutBlob(ctx context.Context, key string, data []byte) error {
+	return s.store.PutBlob(ctx, s.prefix+key, data)
+}
+
+func (s *treeMediaBlobStore) GetBlob(ctx context.Context, key string) ([]byte, error) {
+	return s.store.GetBlob(ctx, s.prefix+key)
+}
+
+func (s *treeMediaBlobStore) DeleteBlob(ctx context.Context, key string) error {
+	return s.store.DeleteBlob(ctx, s.prefix+key)
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// MediaBlobStore is an in-memory implementation of repository.MediaBlobStore.
+type MediaBlobStore struct {
+	mu    sync.RWMutex
+	blobs map[string][]byte
+}
+
+// NewMediaBlobStore creates a new in-memory media blob store.
+func NewMediaBlobStore() *MediaBlobStore {
+	return &MediaBlobStore{blobs: make(map[string][]byte)}
+}
+
+// PutBlob stores a copy of data under key.
+func (s *MediaBlobStore) PutBlob(_ context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blobs[key] = append([]byte(nil), data...)
+	return nil
+}
+
+// GetBlob returns the data stored under key.
+func (s *MediaBlobStore) GetBlob(_ context.Context, key string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, ok := s.blobs[key]
+	if !ok {
+		return nil, repository.ErrMediaBlobNotFound
+	}
+	return data, nil
+}
+
+// DeleteBlob removes the blob stored under key.
+func (s *MediaBlobStore) DeleteBlob(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.blobs, key)
+	return nil
+}
+
+// Reset clears all blobs.
+func (s *MediaBlobStore) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blobs = make(map[string][]byte)
+}
@@ -28,6 +28,7 @@ type ReadModelStore struct {
 	sourceExternalIDs     map[uuid.UUID][]repository.SourceExternalIDReadModel // keyed by source ID
 	citations             map[uuid.UUID]*repository.CitationReadModel
 	media                 map[uuid.UUID]*repository.MediaReadModel
+	mediaBlobs            map[string]*mediaBlob // keyed by content hash
 	events                map[uuid.UUID]*repository.EventReadModel
 	attributes            map[uuid.UUID]*repository.AttributeReadModel
 	notes                 map[uuid.UUID]*repository.NoteReadModel
@@ -40,6 +41,7 @@ type ReadModelStore struct {
 	evidenceConflicts     map[uuid.UUID]*repository.EvidenceConflictReadModel
 	researchLogs          map[uuid.UUID]*repository.ResearchLogReadModel
 	proofSummaries        map[uuid.UUID]*repository.ProofSummaryReadModel
+	searchIndex           *personSearchIndex
 }
 
 // NewReadModelStore creates a new in-memory read model store.
@@ -47,6 +49,7 @@ func NewReadModelStore() *ReadModelStore {
 	return &ReadModelStore{
 		persons:               make(map[uuid.UUID]*repository.PersonReadModel),
 		personNames:           make(map[uuid.UUID][]repository.PersonNameReadModel),
+		searchIndex:           newPersonSearchIndex(),
 		personExternalIDs:     make(map[uuid.UUID][]repository.PersonExternalIDReadModel),
 		families:              make(map[uuid.UUID]*repository.FamilyReadModel),
 		familyChildren:        make(map[uuid.UUID][]repository.FamilyChildReadModel),
@@ -56,6 +59,7 @@ func NewReadModelStore() *ReadModelStore {
 		sourceExternalIDs:     make(map[uuid.UUID][]repository.SourceExternalIDReadModel),
 		citations:             make(map[uuid.UUID]*repository.CitationReadModel),
 		media:                 make(map[uuid.UUID]*repository.MediaReadModel),
+		mediaBlobs:            make(map[string]*mediaBlob),
 		events:                make(map[uuid.UUID]*repository.EventReadModel),
 		attributes:            make(map[uuid.UUID]*repository.AttributeReadModel),
 		notes:                 make(map[uuid.UUID]*repository.NoteReadModel),
@@ -96,17 +100,30 @@ func matchesResearchStatusFilter(p *repository.PersonReadModel, filter *string)
 	return string(p.ResearchStatus) == *filter
 }
 
-// comparePersons compares two persons based on the sort field.
-func comparePersons(a, b *repository.PersonReadModel, sortField string) int {
+// comparePersons compares two persons based on the sort field. Surnames are
+// compared without leading particles when ignoreSurnamePrefix is set.
+func comparePersons(a, b *repository.PersonReadModel, sortField string, ignoreSurnamePrefix bool) int {
 	switch sortField {
 	case "given_name":
 		return strings.Compare(a.GivenName, b.GivenName)
 	case "birth_date":
-		return compareBirthDates(a.BirthDateSort, b.BirthDateSort)
+		if cmp := compareBirthDates(a.BirthDateSort, b.BirthDateSort); cmp != 0 {
+			return cmp
+		}
+		// Same resolved point: order by precision and qualifier, as the SQL
+		// stores do with birth_date_rank, then by name for a stable order
+		if cmp := compareBirthDateRanks(a.BirthDateRaw, b.BirthDateRaw); cmp != 0 {
+			return cmp
+		}
+		return strings.Compare(a.GivenName, b.GivenName)
 	case "updated_at":
 		return compareTimestamps(a.UpdatedAt, b.UpdatedAt)
 	default: // surname
-		cmp := strings.Compare(a.Surname, b.Surname)
+		surnameA, surnameB := a.Surname, b.Surname
+		if ignoreSurnamePrefix {
+			surnameA, surnameB = domain.SurnameSortKey(surnameA), domain.SurnameSortKey(surnameB)
+		}
+		cmp := strings.Compare(surnameA, surnameB)
 		if cmp == 0 {
 			return strings.Compare(a.GivenName, b.GivenName)
 		}
@@ -128,6 +145,19 @@ func compareBirthDates(a, b *time.Time) int {
 	return compareTimestamps(*a, *b)
 }
 
+// compareBirthDateRanks compares the GenDate sort ranks of two raw dates.
+func compareBirthDateRanks(a, b string) int {
+	gdA, gdB := domain.ParseGenDate(a), domain.ParseGenDate(b)
+	rankA, rankB := gdA.SortRank(), gdB.SortRank()
+	if rankA < rankB {
+		return -1
+	}
+	if rankA > rankB {
+		return 1
+	}
+	return 0
+}
+
 // compareTimestamps compares two timestamps.
 func compareTimestamps(a, b time.Time) int {
 	if a.Before(b) {
@@ -144,17 +174,32 @@ func (s *ReadModelStore) ListPersons(ctx context.Context, opts repository.ListOp
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	// Convert map to slice, applying research_status filter if present
+	var linked map[uuid.UUID]bool
+	if opts.Unlinked {
+		linked = s.linkedPersonIDs()
+	}
+
+	// Convert map to slice, applying research_status, surname, gender, and unlinked filters if present
 	persons := make([]repository.PersonReadModel, 0, len(s.persons))
 	for _, p := range s.persons {
-		if matchesResearchStatusFilter(p, opts.ResearchStatus) {
-			persons = append(persons, *p)
+		if !matchesResearchStatusFilter(p, opts.ResearchStatus) {
+			continue
+		}
+		if opts.Surname != "" && !strings.EqualFold(p.Surname, opts.Surname) {
+			continue
+		}
+		if opts.Gender != "" && string(p.Gender) != opts.Gender {
+			continue
+		}
+		if opts.Unlinked && linked[p.ID] {
+			continue
 		}
+		persons = append(persons, *p)
 	}
 
 	// Sort
 	sort.Slice(persons, func(i, j int) bool {
-		cmp := comparePersons(&persons[i], &persons[j], opts.Sort)
+		cmp := comparePersons(&persons[i], &persons[j], opts.Sort, opts.IgnoreSurnamePrefix)
 		if opts.Order == "desc" {
 			return cmp > 0
 		}
@@ -176,6 +221,26 @@ func (s *ReadModelStore) ListPersons(ctx context.Context, opts repository.ListOp
 	return persons[start:end], total, nil
 }
 
+// linkedPersonIDs returns the persons who are a partner or a child in some
+// family. The caller must hold the lock.
+func (s *ReadModelStore) linkedPersonIDs() map[uuid.UUID]bool {
+	linked := make(map[uuid.UUID]bool)
+	for _, f := range s.families {
+		if f.Partner1ID != nil {
+			linked[*f.Partner1ID] = true
+		}
+		if f.Partner2ID != nil {
+			linked[*f.Partner2ID] = true
+		}
+	}
+	for _, children := range s.familyChildren {
+		for _, c := range children {
+			linked[c.PersonID] = true
+		}
+	}
+	return linked
+}
+
 // SearchPersons searches for persons by name, including alternate names.
 func (s *ReadModelStore) SearchPersons(ctx context.Context, opts repository.SearchOptions) ([]repository.PersonReadModel, error) {
 	s.mu.RLock()
@@ -185,7 +250,8 @@ func (s *ReadModelStore) SearchPersons(ctx context.Context, opts repository.Sear
 	hasDateFilter := opts.BirthDateFrom != nil || opts.BirthDateTo != nil ||
 		opts.DeathDateFrom != nil || opts.DeathDateTo != nil
 	hasPlaceFilter := strings.TrimSpace(opts.BirthPlace) != "" || strings.TrimSpace(opts.DeathPlace) != ""
-	if !hasQuery && !hasDateFilter && !hasPlaceFilter {
+	hasPersonFilter := strings.TrimSpace(opts.GivenName) != "" || strings.TrimSpace(opts.Surname) != "" || opts.Gender != ""
+	if !hasQuery && !hasDateFilter && !hasPlaceFilter && !hasPersonFilter {
 		return nil, nil
 	}
 
@@ -193,12 +259,27 @@ func (s *ReadModelStore) SearchPersons(ctx context.Context, opts repository.Sear
 	foundIDs := make(map[uuid.UUID]bool)
 	var results []repository.PersonReadModel
 
+	// Narrow the search to index candidates when the query allows it
+	persons, personNames := s.persons, s.personNames
+	if candidates, ok := s.searchIndex.candidates(queryLower, opts); ok {
+		persons = make(map[uuid.UUID]*repository.PersonReadModel, len(candidates))
+		personNames = make(map[uuid.UUID][]repository.PersonNameReadModel, len(candidates))
+		for id := range candidates {
+			if p, exists := s.persons[id]; exists {
+				persons[id] = p
+			}
+			if names, exists := s.personNames[id]; exists {
+				personNames[id] = names
+			}
+		}
+	}
+
 	// Search in main persons table
-	for _, p := range s.persons {
+	for _, p := range persons {
 		if !s.matchesSearchFilters(p, opts) {
 			continue
 		}
-		if s.personMatchesQuery(p, queryLower, opts.Soundex) && !foundIDs[p.ID] {
+		if s.personMatchesQuery(p, queryLower, opts) && !foundIDs[p.ID] {
 			results = append(results, *p)
 			foundIDs[p.ID] = true
 		}
@@ -206,7 +287,7 @@ func (s *ReadModelStore) SearchPersons(ctx context.Context, opts repository.Sear
 
 	// Search in person_names table for alternate names (only if text query provided)
 	if opts.Query != "" {
-		s.searchAlternateNames(queryLower, opts, foundIDs, &results)
+		s.searchAlternateNames(personNames, queryLower, opts, foundIDs, &results)
 	}
 
 	// Sort results to match postgres/sqlite behavior
@@ -221,28 +302,34 @@ func (s *ReadModelStore) SearchPersons(ctx context.Context, opts repository.Sear
 }
 
 // personMatchesQuery checks if a person matches the text query (or returns true if no query).
-func (s *ReadModelStore) personMatchesQuery(p *repository.PersonReadModel, queryLower string, soundex bool) bool {
+func (s *ReadModelStore) personMatchesQuery(p *repository.PersonReadModel, queryLower string, opts repository.SearchOptions) bool {
 	if queryLower == "" {
 		return true
 	}
+	if opts.Wildcard {
+		return repository.WildcardMatch(queryLower, p.FullName) ||
+			repository.WildcardMatch(queryLower, p.GivenName) ||
+			repository.WildcardMatch(queryLower, p.Surname)
+	}
 	if strings.Contains(strings.ToLower(p.FullName), queryLower) ||
 		strings.Contains(strings.ToLower(p.GivenName), queryLower) ||
 		strings.Contains(strings.ToLower(p.Surname), queryLower) {
 		return true
 	}
-	if soundex {
-		for _, word := range strings.Fields(queryLower) {
-			if repository.SoundexMatch(word, p.GivenName) || repository.SoundexMatch(word, p.Surname) {
-				return true
-			}
+	for _, word := range strings.Fields(queryLower) {
+		if opts.Soundex && (repository.SoundexMatch(word, p.GivenName) || repository.SoundexMatch(word, p.Surname)) {
+			return true
+		}
+		if opts.Metaphone && repository.MetaphoneMatch(word, p.Surname) {
+			return true
 		}
 	}
 	return false
 }
 
 // searchAlternateNames searches person_names for alternate name matches.
-func (s *ReadModelStore) searchAlternateNames(queryLower string, opts repository.SearchOptions, foundIDs map[uuid.UUID]bool, results *[]repository.PersonReadModel) {
-	for personID, names := range s.personNames {
+func (s *ReadModelStore) searchAlternateNames(personNames map[uuid.UUID][]repository.PersonNameReadModel, queryLower string, opts repository.SearchOptions, foundIDs map[uuid.UUID]bool, results *[]repository.PersonReadModel) {
+	for personID, names := range personNames {
 		if len(*results) >= opts.Limit {
 			break
 		}
@@ -250,7 +337,7 @@ func (s *ReadModelStore) searchAlternateNames(queryLower string, opts repository
 			continue
 		}
 		for _, name := range names {
-			if altNameMatches(name, queryLower, opts.Soundex) {
+			if altNameMatches(name, queryLower, opts) {
 				if p, exists := s.persons[personID]; exists && !foundIDs[personID] && s.matchesSearchFilters(p, opts) {
 					*results = append(*results, *p)
 					foundIDs[personID] = true
@@ -261,19 +348,35 @@ func (s *ReadModelStore) searchAlternateNames(queryLower string, opts repository
 	}
 }
 
-// altNameMatches checks if a PersonNameReadModel matches via substring or Soundex.
-func altNameMatches(name repository.PersonNameReadModel, queryLower string, soundex bool) bool {
+// altNameMatches checks if a PersonNameReadModel matches via substring, wildcard, Soundex, or Metaphone.
+func altNameMatches(name repository.PersonNameReadModel, queryLower string, opts repository.SearchOptions) bool {
+	if opts.Wildcard {
+		for _, field := range []string{name.FullName, name.GivenName, name.Surname, name.Nickname, name.Romanized} {
+			if repository.WildcardMatch(queryLower, field) {
+				return true
+			}
+		}
+		return false
+	}
 	if nameMatchesQuery(name, queryLower) {
 		return true
 	}
-	if soundex {
-		for _, word := range strings.Fields(queryLower) {
-			if repository.SoundexMatch(word, name.GivenName) ||
-				repository.SoundexMatch(word, name.Surname) ||
-				repository.SoundexMatch(word, name.Nickname) {
-				return true
+	for _, word := range strings.Fields(queryLower) {
+		if opts.Soundex && (repository.SoundexMatch(word, name.GivenName) ||
+			repository.SoundexMatch(word, name.Surname) ||
+			repository.SoundexMatch(word, name.Nickname)) {
+			return true
+		}
+		if opts.Soundex {
+			for _, romanized := range strings.Fields(name.Romanized) {
+				if repository.SoundexMatch(word, romanized) {
+					return true
+				}
 			}
 		}
+		if opts.Metaphone && repository.MetaphoneMatch(word, name.Surname) {
+			return true
+		}
 	}
 	return false
 }
@@ -325,10 +428,11 @@ func nameMatchesQuery(name repository.PersonNameReadModel, queryLower string) bo
 	return strings.Contains(strings.ToLower(name.FullName), queryLower) ||
 		strings.Contains(strings.ToLower(name.GivenName), queryLower) ||
 		strings.Contains(strings.ToLower(name.Surname), queryLower) ||
-		strings.Contains(strings.ToLower(name.Nickname), queryLower)
+		strings.Contains(strings.ToLower(name.Nickname), queryLower) ||
+		strings.Contains(strings.ToLower(name.Romanized), queryLower)
 }
 
-// matchesSearchFilters checks if a person matches the date/place filters in SearchOptions.
+// matchesSearchFilters checks if a person matches the date/place/name/gender filters in SearchOptions.
 func (s *ReadModelStore) matchesSearchFilters(p *repository.PersonReadModel, opts repository.SearchOptions) bool {
 	if opts.BirthDateFrom != nil && (p.BirthDateSort == nil || p.BirthDateSort.Before(*opts.BirthDateFrom)) {
 		return false
@@ -348,6 +452,15 @@ func (s *ReadModelStore) matchesSearchFilters(p *repository.PersonReadModel, opt
 	if opts.DeathPlace != "" && !strings.Contains(strings.ToLower(p.DeathPlace), strings.ToLower(opts.DeathPlace)) {
 		return false
 	}
+	if opts.GivenName != "" && !strings.Contains(strings.ToLower(p.GivenName), strings.ToLower(opts.GivenName)) {
+		return false
+	}
+	if opts.Surname != "" && !strings.Contains(strings.ToLower(p.Surname), strings.ToLower(opts.Surname)) {
+		return false
+	}
+	if opts.Gender != "" && p.Gender != opts.Gender {
+		return false
+	}
 	return true
 }
 
@@ -358,6 +471,7 @@ func (s *ReadModelStore) SavePerson(ctx context.Context, person *repository.Pers
 
 	result := *person
 	s.persons[person.ID] = &result
+	s.reindexPerson(person.ID)
 	return nil
 }
 
@@ -370,6 +484,7 @@ func (s *ReadModelStore) DeletePerson(ctx context.Context, id uuid.UUID) error {
 	// Also delete associated person names and external IDs (cascade behavior)
 	delete(s.personNames, id)
 	delete(s.personExternalIDs, id)
+	s.searchIndex.remove(id)
 	return nil
 }
 
@@ -394,15 +509,23 @@ func (s *ReadModelStore) SavePersonName(ctx context.Context, name *repository.Pe
 		nameCopy.FullName = fullName
 		names[i] = nameCopy
 		s.personNames[name.PersonID] = names
+		s.reindexPerson(name.PersonID)
 		return nil
 	}
 	// Add new
 	nameCopy := *name
 	nameCopy.FullName = fullName
 	s.personNames[name.PersonID] = append(names, nameCopy)
+	s.reindexPerson(name.PersonID)
 	return nil
 }
 
+// reindexPerson refreshes the search index entries for a person.
+// Callers must hold the write lock.
+func (s *ReadModelStore) reindexPerson(personID uuid.UUID) {
+	s.searchIndex.update(personID, s.persons[personID], s.personNames[personID])
+}
+
 // GetPersonName retrieves a person name by ID.
 func (s *ReadModelStore) GetPersonName(ctx context.Context, nameID uuid.UUID) (*repository.PersonNameReadModel, error) {
 	s.mu.RLock()
@@ -451,6 +574,7 @@ func (s *ReadModelStore) DeletePersonName(ctx context.Context, nameID uuid.UUID)
 		for i, n := range names {
 			if n.ID == nameID {
 				s.personNames[personID] = append(names[:i], names[i+1:]...)
+				s.reindexPerson(personID)
 				return nil
 			}
 		}
@@ -659,6 +783,44 @@ func (s *ReadModelStore) GetFamiliesForPerson(ctx context.Context, personID uuid
 	return results, nil
 }
 
+// SearchFamilies searches for families by partner name. Every word of the
+// query must appear in either partner's name.
+func (s *ReadModelStore) SearchFamilies(ctx context.Context, query string, limit int) ([]repository.FamilyReadModel, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	var results []repository.FamilyReadModel
+	for _, f := range s.families {
+		names := strings.ToLower(f.Partner1GivenName + " " + f.Partner1Surname + " " + f.Partner2GivenName + " " + f.Partner2Surname)
+		matches := true
+		for _, word := range words {
+			if !strings.Contains(names, word) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			results = append(results, *f)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Partner1Surname != results[j].Partner1Surname {
+			return results[i].Partner1Surname < results[j].Partner1Surname
+		}
+		return results[i].Partner1GivenName < results[j].Partner1GivenName
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
 // SaveFamily saves or updates a family.
 func (s *ReadModelStore) SaveFamily(ctx context.Context, family *repository.FamilyReadModel) error {
 	s.mu.Lock()
@@ -800,12 +962,14 @@ func (s *ReadModelStore) Reset() {
 
 	s.persons = make(map[uuid.UUID]*repository.PersonReadModel)
 	s.personNames = make(map[uuid.UUID][]repository.PersonNameReadModel)
+	s.searchIndex = newPersonSearchIndex()
 	s.families = make(map[uuid.UUID]*repository.FamilyReadModel)
 	s.familyChildren = make(map[uuid.UUID][]repository.FamilyChildReadModel)
 	s.pedigreeEdges = make(map[uuid.UUID]*repository.PedigreeEdge)
 	s.sources = make(map[uuid.UUID]*repository.SourceReadModel)
 	s.citations = make(map[uuid.UUID]*repository.CitationReadModel)
 	s.media = make(map[uuid.UUID]*repository.MediaReadModel)
+	s.mediaBlobs = make(map[string]*mediaBlob)
 	s.events = make(map[uuid.UUID]*repository.EventReadModel)
 	s.attributes = make(map[uuid.UUID]*repository.AttributeReadModel)
 	s.notes = make(map[uuid.UUID]*repository.NoteReadModel)
@@ -842,9 +1006,20 @@ func (s *ReadModelStore) ListSources(ctx context.Context, opts repository.ListOp
 		sources = append(sources, *src)
 	}
 
-	// Sort by title
+	// Sort by the requested field, then title
 	sort.Slice(sources, func(i, j int) bool {
-		cmp := strings.Compare(sources[i].Title, sources[j].Title)
+		var cmp int
+		switch opts.Sort {
+		case "source_type":
+			cmp = strings.Compare(string(sources[i].SourceType), string(sources[j].SourceType))
+		case "citation_count":
+			cmp = sources[i].CitationCount - sources[j].CitationCount
+		case "updated_at":
+			cmp = compareTimestamps(sources[i].UpdatedAt, sources[j].UpdatedAt)
+		}
+		if cmp == 0 {
+			cmp = strings.Compare(sources[i].Title, sources[j].Title)
+		}
 		if opts.Order == "desc" {
 			return cmp > 0
 		}
@@ -976,6 +1151,31 @@ func (s *ReadModelStore) GetCitationsForSource(ctx context.Context, sourceID uui
 	return results, nil
 }
 
+// ListCitationsForSource returns a page of a source's citations matching
+// filter, oldest first.
+func (s *ReadModelStore) ListCitationsForSource(ctx context.Context, sourceID uuid.UUID, filter repository.CitationFilter, opts repository.ListOptions) ([]repository.CitationReadModel, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var results []repository.CitationReadModel
+	for _, cit := range s.citations {
+		if cit.SourceID == sourceID && filter.Matches(*cit) {
+			results = append(results, *cit)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if cmp := compareTimestamps(results[i].CreatedAt, results[j].CreatedAt); cmp != 0 {
+			return cmp < 0
+		}
+		return results[i].ID.String() < results[j].ID.String()
+	})
+
+	total := len(results)
+	start := min(opts.Offset, total)
+	end := min(start+opts.Limit, total)
+	return results[start:end], total, nil
+}
+
 // GetCitationsForPerson returns all citations for a person.
 func (s *ReadModelStore) GetCitationsForPerson(ctx context.Context, personID uuid.UUID) ([]repository.CitationReadModel, error) {
 	s.mu.RLock()
@@ -1102,25 +1302,121 @@ func (s *ReadModelStore) ListMediaForEntity(ctx context.Context, entityType stri
 	return results, total, nil
 }
 
-// SaveMedia saves or updates a media record.
+// mediaBlob is file content shared by all media records with the same hash.
+type mediaBlob struct {
+	data []byte
+	refs int
+}
+
+// SaveMedia saves or updates a media record. Records with a content hash share
+// a single reference-counted copy of their file data.
 func (s *ReadModelStore) SaveMedia(ctx context.Context, media *repository.MediaReadModel) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	result := *media
+	prevHash := ""
+	if prev, exists := s.media[media.ID]; exists {
+		prevHash = prev.ContentHash
+	}
+	if result.ContentHash != prevHash {
+		s.releaseMediaBlob(prevHash)
+	}
+	if result.ContentHash != "" && len(result.FileData) > 0 {
+		blob, ok := s.mediaBlobs[result.ContentHash]
+		if !ok {
+			blob = &mediaBlob{data: result.FileData}
+			s.mediaBlobs[result.ContentHash] = blob
+		}
+		if !ok || result.ContentHash != prevHash {
+			blob.refs++
+		}
+		result.FileData = blob.data
+	}
 	s.media[media.ID] = &result
 	return nil
 }
 
-// DeleteMedia removes a media record.
+// DeleteMedia removes a media record, dropping its file data once no other
+// record shares it.
 func (s *ReadModelStore) DeleteMedia(ctx context.Context, id uuid.UUID) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if m, exists := s.media[id]; exists {
+		s.releaseMediaBlob(m.ContentHash)
+	}
 	delete(s.media, id)
 	return nil
 }
 
+// releaseMediaBlob drops one reference to a shared blob. Caller must hold s.mu.
+func (s *ReadModelStore) releaseMediaBlob(hash string) {
+	blob, ok := s.mediaBlobs[hash]
+	if !ok {
+		return
+	}
+	blob.refs--
+	if blob.refs <= 0 {
+		delete(s.mediaBlobs, hash)
+	}
+}
+
+// FindMediaByContentHash returns a media record, including file data, whose
+// content has the given hash.
+func (s *ReadModelStore) FindMediaByContentHash(ctx context.Context, hash string) (*repository.MediaReadModel, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var found *repository.MediaReadModel
+	for _, m := range s.media {
+		if m.ContentHash == hash && (found == nil || m.CreatedAt.Before(found.CreatedAt)) {
+			found = m
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	result := *found
+	return &result, nil
+}
+
+// ListDuplicateMedia groups media records whose file content is identical.
+func (s *ReadModelStore) ListDuplicateMedia(ctx context.Context) ([]repository.MediaDuplicateGroup, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	byHash := make(map[string][]repository.MediaReadModel)
+	for _, m := range s.media {
+		if m.ContentHash == "" {
+			continue
+		}
+		result := *m
+		result.FileData = nil
+		result.ThumbnailData = nil
+		byHash[m.ContentHash] = append(byHash[m.ContentHash], result)
+	}
+
+	var groups []repository.MediaDuplicateGroup
+	for hash, items := range byHash {
+		if len(items) < 2 {
+			continue
+		}
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].CreatedAt.Before(items[j].CreatedAt)
+		})
+		groups = append(groups, repository.MediaDuplicateGroup{
+			ContentHash: hash,
+			FileSize:    items[0].FileSize,
+			Media:       items,
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].ContentHash < groups[j].ContentHash
+	})
+	return groups, nil
+}
+
 // GetEvent retrieves an event by ID.
 func (s *ReadModelStore) GetEvent(ctx context.Context, id uuid.UUID) (*repository.EventReadModel, error) {
 	s.mu.RLock()
@@ -1316,6 +1612,7 @@ func (s *ReadModelStore) DeleteAttribute(ctx context.Context, id uuid.UUID) erro
 }
 
 // GetSurnameIndex returns a list of unique surnames with counts and letter distribution.
+// Persons whose primary name is a patronymic have no family surname and are skipped.
 func (s *ReadModelStore) GetSurnameIndex(ctx context.Context) ([]repository.SurnameEntry, []repository.LetterCount, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -1324,6 +1621,9 @@ func (s *ReadModelStore) GetSurnameIndex(ctx context.Context) ([]repository.Surn
 	surnamesByLetter := make(map[string]map[string]bool) // letter -> set of surnames
 
 	for _, p := range s.persons {
+		if s.hasPatronymicPrimaryName(p.ID) {
+			continue
+		}
 		surname := p.Surname
 		surnameCount[surname]++
 		if surname != "" {
@@ -1354,6 +1654,17 @@ func (s *ReadModelStore) GetSurnameIndex(ctx context.Context) ([]repository.Surn
 	return surnames, letters, nil
 }
 
+// hasPatronymicPrimaryName reports whether a person's primary name is a
+// patronymic rather than a family surname. Callers must hold s.mu.
+func (s *ReadModelStore) hasPatronymicPrimaryName(personID uuid.UUID) bool {
+	for _, n := range s.personNames[personID] {
+		if n.IsPrimary && n.NameType == domain.NameTypePatronymic {
+			return true
+		}
+	}
+	return false
+}
+
 // GetSurnamesByLetter returns surnames starting with a specific letter.
 func (s *ReadModelStore) GetSurnamesByLetter(ctx context.Context, letter string) ([]repository.SurnameEntry, error) {
 	s.mu.RLock()
@@ -1361,6 +1672,9 @@ func (s *ReadModelStore) GetSurnamesByLetter(ctx context.Context, letter string)
 
 	surnameCount := make(map[string]int)
 	for _, p := range s.persons {
+		if s.hasPatronymicPrimaryName(p.ID) {
+			continue
+		}
 		surname := p.Surname
 		if surname != "" && strings.EqualFold(string(surname[0]), letter) {
 			surnameCount[surname]++
@@ -1556,6 +1870,241 @@ func (s *ReadModelStore) GetPersonsByCemetery(ctx context.Context, place string,
 	return results, total, nil
 }
 
+// GetBirthDecadeIndex returns person counts per birth decade, with undated
+// persons counted in a trailing entry with a nil decade.
+func (s *ReadModelStore) GetBirthDecadeIndex(ctx context.Context) ([]repository.BirthDecadeEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	decadeCount := make(map[int]int)
+	unknown := 0
+	for _, p := range s.persons {
+		if p.BirthDateSort == nil {
+			unknown++
+			continue
+		}
+		decadeCount[repository.BirthDecade(*p.BirthDateSort)]++
+	}
+
+	entries := make([]repository.BirthDecadeEntry, 0, len(decadeCount)+1)
+	for decade, count := range decadeCount {
+		entries = append(entries, repository.BirthDecadeEntry{Decade: &decade, Count: count})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return *entries[i].Decade < *entries[j].Decade
+	})
+	if unknown > 0 {
+		entries = append(entries, repository.BirthDecadeEntry{Count: unknown})
+	}
+
+	return entries, nil
+}
+
+// GetPersonsByBirthDecade returns persons born in the given decade, or persons
+// without a parseable birth date when decade is nil.
+func (s *ReadModelStore) GetPersonsByBirthDecade(ctx context.Context, decade *int, opts repository.ListOptions) ([]repository.PersonReadModel, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var results []repository.PersonReadModel
+	for _, p := range s.persons {
+		if decade == nil {
+			if p.BirthDateSort == nil {
+				results = append(results, *p)
+			}
+			continue
+		}
+		if p.BirthDateSort != nil && repository.BirthDecade(*p.BirthDateSort) == *decade {
+			results = append(results, *p)
+		}
+	}
+
+	total := len(results)
+
+	// Sort by birth date, then surname, then given name
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.BirthDateSort != nil && b.BirthDateSort != nil && !a.BirthDateSort.Equal(*b.BirthDateSort) {
+			return a.BirthDateSort.Before(*b.BirthDateSort)
+		}
+		if a.Surname != b.Surname {
+			return a.Surname < b.Surname
+		}
+		return a.GivenName < b.GivenName
+	})
+
+	// Apply pagination
+	if opts.Offset > 0 && opts.Offset < len(results) {
+		results = results[opts.Offset:]
+	} else if opts.Offset >= len(results) {
+		results = nil
+	}
+	if opts.Limit > 0 && opts.Limit < len(results) {
+		results = results[:opts.Limit]
+	}
+
+	return results, total, nil
+}
+
+// GetSourceRepositoryIndex returns each distinct source repository name with its source count.
+func (s *ReadModelStore) GetSourceRepositoryIndex(ctx context.Context) ([]repository.SourceRepositoryEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	nameCount := make(map[string]int)
+	for _, src := range s.sources {
+		if src.RepositoryName != "" {
+			nameCount[src.RepositoryName]++
+		}
+	}
+
+	entries := make([]repository.SourceRepositoryEntry, 0, len(nameCount))
+	for name, count := range nameCount {
+		entries = append(entries, repository.SourceRepositoryEntry{Name: name, Count: count})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+
+	return entries, nil
+}
+
+// GetSourcesByRepositoryName returns sources held by the named repository.
+func (s *ReadModelStore) GetSourcesByRepositoryName(ctx context.Context, name string, opts repository.ListOptions) ([]repository.SourceReadModel, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var results []repository.SourceReadModel
+	for _, src := range s.sources {
+		if strings.EqualFold(src.RepositoryName, name) {
+			results = append(results, *src)
+		}
+	}
+
+	total := len(results)
+
+	// Sort by title
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Title < results[j].Title
+	})
+
+	// Apply pagination
+	if opts.Offset > 0 && opts.Offset < len(results) {
+		results = results[opts.Offset:]
+	} else if opts.Offset >= len(results) {
+		results = nil
+	}
+	if opts.Limit > 0 && opts.Limit < len(results) {
+		results = results[:opts.Limit]
+	}
+
+	return results, total, nil
+}
+
+// ListFactTypeUsage returns every fact type used by a life event, attribute,
+// or citation, with its counts, ordered by fact type.
+func (s *ReadModelStore) ListFactTypeUsage(ctx context.Context) ([]repository.FactTypeUsage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	byType := make(map[domain.FactType]*repository.FactTypeUsage)
+	entry := func(factType domain.FactType) *repository.FactTypeUsage {
+		u, ok := byType[factType]
+		if !ok {
+			u = &repository.FactTypeUsage{FactType: factType}
+			byType[factType] = u
+		}
+		return u
+	}
+	for _, e := range s.events {
+		entry(e.FactType).EventCount++
+	}
+	for _, a := range s.attributes {
+		entry(a.FactType).AttributeCount++
+	}
+	for _, c := range s.citations {
+		entry(c.FactType).CitationCount++
+	}
+
+	usage := make([]repository.FactTypeUsage, 0, len(byType))
+	for _, u := range byType {
+		usage = append(usage, *u)
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		return usage[i].FactType < usage[j].FactType
+	})
+
+	return usage, nil
+}
+
+// ListPlaceUsage returns every distinct place string used by persons, families,
+// and life events, with its reference count.
+func (s *ReadModelStore) ListPlaceUsage(ctx context.Context) ([]repository.PlaceUsage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	placeCount := make(map[string]int)
+	for _, p := range s.persons {
+		for _, place := range []string{p.BirthPlace, p.DeathPlace} {
+			if place != "" {
+				placeCount[place]++
+			}
+		}
+	}
+	for _, f := range s.families {
+		if f.MarriagePlace != "" {
+			placeCount[f.MarriagePlace]++
+		}
+	}
+	for _, e := range s.events {
+		if e.Place != "" {
+			placeCount[e.Place]++
+		}
+	}
+
+	usage := make([]repository.PlaceUsage, 0, len(placeCount))
+	for place, count := range placeCount {
+		usage = append(usage, repository.PlaceUsage{Place: place, Count: count})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		return usage[i].Place < usage[j].Place
+	})
+
+	return usage, nil
+}
+
+// FindPlaceReferences returns every person, family, and life event field whose
+// place exactly matches place.
+func (s *ReadModelStore) FindPlaceReferences(ctx context.Context, place string) ([]repository.PlaceReference, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var refs []repository.PlaceReference
+	if place == "" {
+		return refs, nil
+	}
+	for _, p := range s.persons {
+		if p.BirthPlace == place {
+			refs = append(refs, repository.PlaceReference{EntityType: repository.PlaceRefPerson, EntityID: p.ID, Field: "birth_place"})
+		}
+		if p.DeathPlace == place {
+			refs = append(refs, repository.PlaceReference{EntityType: repository.PlaceRefPerson, EntityID: p.ID, Field: "death_place"})
+		}
+	}
+	for _, f := range s.families {
+		if f.MarriagePlace == place {
+			refs = append(refs, repository.PlaceReference{EntityType: repository.PlaceRefFamily, EntityID: f.ID, Field: "marriage_place"})
+		}
+	}
+	for _, e := range s.events {
+		if e.Place == place {
+			refs = append(refs, repository.PlaceReference{EntityType: repository.PlaceRefEvent, EntityID: e.ID, Field: "place"})
+		}
+	}
+
+	return refs, nil
+}
+
 // GetMapLocations returns aggregated geographic locations from person birth/death coordinates.
 func (s *ReadModelStore) GetMapLocations(ctx context.Context) ([]repository.MapLocation, error) {
 	s.mu.RLock()
@@ -1730,6 +2279,28 @@ func (s *ReadModelStore) ListNotes(ctx context.Context, opts repository.ListOpti
 	return results, total, nil
 }
 
+// ListNotesForEntity returns the notes attached to a person, family, or source.
+func (s *ReadModelStore) ListNotesForEntity(ctx context.Context, entityID uuid.UUID) ([]repository.NoteReadModel, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var results []repository.NoteReadModel
+	for _, n := range s.notes {
+		for _, l := range n.Links {
+			if l.EntityID == entityID {
+				results = append(results, *n)
+				break
+			}
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].UpdatedAt.Before(results[j].UpdatedAt)
+	})
+
+	return results, nil
+}
+
 // SaveNote saves or updates a note.
 func (s *ReadModelStore) SaveNote(ctx context.Context, note *repository.NoteReadModel) error {
 	s.mu.Lock()
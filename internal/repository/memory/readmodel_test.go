@@ -387,6 +387,46 @@ func TestReadModelStore_ListPersonsWithBirthDates(t *testing.T) {
 	}
 }
 
+func TestReadModelStore_ListPersonsMixedPrecisionBirthDates(t *testing.T) {
+	store := memory.NewReadModelStore()
+	ctx := context.Background()
+
+	// All resolve to 1 January 1850; order must follow precision then qualifier
+	births := map[string]string{
+		"Dora":  "1 JAN 1850",
+		"Carl":  "JAN 1850",
+		"Anna":  "ABT 1850",
+		"Berta": "1850",
+	}
+	for given, raw := range births {
+		gd := domain.ParseGenDate(raw)
+		sortDate := gd.SortDate()
+		err := store.SavePerson(ctx, &repository.PersonReadModel{
+			ID:            uuid.New(),
+			GivenName:     given,
+			Surname:       "Weber",
+			BirthDateRaw:  raw,
+			BirthDateSort: &sortDate,
+		})
+		if err != nil {
+			t.Fatalf("SavePerson() failed: %v", err)
+		}
+	}
+
+	want := []string{"Berta", "Anna", "Carl", "Dora"}
+	for i := 0; i < 5; i++ {
+		results, _, err := store.ListPersons(ctx, repository.ListOptions{Limit: 10, Sort: "birth_date", Order: "asc"})
+		if err != nil {
+			t.Fatalf("ListPersons() failed: %v", err)
+		}
+		for j, p := range results {
+			if p.GivenName != want[j] {
+				t.Fatalf("result %d = %s, want %s", j, p.GivenName, want[j])
+			}
+		}
+	}
+}
+
 func TestReadModelStore_SearchPersons(t *testing.T) {
 	store := memory.NewReadModelStore()
 	ctx := context.Background()
@@ -2748,6 +2788,36 @@ func TestReadModelStore_GetSurnameIndex(t *testing.T) {
 	}
 }
 
+func TestReadModelStore_GetSurnameIndex_SkipsPatronymics(t *testing.T) {
+	store := memory.NewReadModelStore()
+	ctx := context.Background()
+
+	person := &repository.PersonReadModel{
+		ID:        uuid.New(),
+		GivenName: "Sigríður",
+		Surname:   "Jónsdóttir",
+		Version:   1,
+		UpdatedAt: time.Now(),
+	}
+	_ = store.SavePerson(ctx, person)
+	_ = store.SavePersonName(ctx, &repository.PersonNameReadModel{
+		ID:        uuid.New(),
+		PersonID:  person.ID,
+		GivenName: "Sigríður",
+		Surname:   "Jónsdóttir",
+		NameType:  domain.NameTypePatronymic,
+		IsPrimary: true,
+	})
+
+	entries, letterCounts, err := store.GetSurnameIndex(ctx)
+	if err != nil {
+		t.Fatalf("GetSurnameIndex() failed: %v", err)
+	}
+	if len(entries) != 0 || len(letterCounts) != 0 {
+		t.Errorf("GetSurnameIndex() = %v, %v, want no entries", entries, letterCounts)
+	}
+}
+
 func TestReadModelStore_GetSurnamesByLetter(t *testing.T) {
 	store := memory.NewReadModelStore()
 	ctx := context.Background()
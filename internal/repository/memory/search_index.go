@@ -0,0 +1,206 @@
+package memory
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// trigramSize is the length of the substrings indexed for name search.
+const trigramSize = 3
+
+// personSearchIndex is an inverted index from name tokens to person IDs.
+// It indexes lowercased trigrams of every searchable name field, so substring
+// queries of three or more characters only need to check persons sharing all
+// of the query's trigrams. It also indexes the Soundex code of each name field
+// and the Metaphone key of each surname, so phonetic queries only need to
+// check persons in the matching buckets. Matches are still confirmed against
+// the read model, so search results are unchanged.
+type personSearchIndex struct {
+	trigrams  map[string]map[uuid.UUID]struct{}
+	soundex   map[string]map[uuid.UUID]struct{}
+	metaphone map[string]map[uuid.UUID]struct{}
+	// keys records the tokens indexed for each person so they can be removed.
+	keys map[uuid.UUID]indexedKeys
+}
+
+type indexedKeys struct {
+	trigrams  []string
+	soundex   []string
+	metaphone []string
+}
+
+func newPersonSearchIndex() *personSearchIndex {
+	return &personSearchIndex{
+		trigrams:  make(map[string]map[uuid.UUID]struct{}),
+		soundex:   make(map[string]map[uuid.UUID]struct{}),
+		metaphone: make(map[string]map[uuid.UUID]struct{}),
+		keys:      make(map[uuid.UUID]indexedKeys),
+	}
+}
+
+// update re-indexes a person from its primary name and alternate names.
+// A nil person and no names removes the person from the index.
+func (idx *personSearchIndex) update(id uuid.UUID, person *repository.PersonReadModel, names []repository.PersonNameReadModel) {
+	idx.remove(id)
+
+	var fields, soundexFields, surnames []string
+	if person != nil {
+		fields = append(fields, person.FullName, person.GivenName, person.Surname)
+		soundexFields = append(soundexFields, person.GivenName, person.Surname)
+		surnames = append(surnames, person.Surname)
+	}
+	for _, n := range names {
+		fields = append(fields, n.FullName, n.GivenName, n.Surname, n.Nickname, n.Romanized)
+		soundexFields = append(soundexFields, n.GivenName, n.Surname, n.Nickname)
+		soundexFields = append(soundexFields, strings.Fields(n.Romanized)...)
+		surnames = append(surnames, n.Surname)
+	}
+
+	var keys indexedKeys
+	seen := make(map[string]bool)
+	for _, field := range fields {
+		for _, tri := range trigrams(strings.ToLower(field)) {
+			if seen[tri] {
+				continue
+			}
+			seen[tri] = true
+			addPosting(idx.trigrams, tri, id)
+			keys.trigrams = append(keys.trigrams, tri)
+		}
+	}
+	seen = make(map[string]bool)
+	for _, field := range soundexFields {
+		code := repository.Soundex(field)
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+		addPosting(idx.soundex, code, id)
+		keys.soundex = append(keys.soundex, code)
+	}
+	seen = make(map[string]bool)
+	for _, surname := range surnames {
+		key := repository.Metaphone(surname)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		addPosting(idx.metaphone, key, id)
+		keys.metaphone = append(keys.metaphone, key)
+	}
+
+	if len(keys.trigrams) > 0 || len(keys.soundex) > 0 || len(keys.metaphone) > 0 {
+		idx.keys[id] = keys
+	}
+}
+
+// remove drops all index entries for a person.
+func (idx *personSearchIndex) remove(id uuid.UUID) {
+	keys, ok := idx.keys[id]
+	if !ok {
+		return
+	}
+	for _, tri := range keys.trigrams {
+		removePosting(idx.trigrams, tri, id)
+	}
+	for _, code := range keys.soundex {
+		removePosting(idx.soundex, code, id)
+	}
+	for _, key := range keys.metaphone {
+		removePosting(idx.metaphone, key, id)
+	}
+	delete(idx.keys, id)
+}
+
+// candidates returns the IDs of persons that may match queryLower. The second
+// return value is false when the index cannot narrow the query (it is shorter
+// than a trigram) and every person must be checked. Wildcard queries are
+// narrowed by the trigrams of their literal parts.
+func (idx *personSearchIndex) candidates(queryLower string, opts repository.SearchOptions) (map[uuid.UUID]struct{}, bool) {
+	queryTrigrams := trigrams(queryLower)
+	if opts.Wildcard {
+		queryTrigrams = nil
+		for _, literal := range repository.WildcardLiterals(queryLower) {
+			queryTrigrams = append(queryTrigrams, trigrams(literal)...)
+		}
+	}
+	if len(queryTrigrams) == 0 {
+		return nil, false
+	}
+
+	result := make(map[uuid.UUID]struct{})
+
+	// A field containing the query contains every trigram of the query, so
+	// only persons present in all of the query's posting lists can match.
+	smallest := idx.trigrams[queryTrigrams[0]]
+	for _, tri := range queryTrigrams[1:] {
+		if len(idx.trigrams[tri]) < len(smallest) {
+			smallest = idx.trigrams[tri]
+		}
+	}
+	for id := range smallest {
+		inAll := true
+		for _, tri := range queryTrigrams {
+			if _, ok := idx.trigrams[tri][id]; !ok {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			result[id] = struct{}{}
+		}
+	}
+
+	for _, word := range strings.Fields(queryLower) {
+		if opts.Soundex {
+			for id := range idx.soundex[repository.Soundex(word)] {
+				result[id] = struct{}{}
+			}
+		}
+		if opts.Metaphone {
+			for id := range idx.metaphone[repository.Metaphone(word)] {
+				result[id] = struct{}{}
+			}
+		}
+	}
+
+	return result, true
+}
+
+// trigrams returns the distinct rune trigrams of s.
+func trigrams(s string) []string {
+	runes := []rune(s)
+	if len(runes) < trigramSize {
+		return nil
+	}
+	seen := make(map[string]bool, len(runes))
+	result := make([]string, 0, len(runes)-trigramSize+1)
+	for i := 0; i+trigramSize <= len(runes); i++ {
+		tri := string(runes[i : i+trigramSize])
+		if !seen[tri] {
+			seen[tri] = true
+			result = append(result, tri)
+		}
+	}
+	return result
+}
+
+func addPosting(postings map[string]map[uuid.UUID]struct{}, key string, id uuid.UUID) {
+	ids, ok := postings[key]
+	if !ok {
+		ids = make(map[uuid.UUID]struct{})
+		postings[key] = ids
+	}
+	ids[id] = struct{}{}
+}
+
+func removePosting(postings map[string]map[uuid.UUID]struct{}, key string, id uuid.UUID) {
+	ids := postings[key]
+	delete(ids, id)
+	if len(ids) == 0 {
+		delete(postings, key)
+	}
+}
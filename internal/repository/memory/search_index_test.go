@@ -0,0 +1,222 @@
+package memory_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/memory"
+)
+
+func searchIDs(t *testing.T, store *memory.ReadModelStore, opts repository.SearchOptions) map[uuid.UUID]bool {
+	t.Helper()
+	if opts.Limit == 0 {
+		opts.Limit = 100
+	}
+	results, err := store.SearchPersons(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("SearchPersons() failed: %v", err)
+	}
+	ids := make(map[uuid.UUID]bool, len(results))
+	for _, r := range results {
+		ids[r.ID] = true
+	}
+	return ids
+}
+
+func TestReadModelStore_SearchIndex_TracksPersonChanges(t *testing.T) {
+	store := memory.NewReadModelStore()
+	ctx := context.Background()
+
+	person := &repository.PersonReadModel{
+		ID:        uuid.New(),
+		GivenName: "Johann",
+		Surname:   "Schmidt",
+		FullName:  "Johann Schmidt",
+		UpdatedAt: time.Now(),
+	}
+	if err := store.SavePerson(ctx, person); err != nil {
+		t.Fatalf("SavePerson() failed: %v", err)
+	}
+	if !searchIDs(t, store, repository.SearchOptions{Query: "schmi"})[person.ID] {
+		t.Fatal("expected person to match surname substring")
+	}
+
+	// Renaming must drop the old tokens and index the new ones
+	renamed := *person
+	renamed.Surname = "Smith"
+	renamed.FullName = "Johann Smith"
+	if err := store.SavePerson(ctx, &renamed); err != nil {
+		t.Fatalf("SavePerson() failed: %v", err)
+	}
+	if searchIDs(t, store, repository.SearchOptions{Query: "schmi"})[person.ID] {
+		t.Error("expected old surname to no longer match after rename")
+	}
+	if !searchIDs(t, store, repository.SearchOptions{Query: "smith"})[person.ID] {
+		t.Error("expected new surname to match after rename")
+	}
+
+	if err := store.DeletePerson(ctx, person.ID); err != nil {
+		t.Fatalf("DeletePerson() failed: %v", err)
+	}
+	if len(searchIDs(t, store, repository.SearchOptions{Query: "smith"})) != 0 {
+		t.Error("expected no results after delete")
+	}
+}
+
+func TestReadModelStore_SearchIndex_TracksAlternateNames(t *testing.T) {
+	store := memory.NewReadModelStore()
+	ctx := context.Background()
+
+	person := &repository.PersonReadModel{
+		ID:        uuid.New(),
+		GivenName: "Mary",
+		Surname:   "Jones",
+		FullName:  "Mary Jones",
+		UpdatedAt: time.Now(),
+	}
+	if err := store.SavePerson(ctx, person); err != nil {
+		t.Fatalf("SavePerson() failed: %v", err)
+	}
+
+	name := &repository.PersonNameReadModel{
+		ID:        uuid.New(),
+		PersonID:  person.ID,
+		GivenName: "Mary",
+		Surname:   "Kowalski",
+		NameType:  "birth",
+	}
+	if err := store.SavePersonName(ctx, name); err != nil {
+		t.Fatalf("SavePersonName() failed: %v", err)
+	}
+	if !searchIDs(t, store, repository.SearchOptions{Query: "kowal"})[person.ID] {
+		t.Fatal("expected alternate name to match")
+	}
+
+	if err := store.DeletePersonName(ctx, name.ID); err != nil {
+		t.Fatalf("DeletePersonName() failed: %v", err)
+	}
+	if searchIDs(t, store, repository.SearchOptions{Query: "kowal"})[person.ID] {
+		t.Error("expected deleted alternate name to no longer match")
+	}
+	if !searchIDs(t, store, repository.SearchOptions{Query: "jones"})[person.ID] {
+		t.Error("expected primary name to still match")
+	}
+}
+
+func TestReadModelStore_SearchIndex_Soundex(t *testing.T) {
+	store := memory.NewReadModelStore()
+	ctx := context.Background()
+
+	person := &repository.PersonReadModel{
+		ID:        uuid.New(),
+		GivenName: "Robert",
+		Surname:   "Smyth",
+		FullName:  "Robert Smyth",
+		UpdatedAt: time.Now(),
+	}
+	if err := store.SavePerson(ctx, person); err != nil {
+		t.Fatalf("SavePerson() failed: %v", err)
+	}
+
+	if searchIDs(t, store, repository.SearchOptions{Query: "smith"})[person.ID] {
+		t.Error("expected no exact match for a different spelling")
+	}
+	if !searchIDs(t, store, repository.SearchOptions{Query: "smith", Soundex: true})[person.ID] {
+		t.Error("expected Soundex match for a different spelling")
+	}
+}
+
+func TestReadModelStore_SearchIndex_MatchesFullScan(t *testing.T) {
+	store := memory.NewReadModelStore()
+	ctx := context.Background()
+
+	surnames := []string{"Smith", "Smyth", "Schmidt", "Johnson", "Jonsson", "Miller", "Müller"}
+	givenNames := []string{"Anna", "Hans", "Johann", "John", "Maria"}
+	var persons []*repository.PersonReadModel
+	for i := 0; i < 200; i++ {
+		given := givenNames[i%len(givenNames)]
+		surname := surnames[i%len(surnames)]
+		p := &repository.PersonReadModel{
+			ID:        uuid.New(),
+			GivenName: given,
+			Surname:   surname,
+			FullName:  fmt.Sprintf("%s %s", given, surname),
+			UpdatedAt: time.Now(),
+		}
+		if err := store.SavePerson(ctx, p); err != nil {
+			t.Fatalf("SavePerson() failed: %v", err)
+		}
+		persons = append(persons, p)
+	}
+
+	queries := []string{
+		"smith",
+		"hans",
+		"n s",    // spans given name and surname in FullName
+		"müller", // multi-byte runes
+		"jo",     // shorter than a trigram
+		"zzz",
+	}
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			want := 0
+			for _, p := range persons {
+				if strings.Contains(strings.ToLower(p.FullName), query) {
+					want++
+				}
+			}
+			got := len(searchIDs(t, store, repository.SearchOptions{Query: query, Limit: 1000}))
+			if got != want {
+				t.Errorf("SearchPersons(%q) returned %d results, want %d", query, got, want)
+			}
+		})
+	}
+}
+
+func TestReadModelStore_SearchIndex_Wildcard(t *testing.T) {
+	store := memory.NewReadModelStore()
+	ctx := context.Background()
+
+	var smith, smithson, goldsmith, johnson uuid.UUID
+	for _, p := range []struct {
+		id      *uuid.UUID
+		given   string
+		surname string
+	}{
+		{&smith, "John", "Smith"},
+		{&smithson, "Mary", "Smithson"},
+		{&goldsmith, "Anna", "Goldsmith"},
+		{&johnson, "Carl", "Johnson"},
+	} {
+		*p.id = uuid.New()
+		if err := store.SavePerson(ctx, &repository.PersonReadModel{
+			ID:        *p.id,
+			GivenName: p.given,
+			Surname:   p.surname,
+			FullName:  p.given + " " + p.surname,
+			UpdatedAt: time.Now(),
+		}); err != nil {
+			t.Fatalf("SavePerson() failed: %v", err)
+		}
+	}
+
+	prefix := searchIDs(t, store, repository.SearchOptions{Query: "smi*", Wildcard: true})
+	if len(prefix) != 2 || !prefix[smith] || !prefix[smithson] {
+		t.Errorf("smi* matched %d persons, want Smith and Smithson", len(prefix))
+	}
+
+	suffix := searchIDs(t, store, repository.SearchOptions{Query: "*son", Wildcard: true})
+	if len(suffix) != 2 || !suffix[smithson] || !suffix[johnson] {
+		t.Errorf("*son matched %d persons, want Smithson and Johnson", len(suffix))
+	}
+
+	if contains := searchIDs(t, store, repository.SearchOptions{Query: "smi"}); !contains[goldsmith] {
+		t.Error("expected a plain query to keep matching inside names")
+	}
+}
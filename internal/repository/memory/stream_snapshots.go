@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// StreamSnapshotStore is an in-memory implementation of repository.StreamSnapshotStore.
+type StreamSnapshotStore struct {
+	mu        sync.RWMutex
+	snapshots map[uuid.UUID]map[int64]*repository.StreamSnapshot
+}
+
+// NewStreamSnapshotStore creates a new in-memory stream snapshot store.
+func NewStreamSnapshotStore() *StreamSnapshotStore {
+	return &StreamSnapshotStore{
+		snapshots: make(map[uuid.UUID]map[int64]*repository.StreamSnapshot),
+	}
+}
+
+// SaveStreamSnapshot stores a snapshot, replacing any existing snapshot at the same version.
+func (s *StreamSnapshotStore) SaveStreamSnapshot(_ context.Context, snapshot *repository.StreamSnapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copied, err := copyStreamSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+
+	byVersion, exists := s.snapshots[snapshot.StreamID]
+	if !exists {
+		byVersion = make(map[int64]*repository.StreamSnapshot)
+		s.snapshots[snapshot.StreamID] = byVersion
+	}
+	byVersion[snapshot.Version] = copied
+	return nil
+}
+
+// GetLatestStreamSnapshot returns the most recent snapshot at or below maxVersion.
+func (s *StreamSnapshotStore) GetLatestStreamSnapshot(_ context.Context, streamID uuid.UUID, maxVersion int64) (*repository.StreamSnapshot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var latest *repository.StreamSnapshot
+	for version, snapshot := range s.snapshots[streamID] {
+		if version <= maxVersion && (latest == nil || version > latest.Version) {
+			latest = snapshot
+		}
+	}
+	if latest == nil {
+		return nil, repository.ErrStreamSnapshotNotFound
+	}
+	return copyStreamSnapshot(latest)
+}
+
+// Reset clears all data (useful for tests).
+func (s *StreamSnapshotStore) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots = make(map[uuid.UUID]map[int64]*repository.StreamSnapshot)
+}
+
+// copyStreamSnapshot returns a deep copy of a snapshot. The state is round-tripped
+// through JSON so values come back in the same shape as the SQL implementations.
+func copyStreamSnapshot(snapshot *repository.StreamSnapshot) (*repository.StreamSnapshot, error) {
+	data, err := json.Marshal(snapshot.State)
+	if err != nil {
+		return nil, fmt.Errorf("marshal snapshot state: %w", err)
+	}
+	copied := *snapshot
+	copied.State = nil
+	if err := json.Unmarshal(data, &copied.State); err != nil {
+		return nil, fmt.Errorf("unmarshal snapshot state: %w", err)
+	}
+	return &copied, nil
+}
@@ -0,0 +1,92 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/memory"
+)
+
+func TestStreamSnapshotStore_GetLatest(t *testing.T) {
+	store := memory.NewStreamSnapshotStore()
+	ctx := context.Background()
+	streamID := uuid.New()
+
+	for _, version := range []int64{5, 10} {
+		err := store.SaveStreamSnapshot(ctx, &repository.StreamSnapshot{
+			StreamID:   streamID,
+			StreamType: "Person",
+			Version:    version,
+			State:      map[string]any{"version": version},
+			CreatedAt:  time.Now().UTC(),
+		})
+		if err != nil {
+			t.Fatalf("SaveStreamSnapshot() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		maxVersion int64
+		want       int64
+	}{
+		{maxVersion: 5, want: 5},
+		{maxVersion: 9, want: 5},
+		{maxVersion: 10, want: 10},
+		{maxVersion: 100, want: 10},
+	}
+	for _, tt := range tests {
+		snapshot, err := store.GetLatestStreamSnapshot(ctx, streamID, tt.maxVersion)
+		if err != nil {
+			t.Fatalf("GetLatestStreamSnapshot(%d) error = %v", tt.maxVersion, err)
+		}
+		if snapshot.Version != tt.want {
+			t.Errorf("GetLatestStreamSnapshot(%d) version = %d, want %d", tt.maxVersion, snapshot.Version, tt.want)
+		}
+	}
+
+	if _, err := store.GetLatestStreamSnapshot(ctx, streamID, 4); !errors.Is(err, repository.ErrStreamSnapshotNotFound) {
+		t.Errorf("expected ErrStreamSnapshotNotFound below first snapshot, got %v", err)
+	}
+	if _, err := store.GetLatestStreamSnapshot(ctx, uuid.New(), 100); !errors.Is(err, repository.ErrStreamSnapshotNotFound) {
+		t.Errorf("expected ErrStreamSnapshotNotFound for unknown stream, got %v", err)
+	}
+}
+
+func TestStreamSnapshotStore_CopiesState(t *testing.T) {
+	store := memory.NewStreamSnapshotStore()
+	ctx := context.Background()
+	streamID := uuid.New()
+
+	state := map[string]any{"given_name": "John"}
+	err := store.SaveStreamSnapshot(ctx, &repository.StreamSnapshot{StreamID: streamID, Version: 1, State: state})
+	if err != nil {
+		t.Fatalf("SaveStreamSnapshot() error = %v", err)
+	}
+	state["given_name"] = "Jane"
+
+	snapshot, err := store.GetLatestStreamSnapshot(ctx, streamID, 1)
+	if err != nil {
+		t.Fatalf("GetLatestStreamSnapshot() error = %v", err)
+	}
+	if snapshot.State["given_name"] != "John" {
+		t.Errorf("given_name = %v, want John", snapshot.State["given_name"])
+	}
+}
+
+func TestStreamSnapshotStore_Reset(t *testing.T) {
+	store := memory.NewStreamSnapshotStore()
+	ctx := context.Background()
+	streamID := uuid.New()
+
+	_ = store.SaveStreamSnapshot(ctx, &repository.StreamSnapshot{StreamID: streamID, Version: 1})
+	store.Reset()
+
+	if _, err := store.GetLatestStreamSnapshot(ctx, streamID, 1); !errors.Is(err, repository.ErrStreamSnapshotNotFound) {
+		t.Errorf("expected ErrStreamSnapshotNotFound after Reset, got %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package repository
+
+import "strings"
+
+// Metaphone returns the original (Lawrence Philips) Metaphone key for a string.
+// Non-ASCII-letter characters are ignored. Returns "" for empty or non-alpha input.
+// "0" in the key stands for the "th" sound.
+func Metaphone(s string) string {
+	var w []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 32
+		}
+		if c >= 'A' && c <= 'Z' {
+			w = append(w, c)
+		}
+	}
+	if len(w) == 0 {
+		return ""
+	}
+
+	// Initial letter exceptions
+	switch {
+	case len(w) > 1 && (string(w[:2]) == "AE" || string(w[:2]) == "GN" || string(w[:2]) == "KN" ||
+		string(w[:2]) == "PN" || string(w[:2]) == "WR"):
+		w = w[1:]
+	case w[0] == 'X':
+		w[0] = 'S'
+	case len(w) > 1 && string(w[:2]) == "WH":
+		w = append([]byte{'W'}, w[2:]...)
+	}
+
+	at := func(i int) byte {
+		if i < 0 || i >= len(w) {
+			return 0
+		}
+		return w[i]
+	}
+	isVowel := func(c byte) bool {
+		return c == 'A' || c == 'E' || c == 'I' || c == 'O' || c == 'U'
+	}
+	isFrontVowel := func(c byte) bool {
+		return c == 'E' || c == 'I' || c == 'Y'
+	}
+
+	result := make([]byte, 0, len(w))
+	for i := 0; i < len(w); i++ {
+		c := w[i]
+		// Skip doubled letters, except C
+		if c != 'C' && c == at(i-1) {
+			continue
+		}
+
+		switch c {
+		case 'A', 'E', 'I', 'O', 'U':
+			if i == 0 {
+				result = append(result, c)
+			}
+		case 'B':
+			// Silent in a trailing "MB"
+			if !(i == len(w)-1 && at(i-1) == 'M') {
+				result = append(result, 'B')
+			}
+		case 'C':
+			switch {
+			case at(i+1) == 'H' && at(i-1) == 'S':
+				result = append(result, 'K')
+			case at(i+1) == 'H', at(i+1) == 'I' && at(i+2) == 'A':
+				result = append(result, 'X')
+			case isFrontVowel(at(i + 1)):
+				if at(i-1) != 'S' {
+					result = append(result, 'S')
+				}
+			default:
+				result = append(result, 'K')
+			}
+		case 'D':
+			if at(i+1) == 'G' && isFrontVowel(at(i+2)) {
+				result = append(result, 'J')
+			} else {
+				result = append(result, 'T')
+			}
+		case 'G':
+			switch {
+			case at(i+1) == 'H' && i+2 < len(w) && !isVowel(at(i+2)):
+				// Silent in "GH" not at the end or before a vowel
+			case at(i-1) == 'D' && isFrontVowel(at(i+1)):
+				// Already encoded as J by "DGE", "DGI", "DGY"
+			case at(i+1) == 'N' && (i+2 == len(w) || (at(i+2) == 'E' && at(i+3) == 'D' && i+4 == len(w))):
+				// Silent in trailing "GN" and "GNED"
+			case isFrontVowel(at(i+1)) && at(i-1) != 'G':
+				result = append(result, 'J')
+			default:
+				result = append(result, 'K')
+			}
+		case 'H':
+			prev := at(i - 1)
+			if prev == 'C' || prev == 'S' || prev == 'P' || prev == 'T' || prev == 'G' {
+				continue
+			}
+			if isVowel(prev) && !isVowel(at(i+1)) {
+				continue
+			}
+			result = append(result, 'H')
+		case 'K':
+			if at(i-1) != 'C' {
+				result = append(result, 'K')
+			}
+		case 'P':
+			if at(i+1) == 'H' {
+				result = append(result, 'F')
+			} else {
+				result = append(result, 'P')
+			}
+		case 'Q':
+			result = append(result, 'K')
+		case 'S':
+			if at(i+1) == 'H' || (at(i+1) == 'I' && (at(i+2) == 'O' || at(i+2) == 'A')) {
+				result = append(result, 'X')
+			} else {
+				result = append(result, 'S')
+			}
+		case 'T':
+			switch {
+			case at(i+1) == 'I' && (at(i+2) == 'O' || at(i+2) == 'A'):
+				result = append(result, 'X')
+			case at(i+1) == 'H':
+				result = append(result, '0')
+			case at(i+1) == 'C' && at(i+2) == 'H':
+				// Silent in "TCH"
+			default:
+				result = append(result, 'T')
+			}
+		case 'V':
+			result = append(result, 'F')
+		case 'W', 'Y':
+			if isVowel(at(i + 1)) {
+				result = append(result, c)
+			}
+		case 'X':
+			result = append(result, 'K', 'S')
+		case 'Z':
+			result = append(result, 'S')
+		default: // F, J, L, M, N, R
+			result = append(result, c)
+		}
+	}
+
+	return string(result)
+}
+
+// MetaphoneMatch returns true if two strings have the same Metaphone key.
+func MetaphoneMatch(a, b string) bool {
+	ma, mb := Metaphone(a), Metaphone(b)
+	return ma != "" && mb != "" && ma == mb
+}
+
+// MetaphoneKeys returns the distinct, non-empty Metaphone keys of the words in s.
+func MetaphoneKeys(s string) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, word := range strings.Fields(s) {
+		key := Metaphone(word)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,65 @@
+package repository
+
+import "testing"
+
+func TestMetaphone(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Smith", "SM0"},
+		{"Smyth", "SM0"},
+		{"Reilly", "RL"},
+		{"Riley", "RL"},
+		{"Knight", "NT"},
+		{"Wright", "RT"},
+		{"Whitaker", "WTKR"},
+		{"Xavier", "SFR"},
+		{"Catherine", "K0RN"},
+		{"Katherine", "K0RN"},
+		{"Philips", "FLPS"},
+		{"Schmidt", "SKMTT"},
+		{"Church", "XRX"},
+		{"Lamb", "LM"},
+		{"Dodge", "TJ"},
+		{"Agnes", "AKNS"},
+		{"", ""},
+		{"123", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := Metaphone(tt.input)
+			if got != tt.want {
+				t.Errorf("Metaphone(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetaphoneMatch(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{"Smith/Smyth match", "Smith", "Smyth", true},
+		{"Reilly/Riley match", "Reilly", "Riley", true},
+		{"Catherine/Katherine match", "Catherine", "Katherine", true},
+		{"Robert/Rupert differ", "Robert", "Rupert", false},
+		{"empty a", "", "Smith", false},
+		{"empty b", "Smith", "", false},
+		{"both empty", "", "", false},
+		{"case insensitive", "SMITH", "smith", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MetaphoneMatch(tt.a, tt.b)
+			if got != tt.want {
+				t.Errorf("MetaphoneMatch(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
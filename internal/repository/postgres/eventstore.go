@@ -127,6 +127,11 @@ func (s *EventStore) Append(ctx context.Context, streamID uuid.UUID, streamType
 			event.OccurredAt(),
 		)
 		if err != nil {
+			// A concurrent writer (e.g. another server process) appended the
+			// same version first; surface it as a concurrency conflict.
+			if isUniqueViolation(err) {
+				return repository.ErrConcurrencyConflict
+			}
 			return fmt.Errorf("insert event: %w", err)
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"os/exec"
+	"sync"
 	"testing"
 	"time"
 
@@ -206,6 +207,71 @@ func TestEventStore_ConcurrencyConflict(t *testing.T) {
 	}
 }
 
+func TestEventStore_ConcurrencyConflict_AcrossStores(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db, cleanup := setupPostgres(t)
+	defer cleanup()
+
+	// Separate store instances do not share a mutex, mirroring multiple
+	// server processes writing to the same database.
+	const writers = 5
+	stores := make([]*pgstore.EventStore, writers)
+	for i := range stores {
+		store, err := pgstore.NewEventStore(db)
+		if err != nil {
+			t.Fatalf("create event store: %v", err)
+		}
+		stores[i] = store
+	}
+
+	ctx := context.Background()
+	streamID := uuid.New()
+
+	created := domain.PersonCreated{
+		BaseEvent: domain.BaseEvent{ID: uuid.New(), Timestamp: time.Now()},
+		PersonID:  streamID,
+		GivenName: "John",
+		Surname:   "Doe",
+	}
+	if err := stores[0].Append(ctx, streamID, "Person", []domain.Event{created}, -1); err != nil {
+		t.Fatalf("append first event: %v", err)
+	}
+
+	errs := make(chan error, writers)
+	var wg sync.WaitGroup
+	for _, store := range stores {
+		wg.Add(1)
+		go func(store *pgstore.EventStore) {
+			defer wg.Done()
+			updated := domain.PersonUpdated{
+				BaseEvent: domain.BaseEvent{ID: uuid.New(), Timestamp: time.Now()},
+				PersonID:  streamID,
+				Changes:   map[string]any{"given_name": "Jane"},
+			}
+			errs <- store.Append(ctx, streamID, "Person", []domain.Event{updated}, 1)
+		}(store)
+	}
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		switch err {
+		case nil:
+			succeeded++
+		case repository.ErrConcurrencyConflict:
+		default:
+			t.Errorf("expected nil or ErrConcurrencyConflict, got %v", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("expected exactly 1 successful append, got %d", succeeded)
+	}
+}
+
 func TestEventStore_ReadAll(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -45,9 +45,12 @@ func (s *ReadModelStore) createTables() error {
 			given_name VARCHAR(100) NOT NULL,
 			surname VARCHAR(100) NOT NULL,
 			full_name VARCHAR(200) GENERATED ALWAYS AS (given_name || ' ' || surname) STORED,
+			surname_metaphone VARCHAR(100),
+			surname_sort VARCHAR(100),
 			gender VARCHAR(10),
 			birth_date_raw VARCHAR(100),
 			birth_date_sort DATE,
+			birth_date_rank INTEGER,
 			birth_place VARCHAR(255),
 			death_date_raw VARCHAR(100),
 			death_date_sort DATE,
@@ -91,6 +94,7 @@ func (s *ReadModelStore) createTables() error {
 			marriage_date_raw VARCHAR(100),
 			marriage_date_sort DATE,
 			marriage_place VARCHAR(255),
+			research_status VARCHAR(20),
 			child_count INTEGER NOT NULL DEFAULT 0,
 			version BIGINT NOT NULL DEFAULT 1,
 			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
@@ -186,6 +190,8 @@ func (s *ReadModelStore) createTables() error {
 			file_size BIGINT NOT NULL,
 			file_data BYTEA NOT NULL,
 			thumbnail_data BYTEA,
+			content_hash VARCHAR(64),
+			storage_key VARCHAR(255), -- Key in the external blob store; file_data is empty when set
 			crop_left INTEGER,
 			crop_top INTEGER,
 			crop_width INTEGER,
@@ -197,12 +203,23 @@ func (s *ReadModelStore) createTables() error {
 			-- GEDCOM 7.0 enhanced fields
 			files JSONB,          -- Multiple file references (GEDCOM 7.0)
 			format VARCHAR(100),  -- Primary format/MIME type (FORM)
-			translations JSONB    -- Translated titles (GEDCOM 7.0)
+			translations JSONB,   -- Translated titles (GEDCOM 7.0)
+			-- EXIF metadata extracted from uploaded photos
+			captured_at TIMESTAMPTZ,
+			gps_latitude DOUBLE PRECISION,
+			gps_longitude DOUBLE PRECISION
 		);
 
 		CREATE INDEX IF NOT EXISTS idx_media_entity ON media(entity_type, entity_id);
 		CREATE INDEX IF NOT EXISTS idx_media_type ON media(media_type);
 
+		-- Media content, stored once per content hash and shared by every media
+		-- row with that hash
+		CREATE TABLE IF NOT EXISTS media_content (
+			content_hash VARCHAR(64) PRIMARY KEY,
+			file_data BYTEA NOT NULL
+		);
+
 		-- Person names table (for multiple name variants)
 		CREATE TABLE IF NOT EXISTS person_names (
 			id UUID PRIMARY KEY,
@@ -210,10 +227,12 @@ func (s *ReadModelStore) createTables() error {
 			given_name VARCHAR(100) NOT NULL,
 			surname VARCHAR(100) NOT NULL,
 			full_name VARCHAR(200) GENERATED ALWAYS AS (given_name || ' ' || surname) STORED,
+			surname_metaphone VARCHAR(100),
 			name_prefix VARCHAR(50),
 			name_suffix VARCHAR(50),
 			surname_prefix VARCHAR(50),
 			nickname VARCHAR(100),
+			romanized VARCHAR(200),
 			name_type VARCHAR(20) NOT NULL DEFAULT '',
 			is_primary BOOLEAN NOT NULL DEFAULT FALSE,
 			search_vector TSVECTOR,
@@ -232,7 +251,8 @@ func (s *ReadModelStore) createTables() error {
 			NEW.search_vector := to_tsvector('english',
 				coalesce(NEW.given_name,'') || ' ' ||
 				coalesce(NEW.surname,'') || ' ' ||
-				coalesce(NEW.nickname,''));
+				coalesce(NEW.nickname,'') || ' ' ||
+				coalesce(NEW.romanized,''));
 			RETURN NEW;
 		END
 		$$ LANGUAGE plpgsql;
@@ -260,6 +280,7 @@ func (s *ReadModelStore) createTables() error {
 			language VARCHAR(35),
 			translations JSONB,
 			gedcom_xref VARCHAR(50),
+			links JSONB,
 			version BIGINT NOT NULL DEFAULT 1,
 			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 		);
@@ -349,7 +370,7 @@ func (s *ReadModelStore) createTables() error {
 		CREATE INDEX IF NOT EXISTS idx_associations_role ON associations(role);
 
 		-- Events table (life events for persons and families)
-		CREATE TABLE IF NOT EXISTS events (
+		CREATE TABLE IF NOT EXISTS life_events (
 			id UUID PRIMARY KEY,
 			owner_type VARCHAR(10) NOT NULL,
 			owner_id UUID NOT NULL,
@@ -369,8 +390,8 @@ func (s *ReadModelStore) createTables() error {
 			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 		);
 
-		CREATE INDEX IF NOT EXISTS idx_events_owner ON events(owner_type, owner_id);
-		CREATE INDEX IF NOT EXISTS idx_events_fact_type ON events(fact_type);
+		CREATE INDEX IF NOT EXISTS idx_life_events_owner ON life_events(owner_type, owner_id);
+		CREATE INDEX IF NOT EXISTS idx_life_events_fact_type ON life_events(fact_type);
 
 		-- Attributes table (person attributes)
 		CREATE TABLE IF NOT EXISTS attributes (
@@ -381,6 +402,7 @@ func (s *ReadModelStore) createTables() error {
 			date_raw VARCHAR(100),
 			date_sort DATE,
 			place VARCHAR(255),
+			research_status VARCHAR(20),
 			version BIGINT NOT NULL DEFAULT 1,
 			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 		);
@@ -515,6 +537,7 @@ func (s *ReadModelStore) runMigrations() {
 	_, _ = s.db.Exec(`ALTER TABLE notes ADD COLUMN IF NOT EXISTS mime VARCHAR(100)`)
 	_, _ = s.db.Exec(`ALTER TABLE notes ADD COLUMN IF NOT EXISTS language VARCHAR(35)`)
 	_, _ = s.db.Exec(`ALTER TABLE notes ADD COLUMN IF NOT EXISTS translations JSONB`)
+	_, _ = s.db.Exec(`ALTER TABLE notes ADD COLUMN IF NOT EXISTS links JSONB`)
 
 	// Split family partner names and family-child names into given_name / surname (issue #483).
 	// Wrapped in a single transaction so a mid-migration crash leaves either the pre-migration
@@ -557,6 +580,199 @@ func (s *ReadModelStore) runMigrations() {
 
 	// Add repository_id to sources for ID-based source→repository linkage (issue #525).
 	_, _ = s.db.Exec(`ALTER TABLE sources ADD COLUMN IF NOT EXISTS repository_id UUID`)
+
+	// Add Metaphone keys for phonetic surname search. Keys are computed in Go
+	// (fuzzystrmatch's metaphone() differs in detail), so existing rows are
+	// backfilled here rather than in SQL.
+	_, _ = s.db.Exec(`ALTER TABLE persons ADD COLUMN IF NOT EXISTS surname_metaphone VARCHAR(100)`)
+	_, _ = s.db.Exec(`ALTER TABLE person_names ADD COLUMN IF NOT EXISTS surname_metaphone VARCHAR(100)`)
+	_, _ = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_persons_surname_metaphone ON persons(surname_metaphone)`)
+	_, _ = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_person_names_surname_metaphone ON person_names(surname_metaphone)`)
+	s.backfillSurnameColumn("persons", "surname_metaphone", repository.Metaphone)
+	s.backfillSurnameColumn("person_names", "surname_metaphone", repository.Metaphone)
+
+	// Add the particle-free surname used for prefix-aware sorting ("van Gogh"
+	// under G). Computed in Go like the Metaphone keys.
+	_, _ = s.db.Exec(`ALTER TABLE persons ADD COLUMN IF NOT EXISTS surname_sort VARCHAR(100)`)
+	_, _ = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_persons_surname_sort ON persons(surname_sort, given_name)`)
+	s.backfillSurnameColumn("persons", "surname_sort", domain.SurnameSortKey)
+
+	// Add a tie-break rank so birth dates of mixed precision sort deterministically.
+	// Ranks are computed in Go, so existing rows are backfilled here.
+	_, _ = s.db.Exec(`ALTER TABLE persons ADD COLUMN IF NOT EXISTS birth_date_rank INTEGER`)
+	s.backfillBirthDateRank()
+
+	// Add EXIF capture date and GPS position to media.
+	_, _ = s.db.Exec(`ALTER TABLE media ADD COLUMN IF NOT EXISTS captured_at TIMESTAMPTZ`)
+	_, _ = s.db.Exec(`ALTER TABLE media ADD COLUMN IF NOT EXISTS gps_latitude DOUBLE PRECISION`)
+	_, _ = s.db.Exec(`ALTER TABLE media ADD COLUMN IF NOT EXISTS gps_longitude DOUBLE PRECISION`)
+
+	// Add content hashes for media deduplication. Hashes are computed in Go, so
+	// existing rows are backfilled here.
+	_, _ = s.db.Exec(`ALTER TABLE media ADD COLUMN IF NOT EXISTS content_hash VARCHAR(64)`)
+	_, _ = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_media_content_hash ON media(content_hash)`)
+
+	// Add storage keys for media content held in an external blob store.
+	_, _ = s.db.Exec(`ALTER TABLE media ADD COLUMN IF NOT EXISTS storage_key VARCHAR(255)`)
+	s.backfillMediaContentHash()
+	s.moveMediaContent()
+
+	// Add creation timestamps, taken from each entity's first event. Rows
+	// projected before this column existed stay NULL until a rebuild.
+	_, _ = s.db.Exec(`ALTER TABLE persons ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ`)
+	_, _ = s.db.Exec(`ALTER TABLE families ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ`)
+
+	// Add romanized name forms, searched alongside the native script. The
+	// search_vector trigger picks them up as names are saved.
+	_, _ = s.db.Exec(`ALTER TABLE person_names ADD COLUMN IF NOT EXISTS romanized VARCHAR(200)`)
+
+	// Add research_status to families and attributes; events already carry it.
+	_, _ = s.db.Exec(`ALTER TABLE families ADD COLUMN IF NOT EXISTS research_status VARCHAR(20)`)
+	_, _ = s.db.Exec(`ALTER TABLE attributes ADD COLUMN IF NOT EXISTS research_status VARCHAR(20)`)
+}
+
+// moveMediaContent moves file data held inline on hashed media rows into
+// media_content, so rows sharing a hash keep a single copy. Idempotent via the
+// length guard; errors are ignored like the other migrations.
+func (s *ReadModelStore) moveMediaContent() {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return
+	}
+	if _, err := tx.Exec(`
+		INSERT INTO media_content (content_hash, file_data)
+		SELECT DISTINCT ON (content_hash) content_hash, file_data FROM media
+		WHERE content_hash IS NOT NULL AND length(file_data) > 0
+		ON CONFLICT (content_hash) DO NOTHING
+	`); err != nil {
+		_ = tx.Rollback()
+		return
+	}
+	if _, err := tx.Exec(`
+		UPDATE media SET file_data = ''::bytea
+		WHERE content_hash IS NOT NULL AND length(file_data) > 0
+	`); err != nil {
+		_ = tx.Rollback()
+		return
+	}
+	_ = tx.Commit()
+}
+
+// backfillMediaContentHash computes content_hash for media saved before the
+// column existed. Idempotent via the IS NULL guard; errors are ignored like the
+// other migrations.
+func (s *ReadModelStore) backfillMediaContentHash() {
+	rows, err := s.db.Query(`SELECT id, file_data FROM media WHERE content_hash IS NULL AND length(file_data) > 0`)
+	if err != nil {
+		return
+	}
+	hashes := make(map[uuid.UUID]string)
+	for rows.Next() {
+		var id uuid.UUID
+		var data []byte
+		if err := rows.Scan(&id, &data); err != nil {
+			rows.Close()
+			return
+		}
+		hashes[id] = domain.MediaContentHash(data)
+	}
+	rows.Close()
+	if len(hashes) == 0 {
+		return
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return
+	}
+	for id, hash := range hashes {
+		if _, err := tx.Exec(`UPDATE media SET content_hash = $1 WHERE id = $2`, hash, id); err != nil {
+			_ = tx.Rollback()
+			return
+		}
+	}
+	_ = tx.Commit()
+}
+
+// backfillBirthDateRank computes birth_date_rank for rows saved before the
+// column existed. Idempotent via the IS NULL guard; errors are ignored like the
+// other migrations.
+func (s *ReadModelStore) backfillBirthDateRank() {
+	rows, err := s.db.Query(`SELECT id, COALESCE(birth_date_raw, '') FROM persons WHERE birth_date_rank IS NULL`)
+	if err != nil {
+		return
+	}
+	ranks := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var id uuid.UUID
+		var raw string
+		if err := rows.Scan(&id, &raw); err != nil {
+			rows.Close()
+			return
+		}
+		ranks[id] = birthDateRank(raw)
+	}
+	rows.Close()
+	if len(ranks) == 0 {
+		return
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return
+	}
+	for id, rank := range ranks {
+		if _, err := tx.Exec(`UPDATE persons SET birth_date_rank = $1 WHERE id = $2`, rank, id); err != nil {
+			_ = tx.Rollback()
+			return
+		}
+	}
+	_ = tx.Commit()
+}
+
+// birthDateRank returns the GenDate sort rank used to order birth dates that
+// resolve to the same birth_date_sort.
+func birthDateRank(raw string) int {
+	gd := domain.ParseGenDate(raw)
+	return gd.SortRank()
+}
+
+// backfillSurnameColumn computes a surname-derived column for rows saved before
+// the column existed. Idempotent via the IS NULL guard; errors are ignored like
+// the other migrations.
+func (s *ReadModelStore) backfillSurnameColumn(table, column string, key func(string) string) {
+	// nosemgrep: go.lang.security.audit.database.string-formatted-query.string-formatted-query -- table and column are fixed identifiers, not user input
+	rows, err := s.db.Query(`SELECT id, surname FROM ` + table + ` WHERE ` + column + ` IS NULL`)
+	if err != nil {
+		return
+	}
+	keys := make(map[uuid.UUID]string)
+	for rows.Next() {
+		var id uuid.UUID
+		var surname string
+		if err := rows.Scan(&id, &surname); err != nil {
+			rows.Close()
+			return
+		}
+		keys[id] = key(surname)
+	}
+	rows.Close()
+	if len(keys) == 0 {
+		return
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return
+	}
+	for id, value := range keys {
+		// nosemgrep: go.lang.security.audit.database.string-formatted-query.string-formatted-query -- table and column are fixed identifiers, not user input
+		if _, err := tx.Exec(`UPDATE `+table+` SET `+column+` = $1 WHERE id = $2`, value, id); err != nil {
+			_ = tx.Rollback()
+			return
+		}
+	}
+	_ = tx.Commit()
 }
 
 // GetPerson retrieves a person by ID.
@@ -566,7 +782,7 @@ func (s *ReadModelStore) GetPerson(ctx context.Context, id uuid.UUID) (*reposito
 			   birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
 			   death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
 			   notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-			   version, updated_at
+			   version, updated_at, created_at
 		FROM persons WHERE id = $1
 	`, id)
 
@@ -575,19 +791,37 @@ func (s *ReadModelStore) GetPerson(ctx context.Context, id uuid.UUID) (*reposito
 
 // ListPersons returns a paginated list of persons.
 func (s *ReadModelStore) ListPersons(ctx context.Context, opts repository.ListOptions) ([]repository.PersonReadModel, int, error) {
-	// Build WHERE clause for research_status filter
-	whereClause := ""
+	// Build WHERE clause for research_status, surname, gender, and unlinked filters
+	var conditions []string
 	var whereArgs []any
 	paramNum := 1
 	if opts.ResearchStatus != nil {
 		if *opts.ResearchStatus == "unset" {
-			whereClause = "WHERE research_status IS NULL OR research_status = ''"
+			conditions = append(conditions, "(research_status IS NULL OR research_status = '')")
 		} else {
-			whereClause = fmt.Sprintf("WHERE research_status = $%d", paramNum)
+			conditions = append(conditions, fmt.Sprintf("research_status = $%d", paramNum))
 			whereArgs = append(whereArgs, *opts.ResearchStatus)
 			paramNum++
 		}
 	}
+	if opts.Surname != "" {
+		conditions = append(conditions, fmt.Sprintf("LOWER(surname) = LOWER($%d)", paramNum))
+		whereArgs = append(whereArgs, opts.Surname)
+		paramNum++
+	}
+	if opts.Gender != "" {
+		conditions = append(conditions, fmt.Sprintf("gender = $%d", paramNum))
+		whereArgs = append(whereArgs, opts.Gender)
+		paramNum++
+	}
+	if opts.Unlinked {
+		conditions = append(conditions, `NOT EXISTS (SELECT 1 FROM families f WHERE f.partner1_id = persons.id OR f.partner2_id = persons.id)
+			AND NOT EXISTS (SELECT 1 FROM family_children fc WHERE fc.person_id = persons.id)`)
+	}
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
 
 	// Count total (with filter if present)
 	var total int
@@ -599,19 +833,23 @@ func (s *ReadModelStore) ListPersons(ctx context.Context, opts repository.ListOp
 	}
 
 	// Build order clause
+	orderDir := "ASC"
+	if opts.Order == "desc" {
+		orderDir = "DESC"
+	}
 	orderColumn := "surname"
+	if opts.IgnoreSurnamePrefix {
+		orderColumn = "COALESCE(surname_sort, surname)"
+	}
 	switch opts.Sort {
 	case "given_name":
 		orderColumn = "given_name"
 	case "birth_date":
-		orderColumn = "birth_date_sort"
+		// Break ties between dates resolving to the same day by precision/qualifier
+		orderColumn = "birth_date_sort " + orderDir + " NULLS LAST, birth_date_rank"
 	case "updated_at":
 		orderColumn = "updated_at"
 	}
-	orderDir := "ASC"
-	if opts.Order == "desc" {
-		orderDir = "DESC"
-	}
 
 	// Build query with filter
 	// #nosec G201 -- orderColumn and orderDir are validated via switch/if above, not user input
@@ -620,7 +858,7 @@ func (s *ReadModelStore) ListPersons(ctx context.Context, opts repository.ListOp
 			   birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
 			   death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
 			   notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-			   version, updated_at
+			   version, updated_at, created_at
 		FROM persons
 		%s
 		ORDER BY %s %s NULLS LAST, given_name %s
@@ -664,7 +902,7 @@ const personCols = `p.id, p.given_name, p.surname, p.full_name, p.gender,
 	p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
 	p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
 	p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
-	p.version, p.updated_at`
+	p.version, p.updated_at, p.created_at`
 
 // SearchPersons searches for persons by name, date, and place using tsvector,
 // trigram similarity, and Soundex matching. Also searches person_names for alternate names.
@@ -681,8 +919,9 @@ func (s *ReadModelStore) SearchPersons(ctx context.Context, opts repository.Sear
 	hasDateFilter := opts.BirthDateFrom != nil || opts.BirthDateTo != nil ||
 		opts.DeathDateFrom != nil || opts.DeathDateTo != nil
 	hasPlaceFilter := strings.TrimSpace(opts.BirthPlace) != "" || strings.TrimSpace(opts.DeathPlace) != ""
+	hasPersonFilter := strings.TrimSpace(opts.GivenName) != "" || strings.TrimSpace(opts.Surname) != "" || opts.Gender != ""
 
-	if !hasQuery && !hasDateFilter && !hasPlaceFilter {
+	if !hasQuery && !hasDateFilter && !hasPlaceFilter && !hasPersonFilter {
 		return nil, nil
 	}
 
@@ -719,10 +958,27 @@ func (s *ReadModelStore) SearchPersons(ctx context.Context, opts repository.Sear
 	return persons, rows.Err()
 }
 
-// writeNameMatchCTE writes the CTE for name matching (fuzzy, soundex, or full-text).
+// writeNameMatchCTE writes the CTE for name matching (wildcard, fuzzy, soundex, metaphone, or full-text).
 func writeNameMatchCTE(qb *strings.Builder, opts repository.SearchOptions, params *searchQueryParams) {
 	query := strings.TrimSpace(opts.Query)
+	if opts.Wildcard {
+		// Word-anchored LIKE pattern; backslash is the default escape character
+		w := params.add(repository.WildcardLikePattern(query))
+		fmt.Fprintf(qb, `WITH matched_persons AS (
+			SELECT %s, TRUE as is_primary, 1.0::float as rank_score
+			FROM persons p
+			WHERE ' ' || LOWER(p.full_name) || ' ' LIKE $%d OR ' ' || LOWER(p.given_name) || ' ' LIKE $%d OR ' ' || LOWER(p.surname) || ' ' LIKE $%d
+			UNION
+			SELECT %s, pn.is_primary, 1.0::float as rank_score
+			FROM persons p JOIN person_names pn ON p.id = pn.person_id
+			WHERE ' ' || LOWER(pn.full_name) || ' ' LIKE $%d OR ' ' || LOWER(pn.given_name) || ' ' LIKE $%d OR ' ' || LOWER(pn.surname) || ' ' LIKE $%d
+				OR ' ' || LOWER(COALESCE(pn.nickname, '')) || ' ' LIKE $%d OR ' ' || LOWER(COALESCE(pn.romanized, '')) || ' ' LIKE $%d
+		)`, personCols, w, w, w,
+			personCols, w, w, w, w, w)
+		return
+	}
 	n := params.add(query)
+	metaphoneKeys := repository.MetaphoneKeys(query)
 
 	switch {
 	case opts.Fuzzy:
@@ -733,11 +989,11 @@ func writeNameMatchCTE(qb *strings.Builder, opts repository.SearchOptions, param
 			WHERE p.given_name %% $%d OR p.surname %% $%d OR p.full_name %% $%d
 			UNION
 			SELECT %s, pn.is_primary,
-				GREATEST(similarity(pn.given_name, $%d), similarity(pn.surname, $%d), similarity(pn.full_name, $%d), similarity(COALESCE(pn.nickname, ''), $%d)) as rank_score
+				GREATEST(similarity(pn.given_name, $%d), similarity(pn.surname, $%d), similarity(pn.full_name, $%d), similarity(COALESCE(pn.nickname, ''), $%d), similarity(COALESCE(pn.romanized, ''), $%d)) as rank_score
 			FROM persons p JOIN person_names pn ON p.id = pn.person_id
-			WHERE pn.given_name %% $%d OR pn.surname %% $%d OR pn.full_name %% $%d OR pn.nickname %% $%d
+			WHERE pn.given_name %% $%d OR pn.surname %% $%d OR pn.full_name %% $%d OR pn.nickname %% $%d OR pn.romanized %% $%d
 		)`, personCols, n, n, n, n, n, n,
-			personCols, n, n, n, n, n, n, n, n)
+			personCols, n, n, n, n, n, n, n, n, n, n)
 
 	case opts.Soundex:
 		fmt.Fprintf(qb, `WITH matched_persons AS (
@@ -753,6 +1009,25 @@ func writeNameMatchCTE(qb *strings.Builder, opts repository.SearchOptions, param
 		)`, personCols, n, n, n, n,
 			personCols, n, n, n, n)
 
+	case opts.Metaphone && len(metaphoneKeys) > 0:
+		placeholders := make([]string, len(metaphoneKeys))
+		for i, key := range metaphoneKeys {
+			placeholders[i] = fmt.Sprintf("$%d", params.add(key))
+		}
+		keyList := strings.Join(placeholders, ", ")
+		fmt.Fprintf(qb, `WITH matched_persons AS (
+			SELECT %s, TRUE as is_primary,
+				CASE WHEN p.full_name ILIKE '%%' || $%d || '%%' THEN 1.0 ELSE 0.5 END as rank_score
+			FROM persons p
+			WHERE p.full_name ILIKE '%%' || $%d || '%%' OR p.surname_metaphone IN (%s)
+			UNION
+			SELECT %s, pn.is_primary,
+				CASE WHEN pn.full_name ILIKE '%%' || $%d || '%%' THEN 1.0 ELSE 0.5 END as rank_score
+			FROM persons p JOIN person_names pn ON p.id = pn.person_id
+			WHERE pn.full_name ILIKE '%%' || $%d || '%%' OR pn.nickname ILIKE '%%' || $%d || '%%' OR pn.romanized ILIKE '%%' || $%d || '%%' OR pn.surname_metaphone IN (%s)
+		)`, personCols, n, n, keyList,
+			personCols, n, n, n, n, keyList)
+
 	default:
 		fmt.Fprintf(qb, `WITH matched_persons AS (
 			SELECT %s, TRUE as is_primary,
@@ -763,9 +1038,9 @@ func writeNameMatchCTE(qb *strings.Builder, opts repository.SearchOptions, param
 			SELECT %s, pn.is_primary,
 				ts_rank(pn.search_vector, plainto_tsquery('english', $%d)) as rank_score
 			FROM persons p JOIN person_names pn ON p.id = pn.person_id
-			WHERE pn.search_vector @@ plainto_tsquery('english', $%d) OR pn.full_name ILIKE '%%' || $%d || '%%' OR pn.nickname ILIKE '%%' || $%d || '%%'
+			WHERE pn.search_vector @@ plainto_tsquery('english', $%d) OR pn.full_name ILIKE '%%' || $%d || '%%' OR pn.nickname ILIKE '%%' || $%d || '%%' OR pn.romanized ILIKE '%%' || $%d || '%%'
 		)`, personCols, n, n, n,
-			personCols, n, n, n, n)
+			personCols, n, n, n, n, n)
 	}
 }
 
@@ -776,7 +1051,7 @@ func writeDedupSelect(qb *strings.Builder) {
 			birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
 			death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
 			notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-			version, updated_at, rank_score
+			version, updated_at, created_at, rank_score
 		FROM matched_persons
 		ORDER BY id, is_primary DESC, rank_score DESC
 	)
@@ -784,11 +1059,11 @@ func writeDedupSelect(qb *strings.Builder) {
 		birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
 		death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
 		notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-		version, updated_at
+		version, updated_at, created_at
 	FROM deduped p`)
 }
 
-// writeDatePlaceFilters appends WHERE clauses for date and place filters.
+// writeDatePlaceFilters appends WHERE clauses for date, place, name, and gender filters.
 func writeDatePlaceFilters(qb *strings.Builder, opts repository.SearchOptions, params *searchQueryParams) {
 	var filters []string
 
@@ -810,6 +1085,15 @@ func writeDatePlaceFilters(qb *strings.Builder, opts repository.SearchOptions, p
 	if dp := strings.TrimSpace(opts.DeathPlace); dp != "" {
 		filters = append(filters, fmt.Sprintf("p.death_place ILIKE '%%' || $%d || '%%'", params.add(dp)))
 	}
+	if gn := strings.TrimSpace(opts.GivenName); gn != "" {
+		filters = append(filters, fmt.Sprintf("p.given_name ILIKE '%%' || $%d || '%%'", params.add(gn)))
+	}
+	if sn := strings.TrimSpace(opts.Surname); sn != "" {
+		filters = append(filters, fmt.Sprintf("p.surname ILIKE '%%' || $%d || '%%'", params.add(sn)))
+	}
+	if opts.Gender != "" {
+		filters = append(filters, fmt.Sprintf("p.gender = $%d", params.add(string(opts.Gender))))
+	}
 
 	if len(filters) > 0 {
 		qb.WriteString(" WHERE " + strings.Join(filters, " AND "))
@@ -857,18 +1141,21 @@ func writeOrderBy(qb *strings.Builder, opts repository.SearchOptions, hasQuery b
 // SavePerson saves or updates a person.
 func (s *ReadModelStore) SavePerson(ctx context.Context, person *repository.PersonReadModel) error {
 	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO persons (id, given_name, surname, gender, birth_date_raw, birth_date_sort, birth_place,
-							 birth_place_lat, birth_place_long, death_date_raw, death_date_sort, death_place,
+		INSERT INTO persons (id, given_name, surname, surname_metaphone, surname_sort, gender, birth_date_raw, birth_date_sort, birth_date_rank,
+							 birth_place, birth_place_lat, birth_place_long, death_date_raw, death_date_sort, death_place,
 							 death_place_lat, death_place_long, notes, research_status,
 							 brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-							 version, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
+							 version, updated_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25)
 		ON CONFLICT(id) DO UPDATE SET
 			given_name = EXCLUDED.given_name,
 			surname = EXCLUDED.surname,
+			surname_metaphone = EXCLUDED.surname_metaphone,
+			surname_sort = EXCLUDED.surname_sort,
 			gender = EXCLUDED.gender,
 			birth_date_raw = EXCLUDED.birth_date_raw,
 			birth_date_sort = EXCLUDED.birth_date_sort,
+			birth_date_rank = EXCLUDED.birth_date_rank,
 			birth_place = EXCLUDED.birth_place,
 			birth_place_lat = EXCLUDED.birth_place_lat,
 			birth_place_long = EXCLUDED.birth_place_long,
@@ -883,15 +1170,17 @@ func (s *ReadModelStore) SavePerson(ctx context.Context, person *repository.Pers
 			brick_wall_since = EXCLUDED.brick_wall_since,
 			brick_wall_resolved_at = EXCLUDED.brick_wall_resolved_at,
 			version = EXCLUDED.version,
-			updated_at = EXCLUDED.updated_at
-	`, person.ID, person.GivenName, person.Surname, nullableGender(person.Gender),
-		nullableString(person.BirthDateRaw), nullableTime(person.BirthDateSort), nullableString(person.BirthPlace),
+			updated_at = EXCLUDED.updated_at,
+			created_at = COALESCE(persons.created_at, EXCLUDED.created_at)
+	`, person.ID, person.GivenName, person.Surname, repository.Metaphone(person.Surname), domain.SurnameSortKey(person.Surname), nullableGender(person.Gender),
+		nullableString(person.BirthDateRaw), nullableTime(person.BirthDateSort), birthDateRank(person.BirthDateRaw),
+		nullableString(person.BirthPlace),
 		nullableStringPtr(person.BirthPlaceLat), nullableStringPtr(person.BirthPlaceLong),
 		nullableString(person.DeathDateRaw), nullableTime(person.DeathDateSort), nullableString(person.DeathPlace),
 		nullableStringPtr(person.DeathPlaceLat), nullableStringPtr(person.DeathPlaceLong),
 		nullableString(person.Notes), nullableString(string(person.ResearchStatus)),
 		nullableString(person.BrickWallNote), nullableTime(person.BrickWallSince), nullableTime(person.BrickWallResolvedAt),
-		person.Version, person.UpdatedAt)
+		person.Version, person.UpdatedAt, nullableZeroTime(person.CreatedAt))
 
 	return err
 }
@@ -905,23 +1194,25 @@ func (s *ReadModelStore) DeletePerson(ctx context.Context, id uuid.UUID) error {
 // SavePersonName saves or updates a person name variant.
 func (s *ReadModelStore) SavePersonName(ctx context.Context, name *repository.PersonNameReadModel) error {
 	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO person_names (id, person_id, given_name, surname, name_prefix, name_suffix,
-								  surname_prefix, nickname, name_type, is_primary, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO person_names (id, person_id, given_name, surname, surname_metaphone, name_prefix, name_suffix,
+								  surname_prefix, nickname, romanized, name_type, is_primary, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		ON CONFLICT(id) DO UPDATE SET
 			person_id = EXCLUDED.person_id,
 			given_name = EXCLUDED.given_name,
 			surname = EXCLUDED.surname,
+			surname_metaphone = EXCLUDED.surname_metaphone,
 			name_prefix = EXCLUDED.name_prefix,
 			name_suffix = EXCLUDED.name_suffix,
 			surname_prefix = EXCLUDED.surname_prefix,
 			nickname = EXCLUDED.nickname,
+			romanized = EXCLUDED.romanized,
 			name_type = EXCLUDED.name_type,
 			is_primary = EXCLUDED.is_primary,
 			updated_at = EXCLUDED.updated_at
-	`, name.ID, name.PersonID, name.GivenName, name.Surname,
+	`, name.ID, name.PersonID, name.GivenName, name.Surname, repository.Metaphone(name.Surname),
 		nullableString(name.NamePrefix), nullableString(name.NameSuffix),
-		nullableString(name.SurnamePrefix), nullableString(name.Nickname),
+		nullableString(name.SurnamePrefix), nullableString(name.Nickname), nullableString(name.Romanized),
 		nullableString(string(name.NameType)), name.IsPrimary, name.UpdatedAt)
 
 	return err
@@ -931,7 +1222,7 @@ func (s *ReadModelStore) SavePersonName(ctx context.Context, name *repository.Pe
 func (s *ReadModelStore) GetPersonName(ctx context.Context, nameID uuid.UUID) (*repository.PersonNameReadModel, error) {
 	row := s.db.QueryRowContext(ctx, `
 		SELECT id, person_id, given_name, surname, full_name, name_prefix, name_suffix,
-			   surname_prefix, nickname, name_type, is_primary, updated_at
+			   surname_prefix, nickname, romanized, name_type, is_primary, updated_at
 		FROM person_names WHERE id = $1
 	`, nameID)
 
@@ -942,7 +1233,7 @@ func (s *ReadModelStore) GetPersonName(ctx context.Context, nameID uuid.UUID) (*
 func (s *ReadModelStore) GetPersonNames(ctx context.Context, personID uuid.UUID) ([]repository.PersonNameReadModel, error) {
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT id, person_id, given_name, surname, full_name, name_prefix, name_suffix,
-			   surname_prefix, nickname, name_type, is_primary, updated_at
+			   surname_prefix, nickname, romanized, name_type, is_primary, updated_at
 		FROM person_names
 		WHERE person_id = $1
 		ORDER BY is_primary DESC, name_type
@@ -1208,14 +1499,14 @@ func scanPersonName(row rowScanner) (*repository.PersonNameReadModel, error) {
 		id, personID                                    uuid.UUID
 		givenName, surname, fullName                    string
 		namePrefix, nameSuffix, surnamePrefix, nickname sql.NullString
-		nameType                                        sql.NullString
+		romanized, nameType                             sql.NullString
 		isPrimary                                       bool
 		updatedAt                                       time.Time
 	)
 
 	err := row.Scan(&id, &personID, &givenName, &surname, &fullName,
 		&namePrefix, &nameSuffix, &surnamePrefix, &nickname,
-		&nameType, &isPrimary, &updatedAt)
+		&romanized, &nameType, &isPrimary, &updatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -1234,6 +1525,7 @@ func scanPersonName(row rowScanner) (*repository.PersonNameReadModel, error) {
 		NameSuffix:    nameSuffix.String,
 		SurnamePrefix: surnamePrefix.String,
 		Nickname:      nickname.String,
+		Romanized:     romanized.String,
 		NameType:      domain.NameType(nameType.String),
 		IsPrimary:     isPrimary,
 		UpdatedAt:     updatedAt,
@@ -1251,8 +1543,8 @@ func (s *ReadModelStore) GetFamily(ctx context.Context, id uuid.UUID) (*reposito
 		SELECT id, partner1_id, partner1_given_name, partner1_surname,
 			   partner2_id, partner2_given_name, partner2_surname,
 			   relationship_type, marriage_date_raw, marriage_date_sort, marriage_place,
-			   marriage_place_lat, marriage_place_long,
-			   child_count, version, updated_at
+			   marriage_place_lat, marriage_place_long, research_status,
+			   child_count, version, updated_at, created_at
 		FROM families WHERE id = $1
 	`, id)
 
@@ -1271,8 +1563,8 @@ func (s *ReadModelStore) ListFamilies(ctx context.Context, opts repository.ListO
 		SELECT id, partner1_id, partner1_given_name, partner1_surname,
 			   partner2_id, partner2_given_name, partner2_surname,
 			   relationship_type, marriage_date_raw, marriage_date_sort, marriage_place,
-			   marriage_place_lat, marriage_place_long,
-			   child_count, version, updated_at
+			   marriage_place_lat, marriage_place_long, research_status,
+			   child_count, version, updated_at, created_at
 		FROM families
 		ORDER BY updated_at DESC
 		LIMIT $1 OFFSET $2
@@ -1300,8 +1592,8 @@ func (s *ReadModelStore) GetFamiliesForPerson(ctx context.Context, personID uuid
 		SELECT id, partner1_id, partner1_given_name, partner1_surname,
 			   partner2_id, partner2_given_name, partner2_surname,
 			   relationship_type, marriage_date_raw, marriage_date_sort, marriage_place,
-			   marriage_place_lat, marriage_place_long,
-			   child_count, version, updated_at
+			   marriage_place_lat, marriage_place_long, research_status,
+			   child_count, version, updated_at, created_at
 		FROM families
 		WHERE partner1_id = $1 OR partner2_id = $1
 	`, personID)
@@ -1322,15 +1614,60 @@ func (s *ReadModelStore) GetFamiliesForPerson(ctx context.Context, personID uuid
 	return families, rows.Err()
 }
 
+// SearchFamilies searches for families by partner name. Every word of the
+// query must appear in either partner's name.
+func (s *ReadModelStore) SearchFamilies(ctx context.Context, query string, limit int) ([]repository.FamilyReadModel, error) {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	conditions := make([]string, len(words))
+	args := make([]any, 0, len(words)+1)
+	for i, word := range words {
+		conditions[i] = fmt.Sprintf("(COALESCE(partner1_given_name, '') || ' ' || COALESCE(partner1_surname, '') || ' ' || "+
+			"COALESCE(partner2_given_name, '') || ' ' || COALESCE(partner2_surname, '')) ILIKE '%%' || $%d || '%%'", i+1)
+		args = append(args, word)
+	}
+	args = append(args, limit)
+
+	// nosemgrep: go.lang.security.audit.database.string-formatted-query.string-formatted-query -- conditions use parameterized placeholders, not user input
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, partner1_id, partner1_given_name, partner1_surname,
+			   partner2_id, partner2_given_name, partner2_surname,
+			   relationship_type, marriage_date_raw, marriage_date_sort, marriage_place,
+			   marriage_place_lat, marriage_place_long, research_status,
+			   child_count, version, updated_at, created_at
+		FROM families
+		WHERE `+strings.Join(conditions, " AND ")+`
+		ORDER BY partner1_surname, partner1_given_name
+		LIMIT `+fmt.Sprintf("$%d", len(args)), args...)
+	if err != nil {
+		return nil, fmt.Errorf("search families: %w", err)
+	}
+	defer rows.Close()
+
+	var families []repository.FamilyReadModel
+	for rows.Next() {
+		f, err := scanFamilyRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		families = append(families, *f)
+	}
+
+	return families, rows.Err()
+}
+
 // SaveFamily saves or updates a family.
 func (s *ReadModelStore) SaveFamily(ctx context.Context, family *repository.FamilyReadModel) error {
 	_, err := s.db.ExecContext(ctx, `
 		INSERT INTO families (id, partner1_id, partner1_given_name, partner1_surname,
 							  partner2_id, partner2_given_name, partner2_surname,
 							  relationship_type, marriage_date_raw, marriage_date_sort, marriage_place,
-							  marriage_place_lat, marriage_place_long,
-							  child_count, version, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+							  marriage_place_lat, marriage_place_long, research_status,
+							  child_count, version, updated_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 		ON CONFLICT(id) DO UPDATE SET
 			partner1_id = EXCLUDED.partner1_id,
 			partner1_given_name = EXCLUDED.partner1_given_name,
@@ -1344,16 +1681,19 @@ func (s *ReadModelStore) SaveFamily(ctx context.Context, family *repository.Fami
 			marriage_place = EXCLUDED.marriage_place,
 			marriage_place_lat = EXCLUDED.marriage_place_lat,
 			marriage_place_long = EXCLUDED.marriage_place_long,
+			research_status = EXCLUDED.research_status,
 			child_count = EXCLUDED.child_count,
 			version = EXCLUDED.version,
-			updated_at = EXCLUDED.updated_at
+			updated_at = EXCLUDED.updated_at,
+			created_at = COALESCE(families.created_at, EXCLUDED.created_at)
 	`, family.ID,
 		nullableUUID(family.Partner1ID), nullableString(family.Partner1GivenName), nullableString(family.Partner1Surname),
 		nullableUUID(family.Partner2ID), nullableString(family.Partner2GivenName), nullableString(family.Partner2Surname),
 		nullableString(string(family.RelationshipType)), nullableString(family.MarriageDateRaw),
 		nullableTime(family.MarriageDateSort), nullableString(family.MarriagePlace),
 		nullableStringPtr(family.MarriagePlaceLat), nullableStringPtr(family.MarriagePlaceLong),
-		family.ChildCount, family.Version, family.UpdatedAt)
+		nullableString(string(family.ResearchStatus)),
+		family.ChildCount, family.Version, family.UpdatedAt, nullableZeroTime(family.CreatedAt))
 
 	return err
 }
@@ -1414,7 +1754,7 @@ func (s *ReadModelStore) GetChildrenOfFamily(ctx context.Context, familyID uuid.
 			   p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
 			   p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
 			   p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
-			   p.version, p.updated_at
+			   p.version, p.updated_at, p.created_at
 		FROM persons p
 		JOIN family_children fc ON p.id = fc.person_id
 		WHERE fc.family_id = $1
@@ -1443,8 +1783,8 @@ func (s *ReadModelStore) GetChildFamily(ctx context.Context, personID uuid.UUID)
 		SELECT f.id, f.partner1_id, f.partner1_given_name, f.partner1_surname,
 			   f.partner2_id, f.partner2_given_name, f.partner2_surname,
 			   f.relationship_type, f.marriage_date_raw, f.marriage_date_sort, f.marriage_place,
-			   f.marriage_place_lat, f.marriage_place_long,
-			   f.child_count, f.version, f.updated_at
+			   f.marriage_place_lat, f.marriage_place_long, f.research_status,
+			   f.child_count, f.version, f.updated_at, f.created_at
 		FROM families f
 		JOIN family_children fc ON f.id = fc.family_id
 		WHERE fc.person_id = $1
@@ -1558,13 +1898,14 @@ func scanPerson(row rowScanner) (*repository.PersonReadModel, error) {
 		birthDateSort, deathDateSort     sql.NullTime
 		version                          int64
 		updatedAt                        time.Time
+		createdAt                        sql.NullTime
 	)
 
 	err := row.Scan(&id, &givenName, &surname, &fullName, &gender,
 		&birthDateRaw, &birthDateSort, &birthPlace, &birthPlaceLat, &birthPlaceLong,
 		&deathDateRaw, &deathDateSort, &deathPlace, &deathPlaceLat, &deathPlaceLong,
 		&notes, &researchStatus, &brickWallNote, &brickWallSince, &brickWallResolvedAt,
-		&version, &updatedAt)
+		&version, &updatedAt, &createdAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -1616,6 +1957,9 @@ func scanPerson(row rowScanner) (*repository.PersonReadModel, error) {
 	if brickWallResolvedAt.Valid {
 		p.BrickWallResolvedAt = &brickWallResolvedAt.Time
 	}
+	if createdAt.Valid {
+		p.CreatedAt = createdAt.Time
+	}
 
 	return p, nil
 }
@@ -1632,18 +1976,20 @@ func scanFamily(row rowScanner) (*repository.FamilyReadModel, error) {
 		partner2GivenName, partner2Surname      sql.NullString
 		relType, marriageDateRaw, marriagePlace sql.NullString
 		marriagePlaceLat, marriagePlaceLong     sql.NullString
+		researchStatus                          sql.NullString
 		marriageDateSort                        sql.NullTime
 		childCount                              int
 		version                                 int64
 		updatedAt                               time.Time
+		createdAt                               sql.NullTime
 	)
 
 	err := row.Scan(&id,
 		&partner1ID, &partner1GivenName, &partner1Surname,
 		&partner2ID, &partner2GivenName, &partner2Surname,
 		&relType, &marriageDateRaw, &marriageDateSort, &marriagePlace,
-		&marriagePlaceLat, &marriagePlaceLong,
-		&childCount, &version, &updatedAt)
+		&marriagePlaceLat, &marriagePlaceLong, &researchStatus,
+		&childCount, &version, &updatedAt, &createdAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -1661,6 +2007,7 @@ func scanFamily(row rowScanner) (*repository.FamilyReadModel, error) {
 		RelationshipType:  domain.RelationType(relType.String),
 		MarriageDateRaw:   marriageDateRaw.String,
 		MarriagePlace:     marriagePlace.String,
+		ResearchStatus:    domain.ResearchStatus(researchStatus.String),
 		ChildCount:        childCount,
 		Version:           version,
 		UpdatedAt:         updatedAt,
@@ -1684,6 +2031,9 @@ func scanFamily(row rowScanner) (*repository.FamilyReadModel, error) {
 	if marriagePlaceLong.Valid && marriagePlaceLong.String != "" {
 		f.MarriagePlaceLong = &marriagePlaceLong.String
 	}
+	if createdAt.Valid {
+		f.CreatedAt = createdAt.Time
+	}
 
 	return f, nil
 }
@@ -1720,6 +2070,21 @@ func nullableTime(t *time.Time) sql.NullTime {
 	return sql.NullTime{Time: *t, Valid: true}
 }
 
+// nullableZeroTime stores a zero time as NULL.
+func nullableZeroTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t, Valid: true}
+}
+
+func nullableFloat(f *float64) sql.NullFloat64 {
+	if f == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *f, Valid: true}
+}
+
 func nullableUUID(id *uuid.UUID) sql.NullString {
 	if id == nil {
 		return sql.NullString{}
@@ -1761,14 +2126,31 @@ func (s *ReadModelStore) ListSources(ctx context.Context, opts repository.ListOp
 		return nil, 0, fmt.Errorf("count sources: %w", err)
 	}
 
-	rows, err := s.db.QueryContext(ctx, `
+	// Build order clause
+	orderDir := "ASC"
+	if opts.Order == "desc" {
+		orderDir = "DESC"
+	}
+	orderColumn := "title"
+	switch opts.Sort {
+	case "source_type":
+		orderColumn = "source_type"
+	case "citation_count":
+		orderColumn = "citation_count"
+	case "updated_at":
+		orderColumn = "updated_at"
+	}
+
+	// #nosec G201 -- orderColumn and orderDir are validated via switch/if above, not user input
+	query := fmt.Sprintf(`
 		SELECT id, source_type, title, author, publisher, publish_date_raw, publish_date_sort,
 			   url, repository_id, repository_name, collection_name, call_number, notes, gedcom_xref,
 			   citation_count, version, updated_at
 		FROM sources
-		ORDER BY title ASC
+		ORDER BY %s %s, title %s
 		LIMIT $1 OFFSET $2
-	`, opts.Limit, opts.Offset)
+	`, orderColumn, orderDir, orderDir)
+	rows, err := s.db.QueryContext(ctx, query, opts.Limit, opts.Offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("query sources: %w", err)
 	}
@@ -1893,6 +2275,59 @@ func (s *ReadModelStore) GetCitationsForSource(ctx context.Context, sourceID uui
 	return citations, rows.Err()
 }
 
+// ListCitationsForSource returns a page of a source's citations matching
+// filter, oldest first.
+func (s *ReadModelStore) ListCitationsForSource(ctx context.Context, sourceID uuid.UUID, filter repository.CitationFilter, opts repository.ListOptions) ([]repository.CitationReadModel, int, error) {
+	conditions := []string{"source_id = $1"}
+	args := []any{sourceID}
+	for _, f := range []struct{ column, value string }{
+		{"fact_type", string(filter.FactType)},
+		{"source_quality", string(filter.SourceQuality)},
+		{"evidence_type", string(filter.EvidenceType)},
+	} {
+		if f.value == "" {
+			continue
+		}
+		args = append(args, f.value)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", f.column, len(args)))
+	}
+	whereClause := strings.Join(conditions, " AND ")
+
+	var total int
+	// #nosec G201 -- whereClause is built from fixed column names, not user input
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM citations WHERE "+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count citations for source: %w", err)
+	}
+
+	// #nosec G201 -- whereClause is built from fixed column names, not user input
+	query := fmt.Sprintf(`
+		SELECT id, source_id, source_title, fact_type, fact_owner_id, page, volume,
+			   source_quality, informant_type, evidence_type, quoted_text, analysis,
+			   template_id, fields_data, gedcom_xref, version, created_at
+		FROM citations
+		WHERE %s
+		ORDER BY created_at ASC, id ASC
+		LIMIT $%d OFFSET $%d
+	`, whereClause, len(args)+1, len(args)+2)
+	rows, err := s.db.QueryContext(ctx, query, append(args, opts.Limit, opts.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query citations for source: %w", err)
+	}
+	defer rows.Close()
+
+	var citations []repository.CitationReadModel
+	for rows.Next() {
+		cit, err := scanCitationRows(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		citations = append(citations, *cit)
+	}
+
+	return citations, total, rows.Err()
+}
+
 // GetCitationsForPerson returns all citations for a person.
 func (s *ReadModelStore) GetCitationsForPerson(ctx context.Context, personID uuid.UUID) ([]repository.CitationReadModel, error) {
 	rows, err := s.db.QueryContext(ctx, `
@@ -2029,7 +2464,7 @@ func (s *ReadModelStore) GetEvent(ctx context.Context, id uuid.UUID) (*repositor
 		SELECT id, owner_type, owner_id, fact_type, date_raw, date_sort,
 		       place, place_lat, place_long, address, description, cause,
 		       age, research_status, is_negated, version, created_at
-		FROM events WHERE id = $1
+		FROM life_events WHERE id = $1
 	`, id)
 
 	return scanEventRow(row)
@@ -2039,7 +2474,7 @@ func (s *ReadModelStore) GetEvent(ctx context.Context, id uuid.UUID) (*repositor
 func (s *ReadModelStore) ListEvents(ctx context.Context, opts repository.ListOptions) ([]repository.EventReadModel, int, error) {
 	// Count total
 	var total int
-	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM events").Scan(&total)
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM life_events").Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("count events: %w", err)
 	}
@@ -2049,7 +2484,7 @@ func (s *ReadModelStore) ListEvents(ctx context.Context, opts repository.ListOpt
 		SELECT id, owner_type, owner_id, fact_type, date_raw, date_sort,
 		       place, place_lat, place_long, address, description, cause,
 		       age, research_status, is_negated, version, created_at
-		FROM events
+		FROM life_events
 		ORDER BY fact_type ASC, date_sort ASC NULLS LAST, id ASC
 		LIMIT $1 OFFSET $2
 	`
@@ -2078,7 +2513,7 @@ func (s *ReadModelStore) ListEventsForPerson(ctx context.Context, personID uuid.
 		SELECT id, owner_type, owner_id, fact_type, date_raw, date_sort,
 		       place, place_lat, place_long, address, description, cause,
 		       age, research_status, is_negated, version, created_at
-		FROM events
+		FROM life_events
 		WHERE owner_type = 'person' AND owner_id = $1
 		ORDER BY fact_type ASC, date_sort ASC NULLS LAST, id ASC
 	`, personID)
@@ -2105,7 +2540,7 @@ func (s *ReadModelStore) ListEventsForFamily(ctx context.Context, familyID uuid.
 		SELECT id, owner_type, owner_id, fact_type, date_raw, date_sort,
 		       place, place_lat, place_long, address, description, cause,
 		       age, research_status, is_negated, version, created_at
-		FROM events
+		FROM life_events
 		WHERE owner_type = 'family' AND owner_id = $1
 		ORDER BY fact_type ASC, date_sort ASC NULLS LAST, id ASC
 	`, familyID)
@@ -2136,7 +2571,7 @@ func (s *ReadModelStore) SaveEvent(ctx context.Context, event *repository.EventR
 	}
 
 	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO events (id, owner_type, owner_id, fact_type, date_raw, date_sort,
+		INSERT INTO life_events (id, owner_type, owner_id, fact_type, date_raw, date_sort,
 		                    place, place_lat, place_long, address, description, cause,
 		                    age, research_status, is_negated, version, created_at)
 		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, NULLIF($7, ''), NULLIF($8, ''), NULLIF($9, ''),
@@ -2170,7 +2605,7 @@ func (s *ReadModelStore) SaveEvent(ctx context.Context, event *repository.EventR
 
 // DeleteEvent deletes an event by ID.
 func (s *ReadModelStore) DeleteEvent(ctx context.Context, id uuid.UUID) error {
-	_, err := s.db.ExecContext(ctx, "DELETE FROM events WHERE id = $1", id)
+	_, err := s.db.ExecContext(ctx, "DELETE FROM life_events WHERE id = $1", id)
 	if err != nil {
 		return fmt.Errorf("delete event: %w", err)
 	}
@@ -2180,7 +2615,7 @@ func (s *ReadModelStore) DeleteEvent(ctx context.Context, id uuid.UUID) error {
 // GetAttribute retrieves an attribute by ID.
 func (s *ReadModelStore) GetAttribute(ctx context.Context, id uuid.UUID) (*repository.AttributeReadModel, error) {
 	row := s.db.QueryRowContext(ctx, `
-		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, version, created_at
+		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, research_status, version, created_at
 		FROM attributes WHERE id = $1
 	`, id)
 
@@ -2198,7 +2633,7 @@ func (s *ReadModelStore) ListAttributes(ctx context.Context, opts repository.Lis
 
 	// Sort by fact_type ASC, value ASC, id ASC for deterministic ordering
 	query := `
-		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, version, created_at
+		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, research_status, version, created_at
 		FROM attributes
 		ORDER BY fact_type ASC, value ASC, id ASC
 		LIMIT $1 OFFSET $2
@@ -2225,7 +2660,7 @@ func (s *ReadModelStore) ListAttributes(ctx context.Context, opts repository.Lis
 // ListAttributesForPerson returns all attributes for a given person.
 func (s *ReadModelStore) ListAttributesForPerson(ctx context.Context, personID uuid.UUID) ([]repository.AttributeReadModel, error) {
 	rows, err := s.db.QueryContext(ctx, `
-		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, version, created_at
+		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, research_status, version, created_at
 		FROM attributes
 		WHERE person_id = $1
 		ORDER BY fact_type ASC, value ASC, id ASC
@@ -2250,8 +2685,8 @@ func (s *ReadModelStore) ListAttributesForPerson(ctx context.Context, personID u
 // SaveAttribute saves or updates an attribute.
 func (s *ReadModelStore) SaveAttribute(ctx context.Context, attribute *repository.AttributeReadModel) error {
 	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO attributes (id, person_id, fact_type, value, date_raw, date_sort, place, version, created_at)
-		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, NULLIF($7, ''), $8, $9)
+		INSERT INTO attributes (id, person_id, fact_type, value, date_raw, date_sort, place, research_status, version, created_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, NULLIF($7, ''), NULLIF($8, ''), $9, $10)
 		ON CONFLICT (id) DO UPDATE SET
 			person_id = EXCLUDED.person_id,
 			fact_type = EXCLUDED.fact_type,
@@ -2259,10 +2694,11 @@ func (s *ReadModelStore) SaveAttribute(ctx context.Context, attribute *repositor
 			date_raw = EXCLUDED.date_raw,
 			date_sort = EXCLUDED.date_sort,
 			place = EXCLUDED.place,
+			research_status = EXCLUDED.research_status,
 			version = EXCLUDED.version
 	`, attribute.ID, attribute.PersonID, string(attribute.FactType),
 		attribute.Value, attribute.DateRaw, nullableTime(attribute.DateSort),
-		attribute.Place, attribute.Version, attribute.CreatedAt)
+		attribute.Place, string(attribute.ResearchStatus), attribute.Version, attribute.CreatedAt)
 	if err != nil {
 		return fmt.Errorf("save attribute: %w", err)
 	}
@@ -2462,13 +2898,14 @@ func scanAttributeRow(row rowScanner) (*repository.AttributeReadModel, error) {
 		id, personID    uuid.UUID
 		factType, value string
 		dateRaw, place  sql.NullString
+		researchStatus  sql.NullString
 		dateSort        sql.NullTime
 		version         int64
 		createdAt       time.Time
 	)
 
 	err := row.Scan(&id, &personID, &factType, &value, &dateRaw, &dateSort,
-		&place, &version, &createdAt)
+		&place, &researchStatus, &version, &createdAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -2478,14 +2915,15 @@ func scanAttributeRow(row rowScanner) (*repository.AttributeReadModel, error) {
 	}
 
 	attr := &repository.AttributeReadModel{
-		ID:        id,
-		PersonID:  personID,
-		FactType:  domain.FactType(factType),
-		Value:     value,
-		DateRaw:   dateRaw.String,
-		Place:     place.String,
-		Version:   version,
-		CreatedAt: createdAt,
+		ID:             id,
+		PersonID:       personID,
+		FactType:       domain.FactType(factType),
+		Value:          value,
+		DateRaw:        dateRaw.String,
+		Place:          place.String,
+		ResearchStatus: domain.ResearchStatus(researchStatus.String),
+		Version:        version,
+		CreatedAt:      createdAt,
 	}
 
 	if dateSort.Valid {
@@ -2505,7 +2943,8 @@ func (s *ReadModelStore) GetMedia(ctx context.Context, id uuid.UUID) (*repositor
 		SELECT id, entity_type, entity_id, title, description, mime_type, media_type,
 			   filename, file_size, crop_left, crop_top, crop_width, crop_height,
 			   gedcom_xref, version, created_at, updated_at,
-			   files, format, translations
+			   files, format, translations, captured_at, gps_latitude, gps_longitude,
+			   content_hash, storage_key
 		FROM media WHERE id = $1
 	`, id)
 
@@ -2515,12 +2954,15 @@ func (s *ReadModelStore) GetMedia(ctx context.Context, id uuid.UUID) (*repositor
 // GetMediaWithData retrieves full media record including FileData and ThumbnailData.
 func (s *ReadModelStore) GetMediaWithData(ctx context.Context, id uuid.UUID) (*repository.MediaReadModel, error) {
 	row := s.db.QueryRowContext(ctx, `
-		SELECT id, entity_type, entity_id, title, description, mime_type, media_type,
-			   filename, file_size, file_data, thumbnail_data,
+		SELECT m.id, entity_type, entity_id, title, description, mime_type, media_type,
+			   filename, file_size, COALESCE(c.file_data, m.file_data), thumbnail_data,
 			   crop_left, crop_top, crop_width, crop_height,
 			   gedcom_xref, version, created_at, updated_at,
-			   files, format, translations
-		FROM media WHERE id = $1
+			   files, format, translations, captured_at, gps_latitude, gps_longitude,
+			   m.content_hash, storage_key
+		FROM media m
+		LEFT JOIN media_content c ON c.content_hash = m.content_hash
+		WHERE m.id = $1
 	`, id)
 
 	return scanMediaFull(row)
@@ -2555,7 +2997,8 @@ func (s *ReadModelStore) ListMediaForEntity(ctx context.Context, entityType stri
 		SELECT id, entity_type, entity_id, title, description, mime_type, media_type,
 			   filename, file_size, crop_left, crop_top, crop_width, crop_height,
 			   gedcom_xref, version, created_at, updated_at,
-			   files, format, translations
+			   files, format, translations, captured_at, gps_latitude, gps_longitude,
+			   content_hash, storage_key
 		FROM media
 		WHERE entity_type = $1 AND entity_id = $2
 		ORDER BY created_at DESC
@@ -2590,13 +3033,42 @@ func (s *ReadModelStore) SaveMedia(ctx context.Context, media *repository.MediaR
 		return fmt.Errorf("marshal translations: %w", err)
 	}
 
-	_, err = s.db.ExecContext(ctx, `
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var prevHash sql.NullString
+	err = tx.QueryRowContext(ctx, "SELECT content_hash FROM media WHERE id = $1", media.ID).Scan(&prevHash)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("get media content hash: %w", err)
+	}
+
+	// file_data is NOT NULL; content held in a blob store or shared through
+	// media_content is stored as empty
+	fileData := media.FileData
+	if media.ContentHash != "" && len(fileData) > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO media_content (content_hash, file_data) VALUES ($1, $2)
+			ON CONFLICT (content_hash) DO NOTHING
+		`, media.ContentHash, fileData); err != nil {
+			return fmt.Errorf("save media content: %w", err)
+		}
+		fileData = nil
+	}
+	if fileData == nil {
+		fileData = []byte{}
+	}
+
+	_, err = tx.ExecContext(ctx, `
 		INSERT INTO media (id, entity_type, entity_id, title, description, mime_type, media_type,
 						  filename, file_size, file_data, thumbnail_data,
 						  crop_left, crop_top, crop_width, crop_height,
 						  gedcom_xref, version, created_at, updated_at,
-						  files, format, translations)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
+						  files, format, translations, captured_at, gps_latitude, gps_longitude,
+						  content_hash, storage_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27)
 		ON CONFLICT(id) DO UPDATE SET
 			entity_type = EXCLUDED.entity_type,
 			entity_id = EXCLUDED.entity_id,
@@ -2617,22 +3089,130 @@ func (s *ReadModelStore) SaveMedia(ctx context.Context, media *repository.MediaR
 			updated_at = EXCLUDED.updated_at,
 			files = EXCLUDED.files,
 			format = EXCLUDED.format,
-			translations = EXCLUDED.translations
+			translations = EXCLUDED.translations,
+			captured_at = EXCLUDED.captured_at,
+			gps_latitude = EXCLUDED.gps_latitude,
+			gps_longitude = EXCLUDED.gps_longitude,
+			content_hash = EXCLUDED.content_hash,
+			storage_key = EXCLUDED.storage_key
 	`, media.ID, media.EntityType, media.EntityID, media.Title,
 		nullableString(media.Description), media.MimeType, string(media.MediaType),
-		media.Filename, media.FileSize, media.FileData, media.ThumbnailData,
+		media.Filename, media.FileSize, fileData, media.ThumbnailData,
 		nullableInt(media.CropLeft), nullableInt(media.CropTop),
 		nullableInt(media.CropWidth), nullableInt(media.CropHeight),
 		nullableString(media.GedcomXref), media.Version, media.CreatedAt, media.UpdatedAt,
-		nullableBytes(filesJSON), nullableString(media.Format), nullableBytes(translationsJSON))
+		nullableBytes(filesJSON), nullableString(media.Format), nullableBytes(translationsJSON),
+		nullableTime(media.CapturedAt), nullableFloat(media.GPSLatitude), nullableFloat(media.GPSLongitude),
+		nullableString(media.ContentHash), nullableString(media.StorageKey))
+	if err != nil {
+		return err
+	}
 
-	return err
+	if prevHash.Valid && prevHash.String != media.ContentHash {
+		if err := pruneMediaContent(ctx, tx, prevHash.String); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
 }
 
-// DeleteMedia removes a media record.
+// DeleteMedia removes a media record, and its content once no other media
+// shares it.
 func (s *ReadModelStore) DeleteMedia(ctx context.Context, id uuid.UUID) error {
-	_, err := s.db.ExecContext(ctx, "DELETE FROM media WHERE id = $1", id)
-	return err
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var hash sql.NullString
+	err = tx.QueryRowContext(ctx, "SELECT content_hash FROM media WHERE id = $1", id).Scan(&hash)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get media content hash: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM media WHERE id = $1", id); err != nil {
+		return err
+	}
+	if hash.Valid {
+		if err := pruneMediaContent(ctx, tx, hash.String); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// pruneMediaContent deletes the shared content for hash once no media row
+// references it.
+func pruneMediaContent(ctx context.Context, tx *sql.Tx, hash string) error {
+	_, err := tx.ExecContext(ctx, `
+		DELETE FROM media_content
+		WHERE content_hash = $1 AND NOT EXISTS (SELECT 1 FROM media WHERE content_hash = $1)
+	`, hash)
+	if err != nil {
+		return fmt.Errorf("prune media content: %w", err)
+	}
+	return nil
+}
+
+// FindMediaByContentHash returns the oldest media record, including file data,
+// whose content has the given hash.
+func (s *ReadModelStore) FindMediaByContentHash(ctx context.Context, hash string) (*repository.MediaReadModel, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT m.id, entity_type, entity_id, title, description, mime_type, media_type,
+			   filename, file_size, COALESCE(c.file_data, m.file_data), thumbnail_data,
+			   crop_left, crop_top, crop_width, crop_height,
+			   gedcom_xref, version, created_at, updated_at,
+			   files, format, translations, captured_at, gps_latitude, gps_longitude,
+			   m.content_hash, storage_key
+		FROM media m
+		LEFT JOIN media_content c ON c.content_hash = m.content_hash
+		WHERE m.content_hash = $1
+		ORDER BY created_at ASC
+		LIMIT 1
+	`, hash)
+
+	return scanMediaFull(row)
+}
+
+// ListDuplicateMedia groups media records whose file content is identical.
+func (s *ReadModelStore) ListDuplicateMedia(ctx context.Context) ([]repository.MediaDuplicateGroup, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, entity_type, entity_id, title, description, mime_type, media_type,
+			   filename, file_size, crop_left, crop_top, crop_width, crop_height,
+			   gedcom_xref, version, created_at, updated_at,
+			   files, format, translations, captured_at, gps_latitude, gps_longitude,
+			   content_hash, storage_key
+		FROM media
+		WHERE content_hash IN (
+			SELECT content_hash FROM media
+			WHERE content_hash IS NOT NULL
+			GROUP BY content_hash HAVING COUNT(*) > 1
+		)
+		ORDER BY content_hash, created_at
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query duplicate media: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []repository.MediaDuplicateGroup
+	for rows.Next() {
+		m, err := scanMediaMetadataRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		if n := len(groups); n == 0 || groups[n-1].ContentHash != m.ContentHash {
+			groups = append(groups, repository.MediaDuplicateGroup{ContentHash: m.ContentHash, FileSize: m.FileSize})
+		}
+		last := &groups[len(groups)-1]
+		last.Media = append(last.Media, *m)
+	}
+
+	return groups, rows.Err()
 }
 
 // Media scanner helpers
@@ -2650,13 +3230,18 @@ func scanMediaMetadata(row rowScanner) (*repository.MediaReadModel, error) {
 		// GEDCOM 7.0 enhanced fields
 		filesJSON, translationsJSON []byte
 		format                      sql.NullString
+		// EXIF metadata
+		capturedAt                sql.NullTime
+		gpsLatitude, gpsLongitude sql.NullFloat64
+		contentHash, storageKey   sql.NullString
 	)
 
 	err := row.Scan(&id, &entityType, &entityID, &title, &description,
 		&mimeType, &mediaType, &filename, &fileSize,
 		&cropLeft, &cropTop, &cropWidth, &cropHeight,
 		&gedcomXref, &version, &createdAt, &updatedAt,
-		&filesJSON, &format, &translationsJSON)
+		&filesJSON, &format, &translationsJSON, &capturedAt, &gpsLatitude, &gpsLongitude,
+		&contentHash, &storageKey)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -2685,6 +3270,8 @@ func scanMediaMetadata(row rowScanner) (*repository.MediaReadModel, error) {
 		MediaType:    domain.MediaType(mediaType),
 		Filename:     filename,
 		FileSize:     fileSize,
+		ContentHash:  contentHash.String,
+		StorageKey:   storageKey.String,
 		GedcomXref:   gedcomXref.String,
 		Version:      version,
 		CreatedAt:    createdAt,
@@ -2710,6 +3297,7 @@ func scanMediaMetadata(row rowScanner) (*repository.MediaReadModel, error) {
 		v := int(cropHeight.Int64)
 		m.CropHeight = &v
 	}
+	applyMediaExif(m, capturedAt, gpsLatitude, gpsLongitude)
 
 	return m, nil
 }
@@ -2732,13 +3320,18 @@ func scanMediaFull(row rowScanner) (*repository.MediaReadModel, error) {
 		// GEDCOM 7.0 enhanced fields
 		filesJSON, translationsJSON []byte
 		format                      sql.NullString
+		// EXIF metadata
+		capturedAt                sql.NullTime
+		gpsLatitude, gpsLongitude sql.NullFloat64
+		contentHash, storageKey   sql.NullString
 	)
 
 	err := row.Scan(&id, &entityType, &entityID, &title, &description,
 		&mimeType, &mediaType, &filename, &fileSize, &fileData, &thumbnailData,
 		&cropLeft, &cropTop, &cropWidth, &cropHeight,
 		&gedcomXref, &version, &createdAt, &updatedAt,
-		&filesJSON, &format, &translationsJSON)
+		&filesJSON, &format, &translationsJSON, &capturedAt, &gpsLatitude, &gpsLongitude,
+		&contentHash, &storageKey)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -2769,6 +3362,8 @@ func scanMediaFull(row rowScanner) (*repository.MediaReadModel, error) {
 		FileSize:      fileSize,
 		FileData:      fileData,
 		ThumbnailData: thumbnailData,
+		ContentHash:   contentHash.String,
+		StorageKey:    storageKey.String,
 		GedcomXref:    gedcomXref.String,
 		Version:       version,
 		CreatedAt:     createdAt,
@@ -2794,16 +3389,35 @@ func scanMediaFull(row rowScanner) (*repository.MediaReadModel, error) {
 		v := int(cropHeight.Int64)
 		m.CropHeight = &v
 	}
+	applyMediaExif(m, capturedAt, gpsLatitude, gpsLongitude)
 
 	return m, nil
 }
 
+// applyMediaExif sets the nullable EXIF columns on a scanned media record.
+func applyMediaExif(m *repository.MediaReadModel, capturedAt sql.NullTime, lat, lon sql.NullFloat64) {
+	if capturedAt.Valid {
+		m.CapturedAt = &capturedAt.Time
+	}
+	if lat.Valid {
+		m.GPSLatitude = &lat.Float64
+	}
+	if lon.Valid {
+		m.GPSLongitude = &lon.Float64
+	}
+}
+
 // GetSurnameIndex returns all unique surnames with counts and letter counts.
+// Persons whose primary name is a patronymic have no family surname and are skipped.
 func (s *ReadModelStore) GetSurnameIndex(ctx context.Context) ([]repository.SurnameEntry, []repository.LetterCount, error) {
 	// Get surname counts
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT surname, COUNT(*) as count
 		FROM persons
+		WHERE NOT EXISTS (
+			SELECT 1 FROM person_names pn
+			WHERE pn.person_id = persons.id AND pn.is_primary AND pn.name_type = 'patronymic'
+		)
 		GROUP BY surname
 		ORDER BY surname ASC
 	`)
@@ -2828,7 +3442,10 @@ func (s *ReadModelStore) GetSurnameIndex(ctx context.Context) ([]repository.Surn
 	letterRows, err := s.db.QueryContext(ctx, `
 		SELECT UPPER(SUBSTRING(surname, 1, 1)) as letter, COUNT(DISTINCT surname) as count
 		FROM persons
-		WHERE surname != ''
+		WHERE surname != '' AND NOT EXISTS (
+			SELECT 1 FROM person_names pn
+			WHERE pn.person_id = persons.id AND pn.is_primary AND pn.name_type = 'patronymic'
+		)
 		GROUP BY UPPER(SUBSTRING(surname, 1, 1))
 		ORDER BY letter ASC
 	`)
@@ -2854,7 +3471,10 @@ func (s *ReadModelStore) GetSurnamesByLetter(ctx context.Context, letter string)
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT surname, COUNT(*) as count
 		FROM persons
-		WHERE UPPER(SUBSTRING(surname, 1, 1)) = UPPER($1)
+		WHERE UPPER(SUBSTRING(surname, 1, 1)) = UPPER($1) AND NOT EXISTS (
+			SELECT 1 FROM person_names pn
+			WHERE pn.person_id = persons.id AND pn.is_primary AND pn.name_type = 'patronymic'
+		)
 		GROUP BY surname
 		ORDER BY surname ASC
 	`, letter)
@@ -2889,7 +3509,7 @@ func (s *ReadModelStore) GetPersonsBySurname(ctx context.Context, surname string
 			   birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
 			   death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
 			   notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-			   version, updated_at
+			   version, updated_at, created_at
 		FROM persons
 		WHERE LOWER(surname) = LOWER($1)
 		ORDER BY given_name ASC
@@ -3029,7 +3649,7 @@ func (s *ReadModelStore) GetPersonsByPlace(ctx context.Context, place string, op
 			   birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
 			   death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
 			   notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-			   version, updated_at
+			   version, updated_at, created_at
 		FROM persons
 		WHERE birth_place ILIKE '%' || $1 || '%' OR death_place ILIKE '%' || $1 || '%'
 		ORDER BY surname ASC, given_name ASC
@@ -3056,7 +3676,7 @@ func (s *ReadModelStore) GetPersonsByPlace(ctx context.Context, place string, op
 func (s *ReadModelStore) GetCemeteryIndex(ctx context.Context) ([]repository.CemeteryEntry, error) {
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT place, COUNT(DISTINCT owner_id) as count
-		FROM events
+		FROM life_events
 		WHERE fact_type IN ($1, $2) AND place != '' AND place IS NOT NULL
 		GROUP BY place
 		ORDER BY place ASC
@@ -3085,7 +3705,7 @@ func (s *ReadModelStore) GetPersonsByCemetery(ctx context.Context, place string,
 	err := s.db.QueryRowContext(ctx, `
 		SELECT COUNT(DISTINCT p.id)
 		FROM persons p
-		INNER JOIN events e ON e.owner_id = p.id
+		INNER JOIN life_events e ON e.owner_id = p.id
 		WHERE e.fact_type IN ($1, $2) AND LOWER(e.place) = LOWER($3)
 	`, string(domain.FactPersonBurial), string(domain.FactPersonCremation), place).Scan(&total)
 	if err != nil {
@@ -3097,9 +3717,9 @@ func (s *ReadModelStore) GetPersonsByCemetery(ctx context.Context, place string,
 			   p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
 			   p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
 			   p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
-			   p.version, p.updated_at
+			   p.version, p.updated_at, p.created_at
 		FROM persons p
-		INNER JOIN events e ON e.owner_id = p.id
+		INNER JOIN life_events e ON e.owner_id = p.id
 		WHERE e.fact_type IN ($1, $2) AND LOWER(e.place) = LOWER($3)
 		ORDER BY p.surname ASC, p.given_name ASC
 		LIMIT $4 OFFSET $5
@@ -3121,6 +3741,253 @@ func (s *ReadModelStore) GetPersonsByCemetery(ctx context.Context, place string,
 	return persons, total, rows.Err()
 }
 
+// GetBirthDecadeIndex returns person counts per birth decade, with undated
+// persons counted in a trailing entry with a nil decade.
+func (s *ReadModelStore) GetBirthDecadeIndex(ctx context.Context) ([]repository.BirthDecadeEntry, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT EXTRACT(YEAR FROM birth_date_sort)::int / 10 * 10 AS decade, COUNT(*) as count
+		FROM persons
+		WHERE birth_date_sort IS NOT NULL
+		GROUP BY decade
+		ORDER BY decade ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query birth decade index: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []repository.BirthDecadeEntry
+	for rows.Next() {
+		var decade, count int
+		if err := rows.Scan(&decade, &count); err != nil {
+			return nil, fmt.Errorf("scan birth decade entry: %w", err)
+		}
+		entries = append(entries, repository.BirthDecadeEntry{Decade: &decade, Count: count})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var unknown int
+	err = s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM persons WHERE birth_date_sort IS NULL").Scan(&unknown)
+	if err != nil {
+		return nil, fmt.Errorf("count undated persons: %w", err)
+	}
+	if unknown > 0 {
+		entries = append(entries, repository.BirthDecadeEntry{Count: unknown})
+	}
+
+	return entries, nil
+}
+
+// GetPersonsByBirthDecade returns persons born in the given decade, or persons
+// without a parseable birth date when decade is nil.
+func (s *ReadModelStore) GetPersonsByBirthDecade(ctx context.Context, decade *int, opts repository.ListOptions) ([]repository.PersonReadModel, int, error) {
+	where := "birth_date_sort IS NULL"
+	var args []any
+	if decade != nil {
+		where = "birth_date_sort >= $1 AND birth_date_sort < $2"
+		args = append(args,
+			time.Date(*decade, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(*decade+10, 1, 1, 0, 0, 0, 0, time.UTC))
+	}
+
+	var total int
+	// nosemgrep: go.lang.security.audit.database.string-formatted-query.string-formatted-query -- where is a constant condition with parameterized placeholders
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM persons WHERE "+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count persons by birth decade: %w", err)
+	}
+
+	// nosemgrep: go.lang.security.audit.database.string-formatted-query.string-formatted-query -- where is a constant condition with parameterized placeholders
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT id, given_name, surname, full_name, gender,
+			   birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
+			   death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
+			   notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
+			   version, updated_at, created_at
+		FROM persons
+		WHERE %s
+		ORDER BY birth_date_sort ASC, surname ASC, given_name ASC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2), append(args, opts.Limit, opts.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query persons by birth decade: %w", err)
+	}
+	defer rows.Close()
+
+	var persons []repository.PersonReadModel
+	for rows.Next() {
+		p, err := scanPersonRow(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		persons = append(persons, *p)
+	}
+
+	return persons, total, rows.Err()
+}
+
+// GetSourceRepositoryIndex returns each distinct source repository name with its source count.
+func (s *ReadModelStore) GetSourceRepositoryIndex(ctx context.Context) ([]repository.SourceRepositoryEntry, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT repository_name, COUNT(*) as count
+		FROM sources
+		WHERE repository_name IS NOT NULL AND repository_name != ''
+		GROUP BY repository_name
+		ORDER BY repository_name ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query source repository index: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []repository.SourceRepositoryEntry
+	for rows.Next() {
+		var entry repository.SourceRepositoryEntry
+		if err := rows.Scan(&entry.Name, &entry.Count); err != nil {
+			return nil, fmt.Errorf("scan source repository entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// GetSourcesByRepositoryName returns sources held by the named repository.
+func (s *ReadModelStore) GetSourcesByRepositoryName(ctx context.Context, name string, opts repository.ListOptions) ([]repository.SourceReadModel, int, error) {
+	var total int
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sources WHERE LOWER(repository_name) = LOWER($1)", name).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count sources by repository: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, source_type, title, author, publisher, publish_date_raw, publish_date_sort,
+			   url, repository_id, repository_name, collection_name, call_number, notes, gedcom_xref,
+			   citation_count, version, updated_at
+		FROM sources
+		WHERE LOWER(repository_name) = LOWER($1)
+		ORDER BY title ASC
+		LIMIT $2 OFFSET $3
+	`, name, opts.Limit, opts.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query sources by repository: %w", err)
+	}
+	defer rows.Close()
+
+	var sources []repository.SourceReadModel
+	for rows.Next() {
+		src, err := scanSourceRow(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		sources = append(sources, *src)
+	}
+
+	return sources, total, rows.Err()
+}
+
+// ListFactTypeUsage returns every fact type used by a life event, attribute,
+// or citation, with its counts, ordered by fact type.
+func (s *ReadModelStore) ListFactTypeUsage(ctx context.Context) ([]repository.FactTypeUsage, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT fact_type,
+			   SUM(CASE WHEN kind = 'event' THEN 1 ELSE 0 END),
+			   SUM(CASE WHEN kind = 'attribute' THEN 1 ELSE 0 END),
+			   SUM(CASE WHEN kind = 'citation' THEN 1 ELSE 0 END)
+		FROM (
+			SELECT fact_type, 'event' AS kind FROM life_events
+			UNION ALL SELECT fact_type, 'attribute' FROM attributes
+			UNION ALL SELECT fact_type, 'citation' FROM citations
+		) AS facts
+		GROUP BY fact_type
+		ORDER BY fact_type ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query fact type usage: %w", err)
+	}
+	defer rows.Close()
+
+	var usage []repository.FactTypeUsage
+	for rows.Next() {
+		var u repository.FactTypeUsage
+		if err := rows.Scan(&u.FactType, &u.EventCount, &u.AttributeCount, &u.CitationCount); err != nil {
+			return nil, fmt.Errorf("scan fact type usage: %w", err)
+		}
+		usage = append(usage, u)
+	}
+
+	return usage, rows.Err()
+}
+
+// ListPlaceUsage returns every distinct place string used by persons, families,
+// and life events, with its reference count.
+func (s *ReadModelStore) ListPlaceUsage(ctx context.Context) ([]repository.PlaceUsage, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT place, COUNT(*) as count
+		FROM (
+			SELECT birth_place AS place FROM persons
+			UNION ALL SELECT death_place FROM persons
+			UNION ALL SELECT marriage_place FROM families
+			UNION ALL SELECT place FROM life_events
+		) AS places
+		WHERE place IS NOT NULL AND place != ''
+		GROUP BY place
+		ORDER BY place ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query place usage: %w", err)
+	}
+	defer rows.Close()
+
+	var usage []repository.PlaceUsage
+	for rows.Next() {
+		var u repository.PlaceUsage
+		if err := rows.Scan(&u.Place, &u.Count); err != nil {
+			return nil, fmt.Errorf("scan place usage: %w", err)
+		}
+		usage = append(usage, u)
+	}
+
+	return usage, rows.Err()
+}
+
+// FindPlaceReferences returns every person, family, and life event field whose
+// place exactly matches place.
+func (s *ReadModelStore) FindPlaceReferences(ctx context.Context, place string) ([]repository.PlaceReference, error) {
+	var refs []repository.PlaceReference
+	if place == "" {
+		return refs, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT 'person', id, 'birth_place' FROM persons WHERE birth_place = $1
+		UNION ALL SELECT 'person', id, 'death_place' FROM persons WHERE death_place = $1
+		UNION ALL SELECT 'family', id, 'marriage_place' FROM families WHERE marriage_place = $1
+		UNION ALL SELECT 'event', id, 'place' FROM life_events WHERE place = $1
+	`, place)
+	if err != nil {
+		return nil, fmt.Errorf("query place references: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ref repository.PlaceReference
+		var id string
+		if err := rows.Scan(&ref.EntityType, &id, &ref.Field); err != nil {
+			return nil, fmt.Errorf("scan place reference: %w", err)
+		}
+		ref.EntityID, err = uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("parse place reference id: %w", err)
+		}
+		refs = append(refs, ref)
+	}
+
+	return refs, rows.Err()
+}
+
 // GetMapLocations returns aggregated geographic locations from person birth/death coordinates.
 func (s *ReadModelStore) GetMapLocations(ctx context.Context) ([]repository.MapLocation, error) {
 	// Query birth locations — individual rows, aggregate in Go
@@ -3290,36 +4157,18 @@ func (s *ReadModelStore) GetBrickWalls(ctx context.Context, includeResolved bool
 // GetNote retrieves a note by ID.
 func (s *ReadModelStore) GetNote(ctx context.Context, id uuid.UUID) (*repository.NoteReadModel, error) {
 	row := s.db.QueryRowContext(ctx, `
-		SELECT id, text, mime, language, translations, gedcom_xref, version, updated_at
+		SELECT id, text, mime, language, translations, gedcom_xref, links, version, updated_at
 		FROM notes WHERE id = $1
 	`, id)
 
-	var note repository.NoteReadModel
-	var mime, language, gedcomXref sql.NullString
-	var translations []byte
-	err := row.Scan(
-		&note.ID,
-		&note.Text,
-		&mime,
-		&language,
-		&translations,
-		&gedcomXref,
-		&note.Version,
-		&note.UpdatedAt,
-	)
+	note, err := scanNote(row)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, fmt.Errorf("scan note: %w", err)
 	}
-	note.MIME = mime.String
-	note.Language = language.String
-	note.Translations = repository.UnmarshalNoteTranslations(string(translations))
-	if gedcomXref.Valid {
-		note.GedcomXref = gedcomXref.String
-	}
-	return &note, nil
+	return note, nil
 }
 
 // ListNotes returns a paginated list of notes.
@@ -3340,7 +4189,7 @@ func (s *ReadModelStore) ListNotes(ctx context.Context, opts repository.ListOpti
 
 	// #nosec G201 -- orderColumn and orderDir are validated via switch/if above, not user input
 	query := fmt.Sprintf(`
-		SELECT id, text, mime, language, translations, gedcom_xref, version, updated_at
+		SELECT id, text, mime, language, translations, gedcom_xref, links, version, updated_at
 		FROM notes
 		ORDER BY %s %s
 		LIMIT $1 OFFSET $2
@@ -3354,52 +4203,92 @@ func (s *ReadModelStore) ListNotes(ctx context.Context, opts repository.ListOpti
 
 	var notes []repository.NoteReadModel
 	for rows.Next() {
-		var note repository.NoteReadModel
-		var mime, language, gedcomXref sql.NullString
-		var translations []byte
-		if err := rows.Scan(
-			&note.ID,
-			&note.Text,
-			&mime,
-			&language,
-			&translations,
-			&gedcomXref,
-			&note.Version,
-			&note.UpdatedAt,
-		); err != nil {
+		note, err := scanNote(rows)
+		if err != nil {
 			return nil, 0, fmt.Errorf("scan note: %w", err)
 		}
-		note.MIME = mime.String
-		note.Language = language.String
-		note.Translations = repository.UnmarshalNoteTranslations(string(translations))
-		if gedcomXref.Valid {
-			note.GedcomXref = gedcomXref.String
-		}
-		notes = append(notes, note)
+		notes = append(notes, *note)
 	}
 
 	return notes, total, rows.Err()
 }
 
+// ListNotesForEntity returns the notes attached to a person, family, or source.
+func (s *ReadModelStore) ListNotesForEntity(ctx context.Context, entityID uuid.UUID) ([]repository.NoteReadModel, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, text, mime, language, translations, gedcom_xref, links, version, updated_at
+		FROM notes
+		WHERE links @> jsonb_build_array(jsonb_build_object('entity_id', $1::text))
+		ORDER BY updated_at
+	`, entityID.String())
+	if err != nil {
+		return nil, fmt.Errorf("query notes for entity: %w", err)
+	}
+	defer rows.Close()
+
+	var notes []repository.NoteReadModel
+	for rows.Next() {
+		note, err := scanNote(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan note: %w", err)
+		}
+		notes = append(notes, *note)
+	}
+
+	return notes, rows.Err()
+}
+
+func scanNote(row rowScanner) (*repository.NoteReadModel, error) {
+	var note repository.NoteReadModel
+	var mime, language, gedcomXref sql.NullString
+	var translations, links []byte
+	if err := row.Scan(
+		&note.ID,
+		&note.Text,
+		&mime,
+		&language,
+		&translations,
+		&gedcomXref,
+		&links,
+		&note.Version,
+		&note.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	note.MIME = mime.String
+	note.Language = language.String
+	note.Translations = repository.UnmarshalNoteTranslations(string(translations))
+	note.Links = repository.UnmarshalNoteLinks(string(links))
+	if gedcomXref.Valid {
+		note.GedcomXref = gedcomXref.String
+	}
+	return &note, nil
+}
+
 // SaveNote saves or updates a note.
 func (s *ReadModelStore) SaveNote(ctx context.Context, note *repository.NoteReadModel) error {
 	var translations any
 	if len(note.Translations) > 0 {
 		translations = repository.MarshalNoteTranslations(note.Translations)
 	}
+	var links any
+	if len(note.Links) > 0 {
+		links = repository.MarshalNoteLinks(note.Links)
+	}
 
 	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO notes (id, text, mime, language, translations, gedcom_xref, version, updated_at)
-		VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), $5, NULLIF($6, ''), $7, $8)
+		INSERT INTO notes (id, text, mime, language, translations, gedcom_xref, links, version, updated_at)
+		VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), $5, NULLIF($6, ''), $7, $8, $9)
 		ON CONFLICT (id) DO UPDATE SET
 			text = EXCLUDED.text,
 			mime = EXCLUDED.mime,
 			language = EXCLUDED.language,
 			translations = EXCLUDED.translations,
 			gedcom_xref = EXCLUDED.gedcom_xref,
+			links = EXCLUDED.links,
 			version = EXCLUDED.version,
 			updated_at = EXCLUDED.updated_at
-	`, note.ID, note.Text, note.MIME, note.Language, translations, note.GedcomXref, note.Version, note.UpdatedAt)
+	`, note.ID, note.Text, note.MIME, note.Language, translations, note.GedcomXref, links, note.Version, note.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("save note: %w", err)
 	}
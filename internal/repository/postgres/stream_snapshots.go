@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// StreamSnapshotStore is a PostgreSQL implementation of repository.StreamSnapshotStore.
+type StreamSnapshotStore struct {
+	db *sql.DB
+}
+
+// NewStreamSnapshotStore creates a new PostgreSQL stream snapshot store.
+func NewStreamSnapshotStore(db *sql.DB) (*StreamSnapshotStore, error) {
+	store := &StreamSnapshotStore{db: db}
+	if err := store.createTables(); err != nil {
+		return nil, fmt.Errorf("failed to create tables: %w", err)
+	}
+	return store, nil
+}
+
+// createTables creates the stream_snapshots table if it doesn't exist.
+func (s *StreamSnapshotStore) createTables() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS stream_snapshots (
+			stream_id UUID NOT NULL,
+			stream_type VARCHAR(50) NOT NULL,
+			version BIGINT NOT NULL,
+			is_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+			state JSONB NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (stream_id, version)
+		);
+	`)
+	return err
+}
+
+// SaveStreamSnapshot stores a snapshot, replacing any existing snapshot at the same version.
+func (s *StreamSnapshotStore) SaveStreamSnapshot(ctx context.Context, snapshot *repository.StreamSnapshot) error {
+	state, err := json.Marshal(snapshot.State)
+	if err != nil {
+		return fmt.Errorf("marshal snapshot state: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO stream_snapshots (stream_id, stream_type, version, is_deleted, state, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (stream_id, version) DO UPDATE SET
+			stream_type = EXCLUDED.stream_type,
+			is_deleted = EXCLUDED.is_deleted,
+			state = EXCLUDED.state,
+			created_at = EXCLUDED.created_at
+	`,
+		snapshot.StreamID,
+		snapshot.StreamType,
+		snapshot.Version,
+		snapshot.IsDeleted,
+		state,
+		snapshot.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert stream snapshot: %w", err)
+	}
+	return nil
+}
+
+// GetLatestStreamSnapshot returns the most recent snapshot at or below maxVersion.
+func (s *StreamSnapshotStore) GetLatestStreamSnapshot(ctx context.Context, streamID uuid.UUID, maxVersion int64) (*repository.StreamSnapshot, error) {
+	snapshot := &repository.StreamSnapshot{StreamID: streamID}
+	var state []byte
+
+	err := s.db.QueryRowContext(ctx, `
+		SELECT stream_type, version, is_deleted, state, created_at
+		FROM stream_snapshots
+		WHERE stream_id = $1 AND version <= $2
+		ORDER BY version DESC
+		LIMIT 1
+	`, streamID, maxVersion).Scan(&snapshot.StreamType, &snapshot.Version, &snapshot.IsDeleted, &state, &snapshot.CreatedAt)
+
+	if err == sql.ErrNoRows {
+		return nil, repository.ErrStreamSnapshotNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query stream snapshot: %w", err)
+	}
+
+	if err := json.Unmarshal(state, &snapshot.State); err != nil {
+		return nil, fmt.Errorf("unmarshal snapshot state: %w", err)
+	}
+
+	return snapshot, nil
+}
@@ -2,10 +2,16 @@ package postgres
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
+
+	"github.com/lib/pq"
 )
 
+// pqUniqueViolation is the SQLSTATE code for unique constraint violations.
+const pqUniqueViolation = "23505"
+
 // OpenDB opens a PostgreSQL database connection.
 func OpenDB(connStr string) (*sql.DB, error) {
 	db, err := sql.Open("postgres", connStr)
@@ -13,16 +19,54 @@ func OpenDB(connStr string) (*sql.DB, error) {
 		return nil, fmt.Errorf("open database: %w", err)
 	}
 
-	// Verify connection
+	return configurePool(db)
+}
+
+// configurePool verifies the connection and sizes the connection pool.
+func configurePool(db *sql.DB) (*sql.DB, error) {
 	if err := db.Ping(); err != nil {
 		db.Close()
 		return nil, fmt.Errorf("ping database: %w", err)
 	}
 
-	// Configure connection pool
 	db.SetMaxOpenConns(25)
 	db.SetMaxIdleConns(5)
 	db.SetConnMaxLifetime(5 * time.Minute)
 
 	return db, nil
 }
+
+// OpenSchemaDB opens a PostgreSQL database connection whose tables live in
+// the named schema instead of the default one, creating the schema if it does
+// not exist. This lets one database hold several independent sets of stores.
+func OpenSchemaDB(connStr, schema string) (*sql.DB, error) {
+	db, err := OpenDB(connStr)
+	if err != nil {
+		return nil, err
+	}
+	_, err = db.Exec("CREATE SCHEMA IF NOT EXISTS " + pq.QuoteIdentifier(schema))
+	db.Close()
+	if err != nil {
+		return nil, fmt.Errorf("create schema %s: %w", schema, err)
+	}
+
+	cfg, err := pq.NewConfig(connStr)
+	if err != nil {
+		return nil, fmt.Errorf("parse connection string: %w", err)
+	}
+	if cfg.Runtime == nil {
+		cfg.Runtime = map[string]string{}
+	}
+	cfg.Runtime["search_path"] = pq.QuoteIdentifier(schema)
+	connector, err := pq.NewConnectorConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	return configurePool(sql.OpenDB(connector))
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique constraint violation.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pqUniqueViolation
+}
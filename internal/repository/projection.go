@@ -88,6 +88,8 @@ func (p *Projector) Project(ctx context.Context, event domain.Event, version int
 		return p.projectNameUpdated(ctx, e, version)
 	case domain.NameRemoved:
 		return p.projectNameRemoved(ctx, e, version)
+	case domain.PersonExternalIDAdded:
+		return p.projectPersonExternalIDAdded(ctx, e, version)
 	case domain.PersonMerged:
 		return p.projectPersonMerged(ctx, e, version)
 	case domain.NoteCreated:
@@ -148,14 +150,14 @@ func (p *Projector) projectPersonCreated(ctx context.Context, e domain.PersonCre
 
 	if e.BirthDate != nil {
 		birthDateRaw = e.BirthDate.Raw
-		t := e.BirthDate.ToTime()
+		t := e.BirthDate.SortDate()
 		if !t.IsZero() {
 			birthDateSort = &t
 		}
 	}
 	if e.DeathDate != nil {
 		deathDateRaw = e.DeathDate.Raw
-		t := e.DeathDate.ToTime()
+		t := e.DeathDate.SortDate()
 		if !t.IsZero() {
 			deathDateSort = &t
 		}
@@ -177,6 +179,7 @@ func (p *Projector) projectPersonCreated(ctx context.Context, e domain.PersonCre
 		ResearchStatus: e.ResearchStatus,
 		Version:        version,
 		UpdatedAt:      e.OccurredAt(),
+		CreatedAt:      e.OccurredAt(),
 	}
 
 	return p.readStore.SavePerson(ctx, person)
@@ -212,7 +215,7 @@ func (p *Projector) projectPersonUpdated(ctx context.Context, e domain.PersonUpd
 			if v, ok := value.(string); ok {
 				person.BirthDateRaw = v
 				gd := domain.ParseGenDate(v)
-				t := gd.ToTime()
+				t := gd.SortDate()
 				if !t.IsZero() {
 					person.BirthDateSort = &t
 				} else {
@@ -227,7 +230,7 @@ func (p *Projector) projectPersonUpdated(ctx context.Context, e domain.PersonUpd
 			if v, ok := value.(string); ok {
 				person.DeathDateRaw = v
 				gd := domain.ParseGenDate(v)
-				t := gd.ToTime()
+				t := gd.SortDate()
 				if !t.IsZero() {
 					person.DeathDateSort = &t
 				} else {
@@ -267,7 +270,7 @@ func (p *Projector) projectFamilyCreated(ctx context.Context, e domain.FamilyCre
 
 	if e.MarriageDate != nil {
 		marriageDateRaw = e.MarriageDate.Raw
-		t := e.MarriageDate.ToTime()
+		t := e.MarriageDate.SortDate()
 		if !t.IsZero() {
 			marriageDateSort = &t
 		}
@@ -300,9 +303,11 @@ func (p *Projector) projectFamilyCreated(ctx context.Context, e domain.FamilyCre
 		MarriageDateRaw:   marriageDateRaw,
 		MarriageDateSort:  marriageDateSort,
 		MarriagePlace:     e.MarriagePlace,
+		ResearchStatus:    e.ResearchStatus,
 		ChildCount:        0,
 		Version:           version,
 		UpdatedAt:         e.OccurredAt(),
+		CreatedAt:         e.OccurredAt(),
 	}
 
 	return p.readStore.SaveFamily(ctx, family)
@@ -338,7 +343,7 @@ func (p *Projector) projectFamilyUpdated(ctx context.Context, e domain.FamilyUpd
 			if v, ok := value.(string); ok {
 				family.MarriageDateRaw = v
 				gd := domain.ParseGenDate(v)
-				t := gd.ToTime()
+				t := gd.SortDate()
 				if !t.IsZero() {
 					family.MarriageDateSort = &t
 				} else {
@@ -349,6 +354,10 @@ func (p *Projector) projectFamilyUpdated(ctx context.Context, e domain.FamilyUpd
 			if v, ok := value.(string); ok {
 				family.MarriagePlace = v
 			}
+		case "research_status":
+			if v, ok := value.(string); ok {
+				family.ResearchStatus = domain.ParseResearchStatus(v)
+			}
 		default:
 			slog.Warn("projection: ignoring unknown change key", "event", "FamilyUpdated", "key", key)
 		}
@@ -530,7 +539,7 @@ func (p *Projector) projectSourceCreated(ctx context.Context, e domain.SourceCre
 
 	if e.PublishDate != nil {
 		publishDateRaw = e.PublishDate.Raw
-		t := e.PublishDate.ToTime()
+		t := e.PublishDate.SortDate()
 		if !t.IsZero() {
 			publishDateSort = &t
 		}
@@ -591,7 +600,7 @@ func (p *Projector) projectSourceUpdated(ctx context.Context, e domain.SourceUpd
 			if v, ok := value.(string); ok {
 				source.PublishDateRaw = v
 				gd := domain.ParseGenDate(v)
-				t := gd.ToTime()
+				t := gd.SortDate()
 				if !t.IsZero() {
 					source.PublishDateSort = &t
 				} else {
@@ -815,7 +824,12 @@ func (p *Projector) projectMediaCreated(ctx context.Context, e domain.MediaCreat
 		FileSize:      e.FileSize,
 		FileData:      e.FileData,
 		ThumbnailData: e.ThumbnailData,
+		ContentHash:   e.ContentHash,
+		StorageKey:    e.StorageKey,
 		GedcomXref:    e.GedcomXref,
+		CapturedAt:    e.CapturedAt,
+		GPSLatitude:   e.GPSLatitude,
+		GPSLongitude:  e.GPSLongitude,
 		Version:       version,
 		CreatedAt:     e.OccurredAt(),
 		UpdatedAt:     e.OccurredAt(),
@@ -825,6 +839,28 @@ func (p *Projector) projectMediaCreated(ctx context.Context, e domain.MediaCreat
 		Translations: e.Translations,
 	}
 
+	if len(media.FileData) > 0 {
+		// Events recorded before deduplication carry no hash
+		if media.ContentHash == "" {
+			media.ContentHash = domain.MediaContentHash(media.FileData)
+		}
+	} else if media.ContentHash != "" && media.StorageKey == "" {
+		// Deduplicated upload: link the blob of existing media with the same
+		// content; persistent stores keep a single copy per hash
+		existing, err := p.readStore.FindMediaByContentHash(ctx, media.ContentHash)
+		if err != nil {
+			return fmt.Errorf("find shared content for media %s: %w", e.MediaID, err)
+		}
+		if existing == nil {
+			return fmt.Errorf("no stored content with hash %s for media %s", media.ContentHash, e.MediaID)
+		}
+		media.FileData = existing.FileData
+		media.StorageKey = existing.StorageKey
+		if media.ThumbnailData == nil {
+			media.ThumbnailData = existing.ThumbnailData
+		}
+	}
+
 	return p.readStore.SaveMedia(ctx, media)
 }
 
@@ -901,7 +937,7 @@ func (p *Projector) projectLifeEventCreated(ctx context.Context, e domain.LifeEv
 
 	if e.Date != nil {
 		dateRaw = e.Date.Raw
-		t := e.Date.ToTime()
+		t := e.Date.SortDate()
 		if !t.IsZero() {
 			dateSort = &t
 		}
@@ -919,20 +955,21 @@ func (p *Projector) projectLifeEventCreated(ctx context.Context, e domain.LifeEv
 	}
 
 	event := &EventReadModel{
-		ID:          e.EventID,
-		OwnerType:   ownerType,
-		OwnerID:     ownerID,
-		FactType:    e.FactType,
-		DateRaw:     dateRaw,
-		DateSort:    dateSort,
-		Place:       e.Place,
-		Address:     e.Address,
-		Description: e.Description,
-		Cause:       e.Cause,
-		Age:         e.Age,
-		IsNegated:   e.IsNegated,
-		Version:     version,
-		CreatedAt:   e.OccurredAt(),
+		ID:             e.EventID,
+		OwnerType:      ownerType,
+		OwnerID:        ownerID,
+		FactType:       e.FactType,
+		DateRaw:        dateRaw,
+		DateSort:       dateSort,
+		Place:          e.Place,
+		Address:        e.Address,
+		Description:    e.Description,
+		Cause:          e.Cause,
+		Age:            e.Age,
+		ResearchStatus: e.ResearchStatus,
+		IsNegated:      e.IsNegated,
+		Version:        version,
+		CreatedAt:      e.OccurredAt(),
 	}
 
 	return p.readStore.SaveEvent(ctx, event)
@@ -948,22 +985,23 @@ func (p *Projector) projectAttributeCreated(ctx context.Context, e domain.Attrib
 
 	if e.Date != nil {
 		dateRaw = e.Date.Raw
-		t := e.Date.ToTime()
+		t := e.Date.SortDate()
 		if !t.IsZero() {
 			dateSort = &t
 		}
 	}
 
 	attribute := &AttributeReadModel{
-		ID:        e.AttributeID,
-		PersonID:  e.PersonID,
-		FactType:  e.FactType,
-		Value:     e.Value,
-		DateRaw:   dateRaw,
-		DateSort:  dateSort,
-		Place:     e.Place,
-		Version:   version,
-		CreatedAt: e.OccurredAt(),
+		ID:             e.AttributeID,
+		PersonID:       e.PersonID,
+		FactType:       e.FactType,
+		Value:          e.Value,
+		DateRaw:        dateRaw,
+		DateSort:       dateSort,
+		Place:          e.Place,
+		ResearchStatus: e.ResearchStatus,
+		Version:        version,
+		CreatedAt:      e.OccurredAt(),
 	}
 
 	return p.readStore.SaveAttribute(ctx, attribute)
@@ -992,7 +1030,7 @@ func (p *Projector) projectLifeEventUpdated(ctx context.Context, e domain.LifeEv
 			if v, ok := value.(string); ok {
 				event.DateRaw = v
 				gd := domain.ParseGenDate(v)
-				t := gd.ToTime()
+				t := gd.SortDate()
 				if !t.IsZero() {
 					event.DateSort = &t
 				} else {
@@ -1034,6 +1072,10 @@ func (p *Projector) projectLifeEventUpdated(ctx context.Context, e domain.LifeEv
 			if v, ok := value.(bool); ok {
 				event.IsNegated = v
 			}
+		case "research_status":
+			if v, ok := value.(string); ok {
+				event.ResearchStatus = domain.ParseResearchStatus(v)
+			}
 		default:
 			slog.Warn("projection: ignoring unknown change key", "event", "LifeEventUpdated", "key", key)
 		}
@@ -1067,7 +1109,7 @@ func (p *Projector) projectAttributeUpdated(ctx context.Context, e domain.Attrib
 			if v, ok := value.(string); ok {
 				attribute.DateRaw = v
 				gd := domain.ParseGenDate(v)
-				t := gd.ToTime()
+				t := gd.SortDate()
 				if !t.IsZero() {
 					attribute.DateSort = &t
 				} else {
@@ -1078,6 +1120,10 @@ func (p *Projector) projectAttributeUpdated(ctx context.Context, e domain.Attrib
 			if v, ok := value.(string); ok {
 				attribute.Place = v
 			}
+		case "research_status":
+			if v, ok := value.(string); ok {
+				attribute.ResearchStatus = domain.ParseResearchStatus(v)
+			}
 		default:
 			slog.Warn("projection: ignoring unknown change key", "event", "AttributeUpdated", "key", key)
 		}
@@ -1190,6 +1236,7 @@ func (p *Projector) projectNameAdded(ctx context.Context, e domain.NameAdded, ve
 		NameSuffix:    e.NameSuffix,
 		SurnamePrefix: e.SurnamePrefix,
 		Nickname:      e.Nickname,
+		Romanized:     e.Romanized,
 		NameType:      e.NameType,
 		IsPrimary:     e.IsPrimary,
 		UpdatedAt:     e.OccurredAt(),
@@ -1217,6 +1264,7 @@ func (p *Projector) projectNameUpdated(ctx context.Context, e domain.NameUpdated
 		NameSuffix:    e.NameSuffix,
 		SurnamePrefix: e.SurnamePrefix,
 		Nickname:      e.Nickname,
+		Romanized:     e.Romanized,
 		NameType:      e.NameType,
 		IsPrimary:     e.IsPrimary,
 		UpdatedAt:     e.OccurredAt(),
@@ -1239,6 +1287,25 @@ func (p *Projector) projectNameRemoved(ctx context.Context, e domain.NameRemoved
 	return p.updatePersonVersion(ctx, e.PersonID, version)
 }
 
+func (p *Projector) projectPersonExternalIDAdded(ctx context.Context, e domain.PersonExternalIDAdded, version int64) error {
+	ids, err := p.readStore.GetPersonExternalIDs(ctx, e.PersonID)
+	if err != nil {
+		return err
+	}
+	ids = append(ids, PersonExternalIDReadModel{
+		PersonID: e.PersonID,
+		Sequence: len(ids),
+		Value:    e.Value,
+		Type:     e.Type,
+	})
+	if err := p.readStore.ReplacePersonExternalIDs(ctx, e.PersonID, ids); err != nil {
+		return err
+	}
+
+	// Update person version to stay in sync with event stream
+	return p.updatePersonVersion(ctx, e.PersonID, version)
+}
+
 // updatePersonVersion updates a person's version in the read model.
 func (p *Projector) updatePersonVersion(ctx context.Context, personID uuid.UUID, version int64) error {
 	person, err := p.readStore.GetPerson(ctx, personID)
@@ -1318,7 +1385,7 @@ func (p *Projector) projectPersonMerged(ctx context.Context, e domain.PersonMerg
 			if v, ok := value.(string); ok {
 				survivor.BirthDateRaw = v
 				gd := domain.ParseGenDate(v)
-				t := gd.ToTime()
+				t := gd.SortDate()
 				if !t.IsZero() {
 					survivor.BirthDateSort = &t
 				} else {
@@ -1333,7 +1400,7 @@ func (p *Projector) projectPersonMerged(ctx context.Context, e domain.PersonMerg
 			if v, ok := value.(string); ok {
 				survivor.DeathDateRaw = v
 				gd := domain.ParseGenDate(v)
-				t := gd.ToTime()
+				t := gd.SortDate()
 				if !t.IsZero() {
 					survivor.DeathDateSort = &t
 				} else {
@@ -1579,6 +1646,7 @@ func (p *Projector) projectNoteCreated(ctx context.Context, e domain.NoteCreated
 		Language:     e.Language,
 		Translations: e.Translations,
 		GedcomXref:   e.GedcomXref,
+		Links:        e.Links,
 		Version:      version,
 		UpdatedAt:    e.OccurredAt(),
 	}
@@ -1767,7 +1835,7 @@ func (p *Projector) projectLDSOrdinanceCreated(ctx context.Context, e domain.LDS
 
 	if e.Date != nil {
 		dateRaw = e.Date.Raw
-		t := e.Date.ToTime()
+		t := e.Date.SortDate()
 		if !t.IsZero() {
 			dateSort = &t
 		}
@@ -1816,7 +1884,7 @@ func (p *Projector) projectLDSOrdinanceUpdated(ctx context.Context, e domain.LDS
 			if v, ok := value.(string); ok {
 				ordinance.DateRaw = v
 				gd := domain.ParseGenDate(v)
-				t := gd.ToTime()
+				t := gd.SortDate()
 				if !t.IsZero() {
 					ordinance.DateSort = &t
 				} else {
@@ -41,6 +41,33 @@ func UnmarshalNoteTranslations(data string) []domain.NoteTranslation {
 	return translations
 }
 
+// MarshalNoteLinks serializes a note's entity links for storage in a
+// text/JSON column. It returns an empty string when there are none.
+func MarshalNoteLinks(links []domain.NoteLink) string {
+	if len(links) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(links)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// UnmarshalNoteLinks parses a note's entity links from a text/JSON column.
+// It returns nil for empty input, and logs and returns nil for malformed input.
+func UnmarshalNoteLinks(data string) []domain.NoteLink {
+	if data == "" {
+		return nil
+	}
+	var links []domain.NoteLink
+	if err := json.Unmarshal([]byte(data), &links); err != nil {
+		slog.Warn("failed to unmarshal note links; dropping", "error", err)
+		return nil
+	}
+	return links
+}
+
 // PersonReadModel represents a person in the read model.
 type PersonReadModel struct {
 	ID                  uuid.UUID             `json:"id"`
@@ -65,26 +92,29 @@ type PersonReadModel struct {
 	BrickWallResolvedAt *time.Time            `json:"brick_wall_resolved_at,omitempty"`
 	Version             int64                 `json:"version"`
 	UpdatedAt           time.Time             `json:"updated_at"`
+	CreatedAt           time.Time             `json:"created_at"` // Time of the first event; zero if not yet projected
 }
 
 // FamilyReadModel represents a family in the read model.
 type FamilyReadModel struct {
-	ID                uuid.UUID           `json:"id"`
-	Partner1ID        *uuid.UUID          `json:"partner1_id,omitempty"`
-	Partner1GivenName string              `json:"partner1_given_name,omitempty"`
-	Partner1Surname   string              `json:"partner1_surname,omitempty"`
-	Partner2ID        *uuid.UUID          `json:"partner2_id,omitempty"`
-	Partner2GivenName string              `json:"partner2_given_name,omitempty"`
-	Partner2Surname   string              `json:"partner2_surname,omitempty"`
-	RelationshipType  domain.RelationType `json:"relationship_type,omitempty"`
-	MarriageDateRaw   string              `json:"marriage_date_raw,omitempty"`
-	MarriageDateSort  *time.Time          `json:"marriage_date_sort,omitempty"`
-	MarriagePlace     string              `json:"marriage_place,omitempty"`
-	MarriagePlaceLat  *string             `json:"marriage_place_lat,omitempty"`
-	MarriagePlaceLong *string             `json:"marriage_place_long,omitempty"`
-	ChildCount        int                 `json:"child_count"`
-	Version           int64               `json:"version"`
-	UpdatedAt         time.Time           `json:"updated_at"`
+	ID                uuid.UUID             `json:"id"`
+	Partner1ID        *uuid.UUID            `json:"partner1_id,omitempty"`
+	Partner1GivenName string                `json:"partner1_given_name,omitempty"`
+	Partner1Surname   string                `json:"partner1_surname,omitempty"`
+	Partner2ID        *uuid.UUID            `json:"partner2_id,omitempty"`
+	Partner2GivenName string                `json:"partner2_given_name,omitempty"`
+	Partner2Surname   string                `json:"partner2_surname,omitempty"`
+	RelationshipType  domain.RelationType   `json:"relationship_type,omitempty"`
+	MarriageDateRaw   string                `json:"marriage_date_raw,omitempty"`
+	MarriageDateSort  *time.Time            `json:"marriage_date_sort,omitempty"`
+	MarriagePlace     string                `json:"marriage_place,omitempty"`
+	MarriagePlaceLat  *string               `json:"marriage_place_lat,omitempty"`
+	MarriagePlaceLong *string               `json:"marriage_place_long,omitempty"`
+	ResearchStatus    domain.ResearchStatus `json:"research_status,omitempty"` // Confidence level
+	ChildCount        int                   `json:"child_count"`
+	Version           int64                 `json:"version"`
+	UpdatedAt         time.Time             `json:"updated_at"`
+	CreatedAt         time.Time             `json:"created_at"` // Time of the first event; zero if not yet projected
 }
 
 // FamilyChildReadModel represents a child in a family.
@@ -161,6 +191,8 @@ type MediaReadModel struct {
 	FileSize      int64            `json:"file_size"`
 	FileData      []byte           `json:"-"` // Excluded from JSON by default
 	ThumbnailData []byte           `json:"-"` // Excluded from JSON by default
+	ContentHash   string           `json:"content_hash,omitempty"`
+	StorageKey    string           `json:"-"` // Key in the external blob store; FileData is empty when set
 	CropLeft      *int             `json:"crop_left,omitempty"`
 	CropTop       *int             `json:"crop_top,omitempty"`
 	CropWidth     *int             `json:"crop_width,omitempty"`
@@ -169,12 +201,23 @@ type MediaReadModel struct {
 	Version       int64            `json:"version"`
 	CreatedAt     time.Time        `json:"created_at"`
 	UpdatedAt     time.Time        `json:"updated_at"`
+	// EXIF metadata extracted from uploaded photos
+	CapturedAt   *time.Time `json:"captured_at,omitempty"`
+	GPSLatitude  *float64   `json:"gps_latitude,omitempty"`
+	GPSLongitude *float64   `json:"gps_longitude,omitempty"`
 	// GEDCOM 7.0 enhanced fields
 	Files        []domain.MediaFile `json:"files,omitempty"`        // Multiple file references (GEDCOM 7.0)
 	Format       string             `json:"format,omitempty"`       // Primary format/MIME type (FORM)
 	Translations []string           `json:"translations,omitempty"` // Translated titles (GEDCOM 7.0)
 }
 
+// MediaDuplicateGroup is a set of media records sharing identical file content.
+type MediaDuplicateGroup struct {
+	ContentHash string           `json:"content_hash"`
+	FileSize    int64            `json:"file_size"`
+	Media       []MediaReadModel `json:"media"` // Metadata only, oldest first
+}
+
 // EventReadModel represents a life event in the read model.
 type EventReadModel struct {
 	ID             uuid.UUID             `json:"id"`
@@ -198,15 +241,16 @@ type EventReadModel struct {
 
 // AttributeReadModel represents a person attribute in the read model.
 type AttributeReadModel struct {
-	ID        uuid.UUID       `json:"id"`
-	PersonID  uuid.UUID       `json:"person_id"`
-	FactType  domain.FactType `json:"fact_type"`
-	Value     string          `json:"value"`
-	DateRaw   string          `json:"date_raw,omitempty"`
-	DateSort  *time.Time      `json:"date_sort,omitempty"`
-	Place     string          `json:"place,omitempty"`
-	Version   int64           `json:"version"`
-	CreatedAt time.Time       `json:"created_at"`
+	ID             uuid.UUID             `json:"id"`
+	PersonID       uuid.UUID             `json:"person_id"`
+	FactType       domain.FactType       `json:"fact_type"`
+	Value          string                `json:"value"`
+	DateRaw        string                `json:"date_raw,omitempty"`
+	DateSort       *time.Time            `json:"date_sort,omitempty"`
+	Place          string                `json:"place,omitempty"`
+	ResearchStatus domain.ResearchStatus `json:"research_status,omitempty"` // Confidence level
+	Version        int64                 `json:"version"`
+	CreatedAt      time.Time             `json:"created_at"`
 }
 
 // NoteReadModel represents a shared GEDCOM note in the read model.
@@ -220,6 +264,7 @@ type NoteReadModel struct {
 	Language     string                   `json:"language,omitempty"`     // GEDCOM 7.0 SNOTE BCP 47 language tag (e.g., "en")
 	Translations []domain.NoteTranslation `json:"translations,omitempty"` // GEDCOM 7.0 SNOTE alternate-language renderings
 	GedcomXref   string                   `json:"gedcom_xref,omitempty"`  // GEDCOM cross-reference ID (e.g., "@N1@")
+	Links        []domain.NoteLink        `json:"links,omitempty"`        // Persons, families, and sources the note is attached to
 	Version      int64                    `json:"version"`
 	UpdatedAt    time.Time                `json:"updated_at"`
 }
@@ -367,6 +412,7 @@ type ReadModelStore interface {
 	GetFamily(ctx context.Context, id uuid.UUID) (*FamilyReadModel, error)
 	ListFamilies(ctx context.Context, opts ListOptions) ([]FamilyReadModel, int, error)
 	GetFamiliesForPerson(ctx context.Context, personID uuid.UUID) ([]FamilyReadModel, error)
+	SearchFamilies(ctx context.Context, query string, limit int) ([]FamilyReadModel, error)
 	SaveFamily(ctx context.Context, family *FamilyReadModel) error
 	DeleteFamily(ctx context.Context, id uuid.UUID) error
 
@@ -401,6 +447,9 @@ type ReadModelStore interface {
 	GetCitation(ctx context.Context, id uuid.UUID) (*CitationReadModel, error)
 	ListCitations(ctx context.Context, opts ListOptions) ([]CitationReadModel, int, error)
 	GetCitationsForSource(ctx context.Context, sourceID uuid.UUID) ([]CitationReadModel, error)
+	// ListCitationsForSource pages through a source's citations matching
+	// filter, in the order they were created
+	ListCitationsForSource(ctx context.Context, sourceID uuid.UUID, filter CitationFilter, opts ListOptions) ([]CitationReadModel, int, error)
 	GetCitationsForPerson(ctx context.Context, personID uuid.UUID) ([]CitationReadModel, error)
 	GetCitationsForFact(ctx context.Context, factType domain.FactType, factOwnerID uuid.UUID) ([]CitationReadModel, error)
 	SaveCitation(ctx context.Context, citation *CitationReadModel) error
@@ -408,10 +457,12 @@ type ReadModelStore interface {
 
 	// Media operations
 	GetMedia(ctx context.Context, id uuid.UUID) (*MediaReadModel, error)
-	GetMediaWithData(ctx context.Context, id uuid.UUID) (*MediaReadModel, error) // Includes FileData
+	GetMediaWithData(ctx context.Context, id uuid.UUID) (*MediaReadModel, error) // Includes FileData unless held in a blob store
 	GetMediaThumbnail(ctx context.Context, id uuid.UUID) ([]byte, error)
 	ListMediaForEntity(ctx context.Context, entityType string, entityID uuid.UUID, opts ListOptions) ([]MediaReadModel, int, error)
 	SaveMedia(ctx context.Context, media *MediaReadModel) error
+	FindMediaByContentHash(ctx context.Context, hash string) (*MediaReadModel, error) // Includes FileData
+	ListDuplicateMedia(ctx context.Context) ([]MediaDuplicateGroup, error)
 	DeleteMedia(ctx context.Context, id uuid.UUID) error
 
 	// Event operations
@@ -432,6 +483,7 @@ type ReadModelStore interface {
 	// Note operations
 	GetNote(ctx context.Context, id uuid.UUID) (*NoteReadModel, error)
 	ListNotes(ctx context.Context, opts ListOptions) ([]NoteReadModel, int, error)
+	ListNotesForEntity(ctx context.Context, entityID uuid.UUID) ([]NoteReadModel, error)
 	SaveNote(ctx context.Context, note *NoteReadModel) error
 	DeleteNote(ctx context.Context, id uuid.UUID) error
 
@@ -505,10 +557,21 @@ type ReadModelStore interface {
 	GetPersonsByPlace(ctx context.Context, place string, opts ListOptions) ([]PersonReadModel, int, error)
 	GetCemeteryIndex(ctx context.Context) ([]CemeteryEntry, error)
 	GetPersonsByCemetery(ctx context.Context, place string, opts ListOptions) ([]PersonReadModel, int, error)
+	GetBirthDecadeIndex(ctx context.Context) ([]BirthDecadeEntry, error)
+	GetSourceRepositoryIndex(ctx context.Context) ([]SourceRepositoryEntry, error)
+	GetSourcesByRepositoryName(ctx context.Context, name string, opts ListOptions) ([]SourceReadModel, int, error)
+	GetPersonsByBirthDecade(ctx context.Context, decade *int, opts ListOptions) ([]PersonReadModel, int, error)
 
 	// Map operations
 	GetMapLocations(ctx context.Context) ([]MapLocation, error)
 
+	// Fact type operations
+	ListFactTypeUsage(ctx context.Context) ([]FactTypeUsage, error)
+
+	// Place operations
+	ListPlaceUsage(ctx context.Context) ([]PlaceUsage, error)
+	FindPlaceReferences(ctx context.Context, place string) ([]PlaceReference, error)
+
 	// Brick wall operations
 	SetBrickWall(ctx context.Context, personID uuid.UUID, note string) error
 	ResolveBrickWall(ctx context.Context, personID uuid.UUID) error
@@ -533,6 +596,25 @@ type CemeteryEntry struct {
 	Count int    `json:"count"`
 }
 
+// BirthDecadeEntry represents a birth decade with person count.
+// Decade is the first year of the decade (1850 for the 1850s), or nil for
+// persons without a parseable birth date.
+type BirthDecadeEntry struct {
+	Decade *int `json:"decade"`
+	Count  int  `json:"count"`
+}
+
+// SourceRepositoryEntry represents a source repository name with source count.
+type SourceRepositoryEntry struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// BirthDecade returns the decade containing t, e.g. 1850 for 1857.
+func BirthDecade(t time.Time) int {
+	return t.Year() / 10 * 10
+}
+
 // MapLocation represents an aggregated geographic location for map display.
 type MapLocation struct {
 	Place     string      `json:"place"`
@@ -543,6 +625,36 @@ type MapLocation struct {
 	PersonIDs []uuid.UUID `json:"person_ids"`
 }
 
+// PlaceUsage represents a distinct place string with the number of person,
+// family, and event fields that reference it.
+type PlaceUsage struct {
+	Place string `json:"place"`
+	Count int    `json:"count"`
+}
+
+// FactTypeUsage is a fact type present in the data, with the number of life
+// events, attributes, and citations that use it.
+type FactTypeUsage struct {
+	FactType       domain.FactType `json:"fact_type"`
+	EventCount     int             `json:"event_count"`
+	AttributeCount int             `json:"attribute_count"`
+	CitationCount  int             `json:"citation_count"`
+}
+
+// Place reference entity types.
+const (
+	PlaceRefPerson = "person"
+	PlaceRefFamily = "family"
+	PlaceRefEvent  = "event"
+)
+
+// PlaceReference identifies a record field holding a place string.
+type PlaceReference struct {
+	EntityType string    `json:"entity_type"` // PlaceRefPerson, PlaceRefFamily, or PlaceRefEvent
+	EntityID   uuid.UUID `json:"entity_id"`
+	Field      string    `json:"field"` // birth_place, death_place, marriage_place, or place
+}
+
 // BrickWallEntry represents a person with a brick wall status for browsing.
 type BrickWallEntry struct {
 	PersonID   uuid.UUID  `json:"person_id"`
@@ -571,6 +683,7 @@ type PersonNameReadModel struct {
 	NameSuffix    string          `json:"name_suffix,omitempty"`
 	SurnamePrefix string          `json:"surname_prefix,omitempty"`
 	Nickname      string          `json:"nickname,omitempty"`
+	Romanized     string          `json:"romanized,omitempty"` // Searchable alongside the native-script name
 	NameType      domain.NameType `json:"name_type"`
 	IsPrimary     bool            `json:"is_primary"`
 	UpdatedAt     time.Time       `json:"updated_at"`
@@ -578,9 +691,9 @@ type PersonNameReadModel struct {
 
 // PersonExternalIDReadModel represents a single GEDCOM 7.0 external identifier
 // (EXID) attached to a person. External identifiers link a person to a record in
-// an external system (FamilySearch, Find a Grave, etc.). They are GEDCOM metadata
-// captured on import and re-emitted on export, so — like place coordinates — they
-// live only in the read model and are not part of the event schema.
+// an external system (FamilySearch, Find a Grave, etc.). Identifiers captured on
+// import live only in the read model, like place coordinates; identifiers added
+// afterwards arrive through PersonExternalIDAdded events.
 type PersonExternalIDReadModel struct {
 	PersonID uuid.UUID `json:"person_id"`
 	// Sequence preserves the order in which identifiers appeared in the source
@@ -633,6 +746,27 @@ type ListOptions struct {
 	Sort           string
 	Order          string  // "asc" or "desc"
 	ResearchStatus *string // Filter by research_status: certain, probable, possible, unknown, or "unset" for NULL
+	Surname        string  // Filter by exact surname, case-insensitive
+	Gender         string  // Filter by gender: male, female, nonbinary, unknown
+	Unlinked       bool    // Only persons who are neither a partner nor a child in any family
+
+	// IgnoreSurnamePrefix sorts by surname without leading particles, so
+	// "van Gogh" sorts under G (see domain.SurnameSortKey)
+	IgnoreSurnamePrefix bool
+}
+
+// CitationFilter restricts a citation listing. Empty fields match any value.
+type CitationFilter struct {
+	FactType      domain.FactType
+	SourceQuality domain.SourceQuality
+	EvidenceType  domain.EvidenceType
+}
+
+// Matches reports whether c passes the filter.
+func (f CitationFilter) Matches(c CitationReadModel) bool {
+	return (f.FactType == "" || c.FactType == f.FactType) &&
+		(f.SourceQuality == "" || c.SourceQuality == f.SourceQuality) &&
+		(f.EvidenceType == "" || c.EvidenceType == f.EvidenceType)
 }
 
 // SearchOptions contains options for advanced person search.
@@ -640,14 +774,19 @@ type SearchOptions struct {
 	Query         string
 	Fuzzy         bool
 	Soundex       bool
+	Metaphone     bool // match query words against the Metaphone key of surnames
+	Wildcard      bool // Query is a pattern where * matches any run of characters (see WildcardMatch)
 	BirthDateFrom *time.Time
 	BirthDateTo   *time.Time
 	DeathDateFrom *time.Time
 	DeathDateTo   *time.Time
 	BirthPlace    string
 	DeathPlace    string
-	Sort          string // "relevance", "name", "birth_date", "death_date"
-	Order         string // "asc", "desc"
+	GivenName     string        // partial, case-insensitive match on the primary given name
+	Surname       string        // partial, case-insensitive match on the primary surname
+	Gender        domain.Gender // exact match
+	Sort          string        // "relevance", "name", "birth_date", "death_date"
+	Order         string        // "asc", "desc"
 	Limit         int
 }
 
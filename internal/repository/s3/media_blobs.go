@@ -0,0 +1,206 @@
+// Package s3 provides a media blob store backed by S3-compatible object storage.
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// Config locates an S3-compatible bucket and the credentials used to access it.
+type Config struct {
+	Endpoint        string // Base URL, e.g. https://s3.us-east-1.amazonaws.com or http://minio:9000
+	Bucket          string
+	Region          string // Signing region (default: us-east-1)
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// MediaBlobStore stores media blobs as objects in an S3-compatible bucket,
+// addressed path-style (endpoint/bucket/key) so it also works with MinIO and
+// other self-hosted services. Requests are signed with AWS Signature Version 4.
+type MediaBlobStore struct {
+	cfg      Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewMediaBlobStore creates an S3 blob store from cfg.
+func NewMediaBlobStore(cfg Config) (*MediaBlobStore, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, errors.New("s3 media storage requires an endpoint and bucket")
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.Endpoint)
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	return &MediaBlobStore{
+		cfg:      cfg,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 5 * time.Minute},
+		now:      time.Now,
+	}, nil
+}
+
+// PutBlob uploads data as the object key.
+func (s *MediaBlobStore) PutBlob(ctx context.Context, key string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return statusError("put", key, resp)
+	}
+	return nil
+}
+
+// GetBlob downloads the object key.
+func (s *MediaBlobStore) GetBlob(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("read s3 object %s: %w", key, err)
+		}
+		return data, nil
+	case http.StatusNotFound:
+		return nil, repository.ErrMediaBlobNotFound
+	default:
+		return nil, statusError("get", key, resp)
+	}
+}
+
+// DeleteBlob removes the object key. S3 reports success for missing objects.
+func (s *MediaBlobStore) DeleteBlob(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return statusError("delete", key, resp)
+	}
+}
+
+// do sends a signed request for the object key.
+func (s *MediaBlobStore) do(ctx context.Context, method, key string, body []byte) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = u.Path + "/" + s.cfg.Bucket + "/" + key
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build s3 request: %w", err)
+	}
+	req.ContentLength = int64(len(body))
+
+	sum := sha256.Sum256(body)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(sum[:]))
+	signV4(req, s.cfg, s.now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 %s %s: %w", strings.ToLower(method), key, err)
+	}
+	return resp, nil
+}
+
+// statusError describes an unexpected response, including the start of the
+// S3 error document.
+func statusError(op, key string, resp *http.Response) error {
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("s3 %s %s: %s: %s", op, key, resp.Status, strings.TrimSpace(string(detail)))
+}
+
+// signV4 adds AWS Signature Version 4 headers to req. The payload hash must
+// already be set in the X-Amz-Content-Sha256 header; every header present on
+// the request is signed along with Host.
+func signV4(req *http.Request, cfg Config, t time.Time) {
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncodePath(req.URL.Path),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		req.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+
+	scope := date + "/" + cfg.Region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+cfg.SecretAccessKey), date)
+	key = hmacSHA256(key, cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		cfg.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// uriEncodePath percent-encodes a path as SigV4 requires: everything except
+// unreserved characters and the segment separator.
+func uriEncodePath(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -40,9 +40,12 @@ func (s *ReadModelStore) createTables() error {
 			given_name TEXT NOT NULL,
 			surname TEXT NOT NULL,
 			full_name TEXT GENERATED ALWAYS AS (given_name || ' ' || surname) STORED,
+			surname_metaphone TEXT,
+			surname_sort TEXT,
 			gender TEXT,
 			birth_date_raw TEXT,
 			birth_date_sort TEXT,
+			birth_date_rank INTEGER,
 			birth_place TEXT,
 			death_date_raw TEXT,
 			death_date_sort TEXT,
@@ -71,6 +74,7 @@ func (s *ReadModelStore) createTables() error {
 			marriage_date_raw TEXT,
 			marriage_date_sort TEXT,
 			marriage_place TEXT,
+			research_status TEXT,
 			child_count INTEGER NOT NULL DEFAULT 0,
 			version INTEGER NOT NULL DEFAULT 1,
 			updated_at TEXT NOT NULL DEFAULT (datetime('now')),
@@ -174,6 +178,8 @@ func (s *ReadModelStore) createTables() error {
 			file_size INTEGER NOT NULL,
 			file_data BLOB NOT NULL,
 			thumbnail_data BLOB,
+			content_hash TEXT,
+			storage_key TEXT,   -- Key in the external blob store; file_data is empty when set
 			crop_left INTEGER,
 			crop_top INTEGER,
 			crop_width INTEGER,
@@ -185,12 +191,23 @@ func (s *ReadModelStore) createTables() error {
 			-- GEDCOM 7.0 enhanced fields
 			files TEXT,        -- JSON array of file references
 			format TEXT,       -- Primary format/MIME type
-			translations TEXT  -- JSON array of translated titles
+			translations TEXT, -- JSON array of translated titles
+			-- EXIF metadata extracted from uploaded photos
+			captured_at TEXT,
+			gps_latitude REAL,
+			gps_longitude REAL
 		);
 
 		CREATE INDEX IF NOT EXISTS idx_media_entity ON media(entity_type, entity_id);
 		CREATE INDEX IF NOT EXISTS idx_media_type ON media(media_type);
 
+		-- Media content, stored once per content hash and shared by every media
+		-- row with that hash
+		CREATE TABLE IF NOT EXISTS media_content (
+			content_hash TEXT PRIMARY KEY,
+			file_data BLOB NOT NULL
+		);
+
 		-- Person names table (for multiple name variants)
 		CREATE TABLE IF NOT EXISTS person_names (
 			id TEXT PRIMARY KEY,
@@ -198,10 +215,12 @@ func (s *ReadModelStore) createTables() error {
 			given_name TEXT NOT NULL,
 			surname TEXT NOT NULL,
 			full_name TEXT GENERATED ALWAYS AS (given_name || ' ' || surname) STORED,
+			surname_metaphone TEXT,
 			name_prefix TEXT,
 			name_suffix TEXT,
 			surname_prefix TEXT,
 			nickname TEXT,
+			romanized TEXT,
 			name_type TEXT NOT NULL DEFAULT '',
 			is_primary INTEGER NOT NULL DEFAULT 0,
 			updated_at TEXT NOT NULL DEFAULT (datetime('now')),
@@ -231,6 +250,7 @@ func (s *ReadModelStore) createTables() error {
 			language TEXT,
 			translations TEXT,
 			gedcom_xref TEXT,
+			links TEXT,
 			version INTEGER NOT NULL DEFAULT 1,
 			updated_at TEXT NOT NULL DEFAULT (datetime('now'))
 		);
@@ -325,7 +345,7 @@ func (s *ReadModelStore) createTables() error {
 		CREATE INDEX IF NOT EXISTS idx_associations_role ON associations(role);
 
 		-- Events table (life events for persons and families)
-		CREATE TABLE IF NOT EXISTS events (
+		CREATE TABLE IF NOT EXISTS life_events (
 			id TEXT PRIMARY KEY,
 			owner_type TEXT NOT NULL,
 			owner_id TEXT NOT NULL,
@@ -345,8 +365,8 @@ func (s *ReadModelStore) createTables() error {
 			created_at TEXT NOT NULL DEFAULT (datetime('now'))
 		);
 
-		CREATE INDEX IF NOT EXISTS idx_events_owner ON events(owner_type, owner_id);
-		CREATE INDEX IF NOT EXISTS idx_events_fact_type ON events(fact_type);
+		CREATE INDEX IF NOT EXISTS idx_life_events_owner ON life_events(owner_type, owner_id);
+		CREATE INDEX IF NOT EXISTS idx_life_events_fact_type ON life_events(fact_type);
 
 		-- Attributes table (person attributes)
 		CREATE TABLE IF NOT EXISTS attributes (
@@ -357,6 +377,7 @@ func (s *ReadModelStore) createTables() error {
 			date_raw TEXT,
 			date_sort TEXT,
 			place TEXT,
+			research_status TEXT,
 			version INTEGER NOT NULL DEFAULT 1,
 			created_at TEXT NOT NULL DEFAULT (datetime('now')),
 			FOREIGN KEY (person_id) REFERENCES persons(id)
@@ -496,6 +517,7 @@ func (s *ReadModelStore) runMigrations() {
 	_, _ = s.db.Exec(`ALTER TABLE notes ADD COLUMN mime TEXT`)
 	_, _ = s.db.Exec(`ALTER TABLE notes ADD COLUMN language TEXT`)
 	_, _ = s.db.Exec(`ALTER TABLE notes ADD COLUMN translations TEXT`)
+	_, _ = s.db.Exec(`ALTER TABLE notes ADD COLUMN links TEXT`)
 
 	// Split family partner names and family-child names into given_name / surname (issue #483).
 	// SQLite ALTER TABLE ADD COLUMN has no IF NOT EXISTS; rely on the existing _,_ = swallowing.
@@ -533,6 +555,193 @@ func (s *ReadModelStore) runMigrations() {
 
 	// Add repository_id to sources for ID-based source→repository linkage (issue #525).
 	_, _ = s.db.Exec(`ALTER TABLE sources ADD COLUMN repository_id TEXT`)
+
+	// Add Metaphone keys for phonetic surname search. Keys are computed in Go,
+	// so existing rows are backfilled here rather than in SQL.
+	_, _ = s.db.Exec(`ALTER TABLE persons ADD COLUMN surname_metaphone TEXT`)
+	_, _ = s.db.Exec(`ALTER TABLE person_names ADD COLUMN surname_metaphone TEXT`)
+	_, _ = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_persons_surname_metaphone ON persons(surname_metaphone)`)
+	_, _ = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_person_names_surname_metaphone ON person_names(surname_metaphone)`)
+	s.backfillSurnameColumn("persons", "surname_metaphone", repository.Metaphone)
+	s.backfillSurnameColumn("person_names", "surname_metaphone", repository.Metaphone)
+
+	// Add the particle-free surname used for prefix-aware sorting ("van Gogh"
+	// under G). Computed in Go like the Metaphone keys.
+	_, _ = s.db.Exec(`ALTER TABLE persons ADD COLUMN surname_sort TEXT`)
+	_, _ = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_persons_surname_sort ON persons(surname_sort, given_name)`)
+	s.backfillSurnameColumn("persons", "surname_sort", domain.SurnameSortKey)
+
+	// Add a tie-break rank so birth dates of mixed precision sort deterministically.
+	// Ranks are computed in Go, so existing rows are backfilled here.
+	_, _ = s.db.Exec(`ALTER TABLE persons ADD COLUMN birth_date_rank INTEGER`)
+	s.backfillBirthDateRank()
+
+	// Add EXIF capture date and GPS position to media.
+	_, _ = s.db.Exec(`ALTER TABLE media ADD COLUMN captured_at TEXT`)
+	_, _ = s.db.Exec(`ALTER TABLE media ADD COLUMN gps_latitude REAL`)
+	_, _ = s.db.Exec(`ALTER TABLE media ADD COLUMN gps_longitude REAL`)
+
+	// Add content hashes for media deduplication. Hashes are computed in Go, so
+	// existing rows are backfilled here.
+	_, _ = s.db.Exec(`ALTER TABLE media ADD COLUMN content_hash TEXT`)
+	_, _ = s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_media_content_hash ON media(content_hash)`)
+
+	// Add storage keys for media content held in an external blob store.
+	_, _ = s.db.Exec(`ALTER TABLE media ADD COLUMN storage_key TEXT`)
+	s.backfillMediaContentHash()
+	s.moveMediaContent()
+
+	// Add creation timestamps, taken from each entity's first event. Rows
+	// projected before this column existed stay NULL until a rebuild.
+	_, _ = s.db.Exec(`ALTER TABLE persons ADD COLUMN created_at TEXT`)
+	_, _ = s.db.Exec(`ALTER TABLE families ADD COLUMN created_at TEXT`)
+
+	// Add romanized name forms, searched alongside the native script.
+	_, _ = s.db.Exec(`ALTER TABLE person_names ADD COLUMN romanized TEXT`)
+	s.upgradePersonNamesFTS()
+
+	// Add research_status to families and attributes; events already carry it.
+	_, _ = s.db.Exec(`ALTER TABLE families ADD COLUMN research_status TEXT`)
+	_, _ = s.db.Exec(`ALTER TABLE attributes ADD COLUMN research_status TEXT`)
+}
+
+// backfillMediaContentHash computes content_hash for media saved before the
+// column existed. Idempotent via the IS NULL guard; errors are ignored like the
+// other migrations.
+func (s *ReadModelStore) backfillMediaContentHash() {
+	rows, err := s.db.Query(`SELECT id, file_data FROM media WHERE content_hash IS NULL AND length(file_data) > 0`)
+	if err != nil {
+		return
+	}
+	hashes := make(map[string]string)
+	for rows.Next() {
+		var id string
+		var data []byte
+		if err := rows.Scan(&id, &data); err != nil {
+			rows.Close()
+			return
+		}
+		hashes[id] = domain.MediaContentHash(data)
+	}
+	rows.Close()
+	if len(hashes) == 0 {
+		return
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return
+	}
+	for id, hash := range hashes {
+		if _, err := tx.Exec(`UPDATE media SET content_hash = ? WHERE id = ?`, hash, id); err != nil {
+			_ = tx.Rollback()
+			return
+		}
+	}
+	_ = tx.Commit()
+}
+
+// moveMediaContent moves file data held inline on hashed media rows into
+// media_content, so rows sharing a hash keep a single copy. Idempotent via the
+// length guard; errors are ignored like the other migrations.
+func (s *ReadModelStore) moveMediaContent() {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return
+	}
+	if _, err := tx.Exec(`
+		INSERT OR IGNORE INTO media_content (content_hash, file_data)
+		SELECT content_hash, file_data FROM media
+		WHERE content_hash IS NOT NULL AND length(file_data) > 0
+	`); err != nil {
+		_ = tx.Rollback()
+		return
+	}
+	if _, err := tx.Exec(`
+		UPDATE media SET file_data = X''
+		WHERE content_hash IS NOT NULL AND length(file_data) > 0
+	`); err != nil {
+		_ = tx.Rollback()
+		return
+	}
+	_ = tx.Commit()
+}
+
+// backfillBirthDateRank computes birth_date_rank for rows saved before the
+// column existed. Idempotent via the IS NULL guard; errors are ignored like the
+// other migrations.
+func (s *ReadModelStore) backfillBirthDateRank() {
+	rows, err := s.db.Query(`SELECT id, COALESCE(birth_date_raw, '') FROM persons WHERE birth_date_rank IS NULL`)
+	if err != nil {
+		return
+	}
+	ranks := make(map[string]int)
+	for rows.Next() {
+		var id, raw string
+		if err := rows.Scan(&id, &raw); err != nil {
+			rows.Close()
+			return
+		}
+		ranks[id] = birthDateRank(raw)
+	}
+	rows.Close()
+	if len(ranks) == 0 {
+		return
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return
+	}
+	for id, rank := range ranks {
+		if _, err := tx.Exec(`UPDATE persons SET birth_date_rank = ? WHERE id = ?`, rank, id); err != nil {
+			_ = tx.Rollback()
+			return
+		}
+	}
+	_ = tx.Commit()
+}
+
+// birthDateRank returns the GenDate sort rank used to order birth dates that
+// resolve to the same birth_date_sort.
+func birthDateRank(raw string) int {
+	gd := domain.ParseGenDate(raw)
+	return gd.SortRank()
+}
+
+// backfillSurnameColumn computes a surname-derived column for rows saved before
+// the column existed. Idempotent via the IS NULL guard; errors are ignored like
+// the other migrations.
+func (s *ReadModelStore) backfillSurnameColumn(table, column string, key func(string) string) {
+	rows, err := s.db.Query(`SELECT id, surname FROM ` + table + ` WHERE ` + column + ` IS NULL`)
+	if err != nil {
+		return
+	}
+	keys := make(map[string]string)
+	for rows.Next() {
+		var id, surname string
+		if err := rows.Scan(&id, &surname); err != nil {
+			rows.Close()
+			return
+		}
+		keys[id] = key(surname)
+	}
+	rows.Close()
+	if len(keys) == 0 {
+		return
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return
+	}
+	for id, value := range keys {
+		if _, err := tx.Exec(`UPDATE `+table+` SET `+column+` = ? WHERE id = ?`, value, id); err != nil {
+			_ = tx.Rollback()
+			return
+		}
+	}
+	_ = tx.Commit()
 }
 
 // tryCreateFTS5 attempts to create FTS5 virtual table for full-text search.
@@ -576,44 +785,67 @@ func (s *ReadModelStore) tryCreateFTS5() {
 		END
 	`)
 
-	// Create FTS5 virtual table for person_names
+	s.createPersonNamesFTS()
+}
+
+// createPersonNamesFTS creates the FTS5 index over name variants and the
+// triggers that keep it in sync with person_names.
+func (s *ReadModelStore) createPersonNamesFTS() {
 	_, _ = s.db.Exec(`
 		CREATE VIRTUAL TABLE IF NOT EXISTS person_names_fts USING fts5(
 			given_name,
 			surname,
 			nickname,
+			romanized,
 			content='person_names',
 			content_rowid='rowid'
 		)
 	`)
 
-	// Create triggers for person_names FTS
 	_, _ = s.db.Exec(`
 		CREATE TRIGGER IF NOT EXISTS person_names_fts_insert AFTER INSERT ON person_names BEGIN
-			INSERT INTO person_names_fts(rowid, given_name, surname, nickname)
-			SELECT rowid, NEW.given_name, NEW.surname, COALESCE(NEW.nickname, '')
+			INSERT INTO person_names_fts(rowid, given_name, surname, nickname, romanized)
+			SELECT rowid, NEW.given_name, NEW.surname, COALESCE(NEW.nickname, ''), COALESCE(NEW.romanized, '')
 			FROM person_names WHERE id = NEW.id;
 		END
 	`)
 
 	_, _ = s.db.Exec(`
 		CREATE TRIGGER IF NOT EXISTS person_names_fts_delete AFTER DELETE ON person_names BEGIN
-			INSERT INTO person_names_fts(person_names_fts, rowid, given_name, surname, nickname)
-			VALUES('delete', OLD.rowid, OLD.given_name, OLD.surname, COALESCE(OLD.nickname, ''));
+			INSERT INTO person_names_fts(person_names_fts, rowid, given_name, surname, nickname, romanized)
+			VALUES('delete', OLD.rowid, OLD.given_name, OLD.surname, COALESCE(OLD.nickname, ''), COALESCE(OLD.romanized, ''));
 		END
 	`)
 
 	_, _ = s.db.Exec(`
 		CREATE TRIGGER IF NOT EXISTS person_names_fts_update AFTER UPDATE ON person_names BEGIN
-			INSERT INTO person_names_fts(person_names_fts, rowid, given_name, surname, nickname)
-			VALUES('delete', OLD.rowid, OLD.given_name, OLD.surname, COALESCE(OLD.nickname, ''));
-			INSERT INTO person_names_fts(rowid, given_name, surname, nickname)
-			SELECT rowid, NEW.given_name, NEW.surname, COALESCE(NEW.nickname, '')
+			INSERT INTO person_names_fts(person_names_fts, rowid, given_name, surname, nickname, romanized)
+			VALUES('delete', OLD.rowid, OLD.given_name, OLD.surname, COALESCE(OLD.nickname, ''), COALESCE(OLD.romanized, ''));
+			INSERT INTO person_names_fts(rowid, given_name, surname, nickname, romanized)
+			SELECT rowid, NEW.given_name, NEW.surname, COALESCE(NEW.nickname, ''), COALESCE(NEW.romanized, '')
 			FROM person_names WHERE id = NEW.id;
 		END
 	`)
 }
 
+// upgradePersonNamesFTS recreates a name index built before the romanized
+// column existed and repopulates it from person_names. A no-op when FTS5 is
+// unavailable or the index is already current.
+func (s *ReadModelStore) upgradePersonNamesFTS() {
+	if _, err := s.db.Exec(`SELECT 1 FROM person_names_fts LIMIT 0`); err != nil {
+		return
+	}
+	if _, err := s.db.Exec(`SELECT romanized FROM person_names_fts LIMIT 0`); err == nil {
+		return
+	}
+	for _, trigger := range []string{"person_names_fts_insert", "person_names_fts_delete", "person_names_fts_update"} {
+		_, _ = s.db.Exec(`DROP TRIGGER IF EXISTS ` + trigger)
+	}
+	_, _ = s.db.Exec(`DROP TABLE IF EXISTS person_names_fts`)
+	s.createPersonNamesFTS()
+	_, _ = s.db.Exec(`INSERT INTO person_names_fts(person_names_fts) VALUES('rebuild')`)
+}
+
 // GetPerson retrieves a person by ID.
 func (s *ReadModelStore) GetPerson(ctx context.Context, id uuid.UUID) (*repository.PersonReadModel, error) {
 	row := s.db.QueryRowContext(ctx, `
@@ -621,7 +853,7 @@ func (s *ReadModelStore) GetPerson(ctx context.Context, id uuid.UUID) (*reposito
 			   birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
 			   death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
 			   notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-			   version, updated_at
+			   version, updated_at, created_at
 		FROM persons WHERE id = ?
 	`, id.String())
 
@@ -630,17 +862,33 @@ func (s *ReadModelStore) GetPerson(ctx context.Context, id uuid.UUID) (*reposito
 
 // ListPersons returns a paginated list of persons.
 func (s *ReadModelStore) ListPersons(ctx context.Context, opts repository.ListOptions) ([]repository.PersonReadModel, int, error) {
-	// Build WHERE clause for research_status filter
-	whereClause := ""
+	// Build WHERE clause for research_status, surname, gender, and unlinked filters
+	var conditions []string
 	var whereArgs []any
 	if opts.ResearchStatus != nil {
 		if *opts.ResearchStatus == "unset" {
-			whereClause = "WHERE research_status IS NULL OR research_status = ''"
+			conditions = append(conditions, "(research_status IS NULL OR research_status = '')")
 		} else {
-			whereClause = "WHERE research_status = ?"
+			conditions = append(conditions, "research_status = ?")
 			whereArgs = append(whereArgs, *opts.ResearchStatus)
 		}
 	}
+	if opts.Surname != "" {
+		conditions = append(conditions, "surname = ? COLLATE NOCASE")
+		whereArgs = append(whereArgs, opts.Surname)
+	}
+	if opts.Gender != "" {
+		conditions = append(conditions, "gender = ?")
+		whereArgs = append(whereArgs, opts.Gender)
+	}
+	if opts.Unlinked {
+		conditions = append(conditions, `NOT EXISTS (SELECT 1 FROM families f WHERE f.partner1_id = persons.id OR f.partner2_id = persons.id)
+			AND NOT EXISTS (SELECT 1 FROM family_children fc WHERE fc.person_id = persons.id)`)
+	}
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
 
 	// Count total (with filter if present)
 	var total int
@@ -651,19 +899,23 @@ func (s *ReadModelStore) ListPersons(ctx context.Context, opts repository.ListOp
 	}
 
 	// Build order clause
+	orderDir := "ASC"
+	if opts.Order == "desc" {
+		orderDir = "DESC"
+	}
 	orderColumn := "surname"
+	if opts.IgnoreSurnamePrefix {
+		orderColumn = "COALESCE(surname_sort, surname)"
+	}
 	switch opts.Sort {
 	case "given_name":
 		orderColumn = "given_name"
 	case "birth_date":
-		orderColumn = "birth_date_sort"
+		// Break ties between dates resolving to the same day by precision/qualifier
+		orderColumn = "birth_date_sort " + orderDir + ", birth_date_rank"
 	case "updated_at":
 		orderColumn = "updated_at"
 	}
-	orderDir := "ASC"
-	if opts.Order == "desc" {
-		orderDir = "DESC"
-	}
 
 	// Build query with filter
 	// #nosec G201 -- orderColumn and orderDir are validated via switch/if above, not user input
@@ -672,7 +924,7 @@ func (s *ReadModelStore) ListPersons(ctx context.Context, opts repository.ListOp
 			   birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
 			   death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
 			   notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-			   version, updated_at
+			   version, updated_at, created_at
 		FROM persons
 		%s
 		ORDER BY %s %s, given_name %s
@@ -714,19 +966,30 @@ func (s *ReadModelStore) SearchPersons(ctx context.Context, opts repository.Sear
 	hasDateFilter := opts.BirthDateFrom != nil || opts.BirthDateTo != nil ||
 		opts.DeathDateFrom != nil || opts.DeathDateTo != nil
 	hasPlaceFilter := opts.BirthPlace != "" || opts.DeathPlace != ""
+	hasPersonFilter := opts.GivenName != "" || opts.Surname != "" || opts.Gender != ""
+
+	// Wildcard: word-anchored LIKE patterns
+	if hasQuery && opts.Wildcard {
+		return s.searchPersonsWildcard(ctx, opts, limit)
+	}
 
 	// Soundex: fetch candidates with SQL filters, then post-filter in Go
 	if hasQuery && opts.Soundex {
 		return s.searchPersonsSoundex(ctx, opts, limit)
 	}
 
+	// Metaphone: match stored surname keys, plus substring matches
+	if hasQuery && opts.Metaphone {
+		return s.searchPersonsMetaphone(ctx, opts, limit)
+	}
+
 	// FTS5 or LIKE name matching combined with date/place SQL filters
 	if hasQuery {
 		return s.searchPersonsFTS(ctx, opts, limit)
 	}
 
-	// No text query — filter only by date/place
-	if hasDateFilter || hasPlaceFilter {
+	// No text query — filter only by date/place/name/gender
+	if hasDateFilter || hasPlaceFilter || hasPersonFilter {
 		return s.searchPersonsFiltersOnly(ctx, opts, limit)
 	}
 
@@ -756,7 +1019,7 @@ func (s *ReadModelStore) searchPersonsFTS(ctx context.Context, opts repository.S
 				   p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
 				   p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
 				   p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
-				   p.version, p.updated_at, 1 as is_primary, rank as search_rank
+				   p.version, p.updated_at, p.created_at, 1 as is_primary, rank as search_rank
 			FROM persons p
 			JOIN persons_fts fts ON p.rowid = fts.rowid
 			WHERE persons_fts MATCH ?`)
@@ -775,7 +1038,7 @@ func (s *ReadModelStore) searchPersonsFTS(ctx context.Context, opts repository.S
 				   p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
 				   p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
 				   p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
-				   p.version, p.updated_at, pn.is_primary, nfts.rank as search_rank
+				   p.version, p.updated_at, p.created_at, pn.is_primary, nfts.rank as search_rank
 			FROM persons p
 			JOIN person_names pn ON p.id = pn.person_id
 			JOIN person_names_fts nfts ON pn.rowid = nfts.rowid
@@ -793,7 +1056,7 @@ func (s *ReadModelStore) searchPersonsFTS(ctx context.Context, opts repository.S
 			   birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
 			   death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
 			   notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-			   version, updated_at
+			   version, updated_at, created_at
 		FROM matched_persons
 		ORDER BY ` + orderClause + `
 		LIMIT ?`)
@@ -833,13 +1096,13 @@ func (s *ReadModelStore) searchPersonsLike(ctx context.Context, opts repository.
 			   p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
 			   p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
 			   p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
-			   p.version, p.updated_at
+			   p.version, p.updated_at, p.created_at
 		FROM persons p
 		LEFT JOIN person_names pn ON p.id = pn.person_id
 		WHERE (LOWER(p.full_name) LIKE ? OR LOWER(p.given_name) LIKE ? OR LOWER(p.surname) LIKE ?
 		   OR LOWER(pn.full_name) LIKE ? OR LOWER(pn.given_name) LIKE ? OR LOWER(pn.surname) LIKE ?
-		   OR LOWER(pn.nickname) LIKE ?)`)
-	args = append(args, likeQuery, likeQuery, likeQuery, likeQuery, likeQuery, likeQuery, likeQuery)
+		   OR LOWER(pn.nickname) LIKE ? OR LOWER(pn.romanized) LIKE ?)`)
+	args = append(args, likeQuery, likeQuery, likeQuery, likeQuery, likeQuery, likeQuery, likeQuery, likeQuery)
 
 	if filterSQL != "" {
 		sb.WriteString(" AND " + filterSQL)
@@ -858,6 +1121,102 @@ func (s *ReadModelStore) searchPersonsLike(ctx context.Context, opts repository.
 	return scanPersonRows(rows)
 }
 
+// searchPersonsWildcard matches a wildcard query against the words of primary
+// and alternate names, e.g. "smi*" for names beginning with "smi".
+func (s *ReadModelStore) searchPersonsWildcard(ctx context.Context, opts repository.SearchOptions, limit int) ([]repository.PersonReadModel, error) {
+	pattern := repository.WildcardLikePattern(opts.Query)
+	filterSQL, filterArgs := buildDatePlaceFilters(opts)
+	orderClause := searchOrderClause(opts, "p.", false)
+
+	var sb strings.Builder
+	var args []any
+
+	sb.WriteString(`
+		SELECT DISTINCT p.id, p.given_name, p.surname, p.full_name, p.gender,
+			   p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
+			   p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
+			   p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
+			   p.version, p.updated_at, p.created_at
+		FROM persons p
+		LEFT JOIN person_names pn ON p.id = pn.person_id
+		WHERE (' ' || LOWER(p.full_name) || ' ' LIKE ? ESCAPE '\' OR ' ' || LOWER(p.given_name) || ' ' LIKE ? ESCAPE '\'
+		   OR ' ' || LOWER(p.surname) || ' ' LIKE ? ESCAPE '\'
+		   OR ' ' || LOWER(pn.full_name) || ' ' LIKE ? ESCAPE '\' OR ' ' || LOWER(pn.given_name) || ' ' LIKE ? ESCAPE '\'
+		   OR ' ' || LOWER(pn.surname) || ' ' LIKE ? ESCAPE '\' OR ' ' || LOWER(pn.nickname) || ' ' LIKE ? ESCAPE '\'
+		   OR ' ' || LOWER(pn.romanized) || ' ' LIKE ? ESCAPE '\')`)
+	for range 8 {
+		args = append(args, pattern)
+	}
+
+	if filterSQL != "" {
+		sb.WriteString(" AND " + filterSQL)
+		args = append(args, filterArgs...)
+	}
+
+	sb.WriteString(" ORDER BY " + orderClause + " LIMIT ?")
+	args = append(args, limit)
+
+	rows, err := s.db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("search persons wildcard: %w", err)
+	}
+	defer rows.Close()
+
+	return scanPersonRows(rows)
+}
+
+// searchPersonsMetaphone matches query words against the stored surname_metaphone
+// keys of persons and alternate names, in addition to substring matches.
+func (s *ReadModelStore) searchPersonsMetaphone(ctx context.Context, opts repository.SearchOptions, limit int) ([]repository.PersonReadModel, error) {
+	keys := repository.MetaphoneKeys(opts.Query)
+	if len(keys) == 0 {
+		return s.searchPersonsLike(ctx, opts, limit)
+	}
+
+	likeQuery := "%" + strings.ToLower(opts.Query) + "%"
+	filterSQL, filterArgs := buildDatePlaceFilters(opts)
+	orderClause := searchOrderClause(opts, "p.", false)
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(keys)), ", ")
+
+	var sb strings.Builder
+	var args []any
+
+	sb.WriteString(`
+		SELECT DISTINCT p.id, p.given_name, p.surname, p.full_name, p.gender,
+			   p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
+			   p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
+			   p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
+			   p.version, p.updated_at, p.created_at
+		FROM persons p
+		LEFT JOIN person_names pn ON p.id = pn.person_id
+		WHERE (LOWER(p.full_name) LIKE ? OR LOWER(pn.full_name) LIKE ? OR LOWER(pn.nickname) LIKE ?
+		   OR LOWER(pn.romanized) LIKE ?
+		   OR p.surname_metaphone IN (` + placeholders + `)
+		   OR pn.surname_metaphone IN (` + placeholders + `))`)
+	args = append(args, likeQuery, likeQuery, likeQuery, likeQuery)
+	for range 2 {
+		for _, key := range keys {
+			args = append(args, key)
+		}
+	}
+
+	if filterSQL != "" {
+		sb.WriteString(" AND " + filterSQL)
+		args = append(args, filterArgs...)
+	}
+
+	sb.WriteString(" ORDER BY " + orderClause + " LIMIT ?")
+	args = append(args, limit)
+
+	rows, err := s.db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("search persons metaphone: %w", err)
+	}
+	defer rows.Close()
+
+	return scanPersonRows(rows)
+}
+
 // searchPersonsSoundex fetches candidates filtered by date/place, then post-filters using Soundex in Go.
 func (s *ReadModelStore) searchPersonsSoundex(ctx context.Context, opts repository.SearchOptions, limit int) ([]repository.PersonReadModel, error) {
 	filterSQL, filterArgs := buildDatePlaceFilters(opts)
@@ -873,7 +1232,7 @@ func (s *ReadModelStore) searchPersonsSoundex(ctx context.Context, opts reposito
 			   p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
 			   p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
 			   p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
-			   p.version, p.updated_at
+			   p.version, p.updated_at, p.created_at
 		FROM persons p`)
 
 	if filterSQL != "" {
@@ -930,6 +1289,7 @@ type nameEntry struct {
 	GivenName string
 	Surname   string
 	Nickname  string
+	Romanized string
 }
 
 // loadPersonNamesForSoundex loads alternate names for a set of persons.
@@ -951,7 +1311,7 @@ func (s *ReadModelStore) loadPersonNamesForSoundex(ctx context.Context, persons
 
 		var sb strings.Builder
 		var args []any
-		sb.WriteString("SELECT person_id, given_name, surname, nickname FROM person_names WHERE person_id IN (")
+		sb.WriteString("SELECT person_id, given_name, surname, nickname, romanized FROM person_names WHERE person_id IN (")
 		for i, p := range batch {
 			if i > 0 {
 				sb.WriteString(", ")
@@ -968,8 +1328,8 @@ func (s *ReadModelStore) loadPersonNamesForSoundex(ctx context.Context, persons
 
 		for rows.Next() {
 			var personID, givenName, surname string
-			var nickname sql.NullString
-			if err := rows.Scan(&personID, &givenName, &surname, &nickname); err != nil {
+			var nickname, romanized sql.NullString
+			if err := rows.Scan(&personID, &givenName, &surname, &nickname, &romanized); err != nil {
 				rows.Close()
 				return nil, err
 			}
@@ -977,6 +1337,7 @@ func (s *ReadModelStore) loadPersonNamesForSoundex(ctx context.Context, persons
 				GivenName: givenName,
 				Surname:   surname,
 				Nickname:  nickname.String,
+				Romanized: romanized.String,
 			})
 		}
 		rows.Close()
@@ -998,6 +1359,11 @@ func personMatchesSoundex(p repository.PersonReadModel, queryWords []string, alt
 			if repository.SoundexMatch(word, name.GivenName) || repository.SoundexMatch(word, name.Surname) || repository.SoundexMatch(word, name.Nickname) {
 				return true
 			}
+			for _, romanized := range strings.Fields(name.Romanized) {
+				if repository.SoundexMatch(word, romanized) {
+					return true
+				}
+			}
 		}
 	}
 	return false
@@ -1016,7 +1382,7 @@ func (s *ReadModelStore) searchPersonsFiltersOnly(ctx context.Context, opts repo
 			   p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
 			   p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
 			   p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
-			   p.version, p.updated_at
+			   p.version, p.updated_at, p.created_at
 		FROM persons p`)
 
 	if filterSQL != "" {
@@ -1036,7 +1402,7 @@ func (s *ReadModelStore) searchPersonsFiltersOnly(ctx context.Context, opts repo
 	return scanPersonRows(rows)
 }
 
-// buildDatePlaceFilters builds SQL WHERE conditions for date range and place filters.
+// buildDatePlaceFilters builds SQL WHERE conditions for date range, place, name, and gender filters.
 // Returns the SQL fragment (without leading WHERE/AND) and args.
 func buildDatePlaceFilters(opts repository.SearchOptions) (string, []any) {
 	var conditions []string
@@ -1066,6 +1432,18 @@ func buildDatePlaceFilters(opts repository.SearchOptions) (string, []any) {
 		conditions = append(conditions, "p.death_place LIKE '%' || ? || '%' COLLATE NOCASE")
 		args = append(args, opts.DeathPlace)
 	}
+	if opts.GivenName != "" {
+		conditions = append(conditions, "p.given_name LIKE '%' || ? || '%' COLLATE NOCASE")
+		args = append(args, opts.GivenName)
+	}
+	if opts.Surname != "" {
+		conditions = append(conditions, "p.surname LIKE '%' || ? || '%' COLLATE NOCASE")
+		args = append(args, opts.Surname)
+	}
+	if opts.Gender != "" {
+		conditions = append(conditions, "p.gender = ?")
+		args = append(args, string(opts.Gender))
+	}
 
 	if len(conditions) == 0 {
 		return "", nil
@@ -1195,19 +1573,28 @@ func (s *ReadModelStore) SavePerson(ctx context.Context, person *repository.Pers
 		brickWallResolvedAt = sql.NullString{String: formatTimestamp(*person.BrickWallResolvedAt), Valid: true}
 	}
 
+	// Creation time is unknown for rows projected before it was tracked
+	var createdAt sql.NullString
+	if !person.CreatedAt.IsZero() {
+		createdAt = sql.NullString{String: formatTimestamp(person.CreatedAt), Valid: true}
+	}
+
 	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO persons (id, given_name, surname, gender, birth_date_raw, birth_date_sort, birth_place,
-							 birth_place_lat, birth_place_long, death_date_raw, death_date_sort, death_place,
+		INSERT INTO persons (id, given_name, surname, surname_metaphone, surname_sort, gender, birth_date_raw, birth_date_sort, birth_date_rank,
+							 birth_place, birth_place_lat, birth_place_long, death_date_raw, death_date_sort, death_place,
 							 death_place_lat, death_place_long, notes, research_status,
 							 brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-							 version, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+							 version, updated_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(id) DO UPDATE SET
 			given_name = excluded.given_name,
 			surname = excluded.surname,
+			surname_metaphone = excluded.surname_metaphone,
+			surname_sort = excluded.surname_sort,
 			gender = excluded.gender,
 			birth_date_raw = excluded.birth_date_raw,
 			birth_date_sort = excluded.birth_date_sort,
+			birth_date_rank = excluded.birth_date_rank,
 			birth_place = excluded.birth_place,
 			birth_place_lat = excluded.birth_place_lat,
 			birth_place_long = excluded.birth_place_long,
@@ -1222,13 +1609,14 @@ func (s *ReadModelStore) SavePerson(ctx context.Context, person *repository.Pers
 			brick_wall_since = excluded.brick_wall_since,
 			brick_wall_resolved_at = excluded.brick_wall_resolved_at,
 			version = excluded.version,
-			updated_at = excluded.updated_at
-	`, person.ID.String(), person.GivenName, person.Surname, string(person.Gender),
-		person.BirthDateRaw, birthDateSort, person.BirthPlace, birthPlaceLat, birthPlaceLong,
+			updated_at = excluded.updated_at,
+			created_at = COALESCE(persons.created_at, excluded.created_at)
+	`, person.ID.String(), person.GivenName, person.Surname, repository.Metaphone(person.Surname), domain.SurnameSortKey(person.Surname), string(person.Gender),
+		person.BirthDateRaw, birthDateSort, birthDateRank(person.BirthDateRaw), person.BirthPlace, birthPlaceLat, birthPlaceLong,
 		person.DeathDateRaw, deathDateSort, person.DeathPlace, deathPlaceLat, deathPlaceLong,
 		person.Notes, string(person.ResearchStatus),
 		person.BrickWallNote, brickWallSince, brickWallResolvedAt,
-		person.Version, formatTimestamp(person.UpdatedAt))
+		person.Version, formatTimestamp(person.UpdatedAt), createdAt)
 
 	return err
 }
@@ -1247,23 +1635,25 @@ func (s *ReadModelStore) SavePersonName(ctx context.Context, name *repository.Pe
 	}
 
 	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO person_names (id, person_id, given_name, surname, name_prefix, name_suffix,
-								  surname_prefix, nickname, name_type, is_primary, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO person_names (id, person_id, given_name, surname, surname_metaphone, name_prefix, name_suffix,
+								  surname_prefix, nickname, romanized, name_type, is_primary, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(id) DO UPDATE SET
 			person_id = excluded.person_id,
 			given_name = excluded.given_name,
 			surname = excluded.surname,
+			surname_metaphone = excluded.surname_metaphone,
 			name_prefix = excluded.name_prefix,
 			name_suffix = excluded.name_suffix,
 			surname_prefix = excluded.surname_prefix,
 			nickname = excluded.nickname,
+			romanized = excluded.romanized,
 			name_type = excluded.name_type,
 			is_primary = excluded.is_primary,
 			updated_at = excluded.updated_at
-	`, name.ID.String(), name.PersonID.String(), name.GivenName, name.Surname,
+	`, name.ID.String(), name.PersonID.String(), name.GivenName, name.Surname, repository.Metaphone(name.Surname),
 		nullableString(name.NamePrefix), nullableString(name.NameSuffix),
-		nullableString(name.SurnamePrefix), nullableString(name.Nickname),
+		nullableString(name.SurnamePrefix), nullableString(name.Nickname), nullableString(name.Romanized),
 		string(name.NameType), isPrimary, formatTimestamp(name.UpdatedAt))
 
 	return err
@@ -1273,7 +1663,7 @@ func (s *ReadModelStore) SavePersonName(ctx context.Context, name *repository.Pe
 func (s *ReadModelStore) GetPersonName(ctx context.Context, nameID uuid.UUID) (*repository.PersonNameReadModel, error) {
 	row := s.db.QueryRowContext(ctx, `
 		SELECT id, person_id, given_name, surname, full_name, name_prefix, name_suffix,
-			   surname_prefix, nickname, name_type, is_primary, updated_at
+			   surname_prefix, nickname, romanized, name_type, is_primary, updated_at
 		FROM person_names WHERE id = ?
 	`, nameID.String())
 
@@ -1284,7 +1674,7 @@ func (s *ReadModelStore) GetPersonName(ctx context.Context, nameID uuid.UUID) (*
 func (s *ReadModelStore) GetPersonNames(ctx context.Context, personID uuid.UUID) ([]repository.PersonNameReadModel, error) {
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT id, person_id, given_name, surname, full_name, name_prefix, name_suffix,
-			   surname_prefix, nickname, name_type, is_primary, updated_at
+			   surname_prefix, nickname, romanized, name_type, is_primary, updated_at
 		FROM person_names
 		WHERE person_id = ?
 		ORDER BY is_primary DESC, name_type
@@ -1549,14 +1939,14 @@ func scanPersonName(row rowScanner) (*repository.PersonNameReadModel, error) {
 	var (
 		idStr, personIDStr, givenName, surname, fullName string
 		namePrefix, nameSuffix, surnamePrefix, nickname  sql.NullString
-		nameType                                         sql.NullString
+		romanized, nameType                              sql.NullString
 		isPrimary                                        int
 		updatedAt                                        string
 	)
 
 	err := row.Scan(&idStr, &personIDStr, &givenName, &surname, &fullName,
 		&namePrefix, &nameSuffix, &surnamePrefix, &nickname,
-		&nameType, &isPrimary, &updatedAt)
+		&romanized, &nameType, &isPrimary, &updatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -1578,6 +1968,7 @@ func scanPersonName(row rowScanner) (*repository.PersonNameReadModel, error) {
 		NameSuffix:    nameSuffix.String,
 		SurnamePrefix: surnamePrefix.String,
 		Nickname:      nickname.String,
+		Romanized:     romanized.String,
 		NameType:      domain.NameType(nameType.String),
 		IsPrimary:     isPrimary == 1,
 	}
@@ -1600,8 +1991,8 @@ func (s *ReadModelStore) GetFamily(ctx context.Context, id uuid.UUID) (*reposito
 		SELECT id, partner1_id, partner1_given_name, partner1_surname,
 			   partner2_id, partner2_given_name, partner2_surname,
 			   relationship_type, marriage_date_raw, marriage_date_sort, marriage_place,
-			   marriage_place_lat, marriage_place_long,
-			   child_count, version, updated_at
+			   marriage_place_lat, marriage_place_long, research_status,
+			   child_count, version, updated_at, created_at
 		FROM families WHERE id = ?
 	`, id.String())
 
@@ -1620,8 +2011,8 @@ func (s *ReadModelStore) ListFamilies(ctx context.Context, opts repository.ListO
 		SELECT id, partner1_id, partner1_given_name, partner1_surname,
 			   partner2_id, partner2_given_name, partner2_surname,
 			   relationship_type, marriage_date_raw, marriage_date_sort, marriage_place,
-			   marriage_place_lat, marriage_place_long,
-			   child_count, version, updated_at
+			   marriage_place_lat, marriage_place_long, research_status,
+			   child_count, version, updated_at, created_at
 		FROM families
 		ORDER BY updated_at DESC
 		LIMIT ? OFFSET ?
@@ -1649,8 +2040,8 @@ func (s *ReadModelStore) GetFamiliesForPerson(ctx context.Context, personID uuid
 		SELECT id, partner1_id, partner1_given_name, partner1_surname,
 			   partner2_id, partner2_given_name, partner2_surname,
 			   relationship_type, marriage_date_raw, marriage_date_sort, marriage_place,
-			   marriage_place_lat, marriage_place_long,
-			   child_count, version, updated_at
+			   marriage_place_lat, marriage_place_long, research_status,
+			   child_count, version, updated_at, created_at
 		FROM families
 		WHERE partner1_id = ? OR partner2_id = ?
 	`, personID.String(), personID.String())
@@ -1671,6 +2062,51 @@ func (s *ReadModelStore) GetFamiliesForPerson(ctx context.Context, personID uuid
 	return families, rows.Err()
 }
 
+// SearchFamilies searches for families by partner name. Every word of the
+// query must appear in either partner's name.
+func (s *ReadModelStore) SearchFamilies(ctx context.Context, query string, limit int) ([]repository.FamilyReadModel, error) {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	conditions := make([]string, len(words))
+	args := make([]any, 0, len(words)+1)
+	for i, word := range words {
+		conditions[i] = "LOWER(COALESCE(partner1_given_name, '') || ' ' || COALESCE(partner1_surname, '') || ' ' || " +
+			"COALESCE(partner2_given_name, '') || ' ' || COALESCE(partner2_surname, '')) LIKE ?"
+		args = append(args, "%"+word+"%")
+	}
+	args = append(args, limit)
+
+	// nosemgrep: go.lang.security.audit.database.string-formatted-query.string-formatted-query -- conditions use parameterized placeholders, not user input
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, partner1_id, partner1_given_name, partner1_surname,
+			   partner2_id, partner2_given_name, partner2_surname,
+			   relationship_type, marriage_date_raw, marriage_date_sort, marriage_place,
+			   marriage_place_lat, marriage_place_long, research_status,
+			   child_count, version, updated_at, created_at
+		FROM families
+		WHERE `+strings.Join(conditions, " AND ")+`
+		ORDER BY partner1_surname, partner1_given_name
+		LIMIT `+"?", args...)
+	if err != nil {
+		return nil, fmt.Errorf("search families: %w", err)
+	}
+	defer rows.Close()
+
+	var families []repository.FamilyReadModel
+	for rows.Next() {
+		f, err := scanFamilyRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		families = append(families, *f)
+	}
+
+	return families, rows.Err()
+}
+
 // SaveFamily saves or updates a family.
 func (s *ReadModelStore) SaveFamily(ctx context.Context, family *repository.FamilyReadModel) error {
 	var partner1ID, partner2ID sql.NullString
@@ -1695,13 +2131,19 @@ func (s *ReadModelStore) SaveFamily(ctx context.Context, family *repository.Fami
 		marriagePlaceLong = sql.NullString{String: *family.MarriagePlaceLong, Valid: true}
 	}
 
+	// Creation time is unknown for rows projected before it was tracked
+	var createdAt sql.NullString
+	if !family.CreatedAt.IsZero() {
+		createdAt = sql.NullString{String: formatTimestamp(family.CreatedAt), Valid: true}
+	}
+
 	_, err := s.db.ExecContext(ctx, `
 		INSERT INTO families (id, partner1_id, partner1_given_name, partner1_surname,
 							  partner2_id, partner2_given_name, partner2_surname,
 							  relationship_type, marriage_date_raw, marriage_date_sort, marriage_place,
-							  marriage_place_lat, marriage_place_long,
-							  child_count, version, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+							  marriage_place_lat, marriage_place_long, research_status,
+							  child_count, version, updated_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(id) DO UPDATE SET
 			partner1_id = excluded.partner1_id,
 			partner1_given_name = excluded.partner1_given_name,
@@ -1715,15 +2157,17 @@ func (s *ReadModelStore) SaveFamily(ctx context.Context, family *repository.Fami
 			marriage_place = excluded.marriage_place,
 			marriage_place_lat = excluded.marriage_place_lat,
 			marriage_place_long = excluded.marriage_place_long,
+			research_status = excluded.research_status,
 			child_count = excluded.child_count,
 			version = excluded.version,
-			updated_at = excluded.updated_at
+			updated_at = excluded.updated_at,
+			created_at = COALESCE(families.created_at, excluded.created_at)
 	`, family.ID.String(),
 		partner1ID, family.Partner1GivenName, family.Partner1Surname,
 		partner2ID, family.Partner2GivenName, family.Partner2Surname,
 		string(family.RelationshipType), family.MarriageDateRaw, marriageDateSort, family.MarriagePlace,
-		marriagePlaceLat, marriagePlaceLong,
-		family.ChildCount, family.Version, formatTimestamp(family.UpdatedAt))
+		marriagePlaceLat, marriagePlaceLong, string(family.ResearchStatus),
+		family.ChildCount, family.Version, formatTimestamp(family.UpdatedAt), createdAt)
 
 	return err
 }
@@ -1787,7 +2231,7 @@ func (s *ReadModelStore) GetChildrenOfFamily(ctx context.Context, familyID uuid.
 			   p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
 			   p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
 			   p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
-			   p.version, p.updated_at
+			   p.version, p.updated_at, p.created_at
 		FROM persons p
 		JOIN family_children fc ON p.id = fc.person_id
 		WHERE fc.family_id = ?
@@ -1816,8 +2260,8 @@ func (s *ReadModelStore) GetChildFamily(ctx context.Context, personID uuid.UUID)
 		SELECT f.id, f.partner1_id, f.partner1_given_name, f.partner1_surname,
 			   f.partner2_id, f.partner2_given_name, f.partner2_surname,
 			   f.relationship_type, f.marriage_date_raw, f.marriage_date_sort, f.marriage_place,
-			   f.marriage_place_lat, f.marriage_place_long,
-			   f.child_count, f.version, f.updated_at
+			   f.marriage_place_lat, f.marriage_place_long, f.research_status,
+			   f.child_count, f.version, f.updated_at, f.created_at
 		FROM families f
 		JOIN family_children fc ON f.id = fc.family_id
 		WHERE fc.person_id = ?
@@ -1940,13 +2384,14 @@ func scanPerson(row rowScanner) (*repository.PersonReadModel, error) {
 		brickWallSince, brickWallResolvedAt             sql.NullString
 		version                                         int64
 		updatedAt                                       string
+		createdAt                                       sql.NullString
 	)
 
 	err := row.Scan(&idStr, &givenName, &surname, &fullName, &gender,
 		&birthDateRaw, &birthDateSort, &birthPlace, &birthPlaceLat, &birthPlaceLong,
 		&deathDateRaw, &deathDateSort, &deathPlace, &deathPlaceLat, &deathPlaceLong,
 		&notes, &researchStatus, &brickWallNote, &brickWallSince, &brickWallResolvedAt,
-		&version, &updatedAt)
+		&version, &updatedAt, &createdAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -2009,6 +2454,11 @@ func scanPerson(row rowScanner) (*repository.PersonReadModel, error) {
 	if t, err := parseTimestamp(updatedAt); err == nil {
 		p.UpdatedAt = t
 	}
+	if createdAt.Valid {
+		if t, err := parseTimestamp(createdAt.String); err == nil {
+			p.CreatedAt = t
+		}
+	}
 
 	return p, nil
 }
@@ -2024,18 +2474,19 @@ func scanFamily(row rowScanner) (*repository.FamilyReadModel, error) {
 		partner1GivenName, partner1Surname                        sql.NullString
 		partner2GivenName, partner2Surname                        sql.NullString
 		relType, marriageDateRaw, marriageDateSort, marriagePlace sql.NullString
-		marriagePlaceLat, marriagePlaceLong                       sql.NullString
+		marriagePlaceLat, marriagePlaceLong, researchStatus       sql.NullString
 		childCount                                                int
 		version                                                   int64
 		updatedAt                                                 string
+		createdAt                                                 sql.NullString
 	)
 
 	err := row.Scan(&idStr,
 		&partner1ID, &partner1GivenName, &partner1Surname,
 		&partner2ID, &partner2GivenName, &partner2Surname,
 		&relType, &marriageDateRaw, &marriageDateSort, &marriagePlace,
-		&marriagePlaceLat, &marriagePlaceLong,
-		&childCount, &version, &updatedAt)
+		&marriagePlaceLat, &marriagePlaceLong, &researchStatus,
+		&childCount, &version, &updatedAt, &createdAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -2054,6 +2505,7 @@ func scanFamily(row rowScanner) (*repository.FamilyReadModel, error) {
 		RelationshipType:  domain.RelationType(relType.String),
 		MarriageDateRaw:   marriageDateRaw.String,
 		MarriagePlace:     marriagePlace.String,
+		ResearchStatus:    domain.ResearchStatus(researchStatus.String),
 		ChildCount:        childCount,
 		Version:           version,
 	}
@@ -2081,6 +2533,11 @@ func scanFamily(row rowScanner) (*repository.FamilyReadModel, error) {
 	if t, err := parseTimestamp(updatedAt); err == nil {
 		f.UpdatedAt = t
 	}
+	if createdAt.Valid {
+		if t, err := parseTimestamp(createdAt.String); err == nil {
+			f.CreatedAt = t
+		}
+	}
 
 	return f, nil
 }
@@ -2124,14 +2581,31 @@ func (s *ReadModelStore) ListSources(ctx context.Context, opts repository.ListOp
 		return nil, 0, fmt.Errorf("count sources: %w", err)
 	}
 
-	rows, err := s.db.QueryContext(ctx, `
+	// Build order clause
+	orderDir := "ASC"
+	if opts.Order == "desc" {
+		orderDir = "DESC"
+	}
+	orderColumn := "title"
+	switch opts.Sort {
+	case "source_type":
+		orderColumn = "source_type"
+	case "citation_count":
+		orderColumn = "citation_count"
+	case "updated_at":
+		orderColumn = "updated_at"
+	}
+
+	// #nosec G201 -- orderColumn and orderDir are validated via switch/if above, not user input
+	query := fmt.Sprintf(`
 		SELECT id, source_type, title, author, publisher, publish_date_raw, publish_date_sort,
 			   url, repository_id, repository_name, collection_name, call_number, notes, gedcom_xref,
 			   citation_count, version, updated_at
 		FROM sources
-		ORDER BY title ASC
+		ORDER BY %s %s, title %s
 		LIMIT ? OFFSET ?
-	`, opts.Limit, opts.Offset)
+	`, orderColumn, orderDir, orderDir)
+	rows, err := s.db.QueryContext(ctx, query, opts.Limit, opts.Offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("query sources: %w", err)
 	}
@@ -2264,6 +2738,59 @@ func (s *ReadModelStore) GetCitationsForSource(ctx context.Context, sourceID uui
 	return citations, rows.Err()
 }
 
+// ListCitationsForSource returns a page of a source's citations matching
+// filter, oldest first.
+func (s *ReadModelStore) ListCitationsForSource(ctx context.Context, sourceID uuid.UUID, filter repository.CitationFilter, opts repository.ListOptions) ([]repository.CitationReadModel, int, error) {
+	conditions := []string{"source_id = ?"}
+	args := []any{sourceID.String()}
+	for _, f := range []struct{ column, value string }{
+		{"fact_type", string(filter.FactType)},
+		{"source_quality", string(filter.SourceQuality)},
+		{"evidence_type", string(filter.EvidenceType)},
+	} {
+		if f.value == "" {
+			continue
+		}
+		conditions = append(conditions, f.column+" = ?")
+		args = append(args, f.value)
+	}
+	whereClause := strings.Join(conditions, " AND ")
+
+	var total int
+	// #nosec G201 -- whereClause is built from fixed column names, not user input
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM citations WHERE "+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count citations for source: %w", err)
+	}
+
+	// #nosec G201 -- whereClause is built from fixed column names, not user input
+	query := fmt.Sprintf(`
+		SELECT id, source_id, source_title, fact_type, fact_owner_id, page, volume,
+			   source_quality, informant_type, evidence_type, quoted_text, analysis,
+			   template_id, fields_data, gedcom_xref, version, created_at
+		FROM citations
+		WHERE %s
+		ORDER BY created_at ASC, id ASC
+		LIMIT ? OFFSET ?
+	`, whereClause)
+	rows, err := s.db.QueryContext(ctx, query, append(args, opts.Limit, opts.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query citations for source: %w", err)
+	}
+	defer rows.Close()
+
+	var citations []repository.CitationReadModel
+	for rows.Next() {
+		cit, err := scanCitationRow(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		citations = append(citations, *cit)
+	}
+
+	return citations, total, rows.Err()
+}
+
 // GetCitationsForPerson returns all citations for a person.
 func (s *ReadModelStore) GetCitationsForPerson(ctx context.Context, personID uuid.UUID) ([]repository.CitationReadModel, error) {
 	rows, err := s.db.QueryContext(ctx, `
@@ -2397,7 +2924,7 @@ func (s *ReadModelStore) GetEvent(ctx context.Context, id uuid.UUID) (*repositor
 		SELECT id, owner_type, owner_id, fact_type, date_raw, date_sort,
 		       place, place_lat, place_long, address, description, cause,
 		       age, research_status, is_negated, version, created_at
-		FROM events WHERE id = ?
+		FROM life_events WHERE id = ?
 	`, id.String())
 
 	return scanEvent(row)
@@ -2407,7 +2934,7 @@ func (s *ReadModelStore) GetEvent(ctx context.Context, id uuid.UUID) (*repositor
 func (s *ReadModelStore) ListEvents(ctx context.Context, opts repository.ListOptions) ([]repository.EventReadModel, int, error) {
 	// Count total
 	var total int
-	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM events").Scan(&total)
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM life_events").Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("count events: %w", err)
 	}
@@ -2417,7 +2944,7 @@ func (s *ReadModelStore) ListEvents(ctx context.Context, opts repository.ListOpt
 		SELECT id, owner_type, owner_id, fact_type, date_raw, date_sort,
 		       place, place_lat, place_long, address, description, cause,
 		       age, research_status, is_negated, version, created_at
-		FROM events
+		FROM life_events
 		ORDER BY fact_type ASC, CASE WHEN date_sort IS NULL THEN 1 ELSE 0 END, date_sort ASC, id ASC
 		LIMIT ? OFFSET ?
 	`
@@ -2446,7 +2973,7 @@ func (s *ReadModelStore) ListEventsForPerson(ctx context.Context, personID uuid.
 		SELECT id, owner_type, owner_id, fact_type, date_raw, date_sort,
 		       place, place_lat, place_long, address, description, cause,
 		       age, research_status, is_negated, version, created_at
-		FROM events
+		FROM life_events
 		WHERE owner_type = 'person' AND owner_id = ?
 		ORDER BY fact_type ASC, CASE WHEN date_sort IS NULL THEN 1 ELSE 0 END, date_sort ASC, id ASC
 	`, personID.String())
@@ -2473,7 +3000,7 @@ func (s *ReadModelStore) ListEventsForFamily(ctx context.Context, familyID uuid.
 		SELECT id, owner_type, owner_id, fact_type, date_raw, date_sort,
 		       place, place_lat, place_long, address, description, cause,
 		       age, research_status, is_negated, version, created_at
-		FROM events
+		FROM life_events
 		WHERE owner_type = 'family' AND owner_id = ?
 		ORDER BY fact_type ASC, CASE WHEN date_sort IS NULL THEN 1 ELSE 0 END, date_sort ASC, id ASC
 	`, familyID.String())
@@ -2527,7 +3054,7 @@ func (s *ReadModelStore) SaveEvent(ctx context.Context, event *repository.EventR
 	}
 
 	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO events (id, owner_type, owner_id, fact_type, date_raw, date_sort,
+		INSERT INTO life_events (id, owner_type, owner_id, fact_type, date_raw, date_sort,
 		                    place, place_lat, place_long, address, description, cause,
 		                    age, research_status, is_negated, version, created_at)
 		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
@@ -2559,7 +3086,7 @@ func (s *ReadModelStore) SaveEvent(ctx context.Context, event *repository.EventR
 
 // DeleteEvent deletes an event by ID.
 func (s *ReadModelStore) DeleteEvent(ctx context.Context, id uuid.UUID) error {
-	_, err := s.db.ExecContext(ctx, "DELETE FROM events WHERE id = ?", id.String())
+	_, err := s.db.ExecContext(ctx, "DELETE FROM life_events WHERE id = ?", id.String())
 	if err != nil {
 		return fmt.Errorf("delete event: %w", err)
 	}
@@ -2569,7 +3096,7 @@ func (s *ReadModelStore) DeleteEvent(ctx context.Context, id uuid.UUID) error {
 // GetAttribute retrieves an attribute by ID.
 func (s *ReadModelStore) GetAttribute(ctx context.Context, id uuid.UUID) (*repository.AttributeReadModel, error) {
 	row := s.db.QueryRowContext(ctx, `
-		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, version, created_at
+		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, research_status, version, created_at
 		FROM attributes WHERE id = ?
 	`, id.String())
 
@@ -2587,7 +3114,7 @@ func (s *ReadModelStore) ListAttributes(ctx context.Context, opts repository.Lis
 
 	// Sort by fact_type ASC, value ASC, id ASC for deterministic ordering
 	query := `
-		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, version, created_at
+		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, research_status, version, created_at
 		FROM attributes
 		ORDER BY fact_type ASC, value ASC, id ASC
 		LIMIT ? OFFSET ?
@@ -2614,7 +3141,7 @@ func (s *ReadModelStore) ListAttributes(ctx context.Context, opts repository.Lis
 // ListAttributesForPerson returns all attributes for a given person.
 func (s *ReadModelStore) ListAttributesForPerson(ctx context.Context, personID uuid.UUID) ([]repository.AttributeReadModel, error) {
 	rows, err := s.db.QueryContext(ctx, `
-		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, version, created_at
+		SELECT id, person_id, fact_type, value, date_raw, date_sort, place, research_status, version, created_at
 		FROM attributes
 		WHERE person_id = ?
 		ORDER BY fact_type ASC, value ASC, id ASC
@@ -2638,14 +3165,17 @@ func (s *ReadModelStore) ListAttributesForPerson(ctx context.Context, personID u
 
 // SaveAttribute saves or updates an attribute.
 func (s *ReadModelStore) SaveAttribute(ctx context.Context, attribute *repository.AttributeReadModel) error {
-	var dateSort interface{}
+	var dateSort, researchStatus interface{}
 	if attribute.DateSort != nil {
 		dateSort = attribute.DateSort.Format(time.RFC3339)
 	}
+	if attribute.ResearchStatus != "" {
+		researchStatus = string(attribute.ResearchStatus)
+	}
 
 	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO attributes (id, person_id, fact_type, value, date_raw, date_sort, place, version, created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO attributes (id, person_id, fact_type, value, date_raw, date_sort, place, research_status, version, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT (id) DO UPDATE SET
 			person_id = excluded.person_id,
 			fact_type = excluded.fact_type,
@@ -2653,9 +3183,10 @@ func (s *ReadModelStore) SaveAttribute(ctx context.Context, attribute *repositor
 			date_raw = excluded.date_raw,
 			date_sort = excluded.date_sort,
 			place = excluded.place,
+			research_status = excluded.research_status,
 			version = excluded.version
 	`, attribute.ID.String(), attribute.PersonID.String(), string(attribute.FactType),
-		attribute.Value, attribute.DateRaw, dateSort, attribute.Place,
+		attribute.Value, attribute.DateRaw, dateSort, attribute.Place, researchStatus,
 		attribute.Version, formatTimestamp(attribute.CreatedAt))
 	if err != nil {
 		return fmt.Errorf("save attribute: %w", err)
@@ -2868,13 +3399,13 @@ func scanAttribute(row rowScanner) (*repository.AttributeReadModel, error) {
 		idStr, personIDStr, factType string
 		value                        string
 		dateRaw, place               sql.NullString
-		dateSort                     sql.NullString
+		dateSort, researchStatus     sql.NullString
 		version                      int64
 		createdAt                    string
 	)
 
 	err := row.Scan(&idStr, &personIDStr, &factType, &value, &dateRaw, &dateSort,
-		&place, &version, &createdAt)
+		&place, &researchStatus, &version, &createdAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -2896,7 +3427,10 @@ func scanAttribute(row rowScanner) (*repository.AttributeReadModel, error) {
 		Version:  version,
 	}
 
-	if dateSort.Valid {
+	if researchStatus.Valid {
+		attr.ResearchStatus = domain.ResearchStatus(researchStatus.String)
+	}
+	if dateSort.Valid {
 		if t, err := parseTimestamp(dateSort.String); err == nil {
 			attr.DateSort = &t
 		}
@@ -2918,7 +3452,8 @@ func (s *ReadModelStore) GetMedia(ctx context.Context, id uuid.UUID) (*repositor
 		SELECT id, entity_type, entity_id, title, description, mime_type, media_type,
 			   filename, file_size, crop_left, crop_top, crop_width, crop_height,
 			   gedcom_xref, version, created_at, updated_at,
-			   files, format, translations
+			   files, format, translations, captured_at, gps_latitude, gps_longitude,
+			   content_hash, storage_key
 		FROM media WHERE id = ?
 	`, id.String())
 
@@ -2928,12 +3463,15 @@ func (s *ReadModelStore) GetMedia(ctx context.Context, id uuid.UUID) (*repositor
 // GetMediaWithData retrieves full media record including FileData and ThumbnailData.
 func (s *ReadModelStore) GetMediaWithData(ctx context.Context, id uuid.UUID) (*repository.MediaReadModel, error) {
 	row := s.db.QueryRowContext(ctx, `
-		SELECT id, entity_type, entity_id, title, description, mime_type, media_type,
-			   filename, file_size, file_data, thumbnail_data,
+		SELECT m.id, entity_type, entity_id, title, description, mime_type, media_type,
+			   filename, file_size, COALESCE(c.file_data, m.file_data), thumbnail_data,
 			   crop_left, crop_top, crop_width, crop_height,
 			   gedcom_xref, version, created_at, updated_at,
-			   files, format, translations
-		FROM media WHERE id = ?
+			   files, format, translations, captured_at, gps_latitude, gps_longitude,
+			   m.content_hash, storage_key
+		FROM media m
+		LEFT JOIN media_content c ON c.content_hash = m.content_hash
+		WHERE m.id = ?
 	`, id.String())
 
 	return scanMediaFull(row)
@@ -2968,7 +3506,8 @@ func (s *ReadModelStore) ListMediaForEntity(ctx context.Context, entityType stri
 		SELECT id, entity_type, entity_id, title, description, mime_type, media_type,
 			   filename, file_size, crop_left, crop_top, crop_width, crop_height,
 			   gedcom_xref, version, created_at, updated_at,
-			   files, format, translations
+			   files, format, translations, captured_at, gps_latitude, gps_longitude,
+			   content_hash, storage_key
 		FROM media
 		WHERE entity_type = ? AND entity_id = ?
 		ORDER BY created_at DESC
@@ -3003,13 +3542,41 @@ func (s *ReadModelStore) SaveMedia(ctx context.Context, media *repository.MediaR
 		return fmt.Errorf("marshal translations: %w", err)
 	}
 
-	_, err = s.db.ExecContext(ctx, `
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var prevHash sql.NullString
+	err = tx.QueryRowContext(ctx, "SELECT content_hash FROM media WHERE id = ?", media.ID.String()).Scan(&prevHash)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("get media content hash: %w", err)
+	}
+
+	// file_data is NOT NULL; content held in a blob store or shared through
+	// media_content is stored as empty
+	fileData := media.FileData
+	if media.ContentHash != "" && len(fileData) > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT OR IGNORE INTO media_content (content_hash, file_data) VALUES (?, ?)
+		`, media.ContentHash, fileData); err != nil {
+			return fmt.Errorf("save media content: %w", err)
+		}
+		fileData = nil
+	}
+	if fileData == nil {
+		fileData = []byte{}
+	}
+
+	_, err = tx.ExecContext(ctx, `
 		INSERT INTO media (id, entity_type, entity_id, title, description, mime_type, media_type,
 						  filename, file_size, file_data, thumbnail_data,
 						  crop_left, crop_top, crop_width, crop_height,
 						  gedcom_xref, version, created_at, updated_at,
-						  files, format, translations)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+						  files, format, translations, captured_at, gps_latitude, gps_longitude,
+						  content_hash, storage_key)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(id) DO UPDATE SET
 			entity_type = excluded.entity_type,
 			entity_id = excluded.entity_id,
@@ -3030,23 +3597,131 @@ func (s *ReadModelStore) SaveMedia(ctx context.Context, media *repository.MediaR
 			updated_at = excluded.updated_at,
 			files = excluded.files,
 			format = excluded.format,
-			translations = excluded.translations
+			translations = excluded.translations,
+			captured_at = excluded.captured_at,
+			gps_latitude = excluded.gps_latitude,
+			gps_longitude = excluded.gps_longitude,
+			content_hash = excluded.content_hash,
+			storage_key = excluded.storage_key
 	`, media.ID.String(), media.EntityType, media.EntityID.String(), media.Title,
 		nullableString(media.Description), media.MimeType, string(media.MediaType),
-		media.Filename, media.FileSize, media.FileData, media.ThumbnailData,
+		media.Filename, media.FileSize, fileData, media.ThumbnailData,
 		nullableInt(media.CropLeft), nullableInt(media.CropTop),
 		nullableInt(media.CropWidth), nullableInt(media.CropHeight),
 		nullableString(media.GedcomXref), media.Version,
 		formatTimestamp(media.CreatedAt), formatTimestamp(media.UpdatedAt),
-		nullableBytes(filesJSON), nullableString(media.Format), nullableBytes(translationsJSON))
+		nullableBytes(filesJSON), nullableString(media.Format), nullableBytes(translationsJSON),
+		nullableTimestamp(media.CapturedAt), nullableFloat(media.GPSLatitude), nullableFloat(media.GPSLongitude),
+		nullableString(media.ContentHash), nullableString(media.StorageKey))
+	if err != nil {
+		return err
+	}
 
-	return err
+	if prevHash.Valid && prevHash.String != media.ContentHash {
+		if err := pruneMediaContent(ctx, tx, prevHash.String); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
 }
 
-// DeleteMedia removes a media record.
+// DeleteMedia removes a media record, and its content once no other media
+// shares it.
 func (s *ReadModelStore) DeleteMedia(ctx context.Context, id uuid.UUID) error {
-	_, err := s.db.ExecContext(ctx, "DELETE FROM media WHERE id = ?", id.String())
-	return err
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var hash sql.NullString
+	err = tx.QueryRowContext(ctx, "SELECT content_hash FROM media WHERE id = ?", id.String()).Scan(&hash)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get media content hash: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM media WHERE id = ?", id.String()); err != nil {
+		return err
+	}
+	if hash.Valid {
+		if err := pruneMediaContent(ctx, tx, hash.String); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// pruneMediaContent deletes the shared content for hash once no media row
+// references it.
+func pruneMediaContent(ctx context.Context, tx *sql.Tx, hash string) error {
+	_, err := tx.ExecContext(ctx, `
+		DELETE FROM media_content
+		WHERE content_hash = ? AND NOT EXISTS (SELECT 1 FROM media WHERE content_hash = ?)
+	`, hash, hash)
+	if err != nil {
+		return fmt.Errorf("prune media content: %w", err)
+	}
+	return nil
+}
+
+// FindMediaByContentHash returns the oldest media record, including file data,
+// whose content has the given hash.
+func (s *ReadModelStore) FindMediaByContentHash(ctx context.Context, hash string) (*repository.MediaReadModel, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT m.id, entity_type, entity_id, title, description, mime_type, media_type,
+			   filename, file_size, COALESCE(c.file_data, m.file_data), thumbnail_data,
+			   crop_left, crop_top, crop_width, crop_height,
+			   gedcom_xref, version, created_at, updated_at,
+			   files, format, translations, captured_at, gps_latitude, gps_longitude,
+			   m.content_hash, storage_key
+		FROM media m
+		LEFT JOIN media_content c ON c.content_hash = m.content_hash
+		WHERE m.content_hash = ?
+		ORDER BY created_at ASC
+		LIMIT 1
+	`, hash)
+
+	return scanMediaFull(row)
+}
+
+// ListDuplicateMedia groups media records whose file content is identical.
+func (s *ReadModelStore) ListDuplicateMedia(ctx context.Context) ([]repository.MediaDuplicateGroup, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, entity_type, entity_id, title, description, mime_type, media_type,
+			   filename, file_size, crop_left, crop_top, crop_width, crop_height,
+			   gedcom_xref, version, created_at, updated_at,
+			   files, format, translations, captured_at, gps_latitude, gps_longitude,
+			   content_hash, storage_key
+		FROM media
+		WHERE content_hash IN (
+			SELECT content_hash FROM media
+			WHERE content_hash IS NOT NULL
+			GROUP BY content_hash HAVING COUNT(*) > 1
+		)
+		ORDER BY content_hash, created_at
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query duplicate media: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []repository.MediaDuplicateGroup
+	for rows.Next() {
+		m, err := scanMediaMetadataRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		if n := len(groups); n == 0 || groups[n-1].ContentHash != m.ContentHash {
+			groups = append(groups, repository.MediaDuplicateGroup{ContentHash: m.ContentHash, FileSize: m.FileSize})
+		}
+		last := &groups[len(groups)-1]
+		last.Media = append(last.Media, *m)
+	}
+
+	return groups, rows.Err()
 }
 
 // Media scanner helpers
@@ -3064,13 +3739,18 @@ func scanMediaMetadata(row rowScanner) (*repository.MediaReadModel, error) {
 		// GEDCOM 7.0 enhanced fields
 		filesJSON, translationsJSON sql.NullString
 		format                      sql.NullString
+		// EXIF metadata
+		capturedAt                sql.NullString
+		gpsLatitude, gpsLongitude sql.NullFloat64
+		contentHash, storageKey   sql.NullString
 	)
 
 	err := row.Scan(&idStr, &entityType, &entityIDStr, &title, &description,
 		&mimeType, &mediaType, &filename, &fileSize,
 		&cropLeft, &cropTop, &cropWidth, &cropHeight,
 		&gedcomXref, &version, &createdAt, &updatedAt,
-		&filesJSON, &format, &translationsJSON)
+		&filesJSON, &format, &translationsJSON, &capturedAt, &gpsLatitude, &gpsLongitude,
+		&contentHash, &storageKey)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -3102,6 +3782,8 @@ func scanMediaMetadata(row rowScanner) (*repository.MediaReadModel, error) {
 		MediaType:    domain.MediaType(mediaType),
 		Filename:     filename,
 		FileSize:     fileSize,
+		ContentHash:  contentHash.String,
+		StorageKey:   storageKey.String,
 		GedcomXref:   gedcomXref.String,
 		Version:      version,
 		Files:        files,
@@ -3132,6 +3814,7 @@ func scanMediaMetadata(row rowScanner) (*repository.MediaReadModel, error) {
 	if t, err := parseTimestamp(updatedAt); err == nil {
 		m.UpdatedAt = t
 	}
+	applyMediaExif(m, capturedAt, gpsLatitude, gpsLongitude)
 
 	return m, nil
 }
@@ -3154,13 +3837,18 @@ func scanMediaFull(row rowScanner) (*repository.MediaReadModel, error) {
 		// GEDCOM 7.0 enhanced fields
 		filesJSON, translationsJSON sql.NullString
 		format                      sql.NullString
+		// EXIF metadata
+		capturedAt                sql.NullString
+		gpsLatitude, gpsLongitude sql.NullFloat64
+		contentHash, storageKey   sql.NullString
 	)
 
 	err := row.Scan(&idStr, &entityType, &entityIDStr, &title, &description,
 		&mimeType, &mediaType, &filename, &fileSize, &fileData, &thumbnailData,
 		&cropLeft, &cropTop, &cropWidth, &cropHeight,
 		&gedcomXref, &version, &createdAt, &updatedAt,
-		&filesJSON, &format, &translationsJSON)
+		&filesJSON, &format, &translationsJSON, &capturedAt, &gpsLatitude, &gpsLongitude,
+		&contentHash, &storageKey)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -3194,6 +3882,8 @@ func scanMediaFull(row rowScanner) (*repository.MediaReadModel, error) {
 		FileSize:      fileSize,
 		FileData:      fileData,
 		ThumbnailData: thumbnailData,
+		ContentHash:   contentHash.String,
+		StorageKey:    storageKey.String,
 		GedcomXref:    gedcomXref.String,
 		Version:       version,
 		Files:         files,
@@ -3224,16 +3914,37 @@ func scanMediaFull(row rowScanner) (*repository.MediaReadModel, error) {
 	if t, err := parseTimestamp(updatedAt); err == nil {
 		m.UpdatedAt = t
 	}
+	applyMediaExif(m, capturedAt, gpsLatitude, gpsLongitude)
 
 	return m, nil
 }
 
+// applyMediaExif sets the nullable EXIF columns on a scanned media record.
+func applyMediaExif(m *repository.MediaReadModel, capturedAt sql.NullString, lat, lon sql.NullFloat64) {
+	if capturedAt.Valid {
+		if t, err := parseTimestamp(capturedAt.String); err == nil {
+			m.CapturedAt = &t
+		}
+	}
+	if lat.Valid {
+		m.GPSLatitude = &lat.Float64
+	}
+	if lon.Valid {
+		m.GPSLongitude = &lon.Float64
+	}
+}
+
 // GetSurnameIndex returns all unique surnames with counts and letter counts.
+// Persons whose primary name is a patronymic have no family surname and are skipped.
 func (s *ReadModelStore) GetSurnameIndex(ctx context.Context) ([]repository.SurnameEntry, []repository.LetterCount, error) {
 	// Get surname counts
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT surname, COUNT(*) as count
 		FROM persons
+		WHERE NOT EXISTS (
+			SELECT 1 FROM person_names pn
+			WHERE pn.person_id = persons.id AND pn.is_primary = 1 AND pn.name_type = 'patronymic'
+		)
 		GROUP BY surname
 		ORDER BY surname ASC
 	`)
@@ -3258,7 +3969,10 @@ func (s *ReadModelStore) GetSurnameIndex(ctx context.Context) ([]repository.Surn
 	letterRows, err := s.db.QueryContext(ctx, `
 		SELECT UPPER(SUBSTR(surname, 1, 1)) as letter, COUNT(DISTINCT surname) as count
 		FROM persons
-		WHERE surname != ''
+		WHERE surname != '' AND NOT EXISTS (
+			SELECT 1 FROM person_names pn
+			WHERE pn.person_id = persons.id AND pn.is_primary = 1 AND pn.name_type = 'patronymic'
+		)
 		GROUP BY UPPER(SUBSTR(surname, 1, 1))
 		ORDER BY letter ASC
 	`)
@@ -3284,7 +3998,10 @@ func (s *ReadModelStore) GetSurnamesByLetter(ctx context.Context, letter string)
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT surname, COUNT(*) as count
 		FROM persons
-		WHERE UPPER(SUBSTR(surname, 1, 1)) = UPPER(?)
+		WHERE UPPER(SUBSTR(surname, 1, 1)) = UPPER(?) AND NOT EXISTS (
+			SELECT 1 FROM person_names pn
+			WHERE pn.person_id = persons.id AND pn.is_primary = 1 AND pn.name_type = 'patronymic'
+		)
 		GROUP BY surname
 		ORDER BY surname ASC
 	`, letter)
@@ -3319,7 +4036,7 @@ func (s *ReadModelStore) GetPersonsBySurname(ctx context.Context, surname string
 			   birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
 			   death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
 			   notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-			   version, updated_at
+			   version, updated_at, created_at
 		FROM persons
 		WHERE LOWER(surname) = LOWER(?)
 		ORDER BY given_name ASC
@@ -3461,7 +4178,7 @@ func (s *ReadModelStore) GetPersonsByPlace(ctx context.Context, place string, op
 			   birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
 			   death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
 			   notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
-			   version, updated_at
+			   version, updated_at, created_at
 		FROM persons
 		WHERE birth_place LIKE '%' || ? || '%' OR death_place LIKE '%' || ? || '%'
 		ORDER BY surname ASC, given_name ASC
@@ -3488,7 +4205,7 @@ func (s *ReadModelStore) GetPersonsByPlace(ctx context.Context, place string, op
 func (s *ReadModelStore) GetCemeteryIndex(ctx context.Context) ([]repository.CemeteryEntry, error) {
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT place, COUNT(DISTINCT owner_id) as count
-		FROM events
+		FROM life_events
 		WHERE fact_type IN (?, ?) AND place != '' AND place IS NOT NULL
 		GROUP BY place
 		ORDER BY place ASC
@@ -3517,7 +4234,7 @@ func (s *ReadModelStore) GetPersonsByCemetery(ctx context.Context, place string,
 	err := s.db.QueryRowContext(ctx, `
 		SELECT COUNT(DISTINCT p.id)
 		FROM persons p
-		INNER JOIN events e ON e.owner_id = p.id
+		INNER JOIN life_events e ON e.owner_id = p.id
 		WHERE e.fact_type IN (?, ?) AND LOWER(e.place) = LOWER(?)
 	`, string(domain.FactPersonBurial), string(domain.FactPersonCremation), place).Scan(&total)
 	if err != nil {
@@ -3529,9 +4246,9 @@ func (s *ReadModelStore) GetPersonsByCemetery(ctx context.Context, place string,
 			   p.birth_date_raw, p.birth_date_sort, p.birth_place, p.birth_place_lat, p.birth_place_long,
 			   p.death_date_raw, p.death_date_sort, p.death_place, p.death_place_lat, p.death_place_long,
 			   p.notes, p.research_status, p.brick_wall_note, p.brick_wall_since, p.brick_wall_resolved_at,
-			   p.version, p.updated_at
+			   p.version, p.updated_at, p.created_at
 		FROM persons p
-		INNER JOIN events e ON e.owner_id = p.id
+		INNER JOIN life_events e ON e.owner_id = p.id
 		WHERE e.fact_type IN (?, ?) AND LOWER(e.place) = LOWER(?)
 		ORDER BY p.surname ASC, p.given_name ASC
 		LIMIT ? OFFSET ?
@@ -3553,6 +4270,253 @@ func (s *ReadModelStore) GetPersonsByCemetery(ctx context.Context, place string,
 	return persons, total, rows.Err()
 }
 
+// GetBirthDecadeIndex returns person counts per birth decade, with undated
+// persons counted in a trailing entry with a nil decade.
+func (s *ReadModelStore) GetBirthDecadeIndex(ctx context.Context) ([]repository.BirthDecadeEntry, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT CAST(SUBSTR(birth_date_sort, 1, 4) AS INTEGER) / 10 * 10 AS decade, COUNT(*) as count
+		FROM persons
+		WHERE birth_date_sort IS NOT NULL AND birth_date_sort != ''
+		GROUP BY decade
+		ORDER BY decade ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query birth decade index: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []repository.BirthDecadeEntry
+	for rows.Next() {
+		var decade, count int
+		if err := rows.Scan(&decade, &count); err != nil {
+			return nil, fmt.Errorf("scan birth decade entry: %w", err)
+		}
+		entries = append(entries, repository.BirthDecadeEntry{Decade: &decade, Count: count})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var unknown int
+	err = s.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM persons WHERE birth_date_sort IS NULL OR birth_date_sort = ''").Scan(&unknown)
+	if err != nil {
+		return nil, fmt.Errorf("count undated persons: %w", err)
+	}
+	if unknown > 0 {
+		entries = append(entries, repository.BirthDecadeEntry{Count: unknown})
+	}
+
+	return entries, nil
+}
+
+// GetPersonsByBirthDecade returns persons born in the given decade, or persons
+// without a parseable birth date when decade is nil.
+func (s *ReadModelStore) GetPersonsByBirthDecade(ctx context.Context, decade *int, opts repository.ListOptions) ([]repository.PersonReadModel, int, error) {
+	where := "(birth_date_sort IS NULL OR birth_date_sort = '')"
+	var args []any
+	if decade != nil {
+		// birth_date_sort is stored as YYYY-MM-DD, so a string range selects the decade
+		where = "birth_date_sort >= ? AND birth_date_sort < ?"
+		args = append(args, fmt.Sprintf("%04d-01-01", *decade), fmt.Sprintf("%04d-01-01", *decade+10))
+	}
+
+	var total int
+	// nosemgrep: go.lang.security.audit.database.string-formatted-query.string-formatted-query -- where is a constant condition with parameterized placeholders
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM persons WHERE "+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count persons by birth decade: %w", err)
+	}
+
+	// nosemgrep: go.lang.security.audit.database.string-formatted-query.string-formatted-query -- where is a constant condition with parameterized placeholders
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, given_name, surname, full_name, gender,
+			   birth_date_raw, birth_date_sort, birth_place, birth_place_lat, birth_place_long,
+			   death_date_raw, death_date_sort, death_place, death_place_lat, death_place_long,
+			   notes, research_status, brick_wall_note, brick_wall_since, brick_wall_resolved_at,
+			   version, updated_at, created_at
+		FROM persons
+		WHERE `+where+`
+		ORDER BY birth_date_sort ASC, surname ASC, given_name ASC
+		LIMIT ? OFFSET ?
+	`, append(args, opts.Limit, opts.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query persons by birth decade: %w", err)
+	}
+	defer rows.Close()
+
+	var persons []repository.PersonReadModel
+	for rows.Next() {
+		p, err := scanPersonRow(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		persons = append(persons, *p)
+	}
+
+	return persons, total, rows.Err()
+}
+
+// GetSourceRepositoryIndex returns each distinct source repository name with its source count.
+func (s *ReadModelStore) GetSourceRepositoryIndex(ctx context.Context) ([]repository.SourceRepositoryEntry, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT repository_name, COUNT(*) as count
+		FROM sources
+		WHERE repository_name IS NOT NULL AND repository_name != ''
+		GROUP BY repository_name
+		ORDER BY repository_name ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query source repository index: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []repository.SourceRepositoryEntry
+	for rows.Next() {
+		var entry repository.SourceRepositoryEntry
+		if err := rows.Scan(&entry.Name, &entry.Count); err != nil {
+			return nil, fmt.Errorf("scan source repository entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// GetSourcesByRepositoryName returns sources held by the named repository.
+func (s *ReadModelStore) GetSourcesByRepositoryName(ctx context.Context, name string, opts repository.ListOptions) ([]repository.SourceReadModel, int, error) {
+	var total int
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sources WHERE LOWER(repository_name) = LOWER(?)", name).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count sources by repository: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, source_type, title, author, publisher, publish_date_raw, publish_date_sort,
+			   url, repository_id, repository_name, collection_name, call_number, notes, gedcom_xref,
+			   citation_count, version, updated_at
+		FROM sources
+		WHERE LOWER(repository_name) = LOWER(?)
+		ORDER BY title ASC
+		LIMIT ? OFFSET ?
+	`, name, opts.Limit, opts.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query sources by repository: %w", err)
+	}
+	defer rows.Close()
+
+	var sources []repository.SourceReadModel
+	for rows.Next() {
+		src, err := scanSourceRow(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		sources = append(sources, *src)
+	}
+
+	return sources, total, rows.Err()
+}
+
+// ListFactTypeUsage returns every fact type used by a life event, attribute,
+// or citation, with its counts, ordered by fact type.
+func (s *ReadModelStore) ListFactTypeUsage(ctx context.Context) ([]repository.FactTypeUsage, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT fact_type,
+			   SUM(CASE WHEN kind = 'event' THEN 1 ELSE 0 END),
+			   SUM(CASE WHEN kind = 'attribute' THEN 1 ELSE 0 END),
+			   SUM(CASE WHEN kind = 'citation' THEN 1 ELSE 0 END)
+		FROM (
+			SELECT fact_type, 'event' AS kind FROM life_events
+			UNION ALL SELECT fact_type, 'attribute' FROM attributes
+			UNION ALL SELECT fact_type, 'citation' FROM citations
+		) AS facts
+		GROUP BY fact_type
+		ORDER BY fact_type ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query fact type usage: %w", err)
+	}
+	defer rows.Close()
+
+	var usage []repository.FactTypeUsage
+	for rows.Next() {
+		var u repository.FactTypeUsage
+		if err := rows.Scan(&u.FactType, &u.EventCount, &u.AttributeCount, &u.CitationCount); err != nil {
+			return nil, fmt.Errorf("scan fact type usage: %w", err)
+		}
+		usage = append(usage, u)
+	}
+
+	return usage, rows.Err()
+}
+
+// ListPlaceUsage returns every distinct place string used by persons, families,
+// and life events, with its reference count.
+func (s *ReadModelStore) ListPlaceUsage(ctx context.Context) ([]repository.PlaceUsage, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT place, COUNT(*) as count
+		FROM (
+			SELECT birth_place AS place FROM persons
+			UNION ALL SELECT death_place FROM persons
+			UNION ALL SELECT marriage_place FROM families
+			UNION ALL SELECT place FROM life_events
+		) AS places
+		WHERE place IS NOT NULL AND place != ''
+		GROUP BY place
+		ORDER BY place ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query place usage: %w", err)
+	}
+	defer rows.Close()
+
+	var usage []repository.PlaceUsage
+	for rows.Next() {
+		var u repository.PlaceUsage
+		if err := rows.Scan(&u.Place, &u.Count); err != nil {
+			return nil, fmt.Errorf("scan place usage: %w", err)
+		}
+		usage = append(usage, u)
+	}
+
+	return usage, rows.Err()
+}
+
+// FindPlaceReferences returns every person, family, and life event field whose
+// place exactly matches place.
+func (s *ReadModelStore) FindPlaceReferences(ctx context.Context, place string) ([]repository.PlaceReference, error) {
+	var refs []repository.PlaceReference
+	if place == "" {
+		return refs, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT 'person', id, 'birth_place' FROM persons WHERE birth_place = ?
+		UNION ALL SELECT 'person', id, 'death_place' FROM persons WHERE death_place = ?
+		UNION ALL SELECT 'family', id, 'marriage_place' FROM families WHERE marriage_place = ?
+		UNION ALL SELECT 'event', id, 'place' FROM life_events WHERE place = ?
+	`, place, place, place, place)
+	if err != nil {
+		return nil, fmt.Errorf("query place references: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ref repository.PlaceReference
+		var id string
+		if err := rows.Scan(&ref.EntityType, &id, &ref.Field); err != nil {
+			return nil, fmt.Errorf("scan place reference: %w", err)
+		}
+		ref.EntityID, err = uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("parse place reference id: %w", err)
+		}
+		refs = append(refs, ref)
+	}
+
+	return refs, rows.Err()
+}
+
 // GetMapLocations returns aggregated geographic locations from person birth/death coordinates.
 func (s *ReadModelStore) GetMapLocations(ctx context.Context) ([]repository.MapLocation, error) {
 	// Query birth locations
@@ -3731,44 +4695,18 @@ func (s *ReadModelStore) GetBrickWalls(ctx context.Context, includeResolved bool
 // GetNote retrieves a note by ID.
 func (s *ReadModelStore) GetNote(ctx context.Context, id uuid.UUID) (*repository.NoteReadModel, error) {
 	row := s.db.QueryRowContext(ctx, `
-		SELECT id, text, mime, language, translations, gedcom_xref, version, updated_at
+		SELECT id, text, mime, language, translations, gedcom_xref, links, version, updated_at
 		FROM notes WHERE id = ?
 	`, id.String())
 
-	var note repository.NoteReadModel
-	var idStr string
-	var mime, language, translations, gedcomXref sql.NullString
-	var updatedAtStr string
-
-	err := row.Scan(
-		&idStr,
-		&note.Text,
-		&mime,
-		&language,
-		&translations,
-		&gedcomXref,
-		&note.Version,
-		&updatedAtStr,
-	)
+	note, err := scanNote(row)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, fmt.Errorf("scan note: %w", err)
 	}
-
-	note.ID, _ = uuid.Parse(idStr)
-	note.MIME = mime.String
-	note.Language = language.String
-	note.Translations = repository.UnmarshalNoteTranslations(translations.String)
-	if gedcomXref.Valid {
-		note.GedcomXref = gedcomXref.String
-	}
-	if t, err := parseTimestamp(updatedAtStr); err == nil {
-		note.UpdatedAt = t
-	}
-
-	return &note, nil
+	return note, nil
 }
 
 // ListNotes returns a paginated list of notes.
@@ -3789,7 +4727,7 @@ func (s *ReadModelStore) ListNotes(ctx context.Context, opts repository.ListOpti
 
 	// #nosec G201 -- orderColumn and orderDir are validated via switch/if above, not user input
 	query := fmt.Sprintf(`
-		SELECT id, text, mime, language, translations, gedcom_xref, version, updated_at
+		SELECT id, text, mime, language, translations, gedcom_xref, links, version, updated_at
 		FROM notes
 		ORDER BY %s %s
 		LIMIT ? OFFSET ?
@@ -3803,38 +4741,80 @@ func (s *ReadModelStore) ListNotes(ctx context.Context, opts repository.ListOpti
 
 	var notes []repository.NoteReadModel
 	for rows.Next() {
-		var note repository.NoteReadModel
-		var idStr string
-		var mime, language, translations, gedcomXref sql.NullString
-		var updatedAtStr string
-
-		if err := rows.Scan(
-			&idStr,
-			&note.Text,
-			&mime,
-			&language,
-			&translations,
-			&gedcomXref,
-			&note.Version,
-			&updatedAtStr,
-		); err != nil {
+		note, err := scanNote(rows)
+		if err != nil {
 			return nil, 0, fmt.Errorf("scan note: %w", err)
 		}
+		notes = append(notes, *note)
+	}
 
-		note.ID, _ = uuid.Parse(idStr)
-		note.MIME = mime.String
-		note.Language = language.String
-		note.Translations = repository.UnmarshalNoteTranslations(translations.String)
-		if gedcomXref.Valid {
-			note.GedcomXref = gedcomXref.String
+	return notes, total, rows.Err()
+}
+
+// ListNotesForEntity returns the notes attached to a person, family, or source.
+func (s *ReadModelStore) ListNotesForEntity(ctx context.Context, entityID uuid.UUID) ([]repository.NoteReadModel, error) {
+	// Links are stored as JSON; match the ID textually, then confirm on the decoded links.
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, text, mime, language, translations, gedcom_xref, links, version, updated_at
+		FROM notes
+		WHERE links LIKE ?
+		ORDER BY updated_at
+	`, "%"+entityID.String()+"%")
+	if err != nil {
+		return nil, fmt.Errorf("query notes for entity: %w", err)
+	}
+	defer rows.Close()
+
+	var notes []repository.NoteReadModel
+	for rows.Next() {
+		note, err := scanNote(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan note: %w", err)
 		}
-		if t, err := parseTimestamp(updatedAtStr); err == nil {
-			note.UpdatedAt = t
+		for _, l := range note.Links {
+			if l.EntityID == entityID {
+				notes = append(notes, *note)
+				break
+			}
 		}
-		notes = append(notes, note)
 	}
 
-	return notes, total, rows.Err()
+	return notes, rows.Err()
+}
+
+func scanNote(row rowScanner) (*repository.NoteReadModel, error) {
+	var note repository.NoteReadModel
+	var idStr string
+	var mime, language, translations, gedcomXref, links sql.NullString
+	var updatedAtStr string
+
+	if err := row.Scan(
+		&idStr,
+		&note.Text,
+		&mime,
+		&language,
+		&translations,
+		&gedcomXref,
+		&links,
+		&note.Version,
+		&updatedAtStr,
+	); err != nil {
+		return nil, err
+	}
+
+	note.ID, _ = uuid.Parse(idStr)
+	note.MIME = mime.String
+	note.Language = language.String
+	note.Translations = repository.UnmarshalNoteTranslations(translations.String)
+	note.Links = repository.UnmarshalNoteLinks(links.String)
+	if gedcomXref.Valid {
+		note.GedcomXref = gedcomXref.String
+	}
+	if t, err := parseTimestamp(updatedAtStr); err == nil {
+		note.UpdatedAt = t
+	}
+
+	return &note, nil
 }
 
 // SaveNote saves or updates a note.
@@ -3855,19 +4835,24 @@ func (s *ReadModelStore) SaveNote(ctx context.Context, note *repository.NoteRead
 	if len(note.Translations) > 0 {
 		translations = repository.MarshalNoteTranslations(note.Translations)
 	}
+	var links any
+	if len(note.Links) > 0 {
+		links = repository.MarshalNoteLinks(note.Links)
+	}
 
 	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO notes (id, text, mime, language, translations, gedcom_xref, version, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO notes (id, text, mime, language, translations, gedcom_xref, links, version, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT (id) DO UPDATE SET
 			text = excluded.text,
 			mime = excluded.mime,
 			language = excluded.language,
 			translations = excluded.translations,
 			gedcom_xref = excluded.gedcom_xref,
+			links = excluded.links,
 			version = excluded.version,
 			updated_at = excluded.updated_at
-	`, note.ID.String(), note.Text, mime, language, translations, gedcomXref, note.Version, note.UpdatedAt.Format(time.RFC3339))
+	`, note.ID.String(), note.Text, mime, language, translations, gedcomXref, links, note.Version, note.UpdatedAt.Format(time.RFC3339))
 	if err != nil {
 		return fmt.Errorf("save note: %w", err)
 	}
@@ -1,8 +1,11 @@
 package sqlite_test
 
 import (
+	"bytes"
 	"context"
 	"os"
+	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
@@ -41,6 +44,111 @@ func setupTestReadModelDB(t *testing.T) (*sqlite.ReadModelStore, func()) {
 	}
 }
 
+// TestReadModelStore_ListSourcesSort verifies sources can be ordered by
+// citation count, with ties broken by title.
+func TestReadModelStore_ListSourcesSort(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	for title, count := range map[string]int{"Census": 5, "Bible": 0, "Almanac": 5, "Parish Register": 12} {
+		err := store.SaveSource(ctx, &repository.SourceReadModel{
+			ID:            uuid.New(),
+			SourceType:    domain.SourceBook,
+			Title:         title,
+			CitationCount: count,
+			Version:       1,
+			UpdatedAt:     time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("SaveSource: %v", err)
+		}
+	}
+
+	sources, total, err := store.ListSources(ctx, repository.ListOptions{Limit: 10, Sort: "citation_count", Order: "desc"})
+	if err != nil {
+		t.Fatalf("ListSources: %v", err)
+	}
+	if total != 4 {
+		t.Errorf("total = %d, want 4", total)
+	}
+	var got []string
+	for _, src := range sources {
+		got = append(got, src.Title)
+	}
+	want := []string{"Parish Register", "Census", "Almanac", "Bible"}
+	if !slices.Equal(got, want) {
+		t.Errorf("titles = %v, want %v", got, want)
+	}
+}
+
+// TestReadModelStore_ListCitationsForSource verifies citation paging and
+// filtering.
+func TestReadModelStore_ListCitationsForSource(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	sourceID := uuid.New()
+	otherSourceID := uuid.New()
+	for _, id := range []uuid.UUID{sourceID, otherSourceID} {
+		src := &repository.SourceReadModel{ID: id, SourceType: domain.SourceBook, Title: "Source", Version: 1, UpdatedAt: time.Now()}
+		if err := store.SaveSource(ctx, src); err != nil {
+			t.Fatalf("SaveSource: %v", err)
+		}
+	}
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	factTypes := []domain.FactType{domain.FactPersonBirth, domain.FactPersonDeath, domain.FactPersonBirth}
+	qualities := []domain.SourceQuality{domain.SourceOriginal, domain.SourceOriginal, domain.SourceDerivative}
+	evidence := []domain.EvidenceType{domain.EvidenceDirect, domain.EvidenceIndirect, domain.EvidenceDirect}
+	var ids []uuid.UUID
+	for i, factType := range factTypes {
+		cit := &repository.CitationReadModel{
+			ID:            uuid.New(),
+			SourceID:      sourceID,
+			FactType:      factType,
+			FactOwnerID:   uuid.New(),
+			SourceQuality: qualities[i],
+			EvidenceType:  evidence[i],
+			Version:       1,
+			CreatedAt:     base.Add(time.Duration(i) * time.Hour),
+		}
+		if err := store.SaveCitation(ctx, cit); err != nil {
+			t.Fatalf("SaveCitation: %v", err)
+		}
+		ids = append(ids, cit.ID)
+	}
+	other := &repository.CitationReadModel{ID: uuid.New(), SourceID: otherSourceID, FactType: domain.FactPersonBirth, FactOwnerID: uuid.New(), Version: 1, CreatedAt: base}
+	if err := store.SaveCitation(ctx, other); err != nil {
+		t.Fatalf("SaveCitation: %v", err)
+	}
+
+	page, total, err := store.ListCitationsForSource(ctx, sourceID, repository.CitationFilter{}, repository.ListOptions{Limit: 2, Offset: 1})
+	if err != nil {
+		t.Fatalf("ListCitationsForSource: %v", err)
+	}
+	if total != 3 || len(page) != 2 || page[0].ID != ids[1] || page[1].ID != ids[2] {
+		t.Errorf("page = %d citations of %d, want citations 2-3 of 3", len(page), total)
+	}
+
+	births, total, err := store.ListCitationsForSource(ctx, sourceID, repository.CitationFilter{FactType: domain.FactPersonBirth}, repository.ListOptions{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListCitationsForSource: %v", err)
+	}
+	if total != 2 || len(births) != 2 || births[0].ID != ids[0] || births[1].ID != ids[2] {
+		t.Errorf("births = %d citations of %d, want the two birth citations", len(births), total)
+	}
+
+	filter := repository.CitationFilter{SourceQuality: domain.SourceOriginal, EvidenceType: domain.EvidenceDirect}
+	strong, total, err := store.ListCitationsForSource(ctx, sourceID, filter, repository.ListOptions{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListCitationsForSource: %v", err)
+	}
+	if total != 1 || len(strong) != 1 || strong[0].ID != ids[0] {
+		t.Errorf("original/direct = %d citations of %d, want only the first", len(strong), total)
+	}
+}
+
 // TestReadModelStore_SourceRepositoryID verifies the repository_id column round-trips
 // through SaveSource/GetSource, including the nil case (issue #525).
 func TestReadModelStore_SourceRepositoryID(t *testing.T) {
@@ -92,6 +200,215 @@ func TestReadModelStore_SourceRepositoryID(t *testing.T) {
 	}
 }
 
+// TestReadModelStore_MediaExif verifies the EXIF columns round-trip through
+// SaveMedia and the metadata and full-record getters, including NULLs.
+func TestReadModelStore_MediaExif(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	capturedAt := time.Date(1962, 3, 12, 14, 30, 5, 0, time.UTC)
+	lat, lon := 40.4462, -79.9667
+	withExif := &repository.MediaReadModel{
+		ID:           uuid.New(),
+		EntityType:   "person",
+		EntityID:     uuid.New(),
+		Title:        "Wedding",
+		MimeType:     "image/jpeg",
+		MediaType:    domain.MediaPhoto,
+		Filename:     "wedding.jpg",
+		FileSize:     3,
+		FileData:     []byte{1, 2, 3},
+		Version:      1,
+		CapturedAt:   &capturedAt,
+		GPSLatitude:  &lat,
+		GPSLongitude: &lon,
+	}
+	if err := store.SaveMedia(ctx, withExif); err != nil {
+		t.Fatalf("SaveMedia: %v", err)
+	}
+
+	got, err := store.GetMedia(ctx, withExif.ID)
+	if err != nil {
+		t.Fatalf("GetMedia: %v", err)
+	}
+	if got.CapturedAt == nil || !got.CapturedAt.Equal(capturedAt) {
+		t.Errorf("CapturedAt = %v, want %v", got.CapturedAt, capturedAt)
+	}
+	if got.GPSLatitude == nil || *got.GPSLatitude != lat {
+		t.Errorf("GPSLatitude = %v, want %v", got.GPSLatitude, lat)
+	}
+	if got.GPSLongitude == nil || *got.GPSLongitude != lon {
+		t.Errorf("GPSLongitude = %v, want %v", got.GPSLongitude, lon)
+	}
+
+	full, err := store.GetMediaWithData(ctx, withExif.ID)
+	if err != nil {
+		t.Fatalf("GetMediaWithData: %v", err)
+	}
+	if full.CapturedAt == nil || full.GPSLatitude == nil || full.GPSLongitude == nil {
+		t.Errorf("GetMediaWithData dropped EXIF fields: %+v", full)
+	}
+
+	withoutExif := *withExif
+	withoutExif.ID = uuid.New()
+	withoutExif.CapturedAt, withoutExif.GPSLatitude, withoutExif.GPSLongitude = nil, nil, nil
+	if err := store.SaveMedia(ctx, &withoutExif); err != nil {
+		t.Fatalf("SaveMedia: %v", err)
+	}
+	got, err = store.GetMedia(ctx, withoutExif.ID)
+	if err != nil {
+		t.Fatalf("GetMedia: %v", err)
+	}
+	if got.CapturedAt != nil || got.GPSLatitude != nil || got.GPSLongitude != nil {
+		t.Errorf("expected nil EXIF fields, got %v, %v, %v", got.CapturedAt, got.GPSLatitude, got.GPSLongitude)
+	}
+}
+
+// TestReadModelStore_DuplicateMedia verifies lookup and grouping by content hash.
+func TestReadModelStore_DuplicateMedia(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	data := []byte("same photo")
+	hash := domain.MediaContentHash(data)
+	base := time.Now().UTC().Truncate(time.Second)
+	save := func(hash string, data []byte, age time.Duration) uuid.UUID {
+		t.Helper()
+		m := &repository.MediaReadModel{
+			ID:          uuid.New(),
+			EntityType:  "person",
+			EntityID:    uuid.New(),
+			Title:       "Photo",
+			MimeType:    "image/jpeg",
+			MediaType:   domain.MediaPhoto,
+			Filename:    "photo.jpg",
+			FileSize:    int64(len(data)),
+			FileData:    data,
+			ContentHash: hash,
+			Version:     1,
+			CreatedAt:   base.Add(-age),
+		}
+		if err := store.SaveMedia(ctx, m); err != nil {
+			t.Fatalf("SaveMedia: %v", err)
+		}
+		return m.ID
+	}
+	older := save(hash, data, time.Hour)
+	newer := save(hash, data, 0)
+	save(domain.MediaContentHash([]byte("other")), []byte("other"), 0)
+
+	found, err := store.FindMediaByContentHash(ctx, hash)
+	if err != nil {
+		t.Fatalf("FindMediaByContentHash: %v", err)
+	}
+	if found == nil || found.ID != older || string(found.FileData) != string(data) {
+		t.Errorf("FindMediaByContentHash = %+v, want oldest record with data", found)
+	}
+	if missing, _ := store.FindMediaByContentHash(ctx, "nope"); missing != nil {
+		t.Errorf("FindMediaByContentHash(unknown) = %v, want nil", missing)
+	}
+
+	groups, err := store.ListDuplicateMedia(ctx)
+	if err != nil {
+		t.Fatalf("ListDuplicateMedia: %v", err)
+	}
+	if len(groups) != 1 {
+		t.Fatalf("ListDuplicateMedia returned %d groups, want 1", len(groups))
+	}
+	g := groups[0]
+	if g.ContentHash != hash || g.FileSize != int64(len(data)) {
+		t.Errorf("group = %s/%d, want %s/%d", g.ContentHash, g.FileSize, hash, len(data))
+	}
+	if len(g.Media) != 2 || g.Media[0].ID != older || g.Media[1].ID != newer {
+		t.Errorf("group media not ordered oldest first: %+v", g.Media)
+	}
+}
+
+// TestReadModelStore_MediaContentStoredOnce verifies media sharing a content
+// hash keeps a single copy of the bytes, dropped with the last media row.
+func TestReadModelStore_MediaContentStoredOnce(t *testing.T) {
+	db, err := sqlite.OpenDB(filepath.Join(t.TempDir(), "readmodel.db"))
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	defer db.Close()
+	store, err := sqlite.NewReadModelStore(db)
+	if err != nil {
+		t.Fatalf("create read model store: %v", err)
+	}
+
+	ctx := context.Background()
+
+	data := bytes.Repeat([]byte("photo"), 1000)
+	hash := domain.MediaContentHash(data)
+	ids := make([]uuid.UUID, 3)
+	for i := range ids {
+		m := &repository.MediaReadModel{
+			ID:          uuid.New(),
+			EntityType:  "person",
+			EntityID:    uuid.New(),
+			Title:       "Photo",
+			MimeType:    "image/jpeg",
+			MediaType:   domain.MediaPhoto,
+			Filename:    "photo.jpg",
+			FileSize:    int64(len(data)),
+			FileData:    data,
+			ContentHash: hash,
+			Version:     1,
+			CreatedAt:   time.Now(),
+		}
+		if err := store.SaveMedia(ctx, m); err != nil {
+			t.Fatalf("SaveMedia: %v", err)
+		}
+		ids[i] = m.ID
+	}
+
+	storedBytes := func() int64 {
+		t.Helper()
+		var inline, shared int64
+		if err := db.QueryRow(`SELECT COALESCE(SUM(length(file_data)), 0) FROM media`).Scan(&inline); err != nil {
+			t.Fatalf("sum media file data: %v", err)
+		}
+		if err := db.QueryRow(`SELECT COALESCE(SUM(length(file_data)), 0) FROM media_content`).Scan(&shared); err != nil {
+			t.Fatalf("sum media content: %v", err)
+		}
+		return inline + shared
+	}
+
+	if got := storedBytes(); got != int64(len(data)) {
+		t.Errorf("stored %d bytes for 3 identical media, want %d", got, len(data))
+	}
+	for _, id := range ids {
+		got, err := store.GetMediaWithData(ctx, id)
+		if err != nil {
+			t.Fatalf("GetMediaWithData: %v", err)
+		}
+		if !bytes.Equal(got.FileData, data) {
+			t.Errorf("GetMediaWithData(%s) returned %d bytes, want %d", id, len(got.FileData), len(data))
+		}
+	}
+
+	// Content survives until the last media sharing it is deleted
+	for _, id := range ids[:2] {
+		if err := store.DeleteMedia(ctx, id); err != nil {
+			t.Fatalf("DeleteMedia: %v", err)
+		}
+	}
+	if got := storedBytes(); got != int64(len(data)) {
+		t.Errorf("stored %d bytes with one media left, want %d", got, len(data))
+	}
+	if err := store.DeleteMedia(ctx, ids[2]); err != nil {
+		t.Fatalf("DeleteMedia: %v", err)
+	}
+	if got := storedBytes(); got != 0 {
+		t.Errorf("stored %d bytes after deleting all media, want 0", got)
+	}
+}
+
 func TestReadModelStore_PersonCRUD(t *testing.T) {
 	store, cleanup := setupTestReadModelDB(t)
 	defer cleanup()
@@ -112,6 +429,7 @@ func TestReadModelStore_PersonCRUD(t *testing.T) {
 		BirthPlace:    "Springfield, IL",
 		Version:       1,
 		UpdatedAt:     time.Now(),
+		CreatedAt:     time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
 	}
 
 	err := store.SavePerson(ctx, person)
@@ -157,6 +475,20 @@ func TestReadModelStore_PersonCRUD(t *testing.T) {
 	if retrieved.Version != 2 {
 		t.Errorf("expected Version 2, got %d", retrieved.Version)
 	}
+	if !retrieved.CreatedAt.Equal(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected CreatedAt to be kept across updates, got %v", retrieved.CreatedAt)
+	}
+
+	// A save without a creation time keeps the stored one
+	person.CreatedAt = time.Time{}
+	person.Version = 3
+	if err := store.SavePerson(ctx, person); err != nil {
+		t.Fatalf("update person: %v", err)
+	}
+	retrieved, _ = store.GetPerson(ctx, personID)
+	if retrieved.CreatedAt.IsZero() {
+		t.Error("expected CreatedAt to be preserved when the update omits it")
+	}
 
 	// Delete
 	err = store.DeletePerson(ctx, personID)
@@ -349,6 +681,7 @@ func TestReadModelStore_FamilyCRUD(t *testing.T) {
 		MarriageDateRaw:   "15 JUN 1875",
 		MarriageDateSort:  &marriageDate,
 		MarriagePlace:     "Springfield, IL",
+		ResearchStatus:    domain.ResearchStatusPossible,
 		Version:           1,
 		UpdatedAt:         time.Now(),
 	}
@@ -373,6 +706,9 @@ func TestReadModelStore_FamilyCRUD(t *testing.T) {
 	if retrieved.RelationshipType != domain.RelationMarriage {
 		t.Errorf("expected RelationshipType marriage, got %s", retrieved.RelationshipType)
 	}
+	if retrieved.ResearchStatus != domain.ResearchStatusPossible {
+		t.Errorf("expected ResearchStatus possible, got %s", retrieved.ResearchStatus)
+	}
 
 	// Get families for person
 	families, err := store.GetFamiliesForPerson(ctx, person1ID)
@@ -614,6 +950,171 @@ func TestReadModelStore_NonExistentRecords(t *testing.T) {
 	}
 }
 
+func TestReadModelStore_SearchFamilies(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	johnID, janeID, bobID := uuid.New(), uuid.New(), uuid.New()
+	for _, p := range []*repository.PersonReadModel{
+		{ID: johnID, GivenName: "John", Surname: "Doe", Version: 1, UpdatedAt: time.Now()},
+		{ID: janeID, GivenName: "Jane", Surname: "Smith", Version: 1, UpdatedAt: time.Now()},
+		{ID: bobID, GivenName: "Bob", Surname: "Smith", Version: 1, UpdatedAt: time.Now()},
+	} {
+		if err := store.SavePerson(ctx, p); err != nil {
+			t.Fatalf("SavePerson failed: %v", err)
+		}
+	}
+
+	families := []*repository.FamilyReadModel{
+		{ID: uuid.New(), Partner1ID: &johnID, Partner1GivenName: "John", Partner1Surname: "Doe",
+			Partner2ID: &janeID, Partner2GivenName: "Jane", Partner2Surname: "Smith", Version: 1, UpdatedAt: time.Now()},
+		{ID: uuid.New(), Partner1ID: &bobID, Partner1GivenName: "Bob", Partner1Surname: "Smith", Version: 1, UpdatedAt: time.Now()},
+	}
+	for _, f := range families {
+		if err := store.SaveFamily(ctx, f); err != nil {
+			t.Fatalf("SaveFamily failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"smith", 2},
+		{"DOE", 1},
+		{"doe smith", 1},
+		{"bob doe", 0},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			results, err := store.SearchFamilies(ctx, tt.query, 10)
+			if err != nil {
+				t.Fatalf("SearchFamilies failed: %v", err)
+			}
+			if len(results) != tt.want {
+				t.Errorf("len = %d, want %d", len(results), tt.want)
+			}
+		})
+	}
+}
+
+func TestReadModelStore_BirthDecades(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	date := func(year int, month time.Month, day int) *time.Time {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	for _, p := range []*repository.PersonReadModel{
+		{ID: uuid.New(), GivenName: "Late", Surname: "Smith", BirthDateSort: date(1859, time.December, 31)},
+		{ID: uuid.New(), GivenName: "Early", Surname: "Smith", BirthDateSort: date(1850, time.January, 1)},
+		{ID: uuid.New(), GivenName: "Next", Surname: "Smith", BirthDateSort: date(1860, time.January, 1)},
+		{ID: uuid.New(), GivenName: "Undated", Surname: "Smith"},
+	} {
+		p.Version = 1
+		p.UpdatedAt = time.Now()
+		if err := store.SavePerson(ctx, p); err != nil {
+			t.Fatalf("SavePerson failed: %v", err)
+		}
+	}
+
+	entries, err := store.GetBirthDecadeIndex(ctx)
+	if err != nil {
+		t.Fatalf("GetBirthDecadeIndex failed: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("len(entries) = %d, want 3", len(entries))
+	}
+	if entries[0].Decade == nil || *entries[0].Decade != 1850 || entries[0].Count != 2 {
+		t.Errorf("entries[0] = %+v, want 1850 with 2", entries[0])
+	}
+	if entries[1].Decade == nil || *entries[1].Decade != 1860 || entries[1].Count != 1 {
+		t.Errorf("entries[1] = %+v, want 1860 with 1", entries[1])
+	}
+	if entries[2].Decade != nil || entries[2].Count != 1 {
+		t.Errorf("entries[2] = %+v, want unknown with 1", entries[2])
+	}
+
+	decade := 1850
+	persons, total, err := store.GetPersonsByBirthDecade(ctx, &decade, repository.ListOptions{Limit: 10})
+	if err != nil {
+		t.Fatalf("GetPersonsByBirthDecade failed: %v", err)
+	}
+	if total != 2 || len(persons) != 2 {
+		t.Fatalf("total = %d, len = %d, want 2", total, len(persons))
+	}
+	if persons[0].GivenName != "Early" || persons[1].GivenName != "Late" {
+		t.Errorf("expected persons in birth order, got %s, %s", persons[0].GivenName, persons[1].GivenName)
+	}
+
+	persons, total, err = store.GetPersonsByBirthDecade(ctx, nil, repository.ListOptions{Limit: 10})
+	if err != nil {
+		t.Fatalf("GetPersonsByBirthDecade(nil) failed: %v", err)
+	}
+	if total != 1 || persons[0].GivenName != "Undated" {
+		t.Errorf("unknown decade: total = %d, persons = %+v", total, persons)
+	}
+}
+
+func TestReadModelStore_PlaceUsageAndReferences(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	personID, familyID, eventID := uuid.New(), uuid.New(), uuid.New()
+	if err := store.SavePerson(ctx, &repository.PersonReadModel{
+		ID: personID, GivenName: "John", Surname: "Doe",
+		BirthPlace: "Springfield, IL", DeathPlace: "Boston, MA", Version: 1, UpdatedAt: time.Now(),
+	}); err != nil {
+		t.Fatalf("SavePerson failed: %v", err)
+	}
+	if err := store.SaveFamily(ctx, &repository.FamilyReadModel{
+		ID: familyID, MarriagePlace: "Springfield, IL", Version: 1, UpdatedAt: time.Now(),
+	}); err != nil {
+		t.Fatalf("SaveFamily failed: %v", err)
+	}
+	if err := store.SaveEvent(ctx, &repository.EventReadModel{
+		ID: eventID, OwnerType: "person", OwnerID: personID, FactType: domain.FactPersonResidence,
+		Place: "Springfield, IL", Version: 1, CreatedAt: time.Now(),
+	}); err != nil {
+		t.Fatalf("SaveEvent failed: %v", err)
+	}
+
+	usage, err := store.ListPlaceUsage(ctx)
+	if err != nil {
+		t.Fatalf("ListPlaceUsage failed: %v", err)
+	}
+	want := []repository.PlaceUsage{{Place: "Boston, MA", Count: 1}, {Place: "Springfield, IL", Count: 3}}
+	if len(usage) != len(want) {
+		t.Fatalf("usage = %+v, want %+v", usage, want)
+	}
+	for i := range want {
+		if usage[i] != want[i] {
+			t.Errorf("usage[%d] = %+v, want %+v", i, usage[i], want[i])
+		}
+	}
+
+	refs, err := store.FindPlaceReferences(ctx, "Springfield, IL")
+	if err != nil {
+		t.Fatalf("FindPlaceReferences failed: %v", err)
+	}
+	found := make(map[string]uuid.UUID)
+	for _, ref := range refs {
+		found[ref.EntityType+"."+ref.Field] = ref.EntityID
+	}
+	if len(refs) != 3 || found["person.birth_place"] != personID ||
+		found["family.marriage_place"] != familyID || found["event.place"] != eventID {
+		t.Errorf("unexpected references: %+v", refs)
+	}
+}
+
 func TestReadModelStore_ListFamilies(t *testing.T) {
 	store, cleanup := setupTestReadModelDB(t)
 	defer cleanup()
@@ -702,6 +1203,60 @@ func TestReadModelStore_ListFamilies(t *testing.T) {
 	}
 }
 
+func TestReadModelStore_SearchPersons_Romanized(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	person := &repository.PersonReadModel{
+		ID:        uuid.New(),
+		GivenName: "Иван",
+		Surname:   "Иванов",
+		FullName:  "Иван Иванов",
+		Version:   1,
+		UpdatedAt: time.Now(),
+	}
+	if err := store.SavePerson(ctx, person); err != nil {
+		t.Fatalf("save person: %v", err)
+	}
+	err := store.SavePersonName(ctx, &repository.PersonNameReadModel{
+		ID:        uuid.New(),
+		PersonID:  person.ID,
+		GivenName: "Иван",
+		Surname:   "Иванов",
+		Romanized: "Ivan Ivanov",
+		IsPrimary: true,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("save person name: %v", err)
+	}
+
+	for _, opts := range []repository.SearchOptions{
+		{Query: "Ivanov", Limit: 10},
+		{Query: "ivan", Fuzzy: true, Limit: 10},
+		{Query: "Ivanov", Metaphone: true, Limit: 10},
+		{Query: "ivanov", Soundex: true, Limit: 10},
+	} {
+		results, err := store.SearchPersons(ctx, opts)
+		if err != nil {
+			t.Fatalf("search %+v: %v", opts, err)
+		}
+		if len(results) != 1 || results[0].ID != person.ID {
+			t.Errorf("search %+v = %d results, want Иван Иванов", opts, len(results))
+		}
+	}
+
+	names, err := store.GetPersonNames(ctx, person.ID)
+	if err != nil {
+		t.Fatalf("get person names: %v", err)
+	}
+	if len(names) != 1 || names[0].Romanized != "Ivan Ivanov" {
+		t.Errorf("names = %+v, want romanized Ivan Ivanov", names)
+	}
+}
+
 func TestReadModelStore_SearchPersons_FTS5Error(t *testing.T) {
 	store, cleanup := setupTestReadModelDB(t)
 	defer cleanup()
@@ -850,6 +1405,247 @@ func TestReadModelStore_ListPersons_Sorting(t *testing.T) {
 	}
 }
 
+func TestReadModelStore_ListPersons_MixedPrecisionBirthDates(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// All resolve to 1 January 1850; order must follow precision then qualifier
+	for given, raw := range map[string]string{
+		"Anna":  "1 JAN 1850",
+		"Berta": "JAN 1850",
+		"Carl":  "ABT 1850",
+		"Dora":  "1850",
+	} {
+		gd := domain.ParseGenDate(raw)
+		sortDate := gd.SortDate()
+		err := store.SavePerson(ctx, &repository.PersonReadModel{
+			ID:            uuid.New(),
+			GivenName:     given,
+			Surname:       "Weber",
+			BirthDateRaw:  raw,
+			BirthDateSort: &sortDate,
+			Version:       1,
+			UpdatedAt:     time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("save person: %v", err)
+		}
+	}
+
+	opts := repository.DefaultListOptions()
+	opts.Sort = "birth_date"
+	opts.Limit = 10
+	results, _, err := store.ListPersons(ctx, opts)
+	if err != nil {
+		t.Fatalf("list persons by birth date: %v", err)
+	}
+
+	want := []string{"Dora", "Carl", "Berta", "Anna"}
+	if len(results) != len(want) {
+		t.Fatalf("expected %d results, got %d", len(want), len(results))
+	}
+	for i, p := range results {
+		if p.GivenName != want[i] {
+			t.Errorf("result %d = %s, want %s", i, p.GivenName, want[i])
+		}
+	}
+}
+
+func TestReadModelStore_ListPersons_IgnoreSurnamePrefix(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	for _, surname := range []string{"van Gogh", "Garcia", "Vance", "de la Cruz"} {
+		err := store.SavePerson(ctx, &repository.PersonReadModel{
+			ID:        uuid.New(),
+			GivenName: "Test",
+			Surname:   surname,
+			Version:   1,
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("save person: %v", err)
+		}
+	}
+
+	surnames := func(opts repository.ListOptions) []string {
+		t.Helper()
+		results, _, err := store.ListPersons(ctx, opts)
+		if err != nil {
+			t.Fatalf("list persons: %v", err)
+		}
+		var names []string
+		for _, r := range results {
+			names = append(names, r.Surname)
+		}
+		return names
+	}
+
+	opts := repository.DefaultListOptions()
+	if got, want := surnames(opts), []string{"Garcia", "Vance", "de la Cruz", "van Gogh"}; !slices.Equal(got, want) {
+		t.Errorf("as written = %v, want %v", got, want)
+	}
+
+	opts.IgnoreSurnamePrefix = true
+	if got, want := surnames(opts), []string{"de la Cruz", "Garcia", "van Gogh", "Vance"}; !slices.Equal(got, want) {
+		t.Errorf("ignoring prefix = %v, want %v", got, want)
+	}
+}
+
+func TestReadModelStore_GetSurnameIndex_SkipsPatronymics(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	for _, p := range []struct {
+		surname  string
+		nameType domain.NameType
+	}{
+		{"Jónsdóttir", domain.NameTypePatronymic},
+		{"Jensen", domain.NameTypeBirth},
+	} {
+		person := &repository.PersonReadModel{
+			ID:        uuid.New(),
+			GivenName: "Test",
+			Surname:   p.surname,
+			Version:   1,
+			UpdatedAt: time.Now(),
+		}
+		if err := store.SavePerson(ctx, person); err != nil {
+			t.Fatalf("save person: %v", err)
+		}
+		err := store.SavePersonName(ctx, &repository.PersonNameReadModel{
+			ID:        uuid.New(),
+			PersonID:  person.ID,
+			GivenName: "Test",
+			Surname:   p.surname,
+			NameType:  p.nameType,
+			IsPrimary: true,
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("save person name: %v", err)
+		}
+	}
+
+	entries, letters, err := store.GetSurnameIndex(ctx)
+	if err != nil {
+		t.Fatalf("get surname index: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Surname != "Jensen" {
+		t.Errorf("entries = %v, want only Jensen", entries)
+	}
+	if len(letters) != 1 || letters[0].Count != 1 {
+		t.Errorf("letters = %v, want J with one surname", letters)
+	}
+
+	byLetter, err := store.GetSurnamesByLetter(ctx, "J")
+	if err != nil {
+		t.Fatalf("get surnames by letter: %v", err)
+	}
+	if len(byLetter) != 1 || byLetter[0].Surname != "Jensen" {
+		t.Errorf("by letter = %v, want only Jensen", byLetter)
+	}
+}
+
+func TestReadModelStore_ListPersons_Unlinked(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	ids := make(map[string]uuid.UUID)
+	for _, name := range []string{"Partner", "Child", "Loner"} {
+		ids[name] = uuid.New()
+		err := store.SavePerson(ctx, &repository.PersonReadModel{
+			ID:        ids[name],
+			GivenName: name,
+			Surname:   "Doe",
+			Version:   1,
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("save person: %v", err)
+		}
+	}
+	partnerID := ids["Partner"]
+	familyID := uuid.New()
+	if err := store.SaveFamily(ctx, &repository.FamilyReadModel{ID: familyID, Partner2ID: &partnerID, Version: 1, UpdatedAt: time.Now()}); err != nil {
+		t.Fatalf("save family: %v", err)
+	}
+	if err := store.SaveFamilyChild(ctx, &repository.FamilyChildReadModel{FamilyID: familyID, PersonID: ids["Child"], RelationshipType: domain.ChildBiological}); err != nil {
+		t.Fatalf("save family child: %v", err)
+	}
+
+	opts := repository.DefaultListOptions()
+	opts.Unlinked = true
+	results, total, err := store.ListPersons(ctx, opts)
+	if err != nil {
+		t.Fatalf("list persons: %v", err)
+	}
+	if total != 1 || len(results) != 1 || results[0].ID != ids["Loner"] {
+		t.Errorf("unlinked = %d %+v, want only Loner", total, results)
+	}
+}
+
+func TestReadModelStore_ListPersons_SurnameAndGenderFilter(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	for _, p := range []struct {
+		given, surname string
+		gender         domain.Gender
+	}{
+		{"Anna", "Muller", domain.GenderFemale},
+		{"Berta", "MULLER", domain.GenderFemale},
+		{"Carl", "Muller", domain.GenderMale},
+		{"Dora", "Mullerson", domain.GenderFemale},
+	} {
+		err := store.SavePerson(ctx, &repository.PersonReadModel{
+			ID:        uuid.New(),
+			GivenName: p.given,
+			Surname:   p.surname,
+			Gender:    p.gender,
+			Version:   1,
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("save person: %v", err)
+		}
+	}
+
+	opts := repository.DefaultListOptions()
+	opts.Sort = "given_name"
+	opts.Surname = "muller"
+	opts.Gender = "female"
+	results, total, err := store.ListPersons(ctx, opts)
+	if err != nil {
+		t.Fatalf("list persons: %v", err)
+	}
+	if total != 2 || len(results) != 2 {
+		t.Fatalf("total = %d, len = %d, want 2 exact case-insensitive surname matches", total, len(results))
+	}
+	if results[0].GivenName != "Anna" || results[1].GivenName != "Berta" {
+		t.Errorf("results = %s, %s, want Anna, Berta", results[0].GivenName, results[1].GivenName)
+	}
+
+	opts.Gender = ""
+	_, total, err = store.ListPersons(ctx, opts)
+	if err != nil {
+		t.Fatalf("list persons: %v", err)
+	}
+	if total != 3 {
+		t.Errorf("surname-only total = %d, want 3", total)
+	}
+}
+
 func TestEventStore_ErrorPaths(t *testing.T) {
 	// Test error path in NewEventStore by using a closed database
 	tmpFile, err := os.CreateTemp("", "myfamily-error-test-*.db")
@@ -1249,15 +2045,16 @@ func TestReadModelStore_AttributeCRUD(t *testing.T) {
 	attrDate := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	attr := &repository.AttributeReadModel{
-		ID:        attrID,
-		PersonID:  personID,
-		FactType:  "person_occupation",
-		Value:     "Software Engineer",
-		DateRaw:   "1990",
-		DateSort:  &attrDate,
-		Place:     "San Francisco, CA",
-		Version:   1,
-		CreatedAt: time.Now(),
+		ID:             attrID,
+		PersonID:       personID,
+		FactType:       "person_occupation",
+		Value:          "Software Engineer",
+		DateRaw:        "1990",
+		DateSort:       &attrDate,
+		Place:          "San Francisco, CA",
+		ResearchStatus: domain.ResearchStatusProbable,
+		Version:        1,
+		CreatedAt:      time.Now(),
 	}
 
 	// Save
@@ -1295,6 +2092,9 @@ func TestReadModelStore_AttributeCRUD(t *testing.T) {
 	if got.Place != "San Francisco, CA" {
 		t.Errorf("expected place 'San Francisco, CA', got '%s'", got.Place)
 	}
+	if got.ResearchStatus != domain.ResearchStatusProbable {
+		t.Errorf("expected research_status probable, got '%s'", got.ResearchStatus)
+	}
 
 	// Update
 	attr.Value = "Senior Engineer"
@@ -1640,6 +2440,140 @@ func TestSearchPersons_Soundex(t *testing.T) {
 	})
 }
 
+func TestSearchPersons_Metaphone(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	reilly := repository.PersonReadModel{ID: uuid.New(), GivenName: "Pat", Surname: "Reilly", FullName: "Pat Reilly", Version: 1, UpdatedAt: time.Now()}
+	brown := repository.PersonReadModel{ID: uuid.New(), GivenName: "Robert", Surname: "Brown", FullName: "Robert Brown", Version: 1, UpdatedAt: time.Now()}
+	for _, p := range []*repository.PersonReadModel{&reilly, &brown} {
+		if err := store.SavePerson(ctx, p); err != nil {
+			t.Fatalf("save person: %v", err)
+		}
+	}
+	// Alternate surname on Brown that sounds like Smith
+	if err := store.SavePersonName(ctx, &repository.PersonNameReadModel{
+		ID: uuid.New(), PersonID: brown.ID, GivenName: "Robert", Surname: "Smyth", NameType: "aka", UpdatedAt: time.Now(),
+	}); err != nil {
+		t.Fatalf("save person name: %v", err)
+	}
+
+	t.Run("Metaphone matches Riley to Reilly", func(t *testing.T) {
+		results, err := store.SearchPersons(ctx, repository.SearchOptions{Query: "Riley", Metaphone: true, Limit: 10})
+		if err != nil {
+			t.Fatalf("search: %v", err)
+		}
+		if len(results) != 1 || results[0].ID != reilly.ID {
+			t.Errorf("expected Reilly, got %v", results)
+		}
+	})
+
+	t.Run("Metaphone matches alternate surnames", func(t *testing.T) {
+		results, err := store.SearchPersons(ctx, repository.SearchOptions{Query: "Smith", Metaphone: true, Limit: 10})
+		if err != nil {
+			t.Fatalf("search: %v", err)
+		}
+		if len(results) != 1 || results[0].ID != brown.ID {
+			t.Errorf("expected Brown via alternate name, got %v", results)
+		}
+	})
+
+	t.Run("Without Metaphone Riley does not match", func(t *testing.T) {
+		results, err := store.SearchPersons(ctx, repository.SearchOptions{Query: "Riley", Limit: 10})
+		if err != nil {
+			t.Fatalf("search: %v", err)
+		}
+		if len(results) != 0 {
+			t.Errorf("expected no results, got %d", len(results))
+		}
+	})
+}
+
+func TestSearchPersons_Wildcard(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	smith := repository.PersonReadModel{ID: uuid.New(), GivenName: "John", Surname: "Smith", FullName: "John Smith", Version: 1, UpdatedAt: time.Now()}
+	goldsmith := repository.PersonReadModel{ID: uuid.New(), GivenName: "Anna", Surname: "Goldsmith", FullName: "Anna Goldsmith", Version: 1, UpdatedAt: time.Now()}
+	brown := repository.PersonReadModel{ID: uuid.New(), GivenName: "Robert", Surname: "Brown", FullName: "Robert Brown", Version: 1, UpdatedAt: time.Now()}
+	for _, p := range []*repository.PersonReadModel{&smith, &goldsmith, &brown} {
+		if err := store.SavePerson(ctx, p); err != nil {
+			t.Fatalf("save person: %v", err)
+		}
+	}
+	// Alternate surname on Brown ending in "son"
+	if err := store.SavePersonName(ctx, &repository.PersonNameReadModel{
+		ID: uuid.New(), PersonID: brown.ID, GivenName: "Robert", Surname: "Robertson", NameType: "aka", UpdatedAt: time.Now(),
+	}); err != nil {
+		t.Fatalf("save person name: %v", err)
+	}
+
+	t.Run("Prefix matches names beginning with the pattern", func(t *testing.T) {
+		results, err := store.SearchPersons(ctx, repository.SearchOptions{Query: "Smi*", Wildcard: true, Limit: 10})
+		if err != nil {
+			t.Fatalf("search: %v", err)
+		}
+		if len(results) != 1 || results[0].ID != smith.ID {
+			t.Errorf("expected only Smith, got %v", results)
+		}
+	})
+
+	t.Run("Suffix matches alternate names", func(t *testing.T) {
+		results, err := store.SearchPersons(ctx, repository.SearchOptions{Query: "*son", Wildcard: true, Limit: 10})
+		if err != nil {
+			t.Fatalf("search: %v", err)
+		}
+		if len(results) != 1 || results[0].ID != brown.ID {
+			t.Errorf("expected Brown via alternate name, got %v", results)
+		}
+	})
+
+	t.Run("LIKE characters in the pattern are literal", func(t *testing.T) {
+		results, err := store.SearchPersons(ctx, repository.SearchOptions{Query: "Sm_th*", Wildcard: true, Limit: 10})
+		if err != nil {
+			t.Fatalf("search: %v", err)
+		}
+		if len(results) != 0 {
+			t.Errorf("expected no results, got %d", len(results))
+		}
+	})
+}
+
+func TestSearchPersons_NameAndGenderFilters(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	persons := []repository.PersonReadModel{
+		{ID: uuid.New(), GivenName: "John", Surname: "Miller", Gender: domain.GenderMale, Version: 1, UpdatedAt: time.Now()},
+		{ID: uuid.New(), GivenName: "Johanna", Surname: "Miller", Gender: domain.GenderFemale, Version: 1, UpdatedAt: time.Now()},
+		{ID: uuid.New(), GivenName: "John", Surname: "Baker", Gender: domain.GenderMale, Version: 1, UpdatedAt: time.Now()},
+	}
+	for i := range persons {
+		if err := store.SavePerson(ctx, &persons[i]); err != nil {
+			t.Fatalf("save person: %v", err)
+		}
+	}
+
+	results, err := store.SearchPersons(ctx, repository.SearchOptions{
+		GivenName: "john",
+		Surname:   "MILL",
+		Gender:    domain.GenderMale,
+		Limit:     10,
+	})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(results) != 1 || results[0].ID != persons[0].ID {
+		t.Errorf("expected only John Miller, got %v", results)
+	}
+}
+
 func TestSearchPersons_Combined(t *testing.T) {
 	store, cleanup := setupTestReadModelDB(t)
 	defer cleanup()
@@ -1980,3 +2914,66 @@ func TestReadModelStore_ListRepositories(t *testing.T) {
 		t.Errorf("first result = %s, want Alpha Archive (asc by name)", results[0].Name)
 	}
 }
+
+func TestReadModelStore_ListNotesForEntity(t *testing.T) {
+	store, cleanup := setupTestReadModelDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	personID := uuid.New()
+	familyID := uuid.New()
+	shared := &repository.NoteReadModel{
+		ID:   uuid.New(),
+		Text: "Shared note",
+		Links: []domain.NoteLink{
+			{EntityType: "person", EntityID: personID},
+			{EntityType: "family", EntityID: familyID},
+		},
+		Version:   1,
+		UpdatedAt: time.Now(),
+	}
+	familyOnly := &repository.NoteReadModel{
+		ID:        uuid.New(),
+		Text:      "Family note",
+		Links:     []domain.NoteLink{{EntityType: "family", EntityID: familyID}},
+		Version:   1,
+		UpdatedAt: time.Now().Add(time.Second),
+	}
+	for _, n := range []*repository.NoteReadModel{shared, familyOnly} {
+		if err := store.SaveNote(ctx, n); err != nil {
+			t.Fatalf("SaveNote() failed: %v", err)
+		}
+	}
+
+	got, err := store.GetNote(ctx, shared.ID)
+	if err != nil {
+		t.Fatalf("GetNote() failed: %v", err)
+	}
+	if !slices.Equal(got.Links, shared.Links) {
+		t.Errorf("Links = %v, want %v", got.Links, shared.Links)
+	}
+
+	personNotes, err := store.ListNotesForEntity(ctx, personID)
+	if err != nil {
+		t.Fatalf("ListNotesForEntity() failed: %v", err)
+	}
+	if len(personNotes) != 1 || personNotes[0].ID != shared.ID {
+		t.Errorf("person notes = %v, want only the shared note", personNotes)
+	}
+
+	familyNotes, err := store.ListNotesForEntity(ctx, familyID)
+	if err != nil {
+		t.Fatalf("ListNotesForEntity() failed: %v", err)
+	}
+	if len(familyNotes) != 2 {
+		t.Errorf("len(family notes) = %d, want 2", len(familyNotes))
+	}
+
+	none, err := store.ListNotesForEntity(ctx, uuid.New())
+	if err != nil {
+		t.Fatalf("ListNotesForEntity() failed: %v", err)
+	}
+	if len(none) != 0 {
+		t.Errorf("len(notes) = %d, want 0 for unlinked entity", len(none))
+	}
+}
@@ -0,0 +1,114 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cacack/my-family/internal/repository"
+)
+
+// StreamSnapshotStore is a SQLite implementation of repository.StreamSnapshotStore.
+type StreamSnapshotStore struct {
+	db *sql.DB
+}
+
+// NewStreamSnapshotStore creates a new SQLite stream snapshot store.
+func NewStreamSnapshotStore(db *sql.DB) (*StreamSnapshotStore, error) {
+	store := &StreamSnapshotStore{db: db}
+	if err := store.createTables(); err != nil {
+		return nil, fmt.Errorf("failed to create tables: %w", err)
+	}
+	return store, nil
+}
+
+// createTables creates the stream_snapshots table if it doesn't exist.
+func (s *StreamSnapshotStore) createTables() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS stream_snapshots (
+			stream_id TEXT NOT NULL,
+			stream_type TEXT NOT NULL,
+			version INTEGER NOT NULL,
+			is_deleted INTEGER NOT NULL DEFAULT 0,
+			state TEXT NOT NULL,
+			created_at TEXT NOT NULL,
+			PRIMARY KEY (stream_id, version)
+		);
+	`)
+	return err
+}
+
+// SaveStreamSnapshot stores a snapshot, replacing any existing snapshot at the same version.
+func (s *StreamSnapshotStore) SaveStreamSnapshot(ctx context.Context, snapshot *repository.StreamSnapshot) error {
+	state, err := json.Marshal(snapshot.State)
+	if err != nil {
+		return fmt.Errorf("marshal snapshot state: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO stream_snapshots (stream_id, stream_type, version, is_deleted, state, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(stream_id, version) DO UPDATE SET
+			stream_type = excluded.stream_type,
+			is_deleted = excluded.is_deleted,
+			state = excluded.state,
+			created_at = excluded.created_at
+	`,
+		snapshot.StreamID.String(),
+		snapshot.StreamType,
+		snapshot.Version,
+		snapshot.IsDeleted,
+		string(state),
+		formatTimestamp(snapshot.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("insert stream snapshot: %w", err)
+	}
+	return nil
+}
+
+// GetLatestStreamSnapshot returns the most recent snapshot at or below maxVersion.
+func (s *StreamSnapshotStore) GetLatestStreamSnapshot(ctx context.Context, streamID uuid.UUID, maxVersion int64) (*repository.StreamSnapshot, error) {
+	var (
+		streamType, state, createdAtStr string
+		version                         int64
+		isDeleted                       bool
+	)
+
+	err := s.db.QueryRowContext(ctx, `
+		SELECT stream_type, version, is_deleted, state, created_at
+		FROM stream_snapshots
+		WHERE stream_id = ? AND version <= ?
+		ORDER BY version DESC
+		LIMIT 1
+	`, streamID.String(), maxVersion).Scan(&streamType, &version, &isDeleted, &state, &createdAtStr)
+
+	if err == sql.ErrNoRows {
+		return nil, repository.ErrStreamSnapshotNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query stream snapshot: %w", err)
+	}
+
+	snapshot := &repository.StreamSnapshot{
+		StreamID:   streamID,
+		StreamType: streamType,
+		Version:    version,
+		IsDeleted:  isDeleted,
+	}
+	if err := json.Unmarshal([]byte(state), &snapshot.State); err != nil {
+		return nil, fmt.Errorf("unmarshal snapshot state: %w", err)
+	}
+
+	createdAt, err := parseTimestamp(createdAtStr)
+	if err != nil {
+		createdAt = time.Now().UTC()
+	}
+	snapshot.CreatedAt = createdAt
+
+	return snapshot, nil
+}
@@ -0,0 +1,109 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/cacack/my-family/internal/repository"
+	"github.com/cacack/my-family/internal/repository/sqlite"
+)
+
+func setupStreamSnapshotStore(t *testing.T) *sqlite.StreamSnapshotStore {
+	t.Helper()
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	store, err := sqlite.NewStreamSnapshotStore(db)
+	if err != nil {
+		t.Fatalf("NewStreamSnapshotStore() error = %v", err)
+	}
+	return store
+}
+
+func TestStreamSnapshotStore_SaveAndGetLatest(t *testing.T) {
+	store := setupStreamSnapshotStore(t)
+	ctx := context.Background()
+	streamID := uuid.New()
+
+	for _, version := range []int64{5, 10} {
+		err := store.SaveStreamSnapshot(ctx, &repository.StreamSnapshot{
+			StreamID:   streamID,
+			StreamType: "Family",
+			Version:    version,
+			State:      map[string]any{"children": []string{"a", "b"}},
+			CreatedAt:  time.Now().UTC(),
+		})
+		if err != nil {
+			t.Fatalf("SaveStreamSnapshot() error = %v", err)
+		}
+	}
+
+	snapshot, err := store.GetLatestStreamSnapshot(ctx, streamID, 9)
+	if err != nil {
+		t.Fatalf("GetLatestStreamSnapshot() error = %v", err)
+	}
+	if snapshot.Version != 5 {
+		t.Errorf("Version = %d, want 5", snapshot.Version)
+	}
+	if snapshot.StreamType != "Family" {
+		t.Errorf("StreamType = %s, want Family", snapshot.StreamType)
+	}
+	children, ok := snapshot.State["children"].([]any)
+	if !ok || len(children) != 2 {
+		t.Errorf("children = %v, want two entries", snapshot.State["children"])
+	}
+
+	snapshot, err = store.GetLatestStreamSnapshot(ctx, streamID, 10)
+	if err != nil {
+		t.Fatalf("GetLatestStreamSnapshot() error = %v", err)
+	}
+	if snapshot.Version != 10 {
+		t.Errorf("Version = %d, want 10", snapshot.Version)
+	}
+}
+
+func TestStreamSnapshotStore_Upsert(t *testing.T) {
+	store := setupStreamSnapshotStore(t)
+	ctx := context.Background()
+	streamID := uuid.New()
+
+	for _, deleted := range []bool{false, true} {
+		err := store.SaveStreamSnapshot(ctx, &repository.StreamSnapshot{
+			StreamID:   streamID,
+			StreamType: "Person",
+			Version:    3,
+			IsDeleted:  deleted,
+			State:      map[string]any{},
+			CreatedAt:  time.Now().UTC(),
+		})
+		if err != nil {
+			t.Fatalf("SaveStreamSnapshot() error = %v", err)
+		}
+	}
+
+	snapshot, err := store.GetLatestStreamSnapshot(ctx, streamID, 3)
+	if err != nil {
+		t.Fatalf("GetLatestStreamSnapshot() error = %v", err)
+	}
+	if !snapshot.IsDeleted {
+		t.Error("expected second save to replace the first")
+	}
+}
+
+func TestStreamSnapshotStore_NotFound(t *testing.T) {
+	store := setupStreamSnapshotStore(t)
+
+	_, err := store.GetLatestStreamSnapshot(context.Background(), uuid.New(), 100)
+	if !errors.Is(err, repository.ErrStreamSnapshotNotFound) {
+		t.Errorf("expected ErrStreamSnapshotNotFound, got %v", err)
+	}
+}
@@ -71,6 +71,22 @@ func nullableInt(i *int) sql.NullInt64 {
 	return sql.NullInt64{Int64: int64(*i), Valid: true}
 }
 
+// nullableFloat converts a *float64 to sql.NullFloat64.
+func nullableFloat(f *float64) sql.NullFloat64 {
+	if f == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *f, Valid: true}
+}
+
+// nullableTimestamp formats a *time.Time as an ISO 8601 string, or NULL.
+func nullableTimestamp(t *time.Time) sql.NullString {
+	if t == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: formatTimestamp(*t), Valid: true}
+}
+
 // nullableBytes converts empty bytes to nil (for NULL in SQLite).
 func nullableBytes(b []byte) any {
 	if len(b) == 0 {
@@ -377,3 +377,26 @@ func TestNullableUUID_SinglePartner(t *testing.T) {
 		t.Fatalf("delete person: %v", err)
 	}
 }
+
+func TestOpenDB_SharedStores(t *testing.T) {
+	// The server runs every store against a single database file, so their
+	// schemas must coexist without table name collisions.
+	db, err := sqlite.OpenDB(filepath.Join(t.TempDir(), "shared.db"))
+	if err != nil {
+		t.Fatalf("OpenDB failed: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := sqlite.NewEventStore(db); err != nil {
+		t.Fatalf("NewEventStore failed: %v", err)
+	}
+	if _, err := sqlite.NewReadModelStore(db); err != nil {
+		t.Fatalf("NewReadModelStore failed: %v", err)
+	}
+	if _, err := sqlite.NewSnapshotStore(db); err != nil {
+		t.Fatalf("NewSnapshotStore failed: %v", err)
+	}
+	if _, err := sqlite.NewStreamSnapshotStore(db); err != nil {
+		t.Fatalf("NewStreamSnapshotStore failed: %v", err)
+	}
+}
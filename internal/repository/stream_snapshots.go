@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Common errors for stream snapshot store operations.
+var (
+	ErrStreamSnapshotNotFound = errors.New("stream snapshot not found")
+)
+
+// StreamSnapshot captures the reconstructed state of a single event stream at a
+// specific version, so that replay can resume from the snapshot instead of the
+// first event in the stream.
+//
+// Stream snapshots are a performance optimization and are distinct from the
+// research milestone snapshots managed by SnapshotStore.
+type StreamSnapshot struct {
+	StreamID   uuid.UUID      `json:"stream_id"`
+	StreamType string         `json:"stream_type"`
+	Version    int64          `json:"version"`
+	IsDeleted  bool           `json:"is_deleted"`
+	State      map[string]any `json:"state"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// StreamSnapshotStore provides storage for per-stream state snapshots.
+type StreamSnapshotStore interface {
+	// SaveStreamSnapshot stores a snapshot, replacing any existing snapshot
+	// for the same stream and version.
+	SaveStreamSnapshot(ctx context.Context, snapshot *StreamSnapshot) error
+
+	// GetLatestStreamSnapshot returns the most recent snapshot for a stream
+	// whose version is at or below maxVersion.
+	// Returns ErrStreamSnapshotNotFound if no such snapshot exists.
+	GetLatestStreamSnapshot(ctx context.Context, streamID uuid.UUID, maxVersion int64) (*StreamSnapshot, error)
+}
@@ -0,0 +1,58 @@
+package repository
+
+import "strings"
+
+// IsWildcardQuery reports whether a name search query is a wildcard pattern,
+// in which * matches any run of characters.
+func IsWildcardQuery(query string) bool {
+	return strings.Contains(query, "*")
+}
+
+// wildcardPattern normalizes a wildcard query into a lowercase pattern
+// anchored at word boundaries, so "Smi*" matches any word beginning with
+// "smi" and "*son" any word ending with "son". Names are compared padded with
+// a space on each side.
+func wildcardPattern(query string) string {
+	return "* " + strings.ToLower(strings.Join(strings.Fields(query), " ")) + " *"
+}
+
+// WildcardMatch reports whether name matches a wildcard query. Matching is
+// case-insensitive and anchored at word boundaries of the name.
+func WildcardMatch(query, name string) bool {
+	if name == "" {
+		return false
+	}
+	parts := strings.Split(wildcardPattern(query), "*")
+	subject := " " + strings.ToLower(name) + " "
+
+	// The pattern starts and ends with *, so each literal part only has to
+	// appear after the previous one.
+	for _, part := range parts {
+		i := strings.Index(subject, part)
+		if i < 0 {
+			return false
+		}
+		subject = subject[i+len(part):]
+	}
+	return true
+}
+
+// WildcardLikePattern converts a wildcard query into a SQL LIKE pattern,
+// escaped with a backslash, equivalent to WildcardMatch. Compare it against
+// the lowercased name padded with a space on each side.
+func WildcardLikePattern(query string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(wildcardPattern(query))
+	return strings.ReplaceAll(escaped, "*", "%")
+}
+
+// WildcardLiterals returns the literal runs of a wildcard query between its
+// wildcards, lowercased, for narrowing candidates before matching.
+func WildcardLiterals(query string) []string {
+	var literals []string
+	for _, part := range strings.Split(strings.ToLower(query), "*") {
+		if part = strings.TrimSpace(part); part != "" {
+			literals = append(literals, part)
+		}
+	}
+	return literals
+}
@@ -0,0 +1,56 @@
+package repository
+
+import "testing"
+
+func TestWildcardMatch(t *testing.T) {
+	tests := []struct {
+		query string
+		name  string
+		want  bool
+	}{
+		{"Smi*", "Smith", true},
+		{"smi*", "SMITHSON", true},
+		{"Smi*", "Goldsmith", false},
+		{"Smi*", "John Smith", true},
+		{"*son", "Johnson", true},
+		{"*son", "Sonja", false},
+		{"*son", "Anders Johnson", true},
+		{"J*n", "John", true},
+		{"J*n", "Johnny", false},
+		{"Jo* Sm*", "John Smith", true},
+		{"Jo* Sm*", "Smith John", false},
+		{"*", "Anyone", true},
+		{"Smi*", "", false},
+	}
+
+	for _, tt := range tests {
+		if got := WildcardMatch(tt.query, tt.name); got != tt.want {
+			t.Errorf("WildcardMatch(%q, %q) = %v, want %v", tt.query, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWildcardLikePattern(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"Smi*", "% smi% %"},
+		{"*son", "% %son %"},
+		{"O_B*", `% o\_b% %`},
+		{"100%*", `% 100\%% %`},
+	}
+
+	for _, tt := range tests {
+		if got := WildcardLikePattern(tt.query); got != tt.want {
+			t.Errorf("WildcardLikePattern(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestWildcardLiterals(t *testing.T) {
+	got := WildcardLiterals("*Mc*Don*")
+	if len(got) != 2 || got[0] != "mc" || got[1] != "don" {
+		t.Errorf("WildcardLiterals = %q, want [mc don]", got)
+	}
+}
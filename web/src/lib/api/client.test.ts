@@ -25,4 +25,19 @@ describe('formatGenDate', () => {
 		const date: GenDate = { qualifier: 'int', year: 1850 };
 		expect(formatGenDate(date)).toBe('INT 1850');
 	});
+
+	it('formats a between range when raw is absent', () => {
+		const date: GenDate = { qualifier: 'bet', year: 1840, year2: 1850, month2: 3 };
+		expect(formatGenDate(date)).toBe('BET 1840 AND MAR 1850');
+	});
+
+	it('formats a from/to period when raw is absent', () => {
+		const date: GenDate = { qualifier: 'from', year: 1840, year2: 1850 };
+		expect(formatGenDate(date)).toBe('FROM 1840 TO 1850');
+	});
+
+	it('formats a dual-dated year when raw is absent', () => {
+		const date: GenDate = { day: 24, month: 3, year: 1719, dual_year: 1720 };
+		expect(formatGenDate(date)).toBe('24 MAR 1719/20');
+	});
 });
@@ -10,12 +10,20 @@ import type { components } from './types.generated';
 export type AhnentafelResponse = components['schemas']['AhnentafelResponse'];
 export type AhnentafelEntry = components['schemas']['AhnentafelEntry'];
 export type AhnentafelSubject = components['schemas']['AhnentafelSubject'];
+export type EndOfLinesResponse = components['schemas']['EndOfLinesResponse'];
 
 // Re-export Citation template types from generated file
 export type CitationTemplate = components['schemas']['CitationTemplate'];
 export type CitationTemplateField = components['schemas']['CitationTemplateField'];
 export type CitationTemplateList = components['schemas']['CitationTemplateList'];
 export type FormattedCitation = components['schemas']['FormattedCitation'];
+export type CitationReference = components['schemas']['CitationReference'];
+export type SourceReport = components['schemas']['SourceReport'];
+export type FactCoverage = components['schemas']['FactCoverage'];
+export type SourceUsage = components['schemas']['SourceUsage'];
+export type FactTypeIndexResponse = components['schemas']['FactTypeIndexResponse'];
+export type FactTypeEntry = components['schemas']['FactTypeEntry'];
+export type CitationOwner = components['schemas']['CitationOwner'];
 export type CitationValidationIssue = components['schemas']['CitationValidationIssue'];
 
 // Re-export Rollback types from generated file
@@ -23,10 +31,16 @@ export type RestorePoint = components['schemas']['RestorePoint'];
 export type RestorePointsResponse = components['schemas']['RestorePointsResponse'];
 export type RollbackRequest = components['schemas']['RollbackRequest'];
 export type RollbackResponse = components['schemas']['RollbackResponse'];
+export type TrashItem = components['schemas']['TrashItem'];
+export type TrashResponse = components['schemas']['TrashResponse'];
 
 // Re-export Quality/Validation types from generated file
 export type ValidationIssue = components['schemas']['ValidationIssue'];
 export type ValidationIssuesResponse = components['schemas']['ValidationIssuesResponse'];
+export type SurnameQuality = components['schemas']['SurnameQuality'];
+export type SurnameQualityReport = components['schemas']['SurnameQualityReport'];
+export type ResearchStatusSummary = components['schemas']['ResearchStatusSummary'];
+export type ResearchStatusUpdateResult = components['schemas']['ResearchStatusUpdateResult'];
 
 // Re-export Duplicate detection & merge types from generated file
 export type DuplicatePair = components['schemas']['DuplicatePair'];
@@ -39,6 +53,11 @@ export type DuplicatePairRef = components['schemas']['DuplicatePairRef'];
 export type BatchMergeRequest = components['schemas']['BatchMergeRequest'];
 export type BatchMergeResponse = components['schemas']['BatchMergeResponse'];
 export type BatchMergeResult = components['schemas']['BatchMergeResult'];
+export type BulkDeleteRequest = components['schemas']['BulkDeleteRequest'];
+export type ExportSelectionRequest = components['schemas']['ExportSelectionRequest'];
+export type ExportBySurnameRequest = components['schemas']['ExportBySurnameRequest'];
+export type BulkDeleteResponse = components['schemas']['BulkDeleteResponse'];
+export type BulkDeleteResult = components['schemas']['BulkDeleteResult'];
 export type BatchDismissRequest = components['schemas']['BatchDismissRequest'];
 export type BatchDismissResponse = components['schemas']['BatchDismissResponse'];
 export type BatchDismissResult = components['schemas']['BatchDismissResult'];
@@ -53,12 +72,62 @@ export type Address = components['schemas']['Address'];
 
 const API_BASE = '/api/v1';
 
+const API_TOKEN_KEY = 'api-token';
+
+/** The bearer token sent with API requests, for servers started with API_TOKENS. */
+export function getApiToken(): string | null {
+	if (typeof window === 'undefined') return null;
+	return localStorage.getItem(API_TOKEN_KEY);
+}
+
+/** Stores the bearer token for later requests, or forgets it when null. */
+export function setApiToken(token: string | null): void {
+	if (typeof window === 'undefined') return;
+	if (token) {
+		localStorage.setItem(API_TOKEN_KEY, token);
+	} else {
+		localStorage.removeItem(API_TOKEN_KEY);
+	}
+}
+
+/**
+ * fetch for API requests: sends the stored bearer token and, when the server
+ * rejects the request as unauthorized, asks for a token and retries once.
+ * Media URLs and the change stream are loaded by the browser itself and
+ * cannot carry the token, so they need AUTH_PUBLIC_READS on the server.
+ */
+export async function apiFetch(input: string, init: RequestInit = {}): Promise<Response> {
+	const send = (token: string | null) => {
+		const headers = new Headers(init.headers);
+		if (token) headers.set('Authorization', `Bearer ${token}`);
+		return fetch(input, { ...init, headers });
+	};
+
+	const response = await send(getApiToken());
+	if (response.status !== 401 || typeof window === 'undefined') {
+		return response;
+	}
+	const token = window.prompt('This server requires an API token. Enter your token:')?.trim();
+	if (!token) {
+		return response;
+	}
+	setApiToken(token);
+	return send(token);
+}
+
+/** Restricts citation listings; omitted fields match any value. */
+export interface CitationFilter {
+	fact_type?: string;
+	source_quality?: 'original' | 'derivative' | 'authored';
+	evidence_type?: 'direct' | 'indirect' | 'negative';
+}
+
 // Types based on OpenAPI schemas
 export type ResearchStatus = 'certain' | 'probable' | 'possible' | 'unknown';
 
 export interface GenDate {
 	raw?: string;
-	qualifier?: 'exact' | 'abt' | 'cal' | 'est' | 'bef' | 'aft' | 'bet' | 'from' | 'int';
+	qualifier?: 'exact' | 'abt' | 'cal' | 'est' | 'bef' | 'aft' | 'bet' | 'from' | 'to' | 'int';
 	/**
 	 * Calendar system as a GEDCOM escape token (DGREGORIAN, DJULIAN, DHEBREW,
 	 * "DFRENCH R"). Absent or DGREGORIAN means the Gregorian calendar.
@@ -70,6 +139,13 @@ export interface GenDate {
 	year2?: number;
 	month2?: number;
 	day2?: number;
+	/** New Style year of a dual-dated year such as "1719/20" (1720); year holds the Old Style year */
+	dual_year?: number;
+	dual_year2?: number;
+	/** Gregorian YYYY-MM-DD used for ordering; BET ranges resolve to their midpoint */
+	sort_date?: string;
+	/** True for approximate, bounded, or interpreted dates (abt, cal, est, bef, aft, bet, int) */
+	uncertain?: boolean;
 	/** Original ambiguous phrase for interpreted (INT) dates, e.g. "about eighteen fifty" */
 	interpreted_from?: string;
 }
@@ -78,7 +154,7 @@ export interface Person {
 	id: string;
 	given_name: string;
 	surname: string;
-	gender?: 'male' | 'female' | 'unknown';
+	gender?: 'male' | 'female' | 'nonbinary' | 'unknown';
 	birth_date?: GenDate;
 	birth_place?: string;
 	death_date?: GenDate;
@@ -92,9 +168,11 @@ export interface Person {
 }
 
 export interface PersonCreate {
-	given_name: string;
+	/** Parsed into name parts server-side; given_name/surname take precedence */
+	full_name?: string;
+	given_name?: string;
 	surname?: string;
-	gender?: 'male' | 'female' | 'unknown';
+	gender?: 'male' | 'female' | 'nonbinary' | 'unknown';
 	birth_date?: string;
 	birth_place?: string;
 	death_date?: string;
@@ -106,7 +184,7 @@ export interface PersonCreate {
 export interface PersonUpdate {
 	given_name?: string;
 	surname?: string;
-	gender?: 'male' | 'female' | 'unknown';
+	gender?: 'male' | 'female' | 'nonbinary' | 'unknown';
 	birth_date?: string;
 	birth_place?: string;
 	death_date?: string;
@@ -120,7 +198,7 @@ export interface PersonSummary {
 	id: string;
 	given_name: string;
 	surname: string;
-	gender?: 'male' | 'female' | 'unknown';
+	gender?: 'male' | 'female' | 'nonbinary' | 'unknown';
 	birth_date?: GenDate;
 	death_date?: GenDate;
 }
@@ -141,10 +219,18 @@ export interface ExternalLink {
 	url?: string;
 }
 
+// Links a person to their profile in another tree. type is the URI naming the
+// external system, e.g. http://www.familysearch.org/ark.
+export interface ExternalIdCreate {
+	value: string;
+	type?: string;
+}
+
 export interface PersonDetail extends Person {
 	families_as_partner?: FamilySummary[];
 	family_as_child?: FamilySummary;
 	external_ids?: ExternalLink[];
+	relationship?: string; // to the home person, when one is configured
 }
 
 export interface PersonList {
@@ -163,6 +249,7 @@ export interface Family {
 	relationship_type?: 'marriage' | 'partnership' | 'unknown';
 	marriage_date?: GenDate;
 	marriage_place?: string;
+	research_status?: ResearchStatus;
 	child_count?: number;
 	version: number;
 }
@@ -173,6 +260,7 @@ export interface FamilyCreate {
 	relationship_type?: 'marriage' | 'partnership' | 'unknown';
 	marriage_date?: string;
 	marriage_place?: string;
+	research_status?: ResearchStatus;
 }
 
 export interface FamilyUpdate {
@@ -181,6 +269,7 @@ export interface FamilyUpdate {
 	relationship_type?: 'marriage' | 'partnership' | 'unknown';
 	marriage_date?: string;
 	marriage_place?: string;
+	research_status?: ResearchStatus;
 	version: number;
 }
 
@@ -231,7 +320,7 @@ export interface GroupSheetPerson {
 	id: string;
 	given_name: string;
 	surname: string;
-	gender?: 'male' | 'female' | 'unknown';
+	gender?: 'male' | 'female' | 'nonbinary' | 'unknown';
 	birth?: GroupSheetEvent;
 	death?: GroupSheetEvent;
 	father_name?: string;
@@ -244,7 +333,7 @@ export interface GroupSheetChild {
 	id: string;
 	given_name: string;
 	surname: string;
-	gender?: 'male' | 'female' | 'unknown';
+	gender?: 'male' | 'female' | 'nonbinary' | 'unknown';
 	relationship_type?: 'biological' | 'adopted' | 'foster';
 	sequence?: number;
 	birth?: GroupSheetEvent;
@@ -261,6 +350,8 @@ export interface FamilyGroupSheet {
 	children?: GroupSheetChild[];
 }
 
+export type FactSourceCounts = components['schemas']['FactSourceCounts'];
+
 export interface PedigreeNode {
 	id: string;
 	given_name?: string;
@@ -268,6 +359,7 @@ export interface PedigreeNode {
 	birth_date?: GenDate;
 	death_date?: GenDate;
 	gender?: string;
+	sources?: FactSourceCounts;
 	father?: PedigreeNode;
 	mother?: PedigreeNode;
 }
@@ -287,6 +379,7 @@ export interface SpouseInfo {
 	gender?: string;
 	marriage_date?: GenDate;
 	marriage_place?: string;
+	family_id?: string; // Family shared with this spouse
 }
 
 export interface DescendancyNode {
@@ -296,6 +389,7 @@ export interface DescendancyNode {
 	birth_date?: GenDate;
 	death_date?: GenDate;
 	gender?: string;
+	family_id?: string; // Parent's family this person descends through; matches a parent spouse's family_id
 	spouses?: SpouseInfo[];
 	children?: DescendancyNode[];
 }
@@ -305,12 +399,19 @@ export interface Descendancy {
 	generations: number;
 	total_descendants: number;
 	max_generation: number;
+	generation_counts?: number[];
 }
 
 // AhnentafelEntry and AhnentafelResponse are imported from types.generated.ts above
 
+export type SearchMatch = components['schemas']['SearchMatch'];
+
 export interface SearchResult extends PersonSummary {
 	score?: number;
+	birth_place?: string;
+	death_place?: string;
+	patronymic?: boolean;
+	matches?: SearchMatch[];
 }
 
 export interface SearchResults {
@@ -331,12 +432,31 @@ export interface ImportError {
 	message: string;
 }
 
+export interface ImportIssue {
+	severity: 'error' | 'warning';
+	code: string;
+	line: number; // 0 when unknown
+	context?: string; // record @XREF@ or raw GEDCOM content
+	message: string;
+}
+
+export interface ImportIssueGroup {
+	code: string;
+	severity: 'error' | 'warning';
+	count: number;
+	issues: ImportIssue[];
+}
+
 export interface ImportResult {
 	success: boolean;
+	import_id?: string; // set by the streaming import
 	persons_imported: number;
 	families_imported: number;
+	file_size?: number; // bytes of the imported GEDCOM file
+	media_imported?: number;
 	warnings?: ImportWarning[];
 	errors?: ImportError[];
+	issue_groups?: ImportIssueGroup[];
 }
 
 export interface ImportProgress {
@@ -345,6 +465,22 @@ export interface ImportProgress {
 	percent: number; // 0-100, or -1 when total is unknown
 }
 
+// Record creation progress, streamed once the GEDCOM file has been parsed
+export interface ImportRecordProgress {
+	import_id: string;
+	phase: string; // kind of record being created, e.g. "persons"
+	done: number;
+	total: number;
+}
+
+// Change notification from the /events/stream Server-Sent Events endpoint
+export interface ChangeNotification {
+	entity_type: string; // snake_case stream type, e.g. "person"
+	entity_id: string;
+	version: number; // stream version after the change
+	action: 'created' | 'updated' | 'deleted';
+}
+
 // Export estimation types
 export interface ExportEstimate {
 	person_count: number;
@@ -373,13 +509,27 @@ export type DataLossItem = components['schemas']['DataLossItem'];
 // GEDCOM versions the export/preview endpoints accept (matches the OpenAPI enum).
 export type GedcomVersion = '5.5' | '5.5.1' | '7.0';
 
+// Optional record types a full GEDCOM export can include; persons and families
+// are always exported.
+export type GedcomExportInclude = 'sources' | 'notes';
+
+// Direction a single-person subtree export walks (matches the OpenAPI enum).
+export type SubtreeExportMode = 'descendants' | 'ancestors';
+
 export interface ApiError {
 	code: string;
 	message: string;
 	details?: Record<string, unknown>;
+	fields?: FieldError[]; // per-field validation failures
+	request_id?: string; // quote when reporting a problem; matches the server logs
 	status?: number;
 }
 
+export interface FieldError {
+	field: string;
+	message: string;
+}
+
 // Evidence Analysis types
 export interface EvidenceAnalysisResponse {
 	id: string;
@@ -614,6 +764,8 @@ export interface Citation {
 export interface CitationListResponse {
 	citations: Citation[];
 	total: number;
+	limit?: number;
+	offset?: number;
 }
 
 export interface CreateCitationRequest {
@@ -645,7 +797,7 @@ export interface UpdateCitationRequest {
 }
 
 // PersonName types
-export type NameType = 'birth' | 'married' | 'aka' | 'immigrant' | 'religious' | 'professional';
+export type NameType = 'birth' | 'married' | 'aka' | 'immigrant' | 'religious' | 'professional' | 'patronymic';
 
 export interface PersonName {
 	id: string;
@@ -657,6 +809,7 @@ export interface PersonName {
 	name_suffix?: string;
 	surname_prefix?: string;
 	nickname?: string;
+	romanized?: string;
 	name_type: NameType;
 	is_primary: boolean;
 }
@@ -668,6 +821,7 @@ export interface PersonNameCreate {
 	name_suffix?: string;
 	surname_prefix?: string;
 	nickname?: string;
+	romanized?: string;
 	name_type: NameType;
 	is_primary: boolean;
 }
@@ -679,6 +833,7 @@ export interface PersonNameUpdate {
 	name_suffix?: string;
 	surname_prefix?: string;
 	nickname?: string;
+	romanized?: string;
 	name_type?: NameType;
 	is_primary?: boolean;
 }
@@ -707,6 +862,11 @@ export interface Media {
 	version: number;
 	created_at: string;
 	updated_at: string;
+	captured_at?: string;
+	gps_latitude?: number;
+	gps_longitude?: number;
+	suggested_event_date?: string;
+	content_hash?: string;
 }
 
 export interface MediaListResponse {
@@ -714,6 +874,17 @@ export interface MediaListResponse {
 	total: number;
 }
 
+export interface MediaDuplicateGroup {
+	content_hash: string;
+	file_size: number;
+	media: Media[];
+}
+
+export interface MediaDuplicatesReport {
+	groups: MediaDuplicateGroup[];
+	total: number;
+}
+
 // Relationship types
 export interface RelationshipPathNode {
 	id: string;
@@ -737,6 +908,21 @@ export interface RelationshipResult {
 	summary?: string;
 }
 
+export interface KinMember {
+	person: Person;
+	relationship: string;
+	degree: number;
+	generations_up: number;
+	generations_down: number;
+}
+
+export interface KinReport {
+	person: Person;
+	max_degree: number;
+	kin: KinMember[];
+	total: number;
+}
+
 // Browse types
 export interface SurnameIndexResponse {
 	items: SurnameEntry[];
@@ -821,6 +1007,22 @@ export interface DiscoveryFeedResponse {
 	total: number;
 }
 
+// Missing data report types
+export interface MissingDataItem {
+	person_id: string;
+	person_name: string;
+	missing: ('birth_date' | 'death_date' | 'parents' | 'sources')[];
+	priority_score: number;
+	completeness_score: number;
+}
+
+export interface MissingDataReport {
+	items: MissingDataItem[];
+	total: number;
+	limit: number;
+	offset: number;
+}
+
 export interface MediaUpdate {
 	title?: string;
 	description?: string;
@@ -845,6 +1047,7 @@ export interface ChangeEntry {
 	entity_id: string;
 	entity_name: string;
 	action: 'created' | 'updated' | 'deleted';
+	summary?: string;
 	changes?: Record<string, FieldChange>;
 	user_id?: string;
 }
@@ -877,7 +1080,7 @@ class ApiClient {
 			options.body = JSON.stringify(body);
 		}
 
-		const response = await fetch(url, options);
+		const response = await apiFetch(url, options);
 
 		if (!response.ok) {
 			const error: ApiError = await response.json().catch(() => ({
@@ -885,6 +1088,7 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
@@ -936,6 +1140,8 @@ class ApiClient {
 		sort?: 'surname' | 'given_name' | 'birth_date' | 'updated_at';
 		order?: 'asc' | 'desc';
 		research_status?: ResearchStatus | 'unset';
+		surname?: string;
+		gender?: 'male' | 'female' | 'nonbinary' | 'unknown';
 	}): Promise<PersonList> {
 		const searchParams = new URLSearchParams();
 		if (params?.limit) searchParams.set('limit', params.limit.toString());
@@ -943,6 +1149,8 @@ class ApiClient {
 		if (params?.sort) searchParams.set('sort', params.sort);
 		if (params?.order) searchParams.set('order', params.order);
 		if (params?.research_status) searchParams.set('research_status', params.research_status);
+		if (params?.surname) searchParams.set('surname', params.surname);
+		if (params?.gender) searchParams.set('gender', params.gender);
 
 		const query = searchParams.toString();
 		return this.request<PersonList>('GET', `/persons${query ? `?${query}` : ''}`);
@@ -1002,24 +1210,89 @@ class ApiClient {
 		return this.request<FamilyGroupSheet>('GET', `/families/${id}/group-sheet`);
 	}
 
+	// Life event and attribute endpoints
+	async setEventResearchStatus(
+		id: string,
+		researchStatus: ResearchStatus,
+		version: number
+	): Promise<ResearchStatusUpdateResult> {
+		return this.request<ResearchStatusUpdateResult>('PUT', `/events/${id}/research-status`, {
+			research_status: researchStatus,
+			version
+		});
+	}
+
+	async setAttributeResearchStatus(
+		id: string,
+		researchStatus: ResearchStatus,
+		version: number
+	): Promise<ResearchStatusUpdateResult> {
+		return this.request<ResearchStatusUpdateResult>('PUT', `/attributes/${id}/research-status`, {
+			research_status: researchStatus,
+			version
+		});
+	}
+
 	// Pedigree endpoint
-	async getPedigree(personId: string, generations?: number): Promise<Pedigree> {
-		const params = generations ? `?generations=${generations}` : '';
-		return this.request<Pedigree>('GET', `/pedigree/${personId}${params}`);
+	async getPedigree(
+		personId: string,
+		generations?: number,
+		includeSources?: boolean
+	): Promise<Pedigree> {
+		const params = new URLSearchParams();
+		if (generations) params.set('generations', generations.toString());
+		if (includeSources) params.set('include_sources', 'true');
+		const query = params.toString();
+		return this.request<Pedigree>('GET', `/pedigree/${personId}${query ? `?${query}` : ''}`);
+	}
+
+	async getPedigreeText(personId: string, generations?: number): Promise<string> {
+		const params = new URLSearchParams();
+		params.set('format', 'text');
+		if (generations) params.set('generations', generations.toString());
+
+		const response = await apiFetch(`${API_BASE}/pedigree/${personId}?${params.toString()}`);
+
+		if (!response.ok) {
+			const error: ApiError = await response.json().catch(() => ({
+				code: 'UNKNOWN_ERROR',
+				message: response.statusText
+			}));
+			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
+			throw error;
+		}
+
+		return response.text();
 	}
 
 	// Ahnentafel endpoint
-	async getAhnentafel(personId: string, generations?: number): Promise<AhnentafelResponse> {
-		const params = generations ? `?generations=${generations}` : '';
-		return this.request<AhnentafelResponse>('GET', `/ahnentafel/${personId}${params}`);
+	async getAhnentafel(
+		personId: string,
+		generations?: number,
+		includeSources?: boolean
+	): Promise<AhnentafelResponse> {
+		const params = new URLSearchParams();
+		if (generations) params.set('generations', generations.toString());
+		if (includeSources) params.set('include_sources', 'true');
+		const query = params.toString();
+		return this.request<AhnentafelResponse>(
+			'GET',
+			`/ahnentafel/${personId}${query ? `?${query}` : ''}`
+		);
 	}
 
-	async getAhnentafelText(personId: string, generations?: number): Promise<string> {
+	async getAhnentafelText(
+		personId: string,
+		generations?: number,
+		includeSources?: boolean
+	): Promise<string> {
 		const params = new URLSearchParams();
 		params.set('format', 'text');
 		if (generations) params.set('generations', generations.toString());
+		if (includeSources) params.set('include_sources', 'true');
 
-		const response = await fetch(`${API_BASE}/ahnentafel/${personId}?${params.toString()}`);
+		const response = await apiFetch(`${API_BASE}/ahnentafel/${personId}?${params.toString()}`);
 
 		if (!response.ok) {
 			const error: ApiError = await response.json().catch(() => ({
@@ -1027,16 +1300,36 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
 		return response.text();
 	}
 
+	// End-of-line ancestors (no recorded parents)
+	async getEndOfLines(personId: string, generations?: number): Promise<EndOfLinesResponse> {
+		const params = new URLSearchParams();
+		if (generations) params.set('generations', generations.toString());
+		const query = params.toString();
+		return this.request<EndOfLinesResponse>(
+			'GET',
+			`/persons/${personId}/end-of-lines${query ? `?${query}` : ''}`
+		);
+	}
+
 	// Descendancy endpoint
-	async getDescendancy(personId: string, generations?: number): Promise<Descendancy> {
-		const params = generations ? `?generations=${generations}` : '';
-		return this.request<Descendancy>('GET', `/descendancy/${personId}${params}`);
+	async getDescendancy(
+		personId: string,
+		generations?: number,
+		filters?: { includeDeceased?: boolean; birthYearFrom?: number }
+	): Promise<Descendancy> {
+		const params = new URLSearchParams();
+		if (generations) params.set('generations', generations.toString());
+		if (filters?.includeDeceased === false) params.set('include_deceased', 'false');
+		if (filters?.birthYearFrom) params.set('birth_year_from', filters.birthYearFrom.toString());
+		const query = params.toString();
+		return this.request<Descendancy>('GET', `/descendancy/${personId}${query ? `?${query}` : ''}`);
 	}
 
 	// Search endpoint
@@ -1076,7 +1369,7 @@ class ApiClient {
 		const formData = new FormData();
 		formData.append('file', file);
 
-		const response = await fetch(`${API_BASE}/gedcom/import`, {
+		const response = await apiFetch(`${API_BASE}/gedcom/import`, {
 			method: 'POST',
 			body: formData
 		});
@@ -1087,6 +1380,7 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
@@ -1097,16 +1391,20 @@ class ApiClient {
 	 * Import a GEDCOM file while receiving real-time parse progress via Server-Sent
 	 * Events. onProgress is invoked with each progress update; the resolved value is
 	 * the final import result. Falls back cleanly if the browser/stream provides no
-	 * progress events (onProgress simply won't be called).
+	 * progress events (onProgress simply won't be called). An optional ZIP
+	 * mediaArchive supplies the media files the GEDCOM references.
 	 */
 	async importGedcomStream(
 		file: File,
-		onProgress?: (progress: ImportProgress) => void
+		onProgress?: (progress: ImportProgress) => void,
+		mediaArchive?: File | null,
+		onRecords?: (progress: ImportRecordProgress) => void
 	): Promise<ImportResult> {
 		const formData = new FormData();
 		formData.append('file', file);
+		if (mediaArchive) formData.append('media', mediaArchive);
 
-		const response = await fetch(`${API_BASE}/gedcom/import/stream`, {
+		const response = await apiFetch(`${API_BASE}/gedcom/import/stream`, {
 			method: 'POST',
 			body: formData
 		});
@@ -1117,6 +1415,7 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
@@ -1139,6 +1438,8 @@ class ApiClient {
 			const data = JSON.parse(dataLines.join('\n'));
 			if (eventName === 'progress') {
 				onProgress?.(data as ImportProgress);
+			} else if (eventName === 'records') {
+				onRecords?.(data as ImportRecordProgress);
 			} else if (eventName === 'result') {
 				result = data as ImportResult;
 			} else if (eventName === 'error') {
@@ -1170,9 +1471,30 @@ class ApiClient {
 		return result;
 	}
 
-	async exportGedcom(version?: GedcomVersion): Promise<string> {
-		const query = version ? `?version=${encodeURIComponent(version)}` : '';
-		const response = await fetch(`${API_BASE}/gedcom/export${query}`);
+	/**
+	 * Subscribe to entity change notifications. onChange is invoked for every
+	 * event appended by any client. The browser reconnects automatically after
+	 * network errors; notifications missed while disconnected are not replayed.
+	 * Returns a function that closes the stream.
+	 */
+	subscribeToChanges(onChange: (change: ChangeNotification) => void): () => void {
+		const source = new EventSource(`${API_BASE}/events/stream`);
+		source.addEventListener('change', (event) => {
+			onChange(JSON.parse((event as MessageEvent).data) as ChangeNotification);
+		});
+		return () => source.close();
+	}
+
+	/**
+	 * Export the whole tree as GEDCOM. When include is given, only the listed
+	 * optional record types are exported alongside persons and families.
+	 */
+	async exportGedcom(version?: GedcomVersion, include?: GedcomExportInclude[]): Promise<string> {
+		const params = new URLSearchParams();
+		if (version) params.set('version', version);
+		if (include) params.set('include', include.join(','));
+		const query = params.size > 0 ? `?${params}` : '';
+		const response = await apiFetch(`${API_BASE}/gedcom/export${query}`);
 
 		if (!response.ok) {
 			const error: ApiError = await response.json().catch(() => ({
@@ -1180,6 +1502,29 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
+			throw error;
+		}
+
+		return response.text();
+	}
+
+	/**
+	 * Export only the descendants or ancestors of a person as GEDCOM, for
+	 * sharing one branch without the rest of the tree.
+	 */
+	async exportPersonGedcom(personId: string, mode: SubtreeExportMode, version?: GedcomVersion): Promise<string> {
+		const params = new URLSearchParams({ mode });
+		if (version) params.set('version', version);
+		const response = await apiFetch(`${API_BASE}/persons/${personId}/export-gedcom?${params}`);
+
+		if (!response.ok) {
+			const error: ApiError = await response.json().catch(() => ({
+				code: 'UNKNOWN_ERROR',
+				message: response.statusText
+			}));
+			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
@@ -1194,7 +1539,7 @@ class ApiClient {
 	 */
 	async previewGedcomExport(version?: GedcomVersion, signal?: AbortSignal): Promise<ExportPreview> {
 		const query = version ? `?version=${encodeURIComponent(version)}` : '';
-		const response = await fetch(`${API_BASE}/gedcom/export/preview${query}`, { signal });
+		const response = await apiFetch(`${API_BASE}/gedcom/export/preview${query}`, { signal });
 
 		if (!response.ok) {
 			const error: ApiError = await response.json().catch(() => ({
@@ -1202,6 +1547,7 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
@@ -1209,7 +1555,7 @@ class ApiClient {
 	}
 
 	async exportTree(): Promise<string> {
-		const response = await fetch(`${API_BASE}/export/tree`);
+		const response = await apiFetch(`${API_BASE}/export/tree`);
 
 		if (!response.ok) {
 			const error: ApiError = await response.json().catch(() => ({
@@ -1217,17 +1563,65 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
 		return response.text();
 	}
 
+	/**
+	 * Export exactly the given persons plus the families connecting them, as
+	 * GEDCOM or JSON text.
+	 */
+	async exportSelection(req: ExportSelectionRequest): Promise<string> {
+		const response = await apiFetch(`${API_BASE}/export/selection`, {
+			method: 'POST',
+			headers: { 'Content-Type': 'application/json' },
+			body: JSON.stringify(req)
+		});
+
+		if (!response.ok) {
+			const error: ApiError = await response.json().catch(() => ({
+				code: 'UNKNOWN_ERROR',
+				message: response.statusText
+			}));
+			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
+			throw error;
+		}
+
+		return response.text();
+	}
+
+	/**
+	 * Export one GEDCOM per surname line, bundled as a ZIP archive.
+	 */
+	async exportBySurname(req: ExportBySurnameRequest = {}): Promise<Blob> {
+		const response = await apiFetch(`${API_BASE}/export/by-surname`, {
+			method: 'POST',
+			headers: { 'Content-Type': 'application/json' },
+			body: JSON.stringify(req)
+		});
+
+		if (!response.ok) {
+			const error: ApiError = await response.json().catch(() => ({
+				code: 'UNKNOWN_ERROR',
+				message: response.statusText
+			}));
+			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
+			throw error;
+		}
+
+		return response.blob();
+	}
+
 	async exportPersons(format: 'json' | 'csv', fields?: string[]): Promise<string> {
 		const params = new URLSearchParams({ format });
 		if (fields?.length) params.set('fields', fields.join(','));
 
-		const response = await fetch(`${API_BASE}/export/persons?${params}`);
+		const response = await apiFetch(`${API_BASE}/export/persons?${params}`);
 
 		if (!response.ok) {
 			const error: ApiError = await response.json().catch(() => ({
@@ -1235,6 +1629,7 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
@@ -1245,7 +1640,7 @@ class ApiClient {
 		const params = new URLSearchParams({ format });
 		if (fields?.length) params.set('fields', fields.join(','));
 
-		const response = await fetch(`${API_BASE}/export/families?${params}`);
+		const response = await apiFetch(`${API_BASE}/export/families?${params}`);
 
 		if (!response.ok) {
 			const error: ApiError = await response.json().catch(() => ({
@@ -1253,6 +1648,7 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
@@ -1263,7 +1659,7 @@ class ApiClient {
 		const params = new URLSearchParams({ format });
 		if (fields?.length) params.set('fields', fields.join(','));
 
-		const response = await fetch(`${API_BASE}/export/sources?${params}`);
+		const response = await apiFetch(`${API_BASE}/export/sources?${params}`);
 
 		if (!response.ok) {
 			const error: ApiError = await response.json().catch(() => ({
@@ -1271,6 +1667,7 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
@@ -1281,7 +1678,7 @@ class ApiClient {
 		const params = new URLSearchParams({ format });
 		if (fields?.length) params.set('fields', fields.join(','));
 
-		const response = await fetch(`${API_BASE}/export/citations?${params}`);
+		const response = await apiFetch(`${API_BASE}/export/citations?${params}`);
 
 		if (!response.ok) {
 			const error: ApiError = await response.json().catch(() => ({
@@ -1289,6 +1686,7 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
@@ -1299,7 +1697,7 @@ class ApiClient {
 		const params = new URLSearchParams({ format });
 		if (fields?.length) params.set('fields', fields.join(','));
 
-		const response = await fetch(`${API_BASE}/export/events?${params}`);
+		const response = await apiFetch(`${API_BASE}/export/events?${params}`);
 
 		if (!response.ok) {
 			const error: ApiError = await response.json().catch(() => ({
@@ -1307,6 +1705,7 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
@@ -1317,7 +1716,7 @@ class ApiClient {
 		const params = new URLSearchParams({ format });
 		if (fields?.length) params.set('fields', fields.join(','));
 
-		const response = await fetch(`${API_BASE}/export/attributes?${params}`);
+		const response = await apiFetch(`${API_BASE}/export/attributes?${params}`);
 
 		if (!response.ok) {
 			const error: ApiError = await response.json().catch(() => ({
@@ -1325,6 +1724,7 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
@@ -1411,9 +1811,36 @@ class ApiClient {
 		return this.request<SourceSearchResponse>('GET', `/sources/search?${searchParams.toString()}`);
 	}
 
+	async getSourceCitations(
+		sourceId: string,
+		params?: { limit?: number; offset?: number } & CitationFilter
+	): Promise<CitationListResponse> {
+		const searchParams = citationFilterParams(params);
+		if (params?.limit) searchParams.set('limit', params.limit.toString());
+		if (params?.offset) searchParams.set('offset', params.offset.toString());
+
+		const query = searchParams.toString();
+		return this.request<CitationListResponse>(
+			'GET',
+			`/sources/${sourceId}/citations${query ? `?${query}` : ''}`
+		);
+	}
+
+	async getSourceUsage(sourceId: string): Promise<SourceUsage> {
+		return this.request<SourceUsage>('GET', `/sources/${sourceId}/usage`);
+	}
+
 	// Citation endpoints
-	async getPersonCitations(personId: string): Promise<CitationListResponse> {
-		return this.request<CitationListResponse>('GET', `/persons/${personId}/citations`);
+	async getPersonCitations(personId: string, filter?: CitationFilter): Promise<CitationListResponse> {
+		const query = citationFilterParams(filter).toString();
+		return this.request<CitationListResponse>(
+			'GET',
+			`/persons/${personId}/citations${query ? `?${query}` : ''}`
+		);
+	}
+
+	async getPersonSourceReport(personId: string): Promise<SourceReport> {
+		return this.request<SourceReport>('GET', `/persons/${personId}/source-report`);
 	}
 
 	async createCitation(data: CreateCitationRequest): Promise<Citation> {
@@ -1442,6 +1869,16 @@ class ApiClient {
 		return this.request<FormattedCitation>('GET', `/citations/${id}/format`);
 	}
 
+	async getFormattedCitation(
+		id: string,
+		style: 'evidence_explained' = 'evidence_explained'
+	): Promise<CitationReference> {
+		return this.request<CitationReference>(
+			'GET',
+			`/citations/${id}/formatted?style=${encodeURIComponent(style)}`
+		);
+	}
+
 	async previewCitationTemplate(
 		templateId: string,
 		fields: Record<string, string>
@@ -1454,8 +1891,16 @@ class ApiClient {
 	}
 
 	// PersonName endpoints
-	async getPersonNames(personId: string): Promise<PersonNameList> {
-		return this.request<PersonNameList>('GET', `/persons/${personId}/names`);
+	async getPersonNames(
+		personId: string,
+		params?: { limit?: number; offset?: number }
+	): Promise<PersonNameList> {
+		const searchParams = new URLSearchParams();
+		if (params?.limit) searchParams.set('limit', params.limit.toString());
+		if (params?.offset) searchParams.set('offset', params.offset.toString());
+
+		const query = searchParams.toString();
+		return this.request<PersonNameList>('GET', `/persons/${personId}/names${query ? `?${query}` : ''}`);
 	}
 
 	async addPersonName(personId: string, data: PersonNameCreate): Promise<PersonName> {
@@ -1470,6 +1915,10 @@ class ApiClient {
 		return this.requestWithConflictRetry<void>('DELETE', `/persons/${personId}/names/${nameId}`);
 	}
 
+	async addPersonExternalId(personId: string, data: ExternalIdCreate): Promise<ExternalLink> {
+		return this.requestWithConflictRetry<ExternalLink>('POST', `/persons/${personId}/external-ids`, data);
+	}
+
 	// Media endpoints
 	async listPersonMedia(
 		personId: string,
@@ -1496,7 +1945,7 @@ class ApiClient {
 		if (description) formData.append('description', description);
 		if (mediaType) formData.append('media_type', mediaType);
 
-		const response = await fetch(`${API_BASE}/persons/${personId}/media`, {
+		const response = await apiFetch(`${API_BASE}/persons/${personId}/media`, {
 			method: 'POST',
 			body: formData
 		});
@@ -1507,12 +1956,17 @@ class ApiClient {
 				message: response.statusText
 			}));
 			error.status = response.status;
+			error.request_id ??= response.headers.get('X-Request-ID') ?? undefined;
 			throw error;
 		}
 
 		return response.json();
 	}
 
+	async listDuplicateMedia(): Promise<MediaDuplicatesReport> {
+		return this.request<MediaDuplicatesReport>('GET', '/media/duplicates');
+	}
+
 	async getMedia(id: string): Promise<Media> {
 		return this.request<Media>('GET', `/media/${id}`);
 	}
@@ -1529,13 +1983,19 @@ class ApiClient {
 		return `${API_BASE}/media/${id}/content`;
 	}
 
-	getMediaThumbnailUrl(id: string): string {
-		return `${API_BASE}/media/${id}/thumbnail`;
+	getPersonMediaArchiveUrl(personId: string): string {
+		return `${API_BASE}/persons/${personId}/media/archive`;
+	}
+
+	getMediaThumbnailUrl(id: string, size?: number): string {
+		const query = size ? `?size=${size}` : '';
+		return `${API_BASE}/media/${id}/thumbnail${query}`;
 	}
 
 	// History endpoints
 	async getGlobalHistory(params?: {
 		entity_type?: string;
+		action?: 'created' | 'updated' | 'deleted' | 'linked' | 'unlinked';
 		from?: string;
 		to?: string;
 		limit?: number;
@@ -1543,6 +2003,7 @@ class ApiClient {
 	}): Promise<ChangeHistoryResponse> {
 		const searchParams = new URLSearchParams();
 		if (params?.entity_type) searchParams.set('entity_type', params.entity_type);
+		if (params?.action) searchParams.set('action', params.action);
 		if (params?.from) searchParams.set('from', params.from);
 		if (params?.to) searchParams.set('to', params.to);
 		if (params?.limit) searchParams.set('limit', params.limit.toString());
@@ -1552,6 +2013,11 @@ class ApiClient {
 		return this.request<ChangeHistoryResponse>('GET', `/history${query ? `?${query}` : ''}`);
 	}
 
+	async getRecentChanges(limit?: number): Promise<{ items: ChangeEntry[] }> {
+		const params = limit != null ? `?limit=${limit}` : '';
+		return this.request<{ items: ChangeEntry[] }>('GET', `/recent${params}`);
+	}
+
 	async getPersonHistory(
 		personId: string,
 		params?: { limit?: number; offset?: number }
@@ -1657,6 +2123,10 @@ class ApiClient {
 		);
 	}
 
+	async listFactTypes(): Promise<FactTypeIndexResponse> {
+		return this.request<FactTypeIndexResponse>('GET', '/fact-types');
+	}
+
 	// Map endpoints
 	async getMapLocations(): Promise<MapLocationsResponse> {
 		return this.request<MapLocationsResponse>('GET', '/map/locations');
@@ -1686,6 +2156,11 @@ class ApiClient {
 		);
 	}
 
+	async getPersonKin(personId: string, maxDegree?: number): Promise<KinReport> {
+		const params = maxDegree ? `?maxDegree=${maxDegree}` : '';
+		return this.request<KinReport>('GET', `/persons/${encodeURIComponent(personId)}/kin${params}`);
+	}
+
 	// Rollback endpoints
 	async getPersonRestorePoints(
 		personId: string,
@@ -1702,6 +2177,19 @@ class ApiClient {
 		);
 	}
 
+	async listTrash(params?: { limit?: number; offset?: number }): Promise<TrashResponse> {
+		const searchParams = new URLSearchParams();
+		if (params?.limit != null) searchParams.set('limit', params.limit.toString());
+		if (params?.offset != null) searchParams.set('offset', params.offset.toString());
+
+		const query = searchParams.toString();
+		return this.request<TrashResponse>('GET', `/trash${query ? `?${query}` : ''}`);
+	}
+
+	async restoreFromTrash(id: string): Promise<RollbackResponse> {
+		return this.request<RollbackResponse>('POST', `/trash/${id}/restore`);
+	}
+
 	async rollbackPerson(personId: string, targetVersion: number): Promise<RollbackResponse> {
 		return this.request<RollbackResponse>('POST', `/persons/${personId}/rollback`, {
 			target_version: targetVersion
@@ -1777,6 +2265,17 @@ class ApiClient {
 		return this.request<DiscoveryFeedResponse>('GET', `/analytics/discovery${params}`);
 	}
 
+	async getMissingDataReport(params?: {
+		limit?: number;
+		offset?: number;
+	}): Promise<MissingDataReport> {
+		const searchParams = new URLSearchParams();
+		if (params?.limit) searchParams.set('limit', params.limit.toString());
+		if (params?.offset) searchParams.set('offset', params.offset.toString());
+		const query = searchParams.toString();
+		return this.request<MissingDataReport>('GET', `/reports/missing-data${query ? `?${query}` : ''}`);
+	}
+
 	// Evidence Analysis endpoints
 	async listEvidenceAnalyses(params?: {
 		limit?: number;
@@ -1983,6 +2482,35 @@ class ApiClient {
 		);
 	}
 
+	async getQualityBySurname(params?: {
+		limit?: number;
+		offset?: number;
+	}): Promise<SurnameQualityReport> {
+		const searchParams = new URLSearchParams();
+		if (params?.limit != null) searchParams.set('limit', params.limit.toString());
+		if (params?.offset != null) searchParams.set('offset', params.offset.toString());
+
+		const query = searchParams.toString();
+		return this.request<SurnameQualityReport>(
+			'GET',
+			`/quality/by-surname${query ? `?${query}` : ''}`
+		);
+	}
+
+	getQualityReportUrl(format: 'csv' | 'pdf'): string {
+		return `${API_BASE}/quality/report?format=${format}`;
+	}
+
+	// Persons with no family links, e.g. left over from an import
+	async listOrphanedPersons(params?: { limit?: number; offset?: number }): Promise<PersonList> {
+		const searchParams = new URLSearchParams();
+		if (params?.limit != null) searchParams.set('limit', params.limit.toString());
+		if (params?.offset != null) searchParams.set('offset', params.offset.toString());
+
+		const query = searchParams.toString();
+		return this.request<PersonList>('GET', `/persons/orphans${query ? `?${query}` : ''}`);
+	}
+
 	// Duplicate detection endpoints
 	async getPersonsDuplicates(params?: {
 		limit?: number;
@@ -2024,6 +2552,10 @@ class ApiClient {
 		return this.request<BatchMergeResponse>('POST', '/persons/merge/batch', req);
 	}
 
+	async bulkDeletePersons(req: BulkDeleteRequest): Promise<BulkDeleteResponse> {
+		return this.request<BulkDeleteResponse>('POST', '/persons/bulk-delete', req);
+	}
+
 	async batchDismissDuplicates(req: BatchDismissRequest): Promise<BatchDismissResponse> {
 		return this.request<BatchDismissResponse>(
 			'POST',
@@ -2035,6 +2567,15 @@ class ApiClient {
 
 export const api = new ApiClient();
 
+/** Encode a citation filter as query parameters. */
+function citationFilterParams(filter?: CitationFilter): URLSearchParams {
+	const searchParams = new URLSearchParams();
+	if (filter?.fact_type) searchParams.set('fact_type', filter.fact_type);
+	if (filter?.source_quality) searchParams.set('source_quality', filter.source_quality);
+	if (filter?.evidence_type) searchParams.set('evidence_type', filter.evidence_type);
+	return searchParams;
+}
+
 /** Check if an error is a version conflict (409). For endpoints using requestWithConflictRetry, auto-retry has already been attempted. */
 export function isConflictError(error: unknown): boolean {
 	const apiError = error as ApiError;
@@ -2072,6 +2613,14 @@ function stripCalendarEscape(raw: string): { text: string; token?: string } {
 	};
 }
 
+/**
+ * Format a year, writing a dual-dated year in the GEDCOM "1719/20" form.
+ */
+function formatYear(year: number, dualYear?: number): string {
+	if (dualYear === undefined) return year.toString();
+	return `${year}/${String(dualYear % 100).padStart(2, '0')}`;
+}
+
 export function formatGenDate(date?: GenDate): string {
 	if (!date) return '';
 
@@ -2114,10 +2663,10 @@ export function formatGenDate(date?: GenDate): string {
 		parts.push(months[date.month - 1]);
 	}
 
-	if (date.year) parts.push(date.year.toString());
+	if (date.year) parts.push(formatYear(date.year, date.dual_year));
 
-	if (date.qualifier === 'bet' && date.year2) {
-		parts.push('AND');
+	if ((date.qualifier === 'bet' || date.qualifier === 'from') && date.year2) {
+		parts.push(date.qualifier === 'bet' ? 'AND' : 'TO');
 		if (date.day2) parts.push(date.day2.toString());
 		if (date.month2) {
 			const months = [
@@ -2136,7 +2685,7 @@ export function formatGenDate(date?: GenDate): string {
 			];
 			parts.push(months[date.month2 - 1]);
 		}
-		parts.push(date.year2.toString());
+		parts.push(formatYear(date.year2, date.dual_year2));
 	}
 
 	if (date.qualifier === 'int' && date.interpreted_from) {
@@ -2171,6 +2720,31 @@ export function formatPersonName(
 	return parts.length > 0 ? parts.join(' ') : 'Unknown';
 }
 
+/**
+ * Split a field value into plain and matched segments for highlighting.
+ * Match offsets are in characters (code points), not UTF-16 units.
+ */
+export function highlightSegments(
+	value: string,
+	matches: SearchMatch[] | undefined,
+	field: SearchMatch['field']
+): { text: string; matched: boolean }[] {
+	const chars = Array.from(value);
+	const spans = (matches ?? [])
+		.filter((m) => m.field === field && m.value === value)
+		.sort((a, b) => a.start - b.start);
+	const segments: { text: string; matched: boolean }[] = [];
+	let pos = 0;
+	for (const span of spans) {
+		if (span.start < pos) continue;
+		if (span.start > pos) segments.push({ text: chars.slice(pos, span.start).join(''), matched: false });
+		segments.push({ text: chars.slice(span.start, span.end).join(''), matched: true });
+		pos = span.end;
+	}
+	if (pos < chars.length) segments.push({ text: chars.slice(pos).join(''), matched: false });
+	return segments;
+}
+
 export function formatLifespan(person: { birth_date?: GenDate; death_date?: GenDate }): string {
 	const birth = person.birth_date?.year;
 	const death = person.death_date?.year;
@@ -61,6 +61,26 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/families/search": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Search families by partner name
+         * @description Every word of the query must appear in either partner's name.
+         */
+        get: operations["searchFamilies"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/families/{id}": {
         parameters: {
             query?: never;
@@ -146,6 +166,48 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/events/{id}/research-status": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        get?: never;
+        /**
+         * Set the research status of a life event
+         * @description Records how confident the researcher is in a person or family event,
+         *     such as a marriage date held as possible until the certificate is found.
+         */
+        put: operations["setEventResearchStatus"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/attributes/{id}/research-status": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        get?: never;
+        /** Set the research status of a person attribute */
+        put: operations["setAttributeResearchStatus"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/pedigree/{id}": {
         parameters: {
             query?: never;
@@ -212,6 +274,30 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/persons/{id}/end-of-lines": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        /**
+         * List end-of-line ancestors for a person
+         * @description Returns the ancestors in a person's pedigree with no recorded father or
+         *     mother - the research frontier. The person is included when they have no
+         *     recorded parents. Entries carry their Ahnentafel number and are sorted by it.
+         */
+        get: operations["getEndOfLines"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/search": {
         parameters: {
             query?: never;
@@ -229,6 +315,27 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/search/advanced": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Search for persons by structured criteria
+         * @description All provided criteria are ANDed together. At least one criterion is required.
+         *     Name and place criteria are case-insensitive partial matches.
+         */
+        post: operations["advancedSearchPersons"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/browse/surnames": {
         parameters: {
             query?: never;
@@ -349,7 +456,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/map/locations": {
+    "/browse/repositories": {
         parameters: {
             query?: never;
             header?: never;
@@ -357,10 +464,10 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get geographic locations for map visualization
-         * @description Returns aggregated birth and death locations with coordinates for map display
+         * Get source repository index with counts
+         * @description Returns each distinct source repository name with its source count
          */
-        get: operations["getMapLocations"];
+        get: operations["browseSourceRepositories"];
         put?: never;
         post?: never;
         delete?: never;
@@ -369,15 +476,20 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/browse/brick-walls": {
+    "/fact-types": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** List brick wall research blocks */
-        get: operations["getBrickWalls"];
+        /**
+         * List the fact types in use with counts
+         * @description Returns each fact type used by a life event, attribute, or citation, with
+         *     how many of each use it. Fact types the application does not define, such
+         *     as vendor-specific types from an import, are marked known: false.
+         */
+        get: operations["listFactTypes"];
         put?: never;
         post?: never;
         delete?: never;
@@ -386,52 +498,62 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/persons/{id}/brick-wall": {
+    "/browse/repositories/{name}": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                id: components["parameters"]["personId"];
+                /** @description The repository name to list sources for (case-insensitive) */
+                name: string;
             };
             cookie?: never;
         };
-        get?: never;
-        /** Mark person as a brick wall */
-        put: operations["setPersonBrickWall"];
+        /** Get sources held by a repository */
+        get: operations["getSourcesByRepository"];
+        put?: never;
         post?: never;
-        /** Resolve a brick wall (mark as broken through) */
-        delete: operations["resolvePersonBrickWall"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/gedcom/import": {
+    "/browse/birth-decades": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
+        /**
+         * Get birth decade index with counts
+         * @description Returns person counts per birth decade in chronological order.
+         *     Persons without a parseable birth date are counted in a trailing "unknown" entry.
+         */
+        get: operations["browseBirthDecades"];
         put?: never;
-        /** Import a GEDCOM file */
-        post: operations["importGedcom"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/gedcom/export": {
+    "/browse/birth-decades/{decade}": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                /** @description Decade to list (e.g. 1850 or 1850s), or "unknown" for undated persons */
+                decade: string;
+            };
             cookie?: never;
         };
-        /** Export all data as GEDCOM */
-        get: operations["exportGedcom"];
+        /**
+         * Get persons born in a decade
+         * @description Returns persons born in the decade, ordered by birth date.
+         */
+        get: operations["getPersonsByBirthDecade"];
         put?: never;
         post?: never;
         delete?: never;
@@ -440,7 +562,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/gedcom/export/preview": {
+    "/places/variants": {
         parameters: {
             query?: never;
             header?: never;
@@ -448,10 +570,13 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Preview a GEDCOM export conversion and report data loss
-         * @description Reports what would change if the data were exported at the given GEDCOM version, without producing a file. Use it to warn about data loss before downloading a downgraded export. This builds the full export document server-side (the same cost as a real export), so call it on demand — e.g. when the user changes the target version — rather than reactively on every keystroke.
+         * Find clusters of similar place names
+         * @description Groups place strings used by persons, families, and events that appear to name the same place.
+         *     Comparison ignores case, periods, and extra spaces, expands US state abbreviations, and drops
+         *     a trailing United States country, so "Springfield, IL" and "Springfield, Illinois, USA" cluster.
+         *     Only clusters with two or more distinct strings are returned, largest first.
          */
-        get: operations["previewGedcomExport"];
+        get: operations["getPlaceVariants"];
         put?: never;
         post?: never;
         delete?: never;
@@ -460,53 +585,67 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/history": {
+    "/places/merge": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** List global change history */
-        get: operations["listHistory"];
+        get?: never;
         put?: never;
-        post?: never;
+        /**
+         * Merge place name variants into a canonical name
+         * @description Rewrites every person birth/death place, family marriage place, and event place that exactly
+         *     matches one of the variants to the canonical name. Each record is updated through its own
+         *     update command, so the change appears in its history.
+         */
+        post: operations["mergePlaces"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/{id}/history": {
+    "/batch": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: components["parameters"]["personId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        /** Get change history for a person */
-        get: operations["getPersonHistory"];
+        get?: never;
         put?: never;
-        post?: never;
+        /**
+         * Run several operations in one request
+         * @description Runs create_person, add_name, create_family, and link_child operations in order. An
+         *     operation may set an alias; later operations reference the created ID as "$alias" in
+         *     person_id, partner1_id, partner2_id, family_id, or child_id. With stop_on_error (the
+         *     default), the first failure rolls back the operations already applied (status
+         *     rolled_back) and skips the rest. Without it, each operation is committed as soon as it
+         *     succeeds and the rest still run.
+         *     Structural problems (unknown operations, duplicate aliases, references to aliases not
+         *     defined earlier) reject the whole batch with 400 before anything runs.
+         */
+        post: operations["executeBatch"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/families/{id}/history": {
+    "/map/locations": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: components["parameters"]["familyId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        /** Get change history for a family */
-        get: operations["getFamilyHistory"];
+        /**
+         * Get geographic locations for map visualization
+         * @description Returns aggregated birth and death locations with coordinates for map display
+         */
+        get: operations["getMapLocations"];
         put?: never;
         post?: never;
         delete?: never;
@@ -515,17 +654,15 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/sources/{id}/history": {
+    "/browse/brick-walls": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
-        /** Get change history for a source */
-        get: operations["getSourceHistory"];
+        /** List brick wall research blocks */
+        get: operations["getBrickWalls"];
         put?: never;
         post?: never;
         delete?: never;
@@ -534,7 +671,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/persons/{id}/restore-points": {
+    "/persons/{id}/brick-wall": {
         parameters: {
             query?: never;
             header?: never;
@@ -543,55 +680,43 @@ export interface paths {
             };
             cookie?: never;
         };
-        /**
-         * Get restore points for a person
-         * @description Returns a list of versions to which the person can be rolled back
-         */
-        get: operations["getPersonRestorePoints"];
-        put?: never;
+        get?: never;
+        /** Mark person as a brick wall */
+        put: operations["setPersonBrickWall"];
         post?: never;
-        delete?: never;
+        /** Resolve a brick wall (mark as broken through) */
+        delete: operations["resolvePersonBrickWall"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/{id}/rollback": {
+    "/gedcom/import": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: components["parameters"]["personId"];
-            };
+            path?: never;
             cookie?: never;
         };
         get?: never;
         put?: never;
-        /**
-         * Rollback a person to a previous version
-         * @description Restores the person's data to match the state at the specified version
-         */
-        post: operations["rollbackPerson"];
+        /** Import a GEDCOM file */
+        post: operations["importGedcom"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/families/{id}/restore-points": {
+    "/gedcom/export": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: components["parameters"]["familyId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        /**
-         * Get restore points for a family
-         * @description Returns a list of versions to which the family can be rolled back
-         */
-        get: operations["getFamilyRestorePoints"];
+        /** Export all data as GEDCOM */
+        get: operations["exportGedcom"];
         put?: never;
         post?: never;
         delete?: never;
@@ -600,42 +725,43 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/families/{id}/rollback": {
+    "/gedcom/export/preview": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: components["parameters"]["familyId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Rollback a family to a previous version
-         * @description Restores the family's data to match the state at the specified version
+         * Preview a GEDCOM export conversion and report data loss
+         * @description Reports what would change if the data were exported at the given GEDCOM version, without producing a file. Use it to warn about data loss before downloading a downgraded export. This builds the full export document server-side (the same cost as a real export), so call it on demand — e.g. when the user changes the target version — rather than reactively on every keystroke.
          */
-        post: operations["rollbackFamily"];
+        get: operations["previewGedcomExport"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/sources/{id}/restore-points": {
+    "/persons/{id}/export-gedcom": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                id: string;
+                id: components["parameters"]["personId"];
             };
             cookie?: never;
         };
         /**
-         * Get restore points for a source
-         * @description Returns a list of versions to which the source can be rolled back
+         * Export one branch of the tree as GEDCOM
+         * @description Exports only the subtree rooted at a person. In descendants mode this
+         *     is the person, their descendants, and the partners in each of their
+         *     families; in ancestors mode it is the person and their direct
+         *     ancestors. Sources, repositories and submitters are included in full.
          */
-        get: operations["getSourceRestorePoints"];
+        get: operations["exportPersonGedcom"];
         put?: never;
         post?: never;
         delete?: never;
@@ -644,42 +770,37 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/sources/{id}/rollback": {
+    "/history": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
-        get?: never;
+        /** List global change history */
+        get: operations["listHistory"];
         put?: never;
-        /**
-         * Rollback a source to a previous version
-         * @description Restores the source's data to match the state at the specified version
-         */
-        post: operations["rollbackSource"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/citations/{id}/restore-points": {
+    "/recent": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
         /**
-         * Get restore points for a citation
-         * @description Returns a list of versions to which the citation can be rolled back
+         * List recently changed entities
+         * @description Returns the most recently changed persons, families, sources, and
+         *     citations, newest first. Each entity appears once, with its latest
+         *     change. Unlike the full history, field-level changes are omitted.
          */
-        get: operations["getCitationRestorePoints"];
+        get: operations["listRecentChanges"];
         put?: never;
         post?: never;
         delete?: never;
@@ -688,40 +809,36 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/citations/{id}/rollback": {
+    "/persons/{id}/history": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                id: string;
+                id: components["parameters"]["personId"];
             };
             cookie?: never;
         };
-        get?: never;
+        /** Get change history for a person */
+        get: operations["getPersonHistory"];
         put?: never;
-        /**
-         * Rollback a citation to a previous version
-         * @description Restores the citation's data to match the state at the specified version
-         */
-        post: operations["rollbackCitation"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/citation-templates": {
+    "/families/{id}/history": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                id: components["parameters"]["familyId"];
+            };
             cookie?: never;
         };
-        /**
-         * List available citation templates
-         * @description Returns all Evidence Explained citation templates, optionally filtered by source type
-         */
-        get: operations["listCitationTemplates"];
+        /** Get change history for a family */
+        get: operations["getFamilyHistory"];
         put?: never;
         post?: never;
         delete?: never;
@@ -730,18 +847,17 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/citation-templates/{id}": {
+    "/sources/{id}/history": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                /** @description Template ID (e.g., census.us.federal) */
                 id: string;
             };
             cookie?: never;
         };
-        /** Get a citation template by ID */
-        get: operations["getCitationTemplate"];
+        /** Get change history for a source */
+        get: operations["getSourceHistory"];
         put?: never;
         post?: never;
         delete?: never;
@@ -750,52 +866,54 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/citation-templates/{id}/preview": {
+    "/trash": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                /** @description Template ID (e.g., census.us.federal) */
-                id: string;
-            };
+            path?: never;
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Preview a citation template with provided fields
-         * @description Renders a citation preview without requiring a saved citation. Useful for live preview during editing.
+         * List deleted entities
+         * @description Returns the persons, families, sources, and citations whose latest
+         *     change deleted them, most recently deleted first. Entities deleted and
+         *     later recreated are not listed.
          */
-        post: operations["previewCitationTemplate"];
+        get: operations["listTrash"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/citations/{id}/format": {
+    "/trash/{id}/restore": {
         parameters: {
             query?: never;
             header?: never;
             path: {
+                /** @description ID of the deleted entity */
                 id: string;
             };
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Format a citation using its template
-         * @description Renders the citation as full and short formatted text using the associated template
+         * Restore a deleted entity
+         * @description Recreates a deleted entity with the data it had just before it was
+         *     deleted. A family cannot be restored once a partner is deleted, nor a
+         *     citation once its source or fact owner is deleted.
          */
-        get: operations["formatCitation"];
-        put?: never;
-        post?: never;
+        post: operations["restoreFromTrash"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/{id}/media": {
+    "/persons/{id}/restore-points": {
         parameters: {
             query?: never;
             header?: never;
@@ -804,51 +922,55 @@ export interface paths {
             };
             cookie?: never;
         };
-        /** List media attached to a person */
-        get: operations["listPersonMedia"];
+        /**
+         * Get restore points for a person
+         * @description Returns a list of versions to which the person can be rolled back
+         */
+        get: operations["getPersonRestorePoints"];
         put?: never;
-        /** Upload media to a person */
-        post: operations["uploadPersonMedia"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/media/{id}": {
+    "/persons/{id}/rollback": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                /** @description Media ID */
-                id: string;
+                id: components["parameters"]["personId"];
             };
             cookie?: never;
         };
-        /** Get media metadata */
-        get: operations["getMedia"];
-        /** Update media metadata */
-        put: operations["updateMedia"];
-        post?: never;
-        /** Delete media */
-        delete: operations["deleteMedia"];
+        get?: never;
+        put?: never;
+        /**
+         * Rollback a person to a previous version
+         * @description Restores the person's data to match the state at the specified version
+         */
+        post: operations["rollbackPerson"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/media/{id}/content": {
+    "/families/{id}/restore-points": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                /** @description Media ID */
-                id: string;
+                id: components["parameters"]["familyId"];
             };
             cookie?: never;
         };
-        /** Download media file */
-        get: operations["downloadMedia"];
+        /**
+         * Get restore points for a family
+         * @description Returns a list of versions to which the family can be rolled back
+         */
+        get: operations["getFamilyRestorePoints"];
         put?: never;
         post?: never;
         delete?: never;
@@ -857,62 +979,73 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/media/{id}/thumbnail": {
+    "/families/{id}/rollback": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                /** @description Media ID */
-                id: string;
+                id: components["parameters"]["familyId"];
             };
             cookie?: never;
         };
-        /** Get media thumbnail */
-        get: operations["getMediaThumbnail"];
+        get?: never;
         put?: never;
-        post?: never;
+        /**
+         * Rollback a family to a previous version
+         * @description Restores the family's data to match the state at the specified version
+         */
+        post: operations["rollbackFamily"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/sources": {
+    "/sources/{id}/restore-points": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                id: string;
+            };
             cookie?: never;
         };
-        /** List all sources */
-        get: operations["listSources"];
+        /**
+         * Get restore points for a source
+         * @description Returns a list of versions to which the source can be rolled back
+         */
+        get: operations["getSourceRestorePoints"];
         put?: never;
-        /** Create a new source */
-        post: operations["createSource"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/sources/search": {
+    "/sources/{id}/rollback": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                id: string;
+            };
             cookie?: never;
         };
-        /** Search sources */
-        get: operations["searchSources"];
+        get?: never;
         put?: never;
-        post?: never;
+        /**
+         * Rollback a source to a previous version
+         * @description Restores the source's data to match the state at the specified version
+         */
+        post: operations["rollbackSource"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/sources/{id}": {
+    "/citations/{id}/restore-points": {
         parameters: {
             query?: never;
             header?: never;
@@ -921,19 +1054,20 @@ export interface paths {
             };
             cookie?: never;
         };
-        /** Get a source by ID */
-        get: operations["getSource"];
-        /** Update a source */
-        put: operations["updateSource"];
+        /**
+         * Get restore points for a citation
+         * @description Returns a list of versions to which the citation can be rolled back
+         */
+        get: operations["getCitationRestorePoints"];
+        put?: never;
         post?: never;
-        /** Delete a source */
-        delete: operations["deleteSource"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/sources/{id}/citations": {
+    "/citations/{id}/rollback": {
         parameters: {
             query?: never;
             header?: never;
@@ -942,161 +1076,166 @@ export interface paths {
             };
             cookie?: never;
         };
-        /** Get citations for a source */
-        get: operations["getCitationsForSource"];
+        get?: never;
         put?: never;
-        post?: never;
+        /**
+         * Rollback a citation to a previous version
+         * @description Restores the citation's data to match the state at the specified version
+         */
+        post: operations["rollbackCitation"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/citations": {
+    "/citation-templates": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        get?: never;
+        /**
+         * List available citation templates
+         * @description Returns all Evidence Explained citation templates, optionally filtered by source type
+         */
+        get: operations["listCitationTemplates"];
         put?: never;
-        /** Create a new citation */
-        post: operations["createCitation"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/citations/{id}": {
+    "/citation-templates/{id}": {
         parameters: {
             query?: never;
             header?: never;
             path: {
+                /** @description Template ID (e.g., census.us.federal) */
                 id: string;
             };
             cookie?: never;
         };
-        /** Get a citation by ID */
-        get: operations["getCitation"];
-        /** Update a citation */
-        put: operations["updateCitation"];
+        /** Get a citation template by ID */
+        get: operations["getCitationTemplate"];
+        put?: never;
         post?: never;
-        /** Delete a citation */
-        delete: operations["deleteCitation"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/{id}/citations": {
+    "/citation-templates/{id}/preview": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                id: components["parameters"]["personId"];
+                /** @description Template ID (e.g., census.us.federal) */
+                id: string;
             };
             cookie?: never;
         };
-        /** Get citations for a person */
-        get: operations["getCitationsForPerson"];
+        get?: never;
         put?: never;
-        post?: never;
+        /**
+         * Preview a citation template with provided fields
+         * @description Renders a citation preview without requiring a saved citation. Useful for live preview during editing.
+         */
+        post: operations["previewCitationTemplate"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/{id}/names": {
+    "/citations/{id}/format": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                id: components["parameters"]["personId"];
+                id: string;
             };
             cookie?: never;
         };
         /**
-         * Get all names for a person
-         * @description Returns all name variants for a person (birth name, married name, aliases, etc.)
+         * Format a citation using its template
+         * @description Renders the citation as full and short formatted text using the associated template
          */
-        get: operations["getPersonNames"];
+        get: operations["formatCitation"];
         put?: never;
-        /**
-         * Add a name to a person
-         * @description Add a new name variant to a person. If is_primary is true, the existing
-         *     primary name will be demoted to non-primary.
-         */
-        post: operations["addPersonName"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/{id}/names/{nameId}": {
+    "/citations/{id}/formatted": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                id: components["parameters"]["personId"];
-                nameId: string;
+                id: string;
             };
             cookie?: never;
         };
-        get?: never;
         /**
-         * Update a person's name
-         * @description Update an existing name variant. If is_primary is set to true, the existing
-         *     primary name will be demoted to non-primary.
+         * Render a citation as footnote and bibliography text
+         * @description Produces reference notes and a source list entry from the citation and
+         *     its source. When the citation uses a template, template fields left
+         *     empty are filled from the matching source or citation value (author,
+         *     title, publisher, URL, repository, page, volume). Citations without a
+         *     template are assembled from the source and citation fields alone.
          */
-        put: operations["updatePersonName"];
+        get: operations["getFormattedCitation"];
+        put?: never;
         post?: never;
-        /**
-         * Remove a name from a person
-         * @description Remove a name variant from a person. Cannot delete the last remaining name
-         *     or the primary name (must transfer primary status first).
-         */
-        delete: operations["deletePersonName"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/export/tree": {
+    "/persons/{id}/media": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
             cookie?: never;
         };
-        /**
-         * Export full family tree
-         * @description Export the complete family tree data in a structured format
-         */
-        get: operations["exportTree"];
+        /** List media attached to a person */
+        get: operations["listPersonMedia"];
         put?: never;
-        post?: never;
+        /** Upload media to a person */
+        post: operations["uploadPersonMedia"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/export/persons": {
+    "/persons/{id}/media/archive": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
             cookie?: never;
         };
         /**
-         * Export persons data
-         * @description Export all persons data in a structured format
+         * Download all media attached to a person as a ZIP
+         * @description Streams a ZIP archive holding the original file of every media item
+         *     attached to the person. Entries are named after the media title with
+         *     the original file extension; repeated names get a numeric suffix.
          */
-        get: operations["exportPersons"];
+        get: operations["downloadPersonMediaArchive"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1105,7 +1244,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/export/families": {
+    "/media/duplicates": {
         parameters: {
             query?: never;
             header?: never;
@@ -1113,10 +1252,11 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Export families data
-         * @description Export all families data in a structured format
+         * Report media with identical file content
+         * @description Groups media records whose uploaded files have the same SHA-256 hash.
+         *     Duplicate uploads share one stored copy of the file.
          */
-        get: operations["exportFamilies"];
+        get: operations["listDuplicateMedia"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1125,38 +1265,40 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/export/sources": {
+    "/media/{id}": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                /** @description Media ID */
+                id: string;
+            };
             cookie?: never;
         };
-        /**
-         * Export sources data
-         * @description Export all sources data in a structured format
-         */
-        get: operations["exportSources"];
-        put?: never;
+        /** Get media metadata */
+        get: operations["getMedia"];
+        /** Update media metadata */
+        put: operations["updateMedia"];
         post?: never;
-        delete?: never;
+        /** Delete media */
+        delete: operations["deleteMedia"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/export/citations": {
+    "/media/{id}/content": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                /** @description Media ID */
+                id: string;
+            };
             cookie?: never;
         };
-        /**
-         * Export citations data
-         * @description Export all citations data in a structured format
-         */
-        get: operations["exportCitations"];
+        /** Download media file */
+        get: operations["downloadMedia"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1165,18 +1307,22 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/export/events": {
+    "/media/{id}/thumbnail": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                /** @description Media ID */
+                id: string;
+            };
             cookie?: never;
         };
         /**
-         * Export events data
-         * @description Export all life events data in a structured format
+         * Get media thumbnail
+         * @description Returns a thumbnail fitting within size x size pixels. The default size
+         *     is stored on upload; other sizes are generated on first request and cached.
          */
-        get: operations["exportEvents"];
+        get: operations["getMediaThumbnail"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1185,40 +1331,33 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/export/attributes": {
+    "/sources": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /**
-         * Export attributes data
-         * @description Export all person attributes data in a structured format
-         */
-        get: operations["exportAttributes"];
+        /** List all sources */
+        get: operations["listSources"];
         put?: never;
-        post?: never;
+        /** Create a new source */
+        post: operations["createSource"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/export/estimate": {
+    "/sources/search": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /**
-         * Get export size estimation
-         * @description Returns an estimate of the export file size and record counts.
-         *     Useful for showing users expected download size and determining
-         *     whether to use streaming progress for large exports.
-         */
-        get: operations["getExportEstimate"];
+        /** Search sources */
+        get: operations["searchSources"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1227,40 +1366,41 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/quality/overview": {
+    "/sources/{id}": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                id: string;
+            };
             cookie?: never;
         };
-        /**
-         * Get aggregate quality metrics
-         * @description Returns overall data quality metrics across all persons
-         */
-        get: operations["getQualityOverview"];
-        put?: never;
+        /** Get a source by ID */
+        get: operations["getSource"];
+        /** Update a source */
+        put: operations["updateSource"];
         post?: never;
-        delete?: never;
+        /** Delete a source */
+        delete: operations["deleteSource"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/quality/persons/{id}": {
+    "/sources/{id}/citations": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                id: components["parameters"]["personId"];
+                id: string;
             };
             cookie?: never;
         };
         /**
-         * Get quality score for a person
-         * @description Returns data completeness score and issues for a specific person
+         * Get citations for a source
+         * @description Returns a page of the source's citations, oldest first.
          */
-        get: operations["getPersonQuality"];
+        get: operations["getCitationsForSource"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1269,18 +1409,22 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/quality/report": {
+    "/sources/{id}/usage": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                id: string;
+            };
             cookie?: never;
         };
         /**
-         * Get full quality report
-         * @description Returns comprehensive data quality metrics including completeness, coverage, and aggregated issues
+         * Get the persons and families a source is cited for
+         * @description Groups the source's citations by the person or family whose facts they
+         *     support, listing each owner with the fact types cited, the citations,
+         *     and the best source quality among them. Owners are ordered by name.
          */
-        get: operations["getQualityReport"];
+        get: operations["getSourceUsage"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1289,41 +1433,55 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/quality/validation": {
+    "/citations": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /**
-         * Get validation issues
-         * @description Returns date logic and reference validation issues categorized by
-         *     severity. Supports pagination so a large tree does not produce an
-         *     unbounded response. The error/warning/info counts always reflect the
-         *     full unfiltered, unpaginated issue set.
-         */
-        get: operations["getValidationIssues"];
+        get?: never;
         put?: never;
-        post?: never;
+        /** Create a new citation */
+        post: operations["createCitation"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/duplicates": {
+    "/citations/{id}": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                id: string;
+            };
             cookie?: never;
         };
-        /**
-         * Find potential duplicate persons
-         * @description Returns pairs of persons that may be duplicates based on name, date, and place matching
-         */
-        get: operations["getPersonsDuplicates"];
+        /** Get a citation by ID */
+        get: operations["getCitation"];
+        /** Update a citation */
+        put: operations["updateCitation"];
+        post?: never;
+        /** Delete a citation */
+        delete: operations["deleteCitation"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/persons/{id}/citations": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        /** Get citations for a person */
+        get: operations["getCitationsForPerson"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1332,99 +1490,113 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/persons/merge": {
+    "/persons/{id}/source-report": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
             cookie?: never;
         };
-        get?: never;
-        put?: never;
         /**
-         * Merge two person records
-         * @description Merges two person records, consolidating data from the merged person into the survivor.
-         *     The merged person is deleted after the merge. All relationships (families, citations,
-         *     alternate names, events, media) are transferred to the survivor.
+         * Get citation coverage for each of a person's facts
+         * @description Lists the person's name, birth, death and life events with the number
+         *     of citations supporting each and the source quality of the best one.
+         *     Citations attach to facts by fact type, so several events of the same
+         *     type share their citations.
          */
-        post: operations["mergePersons"];
+        get: operations["getPersonSourceReport"];
+        put?: never;
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/duplicates/{person1Id}/{person2Id}/dismiss": {
+    "/persons/{id}/names": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                /** @description First person ID in the duplicate pair */
-                person1Id: string;
-                /** @description Second person ID in the duplicate pair */
-                person2Id: string;
+                id: components["parameters"]["personId"];
             };
             cookie?: never;
         };
-        get?: never;
+        /**
+         * Get all names for a person
+         * @description Returns all name variants for a person (birth name, married name, aliases, etc.)
+         */
+        get: operations["getPersonNames"];
         put?: never;
         /**
-         * Dismiss a duplicate pair as false positive
-         * @description Marks a duplicate pair as a false positive. The pair will not be returned
-         *     in future duplicate detection results.
+         * Add a name to a person
+         * @description Add a new name variant to a person. If is_primary is true, the existing
+         *     primary name will be demoted to non-primary.
          */
-        post: operations["dismissDuplicate"];
+        post: operations["addPersonName"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/merge/batch": {
+    "/persons/{id}/names/{nameId}": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                id: components["parameters"]["personId"];
+                nameId: string;
+            };
             cookie?: never;
         };
         get?: never;
-        put?: never;
         /**
-         * Batch merge multiple duplicate pairs
-         * @description Merges multiple duplicate pairs in a single batch operation.
-         *     Each pair is processed independently; partial failures are reported
-         *     in the response. Successfully merged pairs continue processing even
-         *     if earlier pairs fail.
+         * Update a person's name
+         * @description Update an existing name variant. If is_primary is set to true, the existing
+         *     primary name will be demoted to non-primary.
          */
-        post: operations["batchMergePersons"];
-        delete?: never;
+        put: operations["updatePersonName"];
+        post?: never;
+        /**
+         * Remove a name from a person
+         * @description Remove a name variant from a person. Cannot delete the last remaining name
+         *     or the primary name (must transfer primary status first).
+         */
+        delete: operations["deletePersonName"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/duplicates/dismiss/batch": {
+    "/persons/{id}/external-ids": {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
             cookie?: never;
         };
         get?: never;
         put?: never;
         /**
-         * Batch dismiss multiple duplicate pairs
-         * @description Dismisses multiple duplicate pairs as false positives in a single operation.
-         *     Each pair is processed independently; partial failures are reported in the response.
+         * Link a person to an external tree
+         * @description Record that this person is the same individual as a profile in another
+         *     tree, such as a FamilySearch, WikiTree, or Ancestry person ID. The link
+         *     appears in the person detail's external_ids and is exported as a
+         *     GEDCOM EXID (or _FSFTID for FamilySearch on a 5.5.1 export).
          */
-        post: operations["batchDismissDuplicates"];
+        post: operations["addPersonExternalId"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/statistics": {
+    "/export/tree": {
         parameters: {
             query?: never;
             header?: never;
@@ -1432,10 +1604,10 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * Get tree-wide statistics
-         * @description Returns aggregate statistics about the family tree
+         * Export full family tree
+         * @description Export the complete family tree data in a structured format
          */
-        get: operations["getStatistics"];
+        get: operations["exportTree"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1444,91 +1616,86 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/analytics/discovery": {
+    "/export/selection": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * Get discovery feed suggestions
-         * @description Returns prioritized research suggestions based on data quality analysis
+         * Export a selected set of persons
+         * @description Exports exactly the listed persons plus the families that connect at
+         *     least two of them. Use it to export an ad-hoc working set, such as
+         *     people gathered from search results. GEDCOM output includes sources,
+         *     repositories and submitters in full.
          */
-        get: operations["getDiscoveryFeed"];
-        put?: never;
-        post?: never;
+        post: operations["exportSelection"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/snapshots": {
+    "/export/by-surname": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /**
-         * List all snapshots
-         * @description Returns all research milestone snapshots ordered by creation date (newest first)
-         */
-        get: operations["listSnapshots"];
+        get?: never;
         put?: never;
         /**
-         * Create a new snapshot
-         * @description Creates a snapshot capturing the current position in the event store
+         * Export one GEDCOM per surname as a ZIP
+         * @description Streams a ZIP archive holding a self-contained GEDCOM file for each
+         *     surname in the tree, for societies that take per-line submissions.
+         *     Each file holds everyone bearing the surname, the partners who married
+         *     into their families, and the families connecting them, so a spouse also
+         *     appears in their own surname's file. Sources, repositories and
+         *     submitters are included in full. Persons without a surname are left out.
          */
-        post: operations["createSnapshot"];
+        post: operations["exportBySurname"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/snapshots/{id}": {
+    "/export/persons": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                /** @description Snapshot UUID */
-                id: components["parameters"]["snapshotId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        /** Get a snapshot by ID */
-        get: operations["getSnapshot"];
-        put?: never;
-        post?: never;
         /**
-         * Delete a snapshot
-         * @description Removes the snapshot record (events in the store remain untouched)
+         * Export persons data
+         * @description Export all persons data in a structured format
          */
-        delete: operations["deleteSnapshot"];
+        get: operations["exportPersons"];
+        put?: never;
+        post?: never;
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/snapshots/{id1}/compare/{id2}": {
+    "/export/families": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                /** @description First snapshot ID */
-                id1: string;
-                /** @description Second snapshot ID */
-                id2: string;
-            };
+            path?: never;
             cookie?: never;
         };
         /**
-         * Compare two snapshots
-         * @description Returns the list of changes (events) between two snapshot positions
+         * Export families data
+         * @description Export all families data in a structured format
          */
-        get: operations["compareSnapshots"];
+        get: operations["exportFamilies"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1537,15 +1704,18 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/relationship/{personId1}/{personId2}": {
+    "/export/sources": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** Calculate relationship between two people */
-        get: operations["getRelationship"];
+        /**
+         * Export sources data
+         * @description Export all sources data in a structured format
+         */
+        get: operations["exportSources"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1554,7 +1724,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/notes": {
+    "/export/citations": {
         parameters: {
             query?: never;
             header?: never;
@@ -1562,42 +1732,39 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * List all notes
-         * @description Returns paginated list of shared GEDCOM NOTE records
+         * Export citations data
+         * @description Export all citations data in a structured format
          */
-        get: operations["listNotes"];
+        get: operations["exportCitations"];
         put?: never;
-        /** Create a new note */
-        post: operations["createNote"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/notes/{id}": {
+    "/export/events": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                /** @description Note UUID */
-                id: components["parameters"]["noteId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        /** Get a note by ID */
-        get: operations["getNote"];
-        /** Update a note */
-        put: operations["updateNote"];
+        /**
+         * Export events data
+         * @description Export all life events data in a structured format
+         */
+        get: operations["exportEvents"];
+        put?: never;
         post?: never;
-        /** Delete a note */
-        delete: operations["deleteNote"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/submitters": {
+    "/export/attributes": {
         parameters: {
             query?: never;
             header?: never;
@@ -1605,42 +1772,41 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * List all submitters
-         * @description Returns paginated list of GEDCOM SUBM (submitter) records
+         * Export attributes data
+         * @description Export all person attributes data in a structured format
          */
-        get: operations["listSubmitters"];
+        get: operations["exportAttributes"];
         put?: never;
-        /** Create a new submitter */
-        post: operations["createSubmitter"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/submitters/{id}": {
+    "/export/estimate": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                /** @description Submitter UUID */
-                id: components["parameters"]["submitterId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        /** Get a submitter by ID */
-        get: operations["getSubmitter"];
-        /** Update a submitter */
-        put: operations["updateSubmitter"];
+        /**
+         * Get export size estimation
+         * @description Returns an estimate of the export file size and record counts.
+         *     Useful for showing users expected download size and determining
+         *     whether to use streaming progress for large exports.
+         */
+        get: operations["getExportEstimate"];
+        put?: never;
         post?: never;
-        /** Delete a submitter */
-        delete: operations["deleteSubmitter"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/repositories": {
+    "/quality/overview": {
         parameters: {
             query?: never;
             header?: never;
@@ -1648,42 +1814,41 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * List all repositories
-         * @description Returns paginated list of GEDCOM REPO (repository) records
+         * Get aggregate quality metrics
+         * @description Returns overall data quality metrics across all persons
          */
-        get: operations["listRepositories"];
+        get: operations["getQualityOverview"];
         put?: never;
-        /** Create a new repository */
-        post: operations["createRepository"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/repositories/{id}": {
+    "/quality/persons/{id}": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                /** @description Repository UUID */
-                id: components["parameters"]["repositoryId"];
+                id: components["parameters"]["personId"];
             };
             cookie?: never;
         };
-        /** Get a repository by ID */
-        get: operations["getRepository"];
-        /** Update a repository */
-        put: operations["updateRepository"];
+        /**
+         * Get quality score for a person
+         * @description Returns data completeness score and issues for a specific person
+         */
+        get: operations["getPersonQuality"];
+        put?: never;
         post?: never;
-        /** Delete a repository */
-        delete: operations["deleteRepository"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/associations": {
+    "/quality/by-surname": {
         parameters: {
             query?: never;
             header?: never;
@@ -1691,58 +1856,58 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * List all associations
-         * @description Returns paginated list of GEDCOM ASSO (association) records
+         * Get quality by surname
+         * @description Returns completeness and issue counts for each surname in the surname
+         *     index, so work can be prioritized one surname line at a time. Persons
+         *     match a surname case-insensitively. Results are ordered by lowest
+         *     average completeness, then surname.
          */
-        get: operations["listAssociations"];
+        get: operations["getQualityBySurname"];
         put?: never;
-        /**
-         * Create a new association
-         * @description Create a GEDCOM ASSO association between two persons
-         */
-        post: operations["createAssociation"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/associations/{id}": {
+    "/quality/report": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                /** @description Association UUID */
-                id: components["parameters"]["associationId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        /** Get an association by ID */
-        get: operations["getAssociation"];
-        /** Update an association */
-        put: operations["updateAssociation"];
+        /**
+         * Get full quality report
+         * @description Returns comprehensive data quality metrics including completeness,
+         *     coverage, and aggregated issues. The csv and pdf formats render the
+         *     same report as a downloadable document for sharing or printing.
+         */
+        get: operations["getQualityReport"];
+        put?: never;
         post?: never;
-        /** Delete an association */
-        delete: operations["deleteAssociation"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/{id}/associations": {
+    "/quality/validation": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: components["parameters"]["personId"];
-            };
+            path?: never;
             cookie?: never;
         };
         /**
-         * List associations for a person
-         * @description Returns all associations where the person is either the subject or associate
+         * Get validation issues
+         * @description Returns date logic and reference validation issues categorized by
+         *     severity. Supports pagination so a large tree does not produce an
+         *     unbounded response. The error/warning/info counts always reflect the
+         *     full unfiltered, unpaginated issue set.
          */
-        get: operations["listAssociationsForPerson"];
+        get: operations["getValidationIssues"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1751,7 +1916,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/lds-ordinances": {
+    "/persons/orphans": {
         parameters: {
             query?: never;
             header?: never;
@@ -1759,151 +1924,170 @@ export interface paths {
             cookie?: never;
         };
         /**
-         * List all LDS ordinances
-         * @description Returns paginated list of LDS temple ordinance records (BAPL, CONL, ENDL, SLGC, SLGS)
+         * List persons with no family links
+         * @description Returns persons who are neither a partner nor a child in any family, sorted by surname.
+         *     These are often import artifacts or stubs that still need to be connected to the tree.
          */
-        get: operations["listLDSOrdinances"];
+        get: operations["listOrphanedPersons"];
         put?: never;
-        /** Create a new LDS ordinance */
-        post: operations["createLDSOrdinance"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/lds-ordinances/{id}": {
+    "/persons/duplicates": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                /** @description LDS Ordinance UUID */
-                id: components["parameters"]["ldsOrdinanceId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        /** Get an LDS ordinance by ID */
-        get: operations["getLDSOrdinance"];
-        /** Update an LDS ordinance */
-        put: operations["updateLDSOrdinance"];
+        /**
+         * Find potential duplicate persons
+         * @description Returns pairs of persons that may be duplicates based on name, date, and place matching
+         */
+        get: operations["getPersonsDuplicates"];
+        put?: never;
         post?: never;
-        /** Delete an LDS ordinance */
-        delete: operations["deleteLDSOrdinance"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/persons/{id}/lds-ordinances": {
+    "/persons/merge": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: components["parameters"]["personId"];
-            };
+            path?: never;
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * List LDS ordinances for a person
-         * @description Returns all individual LDS ordinances (BAPL, CONL, ENDL, SLGC) for a person
+         * Merge two person records
+         * @description Merges two person records, consolidating data from the merged person into the survivor.
+         *     The merged person is deleted after the merge. All relationships (families, citations,
+         *     alternate names, events, media) are transferred to the survivor.
          */
-        get: operations["listLDSOrdinancesForPerson"];
-        put?: never;
-        post?: never;
+        post: operations["mergePersons"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/families/{id}/lds-ordinances": {
+    "/persons/duplicates/{person1Id}/{person2Id}/dismiss": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                id: components["parameters"]["familyId"];
+                /** @description First person ID in the duplicate pair */
+                person1Id: string;
+                /** @description Second person ID in the duplicate pair */
+                person2Id: string;
             };
             cookie?: never;
         };
+        get?: never;
+        put?: never;
         /**
-         * List LDS ordinances for a family
-         * @description Returns all spouse sealing (SLGS) ordinances for a family
+         * Dismiss a duplicate pair as false positive
+         * @description Marks a duplicate pair as a false positive. The pair will not be returned
+         *     in future duplicate detection results.
          */
-        get: operations["listLDSOrdinancesForFamily"];
-        put?: never;
-        post?: never;
+        post: operations["dismissDuplicate"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/evidence-analyses": {
+    "/persons/bulk-delete": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** List all evidence analyses */
-        get: operations["listEvidenceAnalyses"];
+        get?: never;
         put?: never;
-        /** Create a new evidence analysis */
-        post: operations["createEvidenceAnalysis"];
+        /**
+         * Delete multiple persons
+         * @description Deletes each listed person if its current version matches the one
+         *     given. Each deletion is processed independently; a version mismatch,
+         *     missing person, or family link fails only that item and is reported
+         *     in the response.
+         */
+        post: operations["bulkDeletePersons"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/evidence-analyses/{id}": {
+    "/persons/merge/batch": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                /** @description Evidence Analysis UUID */
-                id: components["parameters"]["evidenceAnalysisId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        /** Get an evidence analysis by ID */
-        get: operations["getEvidenceAnalysis"];
-        /** Update an evidence analysis */
-        put: operations["updateEvidenceAnalysis"];
-        post?: never;
-        /** Delete an evidence analysis */
-        delete: operations["deleteEvidenceAnalysis"];
+        get?: never;
+        put?: never;
+        /**
+         * Batch merge multiple duplicate pairs
+         * @description Merges multiple duplicate pairs in a single batch operation.
+         *     Each pair is processed independently; partial failures are reported
+         *     in the response. Successfully merged pairs continue processing even
+         *     if earlier pairs fail.
+         */
+        post: operations["batchMergePersons"];
+        delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/evidence-analyses/by-fact": {
+    "/persons/duplicates/dismiss/batch": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** Get evidence analyses by fact type and subject */
-        get: operations["getAnalysesByFact"];
+        get?: never;
         put?: never;
-        post?: never;
+        /**
+         * Batch dismiss multiple duplicate pairs
+         * @description Dismisses multiple duplicate pairs as false positives in a single operation.
+         *     Each pair is processed independently; partial failures are reported in the response.
+         */
+        post: operations["batchDismissDuplicates"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/evidence-conflicts": {
+    "/reports/missing-data": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** List all evidence conflicts */
-        get: operations["listEvidenceConflicts"];
+        /**
+         * List persons with research gaps
+         * @description Lists persons missing a birth date, a death date (when born over 100
+         *     years ago), parents, or any citation. Each person gets a priority score
+         *     from the gaps found (birth date and parents 3 each, sources and death
+         *     date 2 each); results are ordered by priority, then lowest
+         *     completeness. Persons without gaps are omitted.
+         */
+        get: operations["getMissingDataReport"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1912,18 +2096,18 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/evidence-conflicts/{id}": {
+    "/statistics": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                /** @description Evidence Conflict UUID */
-                id: components["parameters"]["evidenceConflictId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        /** Get an evidence conflict by ID */
-        get: operations["getEvidenceConflict"];
+        /**
+         * Get tree-wide statistics
+         * @description Returns aggregate statistics about the family tree
+         */
+        get: operations["getStatistics"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1932,37 +2116,40 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/evidence-conflicts/{id}/resolve": {
+    "/statistics/marriage-age": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                /** @description Evidence Conflict UUID */
-                id: components["parameters"]["evidenceConflictId"];
-            };
+            path?: never;
             cookie?: never;
         };
-        get?: never;
+        /**
+         * Get age at first marriage statistics
+         * @description Returns average and median age at first marriage, overall and by gender. Each partner's
+         *     earliest dated marriage is compared with their birth date; partners without both dates
+         *     are skipped.
+         */
+        get: operations["getMarriageAgeStatistics"];
         put?: never;
-        /** Resolve an evidence conflict */
-        post: operations["resolveEvidenceConflict"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/evidence-conflicts/by-subject/{subjectId}": {
+    "/analytics/discovery": {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                subjectId: string;
-            };
+            path?: never;
             cookie?: never;
         };
-        /** Get evidence conflicts for a subject */
-        get: operations["getConflictsBySubject"];
+        /**
+         * Get discovery feed suggestions
+         * @description Returns prioritized research suggestions based on data quality analysis
+         */
+        get: operations["getDiscoveryFeed"];
         put?: never;
         post?: never;
         delete?: never;
@@ -1971,57 +2158,71 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/research-logs": {
+    "/snapshots": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** List all research log entries */
-        get: operations["listResearchLogs"];
+        /**
+         * List all snapshots
+         * @description Returns all research milestone snapshots ordered by creation date (newest first)
+         */
+        get: operations["listSnapshots"];
         put?: never;
-        /** Create a new research log entry */
-        post: operations["createResearchLog"];
+        /**
+         * Create a new snapshot
+         * @description Creates a snapshot capturing the current position in the event store
+         */
+        post: operations["createSnapshot"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/research-logs/{id}": {
+    "/snapshots/{id}": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                /** @description Research Log UUID */
-                id: components["parameters"]["researchLogId"];
+                /** @description Snapshot UUID */
+                id: components["parameters"]["snapshotId"];
             };
             cookie?: never;
         };
-        /** Get a research log entry by ID */
-        get: operations["getResearchLog"];
-        /** Update a research log entry */
-        put: operations["updateResearchLog"];
+        /** Get a snapshot by ID */
+        get: operations["getSnapshot"];
+        put?: never;
         post?: never;
-        /** Delete a research log entry */
-        delete: operations["deleteResearchLog"];
+        /**
+         * Delete a snapshot
+         * @description Removes the snapshot record (events in the store remain untouched)
+         */
+        delete: operations["deleteSnapshot"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/research-logs/by-subject/{subjectId}": {
+    "/snapshots/{id1}/compare/{id2}": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                subjectId: string;
+                /** @description First snapshot ID */
+                id1: string;
+                /** @description Second snapshot ID */
+                id2: string;
             };
             cookie?: never;
         };
-        /** Get research log entries for a subject */
-        get: operations["getResearchLogsBySubject"];
+        /**
+         * Compare two snapshots
+         * @description Returns the list of changes (events) between two snapshot positions
+         */
+        get: operations["compareSnapshots"];
         put?: never;
         post?: never;
         delete?: never;
@@ -2030,101 +2231,663 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/proof-summaries": {
+    "/persons/{id}/kin": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        /**
+         * List a person's blood relatives with their relationship
+         * @description Walks the family graph breadth-first from the person and returns every
+         *     blood relative within maxDegree parent-child links, labelled as the
+         *     relationship calculator would name them (e.g. "grandparent",
+         *     "1st cousin once removed"). Spouses and in-laws are not included.
+         *     Results are ordered by degree, then name.
+         */
+        get: operations["getPersonKin"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/relationship/{personId1}/{personId2}": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** List all proof summaries */
-        get: operations["listProofSummaries"];
+        /** Calculate relationship between two people */
+        get: operations["getRelationship"];
         put?: never;
-        /** Create a new proof summary */
-        post: operations["createProofSummary"];
+        post?: never;
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/proof-summaries/{id}": {
+    "/notes": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * List all notes
+         * @description Returns paginated list of shared GEDCOM NOTE records. When entityId is given, only notes attached to that person, family, or source are returned.
+         */
+        get: operations["listNotes"];
+        put?: never;
+        /** Create a new note */
+        post: operations["createNote"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/notes/{id}": {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                /** @description Proof Summary UUID */
-                id: components["parameters"]["proofSummaryId"];
+                /** @description Note UUID */
+                id: components["parameters"]["noteId"];
             };
             cookie?: never;
         };
-        /** Get a proof summary by ID */
-        get: operations["getProofSummary"];
-        /** Update a proof summary */
-        put: operations["updateProofSummary"];
+        /** Get a note by ID */
+        get: operations["getNote"];
+        /** Update a note */
+        put: operations["updateNote"];
         post?: never;
-        /** Delete a proof summary */
-        delete: operations["deleteProofSummary"];
+        /** Delete a note */
+        delete: operations["deleteNote"];
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-    "/proof-summaries/by-fact": {
+    "/submitters": {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        /** Get proof summaries by fact type and subject */
-        get: operations["getProofSummaryByFact"];
+        /**
+         * List all submitters
+         * @description Returns paginated list of GEDCOM SUBM (submitter) records
+         */
+        get: operations["listSubmitters"];
         put?: never;
-        post?: never;
+        /** Create a new submitter */
+        post: operations["createSubmitter"];
         delete?: never;
         options?: never;
         head?: never;
         patch?: never;
         trace?: never;
     };
-}
-export type webhooks = Record<string, never>;
-export interface components {
-    schemas: {
-        Error: {
-            /**
-             * @description Error code
-             * @example NOT_FOUND
-             */
-            code: string;
-            /**
-             * @description Human-readable error message
-             * @example Person not found
-             */
-            message: string;
-            details?: {
-                [key: string]: unknown;
+    "/submitters/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description Submitter UUID */
+                id: components["parameters"]["submitterId"];
             };
+            cookie?: never;
         };
-        DataLossItem: {
-            /**
-             * @description Name of the feature that cannot be represented in the target version.
-             * @example EXID tags
-             */
-            feature: string;
-            /**
-             * @description Why the feature would be lost.
-             * @example Tag not supported in GEDCOM 5.5.1
-             */
-            reason: string;
-            /** @description Ephemeral GEDCOM XREFs (e.g. "@I1@") of the records affected by this loss, assigned during export from record order. They are for display and debugging only and do NOT resolve back to entity IDs in this API. */
-            affectedRecords?: string[];
+        /** Get a submitter by ID */
+        get: operations["getSubmitter"];
+        /** Update a submitter */
+        put: operations["updateSubmitter"];
+        post?: never;
+        /** Delete a submitter */
+        delete: operations["deleteSubmitter"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/repositories": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        ExportPreview: {
-            /**
-             * @description The GEDCOM version the data naturally requires before any downgrade.
-             * @example 7.0
-             */
+        /**
+         * List all repositories
+         * @description Returns paginated list of GEDCOM REPO (repository) records
+         */
+        get: operations["listRepositories"];
+        put?: never;
+        /** Create a new repository */
+        post: operations["createRepository"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/repositories/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description Repository UUID */
+                id: components["parameters"]["repositoryId"];
+            };
+            cookie?: never;
+        };
+        /** Get a repository by ID */
+        get: operations["getRepository"];
+        /** Update a repository */
+        put: operations["updateRepository"];
+        post?: never;
+        /** Delete a repository */
+        delete: operations["deleteRepository"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/associations": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * List all associations
+         * @description Returns paginated list of GEDCOM ASSO (association) records
+         */
+        get: operations["listAssociations"];
+        put?: never;
+        /**
+         * Create a new association
+         * @description Create a GEDCOM ASSO association between two persons
+         */
+        post: operations["createAssociation"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/associations/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description Association UUID */
+                id: components["parameters"]["associationId"];
+            };
+            cookie?: never;
+        };
+        /** Get an association by ID */
+        get: operations["getAssociation"];
+        /** Update an association */
+        put: operations["updateAssociation"];
+        post?: never;
+        /** Delete an association */
+        delete: operations["deleteAssociation"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/persons/{id}/associations": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        /**
+         * List associations for a person
+         * @description Returns all associations where the person is either the subject or associate
+         */
+        get: operations["listAssociationsForPerson"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/lds-ordinances": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * List all LDS ordinances
+         * @description Returns paginated list of LDS temple ordinance records (BAPL, CONL, ENDL, SLGC, SLGS)
+         */
+        get: operations["listLDSOrdinances"];
+        put?: never;
+        /** Create a new LDS ordinance */
+        post: operations["createLDSOrdinance"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/lds-ordinances/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description LDS Ordinance UUID */
+                id: components["parameters"]["ldsOrdinanceId"];
+            };
+            cookie?: never;
+        };
+        /** Get an LDS ordinance by ID */
+        get: operations["getLDSOrdinance"];
+        /** Update an LDS ordinance */
+        put: operations["updateLDSOrdinance"];
+        post?: never;
+        /** Delete an LDS ordinance */
+        delete: operations["deleteLDSOrdinance"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/persons/{id}/lds-ordinances": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        /**
+         * List LDS ordinances for a person
+         * @description Returns all individual LDS ordinances (BAPL, CONL, ENDL, SLGC) for a person
+         */
+        get: operations["listLDSOrdinancesForPerson"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/families/{id}/lds-ordinances": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["familyId"];
+            };
+            cookie?: never;
+        };
+        /**
+         * List LDS ordinances for a family
+         * @description Returns all spouse sealing (SLGS) ordinances for a family
+         */
+        get: operations["listLDSOrdinancesForFamily"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/evidence-analyses": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all evidence analyses */
+        get: operations["listEvidenceAnalyses"];
+        put?: never;
+        /** Create a new evidence analysis */
+        post: operations["createEvidenceAnalysis"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/evidence-analyses/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description Evidence Analysis UUID */
+                id: components["parameters"]["evidenceAnalysisId"];
+            };
+            cookie?: never;
+        };
+        /** Get an evidence analysis by ID */
+        get: operations["getEvidenceAnalysis"];
+        /** Update an evidence analysis */
+        put: operations["updateEvidenceAnalysis"];
+        post?: never;
+        /** Delete an evidence analysis */
+        delete: operations["deleteEvidenceAnalysis"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/evidence-analyses/by-fact": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get evidence analyses by fact type and subject */
+        get: operations["getAnalysesByFact"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/evidence-conflicts": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all evidence conflicts */
+        get: operations["listEvidenceConflicts"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/evidence-conflicts/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description Evidence Conflict UUID */
+                id: components["parameters"]["evidenceConflictId"];
+            };
+            cookie?: never;
+        };
+        /** Get an evidence conflict by ID */
+        get: operations["getEvidenceConflict"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/evidence-conflicts/{id}/resolve": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description Evidence Conflict UUID */
+                id: components["parameters"]["evidenceConflictId"];
+            };
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Resolve an evidence conflict */
+        post: operations["resolveEvidenceConflict"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/evidence-conflicts/by-subject/{subjectId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                subjectId: string;
+            };
+            cookie?: never;
+        };
+        /** Get evidence conflicts for a subject */
+        get: operations["getConflictsBySubject"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/research-logs": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all research log entries */
+        get: operations["listResearchLogs"];
+        put?: never;
+        /** Create a new research log entry */
+        post: operations["createResearchLog"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/research-logs/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description Research Log UUID */
+                id: components["parameters"]["researchLogId"];
+            };
+            cookie?: never;
+        };
+        /** Get a research log entry by ID */
+        get: operations["getResearchLog"];
+        /** Update a research log entry */
+        put: operations["updateResearchLog"];
+        post?: never;
+        /** Delete a research log entry */
+        delete: operations["deleteResearchLog"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/research-logs/by-subject/{subjectId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                subjectId: string;
+            };
+            cookie?: never;
+        };
+        /** Get research log entries for a subject */
+        get: operations["getResearchLogsBySubject"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/proof-summaries": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all proof summaries */
+        get: operations["listProofSummaries"];
+        put?: never;
+        /** Create a new proof summary */
+        post: operations["createProofSummary"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/proof-summaries/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description Proof Summary UUID */
+                id: components["parameters"]["proofSummaryId"];
+            };
+            cookie?: never;
+        };
+        /** Get a proof summary by ID */
+        get: operations["getProofSummary"];
+        /** Update a proof summary */
+        put: operations["updateProofSummary"];
+        post?: never;
+        /** Delete a proof summary */
+        delete: operations["deleteProofSummary"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/proof-summaries/by-fact": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get proof summaries by fact type and subject */
+        get: operations["getProofSummaryByFact"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/admin/verify-projections": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Compare read models against the event log
+         * @description Rebuilds persons, families, sources, and citations from the event log and
+         *     compares them with the read model, reporting entities that are missing,
+         *     unexpectedly present, or have differing fields. Only event-sourced fields
+         *     and versions are compared. The whole event log is replayed even when
+         *     sampling, so this can be slow on large trees.
+         */
+        get: operations["verifyProjections"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+}
+export type webhooks = Record<string, never>;
+export interface components {
+    schemas: {
+        Error: {
+            /**
+             * @description Error code
+             * @example NOT_FOUND
+             */
+            code: string;
+            /**
+             * @description Human-readable error message
+             * @example Person not found
+             */
+            message: string;
+            details?: {
+                [key: string]: unknown;
+            };
+            /** @description Per-field validation failures, present when the request failed validation */
+            fields?: components["schemas"]["FieldError"][];
+            /**
+             * @description Identifier of the request, also sent in the X-Request-ID response header. Quote it when reporting a problem so the matching server log lines can be found.
+             * @example 3f2b8c1e-6d4a-4f0e-9a7b-2c5d8e1f0a93
+             */
+            request_id?: string;
+        };
+        FieldError: {
+            /**
+             * @description Name of the offending request field
+             * @example gender
+             */
+            field: string;
+            /**
+             * @description Why the value is invalid
+             * @example invalid value: robot
+             */
+            message: string;
+        };
+        DataLossItem: {
+            /**
+             * @description Name of the feature that cannot be represented in the target version.
+             * @example EXID tags
+             */
+            feature: string;
+            /**
+             * @description Why the feature would be lost.
+             * @example Tag not supported in GEDCOM 5.5.1
+             */
+            reason: string;
+            /** @description Ephemeral GEDCOM XREFs (e.g. "@I1@") of the records affected by this loss, assigned during export from record order. They are for display and debugging only and do NOT resolve back to entity IDs in this API. */
+            affectedRecords?: string[];
+        };
+        ExportPreview: {
+            /**
+             * @description The GEDCOM version the data naturally requires before any downgrade.
+             * @example 7.0
+             */
             sourceVersion: string;
             /**
              * @description The GEDCOM version that would be emitted.
@@ -2191,6 +2954,35 @@ export interface components {
          * @enum {string}
          */
         ResearchStatus: "certain" | "probable" | "possible" | "unknown";
+        ResearchStatusUpdate: {
+            research_status: components["schemas"]["ResearchStatus"];
+            /**
+             * Format: int64
+             * @description Current version for optimistic locking
+             */
+            version: number;
+        };
+        ResearchStatusUpdateResult: {
+            /** Format: uuid */
+            id: string;
+            research_status: components["schemas"]["ResearchStatus"];
+            /** Format: int64 */
+            version: number;
+        };
+        /** @description Records by research status. Records never assessed count as unknown. */
+        ResearchStatusCounts: {
+            certain: number;
+            probable: number;
+            possible: number;
+            unknown: number;
+        };
+        /** @description Research status breakdown for each kind of record */
+        ResearchStatusSummary: {
+            persons: components["schemas"]["ResearchStatusCounts"];
+            families: components["schemas"]["ResearchStatusCounts"];
+            events: components["schemas"]["ResearchStatusCounts"];
+            attributes: components["schemas"]["ResearchStatusCounts"];
+        };
         /** @description Genealogical date with flexible precision */
         GenDate: {
             /**
@@ -2202,7 +2994,7 @@ export interface components {
              * @example abt
              * @enum {string}
              */
-            qualifier?: "exact" | "abt" | "cal" | "est" | "bef" | "aft" | "bet" | "from" | "int";
+            qualifier?: "exact" | "abt" | "cal" | "est" | "bef" | "aft" | "bet" | "from" | "to" | "int";
             /**
              * @description Calendar system for the date, using the GEDCOM escape token (DGREGORIAN, DJULIAN, DHEBREW, or "DFRENCH R"). Absent or DGREGORIAN means the Gregorian calendar.
              * @example DJULIAN
@@ -2216,6 +3008,21 @@ export interface components {
             year2?: number;
             month2?: number;
             day2?: number;
+            /**
+             * @description New Style year of a dual-dated year such as "1719/20" (1720); year holds the Old Style year
+             * @example 1720
+             */
+            dual_year?: number;
+            /** @description New Style year of a dual-dated range end */
+            dual_year2?: number;
+            /**
+             * Format: date
+             * @description Gregorian date used to order this date against others. Ranges (BET ... AND ...) resolve to the midpoint of their bounds; other dates resolve to the date they name, or the start of a FROM period.
+             * @example 1845-01-01
+             */
+            sort_date?: string;
+            /** @description True when the qualifier makes the date imprecise (abt, cal, est, bef, aft, bet, int) */
+            uncertain?: boolean;
             /**
              * @description Original ambiguous phrase for interpreted (INT) dates, preserved for research transparency (e.g. "about eighteen fifty")
              * @example about eighteen fifty
@@ -2228,7 +3035,7 @@ export interface components {
             given_name: string;
             surname: string;
             /** @enum {string} */
-            gender?: "male" | "female" | "unknown";
+            gender?: "male" | "female" | "nonbinary" | "unknown";
             birth_date?: components["schemas"]["GenDate"];
             birth_place?: string;
             /** @description Latitude in GEDCOM format (e.g., "N42.3601") */
@@ -2248,17 +3055,34 @@ export interface components {
             brick_wall_since?: string | null;
             /** Format: date-time */
             brick_wall_resolved_at?: string | null;
+            /**
+             * Format: date-time
+             * @description When the person was first recorded. Omitted for records projected before creation times were tracked.
+             */
+            readonly created_at?: string;
+            /**
+             * Format: date-time
+             * @description When the person was last changed
+             */
+            readonly updated_at?: string;
             /**
              * Format: int64
              * @description Optimistic locking version
              */
             version: number;
         };
+        /** @description Either given_name or full_name is required */
         PersonCreate: {
-            given_name: string;
+            /**
+             * @description Full name to split into parts, e.g. "Dr. John A. Smith Jr.", "Jan van der Berg"
+             *     or "Smith, John". Explicit given_name and surname take precedence over the parsed parts.
+             * @example Mary Elizabeth O'Brien
+             */
+            full_name?: string;
+            given_name?: string;
             surname?: string;
             /** @enum {string} */
-            gender?: "male" | "female" | "unknown";
+            gender?: "male" | "female" | "nonbinary" | "unknown";
             /**
              * @description GEDCOM-format date string
              * @example 1 JAN 1850
@@ -2275,7 +3099,7 @@ export interface components {
             given_name?: string;
             surname?: string;
             /** @enum {string} */
-            gender?: "male" | "female" | "unknown";
+            gender?: "male" | "female" | "nonbinary" | "unknown";
             birth_date?: string;
             birth_place?: string;
             death_date?: string;
@@ -2284,9 +3108,9 @@ export interface components {
             research_status?: components["schemas"]["ResearchStatus"];
             /**
              * Format: int64
-             * @description Current version for optimistic locking
+             * @description Current version for optimistic locking. Required unless the If-Match header carries the version instead.
              */
-            version: number;
+            version?: number;
         };
         PersonDetail: components["schemas"]["Person"] & {
             /** @description All name variants for the person (birth, married, aliases, etc.) */
@@ -2295,6 +3119,11 @@ export interface components {
             family_as_child?: components["schemas"]["FamilySummary"];
             /** @description GEDCOM 7.0 external identifiers (EXID) with resolved display label and link. Read-only: populated from GEDCOM import; there is no direct-write endpoint. */
             external_ids?: components["schemas"]["ExternalLink"][];
+            /**
+             * @description What this person is to the configured home person (HOME_PERSON), as named by the relationship calculator. Omitted when no home person is configured or the two are not related.
+             * @example 3rd great-grandparent
+             */
+            relationship?: string;
         };
         /** @description A GEDCOM 7.0 external identifier (EXID) with a resolved display label and, for recognized systems, a browsable URL. */
         ExternalLink: {
@@ -2319,6 +3148,18 @@ export interface components {
              */
             url?: string;
         };
+        ExternalIdCreate: {
+            /**
+             * @description The identifier within the external system.
+             * @example KWCJ-QN7
+             */
+            value: string;
+            /**
+             * @description URI naming the external system, e.g. http://www.familysearch.org/ark, https://www.wikitree.com/, or https://www.ancestry.com/.
+             * @example http://www.familysearch.org/ark
+             */
+            type?: string;
+        };
         PersonSummary: {
             /** Format: uuid */
             id: string;
@@ -2348,6 +3189,17 @@ export interface components {
             marriage_place_latitude?: string | null;
             /** @description Longitude in GEDCOM format (e.g., "W89.6501") */
             marriage_place_longitude?: string | null;
+            research_status?: components["schemas"]["ResearchStatus"];
+            /**
+             * Format: date-time
+             * @description When the family was first recorded. Omitted for records projected before creation times were tracked.
+             */
+            readonly created_at?: string;
+            /**
+             * Format: date-time
+             * @description When the family was last changed
+             */
+            readonly updated_at?: string;
             /** Format: int64 */
             version: number;
         };
@@ -2360,6 +3212,7 @@ export interface components {
             relationship_type?: "marriage" | "partnership" | "unknown";
             marriage_date?: string;
             marriage_place?: string;
+            research_status?: components["schemas"]["ResearchStatus"];
         };
         FamilyUpdate: {
             /** Format: uuid */
@@ -2370,8 +3223,12 @@ export interface components {
             relationship_type?: "marriage" | "partnership" | "unknown";
             marriage_date?: string;
             marriage_place?: string;
-            /** Format: int64 */
-            version: number;
+            research_status?: components["schemas"]["ResearchStatus"];
+            /**
+             * Format: int64
+             * @description Current version for optimistic locking. Required unless the If-Match header carries the version instead.
+             */
+            version?: number;
         };
         FamilyDetail: components["schemas"]["Family"] & {
             /** @description Partner 1 summary. Present whenever partner1_id is set; given_name and surname may be empty strings if the partner has no recorded name. */
@@ -2389,6 +3246,11 @@ export interface components {
             partner2_name?: string;
             relationship_type?: string;
         };
+        FamilySearchResults: {
+            families: components["schemas"]["FamilySummary"][];
+            total: number;
+            query: string;
+        };
         FamilyChild: {
             /** Format: uuid */
             person_id: string;
@@ -2432,7 +3294,7 @@ export interface components {
             given_name: string;
             surname: string;
             /** @enum {string} */
-            gender?: "male" | "female" | "unknown";
+            gender?: "male" | "female" | "nonbinary" | "unknown";
             birth?: components["schemas"]["GroupSheetEvent"];
             death?: components["schemas"]["GroupSheetEvent"];
             /** @description Name of father (if known) */
@@ -2463,7 +3325,7 @@ export interface components {
             given_name: string;
             surname: string;
             /** @enum {string} */
-            gender?: "male" | "female" | "unknown";
+            gender?: "male" | "female" | "nonbinary" | "unknown";
             /** @enum {string} */
             relationship_type?: "biological" | "adopted" | "foster";
             /** @description Birth order */
@@ -2505,9 +3367,17 @@ export interface components {
             gender?: string;
             /** @description Generation level (0 = subject, 1 = parents, 2 = grandparents, etc.) */
             generation?: number;
+            sources?: components["schemas"]["FactSourceCounts"];
             father?: components["schemas"]["PedigreeNode"];
             mother?: components["schemas"]["PedigreeNode"];
         };
+        /** @description Citation counts for a person's vital facts; zero marks an unsourced fact */
+        FactSourceCounts: {
+            /** @description Citations supporting the birth */
+            birth: number;
+            /** @description Citations supporting the death */
+            death: number;
+        };
         /** @description Descendancy tree showing descendants of a person */
         Descendancy: {
             root: components["schemas"]["DescendancyNode"];
@@ -2517,6 +3387,8 @@ export interface components {
             total_descendants?: number;
             /** @description Maximum generation depth reached */
             max_generation?: number;
+            /** @description Number of descendants at each generation; the first element counts children (generation 1) */
+            generation_counts?: number[];
         };
         /** @description A person node in the descendancy tree */
         DescendancyNode: {
@@ -2529,6 +3401,13 @@ export interface components {
             gender?: string;
             /** @description Generation level (0 = root, 1 = children, 2 = grandchildren, etc.) */
             generation?: number;
+            /**
+             * Format: uuid
+             * @description Family of the parent node this person descends through. Matches the
+             *     family_id of one of the parent's spouses, so children can be grouped
+             *     per spouse. Omitted for the root.
+             */
+            family_id?: string;
             spouses?: components["schemas"]["SpouseInfo"][];
             children?: components["schemas"]["DescendancyNode"][];
         };
@@ -2537,6 +3416,11 @@ export interface components {
             /** Format: uuid */
             id: string;
             name: string;
+            /**
+             * Format: uuid
+             * @description Family shared with this spouse
+             */
+            family_id: string;
             marriage_date?: components["schemas"]["GenDate"];
         };
         /**
@@ -2595,7 +3479,7 @@ export interface components {
              * @description Gender (omitted if ancestor is unknown)
              * @enum {string}
              */
-            gender?: "male" | "female" | "unknown";
+            gender?: "male" | "female" | "nonbinary" | "unknown";
             birth_date?: components["schemas"]["GenDate"];
             birth_place?: string;
             death_date?: components["schemas"]["GenDate"];
@@ -2605,6 +3489,47 @@ export interface components {
              * @example Father's Father
              */
             relationship: string;
+            sources?: components["schemas"]["FactSourceCounts"];
+        };
+        AdvancedSearchRequest: {
+            /** @description Must have at least SEARCH_MIN_QUERY_LENGTH characters (default 2) when given */
+            given_name?: string;
+            /** @description Must have at least SEARCH_MIN_QUERY_LENGTH characters (default 2) when given */
+            surname?: string;
+            /** @description Born in or after this year */
+            birth_year_from?: number;
+            /** @description Born in or before this year */
+            birth_year_to?: number;
+            /** @description Died in or after this year */
+            death_year_from?: number;
+            /** @description Died in or before this year */
+            death_year_to?: number;
+            birth_place?: string;
+            /** @enum {string} */
+            gender?: "male" | "female" | "nonbinary" | "unknown";
+            /**
+             * @default name
+             * @enum {string}
+             */
+            sort: "name" | "birth_date" | "death_date";
+            /**
+             * @default asc
+             * @enum {string}
+             */
+            order: "asc" | "desc";
+            /**
+             * @description Maximum results to return (default 20), capped at SEARCH_MAX_LIMIT (default 100)
+             * @default 20
+             */
+            limit: number;
+        };
+        /** @description Ancestors in a person's pedigree with no recorded parents */
+        EndOfLinesResponse: {
+            subject: components["schemas"]["AhnentafelSubject"];
+            /** @description End-of-line ancestors in Ahnentafel number order */
+            entries: components["schemas"]["AhnentafelEntry"][];
+            /** @description Number of end-of-line ancestors */
+            total: number;
         };
         SearchResults: {
             items: components["schemas"]["SearchResult"][];
@@ -2617,13 +3542,79 @@ export interface components {
              * @description Relevance score (0-1)
              */
             score?: number;
+            birth_place?: string;
+            death_place?: string;
+            /**
+             * @description Algorithm that matched the result. `exact` when the primary name
+             *     contains the query, otherwise the requested algorithm.
+             * @enum {string}
+             */
+            matched_by?: "exact" | "fuzzy" | "soundex" | "metaphone";
+            /**
+             * @description True when the primary name is a patronymic, so the surname is
+             *     derived from a parent rather than a family name.
+             */
+            patronymic?: boolean;
+            /** @description Where the search criteria matched, for highlighting */
+            matches?: components["schemas"]["SearchMatch"][];
+        };
+        /**
+         * @description A matched span within a field of a search result. `start` and `end`
+         *     are character (Unicode code point) offsets into `value`, with `end`
+         *     exclusive. Date range matches span the whole value.
+         */
+        SearchMatch: {
+            /** @enum {string} */
+            field: "given_name" | "surname" | "alternate_name" | "birth_place" | "death_place" | "birth_date" | "death_date";
+            /** @description The field's text; the full name for alternate names */
+            value: string;
+            start: number;
+            end: number;
         };
         ImportResult: {
             success: boolean;
             persons_imported: number;
             families_imported: number;
+            /**
+             * Format: int64
+             * @description Size of the imported GEDCOM file in bytes
+             */
+            file_size?: number;
+            /** @description Media records created from files in the uploaded media archive */
+            media_imported?: number;
             warnings?: components["schemas"]["ImportWarning"][];
             errors?: components["schemas"]["ImportError"][];
+            /**
+             * @description Warnings and parse errors as machine-readable issues, grouped by code.
+             *     Groups containing errors come first, then larger groups.
+             */
+            issue_groups?: components["schemas"]["ImportIssueGroup"][];
+        };
+        ImportIssueGroup: {
+            /** @description Issue code shared by the group, e.g. BROKEN_XREF, INVALID_DATE, UNKNOWN_TAG */
+            code: string;
+            /**
+             * @description Most severe of the group's issues
+             * @enum {string}
+             */
+            severity: "error" | "warning";
+            count: number;
+            issues: components["schemas"]["ImportIssue"][];
+        };
+        /**
+         * @description A problem found while importing. Warnings are recoverable: the data was
+         *     imported as-is or with a substitute (e.g. an unknown tag). Errors are data
+         *     problems to fix, such as an unparseable date or a reference to a missing record.
+         */
+        ImportIssue: {
+            /** @enum {string} */
+            severity: "error" | "warning";
+            code: string;
+            /** @description GEDCOM line number, 0 when unknown */
+            line: number;
+            /** @description Record @XREF@ or raw GEDCOM content the issue concerns */
+            context?: string;
+            message: string;
         };
         ImportWarning: {
             line: number;
@@ -2649,11 +3640,13 @@ export interface components {
             entity_name?: string;
             /** @enum {string} */
             action: "created" | "updated" | "deleted";
+            /** @description Human-readable description of the change (e.g., "updated birth_place, notes") */
+            summary?: string;
             /** @description Field-level changes for updates */
             changes?: {
                 [key: string]: components["schemas"]["FieldChange"];
             };
-            /** @description ID of user who made the change (null if single-user) */
+            /** @description Who made the change, from the X-Actor header (omitted if unattributed) */
             user_id?: string;
         };
         FieldChange: {
@@ -2669,6 +3662,9 @@ export interface components {
             offset?: number;
             has_more?: boolean;
         };
+        RecentChangesResponse: {
+            items: components["schemas"]["ChangeEntry"][];
+        };
         RestorePoint: {
             /**
              * Format: int64
@@ -2693,6 +3689,8 @@ export interface components {
              * @example updated given_name, birth_date
              */
             summary: string;
+            /** @description Who made the change, from the X-Actor header (omitted if unattributed) */
+            user_id?: string;
         };
         RestorePointsResponse: {
             items: components["schemas"]["RestorePoint"][];
@@ -2709,6 +3707,26 @@ export interface components {
              */
             target_version: number;
         };
+        TrashItem: {
+            /** @enum {string} */
+            entity_type: "person" | "family" | "source" | "citation";
+            /** Format: uuid */
+            entity_id: string;
+            /** @description Human-readable name of the entity */
+            entity_name: string;
+            /** Format: date-time */
+            deleted_at: string;
+            /** @description Reason given for the deletion */
+            reason?: string;
+            /** @description Who deleted the entity (omitted if unattributed) */
+            user_id?: string;
+        };
+        TrashResponse: {
+            items: components["schemas"]["TrashItem"][];
+            total: number;
+            limit: number;
+            offset: number;
+        };
         RollbackResponse: {
             /**
              * Format: uuid
@@ -2756,6 +3774,8 @@ export interface components {
             file_size: number;
             /** @description Whether a thumbnail is available */
             has_thumbnail?: boolean;
+            /** @description Hex-encoded SHA-256 hash of the file content */
+            content_hash?: string;
             crop_left?: number;
             crop_top?: number;
             crop_width?: number;
@@ -2775,6 +3795,26 @@ export interface components {
             format?: string;
             /** @description Translated titles (GEDCOM 7.0 support) */
             translations?: string[];
+            /**
+             * Format: date-time
+             * @description When the photo was taken, from EXIF DateTimeOriginal
+             */
+            captured_at?: string;
+            /**
+             * Format: double
+             * @description EXIF GPS latitude in decimal degrees (negative is south)
+             */
+            gps_latitude?: number;
+            /**
+             * Format: double
+             * @description EXIF GPS longitude in decimal degrees (negative is west)
+             */
+            gps_longitude?: number;
+            /**
+             * @description Capture date as a GEDCOM date, suitable for prefilling an event date
+             * @example 12 MAR 1962
+             */
+            suggested_event_date?: string;
         };
         /** @description A single file reference within a media object (GEDCOM 7.0 FILE structure) */
         MediaFile: {
@@ -2807,6 +3847,22 @@ export interface components {
             /** @description Total number of media items */
             total: number;
         };
+        MediaDuplicateGroup: {
+            /** @description Hex-encoded SHA-256 hash shared by the group */
+            content_hash: string;
+            /**
+             * Format: int64
+             * @description Size in bytes of the shared file
+             */
+            file_size: number;
+            /** @description Media sharing the file, oldest first */
+            media: components["schemas"]["Media"][];
+        };
+        MediaDuplicatesReport: {
+            groups: components["schemas"]["MediaDuplicateGroup"][];
+            /** @description Number of duplicate groups */
+            total: number;
+        };
         MediaUpdate: {
             title?: string;
             description?: string;
@@ -2868,6 +3924,135 @@ export interface components {
             /** @description Number of persons buried/cremated here */
             count: number;
         };
+        PlaceVariantsResponse: {
+            clusters: components["schemas"]["PlaceVariantCluster"][];
+            /** @description Number of clusters */
+            total: number;
+        };
+        PlaceVariantCluster: {
+            /** @description Suggested canonical name (the most used variant) */
+            suggested: string;
+            variants: components["schemas"]["PlaceVariant"][];
+            /** @description Total references across all variants */
+            count: number;
+        };
+        PlaceVariant: {
+            place: string;
+            /** @description Number of person, family, and event fields using this place */
+            count: number;
+        };
+        PlaceMergeRequest: {
+            /** @description Place name to keep */
+            canonical: string;
+            /** @description Place names to rewrite to the canonical name */
+            variants: string[];
+        };
+        PlaceMergeResult: {
+            persons_updated: number;
+            families_updated: number;
+            events_updated: number;
+        };
+        BatchRequest: {
+            /**
+             * @description Roll back the applied operations and skip the rest after the first failure
+             * @default true
+             */
+            stop_on_error: boolean;
+            operations: components["schemas"]["BatchOperation"][];
+        };
+        BatchOperation: {
+            /** @enum {string} */
+            op: "create_person" | "add_name" | "create_family" | "link_child";
+            /**
+             * @description Name later operations use to reference the created entity (as "$alias")
+             * @example father
+             */
+            alias?: string;
+            person?: components["schemas"]["PersonCreate"];
+            name?: components["schemas"]["PersonNameCreate"];
+            family?: components["schemas"]["BatchFamily"];
+            /** @description add_name: person UUID or $alias */
+            person_id?: string;
+            /** @description create_family: partner UUID or $alias */
+            partner1_id?: string;
+            /** @description create_family: partner UUID or $alias */
+            partner2_id?: string;
+            /** @description link_child: family UUID or $alias */
+            family_id?: string;
+            /** @description link_child: child UUID or $alias */
+            child_id?: string;
+            /**
+             * @description link_child: the child's relationship to the family
+             * @default biological
+             * @enum {string}
+             */
+            relationship_type: "biological" | "adopted" | "foster";
+        };
+        /** @description Family fields for create_family; partners are given on the operation */
+        BatchFamily: {
+            /** @enum {string} */
+            relationship_type?: "marriage" | "partnership" | "unknown";
+            marriage_date?: string;
+            marriage_place?: string;
+        };
+        BatchResult: {
+            results: components["schemas"]["BatchOperationResult"][];
+            succeeded: number;
+            failed: number;
+            skipped: number;
+            rolled_back: number;
+        };
+        BatchOperationResult: {
+            index: number;
+            op: string;
+            alias?: string;
+            /** @enum {string} */
+            status: "ok" | "error" | "skipped" | "rolled_back";
+            /**
+             * Format: uuid
+             * @description Created person, name, or family; the family for link_child
+             */
+            id?: string;
+            /** @description Why the operation failed, or why its rollback failed */
+            error?: string;
+        };
+        SourceRepositoryIndexResponse: {
+            items: components["schemas"]["SourceRepositoryEntry"][];
+            /** @description Total number of unique repository names */
+            total: number;
+        };
+        FactTypeIndexResponse: {
+            items: components["schemas"]["FactTypeEntry"][];
+            total: number;
+        };
+        FactTypeEntry: {
+            /** @example person_occupation */
+            fact_type: string;
+            /** @description Whether the application defines this fact type */
+            known: boolean;
+            /** @description Total uses across events, attributes, and citations */
+            count: number;
+            event_count: number;
+            attribute_count: number;
+            citation_count: number;
+        };
+        SourceRepositoryEntry: {
+            /** @description Repository name as recorded on sources */
+            name: string;
+            /** @description Number of sources held by the repository */
+            count: number;
+        };
+        BirthDecadeIndexResponse: {
+            items: components["schemas"]["BirthDecadeEntry"][];
+            /** @description Total number of decades, including the unknown bucket */
+            total: number;
+        };
+        BirthDecadeEntry: {
+            /** @description Decade label (e.g. "1850s"), or "unknown" for undated persons */
+            decade: string;
+            /** @description Number of persons born in the decade */
+            count: number;
+        };
         MapLocationsResponse: {
             items: components["schemas"]["MapLocation"][];
             /** @description Total number of locations with coordinates */
@@ -2962,9 +4147,9 @@ export interface components {
             notes?: string;
             /**
              * Format: int64
-             * @description Current version for optimistic locking
+             * @description Current version for optimistic locking. Required unless the If-Match header carries the version instead.
              */
-            version: number;
+            version?: number;
         };
         SourceDetail: components["schemas"]["Source"] & {
             citations?: components["schemas"]["Citation"][];
@@ -2994,8 +4179,10 @@ export interface components {
             name_suffix?: string;
             surname_prefix?: string;
             nickname?: string;
+            /** @description Latin-script form of a name written in another script, searched alongside it */
+            romanized?: string;
             /** @enum {string} */
-            name_type: "birth" | "married" | "aka" | "immigrant" | "religious" | "professional";
+            name_type: "birth" | "married" | "aka" | "immigrant" | "religious" | "professional" | "patronymic";
             is_primary: boolean;
         };
         PersonNameCreate: {
@@ -3005,8 +4192,10 @@ export interface components {
             name_suffix?: string;
             surname_prefix?: string;
             nickname?: string;
+            /** @description Latin-script form of a name written in another script, searched alongside it */
+            romanized?: string;
             /** @enum {string} */
-            name_type: "birth" | "married" | "aka" | "immigrant" | "religious" | "professional";
+            name_type: "birth" | "married" | "aka" | "immigrant" | "religious" | "professional" | "patronymic";
             /** @default false */
             is_primary: boolean;
         };
@@ -3017,8 +4206,10 @@ export interface components {
             name_suffix?: string;
             surname_prefix?: string;
             nickname?: string;
+            /** @description Latin-script form of a name written in another script, searched alongside it */
+            romanized?: string;
             /** @enum {string} */
-            name_type?: "birth" | "married" | "aka" | "immigrant" | "religious" | "professional";
+            name_type?: "birth" | "married" | "aka" | "immigrant" | "religious" | "professional" | "patronymic";
             is_primary?: boolean;
         };
         PersonNameList: {
@@ -3112,6 +4303,64 @@ export interface components {
         CitationList: {
             citations: components["schemas"]["Citation"][];
             total: number;
+            limit?: number;
+            offset?: number;
+        };
+        SourceReport: {
+            /** Format: uuid */
+            person_id: string;
+            person_name: string;
+            facts: components["schemas"]["FactCoverage"][];
+            /** @description Number of facts with at least one citation */
+            cited_count: number;
+            /** @description Number of facts without citations */
+            uncited_count: number;
+        };
+        FactCoverage: {
+            /** @description Fact type (person_name, person_birth, person_death, or an event type) */
+            fact_type: string;
+            /**
+             * Format: uuid
+             * @description Life event ID, for event facts
+             */
+            event_id?: string;
+            date?: string;
+            place?: string;
+            /** @description Fact value, e.g. the name or an event description */
+            value?: string;
+            citation_count: number;
+            /**
+             * @description Highest source quality among the fact's citations
+             * @enum {string}
+             */
+            best_quality?: "original" | "derivative" | "authored";
+        };
+        SourceUsage: {
+            /** Format: uuid */
+            source_id: string;
+            source_title: string;
+            /** @description Total number of citations of the source */
+            citation_count: number;
+            owners: components["schemas"]["CitationOwner"][];
+        };
+        CitationOwner: {
+            /** @enum {string} */
+            owner_type: "person" | "family";
+            /** Format: uuid */
+            owner_id: string;
+            /** @description Person's name or the family's partner names; absent if the owner no longer exists */
+            owner_name?: string;
+            facts: components["schemas"]["CitedFact"][];
+        };
+        CitedFact: {
+            fact_type: string;
+            citation_ids: string[];
+            citation_count: number;
+            /**
+             * @description Highest source quality among the fact's citations
+             * @enum {string}
+             */
+            best_quality?: "original" | "derivative" | "authored";
         };
         CitationTemplate: {
             /** @description Stable template identifier (e.g., census.us.federal) */
@@ -3148,6 +4397,18 @@ export interface components {
             /** @description Any field validation warnings or errors */
             validation_issues?: components["schemas"]["CitationValidationIssue"][];
         };
+        CitationReference: {
+            /** @description Citation style used */
+            style: string;
+            /** @description First reference note */
+            footnote: string;
+            /** @description Subsequent reference note */
+            short_note: string;
+            /** @description Source list entry */
+            bibliography: string;
+            /** @description Template field warnings or errors, after source fields are applied */
+            validation_issues?: components["schemas"]["CitationValidationIssue"][];
+        };
         CitationValidationIssue: {
             field: string;
             message: string;
@@ -3167,12 +4428,56 @@ export interface components {
             /** @description Most common data quality issues */
             top_issues: components["schemas"]["QualityIssue"][];
         };
+        SurnameQualityReport: {
+            items: components["schemas"]["SurnameQuality"][];
+            /** @description Number of surnames */
+            total: number;
+            limit: number;
+            offset: number;
+        };
+        SurnameQuality: {
+            surname: string;
+            /** @description Number of persons with this surname */
+            person_count: number;
+            /**
+             * Format: float
+             * @description Average completeness score of those persons (0-100)
+             */
+            average_completeness: number;
+            /** @description Number of those persons with at least one data quality issue */
+            records_with_issues: number;
+            /** @description Number of those persons without a usable birth date */
+            missing_birth_dates: number;
+            /** @description Number of those persons with a birth date or place but no birth citation */
+            unsourced_births: number;
+        };
         QualityIssue: {
             /** @description Description of the issue */
             issue: string;
             /** @description Number of records with this issue */
             count: number;
         };
+        MissingDataReport: {
+            items: components["schemas"]["MissingDataItem"][];
+            /** @description Number of persons with at least one gap */
+            total: number;
+            limit: number;
+            offset: number;
+        };
+        MissingDataItem: {
+            /** Format: uuid */
+            person_id: string;
+            person_name: string;
+            /** @description Key facts the person is missing */
+            missing: ("birth_date" | "death_date" | "parents" | "sources")[];
+            /** @description Sum of the gap weights; higher means research first */
+            priority_score: number;
+            /**
+             * Format: float
+             * @description Completeness score (0-100) as reported by the person quality endpoint
+             */
+            completeness_score: number;
+        };
         PersonQuality: {
             /** Format: uuid */
             person_id: string;
@@ -3233,6 +4538,7 @@ export interface components {
             info_count: number;
             /** @description Most common issues by count */
             top_issues: components["schemas"]["QualityReportIssue"][];
+            research_status: components["schemas"]["ResearchStatusSummary"];
         };
         /** @description Aggregated issue count for quality reports */
         QualityReportIssue: {
@@ -3335,6 +4641,70 @@ export interface components {
             /** @description Optional reason for dismissal */
             reason?: string;
         };
+        /** @description Persons to include in a selection export */
+        ExportSelectionRequest: {
+            /** @description Persons to export */
+            person_ids: string[];
+            /**
+             * @description Output format
+             * @default gedcom
+             * @enum {string}
+             */
+            format?: "gedcom" | "json";
+            /**
+             * @description GEDCOM version to emit. Ignored for JSON. When omitted, defaults to 5.5 and is automatically upgraded to 7.0 if the data uses 7.0-only features.
+             * @enum {string}
+             */
+            version?: "5.5" | "5.5.1" | "7.0";
+        };
+        /** @description Request to delete multiple persons */
+        /** @description Options for a per-surname export */
+        ExportBySurnameRequest: {
+            /**
+             * @description GEDCOM version to emit. When omitted, each file defaults to 5.5 and is automatically upgraded to 7.0 if its data uses 7.0-only features.
+             * @enum {string}
+             */
+            version?: "5.5" | "5.5.1" | "7.0";
+        };
+        BulkDeleteRequest: {
+            /** @description Persons to delete, each with the version last read */
+            deletions: components["schemas"]["VersionedRef"][];
+            /** @description Reason recorded on each deletion */
+            reason?: string;
+        };
+        /** @description Entity ID with the version expected for optimistic locking */
+        VersionedRef: {
+            /** Format: uuid */
+            id: string;
+            /** Format: int64 */
+            version: number;
+        };
+        /** @description Results of bulk delete operation */
+        BulkDeleteResponse: {
+            /** @description Total number of deletions attempted */
+            total: number;
+            /** @description Number of persons deleted */
+            successful: number;
+            /** @description Number of deletions that failed */
+            failed: number;
+            /** @description Individual results in request order */
+            results: components["schemas"]["BulkDeleteResult"][];
+        };
+        /** @description Result of a single deletion in a bulk delete */
+        BulkDeleteResult: {
+            /** Format: uuid */
+            id: string;
+            /** @description Whether the person was deleted */
+            success: boolean;
+            /**
+             * @description Outcome: deleted; conflict when the version is stale; not_found;
+             *     has_families when the person is still linked to a family; error otherwise
+             * @enum {string}
+             */
+            status: "deleted" | "conflict" | "not_found" | "has_families" | "error";
+            /** @description Error message if the deletion failed */
+            error?: string;
+        };
         /** @description Request to merge multiple duplicate pairs */
         BatchMergeRequest: {
             /** @description List of merge operations to perform */
@@ -3465,7 +4835,103 @@ export interface components {
             date_range: components["schemas"]["DateRange"];
             /** @description Most common surnames (top 10) */
             top_surnames: components["schemas"]["SurnameCount"][];
+            /** @description Most common given names (top 10), counted by first token of compound names */
+            top_given_names: components["schemas"]["NameCount"][];
             gender_distribution: components["schemas"]["GenderDistribution"];
+            living: components["schemas"]["LivingStatistics"];
+            lifespan: components["schemas"]["LifespanStatistics"];
+            /**
+             * @description Birth counts per decade from the earliest to the latest birth decade, including
+             *     decades with no births. Persons without a parseable birth year are not counted.
+             */
+            births_by_decade: components["schemas"]["DecadeCount"][];
+            family_size: components["schemas"]["FamilySizeStatistics"];
+        };
+        /**
+         * @description Living and deceased persons. Living status is estimated: a person with a death date
+         *     or place is deceased; one without who was born more than presumed_lifespan years
+         *     before as_of_year is presumed deceased, and one born since is presumed living.
+         *     Persons with neither a death nor a birth year are counted as unknown.
+         */
+        LivingStatistics: {
+            living: number;
+            /** @description Persons with a recorded death, plus those presumed deceased */
+            deceased: number;
+            /** @description Deceased persons with no recorded death, judged by birth year alone */
+            presumed_deceased: number;
+            unknown: number;
+            /**
+             * @description Age in years beyond which a person with no recorded death is presumed deceased
+             * @example 100
+             */
+            presumed_lifespan: number;
+            /**
+             * @description Year the estimate was made for
+             * @example 2026
+             */
+            as_of_year: number;
+        };
+        DecadeCount: {
+            /** @description First year of the decade (e.g. 1850) */
+            decade: number;
+            count: number;
+        };
+        /**
+         * @description Age at death for persons with both a birth and a death date. Ages outside 0-120 years
+         *     are treated as data errors and excluded.
+         */
+        LifespanStatistics: {
+            /** @description Number of persons with a plausible age at death */
+            person_count: number;
+            /** @description Average age at death in years (omitted when person_count is 0) */
+            average_age?: number;
+            /** @description Median age at death in years (omitted when person_count is 0) */
+            median_age?: number;
+            /** @description Age at death grouped by birth century, oldest first */
+            by_century: components["schemas"]["CenturyLifespan"][];
+        };
+        /** @description Number of children per family */
+        FamilySizeStatistics: {
+            family_count: number;
+            /** @description Average children per family (omitted when family_count is 0) */
+            average_children?: number;
+            /** @description Median children per family (omitted when family_count is 0) */
+            median_children?: number;
+            /**
+             * @description Families by number of children, from childless up to the largest family, including
+             *     sizes no family has.
+             */
+            distribution: components["schemas"]["FamilySizeCount"][];
+        };
+        FamilySizeCount: {
+            children: number;
+            /** @description Number of families with exactly this many children */
+            families: number;
+        };
+        /**
+         * @description Age at first marriage for partners with both a birth date and a dated marriage. Ages
+         *     outside 12-100 years are treated as data errors and excluded.
+         */
+        MarriageAgeStatistics: {
+            overall: components["schemas"]["AgeSummary"];
+            male: components["schemas"]["AgeSummary"];
+            female: components["schemas"]["AgeSummary"];
+            unknown: components["schemas"]["AgeSummary"];
+        };
+        AgeSummary: {
+            /** @description Number of persons with a plausible age */
+            person_count: number;
+            /** @description Average age in years (omitted when person_count is 0) */
+            average_age?: number;
+            /** @description Median age in years (omitted when person_count is 0) */
+            median_age?: number;
+        };
+        CenturyLifespan: {
+            /** @description Birth century (e.g. "1800s") */
+            century: string;
+            person_count: number;
+            average_age: number;
+            median_age: number;
         };
         DateRange: {
             /** @description Earliest birth year in the tree */
@@ -3477,9 +4943,15 @@ export interface components {
             surname: string;
             count: number;
         };
+        NameCount: {
+            name: string;
+            count: number;
+        };
         GenderDistribution: {
             male: number;
             female: number;
+            nonbinary: number;
+            /** @description Persons whose gender is unknown or was never recorded */
             unknown: number;
         };
         Snapshot: {
@@ -3554,6 +5026,24 @@ export interface components {
             /** @description Human-readable relationship summary */
             summary?: string;
         };
+        KinReport: {
+            person: components["schemas"]["Person"];
+            /** @description Degree limit applied to the walk */
+            max_degree: number;
+            kin: components["schemas"]["KinMember"][];
+            total: number;
+        };
+        KinMember: {
+            person: components["schemas"]["Person"];
+            /** @description What the relative is to the subject person, e.g. "1st cousin" */
+            relationship: string;
+            /** @description Parent-child links between the subject person and the relative */
+            degree: number;
+            /** @description Generations from the subject person to the common ancestor */
+            generations_up: number;
+            /** @description Generations from the common ancestor to the relative */
+            generations_down: number;
+        };
         /** @description A shared GEDCOM NOTE record that can be referenced by multiple entities */
         Note: {
             /** Format: uuid */
@@ -3568,6 +5058,8 @@ export interface components {
             translations?: components["schemas"]["NoteTranslation"][];
             /** @description GEDCOM cross-reference ID (e.g., "@N1@") for round-trip support */
             gedcom_xref?: string;
+            /** @description Persons, families, and sources this note is attached to */
+            links?: components["schemas"]["NoteLink"][];
             /**
              * Format: int64
              * @description Optimistic locking version
@@ -3576,6 +5068,13 @@ export interface components {
             /** Format: date-time */
             updated_at?: string;
         };
+        /** @description Attachment of a note to a person, family, or source */
+        NoteLink: {
+            /** @enum {string} */
+            entity_type: "person" | "family" | "source";
+            /** Format: uuid */
+            entity_id: string;
+        };
         /** @description An alternate-language rendering of a shared note (GEDCOM 7.0 SNOTE TRAN) */
         NoteTranslation: {
             /** @description Translated note content */
@@ -3963,6 +5462,7 @@ export interface components {
             /** @description Date in GEDCOM format */
             date?: string;
             place?: string;
+            research_status?: components["schemas"]["ResearchStatus"];
             /** Format: int64 */
             version?: number;
             /** Format: date-time */
@@ -4163,6 +5663,42 @@ export interface components {
             limit?: number;
             offset?: number;
         };
+        ProjectionCheckResponse: {
+            /** @description Number of entities compared */
+            checked: number;
+            /** @description Number of events applied to rebuild state */
+            events_replayed: number;
+            discrepancies: components["schemas"]["ProjectionDiscrepancy"][];
+        };
+        ProjectionDiscrepancy: {
+            /**
+             * @description person, family, source, or citation; any stream type for failed
+             * @example person
+             */
+            entity_type: string;
+            /** Format: uuid */
+            entity_id: string;
+            /**
+             * @description missing: the event log has the entity but the read model does not.
+             *     unexpected: the read model has an entity the event log deleted.
+             *     mismatch: both have the entity but fields differ.
+             *     failed: an event for the entity could not be decoded or projected.
+             * @enum {string}
+             */
+            issue: "missing" | "unexpected" | "mismatch" | "failed";
+            /** @description Differing fields (mismatch only) */
+            fields?: components["schemas"]["ProjectionFieldMismatch"][];
+            /** @description Why the first failing event could not be replayed (failed only) */
+            error?: string;
+        };
+        ProjectionFieldMismatch: {
+            /** @example birth_place */
+            field: string;
+            /** @description Value rebuilt from the event log */
+            expected: string;
+            /** @description Value stored in the read model */
+            actual: string;
+        };
     };
     responses: {
         /** @description Invalid request */
@@ -4171,71 +5707,276 @@ export interface components {
                 [name: string]: unknown;
             };
             content: {
-                "application/json": components["schemas"]["Error"];
+                "application/json": components["schemas"]["Error"];
+            };
+        };
+        /** @description Resource not found */
+        NotFound: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/json": components["schemas"]["Error"];
+            };
+        };
+        /** @description Version conflict */
+        Conflict: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/json": components["schemas"]["Error"];
+            };
+        };
+        /** @description Entity has changed since the version in If-Match */
+        PreconditionFailed: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/json": components["schemas"]["Error"];
+            };
+        };
+        /** @description Resource unchanged since the version in If-None-Match */
+        NotModified: {
+            headers: {
+                ETag?: components["headers"]["ETag"];
+                [name: string]: unknown;
+            };
+            content?: never;
+        };
+        /** @description Missing or invalid bearer token */
+        Unauthorized: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/json": components["schemas"]["Error"];
+            };
+        };
+        /** @description Endpoint is disabled on this server */
+        Forbidden: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/json": components["schemas"]["Error"];
+            };
+        };
+    };
+    parameters: {
+        personId: string;
+        familyId: string;
+        /** @description ETag from a previous response. When it matches the current version, the server responds 304 Not Modified without a body. */
+        ifNoneMatch: string;
+        /** @description ETag of the version being updated, as returned by GET (e.g. "3" or "3-9f86d081884c7d65"); only the version before any dash is used. Used in place of the body version; when the entity has since changed the server responds 412 Precondition Failed. Retries are not attempted when this header is sent. */
+        ifMatch: string;
+        limitParam: number;
+        /** @description Maximum results to return (default 20), capped at SEARCH_MAX_LIMIT (default 100) */
+        searchLimitParam: number;
+        offsetParam: number;
+        /** @description Only return citations whose source has this quality */
+        sourceQualityParam: "original" | "derivative" | "authored";
+        /** @description Only return citations providing this type of evidence */
+        evidenceTypeParam: "direct" | "indirect" | "negative";
+        /** @description Entity version for optimistic locking */
+        versionParam: number;
+        /**
+         * @description Automatically retry on a version conflict by re-reading the current
+         *     version and re-applying the submitted changes. Changes to the same
+         *     field made concurrently by someone else are overwritten.
+         */
+        retryParam: boolean;
+        /** @description Snapshot UUID */
+        snapshotId: string;
+        /** @description Note UUID */
+        noteId: string;
+        /** @description Submitter UUID */
+        submitterId: string;
+        /** @description Repository UUID */
+        repositoryId: string;
+        /** @description Association UUID */
+        associationId: string;
+        /** @description LDS Ordinance UUID */
+        ldsOrdinanceId: string;
+        /** @description Evidence Analysis UUID */
+        evidenceAnalysisId: string;
+        /** @description Evidence Conflict UUID */
+        evidenceConflictId: string;
+        /** @description Research Log UUID */
+        researchLogId: string;
+        /** @description Proof Summary UUID */
+        proofSummaryId: string;
+    };
+    requestBodies: never;
+    headers: {
+        /** @description Entity version, quoted (e.g. "3"). A person's ETag adds a dash and a hash of the response (e.g. "3-9f86d081884c7d65"), since it embeds family and partner details that change without the person's version. */
+        ETag: string;
+    };
+    pathItems: never;
+}
+export type $defs = Record<string, never>;
+export interface operations {
+    listPersons: {
+        parameters: {
+            query?: {
+                limit?: components["parameters"]["limitParam"];
+                offset?: components["parameters"]["offsetParam"];
+                sort?: "surname" | "given_name" | "birth_date" | "updated_at";
+                order?: "asc" | "desc";
+                /** @description Filter by research status */
+                research_status?: "certain" | "probable" | "possible" | "unknown" | "unset";
+                /** @description Filter by exact surname (case-insensitive) */
+                surname?: string;
+                /** @description Filter by gender */
+                gender?: "male" | "female" | "nonbinary" | "unknown";
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of persons */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PersonList"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+        };
+    };
+    createPerson: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["PersonCreate"];
+            };
+        };
+        responses: {
+            /** @description Person created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Person"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+        };
+    };
+    getPerson: {
+        parameters: {
+            query?: never;
+            header?: {
+                /** @description ETag from a previous response. When it matches the current version, the server responds 304 Not Modified without a body. */
+                "If-None-Match"?: components["parameters"]["ifNoneMatch"];
+            };
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Person details */
+            200: {
+                headers: {
+                    ETag?: components["headers"]["ETag"];
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PersonDetail"];
+                };
+            };
+            304: components["responses"]["NotModified"];
+            404: components["responses"]["NotFound"];
+        };
+    };
+    updatePerson: {
+        parameters: {
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
+            header?: {
+                /** @description ETag of the version being updated, as returned by GET (e.g. "3" or "3-9f86d081884c7d65"); only the version before any dash is used. Used in place of the body version; when the entity has since changed the server responds 412 Precondition Failed. Retries are not attempted when this header is sent. */
+                "If-Match"?: components["parameters"]["ifMatch"];
+            };
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["PersonUpdate"];
             };
         };
-        /** @description Resource not found */
-        NotFound: {
-            headers: {
-                [name: string]: unknown;
+        responses: {
+            /** @description Person updated */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Person"];
+                };
             };
-            content: {
-                "application/json": components["schemas"]["Error"];
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
+            409: components["responses"]["Conflict"];
+            412: components["responses"]["PreconditionFailed"];
+        };
+    };
+    deletePerson: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
             };
+            cookie?: never;
         };
-        /** @description Version conflict */
-        Conflict: {
-            headers: {
-                [name: string]: unknown;
+        requestBody?: never;
+        responses: {
+            /** @description Person deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
             };
-            content: {
-                "application/json": components["schemas"]["Error"];
+            404: components["responses"]["NotFound"];
+            /** @description Person is linked to families */
+            409: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
             };
         };
     };
-    parameters: {
-        personId: string;
-        familyId: string;
-        limitParam: number;
-        offsetParam: number;
-        /** @description Entity version for optimistic locking */
-        versionParam: number;
-        /** @description Snapshot UUID */
-        snapshotId: string;
-        /** @description Note UUID */
-        noteId: string;
-        /** @description Submitter UUID */
-        submitterId: string;
-        /** @description Repository UUID */
-        repositoryId: string;
-        /** @description Association UUID */
-        associationId: string;
-        /** @description LDS Ordinance UUID */
-        ldsOrdinanceId: string;
-        /** @description Evidence Analysis UUID */
-        evidenceAnalysisId: string;
-        /** @description Evidence Conflict UUID */
-        evidenceConflictId: string;
-        /** @description Research Log UUID */
-        researchLogId: string;
-        /** @description Proof Summary UUID */
-        proofSummaryId: string;
-    };
-    requestBodies: never;
-    headers: never;
-    pathItems: never;
-}
-export type $defs = Record<string, never>;
-export interface operations {
-    listPersons: {
+    listFamilies: {
         parameters: {
             query?: {
                 limit?: components["parameters"]["limitParam"];
                 offset?: components["parameters"]["offsetParam"];
-                sort?: "surname" | "given_name" | "birth_date" | "updated_at";
-                order?: "asc" | "desc";
-                /** @description Filter by research status */
-                research_status?: "certain" | "probable" | "possible" | "unknown" | "unset";
             };
             header?: never;
             path?: never;
@@ -4243,88 +5984,259 @@ export interface operations {
         };
         requestBody?: never;
         responses: {
-            /** @description List of persons */
+            /** @description List of families */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["FamilyList"];
+                };
+            };
+        };
+    };
+    createFamily: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["FamilyCreate"];
+            };
+        };
+        responses: {
+            /** @description Family created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Family"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+        };
+    };
+    searchFamilies: {
+        parameters: {
+            query: {
+                /** @description Search query (partner names); must have at least SEARCH_MIN_QUERY_LENGTH characters (default 2). */
+                q: string;
+                limit?: components["parameters"]["searchLimitParam"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Search results */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["FamilySearchResults"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+        };
+    };
+    getFamily: {
+        parameters: {
+            query?: never;
+            header?: {
+                /** @description ETag from a previous response. When it matches the current version, the server responds 304 Not Modified without a body. */
+                "If-None-Match"?: components["parameters"]["ifNoneMatch"];
+            };
+            path: {
+                id: components["parameters"]["familyId"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Family details */
+            200: {
+                headers: {
+                    ETag?: components["headers"]["ETag"];
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["FamilyDetail"];
+                };
+            };
+            304: components["responses"]["NotModified"];
+            404: components["responses"]["NotFound"];
+        };
+    };
+    updateFamily: {
+        parameters: {
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
+            header?: {
+                /** @description ETag of the version being updated, as returned by GET (e.g. "3" or "3-9f86d081884c7d65"); only the version before any dash is used. Used in place of the body version; when the entity has since changed the server responds 412 Precondition Failed. Retries are not attempted when this header is sent. */
+                "If-Match"?: components["parameters"]["ifMatch"];
+            };
+            path: {
+                id: components["parameters"]["familyId"];
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["FamilyUpdate"];
+            };
+        };
+        responses: {
+            /** @description Family updated */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["PersonList"];
+                    "application/json": components["schemas"]["Family"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
+            412: components["responses"]["PreconditionFailed"];
+        };
+    };
+    deleteFamily: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["familyId"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Family deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            404: components["responses"]["NotFound"];
+        };
+    };
+    addChildToFamily: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["familyId"];
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["AddChild"];
+            };
+        };
+        responses: {
+            /** @description Child added */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["FamilyChild"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
+            /** @description Child already belongs to a family or circular relationship */
+            409: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
                 };
             };
-            400: components["responses"]["BadRequest"];
         };
     };
-    createPerson: {
+    removeChildFromFamily: {
         parameters: {
             query?: never;
             header?: never;
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["PersonCreate"];
+            path: {
+                id: components["parameters"]["familyId"];
+                personId: string;
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
-            /** @description Person created */
-            201: {
+            /** @description Child removed */
+            204: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content: {
-                    "application/json": components["schemas"]["Person"];
-                };
+                content?: never;
             };
-            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
         };
     };
-    getPerson: {
+    getFamilyGroupSheet: {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                id: components["parameters"]["personId"];
+                id: components["parameters"]["familyId"];
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Person details */
+            /** @description Family group sheet data */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["PersonDetail"];
+                    "application/json": components["schemas"]["FamilyGroupSheet"];
                 };
             };
             404: components["responses"]["NotFound"];
         };
     };
-    updatePerson: {
+    setEventResearchStatus: {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                id: components["parameters"]["personId"];
+                id: string;
             };
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["PersonUpdate"];
+                "application/json": components["schemas"]["ResearchStatusUpdate"];
             };
         };
         responses: {
-            /** @description Person updated */
+            /** @description Research status updated */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Person"];
+                    "application/json": components["schemas"]["ResearchStatusUpdateResult"];
                 };
             };
             400: components["responses"]["BadRequest"];
@@ -4332,389 +6244,442 @@ export interface operations {
             409: components["responses"]["Conflict"];
         };
     };
-    deletePerson: {
+    setAttributeResearchStatus: {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                id: components["parameters"]["personId"];
+                id: string;
             };
             cookie?: never;
         };
-        requestBody?: never;
-        responses: {
-            /** @description Person deleted */
-            204: {
-                headers: {
-                    [name: string]: unknown;
-                };
-                content?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ResearchStatusUpdate"];
             };
-            404: components["responses"]["NotFound"];
-            /** @description Person is linked to families */
-            409: {
+        };
+        responses: {
+            /** @description Research status updated */
+            200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Error"];
+                    "application/json": components["schemas"]["ResearchStatusUpdateResult"];
                 };
             };
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
+            409: components["responses"]["Conflict"];
         };
     };
-    listFamilies: {
+    getPedigree: {
         parameters: {
             query?: {
-                limit?: components["parameters"]["limitParam"];
-                offset?: components["parameters"]["offsetParam"];
+                /**
+                 * @description Number of ancestor generations to include. Defaults to the server's
+                 *     DEFAULT_GENERATIONS (5 when unset) and is capped at MAX_GENERATIONS (default 10).
+                 */
+                generations?: number;
+                /** @description Output format; text is an indented outline of the tree for pasting into emails */
+                format?: "json" | "text";
+                /** @description Attach birth and death citation counts to each ancestor, so unsourced facts can be flagged */
+                include_sources?: boolean;
             };
             header?: never;
-            path?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description List of families */
+            /** @description Pedigree data */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["FamilyList"];
+                    "application/json": components["schemas"]["Pedigree"];
+                    "text/plain": string;
                 };
             };
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
         };
     };
-    createFamily: {
+    getDescendancy: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Number of descendant generations to include. Defaults to the server's
+                 *     DEFAULT_GENERATIONS (4 when unset) and is capped at MAX_GENERATIONS (default 10).
+                 */
+                generations?: number;
+                /**
+                 * @description Set to false to leave out descendants estimated to be deceased: those with a
+                 *     death date or place, or born more than 100 years ago. Descendants with no
+                 *     birth year are kept.
+                 */
+                include_deceased?: boolean;
+                /**
+                 * @description Leave out descendants born before this year. Descendants with no birth year
+                 *     are kept.
+                 */
+                birth_year_from?: number;
+            };
             header?: never;
-            path?: never;
-            cookie?: never;
-        };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["FamilyCreate"];
+            path: {
+                id: components["parameters"]["personId"];
             };
+            cookie?: never;
         };
+        requestBody?: never;
         responses: {
-            /** @description Family created */
-            201: {
+            /**
+             * @description Descendancy data. When filters are given, a descendant that fails them is
+             *     still included if it connects the root to descendants that pass.
+             */
+            200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Family"];
+                    "application/json": components["schemas"]["Descendancy"];
                 };
             };
-            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
         };
     };
-    getFamily: {
+    getAhnentafel: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Number of ancestor generations to include. Defaults to the server's
+                 *     DEFAULT_GENERATIONS (5 when unset) and is capped at MAX_GENERATIONS (default 10).
+                 */
+                generations?: number;
+                /** @description Output format */
+                format?: "json" | "text";
+                /** @description Attach birth and death citation counts to each ancestor, so unsourced facts can be flagged */
+                include_sources?: boolean;
+            };
             header?: never;
             path: {
-                id: components["parameters"]["familyId"];
+                id: components["parameters"]["personId"];
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Family details */
+            /** @description Ahnentafel report */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["FamilyDetail"];
+                    "application/json": components["schemas"]["AhnentafelResponse"];
+                    "text/plain": string;
                 };
             };
+            400: components["responses"]["BadRequest"];
             404: components["responses"]["NotFound"];
         };
     };
-    updateFamily: {
+    getEndOfLines: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Number of ancestor generations to search. Defaults to the server's
+                 *     DEFAULT_GENERATIONS (5 when unset) and is capped at MAX_GENERATIONS (default 10).
+                 */
+                generations?: number;
+            };
             header?: never;
             path: {
-                id: components["parameters"]["familyId"];
+                id: components["parameters"]["personId"];
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["FamilyUpdate"];
-            };
-        };
+        requestBody?: never;
         responses: {
-            /** @description Family updated */
+            /** @description End-of-line ancestors */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Family"];
+                    "application/json": components["schemas"]["EndOfLinesResponse"];
                 };
             };
-            400: components["responses"]["BadRequest"];
             404: components["responses"]["NotFound"];
         };
     };
-    deleteFamily: {
+    searchPersons: {
         parameters: {
-            query?: never;
-            header?: never;
-            path: {
-                id: components["parameters"]["familyId"];
+            query?: {
+                /**
+                 * @description Search query (name). `*` matches any run of characters at a word boundary,
+                 *     so `Smi*` finds names beginning with "Smi" and `*son` names ending in "son";
+                 *     wildcard queries ignore `algorithm`, `fuzzy`, and `soundex`.
+                 *     Must have at least SEARCH_MIN_QUERY_LENGTH characters (default 2).
+                 */
+                q?: string;
+                /** @description Enable fuzzy matching for spelling variations */
+                fuzzy?: boolean;
+                /** @description Enable Soundex phonetic matching for name variants */
+                soundex?: boolean;
+                /**
+                 * @description Name matching algorithm. Overrides `fuzzy` and `soundex` when set.
+                 *     `metaphone` matches query words against the Metaphone key of surnames.
+                 */
+                algorithm?: "exact" | "fuzzy" | "soundex" | "metaphone";
+                /** @description Filter by birth date on or after this date */
+                birth_date_from?: string;
+                /** @description Filter by birth date on or before this date */
+                birth_date_to?: string;
+                /** @description Filter by death date on or after this date */
+                death_date_from?: string;
+                /** @description Filter by death date on or before this date */
+                death_date_to?: string;
+                /** @description Filter by birth place (partial match) */
+                birth_place?: string;
+                /** @description Filter by death place (partial match) */
+                death_place?: string;
+                /** @description Sort results by field */
+                sort?: "relevance" | "name" | "birth_date" | "death_date";
+                /** @description Sort order */
+                order?: "asc" | "desc";
+                limit?: components["parameters"]["searchLimitParam"];
             };
+            header?: never;
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Family deleted */
-            204: {
+            /** @description Search results */
+            200: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": components["schemas"]["SearchResults"];
+                };
             };
-            404: components["responses"]["NotFound"];
+            400: components["responses"]["BadRequest"];
         };
     };
-    addChildToFamily: {
+    advancedSearchPersons: {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: components["parameters"]["familyId"];
-            };
+            path?: never;
             cookie?: never;
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["AddChild"];
+                "application/json": components["schemas"]["AdvancedSearchRequest"];
             };
         };
         responses: {
-            /** @description Child added */
-            201: {
+            /** @description Search results */
+            200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["FamilyChild"];
+                    "application/json": components["schemas"]["SearchResults"];
                 };
             };
             400: components["responses"]["BadRequest"];
-            404: components["responses"]["NotFound"];
-            /** @description Child already belongs to a family or circular relationship */
-            409: {
+        };
+    };
+    browseSurnames: {
+        parameters: {
+            query?: {
+                /** @description Filter surnames by starting letter (A-Z) */
+                letter?: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Surname index or surnames list */
+            200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Error"];
+                    "application/json": components["schemas"]["SurnameIndexResponse"];
                 };
             };
         };
     };
-    removeChildFromFamily: {
+    getPersonsBySurname: {
         parameters: {
-            query?: never;
+            query?: {
+                limit?: components["parameters"]["limitParam"];
+                offset?: components["parameters"]["offsetParam"];
+            };
             header?: never;
             path: {
-                id: components["parameters"]["familyId"];
-                personId: string;
+                /** @description The surname to get people for */
+                surname: string;
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Child removed */
-            204: {
+            /** @description List of persons with the surname */
+            200: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": components["schemas"]["PersonList"];
+                };
             };
-            404: components["responses"]["NotFound"];
         };
     };
-    getFamilyGroupSheet: {
+    browsePlaces: {
         parameters: {
-            query?: never;
-            header?: never;
-            path: {
-                id: components["parameters"]["familyId"];
+            query?: {
+                /** @description Parent place to get children for (empty for top-level) */
+                parent?: string;
             };
+            header?: never;
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Family group sheet data */
+            /** @description Place hierarchy */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["FamilyGroupSheet"];
+                    "application/json": components["schemas"]["PlaceIndexResponse"];
                 };
             };
-            404: components["responses"]["NotFound"];
         };
     };
-    getPedigree: {
+    getPersonsByPlace: {
         parameters: {
             query?: {
-                /** @description Number of ancestor generations to include */
-                generations?: number;
+                limit?: components["parameters"]["limitParam"];
+                offset?: components["parameters"]["offsetParam"];
             };
             header?: never;
             path: {
-                id: components["parameters"]["personId"];
+                /** @description The place to get people for */
+                place: string;
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Pedigree data */
+            /** @description List of persons associated with the place */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Pedigree"];
+                    "application/json": components["schemas"]["PersonList"];
                 };
             };
-            404: components["responses"]["NotFound"];
         };
     };
-    getDescendancy: {
+    browseCemeteries: {
         parameters: {
-            query?: {
-                /** @description Number of descendant generations to include */
-                generations?: number;
-            };
+            query?: never;
             header?: never;
-            path: {
-                id: components["parameters"]["personId"];
-            };
+            path?: never;
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Descendancy data */
+            /** @description Cemetery/burial place index */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Descendancy"];
+                    "application/json": components["schemas"]["CemeteryIndexResponse"];
                 };
             };
-            404: components["responses"]["NotFound"];
         };
     };
-    getAhnentafel: {
+    getPersonsByCemetery: {
         parameters: {
             query?: {
-                /** @description Number of ancestor generations to include (1-10) */
-                generations?: number;
-                /** @description Output format */
-                format?: "json" | "text";
+                limit?: components["parameters"]["limitParam"];
+                offset?: components["parameters"]["offsetParam"];
             };
             header?: never;
             path: {
-                id: components["parameters"]["personId"];
+                /** @description The cemetery or burial place to get people for */
+                place: string;
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Ahnentafel report */
+            /** @description List of persons buried or cremated at the place */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["AhnentafelResponse"];
-                    "text/plain": string;
+                    "application/json": components["schemas"]["PersonList"];
                 };
             };
-            400: components["responses"]["BadRequest"];
-            404: components["responses"]["NotFound"];
         };
     };
-    searchPersons: {
+    browseSourceRepositories: {
         parameters: {
-            query?: {
-                /** @description Search query (name) */
-                q?: string;
-                /** @description Enable fuzzy matching for spelling variations */
-                fuzzy?: boolean;
-                /** @description Enable Soundex phonetic matching for name variants */
-                soundex?: boolean;
-                /** @description Filter by birth date on or after this date */
-                birth_date_from?: string;
-                /** @description Filter by birth date on or before this date */
-                birth_date_to?: string;
-                /** @description Filter by death date on or after this date */
-                death_date_from?: string;
-                /** @description Filter by death date on or before this date */
-                death_date_to?: string;
-                /** @description Filter by birth place (partial match) */
-                birth_place?: string;
-                /** @description Filter by death place (partial match) */
-                death_place?: string;
-                /** @description Sort results by field */
-                sort?: "relevance" | "name" | "birth_date" | "death_date";
-                /** @description Sort order */
-                order?: "asc" | "desc";
-                limit?: components["parameters"]["limitParam"];
-            };
+            query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Search results */
+            /** @description Source repository index */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SearchResults"];
+                    "application/json": components["schemas"]["SourceRepositoryIndexResponse"];
                 };
             };
-            400: components["responses"]["BadRequest"];
         };
     };
-    browseSurnames: {
+    listFactTypes: {
         parameters: {
-            query?: {
-                /** @description Filter surnames by starting letter (A-Z) */
-                letter?: string;
-            };
+            query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Surname index or surnames list */
+            /** @description Fact types in use */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["SurnameIndexResponse"];
+                    "application/json": components["schemas"]["FactTypeIndexResponse"];
                 };
             };
         };
     };
-    getPersonsBySurname: {
+    getSourcesByRepository: {
         parameters: {
             query?: {
                 limit?: components["parameters"]["limitParam"];
@@ -4722,48 +6687,45 @@ export interface operations {
             };
             header?: never;
             path: {
-                /** @description The surname to get people for */
-                surname: string;
+                /** @description The repository name to list sources for (case-insensitive) */
+                name: string;
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description List of persons with the surname */
+            /** @description List of sources held by the repository */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["PersonList"];
+                    "application/json": components["schemas"]["SourceList"];
                 };
             };
         };
     };
-    browsePlaces: {
+    browseBirthDecades: {
         parameters: {
-            query?: {
-                /** @description Parent place to get children for (empty for top-level) */
-                parent?: string;
-            };
+            query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Place hierarchy */
+            /** @description Birth decade index */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["PlaceIndexResponse"];
+                    "application/json": components["schemas"]["BirthDecadeIndexResponse"];
                 };
             };
         };
     };
-    getPersonsByPlace: {
+    getPersonsByBirthDecade: {
         parameters: {
             query?: {
                 limit?: components["parameters"]["limitParam"];
@@ -4771,14 +6733,14 @@ export interface operations {
             };
             header?: never;
             path: {
-                /** @description The place to get people for */
-                place: string;
+                /** @description Decade to list (e.g. 1850 or 1850s), or "unknown" for undated persons */
+                decade: string;
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description List of persons associated with the place */
+            /** @description List of persons born in the decade */
             200: {
                 headers: {
                     [name: string]: unknown;
@@ -4787,9 +6749,10 @@ export interface operations {
                     "application/json": components["schemas"]["PersonList"];
                 };
             };
+            400: components["responses"]["BadRequest"];
         };
     };
-    browseCemeteries: {
+    getPlaceVariants: {
         parameters: {
             query?: never;
             header?: never;
@@ -4798,41 +6761,66 @@ export interface operations {
         };
         requestBody?: never;
         responses: {
-            /** @description Cemetery/burial place index */
+            /** @description Place variant clusters */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["CemeteryIndexResponse"];
+                    "application/json": components["schemas"]["PlaceVariantsResponse"];
                 };
             };
         };
     };
-    getPersonsByCemetery: {
+    mergePlaces: {
         parameters: {
-            query?: {
-                limit?: components["parameters"]["limitParam"];
-                offset?: components["parameters"]["offsetParam"];
-            };
+            query?: never;
             header?: never;
-            path: {
-                /** @description The cemetery or burial place to get people for */
-                place: string;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["PlaceMergeRequest"];
+            };
+        };
+        responses: {
+            /** @description Places merged */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PlaceMergeResult"];
+                };
             };
+            400: components["responses"]["BadRequest"];
+            409: components["responses"]["Conflict"];
+        };
+    };
+    executeBatch: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["BatchRequest"];
+            };
+        };
         responses: {
-            /** @description List of persons buried or cremated at the place */
+            /** @description Per-operation results */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["PersonList"];
+                    "application/json": components["schemas"]["BatchResult"];
                 };
             };
+            400: components["responses"]["BadRequest"];
         };
     };
     getMapLocations: {
@@ -4941,6 +6929,11 @@ export interface operations {
                      * @description GEDCOM file to import
                      */
                     file: string;
+                    /**
+                     * Format: binary
+                     * @description Optional ZIP archive of the media files referenced by the GEDCOM OBJE/FILE structures. Files are matched by path, or by file name when the path does not match.
+                     */
+                    media?: string;
                 };
             };
         };
@@ -4963,6 +6956,15 @@ export interface operations {
                     "application/json": components["schemas"]["Error"];
                 };
             };
+            /** @description Upload exceeds the configured import limit (MAX_IMPORT_SIZE, default 100MB, covering the GEDCOM file and media archive together) */
+            413: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
         };
     };
     exportGedcom: {
@@ -4970,6 +6972,11 @@ export interface operations {
             query?: {
                 /** @description GEDCOM version to emit. When omitted, defaults to 5.5 and is automatically upgraded to 7.0 if the data uses 7.0-only features. */
                 version?: "5.5" | "5.5.1" | "7.0";
+                /**
+                 * @description Comma-separated optional record types to export, from "sources" (with their repositories and citations) and "notes". Persons and families are always exported. When omitted, everything is exported; an empty value gives a lean person and family file.
+                 * @example sources,notes
+                 */
+                include?: string;
             };
             header?: never;
             path?: never;
@@ -5014,11 +7021,43 @@ export interface operations {
             400: components["responses"]["BadRequest"];
         };
     };
+    exportPersonGedcom: {
+        parameters: {
+            query: {
+                /** @description Direction to walk from the person */
+                mode: "descendants" | "ancestors";
+                /** @description GEDCOM version to emit. When omitted, defaults to 5.5 and is automatically upgraded to 7.0 if the data uses 7.0-only features. */
+                version?: "5.5" | "5.5.1" | "7.0";
+            };
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description GEDCOM file */
+            200: {
+                headers: {
+                    "Content-Disposition"?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/x-gedcom": string;
+                };
+            };
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
+        };
+    };
     listHistory: {
         parameters: {
             query?: {
                 /** @description Filter by entity type */
                 entity_type?: "person" | "family" | "source" | "citation";
+                /** @description Filter by action. Linked and unlinked match child links and unlinks on families. */
+                action?: "created" | "updated" | "deleted" | "linked" | "unlinked";
                 /** @description Start date/time for history (ISO 8601) */
                 from?: string;
                 /** @description End date/time for history (ISO 8601) */
@@ -5044,6 +7083,28 @@ export interface operations {
             400: components["responses"]["BadRequest"];
         };
     };
+    listRecentChanges: {
+        parameters: {
+            query?: {
+                limit?: components["parameters"]["limitParam"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Recently changed entities */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["RecentChangesResponse"];
+                };
+            };
+        };
+    };
     getPersonHistory: {
         parameters: {
             query?: {
@@ -5084,42 +7145,109 @@ export interface operations {
         };
         requestBody?: never;
         responses: {
-            /** @description Family change history */
+            /** @description Family change history */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ChangeHistoryResponse"];
+                };
+            };
+            404: components["responses"]["NotFound"];
+        };
+    };
+    getSourceHistory: {
+        parameters: {
+            query?: {
+                limit?: components["parameters"]["limitParam"];
+                offset?: components["parameters"]["offsetParam"];
+            };
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Source change history */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ChangeHistoryResponse"];
+                };
+            };
+            404: components["responses"]["NotFound"];
+        };
+    };
+    listTrash: {
+        parameters: {
+            query?: {
+                limit?: components["parameters"]["limitParam"];
+                offset?: components["parameters"]["offsetParam"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Deleted entities */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ChangeHistoryResponse"];
+                    "application/json": components["schemas"]["TrashResponse"];
                 };
             };
-            404: components["responses"]["NotFound"];
         };
     };
-    getSourceHistory: {
+    restoreFromTrash: {
         parameters: {
-            query?: {
-                limit?: components["parameters"]["limitParam"];
-                offset?: components["parameters"]["offsetParam"];
-            };
+            query?: never;
             header?: never;
             path: {
+                /** @description ID of the deleted entity */
                 id: string;
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Source change history */
+            /** @description Restore successful */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ChangeHistoryResponse"];
+                    /**
+                     * @example {
+                     *       "entity_id": "123e4567-e89b-12d3-a456-426614174000",
+                     *       "entity_type": "Person",
+                     *       "new_version": 5,
+                     *       "changes": {
+                     *         "birth_place": "Boston"
+                     *       },
+                     *       "message": "Person restored successfully"
+                     *     }
+                     */
+                    "application/json": components["schemas"]["RollbackResponse"];
                 };
             };
             404: components["responses"]["NotFound"];
+            /** @description Entity is not deleted, or references a deleted record */
+            409: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
         };
     };
     getPersonRestorePoints: {
@@ -5521,6 +7649,33 @@ export interface operations {
             404: components["responses"]["NotFound"];
         };
     };
+    getFormattedCitation: {
+        parameters: {
+            query?: {
+                /** @description Citation style (default evidence_explained) */
+                style?: "evidence_explained";
+            };
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Formatted citation */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["CitationReference"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
+        };
+    };
     listPersonMedia: {
         parameters: {
             query?: {
@@ -5570,7 +7725,7 @@ export interface operations {
                     media_type?: "photo" | "document" | "audio" | "video" | "certificate";
                     /**
                      * Format: binary
-                     * @description The file to upload (max 10MB)
+                     * @description The file to upload (max 10MB unless MAX_MEDIA_SIZE is set)
                      */
                     file: string;
                 };
@@ -5588,7 +7743,7 @@ export interface operations {
             };
             400: components["responses"]["BadRequest"];
             404: components["responses"]["NotFound"];
-            /** @description File too large (max 10MB) */
+            /** @description File exceeds the configured upload limit (MAX_MEDIA_SIZE, default 10MB) */
             413: {
                 headers: {
                     [name: string]: unknown;
@@ -5599,6 +7754,50 @@ export interface operations {
             };
         };
     };
+    downloadPersonMediaArchive: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description ZIP archive of the person's media */
+            200: {
+                headers: {
+                    "Content-Disposition"?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/zip": string;
+                };
+            };
+            404: components["responses"]["NotFound"];
+        };
+    };
+    listDuplicateMedia: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Groups of duplicate media */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["MediaDuplicatesReport"];
+                };
+            };
+        };
+    };
     getMedia: {
         parameters: {
             query?: never;
@@ -5625,7 +7824,14 @@ export interface operations {
     };
     updateMedia: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
             header?: never;
             path: {
                 /** @description Media ID */
@@ -5703,6 +7909,10 @@ export interface operations {
                     "image/png": string;
                     "image/gif": string;
                     "application/pdf": string;
+                    "audio/mpeg": string;
+                    "audio/wave": string;
+                    "video/mp4": string;
+                    "video/webm": string;
                 };
             };
             404: components["responses"]["NotFound"];
@@ -5710,7 +7920,10 @@ export interface operations {
     };
     getMediaThumbnail: {
         parameters: {
-            query?: never;
+            query?: {
+                /** @description Maximum thumbnail width and height in pixels (default set by THUMBNAIL_SIZE) */
+                size?: number;
+            };
             header?: never;
             path: {
                 /** @description Media ID */
@@ -5720,7 +7933,7 @@ export interface operations {
         };
         requestBody?: never;
         responses: {
-            /** @description Thumbnail image (JPEG) */
+            /** @description Thumbnail image (JPEG); videos have a generic play icon */
             200: {
                 headers: {
                     [name: string]: unknown;
@@ -5729,6 +7942,7 @@ export interface operations {
                     "image/jpeg": string;
                 };
             };
+            400: components["responses"]["BadRequest"];
             404: components["responses"]["NotFound"];
         };
     };
@@ -5737,7 +7951,8 @@ export interface operations {
             query?: {
                 limit?: components["parameters"]["limitParam"];
                 offset?: components["parameters"]["offsetParam"];
-                sort?: "title" | "source_type" | "created_at" | "updated_at";
+                /** @description Field to sort by; citation_count orders sources by how often they are cited */
+                sort?: "title" | "source_type" | "created_at" | "updated_at" | "citation_count";
                 order?: "asc" | "desc";
                 /** @description Search query to filter sources */
                 q?: string;
@@ -5788,9 +8003,9 @@ export interface operations {
     searchSources: {
         parameters: {
             query: {
-                /** @description Search query */
+                /** @description Search query; must have at least SEARCH_MIN_QUERY_LENGTH characters (default 2). */
                 q: string;
-                limit?: components["parameters"]["limitParam"];
+                limit?: components["parameters"]["searchLimitParam"];
             };
             header?: never;
             path?: never;
@@ -5813,7 +8028,10 @@ export interface operations {
     getSource: {
         parameters: {
             query?: never;
-            header?: never;
+            header?: {
+                /** @description ETag from a previous response. When it matches the current version, the server responds 304 Not Modified without a body. */
+                "If-None-Match"?: components["parameters"]["ifNoneMatch"];
+            };
             path: {
                 id: string;
             };
@@ -5824,19 +8042,31 @@ export interface operations {
             /** @description Source details */
             200: {
                 headers: {
+                    ETag?: components["headers"]["ETag"];
                     [name: string]: unknown;
                 };
                 content: {
                     "application/json": components["schemas"]["SourceDetail"];
                 };
             };
+            304: components["responses"]["NotModified"];
             404: components["responses"]["NotFound"];
         };
     };
     updateSource: {
         parameters: {
-            query?: never;
-            header?: never;
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
+            header?: {
+                /** @description ETag of the version being updated, as returned by GET (e.g. "3" or "3-9f86d081884c7d65"); only the version before any dash is used. Used in place of the body version; when the entity has since changed the server responds 412 Precondition Failed. Retries are not attempted when this header is sent. */
+                "If-Match"?: components["parameters"]["ifMatch"];
+            };
             path: {
                 id: string;
             };
@@ -5860,6 +8090,7 @@ export interface operations {
             400: components["responses"]["BadRequest"];
             404: components["responses"]["NotFound"];
             409: components["responses"]["Conflict"];
+            412: components["responses"]["PreconditionFailed"];
         };
     };
     deleteSource: {
@@ -5890,7 +8121,16 @@ export interface operations {
     };
     getCitationsForSource: {
         parameters: {
-            query?: never;
+            query?: {
+                limit?: components["parameters"]["limitParam"];
+                offset?: components["parameters"]["offsetParam"];
+                /** @description Only return citations of this fact type (e.g. person_birth) */
+                fact_type?: string;
+                /** @description Only return citations whose source has this quality */
+                source_quality?: components["parameters"]["sourceQualityParam"];
+                /** @description Only return citations providing this type of evidence */
+                evidence_type?: components["parameters"]["evidenceTypeParam"];
+            };
             header?: never;
             path: {
                 id: string;
@@ -5908,6 +8148,30 @@ export interface operations {
                     "application/json": components["schemas"]["CitationList"];
                 };
             };
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
+        };
+    };
+    getSourceUsage: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Source usage */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SourceUsage"];
+                };
+            };
             404: components["responses"]["NotFound"];
         };
     };
@@ -5970,7 +8234,14 @@ export interface operations {
     };
     updateCitation: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
             header?: never;
             path: {
                 id: string;
@@ -6025,7 +8296,12 @@ export interface operations {
     };
     getCitationsForPerson: {
         parameters: {
-            query?: never;
+            query?: {
+                /** @description Only return citations whose source has this quality */
+                source_quality?: components["parameters"]["sourceQualityParam"];
+                /** @description Only return citations providing this type of evidence */
+                evidence_type?: components["parameters"]["evidenceTypeParam"];
+            };
             header?: never;
             path: {
                 id: components["parameters"]["personId"];
@@ -6043,10 +8319,11 @@ export interface operations {
                     "application/json": components["schemas"]["CitationList"];
                 };
             };
+            400: components["responses"]["BadRequest"];
             404: components["responses"]["NotFound"];
         };
     };
-    getPersonNames: {
+    getPersonSourceReport: {
         parameters: {
             query?: never;
             header?: never;
@@ -6056,6 +8333,32 @@ export interface operations {
             cookie?: never;
         };
         requestBody?: never;
+        responses: {
+            /** @description Source coverage report */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SourceReport"];
+                };
+            };
+            404: components["responses"]["NotFound"];
+        };
+    };
+    getPersonNames: {
+        parameters: {
+            query?: {
+                limit?: components["parameters"]["limitParam"];
+                offset?: components["parameters"]["offsetParam"];
+            };
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
         responses: {
             /** @description List of person names */
             200: {
@@ -6063,13 +8366,101 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["PersonNameList"];
+                    "application/json": components["schemas"]["PersonNameList"];
+                };
+            };
+            404: components["responses"]["NotFound"];
+        };
+    };
+    addPersonName: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["PersonNameCreate"];
+            };
+        };
+        responses: {
+            /** @description Name added */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PersonName"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
+        };
+    };
+    updatePersonName: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+                nameId: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["PersonNameUpdate"];
+            };
+        };
+        responses: {
+            /** @description Name updated */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PersonName"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
+        };
+    };
+    deletePersonName: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+                nameId: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Name removed */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Cannot delete last name or primary name */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
                 };
             };
             404: components["responses"]["NotFound"];
         };
     };
-    addPersonName: {
+    addPersonExternalId: {
         parameters: {
             query?: never;
             header?: never;
@@ -6080,104 +8471,101 @@ export interface operations {
         };
         requestBody: {
             content: {
-                "application/json": components["schemas"]["PersonNameCreate"];
+                "application/json": components["schemas"]["ExternalIdCreate"];
             };
         };
         responses: {
-            /** @description Name added */
+            /** @description External identifier added */
             201: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["PersonName"];
+                    "application/json": components["schemas"]["ExternalLink"];
                 };
             };
             400: components["responses"]["BadRequest"];
             404: components["responses"]["NotFound"];
         };
     };
-    updatePersonName: {
+    exportTree: {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: components["parameters"]["personId"];
-                nameId: string;
-            };
+            path?: never;
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": components["schemas"]["PersonNameUpdate"];
-            };
-        };
+        requestBody?: never;
         responses: {
-            /** @description Name updated */
+            /** @description Family tree data */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["PersonName"];
+                    "application/json": {
+                        persons?: components["schemas"]["Person"][];
+                        families?: components["schemas"]["Family"][];
+                    };
                 };
             };
-            400: components["responses"]["BadRequest"];
-            404: components["responses"]["NotFound"];
         };
     };
-    deletePersonName: {
+    exportSelection: {
         parameters: {
             query?: never;
             header?: never;
-            path: {
-                id: components["parameters"]["personId"];
-                nameId: string;
-            };
+            path?: never;
             cookie?: never;
         };
-        requestBody?: never;
-        responses: {
-            /** @description Name removed */
-            204: {
-                headers: {
-                    [name: string]: unknown;
-                };
-                content?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ExportSelectionRequest"];
             };
-            /** @description Cannot delete last name or primary name */
-            400: {
+        };
+        responses: {
+            /** @description Selected persons and connecting families */
+            200: {
                 headers: {
+                    "Content-Disposition"?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Error"];
+                    "application/x-gedcom": string;
+                    "application/json": {
+                        persons?: components["schemas"]["Person"][];
+                        families?: components["schemas"]["Family"][];
+                    };
                 };
             };
+            400: components["responses"]["BadRequest"];
             404: components["responses"]["NotFound"];
         };
     };
-    exportTree: {
+    exportBySurname: {
         parameters: {
             query?: never;
             header?: never;
             path?: never;
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody?: {
+            content: {
+                "application/json": components["schemas"]["ExportBySurnameRequest"];
+            };
+        };
         responses: {
-            /** @description Family tree data */
+            /** @description ZIP archive with one GEDCOM file per surname */
             200: {
                 headers: {
+                    "Content-Disposition"?: string;
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": {
-                        persons?: components["schemas"]["Person"][];
-                        families?: components["schemas"]["Family"][];
-                    };
+                    "application/zip": string;
                 };
             };
+            400: components["responses"]["BadRequest"];
         };
     };
     exportPersons: {
@@ -6375,9 +8763,35 @@ export interface operations {
             404: components["responses"]["NotFound"];
         };
     };
+    getQualityBySurname: {
+        parameters: {
+            query?: {
+                limit?: components["parameters"]["limitParam"];
+                offset?: components["parameters"]["offsetParam"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Per-surname quality */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SurnameQualityReport"];
+                };
+            };
+        };
+    };
     getQualityReport: {
         parameters: {
-            query?: never;
+            query?: {
+                /** @description Output format */
+                format?: "json" | "csv" | "pdf";
+            };
             header?: never;
             path?: never;
             cookie?: never;
@@ -6387,12 +8801,16 @@ export interface operations {
             /** @description Full quality report */
             200: {
                 headers: {
+                    "Content-Disposition"?: string;
                     [name: string]: unknown;
                 };
                 content: {
                     "application/json": components["schemas"]["QualityReport"];
+                    "text/csv": string;
+                    "application/pdf": string;
                 };
             };
+            400: components["responses"]["BadRequest"];
         };
     };
     getValidationIssues: {
@@ -6422,6 +8840,29 @@ export interface operations {
             };
         };
     };
+    listOrphanedPersons: {
+        parameters: {
+            query?: {
+                limit?: components["parameters"]["limitParam"];
+                offset?: components["parameters"]["offsetParam"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Persons with no family links */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PersonList"];
+                };
+            };
+        };
+    };
     getPersonsDuplicates: {
         parameters: {
             query?: {
@@ -6514,6 +8955,31 @@ export interface operations {
             404: components["responses"]["NotFound"];
         };
     };
+    bulkDeletePersons: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["BulkDeleteRequest"];
+            };
+        };
+        responses: {
+            /** @description Bulk delete completed (check results for individual outcomes) */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["BulkDeleteResponse"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+        };
+    };
     batchMergePersons: {
         parameters: {
             query?: never;
@@ -6564,6 +9030,29 @@ export interface operations {
             400: components["responses"]["BadRequest"];
         };
     };
+    getMissingDataReport: {
+        parameters: {
+            query?: {
+                limit?: components["parameters"]["limitParam"];
+                offset?: components["parameters"]["offsetParam"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Missing data report */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["MissingDataReport"];
+                };
+            };
+        };
+    };
     getStatistics: {
         parameters: {
             query?: never;
@@ -6584,6 +9073,26 @@ export interface operations {
             };
         };
     };
+    getMarriageAgeStatistics: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Marriage age statistics */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["MarriageAgeStatistics"];
+                };
+            };
+        };
+    };
     getDiscoveryFeed: {
         parameters: {
             query?: {
@@ -6729,6 +9238,32 @@ export interface operations {
             404: components["responses"]["NotFound"];
         };
     };
+    getPersonKin: {
+        parameters: {
+            query?: {
+                /** @description Maximum number of parent-child links between the person and a relative */
+                maxDegree?: number;
+            };
+            header?: never;
+            path: {
+                id: components["parameters"]["personId"];
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Kinship report */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["KinReport"];
+                };
+            };
+            404: components["responses"]["NotFound"];
+        };
+    };
     getRelationship: {
         parameters: {
             query?: never;
@@ -6767,6 +9302,8 @@ export interface operations {
                 limit?: components["parameters"]["limitParam"];
                 offset?: components["parameters"]["offsetParam"];
                 order?: "asc" | "desc";
+                /** @description Only return notes attached to this person, family, or source */
+                entityId?: string;
             };
             header?: never;
             path?: never;
@@ -6837,7 +9374,14 @@ export interface operations {
     };
     updateNote: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
             header?: never;
             path: {
                 /** @description Note UUID */
@@ -6969,7 +9513,14 @@ export interface operations {
     };
     updateSubmitter: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
             header?: never;
             path: {
                 /** @description Submitter UUID */
@@ -7101,7 +9652,14 @@ export interface operations {
     };
     updateRepository: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
             header?: never;
             path: {
                 /** @description Repository UUID */
@@ -7233,7 +9791,14 @@ export interface operations {
     };
     updateAssociation: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
             header?: never;
             path: {
                 /** @description Association UUID */
@@ -7388,7 +9953,14 @@ export interface operations {
     };
     updateLDSOrdinance: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
             header?: never;
             path: {
                 /** @description LDS Ordinance UUID */
@@ -7566,7 +10138,14 @@ export interface operations {
     };
     updateEvidenceAnalysis: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
             header?: never;
             path: {
                 /** @description Evidence Analysis UUID */
@@ -7824,7 +10403,14 @@ export interface operations {
     };
     updateResearchLog: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
             header?: never;
             path: {
                 /** @description Research Log UUID */
@@ -7978,7 +10564,14 @@ export interface operations {
     };
     updateProofSummary: {
         parameters: {
-            query?: never;
+            query?: {
+                /**
+                 * @description Automatically retry on a version conflict by re-reading the current
+                 *     version and re-applying the submitted changes. Changes to the same
+                 *     field made concurrently by someone else are overwritten.
+                 */
+                retry?: components["parameters"]["retryParam"];
+            };
             header?: never;
             path: {
                 /** @description Proof Summary UUID */
@@ -8057,4 +10650,29 @@ export interface operations {
             400: components["responses"]["BadRequest"];
         };
     };
+    verifyProjections: {
+        parameters: {
+            query?: {
+                /** @description Compare only this many randomly chosen entities (default all) */
+                sample?: number;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Projection check result */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ProjectionCheckResponse"];
+                };
+            };
+            401: components["responses"]["Unauthorized"];
+            403: components["responses"]["Forbidden"];
+        };
+    };
 }
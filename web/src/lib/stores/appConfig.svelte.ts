@@ -1,24 +1,36 @@
 /**
  * App Config Store
  *
- * Fetches application configuration from the backend (e.g., demo mode status).
+ * Fetches application configuration from the backend (e.g., demo mode status
+ * and upload and tree depth limits).
  * Loaded once on app startup via the root layout.
  */
 
+import { apiFetch } from '$lib/api/client';
+
 interface AppConfig {
 	demo_mode: boolean;
+	max_import_size: number; // bytes, 0 when unknown
+	max_generations: number; // deepest tree view the server allows
+	trees: string[]; // hosted family trees, the default tree first
 }
 
 let config = $state<AppConfig>({
-	demo_mode: false
+	demo_mode: false,
+	max_import_size: 0,
+	max_generations: 10,
+	trees: ['default']
 });
 
 export async function loadAppConfig(): Promise<void> {
 	try {
-		const res = await fetch('/api/v1/config');
+		const res = await apiFetch('/api/v1/config');
 		if (res.ok) {
 			const data = await res.json();
 			config.demo_mode = data.demo_mode ?? false;
+			config.max_import_size = data.max_import_size ?? 0;
+			config.max_generations = data.max_generations ?? 10;
+			config.trees = data.trees ?? ['default'];
 		}
 	} catch {
 		// Silently fail - defaults are safe
@@ -27,7 +39,7 @@ export async function loadAppConfig(): Promise<void> {
 
 export async function resetDemo(): Promise<boolean> {
 	try {
-		const res = await fetch('/api/v1/demo/reset', { method: 'POST' });
+		const res = await apiFetch('/api/v1/demo/reset', { method: 'POST' });
 		return res.ok;
 	} catch {
 		return false;